@@ -0,0 +1,62 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+
+	"2021_2_LostPointer/internal/microservices/catalog/proto"
+	"2021_2_LostPointer/internal/microservices/catalog/repository"
+	"2021_2_LostPointer/internal/microservices/catalog/usecase"
+)
+
+func InitializeDatabase() *sql.DB {
+	connectionString := fmt.Sprintf(
+		"user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
+		os.Getenv("DBUSER"),
+		os.Getenv("DBPASS"),
+		os.Getenv("DBHOST"),
+		os.Getenv("DBPORT"),
+		os.Getenv("DBNAME"),
+	)
+	database, err := sql.Open("postgres", connectionString)
+	if err != nil {
+		log.Fatalln("NO CONNECTION TO DATABASE", err.Error())
+	}
+	database.SetConnMaxLifetime(time.Second * 300)
+
+	return database
+}
+
+func main() {
+	dbConnection := InitializeDatabase()
+	storage := repository.NewCatalogStorage(dbConnection)
+	defer func() {
+		if dbConnection != nil {
+			err := dbConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing database connection")
+			}
+		}
+	}()
+
+	port := os.Getenv("CATALOG_PORT")
+	listen, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Println("CANNOT LISTEN PORT: ", port, err.Error())
+	}
+
+	server := grpc.NewServer()
+	proto.RegisterCatalogServer(server, usecase.NewCatalogService(storage))
+	log.Printf("STARTED CATALOG MICROSERVICE ON %s", port)
+	err = server.Serve(listen)
+	if err != nil {
+		log.Println("CANNOT LISTEN PORT: ", port, err.Error())
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"2021_2_LostPointer/internal/ingest"
+	"2021_2_LostPointer/pkg/image"
+)
+
+func InitializeDatabase() *sql.DB {
+	connectionString := fmt.Sprintf(
+		"user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
+		os.Getenv("DBUSER"),
+		os.Getenv("DBPASS"),
+		os.Getenv("DBHOST"),
+		os.Getenv("DBPORT"),
+		os.Getenv("DBNAME"),
+	)
+	database, err := sql.Open("postgres", connectionString)
+	if err != nil {
+		log.Fatalln("NO CONNECTION TO DATABASE", err.Error())
+	}
+	database.SetConnMaxLifetime(time.Second * 300)
+
+	return database
+}
+
+func printSkipped(skipped map[string]error) {
+	paths := make([]string, 0, len(skipped))
+	for path := range skipped {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		log.Printf("SKIPPED %s: %s", path, skipped[path].Error())
+	}
+}
+
+// Загрузка каталога из тегов аудиофайлов (MP3 и FLAC того же трека) вместо ручных CSV.
+// С -dry-run выводит изменения, которые были бы внесены, не трогая базу и файлы
+func main() {
+	dir := flag.String("dir", ".", "directory with audio files")
+	dryRun := flag.Bool("dry-run", false, "print changes without applying them")
+	flag.Parse()
+
+	tracks, skipped, err := ingest.Scan(*dir)
+	if err != nil {
+		log.Fatalln("CANNOT SCAN DIRECTORY", err.Error())
+	}
+	printSkipped(skipped)
+
+	dbConnection := InitializeDatabase()
+	defer func() {
+		if dbConnection != nil {
+			err := dbConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing database connection")
+			}
+		}
+	}()
+
+	imageServices := image.NewImagesService()
+	catalog := ingest.NewCatalog(dbConnection, &imageServices, os.Getenv("ARTWORKS_FULL_PREFIX"), os.Getenv("TRACKS_PATH"))
+	report, err := catalog.Ingest(tracks, *dryRun)
+	if err != nil {
+		log.Fatalf("CANNOT INGEST CATALOG: %s", err.Error())
+	}
+
+	printSkipped(report.Skipped)
+	for _, change := range report.Changes {
+		fmt.Println(change)
+	}
+	log.Printf("%d files scanned, %d skipped, %d changes, %d tracks unchanged",
+		len(tracks), len(skipped)+len(report.Skipped), len(report.Changes), report.Unchanged)
+	if *dryRun {
+		log.Println("DRY RUN: nothing was applied")
+	}
+}
@@ -4,25 +4,49 @@ import (
 	"2021_2_LostPointer/internal/monitoring/delivery"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 
 	api "2021_2_LostPointer/internal/api/delivery"
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
+	"2021_2_LostPointer/internal/media"
 	authMicroservice "2021_2_LostPointer/internal/microservices/authorization/proto"
+	catalogMicroservice "2021_2_LostPointer/internal/microservices/catalog/proto"
 	musicMicroservice "2021_2_LostPointer/internal/microservices/music/proto"
+	playerMicroservice "2021_2_LostPointer/internal/microservices/player/proto"
 	playlistsMicroservice "2021_2_LostPointer/internal/microservices/playlists/proto"
 	profileMicroservice "2021_2_LostPointer/internal/microservices/profile/proto"
 	"2021_2_LostPointer/internal/middleware"
 	"2021_2_LostPointer/pkg/image"
 )
 
+func InitializeRedis() *redis.Client {
+	var AddrConfig string
+	if len(os.Getenv("REDIS_PORT")) == 0 {
+		AddrConfig = os.Getenv("REDIS_HOST")
+	} else {
+		AddrConfig = fmt.Sprintf("%s:%s", os.Getenv("REDIS_HOST"), os.Getenv("REDIS_PORT"))
+	}
+	redisConnection := redis.NewClient(&redis.Options{
+		Addr:     AddrConfig,
+		Password: os.Getenv("REDIS_PASS"),
+		DB:       constants.EventsRedisDB,
+	})
+
+	return redisConnection
+}
+
 //nolint:ireturn
 func LoadMicroservices(server *echo.Echo) (authMicroservice.AuthorizationClient, profileMicroservice.ProfileClient,
-	musicMicroservice.MusicClient, playlistsMicroservice.PlaylistsClient, []*grpc.ClientConn) {
+	musicMicroservice.MusicClient, playlistsMicroservice.PlaylistsClient, catalogMicroservice.CatalogClient,
+	playerMicroservice.PlayerClient, []*grpc.ClientConn) {
 	connections := make([]*grpc.ClientConn, 0)
 
 	authPORT := os.Getenv("AUTH_PORT")
@@ -65,16 +89,40 @@ func LoadMicroservices(server *echo.Echo) (authMicroservice.AuthorizationClient,
 	}
 	connections = append(connections, playlistsConn)
 
+	catalogPORT := os.Getenv("CATALOG_PORT")
+	catalogConn, err := grpc.Dial(
+		os.Getenv("CATALOG_HOST")+catalogPORT,
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		server.Logger.Fatal("cant connect to grpc")
+	}
+	connections = append(connections, catalogConn)
+
+	playerPORT := os.Getenv("PLAYER_PORT")
+	playerConn, err := grpc.Dial(
+		os.Getenv("PLAYER_HOST")+playerPORT,
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		server.Logger.Fatal("cant connect to grpc")
+	}
+	connections = append(connections, playerConn)
+
 	authorizationManager := authMicroservice.NewAuthorizationClient(authConn)
 	profileManager := profileMicroservice.NewProfileClient(profileConn)
 	musicManager := musicMicroservice.NewMusicClient(musicConn)
 	playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+	catalogManager := catalogMicroservice.NewCatalogClient(catalogConn)
+	playerManager := playerMicroservice.NewPlayerClient(playerConn)
 
-	return authorizationManager, profileManager, musicManager, playlistsManager, connections
+	return authorizationManager, profileManager, musicManager, playlistsManager, catalogManager, playerManager, connections
 }
 
 func main() {
 	server := echo.New()
+	// X-Forwarded-For учитывается только от прокси из внутренней сети, иначе клиент может подменить свой IP
+	server.IPExtractor = echo.ExtractIPFromXFFHeader()
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -86,7 +134,7 @@ func main() {
 		}
 	}(prLogger)
 
-	auth, profile, music, playlists, conn := LoadMicroservices(server)
+	auth, profile, music, playlists, catalog, player, conn := LoadMicroservices(server)
 	defer func() {
 		if len(conn) == 0 {
 			return
@@ -98,8 +146,23 @@ func main() {
 			}
 		}
 	}()
+	redisConnection := InitializeRedis()
+	defer func() {
+		if redisConnection != nil {
+			err := redisConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing redis connection")
+			}
+		}
+	}()
 	imageServices := image.NewImagesService()
-	appHandler := api.NewAPIMicroservices(logger, imageServices, auth, profile, music, playlists)
+	signer, err := media.NewURLSigner(os.Getenv("MEDIA_URL_KEYS"), constants.MediaURLLifetime)
+	if err != nil {
+		log.Fatalf("Error occurred during media url signer initialization: %s", err.Error())
+	}
+	appHandler := api.NewAPIMicroservices(logger, imageServices, auth, profile, music, playlists, catalog, player,
+		http.Dir(os.Getenv("TRACKS_PATH")), http.Dir(os.Getenv("PLAYLIST_FULL_PREFIX")), signer,
+		events.NewRedisBroker(redisConnection))
 
 	monitor := delivery.RegisterMonitoring(server)
 	middlewareHandler := middleware.NewMiddlewareHandler(auth, logger, monitor)
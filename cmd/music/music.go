@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -8,14 +9,34 @@ import (
 	"os"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/media"
 	"2021_2_LostPointer/internal/microservices/music/proto"
 	"2021_2_LostPointer/internal/microservices/music/repository"
 	"2021_2_LostPointer/internal/microservices/music/usecase"
+	"2021_2_LostPointer/pkg/scheduler"
 )
 
+func InitializeRedis() *redis.Client {
+	var AddrConfig string
+	if len(os.Getenv("REDIS_PORT")) == 0 {
+		AddrConfig = os.Getenv("REDIS_HOST")
+	} else {
+		AddrConfig = fmt.Sprintf("%s:%s", os.Getenv("REDIS_HOST"), os.Getenv("REDIS_PORT"))
+	}
+	redisConnection := redis.NewClient(&redis.Options{
+		Addr:     AddrConfig,
+		Password: os.Getenv("REDIS_PASS"),
+		DB:       2,
+	})
+
+	return redisConnection
+}
+
 func InitializeDatabase() *sql.DB {
 	connectionString := fmt.Sprintf(
 		"user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
@@ -35,8 +56,22 @@ func InitializeDatabase() *sql.DB {
 }
 
 func main() {
+	redisConnection := InitializeRedis()
 	dbConnection := InitializeDatabase()
-	storage := repository.NewMusicStorage(dbConnection)
+	signer, err := media.NewURLSigner(os.Getenv("MEDIA_URL_KEYS"), constants.MediaURLLifetime)
+	if err != nil {
+		log.Fatalln("CANNOT INITIALIZE MEDIA URL SIGNER", err.Error())
+	}
+	links := media.NewLinks(signer, os.Getenv("ARTISTS_ROOT_PREFIX"), os.Getenv("MOV_ROOT_PREFIX"), os.Getenv("PLAYLIST_ROOT_PREFIX"))
+	storage := repository.NewMusicStorage(dbConnection, redisConnection, links)
+	defer func() {
+		if redisConnection != nil {
+			err := redisConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing redis connection")
+			}
+		}
+	}()
 	defer func() {
 		if dbConnection != nil {
 			err := dbConnection.Close()
@@ -52,8 +87,30 @@ func main() {
 		log.Printf("CANNOT LISTEN PORT: %s error: %s", port, err.Error())
 	}
 
+	service := usecase.NewMusicService(storage)
+	go scheduler.Every(context.Background(), constants.ChartsRebuildInterval, func() {
+		if err := service.RebuildCharts(time.Now()); err != nil {
+			log.Printf("CANNOT REBUILD CHARTS: %s", err.Error())
+		}
+	})
+	go scheduler.Every(context.Background(), constants.ReleaseRadarRebuildInterval, func() {
+		if err := service.RebuildReleaseRadarPlaylists(time.Now()); err != nil {
+			log.Printf("CANNOT REBUILD RELEASE RADAR PLAYLISTS: %s", err.Error())
+		}
+	})
+	go scheduler.Every(context.Background(), constants.RecapsRebuildInterval, func() {
+		if err := service.RebuildRecaps(time.Now()); err != nil {
+			log.Printf("CANNOT REBUILD RECAPS: %s", err.Error())
+		}
+	})
+	go scheduler.Every(context.Background(), constants.RandomPoolsRebuildInterval, func() {
+		if err := service.RebuildRandomPools(); err != nil {
+			log.Printf("CANNOT REBUILD RANDOM POOLS: %s", err.Error())
+		}
+	})
+
 	server := grpc.NewServer()
-	proto.RegisterMusicServer(server, usecase.NewMusicService(storage))
+	proto.RegisterMusicServer(server, service)
 	log.Printf("STARTED MUSIC MICROSERVICE ON %s", port)
 	err = server.Serve(listen)
 	if err != nil {
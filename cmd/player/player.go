@@ -0,0 +1,99 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	_ "github.com/lib/pq"
+	"google.golang.org/grpc"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
+	"2021_2_LostPointer/internal/microservices/player/proto"
+	"2021_2_LostPointer/internal/microservices/player/repository"
+	"2021_2_LostPointer/internal/microservices/player/usecase"
+)
+
+func InitializeRedis(db int) *redis.Client {
+	var AddrConfig string
+	if len(os.Getenv("REDIS_PORT")) == 0 {
+		AddrConfig = os.Getenv("REDIS_HOST")
+	} else {
+		AddrConfig = fmt.Sprintf("%s:%s", os.Getenv("REDIS_HOST"), os.Getenv("REDIS_PORT"))
+	}
+	redisConnection := redis.NewClient(&redis.Options{
+		Addr:     AddrConfig,
+		Password: os.Getenv("REDIS_PASS"),
+		DB:       db,
+	})
+
+	return redisConnection
+}
+
+func InitializeDatabase() *sql.DB {
+	connectionString := fmt.Sprintf(
+		"user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
+		os.Getenv("DBUSER"),
+		os.Getenv("DBPASS"),
+		os.Getenv("DBHOST"),
+		os.Getenv("DBPORT"),
+		os.Getenv("DBNAME"),
+	)
+	database, err := sql.Open("postgres", connectionString)
+	if err != nil {
+		log.Fatalln("NO CONNECTION TO DATABASE", err.Error())
+	}
+	database.SetConnMaxLifetime(time.Second * 300)
+
+	return database
+}
+
+func main() {
+	redisConnection := InitializeRedis(3)
+	eventsConnection := InitializeRedis(constants.EventsRedisDB)
+	dbConnection := InitializeDatabase()
+	storage := repository.NewPlayerStorage(dbConnection, redisConnection)
+	defer func() {
+		if redisConnection != nil {
+			err := redisConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing redis connection")
+			}
+		}
+	}()
+	defer func() {
+		if eventsConnection != nil {
+			err := eventsConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing redis connection")
+			}
+		}
+	}()
+	defer func() {
+		if dbConnection != nil {
+			err := dbConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing database connection")
+			}
+		}
+	}()
+
+	port := os.Getenv("PLAYER_PORT")
+	listen, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Println("CANNOT LISTEN PORT: ", port, err.Error())
+	}
+
+	server := grpc.NewServer()
+	proto.RegisterPlayerServer(server, usecase.NewPlayerService(storage, events.NewRedisBroker(eventsConnection)))
+	log.Printf("STARTED PLAYER MICROSERVICE ON %s", port)
+	err = server.Serve(listen)
+	if err != nil {
+		log.Println("CANNOT LISTEN PORT: ", port, err.Error())
+	}
+}
@@ -8,14 +8,33 @@ import (
 	"os"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
 	"2021_2_LostPointer/internal/microservices/playlists/proto"
 	"2021_2_LostPointer/internal/microservices/playlists/repository"
 	"2021_2_LostPointer/internal/microservices/playlists/usecase"
 )
 
+func InitializeRedis() *redis.Client {
+	var AddrConfig string
+	if len(os.Getenv("REDIS_PORT")) == 0 {
+		AddrConfig = os.Getenv("REDIS_HOST")
+	} else {
+		AddrConfig = fmt.Sprintf("%s:%s", os.Getenv("REDIS_HOST"), os.Getenv("REDIS_PORT"))
+	}
+	redisConnection := redis.NewClient(&redis.Options{
+		Addr:     AddrConfig,
+		Password: os.Getenv("REDIS_PASS"),
+		DB:       constants.EventsRedisDB,
+	})
+
+	return redisConnection
+}
+
 func InitializeDatabase() *sql.DB {
 	connectionString := fmt.Sprintf(
 		"user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
@@ -35,8 +54,17 @@ func InitializeDatabase() *sql.DB {
 }
 
 func main() {
+	redisConnection := InitializeRedis()
 	dbConnection := InitializeDatabase()
 	storage := repository.NewPlaylistsStorage(dbConnection)
+	defer func() {
+		if redisConnection != nil {
+			err := redisConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing redis connection")
+			}
+		}
+	}()
 	defer func() {
 		if dbConnection != nil {
 			err := dbConnection.Close()
@@ -53,7 +81,7 @@ func main() {
 	}
 
 	server := grpc.NewServer()
-	proto.RegisterPlaylistsServer(server, usecase.NewPlaylistsService(storage))
+	proto.RegisterPlaylistsServer(server, usecase.NewPlaylistsService(storage, events.NewRedisBroker(redisConnection)))
 	log.Printf("STARTED PLAYLISTS MICROSERVICE ON %s", port)
 	err = server.Serve(listen)
 	if err != nil {
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"2021_2_LostPointer/internal/microservices/music/repository"
+)
+
+func InitializeDatabase() *sql.DB {
+	connectionString := fmt.Sprintf(
+		"user=%s password=%s host=%s port=%s dbname=%s sslmode=disable",
+		os.Getenv("DBUSER"),
+		os.Getenv("DBPASS"),
+		os.Getenv("DBHOST"),
+		os.Getenv("DBPORT"),
+		os.Getenv("DBNAME"),
+	)
+	database, err := sql.Open("postgres", connectionString)
+	if err != nil {
+		log.Fatalln("NO CONNECTION TO DATABASE", err.Error())
+	}
+	database.SetConnMaxLifetime(time.Second * 300)
+
+	return database
+}
+
+// Полная перестройка поискового индекса треков. Триггеры поддерживают индекс в актуальном
+// состоянии, перестройка нужна после массовой загрузки данных в обход триггеров или смены формата документов
+func main() {
+	dbConnection := InitializeDatabase()
+	defer func() {
+		if dbConnection != nil {
+			err := dbConnection.Close()
+			if err != nil {
+				log.Fatal("Error occurred during closing database connection")
+			}
+		}
+	}()
+
+	storage := repository.NewMusicStorage(dbConnection, nil, nil)
+	started := time.Now()
+	indexed, err := storage.RebuildSearchIndex()
+	if err != nil {
+		log.Printf("CANNOT REBUILD SEARCH INDEX: %s", err.Error())
+		return
+	}
+	log.Printf("SEARCH INDEX REBUILT: %d tracks in %s", indexed, time.Since(started))
+}
@@ -29,6 +29,118 @@ CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
 COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement and index searching based on trigrams';
 
 
+--
+-- Name: lostpointer; Type: DATABASE PROPERTIES; Schema: -; Owner: postgres
+--
+
+ALTER DATABASE lostpointer SET pg_trgm.word_similarity_threshold TO '0.3';
+
+
+--
+-- Name: refresh_track_search(integer[]); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.refresh_track_search(track_ids integer[]) RETURNS void
+    LANGUAGE sql
+    AS $$
+INSERT INTO public.track_search (track_id, document, text)
+SELECT t.id,
+       setweight(to_tsvector('simple', t.title), 'A') || setweight(to_tsvector('simple', art.name), 'B') ||
+       setweight(to_tsvector('simple', alb.title), 'C'),
+       concat_ws(' ', t.title, art.name, alb.title)
+FROM public.tracks t
+JOIN public.artists art ON art.id = t.artist
+JOIN public.albums alb ON alb.id = t.album
+WHERE track_ids IS NULL OR t.id = ANY (track_ids)
+ON CONFLICT (track_id) DO UPDATE SET document = EXCLUDED.document, text = EXCLUDED.text;
+$$;
+
+
+ALTER FUNCTION public.refresh_track_search(track_ids integer[]) OWNER TO postgres;
+
+--
+-- Name: rebuild_track_search(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.rebuild_track_search() RETURNS bigint
+    LANGUAGE sql
+    AS $$
+TRUNCATE public.track_search;
+SELECT public.refresh_track_search(NULL);
+SELECT count(*) FROM public.track_search;
+$$;
+
+
+ALTER FUNCTION public.rebuild_track_search() OWNER TO postgres;
+
+--
+-- Name: albums_track_search_trigger(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.albums_track_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM public.refresh_track_search(ARRAY(SELECT id FROM public.tracks WHERE album = NEW.id));
+    RETURN NULL;
+END;
+$$;
+
+
+ALTER FUNCTION public.albums_track_search_trigger() OWNER TO postgres;
+
+--
+-- Name: artists_track_search_trigger(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.artists_track_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM public.refresh_track_search(ARRAY(SELECT id FROM public.tracks WHERE artist = NEW.id));
+    RETURN NULL;
+END;
+$$;
+
+
+ALTER FUNCTION public.artists_track_search_trigger() OWNER TO postgres;
+
+--
+-- Name: tracks_track_search_trigger(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.tracks_track_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM public.refresh_track_search(ARRAY[NEW.id]);
+    RETURN NULL;
+END;
+$$;
+
+
+ALTER FUNCTION public.tracks_track_search_trigger() OWNER TO postgres;
+
+--
+-- Name: lyrics_has_lyrics_trigger(); Type: FUNCTION; Schema: public; Owner: postgres
+--
+
+CREATE FUNCTION public.lyrics_has_lyrics_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE public.tracks SET has_lyrics = false WHERE id = OLD.track_id;
+    ELSE
+        UPDATE public.tracks SET has_lyrics = true WHERE id = NEW.track_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+
+ALTER FUNCTION public.lyrics_has_lyrics_trigger() OWNER TO postgres;
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
@@ -44,7 +156,8 @@ CREATE TABLE public.albums (
                                artist integer NOT NULL,
                                artwork character varying,
                                track_count integer,
-                               artwork_color text DEFAULT '#4b4b4b'::text
+                               artwork_color text DEFAULT '#4b4b4b'::text,
+                               release_date date DEFAULT CURRENT_DATE NOT NULL
 );
 
 
@@ -151,7 +264,9 @@ ALTER SEQUENCE public.friends_id_seq OWNED BY public.friends.id;
 
 CREATE TABLE public.genres (
                                id integer NOT NULL,
-                               name character varying NOT NULL
+                               name character varying NOT NULL,
+                               artwork character varying DEFAULT 'default_genre_artwork'::character varying NOT NULL,
+                               artwork_color character varying DEFAULT '#8071c2'::character varying NOT NULL
 );
 
 
@@ -253,76 +368,17 @@ ALTER SEQUENCE public.playlists_id_seq OWNED BY public.playlists.id;
 
 
 --
--- Name: search; Type: TABLE; Schema: public; Owner: postgres
---
-
-CREATE TABLE public.search (
-                               id integer NOT NULL,
-                               concatenation tsvector,
-                               track integer NOT NULL,
-                               artist integer NOT NULL
-);
-
-
-ALTER TABLE public.search OWNER TO postgres;
-
---
--- Name: search_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
---
-
-CREATE SEQUENCE public.search_id_seq
-    AS integer
-    START WITH 1
-    INCREMENT BY 1
-    NO MINVALUE
-    NO MAXVALUE
-    CACHE 1;
-
-
-ALTER TABLE public.search_id_seq OWNER TO postgres;
-
---
--- Name: search_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
---
-
-ALTER SEQUENCE public.search_id_seq OWNED BY public.search.id;
-
-
---
--- Name: test; Type: TABLE; Schema: public; Owner: postgres
+-- Name: track_search; Type: TABLE; Schema: public; Owner: postgres
 --
 
-CREATE TABLE public.test (
-                             id integer NOT NULL,
-                             concat character varying,
-                             track integer NOT NULL,
-                             artist integer
+CREATE TABLE public.track_search (
+                                     track_id integer NOT NULL,
+                                     document tsvector NOT NULL,
+                                     text character varying NOT NULL
 );
 
 
-ALTER TABLE public.test OWNER TO postgres;
-
---
--- Name: test_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
---
-
-CREATE SEQUENCE public.test_id_seq
-    AS integer
-    START WITH 1
-    INCREMENT BY 1
-    NO MINVALUE
-    NO MAXVALUE
-    CACHE 1;
-
-
-ALTER TABLE public.test_id_seq OWNER TO postgres;
-
---
--- Name: test_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
---
-
-ALTER SEQUENCE public.test_id_seq OWNED BY public.test.id;
-
+ALTER TABLE public.track_search OWNER TO postgres;
 
 --
 -- Name: tracks; Type: TABLE; Schema: public; Owner: postgres
@@ -334,12 +390,13 @@ CREATE TABLE public.tracks (
                                artist integer NOT NULL,
                                album integer NOT NULL,
                                explicit boolean DEFAULT false NOT NULL,
-                               genre integer,
-                               number integer,
+                               genre integer NOT NULL,
+                               number integer NOT NULL,
                                file character varying NOT NULL,
                                listen_count bigint DEFAULT 0 NOT NULL,
                                duration integer NOT NULL,
-                               lossless boolean DEFAULT false NOT NULL
+                               lossless boolean DEFAULT false NOT NULL,
+                               has_lyrics boolean DEFAULT false NOT NULL
 );
 
 
@@ -377,7 +434,9 @@ CREATE TABLE public.users (
                               password character varying NOT NULL,
                               salt character varying NOT NULL,
                               avatar character varying,
-                              nickname character varying NOT NULL
+                              nickname character varying NOT NULL,
+                              save_search_history boolean DEFAULT true NOT NULL,
+                              is_admin boolean DEFAULT false NOT NULL
 );
 
 
@@ -391,7 +450,8 @@ ALTER TABLE public.users OWNER TO postgres;
 CREATE TABLE public.likes (
                               id integer NOT NULL,
                               user_id integer NOT NULL,
-                              track_id integer NOT NULL
+                              track_id integer NOT NULL,
+                              created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -419,257 +479,562 @@ ALTER TABLE public.likes_id_seq OWNER TO postgres;
 ALTER SEQUENCE public.likes_id_seq OWNED BY public.likes.id;
 
 --
--- Name: users_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
+-- Name: track_plays_daily; Type: TABLE; Schema: public; Owner: postgres
 --
 
-CREATE SEQUENCE public.users_id_seq
-    AS integer
-    START WITH 1
-    INCREMENT BY 1
-    NO MINVALUE
-    NO MAXVALUE
-    CACHE 1;
+CREATE TABLE public.track_plays_daily (
+                              track_id integer NOT NULL,
+                              day date NOT NULL,
+                              plays bigint DEFAULT 0 NOT NULL
+);
 
 
-ALTER TABLE public.users_id_seq OWNER TO postgres;
+ALTER TABLE public.track_plays_daily OWNER TO postgres;
 
 --
--- Name: users_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
+-- Name: charts; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id;
+CREATE TABLE public.charts (
+                              period character varying NOT NULL,
+                              entity character varying NOT NULL,
+                              genre integer DEFAULT 0 NOT NULL,
+                              period_start date NOT NULL,
+                              "position" integer NOT NULL,
+                              entity_id integer NOT NULL,
+                              plays bigint NOT NULL,
+                              previous_position integer
+);
 
 
+ALTER TABLE public.charts OWNER TO postgres;
+
 --
--- Name: albums id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: recent_searches; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.albums ALTER COLUMN id SET DEFAULT nextval('public.albums_id_seq'::regclass);
+CREATE TABLE public.recent_searches (
+                              id integer NOT NULL,
+                              user_id integer NOT NULL,
+                              query character varying NOT NULL,
+                              result_type character varying,
+                              result_id integer,
+                              created_at timestamp with time zone DEFAULT now() NOT NULL
+);
 
 
+ALTER TABLE public.recent_searches OWNER TO postgres;
+
 --
--- Name: artists id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: recent_searches_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.artists ALTER COLUMN id SET DEFAULT nextval('public.artists_id_seq'::regclass);
+CREATE SEQUENCE public.recent_searches_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
 
+ALTER TABLE public.recent_searches_id_seq OWNER TO postgres;
 
 --
--- Name: friends id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: recent_searches_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.friends ALTER COLUMN id SET DEFAULT nextval('public.friends_id_seq'::regclass);
-
+ALTER SEQUENCE public.recent_searches_id_seq OWNED BY public.recent_searches.id;
 
 --
--- Name: genres id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: catalog_audit; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.genres ALTER COLUMN id SET DEFAULT nextval('public.genres_id_seq'::regclass);
+CREATE TABLE public.catalog_audit (
+                              id integer NOT NULL,
+                              admin_id integer,
+                              action character varying NOT NULL,
+                              entity character varying NOT NULL,
+                              entity_id integer NOT NULL,
+                              data jsonb NOT NULL,
+                              created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
 
+ALTER TABLE public.catalog_audit OWNER TO postgres;
 
 --
--- Name: playlist_tracks id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: catalog_audit_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.playlist_tracks ALTER COLUMN id SET DEFAULT nextval('public.playlist_tracks_id_seq'::regclass);
+CREATE SEQUENCE public.catalog_audit_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
+ALTER TABLE public.catalog_audit_id_seq OWNER TO postgres;
+
 --
--- Name: playlists id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: catalog_audit_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.playlists ALTER COLUMN id SET DEFAULT nextval('public.playlists_id_seq'::regclass);
-
+ALTER SEQUENCE public.catalog_audit_id_seq OWNED BY public.catalog_audit.id;
 
 --
--- Name: search id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: lyrics; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.search ALTER COLUMN id SET DEFAULT nextval('public.search_id_seq'::regclass);
+CREATE TABLE public.lyrics (
+                              track_id integer NOT NULL,
+                              plain text NOT NULL,
+                              lines jsonb,
+                              updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
 
+ALTER TABLE public.lyrics OWNER TO postgres;
 
 --
--- Name: test id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: saved_albums; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.test ALTER COLUMN id SET DEFAULT nextval('public.test_id_seq'::regclass);
+CREATE TABLE public.saved_albums (
+                              user_id integer NOT NULL,
+                              album_id integer NOT NULL,
+                              created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
 
+ALTER TABLE public.saved_albums OWNER TO postgres;
 
 --
--- Name: tracks id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: artist_follows; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.tracks ALTER COLUMN id SET DEFAULT nextval('public.tracks_id_seq'::regclass);
+CREATE TABLE public.artist_follows (
+                              user_id integer NOT NULL,
+                              artist_id integer NOT NULL,
+                              created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
 
+ALTER TABLE public.artist_follows OWNER TO postgres;
 
 --
--- Name: users id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: dislikes; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass);
+CREATE TABLE public.dislikes (
+                              user_id integer NOT NULL,
+                              track_id integer NOT NULL,
+                              created_at timestamp with time zone DEFAULT now() NOT NULL
+);
 
 
+ALTER TABLE public.dislikes OWNER TO postgres;
+
 --
--- Name: likes id; Type: DEFAULT; Schema: public; Owner: postgres
+-- Name: listens; Type: TABLE; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.likes ALTER COLUMN id SET DEFAULT nextval('public.likes_id_seq'::regclass);
+CREATE TABLE public.listens (
+                              id bigint NOT NULL,
+                              user_id integer NOT NULL,
+                              track_id integer NOT NULL,
+                              listened_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
 
+ALTER TABLE public.listens OWNER TO postgres;
 
 --
--- Data for Name: albums; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listens_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
 
-COPY public.albums (id, title, year, artist, artwork, track_count, artwork_color)
-    FROM '/docker-entrypoint-initdb.d/csv/albums.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE SEQUENCE public.listens_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
 
+ALTER TABLE public.listens_id_seq OWNER TO postgres;
 
 --
--- Data for Name: artists; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listens_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
 --
 
-COPY public.artists (id, name, avatar, video, avatar_color)
-    FROM '/docker-entrypoint-initdb.d/csv/artists.csv'
-    DELIMITER ';' quote E'\b' CSV;
-
+ALTER SEQUENCE public.listens_id_seq OWNED BY public.listens.id;
 
 --
--- Data for Name: friends; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listen_rejections; Type: TABLE; Schema: public; Owner: postgres
 --
 
-COPY public.friends (id, "user", friend)
-    FROM '/docker-entrypoint-initdb.d/csv/friends.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE TABLE public.listen_rejections (
+                              id bigint NOT NULL,
+                              user_id integer,
+                              ip character varying NOT NULL,
+                              track_id bigint NOT NULL,
+                              reason character varying NOT NULL,
+                              rejected_at timestamp with time zone DEFAULT now() NOT NULL
+);
 
 
+ALTER TABLE public.listen_rejections OWNER TO postgres;
+
 --
--- Data for Name: genres; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listen_rejections_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
 
-COPY public.genres (id, name)
-    FROM '/docker-entrypoint-initdb.d/csv/genres.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE SEQUENCE public.listen_rejections_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
 
 
+ALTER TABLE public.listen_rejections_id_seq OWNER TO postgres;
+
 --
--- Data for Name: playlist_tracks; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listen_rejections_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
 --
 
-COPY public.playlist_tracks (id, playlist, track)
-    FROM '/docker-entrypoint-initdb.d/csv/playlist_tracks.csv'
-    DELIMITER ';' quote E'\b' CSV;
-
+ALTER SEQUENCE public.listen_rejections_id_seq OWNED BY public.listen_rejections.id;
 
 --
--- Data for Name: playlists; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: play_queues; Type: TABLE; Schema: public; Owner: postgres
 --
 
-COPY public.playlists (id, title, user_id)
-    FROM '/docker-entrypoint-initdb.d/csv/playlists.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE TABLE public.play_queues (
+                              user_id integer NOT NULL,
+                              track_ids integer[] DEFAULT '{}'::integer[] NOT NULL,
+                              current_index integer DEFAULT 0 NOT NULL,
+                              position_ms bigint DEFAULT 0 NOT NULL,
+                              shuffle boolean DEFAULT false NOT NULL,
+                              repeat_mode character varying DEFAULT 'off'::character varying NOT NULL,
+                              context_type character varying DEFAULT ''::character varying NOT NULL,
+                              context_id integer DEFAULT 0 NOT NULL,
+                              device_id character varying DEFAULT ''::character varying NOT NULL,
+                              version bigint DEFAULT 0 NOT NULL,
+                              updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
 
 
+ALTER TABLE public.play_queues OWNER TO postgres;
+
 --
--- Data for Name: search; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: release_radar_playlists; Type: TABLE; Schema: public; Owner: postgres
 --
 
-COPY public.search (id, concatenation, track, artist)
-    FROM '/docker-entrypoint-initdb.d/csv/search.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE TABLE public.release_radar_playlists (
+                              user_id integer NOT NULL,
+                              playlist_id integer,
+                              week_start date NOT NULL
+);
 
 
+ALTER TABLE public.release_radar_playlists OWNER TO postgres;
+
 --
--- Data for Name: test; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listening_recaps; Type: TABLE; Schema: public; Owner: postgres
 --
 
-COPY public.test (id, concat, track, artist)
-    FROM '/docker-entrypoint-initdb.d/csv/test.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE TABLE public.listening_recaps (
+                              user_id integer NOT NULL,
+                              year integer NOT NULL,
+                              minutes_listened bigint NOT NULL,
+                              listens bigint NOT NULL,
+                              longest_streak integer NOT NULL,
+                              longest_streak_start date NOT NULL,
+                              most_played_day date NOT NULL,
+                              most_played_day_listens bigint NOT NULL,
+                              built_at timestamp with time zone DEFAULT now() NOT NULL
+);
 
 
+ALTER TABLE public.listening_recaps OWNER TO postgres;
+
 --
--- Data for Name: tracks; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: listening_recap_tops; Type: TABLE; Schema: public; Owner: postgres
 --
 
-COPY public.tracks (id, title, artist, album, explicit, genre, number, file, listen_count, duration, lossless)
-    FROM '/docker-entrypoint-initdb.d/csv/tracks.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE TABLE public.listening_recap_tops (
+                              user_id integer NOT NULL,
+                              year integer NOT NULL,
+                              entity character varying NOT NULL,
+                              "position" integer NOT NULL,
+                              entity_id integer NOT NULL,
+                              plays bigint NOT NULL
+);
+
 
+ALTER TABLE public.listening_recap_tops OWNER TO postgres;
 
 --
--- Data for Name: users; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: recap_playlists; Type: TABLE; Schema: public; Owner: postgres
 --
 
-COPY public.users (id, email, password, salt, avatar, nickname)
-    FROM '/docker-entrypoint-initdb.d/csv/users.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE TABLE public.recap_playlists (
+                              user_id integer NOT NULL,
+                              year integer NOT NULL,
+                              playlist_id integer NOT NULL
+);
 
 
+ALTER TABLE public.recap_playlists OWNER TO postgres;
+
 --
--- Data for Name: likes; Type: TABLE DATA; Schema: public; Owner: postgres
+-- Name: users_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
 
-COPY public.likes (id, user_id, track_id)
-    FROM '/docker-entrypoint-initdb.d/csv/likes.csv'
-    DELIMITER ';' quote E'\b' CSV;
+CREATE SEQUENCE public.users_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
 
+ALTER TABLE public.users_id_seq OWNER TO postgres;
 
 --
--- Name: albums_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: users_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.albums_id_seq', 658, true);
+ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id;
 
 
 --
--- Name: artists_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: albums id; Type: DEFAULT; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.artists_id_seq', 338, true);
+ALTER TABLE ONLY public.albums ALTER COLUMN id SET DEFAULT nextval('public.albums_id_seq'::regclass);
 
 
 --
--- Name: friends_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: artists id; Type: DEFAULT; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.friends_id_seq', 1, false);
+ALTER TABLE ONLY public.artists ALTER COLUMN id SET DEFAULT nextval('public.artists_id_seq'::regclass);
 
 
 --
--- Name: genres_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: friends id; Type: DEFAULT; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.genres_id_seq', 63, true);
+ALTER TABLE ONLY public.friends ALTER COLUMN id SET DEFAULT nextval('public.friends_id_seq'::regclass);
 
 
 --
--- Name: playlist_tracks_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: genres id; Type: DEFAULT; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.playlist_tracks_id_seq', 1, false);
+ALTER TABLE ONLY public.genres ALTER COLUMN id SET DEFAULT nextval('public.genres_id_seq'::regclass);
 
 
 --
--- Name: playlists_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: playlist_tracks id; Type: DEFAULT; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.playlists_id_seq', 11, true);
+ALTER TABLE ONLY public.playlist_tracks ALTER COLUMN id SET DEFAULT nextval('public.playlist_tracks_id_seq'::regclass);
 
 
 --
--- Name: search_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: playlists id; Type: DEFAULT; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.search_id_seq', 1267, true);
+ALTER TABLE ONLY public.playlists ALTER COLUMN id SET DEFAULT nextval('public.playlists_id_seq'::regclass);
+
+
+--
+-- Name: tracks id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.tracks ALTER COLUMN id SET DEFAULT nextval('public.tracks_id_seq'::regclass);
+
+
+--
+-- Name: users id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass);
+
+
+--
+-- Name: likes id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.likes ALTER COLUMN id SET DEFAULT nextval('public.likes_id_seq'::regclass);
+
+
+--
+-- Name: recent_searches id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.recent_searches ALTER COLUMN id SET DEFAULT nextval('public.recent_searches_id_seq'::regclass);
+
+
+--
+-- Name: catalog_audit id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.catalog_audit ALTER COLUMN id SET DEFAULT nextval('public.catalog_audit_id_seq'::regclass);
+
+
+--
+-- Name: listens id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listens ALTER COLUMN id SET DEFAULT nextval('public.listens_id_seq'::regclass);
+
+
+--
+-- Name: listen_rejections id; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listen_rejections ALTER COLUMN id SET DEFAULT nextval('public.listen_rejections_id_seq'::regclass);
+
+
+--
+-- Data for Name: albums; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.albums (id, title, year, artist, artwork, track_count, artwork_color)
+    FROM '/docker-entrypoint-initdb.d/csv/albums.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+UPDATE public.albums SET release_date = make_date(GREATEST(year, 1), 1, 1);
+
+
+--
+-- Data for Name: artists; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.artists (id, name, avatar, video, avatar_color)
+    FROM '/docker-entrypoint-initdb.d/csv/artists.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: friends; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.friends (id, "user", friend)
+    FROM '/docker-entrypoint-initdb.d/csv/friends.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: genres; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.genres (id, name)
+    FROM '/docker-entrypoint-initdb.d/csv/genres.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: playlist_tracks; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.playlist_tracks (id, playlist, track)
+    FROM '/docker-entrypoint-initdb.d/csv/playlist_tracks.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: playlists; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.playlists (id, title, user_id)
+    FROM '/docker-entrypoint-initdb.d/csv/playlists.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: tracks; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.tracks (id, title, artist, album, explicit, genre, number, file, listen_count, duration, lossless)
+    FROM '/docker-entrypoint-initdb.d/csv/tracks.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: users; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.users (id, email, password, salt, avatar, nickname)
+    FROM '/docker-entrypoint-initdb.d/csv/users.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+INSERT INTO public.users (id, email, password, salt, nickname)
+VALUES (0, 'system@lostpointer.site', '', '', 'LostPointer');
+
+
+--
+-- Data for Name: likes; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.likes (id, user_id, track_id)
+    FROM '/docker-entrypoint-initdb.d/csv/likes.csv'
+    DELIMITER ';' quote E'\b' CSV;
+
+
+--
+-- Data for Name: track_search; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+SELECT public.rebuild_track_search();
+
+
+--
+-- Name: albums_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.albums_id_seq', 658, true);
+
+
+--
+-- Name: artists_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.artists_id_seq', 338, true);
+
+
+--
+-- Name: friends_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.friends_id_seq', 1, false);
+
+
+--
+-- Name: genres_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.genres_id_seq', 63, true);
+
+
+--
+-- Name: playlist_tracks_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.playlist_tracks_id_seq', 1, false);
 
 
 --
--- Name: test_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+-- Name: playlists_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
 --
 
-SELECT pg_catalog.setval('public.test_id_seq', 1267, true);
+SELECT pg_catalog.setval('public.playlists_id_seq', 11, true);
 
 
 --
@@ -693,6 +1058,34 @@ SELECT pg_catalog.setval('public.users_id_seq', 271, true);
 SELECT pg_catalog.setval('public.likes_id_seq', 1, false);
 
 
+--
+-- Name: recent_searches_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.recent_searches_id_seq', 1, false);
+
+
+--
+-- Name: catalog_audit_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.catalog_audit_id_seq', 1, false);
+
+
+--
+-- Name: listens_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.listens_id_seq', 1, false);
+
+
+--
+-- Name: listen_rejections_id_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.listen_rejections_id_seq', 1, false);
+
+
 --
 -- Name: albums albums_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -750,19 +1143,11 @@ ALTER TABLE ONLY public.playlists
 
 
 --
--- Name: search search_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.search
-    ADD CONSTRAINT search_pk PRIMARY KEY (id);
-
-
---
--- Name: test test_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
+-- Name: track_search track_search_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.test
-    ADD CONSTRAINT test_pk PRIMARY KEY (id);
+ALTER TABLE ONLY public.track_search
+    ADD CONSTRAINT track_search_pkey PRIMARY KEY (track_id);
 
 
 --
@@ -846,151 +1231,631 @@ ALTER TABLE ONLY public.likes
 
 
 --
--- Name: concatenation_idx; Type: INDEX; Schema: public; Owner: postgres
+-- Name: track_plays_daily track_plays_daily_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-CREATE INDEX concatenation_idx ON public.search USING gin (concatenation);
+ALTER TABLE ONLY public.track_plays_daily
+    ADD CONSTRAINT track_plays_daily_pkey PRIMARY KEY (track_id, day);
 
 
 --
--- Name: test_trgm_idx; Type: INDEX; Schema: public; Owner: postgres
+-- Name: charts charts_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-CREATE INDEX test_trgm_idx ON public.test USING gin (concat public.gin_trgm_ops);
+ALTER TABLE ONLY public.charts
+    ADD CONSTRAINT charts_pkey PRIMARY KEY (period, entity, genre, period_start, "position");
 
 
 --
--- Name: trgm_idx_artist_name; Type: INDEX; Schema: public; Owner: postgres
+-- Name: recent_searches recent_searches_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-CREATE INDEX trgm_idx_artist_name ON public.artists USING gin (name public.gin_trgm_ops);
+ALTER TABLE ONLY public.recent_searches
+    ADD CONSTRAINT recent_searches_pkey PRIMARY KEY (id);
 
 
 --
--- Name: trgm_idx_tracks_title; Type: INDEX; Schema: public; Owner: postgres
+-- Name: catalog_audit catalog_audit_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-CREATE INDEX trgm_idx_tracks_title ON public.tracks USING gin (title public.gin_trgm_ops);
+ALTER TABLE ONLY public.catalog_audit
+    ADD CONSTRAINT catalog_audit_pkey PRIMARY KEY (id);
 
 
 --
--- Name: albums artist_id; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: lyrics lyrics_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.albums
-    ADD CONSTRAINT artist_id FOREIGN KEY (artist) REFERENCES public.artists(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.lyrics
+    ADD CONSTRAINT lyrics_pkey PRIMARY KEY (track_id);
 
 
 --
--- Name: friends friend; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: saved_albums saved_albums_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.friends
-    ADD CONSTRAINT friend FOREIGN KEY (friend) REFERENCES public.users(id);
+ALTER TABLE ONLY public.saved_albums
+    ADD CONSTRAINT saved_albums_pkey PRIMARY KEY (user_id, album_id);
 
 
 --
--- Name: playlist_tracks playlist; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: artist_follows artist_follows_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.playlist_tracks
-    ADD CONSTRAINT playlist FOREIGN KEY (playlist) REFERENCES public.playlists(id) NOT VALID;
+ALTER TABLE ONLY public.artist_follows
+    ADD CONSTRAINT artist_follows_pkey PRIMARY KEY (user_id, artist_id);
 
 
 --
--- Name: playlists playlist_user; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: dislikes dislikes_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.playlists
-    ADD CONSTRAINT playlist_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.dislikes
+    ADD CONSTRAINT dislikes_pkey PRIMARY KEY (user_id, track_id);
 
 
 --
--- Name: search search_artist; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: listens listens_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.search
-    ADD CONSTRAINT search_artist FOREIGN KEY (artist) REFERENCES public.artists(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.listens
+    ADD CONSTRAINT listens_pkey PRIMARY KEY (id);
 
 
 --
--- Name: search search_track; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: listen_rejections listen_rejections_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.search
-    ADD CONSTRAINT search_track FOREIGN KEY (track) REFERENCES public.tracks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.listen_rejections
+    ADD CONSTRAINT listen_rejections_pkey PRIMARY KEY (id);
 
 
 --
--- Name: test test_artist; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: play_queues play_queues_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.test
-    ADD CONSTRAINT test_artist FOREIGN KEY (artist) REFERENCES public.artists(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.play_queues
+    ADD CONSTRAINT play_queues_pkey PRIMARY KEY (user_id);
 
 
 --
--- Name: test test_track; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: release_radar_playlists release_radar_playlists_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.test
-    ADD CONSTRAINT test_track FOREIGN KEY (track) REFERENCES public.tracks(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.release_radar_playlists
+    ADD CONSTRAINT release_radar_playlists_pkey PRIMARY KEY (user_id);
 
 
 --
--- Name: playlist_tracks track; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: listening_recaps listening_recaps_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.playlist_tracks
-    ADD CONSTRAINT track FOREIGN KEY (track) REFERENCES public.tracks(id) NOT VALID;
+ALTER TABLE ONLY public.listening_recaps
+    ADD CONSTRAINT listening_recaps_pkey PRIMARY KEY (user_id, year);
 
 
 --
--- Name: tracks tracks_album; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: listening_recap_tops listening_recap_tops_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.tracks
-    ADD CONSTRAINT tracks_album FOREIGN KEY (album) REFERENCES public.albums(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.listening_recap_tops
+    ADD CONSTRAINT listening_recap_tops_pkey PRIMARY KEY (user_id, year, entity, "position");
 
 
 --
--- Name: tracks tracks_artist; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: recap_playlists recap_playlists_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.tracks
-    ADD CONSTRAINT tracks_artist FOREIGN KEY (artist) REFERENCES public.artists(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.recap_playlists
+    ADD CONSTRAINT recap_playlists_pkey PRIMARY KEY (user_id, year);
 
 
 --
--- Name: tracks tracks_genre; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: albums_title_document_idx; Type: INDEX; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.tracks
-    ADD CONSTRAINT tracks_genre FOREIGN KEY (genre) REFERENCES public.genres(id) NOT VALID;
+CREATE INDEX albums_title_document_idx ON public.albums USING gin (to_tsvector('simple'::regconfig, (title)::text));
 
 
 --
--- Name: friends user; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: albums_title_lower_trgm_idx; Type: INDEX; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.friends
-    ADD CONSTRAINT "user" FOREIGN KEY ("user") REFERENCES public.users(id);
+CREATE INDEX albums_title_lower_trgm_idx ON public.albums USING gin (lower((title)::text) public.gin_trgm_ops);
 
 
 --
--- Name: likes user_id; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: albums_title_prefix_idx; Type: INDEX; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.likes
-    ADD CONSTRAINT likes_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+CREATE INDEX albums_title_prefix_idx ON public.albums USING btree (lower((title)::text) text_pattern_ops);
 
 
 --
--- Name: playlists playlist_user; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+-- Name: artists_name_document_idx; Type: INDEX; Schema: public; Owner: postgres
 --
 
-ALTER TABLE ONLY public.likes
-    ADD CONSTRAINT likes_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+CREATE INDEX artists_name_document_idx ON public.artists USING gin (to_tsvector('simple'::regconfig, (name)::text));
+
+
+--
+-- Name: artists_name_lower_trgm_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX artists_name_lower_trgm_idx ON public.artists USING gin (lower((name)::text) public.gin_trgm_ops);
+
+
+--
+-- Name: artists_name_prefix_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX artists_name_prefix_idx ON public.artists USING btree (lower((name)::text) text_pattern_ops);
+
+
+--
+-- Name: catalog_audit_entity_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX catalog_audit_entity_idx ON public.catalog_audit USING btree (entity, entity_id, created_at DESC);
+
+
+--
+-- Name: likes_user_id_created_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX likes_user_id_created_at_idx ON public.likes USING btree (user_id, created_at DESC, id DESC);
+
+
+--
+-- Name: lyrics_plain_document_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX lyrics_plain_document_idx ON public.lyrics USING gin (to_tsvector('simple'::regconfig, plain));
+
+
+--
+-- Name: saved_albums_user_id_created_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX saved_albums_user_id_created_at_idx ON public.saved_albums USING btree (user_id, created_at DESC, album_id DESC);
+
+
+--
+-- Name: artist_follows_user_id_created_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX artist_follows_user_id_created_at_idx ON public.artist_follows USING btree (user_id, created_at DESC, artist_id DESC);
+
+
+--
+-- Name: artist_follows_artist_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX artist_follows_artist_id_idx ON public.artist_follows USING btree (artist_id);
+
+
+--
+-- Name: listens_user_id_track_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX listens_user_id_track_id_idx ON public.listens USING btree (user_id, track_id);
+
+
+--
+-- Name: listens_user_id_listened_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX listens_user_id_listened_at_idx ON public.listens USING btree (user_id, listened_at DESC);
+
+
+--
+-- Name: albums_artist_release_date_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX albums_artist_release_date_idx ON public.albums USING btree (artist, release_date DESC);
+
+
+--
+-- Name: release_radar_playlists_playlist_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX release_radar_playlists_playlist_id_idx ON public.release_radar_playlists USING btree (playlist_id);
+
+
+--
+-- Name: listening_recaps_year_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX listening_recaps_year_idx ON public.listening_recaps USING btree (year);
+
+
+--
+-- Name: listening_recap_tops_year_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX listening_recap_tops_year_idx ON public.listening_recap_tops USING btree (year);
+
+
+--
+-- Name: recap_playlists_playlist_id_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX recap_playlists_playlist_id_idx ON public.recap_playlists USING btree (playlist_id);
+
+
+--
+-- Name: listens_listened_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX listens_listened_at_idx ON public.listens USING btree (listened_at);
+
+
+--
+-- Name: listen_rejections_rejected_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX listen_rejections_rejected_at_idx ON public.listen_rejections USING btree (rejected_at);
+
+
+--
+-- Name: playlists_title_document_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX playlists_title_document_idx ON public.playlists USING gin (to_tsvector('simple'::regconfig, (title)::text));
+
+
+--
+-- Name: playlists_title_lower_trgm_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX playlists_title_lower_trgm_idx ON public.playlists USING gin (lower((title)::text) public.gin_trgm_ops);
+
+
+--
+-- Name: playlists_title_prefix_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX playlists_title_prefix_idx ON public.playlists USING btree (lower((title)::text) text_pattern_ops);
+
+
+--
+-- Name: recent_searches_user_id_created_at_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX recent_searches_user_id_created_at_idx ON public.recent_searches USING btree (user_id, created_at DESC, id DESC);
+
+
+--
+-- Name: recent_searches_user_id_query_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE UNIQUE INDEX recent_searches_user_id_query_idx ON public.recent_searches USING btree (user_id, lower((query)::text));
+
+
+--
+-- Name: track_search_document_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX track_search_document_idx ON public.track_search USING gin (document);
+
+
+--
+-- Name: track_search_text_trgm_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX track_search_text_trgm_idx ON public.track_search USING gin (text public.gin_trgm_ops);
+
+
+--
+-- Name: tracks_title_lower_trgm_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX tracks_title_lower_trgm_idx ON public.tracks USING gin (lower((title)::text) public.gin_trgm_ops);
+
+
+--
+-- Name: tracks_title_prefix_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX tracks_title_prefix_idx ON public.tracks USING btree (lower((title)::text) text_pattern_ops);
+
+
+--
+-- Name: trgm_idx_artist_name; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX trgm_idx_artist_name ON public.artists USING gin (name public.gin_trgm_ops);
+
+
+--
+-- Name: trgm_idx_tracks_title; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX trgm_idx_tracks_title ON public.tracks USING gin (title public.gin_trgm_ops);
+
+
+--
+-- Name: albums albums_track_search; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER albums_track_search AFTER UPDATE OF title ON public.albums FOR EACH ROW WHEN (((old.title)::text IS DISTINCT FROM (new.title)::text)) EXECUTE FUNCTION public.albums_track_search_trigger();
+
+
+--
+-- Name: artists artists_track_search; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER artists_track_search AFTER UPDATE OF name ON public.artists FOR EACH ROW WHEN (((old.name)::text IS DISTINCT FROM (new.name)::text)) EXECUTE FUNCTION public.artists_track_search_trigger();
+
+
+--
+-- Name: lyrics lyrics_has_lyrics; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER lyrics_has_lyrics AFTER INSERT OR DELETE ON public.lyrics FOR EACH ROW EXECUTE FUNCTION public.lyrics_has_lyrics_trigger();
+
+
+--
+-- Name: tracks tracks_track_search; Type: TRIGGER; Schema: public; Owner: postgres
+--
+
+CREATE TRIGGER tracks_track_search AFTER INSERT OR UPDATE OF title, artist, album ON public.tracks FOR EACH ROW EXECUTE FUNCTION public.tracks_track_search_trigger();
+
+
+--
+-- Name: albums artist_id; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.albums
+    ADD CONSTRAINT artist_id FOREIGN KEY (artist) REFERENCES public.artists(id) ON DELETE CASCADE;
+
+
+--
+-- Name: friends friend; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.friends
+    ADD CONSTRAINT friend FOREIGN KEY (friend) REFERENCES public.users(id);
+
+
+--
+-- Name: playlist_tracks playlist; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.playlist_tracks
+    ADD CONSTRAINT playlist FOREIGN KEY (playlist) REFERENCES public.playlists(id) NOT VALID;
+
+
+--
+-- Name: playlists playlist_user; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.playlists
+    ADD CONSTRAINT playlist_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: playlist_tracks track; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.playlist_tracks
+    ADD CONSTRAINT track FOREIGN KEY (track) REFERENCES public.tracks(id) ON DELETE CASCADE NOT VALID;
+
+
+--
+-- Name: tracks tracks_album; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.tracks
+    ADD CONSTRAINT tracks_album FOREIGN KEY (album) REFERENCES public.albums(id) ON DELETE CASCADE;
+
+
+--
+-- Name: tracks tracks_artist; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.tracks
+    ADD CONSTRAINT tracks_artist FOREIGN KEY (artist) REFERENCES public.artists(id) ON DELETE CASCADE;
+
+
+--
+-- Name: tracks tracks_genre; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.tracks
+    ADD CONSTRAINT tracks_genre FOREIGN KEY (genre) REFERENCES public.genres(id) NOT VALID;
+
+
+--
+-- Name: friends user; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.friends
+    ADD CONSTRAINT "user" FOREIGN KEY ("user") REFERENCES public.users(id);
+
+
+--
+-- Name: likes user_id; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.likes
+    ADD CONSTRAINT likes_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: playlists playlist_user; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.likes
+    ADD CONSTRAINT likes_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: track_plays_daily track_plays_daily_track_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.track_plays_daily
+    ADD CONSTRAINT track_plays_daily_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: recent_searches recent_searches_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.recent_searches
+    ADD CONSTRAINT recent_searches_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: track_search track_search_track_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.track_search
+    ADD CONSTRAINT track_search_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: catalog_audit catalog_audit_admin_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.catalog_audit
+    ADD CONSTRAINT catalog_audit_admin_id_fkey FOREIGN KEY (admin_id) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: lyrics lyrics_track_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.lyrics
+    ADD CONSTRAINT lyrics_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: saved_albums saved_albums_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saved_albums
+    ADD CONSTRAINT saved_albums_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: saved_albums saved_albums_album_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saved_albums
+    ADD CONSTRAINT saved_albums_album_id_fkey FOREIGN KEY (album_id) REFERENCES public.albums(id) ON DELETE CASCADE;
+
+
+--
+-- Name: artist_follows artist_follows_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.artist_follows
+    ADD CONSTRAINT artist_follows_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: artist_follows artist_follows_artist_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.artist_follows
+    ADD CONSTRAINT artist_follows_artist_id_fkey FOREIGN KEY (artist_id) REFERENCES public.artists(id) ON DELETE CASCADE;
+
+
+--
+-- Name: dislikes dislikes_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.dislikes
+    ADD CONSTRAINT dislikes_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: dislikes dislikes_track_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.dislikes
+    ADD CONSTRAINT dislikes_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: listens listens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listens
+    ADD CONSTRAINT listens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: listens listens_track_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listens
+    ADD CONSTRAINT listens_track_id_fkey FOREIGN KEY (track_id) REFERENCES public.tracks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: play_queues play_queues_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.play_queues
+    ADD CONSTRAINT play_queues_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: release_radar_playlists release_radar_playlists_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.release_radar_playlists
+    ADD CONSTRAINT release_radar_playlists_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: release_radar_playlists release_radar_playlists_playlist_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.release_radar_playlists
+    ADD CONSTRAINT release_radar_playlists_playlist_id_fkey FOREIGN KEY (playlist_id) REFERENCES public.playlists(id) ON DELETE CASCADE;
+
+
+--
+-- Name: listening_recaps listening_recaps_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listening_recaps
+    ADD CONSTRAINT listening_recaps_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: listening_recap_tops listening_recap_tops_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listening_recap_tops
+    ADD CONSTRAINT listening_recap_tops_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: recap_playlists recap_playlists_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.recap_playlists
+    ADD CONSTRAINT recap_playlists_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: recap_playlists recap_playlists_playlist_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.recap_playlists
+    ADD CONSTRAINT recap_playlists_playlist_id_fkey FOREIGN KEY (playlist_id) REFERENCES public.playlists(id) ON DELETE CASCADE;
+
+
+--
+-- Name: listen_rejections listen_rejections_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.listen_rejections
+    ADD CONSTRAINT listen_rejections_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
 --
@@ -0,0 +1,28 @@
+\c lostpointer
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.track_plays_daily (
+    track_id integer NOT NULL REFERENCES public.tracks(id) ON DELETE CASCADE,
+    day date NOT NULL,
+    plays bigint DEFAULT 0 NOT NULL,
+    CONSTRAINT track_plays_daily_pkey PRIMARY KEY (track_id, day)
+);
+
+ALTER TABLE public.track_plays_daily OWNER TO postgres;
+
+CREATE TABLE IF NOT EXISTS public.charts (
+    period character varying NOT NULL,
+    entity character varying NOT NULL,
+    genre integer DEFAULT 0 NOT NULL,
+    period_start date NOT NULL,
+    "position" integer NOT NULL,
+    entity_id integer NOT NULL,
+    plays bigint NOT NULL,
+    previous_position integer,
+    CONSTRAINT charts_pkey PRIMARY KEY (period, entity, genre, period_start, "position")
+);
+
+ALTER TABLE public.charts OWNER TO postgres;
+
+COMMIT;
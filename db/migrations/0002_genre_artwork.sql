@@ -0,0 +1,9 @@
+\c lostpointer
+
+BEGIN;
+
+ALTER TABLE public.genres
+    ADD COLUMN IF NOT EXISTS artwork character varying DEFAULT 'default_genre_artwork'::character varying NOT NULL,
+    ADD COLUMN IF NOT EXISTS artwork_color character varying DEFAULT '#8071c2'::character varying NOT NULL;
+
+COMMIT;
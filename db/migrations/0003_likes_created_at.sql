@@ -0,0 +1,19 @@
+\c lostpointer
+
+BEGIN;
+
+ALTER TABLE public.likes
+    ADD COLUMN IF NOT EXISTS created_at timestamp with time zone;
+
+-- Настоящее время лайка неизвестно, сохраняем порядок добавления по id
+UPDATE public.likes
+SET created_at = now() - ((SELECT max(id) FROM public.likes) - id) * interval '1 second'
+WHERE created_at IS NULL;
+
+ALTER TABLE public.likes
+    ALTER COLUMN created_at SET DEFAULT now(),
+    ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS likes_user_id_created_at_idx ON public.likes USING btree (user_id, created_at DESC, id DESC);
+
+COMMIT;
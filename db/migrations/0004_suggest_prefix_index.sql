@@ -0,0 +1,15 @@
+\c lostpointer
+
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS tracks_title_prefix_idx ON public.tracks USING btree (lower((title)::text) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS artists_name_prefix_idx ON public.artists USING btree (lower((name)::text) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS albums_title_prefix_idx ON public.albums USING btree (lower((title)::text) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS playlists_title_prefix_idx ON public.playlists USING btree (lower((title)::text) text_pattern_ops);
+
+CREATE INDEX IF NOT EXISTS tracks_title_lower_trgm_idx ON public.tracks USING gin (lower((title)::text) public.gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS artists_name_lower_trgm_idx ON public.artists USING gin (lower((name)::text) public.gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS albums_title_lower_trgm_idx ON public.albums USING gin (lower((title)::text) public.gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS playlists_title_lower_trgm_idx ON public.playlists USING gin (lower((title)::text) public.gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,23 @@
+\c lostpointer
+
+BEGIN;
+
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS save_search_history boolean DEFAULT true NOT NULL;
+
+CREATE TABLE IF NOT EXISTS public.recent_searches (
+    id serial NOT NULL,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    query character varying NOT NULL,
+    result_type character varying,
+    result_id integer,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT recent_searches_pkey PRIMARY KEY (id)
+);
+
+ALTER TABLE public.recent_searches OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS recent_searches_user_id_created_at_idx ON public.recent_searches USING btree (user_id, created_at DESC, id DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS recent_searches_user_id_query_idx ON public.recent_searches USING btree (user_id, lower((query)::text));
+
+COMMIT;
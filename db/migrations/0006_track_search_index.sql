@@ -0,0 +1,94 @@
+\c lostpointer
+
+BEGIN;
+
+DROP TABLE IF EXISTS public.search;
+DROP TABLE IF EXISTS public.test;
+
+CREATE TABLE IF NOT EXISTS public.track_search (
+    track_id integer NOT NULL REFERENCES public.tracks(id) ON DELETE CASCADE,
+    document tsvector NOT NULL,
+    text character varying NOT NULL,
+    CONSTRAINT track_search_pkey PRIMARY KEY (track_id)
+);
+
+ALTER TABLE public.track_search OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS track_search_document_idx ON public.track_search USING gin (document);
+CREATE INDEX IF NOT EXISTS track_search_text_trgm_idx ON public.track_search USING gin (text public.gin_trgm_ops);
+
+CREATE OR REPLACE FUNCTION public.refresh_track_search(track_ids integer[]) RETURNS void
+    LANGUAGE sql
+    AS $$
+INSERT INTO public.track_search (track_id, document, text)
+SELECT t.id,
+       setweight(to_tsvector('simple', t.title), 'A') || setweight(to_tsvector('simple', art.name), 'B') ||
+       setweight(to_tsvector('simple', alb.title), 'C'),
+       concat_ws(' ', t.title, art.name, alb.title)
+FROM public.tracks t
+JOIN public.artists art ON art.id = t.artist
+JOIN public.albums alb ON alb.id = t.album
+WHERE track_ids IS NULL OR t.id = ANY (track_ids)
+ON CONFLICT (track_id) DO UPDATE SET document = EXCLUDED.document, text = EXCLUDED.text;
+$$;
+
+ALTER FUNCTION public.refresh_track_search(track_ids integer[]) OWNER TO postgres;
+
+CREATE OR REPLACE FUNCTION public.rebuild_track_search() RETURNS bigint
+    LANGUAGE sql
+    AS $$
+TRUNCATE public.track_search;
+SELECT public.refresh_track_search(NULL);
+SELECT count(*) FROM public.track_search;
+$$;
+
+ALTER FUNCTION public.rebuild_track_search() OWNER TO postgres;
+
+CREATE OR REPLACE FUNCTION public.albums_track_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM public.refresh_track_search(ARRAY(SELECT id FROM public.tracks WHERE album = NEW.id));
+    RETURN NULL;
+END;
+$$;
+
+ALTER FUNCTION public.albums_track_search_trigger() OWNER TO postgres;
+
+CREATE OR REPLACE FUNCTION public.artists_track_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM public.refresh_track_search(ARRAY(SELECT id FROM public.tracks WHERE artist = NEW.id));
+    RETURN NULL;
+END;
+$$;
+
+ALTER FUNCTION public.artists_track_search_trigger() OWNER TO postgres;
+
+CREATE OR REPLACE FUNCTION public.tracks_track_search_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM public.refresh_track_search(ARRAY[NEW.id]);
+    RETURN NULL;
+END;
+$$;
+
+ALTER FUNCTION public.tracks_track_search_trigger() OWNER TO postgres;
+
+DROP TRIGGER IF EXISTS albums_track_search ON public.albums;
+CREATE TRIGGER albums_track_search AFTER UPDATE OF title ON public.albums FOR EACH ROW
+    WHEN (((old.title)::text IS DISTINCT FROM (new.title)::text)) EXECUTE FUNCTION public.albums_track_search_trigger();
+
+DROP TRIGGER IF EXISTS artists_track_search ON public.artists;
+CREATE TRIGGER artists_track_search AFTER UPDATE OF name ON public.artists FOR EACH ROW
+    WHEN (((old.name)::text IS DISTINCT FROM (new.name)::text)) EXECUTE FUNCTION public.artists_track_search_trigger();
+
+DROP TRIGGER IF EXISTS tracks_track_search ON public.tracks;
+CREATE TRIGGER tracks_track_search AFTER INSERT OR UPDATE OF title, artist, album ON public.tracks FOR EACH ROW
+    EXECUTE FUNCTION public.tracks_track_search_trigger();
+
+SELECT public.rebuild_track_search();
+
+COMMIT;
@@ -0,0 +1,32 @@
+\c lostpointer
+
+BEGIN;
+
+ALTER TABLE public.users
+    ADD COLUMN IF NOT EXISTS is_admin boolean DEFAULT false NOT NULL;
+
+CREATE TABLE IF NOT EXISTS public.catalog_audit (
+    id serial NOT NULL,
+    admin_id integer REFERENCES public.users(id) ON DELETE SET NULL,
+    action character varying NOT NULL,
+    entity character varying NOT NULL,
+    entity_id integer NOT NULL,
+    data jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT catalog_audit_pkey PRIMARY KEY (id)
+);
+
+ALTER TABLE public.catalog_audit OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS catalog_audit_entity_idx ON public.catalog_audit USING btree (entity, entity_id, created_at DESC);
+
+-- Снятие трека с публикации убирает его из плейлистов, удаление жанра оставляет треки без жанра
+ALTER TABLE public.playlist_tracks DROP CONSTRAINT IF EXISTS track;
+ALTER TABLE public.playlist_tracks
+    ADD CONSTRAINT track FOREIGN KEY (track) REFERENCES public.tracks(id) ON DELETE CASCADE NOT VALID;
+
+ALTER TABLE public.tracks DROP CONSTRAINT IF EXISTS tracks_genre;
+ALTER TABLE public.tracks
+    ADD CONSTRAINT tracks_genre FOREIGN KEY (genre) REFERENCES public.genres(id) ON DELETE SET NULL NOT VALID;
+
+COMMIT;
@@ -0,0 +1,42 @@
+\c lostpointer
+
+BEGIN;
+
+ALTER TABLE public.tracks
+    ADD COLUMN IF NOT EXISTS has_lyrics boolean DEFAULT false NOT NULL;
+
+CREATE TABLE IF NOT EXISTS public.lyrics (
+    track_id integer NOT NULL REFERENCES public.tracks(id) ON DELETE CASCADE,
+    plain text NOT NULL,
+    lines jsonb,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT lyrics_pkey PRIMARY KEY (track_id)
+);
+
+ALTER TABLE public.lyrics OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS lyrics_plain_document_idx ON public.lyrics USING gin (to_tsvector('simple'::regconfig, plain));
+
+-- Флаг has_lyrics хранится в tracks, чтобы не проверять наличие текста в каждом запросе списка треков
+CREATE OR REPLACE FUNCTION public.lyrics_has_lyrics_trigger() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE public.tracks SET has_lyrics = false WHERE id = OLD.track_id;
+    ELSE
+        UPDATE public.tracks SET has_lyrics = true WHERE id = NEW.track_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+ALTER FUNCTION public.lyrics_has_lyrics_trigger() OWNER TO postgres;
+
+DROP TRIGGER IF EXISTS lyrics_has_lyrics ON public.lyrics;
+CREATE TRIGGER lyrics_has_lyrics AFTER INSERT OR DELETE ON public.lyrics FOR EACH ROW
+    EXECUTE FUNCTION public.lyrics_has_lyrics_trigger();
+
+UPDATE public.tracks t SET has_lyrics = EXISTS(SELECT 1 FROM public.lyrics l WHERE l.track_id = t.id);
+
+COMMIT;
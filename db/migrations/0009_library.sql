@@ -0,0 +1,28 @@
+\c lostpointer
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.saved_albums (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    album_id integer NOT NULL REFERENCES public.albums(id) ON DELETE CASCADE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT saved_albums_pkey PRIMARY KEY (user_id, album_id)
+);
+
+ALTER TABLE public.saved_albums OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS saved_albums_user_id_created_at_idx ON public.saved_albums USING btree (user_id, created_at DESC, album_id DESC);
+
+CREATE TABLE IF NOT EXISTS public.artist_follows (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    artist_id integer NOT NULL REFERENCES public.artists(id) ON DELETE CASCADE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT artist_follows_pkey PRIMARY KEY (user_id, artist_id)
+);
+
+ALTER TABLE public.artist_follows OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS artist_follows_user_id_created_at_idx ON public.artist_follows USING btree (user_id, created_at DESC, artist_id DESC);
+CREATE INDEX IF NOT EXISTS artist_follows_artist_id_idx ON public.artist_follows USING btree (artist_id);
+
+COMMIT;
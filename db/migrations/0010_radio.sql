@@ -0,0 +1,28 @@
+\c lostpointer
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.dislikes (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    track_id integer NOT NULL REFERENCES public.tracks(id) ON DELETE CASCADE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT dislikes_pkey PRIMARY KEY (user_id, track_id)
+);
+
+ALTER TABLE public.dislikes OWNER TO postgres;
+
+-- История прослушиваний пользователя, пишется при стриминге трека
+CREATE TABLE IF NOT EXISTS public.listens (
+    id bigserial NOT NULL,
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    track_id integer NOT NULL REFERENCES public.tracks(id) ON DELETE CASCADE,
+    listened_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT listens_pkey PRIMARY KEY (id)
+);
+
+ALTER TABLE public.listens OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS listens_user_id_track_id_idx ON public.listens USING btree (user_id, track_id);
+CREATE INDEX IF NOT EXISTS listens_user_id_listened_at_idx ON public.listens USING btree (user_id, listened_at DESC);
+
+COMMIT;
@@ -0,0 +1,23 @@
+\c lostpointer
+
+BEGIN;
+
+-- Очередь воспроизведения пользователя, общая для всех его устройств
+CREATE TABLE IF NOT EXISTS public.play_queues (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    track_ids integer[] DEFAULT '{}'::integer[] NOT NULL,
+    current_index integer DEFAULT 0 NOT NULL,
+    position_ms bigint DEFAULT 0 NOT NULL,
+    shuffle boolean DEFAULT false NOT NULL,
+    repeat_mode character varying DEFAULT 'off'::character varying NOT NULL,
+    context_type character varying DEFAULT ''::character varying NOT NULL,
+    context_id integer DEFAULT 0 NOT NULL,
+    device_id character varying DEFAULT ''::character varying NOT NULL,
+    version bigint DEFAULT 0 NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT play_queues_pkey PRIMARY KEY (user_id)
+);
+
+ALTER TABLE public.play_queues OWNER TO postgres;
+
+COMMIT;
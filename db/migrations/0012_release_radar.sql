@@ -0,0 +1,30 @@
+\c lostpointer
+
+BEGIN;
+
+-- Дата выпуска с точностью до дня. Для уже загруженных альбомов известен только год
+ALTER TABLE public.albums ADD COLUMN IF NOT EXISTS release_date date;
+UPDATE public.albums SET release_date = make_date(GREATEST(year, 1), 1, 1) WHERE release_date IS NULL;
+ALTER TABLE public.albums ALTER COLUMN release_date SET DEFAULT CURRENT_DATE;
+ALTER TABLE public.albums ALTER COLUMN release_date SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS albums_artist_release_date_idx ON public.albums USING btree (artist, release_date DESC);
+
+-- Системный пользователь, владелец сгенерированных плейлистов. Пароль пустой, поэтому войти под ним нельзя
+INSERT INTO public.users (id, email, password, salt, nickname)
+VALUES (0, 'system@lostpointer.site', '', '', 'LostPointer')
+ON CONFLICT (id) DO NOTHING;
+
+-- Еженедельный плейлист новинок, собранный для пользователя
+CREATE TABLE IF NOT EXISTS public.release_radar_playlists (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    playlist_id integer NOT NULL REFERENCES public.playlists(id) ON DELETE CASCADE,
+    week_start date NOT NULL,
+    CONSTRAINT release_radar_playlists_pkey PRIMARY KEY (user_id)
+);
+
+ALTER TABLE public.release_radar_playlists OWNER TO postgres;
+
+CREATE UNIQUE INDEX IF NOT EXISTS release_radar_playlists_playlist_id_idx ON public.release_radar_playlists USING btree (playlist_id);
+
+COMMIT;
@@ -0,0 +1,40 @@
+\c lostpointer
+
+BEGIN;
+
+-- Итоги года пользователя, пересчитываются фоновой задачей по таблице listens
+CREATE TABLE IF NOT EXISTS public.listening_recaps (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    year integer NOT NULL,
+    minutes_listened bigint NOT NULL,
+    listens bigint NOT NULL,
+    longest_streak integer NOT NULL,
+    longest_streak_start date NOT NULL,
+    most_played_day date NOT NULL,
+    most_played_day_listens bigint NOT NULL,
+    built_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT listening_recaps_pkey PRIMARY KEY (user_id, year)
+);
+
+ALTER TABLE public.listening_recaps OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS listening_recaps_year_idx ON public.listening_recaps USING btree (year);
+
+-- Топы итогов года: entity - tracks, albums, artists или genres
+CREATE TABLE IF NOT EXISTS public.listening_recap_tops (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    year integer NOT NULL,
+    entity character varying NOT NULL,
+    "position" integer NOT NULL,
+    entity_id integer NOT NULL,
+    plays bigint NOT NULL,
+    CONSTRAINT listening_recap_tops_pkey PRIMARY KEY (user_id, year, entity, "position")
+);
+
+ALTER TABLE public.listening_recap_tops OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS listening_recap_tops_year_idx ON public.listening_recap_tops USING btree (year);
+
+CREATE INDEX IF NOT EXISTS listens_listened_at_idx ON public.listens USING btree (listened_at);
+
+COMMIT;
@@ -0,0 +1,21 @@
+\c lostpointer
+
+BEGIN;
+
+-- Прослушивания, которые не были засчитаны: превышение лимитов или несуществующий трек.
+-- По этой таблице строится отчёт о подозрительных аккаунтах для модераторов
+CREATE TABLE IF NOT EXISTS public.listen_rejections (
+    id bigserial NOT NULL,
+    user_id integer REFERENCES public.users(id) ON DELETE CASCADE,
+    ip character varying NOT NULL,
+    track_id bigint NOT NULL,
+    reason character varying NOT NULL,
+    rejected_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT listen_rejections_pkey PRIMARY KEY (id)
+);
+
+ALTER TABLE public.listen_rejections OWNER TO postgres;
+
+CREATE INDEX IF NOT EXISTS listen_rejections_rejected_at_idx ON public.listen_rejections USING btree (rejected_at);
+
+COMMIT;
@@ -0,0 +1,19 @@
+\c lostpointer
+
+BEGIN;
+
+-- Списки треков рассчитывают на номер и жанр у каждого трека, поэтому пропуски заполняются,
+-- а жанр с треками удалить нельзя
+UPDATE public.tracks SET number = 0 WHERE number IS NULL;
+
+INSERT INTO public.genres (name)
+SELECT 'Unknown' WHERE EXISTS(SELECT 1 FROM public.tracks WHERE genre IS NULL)
+ON CONFLICT (name) DO NOTHING;
+UPDATE public.tracks SET genre = (SELECT id FROM public.genres WHERE name = 'Unknown') WHERE genre IS NULL;
+ALTER TABLE public.tracks ALTER COLUMN genre SET NOT NULL, ALTER COLUMN number SET NOT NULL;
+
+ALTER TABLE public.tracks DROP CONSTRAINT IF EXISTS tracks_genre;
+ALTER TABLE public.tracks
+    ADD CONSTRAINT tracks_genre FOREIGN KEY (genre) REFERENCES public.genres(id) NOT VALID;
+
+COMMIT;
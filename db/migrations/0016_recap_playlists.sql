@@ -0,0 +1,18 @@
+\c lostpointer
+
+BEGIN;
+
+-- Плейлист из итогов года создаётся один раз на пользователя и год. Итоги пересчитываются с удалением строк,
+-- поэтому связь хранится отдельно от listening_recaps
+CREATE TABLE IF NOT EXISTS public.recap_playlists (
+    user_id integer NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    year integer NOT NULL,
+    playlist_id integer NOT NULL REFERENCES public.playlists(id) ON DELETE CASCADE,
+    CONSTRAINT recap_playlists_pkey PRIMARY KEY (user_id, year)
+);
+
+ALTER TABLE public.recap_playlists OWNER TO postgres;
+
+CREATE UNIQUE INDEX IF NOT EXISTS recap_playlists_playlist_id_idx ON public.recap_playlists USING btree (playlist_id);
+
+COMMIT;
@@ -0,0 +1,13 @@
+\c lostpointer
+
+BEGIN;
+
+-- Поиск отбирает кандидатов операторами @@ и <%, которые используют GIN-индексы.
+-- Порог <% задаётся настройкой базы, а не параметром запроса
+ALTER DATABASE lostpointer SET pg_trgm.word_similarity_threshold TO '0.3';
+
+CREATE INDEX IF NOT EXISTS artists_name_document_idx ON public.artists USING gin (to_tsvector('simple'::regconfig, (name)::text));
+CREATE INDEX IF NOT EXISTS albums_title_document_idx ON public.albums USING gin (to_tsvector('simple'::regconfig, (title)::text));
+CREATE INDEX IF NOT EXISTS playlists_title_document_idx ON public.playlists USING gin (to_tsvector('simple'::regconfig, (title)::text));
+
+COMMIT;
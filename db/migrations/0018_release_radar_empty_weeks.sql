@@ -0,0 +1,8 @@
+\c lostpointer
+
+BEGIN;
+
+-- Неделя без новинок отмечается строкой без плейлиста, чтобы пользователь не перебирался на каждом запуске
+ALTER TABLE public.release_radar_playlists ALTER COLUMN playlist_id DROP NOT NULL;
+
+COMMIT;
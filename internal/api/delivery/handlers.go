@@ -3,22 +3,35 @@ package delivery
 
 import (
 	"2021_2_LostPointer/internal/csrf"
+	"2021_2_LostPointer/pkg/audiotag"
 	"2021_2_LostPointer/pkg/image"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/mailru/easyjson"
+	uuid "github.com/satori/go.uuid"
 	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
+	"2021_2_LostPointer/internal/media"
 	authorization "2021_2_LostPointer/internal/microservices/authorization/proto"
+	catalog "2021_2_LostPointer/internal/microservices/catalog/proto"
 	music "2021_2_LostPointer/internal/microservices/music/proto"
+	player "2021_2_LostPointer/internal/microservices/player/proto"
 	playlists "2021_2_LostPointer/internal/microservices/playlists/proto"
 	profile "2021_2_LostPointer/internal/microservices/profile/proto"
 	"2021_2_LostPointer/internal/models"
@@ -32,10 +45,19 @@ type APIMicroservices struct {
 	profileMicroservice   profile.ProfileClient
 	musicMicroservice     music.MusicClient
 	playlistsMicroservice playlists.PlaylistsClient
+	catalogMicroservice   catalog.CatalogClient
+	playerMicroservice    player.PlayerClient
+
+	tracksStorage   http.FileSystem
+	artworksStorage http.FileSystem
+	mediaSigner     *media.URLSigner
+	eventsBroker    events.Broker
 }
 
 func NewAPIMicroservices(logger *zap.SugaredLogger, imageService image.ImagesService, auth authorization.AuthorizationClient,
-	profile profile.ProfileClient, music music.MusicClient, playlists playlists.PlaylistsClient) APIMicroservices {
+	profile profile.ProfileClient, music music.MusicClient, playlists playlists.PlaylistsClient, catalog catalog.CatalogClient,
+	player player.PlayerClient, tracks http.FileSystem, artworks http.FileSystem, signer *media.URLSigner,
+	broker events.Broker) APIMicroservices {
 	return APIMicroservices{
 		logger:                logger,
 		imageService:          imageService,
@@ -43,6 +65,12 @@ func NewAPIMicroservices(logger *zap.SugaredLogger, imageService image.ImagesSer
 		profileMicroservice:   profile,
 		musicMicroservice:     music,
 		playlistsMicroservice: playlists,
+		catalogMicroservice:   catalog,
+		playerMicroservice:    player,
+		tracksStorage:         tracks,
+		artworksStorage:       artworks,
+		mediaSigner:           signer,
+		eventsBroker:          broker,
 	}
 }
 
@@ -117,6 +145,48 @@ func (api *APIMicroservices) ParseErrorByCode(ctx echo.Context, requestID string
 				return ctx.NoContent(http.StatusInternalServerError)
 			}
 
+			return ctx.JSONBlob(http.StatusOK, jsonResponse)
+		}
+		if currentError.Code() == codes.ResourceExhausted {
+			api.logger.Info(
+				zap.String("ID", requestID),
+				zap.String("MESSAGE", currentError.Message()),
+				zap.Int("ANSWER STATUS", http.StatusTooManyRequests))
+
+			response := &models.Response{
+				Status:  http.StatusTooManyRequests,
+				Message: currentError.Message(),
+			}
+			jsonResponse, err := easyjson.Marshal(response)
+			if err != nil {
+				api.logger.Error(
+					zap.String("ID", requestID),
+					zap.String("ERROR", err.Error()),
+					zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+				return ctx.NoContent(http.StatusInternalServerError)
+			}
+
+			return ctx.JSONBlob(http.StatusOK, jsonResponse)
+		}
+		if currentError.Code() == codes.Aborted {
+			api.logger.Info(
+				zap.String("ID", requestID),
+				zap.String("MESSAGE", currentError.Message()),
+				zap.Int("ANSWER STATUS", http.StatusConflict))
+
+			response := &models.Response{
+				Status:  http.StatusConflict,
+				Message: currentError.Message(),
+			}
+			jsonResponse, err := easyjson.Marshal(response)
+			if err != nil {
+				api.logger.Error(
+					zap.String("ID", requestID),
+					zap.String("ERROR", err.Error()),
+					zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+				return ctx.NoContent(http.StatusInternalServerError)
+			}
+
 			return ctx.JSONBlob(http.StatusOK, jsonResponse)
 		}
 	}
@@ -484,6 +554,18 @@ func (api *APIMicroservices) UpdateSettings(ctx echo.Context) error {
 	} else {
 		newAvatarFilename = file.Filename
 	}
+	var saveSearchHistory *bool
+	if formSaveSearchHistory := ctx.FormValue("save_search_history"); len(formSaveSearchHistory) != 0 {
+		value, err := strconv.ParseBool(formSaveSearchHistory)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		saveSearchHistory = &value
+	}
 
 	if len(newAvatarFilename) != 0 {
 		var createdImageData *models.ImageData
@@ -512,16 +594,28 @@ func (api *APIMicroservices) UpdateSettings(ctx echo.Context) error {
 		NewPassword:    newPassword,
 		AvatarFilename: newAvatarFilename,
 		OldSettings: &profile.UserSettings{
-			Email:       oldSettings.Email,
-			Nickname:    oldSettings.Nickname,
-			SmallAvatar: oldSettings.SmallAvatar,
-			BigAvatar:   oldSettings.BigAvatar,
+			Email:             oldSettings.Email,
+			Nickname:          oldSettings.Nickname,
+			SmallAvatar:       oldSettings.SmallAvatar,
+			BigAvatar:         oldSettings.BigAvatar,
+			SaveSearchHistory: oldSettings.SaveSearchHistory,
 		},
+		SaveSearchHistory: saveSearchHistory,
 	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
 
+	// При отключении истории поиска удаляем уже сохранённые запросы
+	if saveSearchHistory != nil && !*saveSearchHistory && oldSettings.SaveSearchHistory {
+		_, err = api.musicMicroservice.ClearRecentSearches(context.Background(), &music.ClearRecentSearchesOptions{
+			UserID: int64(userID),
+		})
+		if err != nil {
+			return api.ParseErrorByCode(ctx, requestID, err)
+		}
+	}
+
 	oldAvatarFilename := oldSettings.BigAvatar[len(os.Getenv("USERS_ROOT_PREFIX")) : len(oldSettings.BigAvatar)-len(constants.UserAvatarExtension150px)]
 	err = api.imageService.DeleteImages(
 		os.Getenv("USERS_FULL_PREFIX"),
@@ -637,11 +731,41 @@ func (api *APIMicroservices) GetHomeTracks(ctx echo.Context) error {
 		isAuthorized = true
 	}
 
-	tracksListProto, err := api.musicMicroservice.RandomTracks(context.Background(),
-		&music.RandomTracksOptions{Amount: constants.HomePageTracksSelectionAmount, UserID: int64(userID), IsAuthorized: isAuthorized})
+	seed, generation, page, err := getSelection(ctx)
+	if err != nil {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.SelectionInvalidMessage),
+			zap.Int("ANSWER STATUS", http.StatusBadRequest))
+
+		response := &models.Response{
+			Status:  http.StatusBadRequest,
+			Message: constants.SelectionInvalidMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	tracksListProto, err := api.musicMicroservice.RandomTracks(context.Background(), &music.RandomTracksOptions{
+		Amount:       constants.HomePageTracksSelectionAmount,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Seed:         seed,
+		Generation:   generation,
+		Page:         page,
+	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	setSelectionHeaders(ctx, tracksListProto.Selection)
 
 	tracks := models.Tracks{}
 	for _, current := range tracksListProto.Tracks {
@@ -675,11 +799,54 @@ func (api *APIMicroservices) GetHomeAlbums(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+
+	seed, generation, page, err := getSelection(ctx)
+	if err != nil {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.SelectionInvalidMessage),
+			zap.Int("ANSWER STATUS", http.StatusBadRequest))
+
+		response := &models.Response{
+			Status:  http.StatusBadRequest,
+			Message: constants.SelectionInvalidMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
 
-	albumsListProto, err := api.musicMicroservice.RandomAlbums(context.Background(), &music.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount})
+	albumsListProto, err := api.musicMicroservice.RandomAlbums(context.Background(), &music.RandomAlbumsOptions{
+		Amount:       constants.HomePageAlbumsSelectionAmount,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Seed:         seed,
+		Generation:   generation,
+		Page:         page,
+	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	setSelectionHeaders(ctx, albumsListProto.Selection)
 
 	albums := models.Albums{}
 	for _, current := range albumsListProto.Albums {
@@ -713,11 +880,54 @@ func (api *APIMicroservices) GetHomeArtists(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+
+	seed, generation, page, err := getSelection(ctx)
+	if err != nil {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.SelectionInvalidMessage),
+			zap.Int("ANSWER STATUS", http.StatusBadRequest))
+
+		response := &models.Response{
+			Status:  http.StatusBadRequest,
+			Message: constants.SelectionInvalidMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
 
-	artistsListProto, err := api.musicMicroservice.RandomArtists(context.Background(), &music.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount})
+	artistsListProto, err := api.musicMicroservice.RandomArtists(context.Background(), &music.RandomArtistsOptions{
+		Amount:       constants.HomePageArtistsSelectionAmount,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Seed:         seed,
+		Generation:   generation,
+		Page:         page,
+	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	setSelectionHeaders(ctx, artistsListProto.Selection)
 
 	artists := models.Artists{}
 	for _, current := range artistsListProto.Artists {
@@ -800,6 +1010,8 @@ func (api *APIMicroservices) GetArtistProfile(ctx echo.Context) error {
 	return ctx.JSONBlob(http.StatusOK, jsonArtistData)
 }
 
+// Устаревший учёт прослушивания: прослушивание засчитывает StreamTrack, поэтому запрос
+// только подтверждается, чтобы старые клиенты не засчитывали трек дважды
 func (api *APIMicroservices) IncrementListenCount(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
@@ -809,25 +1021,9 @@ func (api *APIMicroservices) IncrementListenCount(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	var trackID models.TrackID
-	err := ctx.Bind(&trackID)
-	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError),
-		)
-		return ctx.NoContent(http.StatusInternalServerError)
-	}
-
-	_, err = api.musicMicroservice.IncrementListenCount(context.Background(), &music.IncrementListenCountOptions{ID: trackID.ID})
-	if err != nil {
-		return api.ParseErrorByCode(ctx, requestID, err)
-	}
-
 	response := &models.Response{
 		Status:  http.StatusOK,
-		Message: "Incremented track listen count",
+		Message: constants.ListenCountDeprecatedMessage,
 	}
 	jsonResponse, err := easyjson.Marshal(response)
 	if err != nil {
@@ -842,6 +1038,7 @@ func (api *APIMicroservices) IncrementListenCount(ctx echo.Context) error {
 		zap.String("ID", requestID),
 		zap.Int("ANSWER STATUS", http.StatusOK),
 	)
+	ctx.Response().Header().Set("Deprecation", "true")
 	return ctx.JSONBlob(http.StatusOK, jsonResponse)
 }
 
@@ -874,15 +1071,25 @@ func (api *APIMicroservices) GetAlbumPage(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
 
 	albumDataProto, err := api.musicMicroservice.AlbumPage(context.Background(), &music.AlbumPageOptions{
 		AlbumID:      int64(albumID),
 		UserID:       int64(userID),
 		IsAuthorized: isAuthorized,
+		Page:         page,
 	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	setPageHeaders(ctx, albumDataProto.Page)
 
 	var albumData models.AlbumPage
 	albumData.BindProto(albumDataProto)
@@ -925,11 +1132,35 @@ func (api *APIMicroservices) SearchMusic(ctx echo.Context) error {
 	}
 
 	text := ctx.FormValue("text")
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	filter, err := getSearchFilter(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
 
-	searchResultProto, err := api.musicMicroservice.Find(context.Background(), &music.FindOptions{Text: text, UserID: int64(userID), IsAuthorized: isAuthorized})
+	searchResultProto, err := api.musicMicroservice.Find(context.Background(), &music.FindOptions{
+		Text:         text,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Page:         page,
+		Filter:       filter,
+	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	setPageHeaders(ctx, searchResultProto.Page)
 
 	var searchResult models.SearchResult
 	searchResult.BindProto(searchResultProto)
@@ -950,8 +1181,8 @@ func (api *APIMicroservices) SearchMusic(ctx echo.Context) error {
 	return ctx.JSONBlob(http.StatusOK, jsonSearchResult)
 }
 
-//nolint:dupl,cyclop
-func (api *APIMicroservices) CreatePlaylist(ctx echo.Context) error {
+//nolint:dupl
+func (api *APIMicroservices) SearchTracks(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -967,69 +1198,826 @@ func (api *APIMicroservices) CreatePlaylist(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
-	if userID == -1 {
-		api.logger.Info(
-			zap.String("ID", requestID),
-			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
-			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
-
-		response := &models.Response{
-			Status:  http.StatusUnauthorized,
-			Message: constants.UserIsNotAuthorizedMessage,
-		}
-		jsonResponse, err := easyjson.Marshal(response)
-		if err != nil {
-			api.logger.Error(
-				zap.String("ID", requestID),
-				zap.String("ERROR", err.Error()),
-				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-			return ctx.NoContent(http.StatusInternalServerError)
-		}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
 
-		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	filter, err := getSearchFilter(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	options := &music.FindOptions{
+		Text:         ctx.FormValue("text"),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Page:         page,
+		Filter:       filter,
 	}
 
-	var artworkFilename, artworkColor string
-	title := ctx.FormValue("title")
-	isPublic, _ := strconv.ParseBool(ctx.FormValue("is_public"))
-	artwork, err := ctx.FormFile("artwork")
+	tracksProto, err := api.musicMicroservice.SearchTracks(context.Background(), options)
 	if err != nil {
-		artworkFilename = ""
-	} else {
-		artworkFilename = artwork.Filename
+		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	setPageHeaders(ctx, tracksProto.Page)
 
-	if len(artworkFilename) != 0 {
-		var createdImageData *models.ImageData
-		createdImageData, err = api.imageService.CreateImages(
-			artwork,
-			os.Getenv("PLAYLIST_FULL_PREFIX"),
-			map[int]string{
-				100: constants.PlaylistArtworkExtension100px,
-				384: constants.PlaylistArtworkExtension384px,
-			})
-		if err != nil {
-			api.logger.Error(
-				zap.String("ID", requestID),
-				zap.String("ERROR", err.Error()),
-				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-			return ctx.NoContent(http.StatusInternalServerError)
-		}
-		artworkFilename = createdImageData.Filename
-		artworkColor = createdImageData.ArtworkColor
+	tracks := models.Tracks{}
+	for _, current := range tracksProto.Tracks {
+		var track models.Track
+		track.BindProto(current)
+		tracks = append(tracks, track)
 	}
 
-	playlistIDProto, err := api.playlistsMicroservice.CreatePlaylist(context.Background(), &playlists.CreatePlaylistOptions{
-		UserID:       int64(userID),
-		Title:        title,
-		IsPublic:     isPublic,
-		Artwork:      artworkFilename,
-		ArtworkColor: artworkColor,
-	})
+	jsonTracks, err := easyjson.Marshal(tracks)
 	if err != nil {
-		deleteErr := api.imageService.DeleteImages(
-			os.Getenv("PLAYLIST_FULL_PREFIX"),
-			artworkFilename,
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonTracks)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) SearchAlbums(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	options := &music.FindOptions{
+		Text:         ctx.FormValue("text"),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Page:         page,
+	}
+
+	albumsProto, err := api.musicMicroservice.SearchAlbums(context.Background(), options)
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, albumsProto.Page)
+
+	albums := models.Albums{}
+	for _, current := range albumsProto.Albums {
+		var album models.Album
+		album.BindProto(current)
+		albums = append(albums, album)
+	}
+
+	jsonAlbums, err := easyjson.Marshal(albums)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonAlbums)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) SearchArtists(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	options := &music.FindOptions{
+		Text:         ctx.FormValue("text"),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Page:         page,
+	}
+
+	artistsProto, err := api.musicMicroservice.SearchArtists(context.Background(), options)
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, artistsProto.Page)
+
+	artists := models.Artists{}
+	for _, current := range artistsProto.Artists {
+		var artist models.Artist
+		artist.BindProto(current)
+		artists = append(artists, artist)
+	}
+
+	jsonArtists, err := easyjson.Marshal(artists)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonArtists)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) SearchPlaylists(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	options := &music.FindOptions{
+		Text:         ctx.FormValue("text"),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Page:         page,
+	}
+
+	playlistsProto, err := api.musicMicroservice.SearchPlaylists(context.Background(), options)
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, playlistsProto.Page)
+
+	var playlists models.UserPlaylists
+	playlists.BindProto(playlistsProto)
+
+	jsonPlaylists, err := easyjson.Marshal(playlists)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonPlaylists)
+}
+
+func (api *APIMicroservices) Suggest(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+	var limit int64
+	if queryLimit := ctx.QueryParam("limit"); len(queryLimit) != 0 {
+		var err error
+		limit, err = strconv.ParseInt(queryLimit, 10, 64)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	suggestionsProto, err := api.musicMicroservice.Suggest(context.Background(), &music.SuggestOptions{
+		Prefix:       ctx.QueryParam("prefix"),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Limit:        limit,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	suggestions := models.Suggestions{}
+	for _, current := range suggestionsProto.Suggestions {
+		var suggestion models.Suggestion
+		suggestion.BindProto(current)
+		suggestions = append(suggestions, suggestion)
+	}
+
+	jsonSuggestions, err := easyjson.Marshal(suggestions)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonSuggestions)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) GetRecentSearches(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var limit int64
+	if queryLimit := ctx.QueryParam("limit"); len(queryLimit) != 0 {
+		var err error
+		limit, err = strconv.ParseInt(queryLimit, 10, 64)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	searchesProto, err := api.musicMicroservice.GetRecentSearches(context.Background(), &music.RecentSearchesOptions{
+		UserID: int64(userID),
+		Limit:  limit,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	searches := models.RecentSearches{}
+	for _, current := range searchesProto.RecentSearches {
+		var search models.RecentSearch
+		search.BindProto(current)
+		searches = append(searches, search)
+	}
+
+	jsonSearches, err := easyjson.Marshal(searches)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonSearches)
+}
+
+func (api *APIMicroservices) RecordSearchResult(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	resultID, err := strconv.ParseInt(ctx.FormValue("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.RecordSearchResult(context.Background(), &music.RecordSearchResultOptions{
+		UserID:     int64(userID),
+		Query:      ctx.FormValue("query"),
+		ResultType: ctx.FormValue("type"),
+		ResultID:   resultID,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.SearchResultRecordedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+func (api *APIMicroservices) DeleteRecentSearch(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	searchID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.DeleteRecentSearch(context.Background(), &music.DeleteRecentSearchOptions{
+		UserID: int64(userID),
+		ID:     searchID,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.RecentSearchDeletedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+func (api *APIMicroservices) ClearRecentSearches(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	_, err := api.musicMicroservice.ClearRecentSearches(context.Background(), &music.ClearRecentSearchesOptions{
+		UserID: int64(userID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.RecentSearchesClearedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl,cyclop
+func (api *APIMicroservices) CreatePlaylist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var artworkFilename, artworkColor string
+	title := ctx.FormValue("title")
+	isPublic, _ := strconv.ParseBool(ctx.FormValue("is_public"))
+	artwork, err := ctx.FormFile("artwork")
+	if err != nil {
+		artworkFilename = ""
+	} else {
+		artworkFilename = artwork.Filename
+	}
+
+	if len(artworkFilename) != 0 {
+		var createdImageData *models.ImageData
+		createdImageData, err = api.imageService.CreateImages(
+			artwork,
+			os.Getenv("PLAYLIST_FULL_PREFIX"),
+			map[int]string{
+				100: constants.PlaylistArtworkExtension100px,
+				384: constants.PlaylistArtworkExtension384px,
+			})
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		artworkFilename = createdImageData.Filename
+		artworkColor = createdImageData.ArtworkColor
+	}
+
+	playlistIDProto, err := api.playlistsMicroservice.CreatePlaylist(context.Background(), &playlists.CreatePlaylistOptions{
+		UserID:       int64(userID),
+		Title:        title,
+		IsPublic:     isPublic,
+		Artwork:      artworkFilename,
+		ArtworkColor: artworkColor,
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("PLAYLIST_FULL_PREFIX"),
+			artworkFilename,
+			[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
+			constants.PlaylistArtworkDefaultFilename,
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var playlistID models.PlaylistID
+	playlistID.BindProto(playlistIDProto)
+
+	jsonPlaylistID, err := easyjson.Marshal(playlistID)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonPlaylistID)
+}
+
+//nolint:cyclop,dupl
+func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	playlistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	var artworkFilename, artworkColor string
+	var isPublic bool
+	title := ctx.FormValue("title")
+	artwork, err := ctx.FormFile("artwork")
+	isPublic, _ = strconv.ParseBool(ctx.FormValue("is_public"))
+	if err != nil {
+		artworkFilename = ""
+	} else {
+		artworkFilename = artwork.Filename
+	}
+	if len(artworkFilename) != 0 {
+		var createdImageData *models.ImageData
+		createdImageData, err = api.imageService.CreateImages(
+			artwork,
+			os.Getenv("PLAYLIST_FULL_PREFIX"),
+			map[int]string{
+				100: constants.PlaylistArtworkExtension100px,
+				384: constants.PlaylistArtworkExtension384px,
+			})
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		artworkFilename = createdImageData.Filename
+		artworkColor = createdImageData.ArtworkColor
+	}
+
+	artworkProto, err := api.playlistsMicroservice.UpdatePlaylist(context.Background(), &playlists.UpdatePlaylistOptions{
+		PlaylistID:   int64(playlistID),
+		Title:        title,
+		UserID:       int64(userID),
+		Artwork:      artworkFilename,
+		ArtworkColor: artworkColor,
+		IsPublic:     isPublic,
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("PLAYLIST_FULL_PREFIX"),
+			artworkFilename,
 			[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
 			constants.PlaylistArtworkDefaultFilename,
 		)
@@ -1042,11 +2030,3773 @@ func (api *APIMicroservices) CreatePlaylist(ctx echo.Context) error {
 		}
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("PLAYLIST_FULL_PREFIX"),
+		artworkProto.OldArtworkFilename,
+		[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
+		constants.PlaylistArtworkDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.PlaylistArtworkColor{ArtworkColor: artworkProto.ArtworkColor}
+	jsonPlaylistArtworkColor, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonPlaylistArtworkColor)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeletePlaylist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	playlistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldArtworkProto, err := api.playlistsMicroservice.DeletePlaylist(context.Background(), &playlists.DeletePlaylistOptions{
+		PlaylistID: int64(playlistID),
+		UserID:     int64(userID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("PLAYLIST_FULL_PREFIX"),
+		oldArtworkProto.OldArtworkFilename,
+		[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
+		constants.PlaylistArtworkDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.PlaylistDeletedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) AddTrack(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var requestData models.PlaylistTrack
+	if err := ctx.Bind(&requestData); err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err := api.playlistsMicroservice.AddTrack(context.Background(), &playlists.AddTrackOptions{
+		TrackID:    requestData.TrackID,
+		PlaylistID: requestData.PlaylistID,
+		UserID:     int64(userID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.TrackAddedToPlaylistMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeleteTrack(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var requestData models.PlaylistTrack
+	if err := ctx.Bind(&requestData); err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err := api.playlistsMicroservice.DeleteTrack(context.Background(), &playlists.DeleteTrackOptions{
+		PlaylistID: requestData.PlaylistID,
+		TrackID:    requestData.TrackID,
+		UserID:     int64(userID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.TrackDeletedFromPlaylistMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) GetUserPlaylists(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	playlistsProto, err := api.musicMicroservice.UserPlaylists(context.Background(), &music.UserPlaylistsOptions{
+		UserID: int64(userID),
+		Page:   page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, playlistsProto.Page)
+
+	var userPlaylists models.UserPlaylists
+	userPlaylists.BindProto(playlistsProto)
+
+	jsonUserPlaylists, err := easyjson.Marshal(userPlaylists)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonUserPlaylists)
+}
+
+func (api *APIMicroservices) GetPlaylistPage(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	playlistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	playlistPageDataProto, err := api.musicMicroservice.PlaylistPage(context.Background(), &music.PlaylistPageOptions{
+		PlaylistID: int64(playlistID),
+		UserID:     int64(userID),
+		Page:       page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, playlistPageDataProto.Page)
+
+	var playlistPage models.PlaylistPage
+	playlistPage.BindProto(playlistPageDataProto)
+
+	jsonPlaylistPage, err := easyjson.Marshal(playlistPage)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonPlaylistPage)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) AddTrackToFavorites(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	trackID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.AddTrackToFavorites(context.Background(), &music.AddTrackToFavoritesOptions{
+		UserID:  int64(userID),
+		TrackID: int64(trackID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.TrackAddedToFavoritesMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeleteTrackFromFavorites(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	trackID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.DeleteTrackFromFavorites(context.Background(), &music.DeleteTrackFromFavoritesOptions{
+		UserID:  int64(userID),
+		TrackID: int64(trackID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.TrackDeletedFromFavoritesMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DislikeTrack(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	trackID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.DislikeTrack(context.Background(), &music.DislikeTrackOptions{
+		UserID:  int64(userID),
+		TrackID: int64(trackID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.TrackDislikedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeleteTrackDislike(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	trackID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.DeleteTrackDislike(context.Background(), &music.DislikeTrackOptions{
+		UserID:  int64(userID),
+		TrackID: int64(trackID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.TrackDislikeDeletedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+// Радио по треку, артисту, альбому или плейлисту. Следующая порция запрашивается по токену сессии
+func (api *APIMicroservices) Radio(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var seedID, limit int64
+	if querySeedID := ctx.QueryParam("seed_id"); len(querySeedID) != 0 {
+		var err error
+		seedID, err = strconv.ParseInt(querySeedID, 10, 64)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+	if queryLimit := ctx.QueryParam("limit"); len(queryLimit) != 0 {
+		var err error
+		limit, err = strconv.ParseInt(queryLimit, 10, 64)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	radioProto, err := api.musicMicroservice.Radio(context.Background(), &music.RadioOptions{
+		SeedType: ctx.QueryParam("seed_type"),
+		SeedID:   seedID,
+		Session:  ctx.QueryParam("session"),
+		UserID:   int64(userID),
+		Amount:   limit,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var radio models.Radio
+	radio.BindProto(radioProto)
+
+	jsonRadio, err := easyjson.Marshal(radio)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonRadio)
+}
+
+func (api *APIMicroservices) GetQueue(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	queueProto, err := api.playerMicroservice.GetQueue(context.Background(), &player.GetQueueOptions{UserID: int64(userID)})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var queue models.Queue
+	queue.BindProto(queueProto)
+
+	jsonQueue, err := easyjson.Marshal(queue)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonQueue)
+}
+
+// Очередь заменяется целиком. В version передается версия, полученная клиентом последней,
+// при конфликте возвращается 409 и очередь нужно перечитать
+func (api *APIMicroservices) UpdateQueue(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var requestData models.Queue
+	if err := ctx.Bind(&requestData); err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	queueProto, err := api.playerMicroservice.UpdateQueue(context.Background(), &player.UpdateQueueOptions{
+		UserID: int64(userID),
+		Queue: &player.Queue{
+			TrackIDs:     requestData.TrackIDs,
+			CurrentIndex: requestData.CurrentIndex,
+			PositionMs:   requestData.PositionMs,
+			Shuffle:      requestData.Shuffle,
+			RepeatMode:   requestData.RepeatMode,
+			ContextType:  requestData.ContextType,
+			ContextID:    requestData.ContextID,
+			Version:      requestData.Version,
+			DeviceID:     requestData.DeviceID,
+		},
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var queue models.Queue
+	queue.BindProto(queueProto)
+
+	jsonQueue, err := easyjson.Marshal(queue)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonQueue)
+}
+
+// Изменения очереди через Server-Sent Events. Первым событием приходит текущее состояние, id события - версия очереди
+//
+//nolint:cyclop
+func (api *APIMicroservices) QueueEvents(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	stream, err := api.playerMicroservice.WatchQueue(ctx.Request().Context(), &player.GetQueueOptions{UserID: int64(userID)})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	queue, err := stream.Recv()
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, constants.EventStreamContentType)
+	ctx.Response().Header().Set("Cache-Control", "no-cache")
+	ctx.Response().Header().Set("X-Accel-Buffering", "no")
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	queues := make(chan *player.Queue)
+	streamErrors := make(chan error, 1)
+	go func() {
+		for {
+			changed, err := stream.Recv()
+			if err != nil {
+				streamErrors <- err
+				return
+			}
+			select {
+			case queues <- changed:
+			case <-ctx.Request().Context().Done():
+				return
+			}
+		}
+	}()
+
+	if err = writeQueueEvent(ctx.Response(), queue); err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()))
+		return nil
+	}
+
+	heartbeat := time.NewTicker(constants.EventStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case err = <-streamErrors:
+			if !errors.Is(err, io.EOF) && status.Code(err) != codes.Canceled {
+				api.logger.Error(
+					zap.String("ID", requestID),
+					zap.String("ERROR", err.Error()))
+			}
+			api.logger.Info(
+				zap.String("ID", requestID),
+				zap.Int("ANSWER STATUS", http.StatusOK),
+			)
+			return nil
+		case queue = <-queues:
+			if err = writeQueueEvent(ctx.Response(), queue); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(ctx.Response(), ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			ctx.Response().Flush()
+		}
+	}
+}
+
+func (api *APIMicroservices) RegisterDevice(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	cookie, err := ctx.Cookie("Session_cookie")
+	if userID == -1 || err != nil {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	var requestData models.Device
+	if err = ctx.Bind(&requestData); err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	deviceProto, err := api.playerMicroservice.RegisterDevice(context.Background(), &player.RegisterDeviceOptions{
+		UserID:  int64(userID),
+		Session: cookie.Value,
+		Name:    requestData.Name,
+		Type:    requestData.Type,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var device models.Device
+	device.BindProto(deviceProto)
+
+	jsonDevice, err := easyjson.Marshal(device)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonDevice)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) GetDevices(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	devicesProto, err := api.playerMicroservice.GetDevices(context.Background(), &player.GetDevicesOptions{UserID: int64(userID)})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var devices models.UserDevices
+	devices.BindProto(devicesProto)
+
+	jsonDevices, err := easyjson.Marshal(devices)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonDevices)
+}
+
+// Канал команд устройства через WebSocket. Сервер присылает команды для этого устройства, а устройство
+// отправляет в тот же канал команды для других своих устройств. Открыть канал можно только из той сессии,
+// в которой устройство зарегистрировано
+func (api *APIMicroservices) DeviceCommands(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	cookie, err := ctx.Cookie("Session_cookie")
+	if userID == -1 || err != nil {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	deviceID := ctx.Param("id")
+	streamCtx, cancel := context.WithCancel(ctx.Request().Context())
+	defer cancel()
+
+	stream, err := api.playerMicroservice.WatchCommands(streamCtx, &player.WatchCommandsOptions{
+		UserID:   int64(userID),
+		Session:  cookie.Value,
+		DeviceID: deviceID,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	connected, err := stream.Recv()
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	server := websocket.Server{
+		Handshake: checkWebSocketOrigin,
+		Handler: func(conn *websocket.Conn) {
+			defer cancel()
+			go func() {
+				defer conn.Close()
+				command := connected
+				for {
+					if err := writeDeviceCommand(conn, command); err != nil {
+						return
+					}
+					var err error
+					if command, err = stream.Recv(); err != nil {
+						if !errors.Is(err, io.EOF) && status.Code(err) != codes.Canceled {
+							api.logger.Error(
+								zap.String("ID", requestID),
+								zap.String("ERROR", err.Error()))
+						}
+						return
+					}
+				}
+			}()
+
+			for {
+				var message []byte
+				if err := websocket.Message.Receive(conn, &message); err != nil {
+					return
+				}
+				api.sendDeviceCommand(conn, requestID, int64(userID), deviceID, message)
+			}
+		},
+	}
+	server.ServeHTTP(ctx.Response(), ctx.Request())
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusSwitchingProtocols),
+	)
+	return nil
+}
+
+// Поток событий пользователя через Server-Sent Events. При переподключении браузер сам присылает Last-Event-ID,
+// и пропущенные события отправляются из истории
+func (api *APIMicroservices) Events(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	lastEventIDValue := ctx.Request().Header.Get(constants.LastEventIDHeader)
+	if len(lastEventIDValue) == 0 {
+		lastEventIDValue = ctx.QueryParam("last_event_id")
+	}
+	var lastEventID int64
+	if len(lastEventIDValue) != 0 {
+		var err error
+		lastEventID, err = strconv.ParseInt(lastEventIDValue, 10, 64)
+		if err != nil || lastEventID < 0 {
+			api.logger.Info(
+				zap.String("ID", requestID),
+				zap.String("MESSAGE", constants.LastEventIDInvalidMessage),
+				zap.Int("ANSWER STATUS", http.StatusBadRequest))
+
+			response := &models.Response{
+				Status:  http.StatusBadRequest,
+				Message: constants.LastEventIDInvalidMessage,
+			}
+			jsonResponse, err := easyjson.Marshal(response)
+			if err != nil {
+				api.logger.Error(
+					zap.String("ID", requestID),
+					zap.String("ERROR", err.Error()),
+					zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+				return ctx.NoContent(http.StatusInternalServerError)
+			}
+
+			return ctx.JSONBlob(http.StatusOK, jsonResponse)
+		}
+	}
+
+	userEvents, err := api.eventsBroker.Subscribe(ctx.Request().Context(), int64(userID), lastEventID)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, constants.EventStreamContentType)
+	ctx.Response().Header().Set("Cache-Control", "no-cache")
+	ctx.Response().Header().Set("X-Accel-Buffering", "no")
+	ctx.Response().WriteHeader(http.StatusOK)
+	if _, err = fmt.Fprintf(ctx.Response(), "retry: %d\n\n", constants.EventStreamRetry.Milliseconds()); err != nil {
+		return nil
+	}
+	ctx.Response().Flush()
+
+	heartbeat := time.NewTicker(constants.EventStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case event, ok := <-userEvents:
+			if !ok {
+				api.logger.Info(
+					zap.String("ID", requestID),
+					zap.String("MESSAGE", constants.EventStreamClosedMessage),
+					zap.Int("ANSWER STATUS", http.StatusOK))
+				return nil
+			}
+			if err = writeEvent(ctx.Response(), event); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(ctx.Response(), ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			ctx.Response().Flush()
+		}
+	}
+}
+
+//nolint:dupl
+func (api *APIMicroservices) GetUserFavorites(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	filter, err := getFavoritesFilter(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	tracksListProto, err := api.musicMicroservice.GetFavoriteTracks(context.Background(), &music.UserFavoritesOptions{
+		UserID: int64(userID),
+		Page:   page,
+		SortBy: ctx.QueryParam("sort"),
+		Filter: filter,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, tracksListProto.Page)
+
+	tracks := models.Tracks{}
+	for _, current := range tracksListProto.Tracks {
+		var track models.Track
+		track.BindProto(current)
+		tracks = append(tracks, track)
+	}
+
+	jsonTracks, err := easyjson.Marshal(tracks)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonTracks)
+}
+
+func (api *APIMicroservices) SaveAlbum(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	albumID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.SaveAlbum(context.Background(), &music.SaveAlbumOptions{
+		UserID:  int64(userID),
+		AlbumID: int64(albumID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.AlbumSavedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+func (api *APIMicroservices) UnsaveAlbum(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	albumID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.UnsaveAlbum(context.Background(), &music.SaveAlbumOptions{
+		UserID:  int64(userID),
+		AlbumID: int64(albumID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.AlbumUnsavedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+func (api *APIMicroservices) GetSavedAlbums(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	albumsProto, err := api.musicMicroservice.GetSavedAlbums(context.Background(), &music.LibraryOptions{
+		UserID: int64(userID),
+		Page:   page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, albumsProto.Page)
+
+	albums := models.Albums{}
+	for _, current := range albumsProto.Albums {
+		var item models.Album
+		item.BindProto(current)
+		albums = append(albums, item)
+	}
+
+	jsonAlbums, err := easyjson.Marshal(albums)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonAlbums)
+}
+
+func (api *APIMicroservices) FollowArtist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	artistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.FollowArtist(context.Background(), &music.FollowArtistOptions{
+		UserID:   int64(userID),
+		ArtistID: int64(artistID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusCreated,
+		Message: constants.ArtistFollowedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+}
+
+func (api *APIMicroservices) UnfollowArtist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	artistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	_, err = api.musicMicroservice.UnfollowArtist(context.Background(), &music.FollowArtistOptions{
+		UserID:   int64(userID),
+		ArtistID: int64(artistID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.ArtistUnfollowedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+func (api *APIMicroservices) GetFollowedArtists(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	artistsProto, err := api.musicMicroservice.GetFollowedArtists(context.Background(), &music.LibraryOptions{
+		UserID: int64(userID),
+		Page:   page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, artistsProto.Page)
+
+	artists := models.Artists{}
+	for _, current := range artistsProto.Artists {
+		var item models.Artist
+		item.BindProto(current)
+		artists = append(artists, item)
+	}
+
+	jsonArtists, err := easyjson.Marshal(artists)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonArtists)
+}
+
+func (api *APIMicroservices) GetReleaseRadar(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	releaseRadarProto, err := api.musicMicroservice.ReleaseRadar(context.Background(), &music.ReleaseRadarOptions{
+		UserID: int64(userID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var releaseRadar models.ReleaseRadar
+	releaseRadar.BindProto(releaseRadarProto)
+
+	jsonReleaseRadar, err := easyjson.Marshal(releaseRadar)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonReleaseRadar)
+}
+
+func (api *APIMicroservices) GetRecap(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	year, err := strconv.Atoi(ctx.Param("year"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	recapProto, err := api.musicMicroservice.Recap(context.Background(), &music.RecapOptions{
+		UserID: int64(userID),
+		Year:   int64(year),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var recap models.Recap
+	recap.BindProto(recapProto)
+
+	jsonRecap, err := easyjson.Marshal(recap)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonRecap)
+}
+
+func (api *APIMicroservices) SaveRecapPlaylist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	year, err := strconv.Atoi(ctx.Param("year"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	playlistIDProto, err := api.playlistsMicroservice.CreateRecapPlaylist(context.Background(), &playlists.RecapPlaylistOptions{
+		UserID: int64(userID),
+		Year:   int64(year),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var playlistID models.PlaylistID
+	playlistID.BindProto(playlistIDProto)
+
+	jsonPlaylistID, err := easyjson.Marshal(playlistID)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonPlaylistID)
+}
+
+func (api *APIMicroservices) DeletePlaylistArtwork(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	playlistID, err := strconv.Atoi(ctx.FormValue("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldArtworkProto, err := api.playlistsMicroservice.DeletePlaylistArtwork(context.Background(), &playlists.DeletePlaylistArtworkOptions{
+		PlaylistID: int64(playlistID),
+		UserID:     int64(userID),
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	err = api.imageService.DeleteImages(
+		os.Getenv("PLAYLIST_FULL_PREFIX"),
+		oldArtworkProto.OldArtworkFilename,
+		[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
+		constants.PlaylistArtworkDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.PlaylistArtworkColor{ArtworkColor: constants.PlaylistArtworkDefaultColor}
+	jsonPlaylistArtworkColor, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonPlaylistArtworkColor)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) GetCharts(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+	var genreID int
+	var err error
+	if genre := ctx.QueryParam("genre"); len(genre) != 0 {
+		genreID, err = strconv.Atoi(genre)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+	}
+	entity := ctx.Param("entity")
+	amount := int64(constants.ChartPositionsAmount)
+	if len(entity) == 0 {
+		amount = constants.ChartPreviewAmount
+	}
+
+	chartsProto, err := api.musicMicroservice.Charts(context.Background(), &music.ChartsOptions{
+		Period:       ctx.Param("period"),
+		Entity:       entity,
+		GenreID:      int64(genreID),
+		Amount:       amount,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var charts models.Charts
+	charts.BindProto(chartsProto)
+
+	jsonCharts, err := easyjson.Marshal(charts)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonCharts)
+}
+
+func (api *APIMicroservices) GetGenres(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	genresListProto, err := api.musicMicroservice.ListGenres(context.Background(), &music.ListGenresOptions{})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	genres := models.Genres{}
+	for _, current := range genresListProto.Genres {
+		var genre models.Genre
+		genre.BindProto(current)
+		genres = append(genres, genre)
+	}
+
+	jsonGenres, err := easyjson.Marshal(genres)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonGenres)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) GetGenrePage(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+	genreID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	genrePageProto, err := api.musicMicroservice.GenrePage(context.Background(), &music.GenrePageOptions{
+		GenreID:      int64(genreID),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		Page:         page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, genrePageProto.Page)
+
+	var genrePage models.GenrePage
+	genrePage.BindProto(genrePageProto)
+
+	jsonGenrePage, err := easyjson.Marshal(genrePage)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonGenrePage)
+}
+
+func (api *APIMicroservices) GetArtistTracks(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+	artistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	tracksListProto, err := api.musicMicroservice.ArtistTracks(context.Background(), &music.ArtistTracksOptions{
+		ArtistID:     int64(artistID),
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+		SortBy:       ctx.QueryParam("sort"),
+		Page:         page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, tracksListProto.Page)
+
+	tracks := models.Tracks{}
+	for _, current := range tracksListProto.Tracks {
+		var track models.Track
+		track.BindProto(current)
+		tracks = append(tracks, track)
+	}
+
+	jsonTracks, err := easyjson.Marshal(tracks)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonTracks)
+}
+
+func (api *APIMicroservices) GetArtistAlbums(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	artistID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	page, err := getPage(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	albumsListProto, err := api.musicMicroservice.ArtistAlbums(context.Background(), &music.ArtistAlbumsOptions{
+		ArtistID: int64(artistID),
+		SortBy:   ctx.QueryParam("sort"),
+		Page:     page,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	setPageHeaders(ctx, albumsListProto.Page)
+
+	albums := models.Albums{}
+	for _, current := range albumsListProto.Albums {
+		var album models.Album
+		album.BindProto(current)
+		albums = append(albums, album)
+	}
+
+	jsonAlbums, err := easyjson.Marshal(albums)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonAlbums)
+}
+
+func (api *APIMicroservices) GetTrack(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+	trackID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	trackProto, err := api.musicMicroservice.GetTrack(context.Background(), &music.GetTrackOptions{
+		TrackID:      trackID,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var track models.Track
+	track.BindProto(trackProto)
+
+	jsonTrack, err := easyjson.Marshal(track)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonTrack)
+}
+
+// Пакетное получение треков: /api/v1/tracks?ids=1,2,3
+func (api *APIMicroservices) GetTracks(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	var isAuthorized bool
+	if userID != -1 {
+		isAuthorized = true
+	}
+	trackIDs, err := getTrackIDs(ctx)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	tracksListProto, err := api.musicMicroservice.GetTracks(context.Background(), &music.GetTracksOptions{
+		IDs:          trackIDs,
+		UserID:       int64(userID),
+		IsAuthorized: isAuthorized,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	tracks := models.Tracks{}
+	for _, current := range tracksListProto.Tracks {
+		var track models.Track
+		track.BindProto(current)
+		tracks = append(tracks, track)
+	}
+
+	jsonTracks, err := easyjson.Marshal(tracks)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonTracks)
+}
+
+func (api *APIMicroservices) GetTrackLyrics(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	trackID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	lyricsProto, err := api.musicMicroservice.GetLyrics(context.Background(), &music.LyricsOptions{TrackID: trackID})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var lyrics models.Lyrics
+	lyrics.BindProto(lyricsProto)
+
+	jsonLyrics, err := easyjson.Marshal(lyrics)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonLyrics)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) CreateCatalogArtist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	avatar, err := api.createFormImages(ctx, "avatar", os.Getenv("ARTISTS_FULL_PREFIX"),
+		map[int]string{constants.ArtistAvatarSize: constants.ImageExtension})
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	entryIDProto, err := api.catalogMicroservice.CreateArtist(context.Background(), &catalog.ArtistOptions{
+		AdminID: int64(userID),
+		Artist: &catalog.CatalogArtist{
+			Name:        ctx.FormValue("name"),
+			Bio:         ctx.FormValue("bio"),
+			Video:       ctx.FormValue("video"),
+			Avatar:      avatar.Filename,
+			AvatarColor: avatar.ArtworkColor,
+		},
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("ARTISTS_FULL_PREFIX"),
+			avatar.Filename,
+			[]string{constants.ImageExtension},
+			constants.ArtistAvatarDefaultFilename,
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var entryID models.CatalogEntryID
+	entryID.BindProto(entryIDProto)
+
+	jsonEntryID, err := easyjson.Marshal(entryID)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonEntryID)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) UpdateCatalogArtist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	avatar, err := api.createFormImages(ctx, "avatar", os.Getenv("ARTISTS_FULL_PREFIX"),
+		map[int]string{constants.ArtistAvatarSize: constants.ImageExtension})
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldFilenameProto, err := api.catalogMicroservice.UpdateArtist(context.Background(), &catalog.ArtistOptions{
+		AdminID: int64(userID),
+		Artist: &catalog.CatalogArtist{
+			ID:          entryID,
+			Name:        ctx.FormValue("name"),
+			Bio:         ctx.FormValue("bio"),
+			Video:       ctx.FormValue("video"),
+			Avatar:      avatar.Filename,
+			AvatarColor: avatar.ArtworkColor,
+		},
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("ARTISTS_FULL_PREFIX"),
+			avatar.Filename,
+			[]string{constants.ImageExtension},
+			constants.ArtistAvatarDefaultFilename,
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("ARTISTS_FULL_PREFIX"),
+		oldFilenameProto.OldFilename,
+		[]string{constants.ImageExtension},
+		constants.ArtistAvatarDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryUpdatedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeleteCatalogArtist(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldFilenameProto, err := api.catalogMicroservice.DeleteArtist(context.Background(), &catalog.DeleteOptions{
+		AdminID: int64(userID),
+		ID:      entryID,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("ARTISTS_FULL_PREFIX"),
+		oldFilenameProto.OldFilename,
+		[]string{constants.ImageExtension},
+		constants.ArtistAvatarDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryDeletedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) CreateCatalogAlbum(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	year, _ := strconv.ParseInt(ctx.FormValue("year"), 10, 64)
+	artistID, _ := strconv.ParseInt(ctx.FormValue("artist_id"), 10, 64)
+	artwork, err := api.createFormImages(ctx, "artwork", os.Getenv("ARTWORKS_FULL_PREFIX"),
+		map[int]string{
+			100: constants.AlbumArtworkExtension100px,
+			384: constants.AlbumArtworkExtension384px,
+		})
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	entryIDProto, err := api.catalogMicroservice.CreateAlbum(context.Background(), &catalog.AlbumOptions{
+		AdminID: int64(userID),
+		Album: &catalog.CatalogAlbum{
+			Title:        ctx.FormValue("title"),
+			Year:         year,
+			ArtistID:     artistID,
+			Artwork:      artwork.Filename,
+			ArtworkColor: artwork.ArtworkColor,
+			ReleaseDate:  ctx.FormValue("release_date"),
+		},
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("ARTWORKS_FULL_PREFIX"),
+			artwork.Filename,
+			[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+			"",
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var entryID models.CatalogEntryID
+	entryID.BindProto(entryIDProto)
+
+	jsonEntryID, err := easyjson.Marshal(entryID)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonEntryID)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) UpdateCatalogAlbum(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	year, _ := strconv.ParseInt(ctx.FormValue("year"), 10, 64)
+	artistID, _ := strconv.ParseInt(ctx.FormValue("artist_id"), 10, 64)
+	artwork, err := api.createFormImages(ctx, "artwork", os.Getenv("ARTWORKS_FULL_PREFIX"),
+		map[int]string{
+			100: constants.AlbumArtworkExtension100px,
+			384: constants.AlbumArtworkExtension384px,
+		})
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldFilenameProto, err := api.catalogMicroservice.UpdateAlbum(context.Background(), &catalog.AlbumOptions{
+		AdminID: int64(userID),
+		Album: &catalog.CatalogAlbum{
+			ID:           entryID,
+			Title:        ctx.FormValue("title"),
+			Year:         year,
+			ArtistID:     artistID,
+			Artwork:      artwork.Filename,
+			ArtworkColor: artwork.ArtworkColor,
+			ReleaseDate:  ctx.FormValue("release_date"),
+		},
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("ARTWORKS_FULL_PREFIX"),
+			artwork.Filename,
+			[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+			"",
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("ARTWORKS_FULL_PREFIX"),
+		oldFilenameProto.OldFilename,
+		[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+		"",
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryUpdatedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeleteCatalogAlbum(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldFilenameProto, err := api.catalogMicroservice.DeleteAlbum(context.Background(), &catalog.DeleteOptions{
+		AdminID: int64(userID),
+		ID:      entryID,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("ARTWORKS_FULL_PREFIX"),
+		oldFilenameProto.OldFilename,
+		[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+		"",
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryDeletedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) CreateCatalogGenre(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	artwork, err := api.createFormImages(ctx, "artwork", os.Getenv("ARTWORKS_FULL_PREFIX"),
+		map[int]string{
+			100: constants.AlbumArtworkExtension100px,
+			384: constants.AlbumArtworkExtension384px,
+		})
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	entryIDProto, err := api.catalogMicroservice.CreateGenre(context.Background(), &catalog.GenreOptions{
+		AdminID: int64(userID),
+		Genre: &catalog.CatalogGenre{
+			Name:         ctx.FormValue("name"),
+			Artwork:      artwork.Filename,
+			ArtworkColor: artwork.ArtworkColor,
+		},
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("ARTWORKS_FULL_PREFIX"),
+			artwork.Filename,
+			[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+			constants.GenreArtworkDefaultFilename,
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+
+	var entryID models.CatalogEntryID
+	entryID.BindProto(entryIDProto)
+
+	jsonEntryID, err := easyjson.Marshal(entryID)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusCreated),
+	)
+	return ctx.JSONBlob(http.StatusCreated, jsonEntryID)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) UpdateCatalogGenre(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	artwork, err := api.createFormImages(ctx, "artwork", os.Getenv("ARTWORKS_FULL_PREFIX"),
+		map[int]string{
+			100: constants.AlbumArtworkExtension100px,
+			384: constants.AlbumArtworkExtension384px,
+		})
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldFilenameProto, err := api.catalogMicroservice.UpdateGenre(context.Background(), &catalog.GenreOptions{
+		AdminID: int64(userID),
+		Genre: &catalog.CatalogGenre{
+			ID:           entryID,
+			Name:         ctx.FormValue("name"),
+			Artwork:      artwork.Filename,
+			ArtworkColor: artwork.ArtworkColor,
+		},
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("ARTWORKS_FULL_PREFIX"),
+			artwork.Filename,
+			[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+			constants.GenreArtworkDefaultFilename,
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("ARTWORKS_FULL_PREFIX"),
+		oldFilenameProto.OldFilename,
+		[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+		constants.GenreArtworkDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryUpdatedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl
+func (api *APIMicroservices) DeleteCatalogGenre(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	oldFilenameProto, err := api.catalogMicroservice.DeleteGenre(context.Background(), &catalog.DeleteOptions{
+		AdminID: int64(userID),
+		ID:      entryID,
+	})
+	if err != nil {
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
+	err = api.imageService.DeleteImages(
+		os.Getenv("ARTWORKS_FULL_PREFIX"),
+		oldFilenameProto.OldFilename,
+		[]string{constants.AlbumArtworkExtension100px, constants.AlbumArtworkExtension384px},
+		constants.GenreArtworkDefaultFilename,
+	)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryDeletedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
+}
+
+//nolint:dupl,cyclop
+func (api *APIMicroservices) CreateCatalogTrack(ctx echo.Context) error {
+	requestID, ok := ctx.Get("REQUEST_ID").(string)
+	if !ok {
+		api.logger.Error(
+			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	userID, ok := ctx.Get("USER_ID").(int)
+	if !ok {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+	if userID == -1 {
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
+			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+
+		response := &models.Response{
+			Status:  http.StatusUnauthorized,
+			Message: constants.UserIsNotAuthorizedMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
+	}
+
+	artistID, _ := strconv.ParseInt(ctx.FormValue("artist_id"), 10, 64)
+	albumID, _ := strconv.ParseInt(ctx.FormValue("album_id"), 10, 64)
+	genreID, _ := strconv.ParseInt(ctx.FormValue("genre_id"), 10, 64)
+	number, _ := strconv.ParseInt(ctx.FormValue("number"), 10, 64)
+	explicit, _ := strconv.ParseBool(ctx.FormValue("explicit"))
+	track := &catalog.CatalogTrack{
+		Title:    ctx.FormValue("title"),
+		ArtistID: artistID,
+		AlbumID:  albumID,
+		GenreID:  genreID,
+		Number:   number,
+		Explicit: explicit,
+	}
+
+	err := api.saveTrackFiles(ctx, track)
+	if err != nil {
+		if _, isStatus := status.FromError(err); isStatus {
+			return api.ParseErrorByCode(ctx, requestID, err)
+		}
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	entryIDProto, err := api.catalogMicroservice.CreateTrack(context.Background(), &catalog.TrackOptions{
+		AdminID: int64(userID),
+		Track:   track,
+	})
+	if err != nil {
+		deleteErr := api.imageService.DeleteImages(
+			os.Getenv("TRACKS_PATH")+"/",
+			track.File,
+			[]string{constants.TrackLossyExtension, constants.TrackLosslessExtension},
+			"",
+		)
+		if deleteErr != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", deleteErr.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		return api.ParseErrorByCode(ctx, requestID, err)
+	}
 
-	var playlistID models.PlaylistID
-	playlistID.BindProto(playlistIDProto)
+	var entryID models.CatalogEntryID
+	entryID.BindProto(entryIDProto)
 
-	jsonPlaylistID, err := easyjson.Marshal(playlistID)
+	jsonEntryID, err := easyjson.Marshal(entryID)
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1059,11 +5809,11 @@ func (api *APIMicroservices) CreatePlaylist(ctx echo.Context) error {
 		zap.String("ID", requestID),
 		zap.Int("ANSWER STATUS", http.StatusCreated),
 	)
-	return ctx.JSONBlob(http.StatusCreated, jsonPlaylistID)
+	return ctx.JSONBlob(http.StatusCreated, jsonEntryID)
 }
 
-//nolint:cyclop,dupl
-func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
+//nolint:dupl,cyclop
+func (api *APIMicroservices) UpdateCatalogTrack(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1101,7 +5851,7 @@ func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	playlistID, err := strconv.Atoi(ctx.Param("id"))
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1110,50 +5860,43 @@ func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	var artworkFilename, artworkColor string
-	var isPublic bool
-	title := ctx.FormValue("title")
-	artwork, err := ctx.FormFile("artwork")
-	isPublic, _ = strconv.ParseBool(ctx.FormValue("is_public"))
-	if err != nil {
-		artworkFilename = ""
-	} else {
-		artworkFilename = artwork.Filename
+	artistID, _ := strconv.ParseInt(ctx.FormValue("artist_id"), 10, 64)
+	albumID, _ := strconv.ParseInt(ctx.FormValue("album_id"), 10, 64)
+	genreID, _ := strconv.ParseInt(ctx.FormValue("genre_id"), 10, 64)
+	number, _ := strconv.ParseInt(ctx.FormValue("number"), 10, 64)
+	explicit, _ := strconv.ParseBool(ctx.FormValue("explicit"))
+	track := &catalog.CatalogTrack{
+		ID:       entryID,
+		Title:    ctx.FormValue("title"),
+		ArtistID: artistID,
+		AlbumID:  albumID,
+		GenreID:  genreID,
+		Number:   number,
+		Explicit: explicit,
 	}
-	if len(artworkFilename) != 0 {
-		var createdImageData *models.ImageData
-		createdImageData, err = api.imageService.CreateImages(
-			artwork,
-			os.Getenv("PLAYLIST_FULL_PREFIX"),
-			map[int]string{
-				100: constants.PlaylistArtworkExtension100px,
-				384: constants.PlaylistArtworkExtension384px,
-			})
-		if err != nil {
-			api.logger.Error(
-				zap.String("ID", requestID),
-				zap.String("ERROR", err.Error()),
-				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-			return ctx.NoContent(http.StatusInternalServerError)
+
+	err = api.saveTrackFiles(ctx, track)
+	if err != nil {
+		if _, isStatus := status.FromError(err); isStatus {
+			return api.ParseErrorByCode(ctx, requestID, err)
 		}
-		artworkFilename = createdImageData.Filename
-		artworkColor = createdImageData.ArtworkColor
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	artworkProto, err := api.playlistsMicroservice.UpdatePlaylist(context.Background(), &playlists.UpdatePlaylistOptions{
-		PlaylistID:   int64(playlistID),
-		Title:        title,
-		UserID:       int64(userID),
-		Artwork:      artworkFilename,
-		ArtworkColor: artworkColor,
-		IsPublic:     isPublic,
+	oldFilenameProto, err := api.catalogMicroservice.UpdateTrack(context.Background(), &catalog.TrackOptions{
+		AdminID: int64(userID),
+		Track:   track,
 	})
 	if err != nil {
 		deleteErr := api.imageService.DeleteImages(
-			os.Getenv("PLAYLIST_FULL_PREFIX"),
-			artworkFilename,
-			[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
-			constants.PlaylistArtworkDefaultFilename,
+			os.Getenv("TRACKS_PATH")+"/",
+			track.File,
+			[]string{constants.TrackLossyExtension, constants.TrackLosslessExtension},
+			"",
 		)
 		if deleteErr != nil {
 			api.logger.Error(
@@ -1165,10 +5908,10 @@ func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
 	err = api.imageService.DeleteImages(
-		os.Getenv("PLAYLIST_FULL_PREFIX"),
-		artworkProto.OldArtworkFilename,
-		[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
-		constants.PlaylistArtworkDefaultFilename,
+		os.Getenv("TRACKS_PATH")+"/",
+		oldFilenameProto.OldFilename,
+		[]string{constants.TrackLossyExtension, constants.TrackLosslessExtension},
+		"",
 	)
 	if err != nil {
 		api.logger.Error(
@@ -1178,8 +5921,11 @@ func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	response := &models.PlaylistArtworkColor{ArtworkColor: artworkProto.ArtworkColor}
-	jsonPlaylistArtworkColor, err := easyjson.Marshal(response)
+	response := &models.Response{
+		Status:  http.StatusOK,
+		Message: constants.CatalogEntryUpdatedMessage,
+	}
+	jsonResponse, err := easyjson.Marshal(response)
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1192,11 +5938,11 @@ func (api *APIMicroservices) UpdatePlaylist(ctx echo.Context) error {
 		zap.String("ID", requestID),
 		zap.Int("ANSWER STATUS", http.StatusOK),
 	)
-	return ctx.JSONBlob(http.StatusOK, jsonPlaylistArtworkColor)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
 }
 
 //nolint:dupl
-func (api *APIMicroservices) DeletePlaylist(ctx echo.Context) error {
+func (api *APIMicroservices) DeleteCatalogTrack(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1234,7 +5980,7 @@ func (api *APIMicroservices) DeletePlaylist(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	playlistID, err := strconv.Atoi(ctx.Param("id"))
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1243,18 +5989,18 @@ func (api *APIMicroservices) DeletePlaylist(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	oldArtworkProto, err := api.playlistsMicroservice.DeletePlaylist(context.Background(), &playlists.DeletePlaylistOptions{
-		PlaylistID: int64(playlistID),
-		UserID:     int64(userID),
+	oldFilenameProto, err := api.catalogMicroservice.DeleteTrack(context.Background(), &catalog.DeleteOptions{
+		AdminID: int64(userID),
+		ID:      entryID,
 	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
 	err = api.imageService.DeleteImages(
-		os.Getenv("PLAYLIST_FULL_PREFIX"),
-		oldArtworkProto.OldArtworkFilename,
-		[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
-		constants.PlaylistArtworkDefaultFilename,
+		os.Getenv("TRACKS_PATH")+"/",
+		oldFilenameProto.OldFilename,
+		[]string{constants.TrackLossyExtension, constants.TrackLosslessExtension},
+		"",
 	)
 	if err != nil {
 		api.logger.Error(
@@ -1266,7 +6012,7 @@ func (api *APIMicroservices) DeletePlaylist(ctx echo.Context) error {
 
 	response := &models.Response{
 		Status:  http.StatusOK,
-		Message: constants.PlaylistDeletedMessage,
+		Message: constants.CatalogEntryDeletedMessage,
 	}
 	jsonResponse, err := easyjson.Marshal(response)
 	if err != nil {
@@ -1284,8 +6030,10 @@ func (api *APIMicroservices) DeletePlaylist(ctx echo.Context) error {
 	return ctx.JSONBlob(http.StatusOK, jsonResponse)
 }
 
+// Загрузка текста трека: обычный текст в поле plain и/или синхронизированный в формате LRC в поле lrc
+//
 //nolint:dupl
-func (api *APIMicroservices) AddTrack(ctx echo.Context) error {
+func (api *APIMicroservices) SetCatalogLyrics(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1323,8 +6071,8 @@ func (api *APIMicroservices) AddTrack(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	var requestData models.PlaylistTrack
-	if err := ctx.Bind(&requestData); err != nil {
+	trackID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
 			zap.String("ERROR", err.Error()),
@@ -1332,18 +6080,19 @@ func (api *APIMicroservices) AddTrack(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	_, err := api.playlistsMicroservice.AddTrack(context.Background(), &playlists.AddTrackOptions{
-		TrackID:    requestData.TrackID,
-		PlaylistID: requestData.PlaylistID,
-		UserID:     int64(userID),
+	_, err = api.catalogMicroservice.SetLyrics(context.Background(), &catalog.SetLyricsOptions{
+		AdminID: int64(userID),
+		TrackID: trackID,
+		Plain:   ctx.FormValue("plain"),
+		Synced:  ctx.FormValue("lrc"),
 	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
 
 	response := &models.Response{
-		Status:  http.StatusCreated,
-		Message: constants.TrackAddedToPlaylistMessage,
+		Status:  http.StatusOK,
+		Message: constants.LyricsUpdatedMessage,
 	}
 	jsonResponse, err := easyjson.Marshal(response)
 	if err != nil {
@@ -1356,13 +6105,13 @@ func (api *APIMicroservices) AddTrack(ctx echo.Context) error {
 
 	api.logger.Info(
 		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusCreated),
+		zap.Int("ANSWER STATUS", http.StatusOK),
 	)
-	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+	return ctx.JSONBlob(http.StatusOK, jsonResponse)
 }
 
 //nolint:dupl
-func (api *APIMicroservices) DeleteTrack(ctx echo.Context) error {
+func (api *APIMicroservices) DeleteCatalogLyrics(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1400,8 +6149,8 @@ func (api *APIMicroservices) DeleteTrack(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	var requestData models.PlaylistTrack
-	if err := ctx.Bind(&requestData); err != nil {
+	trackID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
 			zap.String("ERROR", err.Error()),
@@ -1409,10 +6158,9 @@ func (api *APIMicroservices) DeleteTrack(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	_, err := api.playlistsMicroservice.DeleteTrack(context.Background(), &playlists.DeleteTrackOptions{
-		PlaylistID: requestData.PlaylistID,
-		TrackID:    requestData.TrackID,
-		UserID:     int64(userID),
+	_, err = api.catalogMicroservice.DeleteLyrics(context.Background(), &catalog.DeleteOptions{
+		AdminID: int64(userID),
+		ID:      trackID,
 	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
@@ -1420,7 +6168,7 @@ func (api *APIMicroservices) DeleteTrack(ctx echo.Context) error {
 
 	response := &models.Response{
 		Status:  http.StatusOK,
-		Message: constants.TrackDeletedFromPlaylistMessage,
+		Message: constants.LyricsDeletedMessage,
 	}
 	jsonResponse, err := easyjson.Marshal(response)
 	if err != nil {
@@ -1438,8 +6186,7 @@ func (api *APIMicroservices) DeleteTrack(ctx echo.Context) error {
 	return ctx.JSONBlob(http.StatusOK, jsonResponse)
 }
 
-//nolint:dupl
-func (api *APIMicroservices) GetUserPlaylists(ctx echo.Context) error {
+func (api *APIMicroservices) GetSuspiciousAccounts(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1455,7 +6202,6 @@ func (api *APIMicroservices) GetUserPlaylists(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
-
 	if userID == -1 {
 		api.logger.Info(
 			zap.String("ID", requestID),
@@ -1478,85 +6224,409 @@ func (api *APIMicroservices) GetUserPlaylists(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	playlistsProto, err := api.musicMicroservice.UserPlaylists(context.Background(), &music.UserPlaylistsOptions{UserID: int64(userID)})
+	accountsProto, err := api.catalogMicroservice.SuspiciousAccounts(context.Background(), &catalog.SuspiciousAccountsOptions{
+		AdminID: int64(userID),
+	})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
 
-	var userPlaylists models.UserPlaylists
-	userPlaylists.BindProto(playlistsProto)
+	var accounts models.SuspiciousAccounts
+	accounts.BindProto(accountsProto)
+
+	jsonAccounts, err := easyjson.Marshal(accounts)
+	if err != nil {
+		api.logger.Error(
+			zap.String("ID", requestID),
+			zap.String("ERROR", err.Error()),
+			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	api.logger.Info(
+		zap.String("ID", requestID),
+		zap.Int("ANSWER STATUS", http.StatusOK),
+	)
+	return ctx.JSONBlob(http.StatusOK, jsonAccounts)
+}
+
+// Проверяет права администратора до разбора формы, неавторизованный запрос отклоняет сам обработчик
+func (api *APIMicroservices) RequireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		requestID, ok := ctx.Get("REQUEST_ID").(string)
+		if !ok {
+			api.logger.Error(
+				zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		userID, ok := ctx.Get("USER_ID").(int)
+		if !ok {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", constants.UserIDTypeAssertionFailed),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+		if userID == -1 {
+			return next(ctx)
+		}
+
+		_, err := api.catalogMicroservice.CheckAdmin(context.Background(), &catalog.CheckAdminOptions{AdminID: int64(userID)})
+		if err != nil {
+			return api.ParseErrorByCode(ctx, requestID, err)
+		}
+
+		return next(ctx)
+	}
+}
+
+// Сохраняет изображение из формы, если оно передано
+func (api *APIMicroservices) createFormImages(ctx echo.Context, field string, path string, extensions map[int]string) (*models.ImageData, error) {
+	fileHeader, err := ctx.FormFile(field)
+	if err != nil {
+		return &models.ImageData{}, nil
+	}
+
+	return api.imageService.CreateImages(fileHeader, path, extensions)
+}
+
+// Сохраняет загруженные аудиофайлы трека в хранилище. Длительность берется из mp3,
+// lossless-версия принимается только вместе с ним
+//
+//nolint:cyclop
+func (api *APIMicroservices) saveTrackFiles(ctx echo.Context, track *catalog.CatalogTrack) error {
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		if _, err = ctx.FormFile("lossless_file"); err == nil {
+			return status.Error(codes.InvalidArgument, constants.TrackFileRequiredMessage)
+		}
+		return nil
+	}
+	if strings.ToLower(filepath.Ext(file.Filename)) != constants.TrackLossyExtension {
+		return status.Error(codes.InvalidArgument, constants.TrackFileInvalidMessage)
+	}
+	losslessFile, err := ctx.FormFile("lossless_file")
+	if err == nil && strings.ToLower(filepath.Ext(losslessFile.Filename)) != constants.TrackLosslessExtension {
+		return status.Error(codes.InvalidArgument, constants.TrackFileInvalidMessage)
+	}
+
+	duration, err := readTrackDuration(file)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, constants.TrackFileInvalidMessage)
+	}
+
+	filename := uuid.NewV4().String()
+	path := os.Getenv("TRACKS_PATH")
+	if err = saveUploadedFile(file, filepath.Join(path, filename+constants.TrackLossyExtension)); err != nil {
+		return err
+	}
+	if losslessFile != nil {
+		err = saveUploadedFile(losslessFile, filepath.Join(path, filename+constants.TrackLosslessExtension))
+		if err != nil {
+			_ = os.Remove(filepath.Join(path, filename+constants.TrackLossyExtension))
+			return err
+		}
+	}
+
+	track.File = filename
+	track.Duration = duration
+	track.Lossless = losslessFile != nil
+
+	return nil
+}
+
+func readTrackDuration(fileHeader *multipart.FileHeader) (int64, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer func(f multipart.File) {
+		_ = f.Close()
+	}(file)
+
+	tags, err := audiotag.Read(file, audiotag.FormatMP3)
+	if err != nil {
+		return 0, err
+	}
+
+	return tags.Duration, nil
+}
+
+func saveUploadedFile(fileHeader *multipart.FileHeader, destination string) error {
+	in, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer func(f multipart.File) {
+		_ = f.Close()
+	}(in)
+
+	out, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(destination)
+		return err
+	}
+
+	return out.Close()
+}
+
+func getPage(ctx echo.Context) (*music.PageRequest, error) {
+	page := &music.PageRequest{Cursor: ctx.QueryParam("cursor")}
+	if queryLimit := ctx.QueryParam("limit"); len(queryLimit) != 0 {
+		limit, err := strconv.ParseInt(queryLimit, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		page.Limit = limit
+	}
+
+	return page, nil
+}
+
+// Seed, поколение пула и номер страницы случайной подборки. Без seed каждая выборка новая,
+// seed и поколение для следующих страниц возвращаются в заголовках первого ответа
+func getSelection(ctx echo.Context) (int64, int64, int64, error) {
+	var seed, generation, page int64
+	var err error
+	if querySeed := ctx.QueryParam("seed"); len(querySeed) != 0 {
+		if seed, err = strconv.ParseInt(querySeed, 10, 64); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	if queryGeneration := ctx.QueryParam("generation"); len(queryGeneration) != 0 {
+		if generation, err = strconv.ParseInt(queryGeneration, 10, 64); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	if queryPage := ctx.QueryParam("page"); len(queryPage) != 0 {
+		if page, err = strconv.ParseInt(queryPage, 10, 64); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+
+	return seed, generation, page, nil
+}
+
+func getTrackIDs(ctx echo.Context) ([]int64, error) {
+	queryIDs := ctx.QueryParam("ids")
+	if len(queryIDs) == 0 {
+		return nil, nil
+	}
+	parts := strings.Split(queryIDs, ",")
+	trackIDs := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		trackID, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		trackIDs = append(trackIDs, trackID)
+	}
+
+	return trackIDs, nil
+}
+
+func getFavoritesFilter(ctx echo.Context) (*music.FavoritesFilter, error) {
+	filter := &music.FavoritesFilter{Text: ctx.QueryParam("q")}
+	var err error
+	if queryArtist := ctx.QueryParam("artist"); len(queryArtist) != 0 {
+		if filter.ArtistID, err = strconv.ParseInt(queryArtist, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if queryGenre := ctx.QueryParam("genre"); len(queryGenre) != 0 {
+		if filter.GenreID, err = strconv.ParseInt(queryGenre, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if queryExplicit := ctx.QueryParam("explicit"); len(queryExplicit) != 0 {
+		explicit, err := strconv.ParseBool(queryExplicit)
+		if err != nil {
+			return nil, err
+		}
+		filter.Explicit = &explicit
+	}
+	if queryLossless := ctx.QueryParam("lossless"); len(queryLossless) != 0 {
+		lossless, err := strconv.ParseBool(queryLossless)
+		if err != nil {
+			return nil, err
+		}
+		filter.Lossless = &lossless
+	}
+
+	return filter, nil
+}
+
+func getSearchFilter(ctx echo.Context) (*music.SearchFilter, error) {
+	filter := &music.SearchFilter{}
+	numbers := []struct {
+		param string
+		value *int64
+	}{
+		{"genre", &filter.GenreID},
+		{"artist", &filter.ArtistID},
+		{"year_from", &filter.YearFrom},
+		{"year_to", &filter.YearTo},
+		{"duration_from", &filter.DurationFrom},
+		{"duration_to", &filter.DurationTo},
+	}
+	var err error
+	for _, number := range numbers {
+		if queryNumber := ctx.QueryParam(number.param); len(queryNumber) != 0 {
+			if *number.value, err = strconv.ParseInt(queryNumber, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if queryExplicit := ctx.QueryParam("explicit"); len(queryExplicit) != 0 {
+		explicit, err := strconv.ParseBool(queryExplicit)
+		if err != nil {
+			return nil, err
+		}
+		filter.Explicit = &explicit
+	}
+	if queryLossless := ctx.QueryParam("lossless"); len(queryLossless) != 0 {
+		lossless, err := strconv.ParseBool(queryLossless)
+		if err != nil {
+			return nil, err
+		}
+		filter.Lossless = &lossless
+	}
+	filter.Lyrics = ctx.QueryParam("lyrics")
+
+	return filter, nil
+}
 
-	jsonUserPlaylists, err := easyjson.Marshal(userPlaylists)
+func writeQueueEvent(response *echo.Response, queueProto *player.Queue) error {
+	var queue models.Queue
+	queue.BindProto(queueProto)
+
+	jsonQueue, err := easyjson.Marshal(queue)
 	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+		return err
+	}
+	if _, err = fmt.Fprintf(response, "id: %d\nevent: queue\ndata: %s\n\n", queueProto.Version, jsonQueue); err != nil {
+		return err
 	}
+	response.Flush()
 
-	api.logger.Info(
-		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusOK),
-	)
-	return ctx.JSONBlob(http.StatusOK, jsonUserPlaylists)
+	return nil
 }
 
-func (api *APIMicroservices) GetPlaylistPage(ctx echo.Context) error {
-	requestID, ok := ctx.Get("REQUEST_ID").(string)
-	if !ok {
-		api.logger.Error(
-			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
-	}
-	userID, ok := ctx.Get("USER_ID").(int)
-	if !ok {
+// Источником команды всегда считается устройство, открывшее канал. Ошибка отправки возвращается в тот же канал
+func (api *APIMicroservices) sendDeviceCommand(conn *websocket.Conn, requestID string, userID int64, deviceID string, message []byte) {
+	var command models.DeviceCommand
+	if err := easyjson.Unmarshal(message, &command); err != nil {
+		_ = writeDeviceResponse(conn, http.StatusBadRequest, constants.DeviceCommandInvalidMessage)
+		return
+	}
+
+	_, err := api.playerMicroservice.SendCommand(context.Background(), &player.SendCommandOptions{
+		UserID: userID,
+		Command: &player.DeviceCommand{
+			Type:           command.Type,
+			SourceDeviceID: deviceID,
+			TargetDeviceID: command.TargetDeviceID,
+			PositionMs:     command.PositionMs,
+		},
+	})
+	if err == nil {
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		_ = writeDeviceResponse(conn, http.StatusBadRequest, status.Convert(err).Message())
+	case codes.PermissionDenied:
+		_ = writeDeviceResponse(conn, http.StatusForbidden, status.Convert(err).Message())
+	case codes.NotFound:
+		_ = writeDeviceResponse(conn, http.StatusNotFound, status.Convert(err).Message())
+	default:
 		api.logger.Error(
 			zap.String("ID", requestID),
-			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+			zap.String("ERROR", err.Error()))
+		_ = writeDeviceResponse(conn, http.StatusInternalServerError, "")
 	}
+}
 
-	playlistID, err := strconv.Atoi(ctx.Param("id"))
+func writeDeviceCommand(conn *websocket.Conn, commandProto *player.DeviceCommand) error {
+	var command models.DeviceCommand
+	command.BindProto(commandProto)
+
+	jsonCommand, err := easyjson.Marshal(command)
 	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+		return err
 	}
 
-	playlistPageDataProto, err := api.musicMicroservice.PlaylistPage(context.Background(), &music.PlaylistPageOptions{
-		PlaylistID: int64(playlistID),
-		UserID:     int64(userID),
+	return websocket.Message.Send(conn, string(jsonCommand))
+}
+
+func writeDeviceResponse(conn *websocket.Conn, code int, message string) error {
+	jsonResponse, err := easyjson.Marshal(&models.Response{
+		Status:  code,
+		Message: message,
 	})
 	if err != nil {
-		return api.ParseErrorByCode(ctx, requestID, err)
+		return err
 	}
 
-	var playlistPage models.PlaylistPage
-	playlistPage.BindProto(playlistPageDataProto)
+	return websocket.Message.Send(conn, string(jsonResponse))
+}
 
-	jsonPlaylistPage, err := easyjson.Marshal(playlistPage)
+// Канал команд открывается с сессионной кукой, поэтому чужие сайты не должны иметь возможности его открыть
+func checkWebSocketOrigin(config *websocket.Config, request *http.Request) error {
+	origin, err := websocket.Origin(config, request)
 	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+		return err
 	}
+	if origin == nil || origin.String() != os.Getenv("CORS_ORIGIN") {
+		return errors.New(constants.WebSocketOriginForbiddenMessage)
+	}
+	config.Origin = origin
 
-	api.logger.Info(
-		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusOK),
-	)
-	return ctx.JSONBlob(http.StatusOK, jsonPlaylistPage)
+	return nil
 }
 
-//nolint:dupl
-func (api *APIMicroservices) AddTrackToFavorites(ctx echo.Context) error {
+func writeEvent(response *echo.Response, event events.Event) error {
+	data := []byte(event.Data)
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+	if _, err := fmt.Fprintf(response, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+		return err
+	}
+	response.Flush()
+
+	return nil
+}
+
+func setSelectionHeaders(ctx echo.Context, selection *music.Selection) {
+	if selection == nil {
+		return
+	}
+	ctx.Response().Header().Set(constants.SelectionSeedHeader, strconv.FormatInt(selection.Seed, 10))
+	ctx.Response().Header().Set(constants.SelectionGenerationHeader, strconv.FormatInt(selection.Generation, 10))
+}
+
+func setPageHeaders(ctx echo.Context, page *music.PageResponse) {
+	if page == nil {
+		return
+	}
+	if len(page.NextCursor) != 0 {
+		ctx.Response().Header().Set(constants.NextCursorHeader, page.NextCursor)
+	}
+	ctx.Response().Header().Set(constants.TotalHintHeader, strconv.FormatInt(page.TotalHint, 10))
+}
+
+// Отдает аудиофайл трека по подписанной ссылке с поддержкой Range, ETag и Last-Modified.
+// Начало воспроизведения (файл целиком или диапазон с первого байта) засчитывается как прослушивание
+//
+//nolint:cyclop
+func (api *APIMicroservices) StreamTrack(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1564,7 +6634,6 @@ func (api *APIMicroservices) AddTrackToFavorites(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
-
 	userID, ok := ctx.Get("USER_ID").(int)
 	if !ok {
 		api.logger.Error(
@@ -1573,7 +6642,6 @@ func (api *APIMicroservices) AddTrackToFavorites(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
-
 	if userID == -1 {
 		api.logger.Info(
 			zap.String("ID", requestID),
@@ -1596,7 +6664,7 @@ func (api *APIMicroservices) AddTrackToFavorites(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	trackID, err := strconv.Atoi(ctx.Param("id"))
+	trackID, err := strconv.ParseInt(ctx.Param("trackID"), 10, 64)
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1605,62 +6673,43 @@ func (api *APIMicroservices) AddTrackToFavorites(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	_, err = api.musicMicroservice.AddTrackToFavorites(context.Background(), &music.AddTrackToFavoritesOptions{
-		UserID:  int64(userID),
-		TrackID: int64(trackID),
-	})
-	if err != nil {
-		return api.ParseErrorByCode(ctx, requestID, err)
-	}
-
-	response := &models.Response{
-		Status:  http.StatusCreated,
-		Message: constants.TrackAddedToFavoritesMessage,
-	}
-	jsonResponse, err := easyjson.Marshal(response)
-	if err != nil {
-		api.logger.Error(
+	quality := ctx.QueryParam("quality")
+	if len(quality) != 0 && quality != constants.StreamQualityLossy && quality != constants.StreamQualityLossless {
+		api.logger.Info(
 			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
-	}
-
-	api.logger.Info(
-		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusCreated),
-	)
-	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
-}
+			zap.String("MESSAGE", constants.StreamQualityInvalidMessage),
+			zap.Int("ANSWER STATUS", http.StatusBadRequest))
 
-//nolint:dupl
-func (api *APIMicroservices) DeleteTrackFromFavorites(ctx echo.Context) error {
-	requestID, ok := ctx.Get("REQUEST_ID").(string)
-	if !ok {
-		api.logger.Error(
-			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
-	}
+		response := &models.Response{
+			Status:  http.StatusBadRequest,
+			Message: constants.StreamQualityInvalidMessage,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
 
-	userID, ok := ctx.Get("USER_ID").(int)
-	if !ok {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	if userID == -1 {
+	err = api.mediaSigner.Verify(media.StreamPath+strconv.FormatInt(trackID, 10), ctx.QueryParams(), int64(userID), time.Now())
+	if err != nil {
+		message := constants.MediaURLInvalidMessage
+		if errors.Is(err, media.ErrURLExpired) {
+			message = constants.MediaURLExpiredMessage
+		}
 		api.logger.Info(
 			zap.String("ID", requestID),
-			zap.String("MESSAGE", constants.UserIsNotAuthorizedMessage),
-			zap.Int("ANSWER STATUS", http.StatusUnauthorized))
+			zap.String("MESSAGE", message),
+			zap.Int("ANSWER STATUS", http.StatusForbidden))
 
 		response := &models.Response{
-			Status:  http.StatusUnauthorized,
-			Message: constants.UserIsNotAuthorizedMessage,
+			Status:  http.StatusForbidden,
+			Message: message,
 		}
 		jsonResponse, err := easyjson.Marshal(response)
 		if err != nil {
@@ -1674,45 +6723,44 @@ func (api *APIMicroservices) DeleteTrackFromFavorites(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	trackID, err := strconv.Atoi(ctx.Param("id"))
-	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
-	}
-
-	_, err = api.musicMicroservice.DeleteTrackFromFavorites(context.Background(), &music.DeleteTrackFromFavoritesOptions{
-		UserID:  int64(userID),
-		TrackID: int64(trackID),
-	})
+	track, err := api.musicMicroservice.TrackFile(context.Background(), &music.TrackFileOptions{TrackID: trackID})
 	if err != nil {
 		return api.ParseErrorByCode(ctx, requestID, err)
 	}
 
-	response := &models.Response{
-		Status:  http.StatusCreated,
-		Message: constants.TrackDeletedFromFavoritesMessage,
+	// Lossless-версия отдается только по запросу и только если она есть у трека
+	extension, contentType := constants.TrackLossyExtension, constants.TrackLossyContentType
+	if quality == constants.StreamQualityLossless && track.Lossless {
+		extension, contentType = constants.TrackLosslessExtension, constants.TrackLosslessContentType
 	}
-	jsonResponse, err := easyjson.Marshal(response)
+
+	err = api.serveMedia(ctx, requestID, api.tracksStorage, track.File+extension, contentType)
 	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+		return err
+	}
+
+	// Засчитывается только отданный файл с первого байта: 304, ошибки и докачка не считаются
+	answerStatus := ctx.Response().Status
+	rangeHeader := ctx.Request().Header.Get("Range")
+	if ctx.Request().Method == http.MethodGet && (answerStatus == http.StatusOK ||
+		(answerStatus == http.StatusPartialContent && strings.HasPrefix(rangeHeader, "bytes=0-"))) {
+		_, err = api.musicMicroservice.IncrementListenCount(context.Background(), &music.IncrementListenCountOptions{
+			ID:     trackID,
+			UserID: int64(userID),
+			IP:     ctx.RealIP(),
+		})
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()))
+		}
 	}
 
-	api.logger.Info(
-		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusCreated),
-	)
-	return ctx.JSONBlob(http.StatusCreated, jsonResponse)
+	return nil
 }
 
-//nolint:dupl
-func (api *APIMicroservices) GetUserFavorites(ctx echo.Context) error {
+// Отдает обложку приватного плейлиста по подписанной ссылке
+func (api *APIMicroservices) GetPlaylistArtwork(ctx echo.Context) error {
 	requestID, ok := ctx.Get("REQUEST_ID").(string)
 	if !ok {
 		api.logger.Error(
@@ -1728,7 +6776,6 @@ func (api *APIMicroservices) GetUserFavorites(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
-
 	if userID == -1 {
 		api.logger.Info(
 			zap.String("ID", requestID),
@@ -1751,53 +6798,49 @@ func (api *APIMicroservices) GetUserFavorites(ctx echo.Context) error {
 		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	tracksListProto, err := api.musicMicroservice.GetFavoriteTracks(context.Background(),
-		&music.UserFavoritesOptions{UserID: int64(userID)})
+	artwork := ctx.Param("artwork")
+	var err error
+	err = api.mediaSigner.Verify(media.PlaylistArtworkPath+artwork, ctx.QueryParams(), int64(userID), time.Now())
 	if err != nil {
-		return api.ParseErrorByCode(ctx, requestID, err)
-	}
+		message := constants.MediaURLInvalidMessage
+		if errors.Is(err, media.ErrURLExpired) {
+			message = constants.MediaURLExpiredMessage
+		}
+		api.logger.Info(
+			zap.String("ID", requestID),
+			zap.String("MESSAGE", message),
+			zap.Int("ANSWER STATUS", http.StatusForbidden))
 
-	tracks := models.Tracks{}
-	for _, current := range tracksListProto.Tracks {
-		var track models.Track
-		track.BindProto(current)
-		tracks = append(tracks, track)
-	}
+		response := &models.Response{
+			Status:  http.StatusForbidden,
+			Message: message,
+		}
+		jsonResponse, err := easyjson.Marshal(response)
+		if err != nil {
+			api.logger.Error(
+				zap.String("ID", requestID),
+				zap.String("ERROR", err.Error()),
+				zap.Int("ANSWER STATUS", http.StatusInternalServerError))
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
 
-	jsonTracks, err := easyjson.Marshal(tracks)
-	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+		return ctx.JSONBlob(http.StatusOK, jsonResponse)
 	}
 
-	api.logger.Info(
-		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusOK),
-	)
-	return ctx.JSONBlob(http.StatusOK, jsonTracks)
+	return api.serveMedia(ctx, requestID, api.artworksStorage, artwork, "")
 }
 
-func (api *APIMicroservices) DeletePlaylistArtwork(ctx echo.Context) error {
-	requestID, ok := ctx.Get("REQUEST_ID").(string)
-	if !ok {
-		api.logger.Error(
-			zap.String("ERROR", constants.RequestIDTypeAssertionFailed),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
-	}
-	userID, ok := ctx.Get("USER_ID").(int)
-	if !ok {
-		api.logger.Error(
+// Отдает файл из хранилища: Range, If-Range и условные запросы обрабатывает http.ServeContent,
+// при пустом contentType тип определяется по расширению
+func (api *APIMicroservices) serveMedia(ctx echo.Context, requestID string, storage http.FileSystem, name string, contentType string) error {
+	file, err := storage.Open("/" + name)
+	if os.IsNotExist(err) {
+		api.logger.Info(
 			zap.String("ID", requestID),
-			zap.String("ERROR", constants.UserIDTypeAssertionFailed),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+			zap.String("MESSAGE", constants.MediaFileNotFoundMessage),
+			zap.Int("ANSWER STATUS", http.StatusNotFound))
+		return ctx.NoContent(http.StatusNotFound)
 	}
-
-	playlistID, err := strconv.Atoi(ctx.FormValue("id"))
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1805,21 +6848,10 @@ func (api *APIMicroservices) DeletePlaylistArtwork(ctx echo.Context) error {
 			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
-
-	oldArtworkProto, err := api.playlistsMicroservice.DeletePlaylistArtwork(context.Background(), &playlists.DeletePlaylistArtworkOptions{
-		PlaylistID: int64(playlistID),
-		UserID:     int64(userID),
-	})
-	if err != nil {
-		return api.ParseErrorByCode(ctx, requestID, err)
-	}
-
-	err = api.imageService.DeleteImages(
-		os.Getenv("PLAYLIST_FULL_PREFIX"),
-		oldArtworkProto.OldArtworkFilename,
-		[]string{constants.PlaylistArtworkExtension100px, constants.PlaylistArtworkExtension384px},
-		constants.PlaylistArtworkDefaultFilename,
-	)
+	defer func() {
+		_ = file.Close()
+	}()
+	info, err := file.Stat()
 	if err != nil {
 		api.logger.Error(
 			zap.String("ID", requestID),
@@ -1828,21 +6860,18 @@ func (api *APIMicroservices) DeletePlaylistArtwork(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
-	response := &models.PlaylistArtworkColor{ArtworkColor: constants.PlaylistArtworkDefaultColor}
-	jsonPlaylistArtworkColor, err := easyjson.Marshal(response)
-	if err != nil {
-		api.logger.Error(
-			zap.String("ID", requestID),
-			zap.String("ERROR", err.Error()),
-			zap.Int("ANSWER STATUS", http.StatusInternalServerError))
-		return ctx.NoContent(http.StatusInternalServerError)
+	if len(contentType) != 0 {
+		ctx.Response().Header().Set(echo.HeaderContentType, contentType)
 	}
+	ctx.Response().Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()))
+	ctx.Response().Header().Set("Cache-Control", "private, max-age=0, must-revalidate")
+	http.ServeContent(ctx.Response(), ctx.Request(), info.Name(), info.ModTime(), file)
 
 	api.logger.Info(
 		zap.String("ID", requestID),
-		zap.Int("ANSWER STATUS", http.StatusOK),
+		zap.Int("ANSWER STATUS", ctx.Response().Status),
 	)
-	return ctx.JSONBlob(http.StatusOK, jsonPlaylistArtworkColor)
+	return nil
 }
 
 func (api *APIMicroservices) Init(server *echo.Echo) {
@@ -1855,20 +6884,64 @@ func (api *APIMicroservices) Init(server *echo.Echo) {
 	// Profile
 	server.GET("/api/v1/user/settings", api.GetSettings)
 	server.PATCH("/api/v1/user/settings", api.UpdateSettings)
+	server.GET("/api/v1/user/recap/:year", api.GetRecap)
+	server.POST("/api/v1/user/recap/:year/playlist", api.SaveRecapPlaylist)
 
 	// Music
 	server.GET("/api/v1/home/tracks", api.GetHomeTracks)
 	server.GET("/api/v1/home/albums", api.GetHomeAlbums)
 	server.GET("/api/v1/home/artists", api.GetHomeArtists)
 	server.GET("/api/v1/artist/:id", api.GetArtistProfile)
+	server.GET("/api/v1/artist/:id/tracks", api.GetArtistTracks)
+	server.GET("/api/v1/artist/:id/albums", api.GetArtistAlbums)
 	server.GET("/api/v1/album/:id", api.GetAlbumPage)
 	server.POST("/api/v1/inc_listencount", api.IncrementListenCount)
 	server.GET("/api/v1/music/search", api.SearchMusic)
+	server.GET("/api/v1/music/search/tracks", api.SearchTracks)
+	server.GET("/api/v1/music/search/albums", api.SearchAlbums)
+	server.GET("/api/v1/music/search/artists", api.SearchArtists)
+	server.GET("/api/v1/music/search/playlists", api.SearchPlaylists)
+	server.GET("/api/v1/music/suggest", api.Suggest)
+	server.GET("/api/v1/music/search/recent", api.GetRecentSearches)
+	server.POST("/api/v1/music/search/recent", api.RecordSearchResult)
+	server.DELETE("/api/v1/music/search/recent", api.ClearRecentSearches)
+	server.DELETE("/api/v1/music/search/recent/:id", api.DeleteRecentSearch)
+	server.GET("/api/v1/stream/:trackID", api.StreamTrack)
+	server.HEAD("/api/v1/stream/:trackID", api.StreamTrack)
+	server.GET("/api/v1/media/playlists/:artwork", api.GetPlaylistArtwork)
 	server.GET("/api/v1/playlists", api.GetUserPlaylists)
 	server.GET("/api/v1/playlists/:id", api.GetPlaylistPage)
 	server.POST("api/v1/track/like/:id", api.AddTrackToFavorites)
 	server.DELETE("api/v1/track/like/:id", api.DeleteTrackFromFavorites)
 	server.GET("api/v1/track/favorites", api.GetUserFavorites)
+	server.POST("/api/v1/track/dislike/:id", api.DislikeTrack)
+	server.DELETE("/api/v1/track/dislike/:id", api.DeleteTrackDislike)
+	server.GET("/api/v1/radio", api.Radio)
+	server.POST("/api/v1/album/save/:id", api.SaveAlbum)
+	server.DELETE("/api/v1/album/save/:id", api.UnsaveAlbum)
+	server.GET("/api/v1/library/albums", api.GetSavedAlbums)
+	server.POST("/api/v1/artist/follow/:id", api.FollowArtist)
+	server.DELETE("/api/v1/artist/follow/:id", api.UnfollowArtist)
+	server.GET("/api/v1/library/artists", api.GetFollowedArtists)
+	server.GET("/api/v1/library/releases", api.GetReleaseRadar)
+	server.GET("/api/v1/charts/:period", api.GetCharts)
+	server.GET("/api/v1/charts/:period/:entity", api.GetCharts)
+	server.GET("/api/v1/genres", api.GetGenres)
+	server.GET("/api/v1/genre/:id", api.GetGenrePage)
+	server.GET("/api/v1/track/:id", api.GetTrack)
+	server.GET("/api/v1/tracks", api.GetTracks)
+	server.GET("/api/v1/track/:id/lyrics", api.GetTrackLyrics)
+
+	// Player
+	server.GET("/api/v1/queue", api.GetQueue)
+	server.PUT("/api/v1/queue", api.UpdateQueue)
+	server.GET("/api/v1/queue/events", api.QueueEvents)
+	server.GET("/api/v1/devices", api.GetDevices)
+	server.POST("/api/v1/devices", api.RegisterDevice)
+	server.GET("/api/v1/devices/:id/commands", api.DeviceCommands)
+
+	// События
+	server.GET("/api/v1/events", api.Events)
 
 	// Playlists
 	server.POST("/api/v1/playlists", api.CreatePlaylist)
@@ -1878,6 +6951,24 @@ func (api *APIMicroservices) Init(server *echo.Echo) {
 	server.DELETE("/api/v1/playlist/track", api.DeleteTrack)
 	server.DELETE("/api/v1/playlist/artwork", api.DeletePlaylistArtwork)
 
+	// Admin
+	admin := server.Group("/api/v1/admin", api.RequireAdmin)
+	admin.POST("/artists", api.CreateCatalogArtist)
+	admin.PUT("/artists/:id", api.UpdateCatalogArtist)
+	admin.DELETE("/artists/:id", api.DeleteCatalogArtist)
+	admin.POST("/albums", api.CreateCatalogAlbum)
+	admin.PUT("/albums/:id", api.UpdateCatalogAlbum)
+	admin.DELETE("/albums/:id", api.DeleteCatalogAlbum)
+	admin.POST("/tracks", api.CreateCatalogTrack)
+	admin.PUT("/tracks/:id", api.UpdateCatalogTrack)
+	admin.DELETE("/tracks/:id", api.DeleteCatalogTrack)
+	admin.PUT("/tracks/:id/lyrics", api.SetCatalogLyrics)
+	admin.DELETE("/tracks/:id/lyrics", api.DeleteCatalogLyrics)
+	admin.POST("/genres", api.CreateCatalogGenre)
+	admin.PUT("/genres/:id", api.UpdateCatalogGenre)
+	admin.DELETE("/genres/:id", api.DeleteCatalogGenre)
+	admin.GET("/listens/suspicious", api.GetSuspiciousAccounts)
+
 	// CSRF
 	server.GET("/api/v1/csrf", api.GenerateCSRF)
 }
@@ -1,8 +1,10 @@
 package delivery
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -16,16 +18,23 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/net/websocket"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
+	"2021_2_LostPointer/internal/media"
 	authorizationMock "2021_2_LostPointer/internal/microservices/authorization/mock"
 	authMicroservice "2021_2_LostPointer/internal/microservices/authorization/proto"
 	authorizationProto "2021_2_LostPointer/internal/microservices/authorization/proto"
+	catalogMock "2021_2_LostPointer/internal/microservices/catalog/mock"
+	catalogMicroservice "2021_2_LostPointer/internal/microservices/catalog/proto"
 	musicMock "2021_2_LostPointer/internal/microservices/music/mock"
 	musicMicroservice "2021_2_LostPointer/internal/microservices/music/proto"
+	playerMock "2021_2_LostPointer/internal/microservices/player/mock"
+	playerMicroservice "2021_2_LostPointer/internal/microservices/player/proto"
 	playlistsMock "2021_2_LostPointer/internal/microservices/playlists/mock"
 	playlistsMicroservice "2021_2_LostPointer/internal/microservices/playlists/proto"
 	profileMock "2021_2_LostPointer/internal/microservices/profile/mock"
@@ -133,7 +142,7 @@ func TestAPIMicroservices_Login(t *testing.T) {
 			controller := gomock.NewController(t)
 			authManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.Login(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -234,7 +243,7 @@ func TestAPIMicroservices_Register(t *testing.T) {
 			controller := gomock.NewController(t)
 			authManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.Register(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -352,7 +361,7 @@ func TestAPIMicroservices_GetUserAvatar(t *testing.T) {
 			controller := gomock.NewController(t)
 			authManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetUserAvatar(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -463,7 +472,7 @@ func TestAPIMicroservices_Logout(t *testing.T) {
 			controller := gomock.NewController(t)
 			authManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManagerMock, profileManager, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.Logout(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -508,11 +517,11 @@ func TestAPIMicroservices_GetSettings(t *testing.T) {
 			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *profileMock.MockProfileClient {
 				moq := profileMock.NewMockProfileClient(controller)
-				moq.EXPECT().GetSettings(gomock.Any(), &profileProto.GetSettingsOptions{ID: ID}).Return(&profileProto.UserSettings{}, nil)
+				moq.EXPECT().GetSettings(gomock.Any(), &profileProto.GetSettingsOptions{ID: ID}).Return(&profileProto.UserSettings{SaveSearchHistory: true}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{}",
+			expectedJSON:   "{\"save_search_history\":true}",
 		},
 		{
 			name: "Handler returned status 400",
@@ -581,7 +590,7 @@ func TestAPIMicroservices_GetSettings(t *testing.T) {
 			controller := gomock.NewController(t)
 			profileManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManagerMock, musicManager, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManagerMock, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetSettings(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -679,7 +688,7 @@ func TestAPIMicroservices_GenerateCSRF(t *testing.T) {
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
 			imageServices := image.NewImagesService()
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GenerateCSRF(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 			}
@@ -716,6 +725,8 @@ func TestAPIMicroservices_GetHomeTracks(t *testing.T) {
 		doNotSetRequestID bool
 		doNotSetUserID    bool
 		userID            int
+		query             string
+		expectedSeed      string
 	}{
 		{
 			name: "Handler returned status 200",
@@ -779,13 +790,49 @@ func TestAPIMicroservices_GetHomeTracks(t *testing.T) {
 			expectedJSON:   "[]",
 			userID:         -1,
 		},
+		{
+			name: "Seeded page",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().RandomTracks(gomock.Any(), &musicMicroservice.RandomTracksOptions{
+					Amount:       constants.HomePageTracksSelectionAmount,
+					IsAuthorized: true,
+					Seed:         42,
+					Generation:   3,
+					Page:         2,
+				}).Return(&musicMicroservice.Tracks{Selection: &musicMicroservice.Selection{Seed: 42, Generation: 3}}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[]",
+			query:          "?seed=42&generation=3&page=2",
+			expectedSeed:   "42",
+		},
+		{
+			name: "Invalid seed",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Seed, generation and page must be integers\"}",
+			query:          "?seed=abc",
+		},
+		{
+			name: "Invalid generation",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Seed, generation and page must be integers\"}",
+			query:          "?seed=42&generation=abc",
+		},
 	}
 
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/home/tracks",
+			req := httptest.NewRequest(echo.GET, "/api/v1/home/tracks"+currentTest.query,
 				strings.NewReader(""))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
@@ -806,10 +853,14 @@ func TestAPIMicroservices_GetHomeTracks(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetHomeTracks(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+				if len(currentTest.expectedSeed) != 0 {
+					assert.Equal(t, currentTest.expectedSeed, rec.Header().Get(constants.SelectionSeedHeader))
+					assert.Equal(t, "3", rec.Header().Get(constants.SelectionGenerationHeader))
+				}
 			}
 		})
 	}
@@ -842,12 +893,14 @@ func TestAPIMicroservices_GetHomeAlbums(t *testing.T) {
 		expectedStatus    int
 		expectedJSON      string
 		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
 	}{
 		{
 			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount}).
+				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount, IsAuthorized: true}).
 					Return(&musicMicroservice.Albums{Albums: []*musicMicroservice.Album{{}}}, nil)
 				return moq
 			},
@@ -858,7 +911,7 @@ func TestAPIMicroservices_GetHomeAlbums(t *testing.T) {
 			name: "Handler returned status 400",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount, IsAuthorized: true}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
@@ -868,12 +921,32 @@ func TestAPIMicroservices_GetHomeAlbums(t *testing.T) {
 			name: "No RequestID",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount, IsAuthorized: true}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
 				return moq
 			},
 			expectedStatus:    http.StatusInternalServerError,
 			doNotSetRequestID: true,
 		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User is unauthorized",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().RandomAlbums(gomock.Any(), &musicMicroservice.RandomAlbumsOptions{Amount: constants.HomePageAlbumsSelectionAmount, UserID: -1}).
+					Return(&musicMicroservice.Albums{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[]",
+			userID:         -1,
+		},
 	}
 
 	for _, test := range tests {
@@ -889,6 +962,9 @@ func TestAPIMicroservices_GetHomeAlbums(t *testing.T) {
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -898,7 +974,7 @@ func TestAPIMicroservices_GetHomeAlbums(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetHomeAlbums(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -934,12 +1010,14 @@ func TestAPIMicroservices_GetHomeArtists(t *testing.T) {
 		expectedStatus    int
 		expectedJSON      string
 		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
 	}{
 		{
 			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount}).
+				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount, IsAuthorized: true}).
 					Return(&musicMicroservice.Artists{Artists: []*musicMicroservice.Artist{{
 						Tracks: []*musicMicroservice.Track{},
 						Albums: []*musicMicroservice.Album{},
@@ -953,7 +1031,7 @@ func TestAPIMicroservices_GetHomeArtists(t *testing.T) {
 			name: "Handler returned status 400",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount, IsAuthorized: true}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
@@ -963,12 +1041,32 @@ func TestAPIMicroservices_GetHomeArtists(t *testing.T) {
 			name: "No RequestID",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount, IsAuthorized: true}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
 				return moq
 			},
 			expectedStatus:    http.StatusInternalServerError,
 			doNotSetRequestID: true,
 		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User is unauthorized",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().RandomArtists(gomock.Any(), &musicMicroservice.RandomArtistsOptions{Amount: constants.HomePageArtistsSelectionAmount, UserID: -1}).
+					Return(&musicMicroservice.Artists{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[]",
+			userID:         -1,
+		},
 	}
 
 	for _, test := range tests {
@@ -984,6 +1082,9 @@ func TestAPIMicroservices_GetHomeArtists(t *testing.T) {
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -993,7 +1094,7 @@ func TestAPIMicroservices_GetHomeArtists(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetHomeArtists(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -1145,7 +1246,7 @@ func TestAPIMicroservices_GetArtistProfile(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetArtistProfile(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -1175,49 +1276,21 @@ func TestAPIMicroservices_IncrementListenCount(t *testing.T) {
 		grpc.WithInsecure(),
 	)
 
-	var ID int64 = 1
-
 	tests := []struct {
 		name              string
-		mock              func(*gomock.Controller) *musicMock.MockMusicClient
 		expectedStatus    int
 		expectedJSON      string
 		doNotSetRequestID bool
-		trackID           int64
 	}{
 		{
-			name: "Handler returned status 200",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().IncrementListenCount(gomock.Any(), &musicMicroservice.IncrementListenCountOptions{ID: ID}).
-					Return(&musicMicroservice.IncrementListenCountEmpty{}, nil)
-				return moq
-			},
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":200,\"message\":\"Incremented track listen count\"}",
-			trackID:        ID,
-		},
-		{
-			name: "Handler returned status 400",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().IncrementListenCount(gomock.Any(), &musicMicroservice.IncrementListenCountOptions{ID: ID}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
-				return moq
-			},
+			name:           "Handler returned status 200 without counting listen",
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			trackID:        ID,
+			expectedJSON:   "{\"status\":200,\"message\":\"Listens are counted when the track is streamed\"}",
 		},
 		{
-			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().IncrementListenCount(gomock.Any(), &musicMicroservice.IncrementListenCountOptions{ID: ID}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
+			name:              "No RequestID",
 			expectedStatus:    http.StatusInternalServerError,
 			doNotSetRequestID: true,
-			trackID:           ID,
 		},
 	}
 
@@ -1226,7 +1299,7 @@ func TestAPIMicroservices_IncrementListenCount(t *testing.T) {
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
 			req := httptest.NewRequest(echo.POST, "/api/v1/inc_listencount",
-				strings.NewReader(fmt.Sprintf(`{"id": %d}`, currentTest.trackID)))
+				strings.NewReader(`{"id": 1}`))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
@@ -1234,6 +1307,7 @@ func TestAPIMicroservices_IncrementListenCount(t *testing.T) {
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
+			ctx.Set("USER_ID", 1)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -1241,12 +1315,15 @@ func TestAPIMicroservices_IncrementListenCount(t *testing.T) {
 			imageServices := image.NewImagesService()
 
 			controller := gomock.NewController(t)
-			musicManagerMock := currentTest.mock(controller)
+			musicManagerMock := musicMock.NewMockMusicClient(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.IncrementListenCount(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+				if !currentTest.doNotSetRequestID {
+					assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+				}
 			}
 		})
 	}
@@ -1290,6 +1367,7 @@ func TestAPIMicroservices_GetAlbumPage(t *testing.T) {
 				moq := musicMock.NewMockMusicClient(controller)
 				moq.EXPECT().AlbumPage(gomock.Any(), &musicMicroservice.AlbumPageOptions{
 					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{},
 				}).
 					Return(&musicMicroservice.AlbumPageResponse{
 						Artist: &musicMicroservice.Artist{},
@@ -1306,6 +1384,7 @@ func TestAPIMicroservices_GetAlbumPage(t *testing.T) {
 				moq := musicMock.NewMockMusicClient(controller)
 				moq.EXPECT().AlbumPage(gomock.Any(), &musicMicroservice.AlbumPageOptions{
 					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{},
 				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
 				return moq
 			},
@@ -1376,7 +1455,7 @@ func TestAPIMicroservices_GetAlbumPage(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.GetAlbumPage(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -1423,6 +1502,8 @@ func TestAPIMicroservices_SearchMusic(t *testing.T) {
 				moq.EXPECT().Find(gomock.Any(), &musicMicroservice.FindOptions{
 					Text:         "testText",
 					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{},
+					Filter:       &musicMicroservice.SearchFilter{},
 				}).
 					Return(&musicMicroservice.FindResponse{
 						Tracks:  []*musicMicroservice.Track{},
@@ -1441,6 +1522,8 @@ func TestAPIMicroservices_SearchMusic(t *testing.T) {
 				moq := musicMock.NewMockMusicClient(controller)
 				moq.EXPECT().Find(gomock.Any(), &musicMicroservice.FindOptions{
 					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{},
+					Filter:       &musicMicroservice.SearchFilter{},
 				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
 				return moq
 			},
@@ -1501,7 +1584,7 @@ func TestAPIMicroservices_SearchMusic(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
 			if assert.NoError(t, r.SearchMusic(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
@@ -1510,7 +1593,7 @@ func TestAPIMicroservices_SearchMusic(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_AddTrack(t *testing.T) {
+func TestAPIMicroservices_SearchTracks(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -1526,99 +1609,87 @@ func TestAPIMicroservices_AddTrack(t *testing.T) {
 		os.Getenv("PROFILE_HOST"),
 		grpc.WithInsecure(),
 	)
-	musicConn, _ := grpc.Dial(
-		os.Getenv("MUSIC_HOST"),
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
 		grpc.WithInsecure(),
 	)
 
+	lossless := true
+
 	tests := []struct {
 		name              string
-		mock              func(*gomock.Controller) *playlistsMock.MockPlaylistsClient
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
 		expectedStatus    int
 		expectedJSON      string
 		doNotSetRequestID bool
-		doNotSetUserID    bool
 		userID            int
-		playlistID        int64
-		trackID           int64
+		query             string
 	}{
 		{
-			name: "Handler returned status 201",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).
-					Return(&playlistsMicroservice.AddTrackResponse{}, nil)
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().SearchTracks(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:         "testText",
+					UserID:       1,
+					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{Limit: 5, Cursor: "cursor"},
+					Filter: &musicMicroservice.SearchFilter{
+						GenreID:    2,
+						YearFrom:   2010,
+						DurationTo: 300,
+						Lossless:   &lossless,
+					},
+				}).Return(&musicMicroservice.Tracks{Tracks: []*musicMicroservice.Track{}}, nil)
 				return moq
 			},
-			expectedStatus: http.StatusCreated,
-			expectedJSON:   "{\"status\":201,\"message\":\"Track was successfully added to playlist\"}",
-			trackID:        2,
-			playlistID:     1,
-			userID:         3,
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[]",
+			userID:         1,
+			query:          "?text=testText&limit=5&cursor=cursor&genre=2&year_from=2010&duration_to=300&lossless=true",
 		},
 		{
 			name: "Handler returned status 400",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().SearchTracks(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:   "testText",
+					UserID: -1,
+					Page:   &musicMicroservice.PageRequest{},
+					Filter: &musicMicroservice.SearchFilter{},
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			trackID:        2,
-			playlistID:     1,
-			userID:         3,
+			expectedJSON:   "{\"status\":400,\"message\":\"Invalid cursor\"}",
+			userID:         -1,
+			query:          "?text=testText",
 		},
 		{
-			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+			name: "Wrong type of limit",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
 			},
-			expectedStatus:    http.StatusInternalServerError,
-			doNotSetRequestID: true,
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			query:          "?text=testText&limit=qwe",
 		},
 		{
-			name: "No UserID",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+			name: "Wrong type of filter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
+			userID:         1,
+			query:          "?text=testText&year_from=qwe",
 		},
 		{
-			name: "User unauthorized -> UserID = -1",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
 			},
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
-			userID:         -1,
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
 		},
 	}
 
@@ -1626,29 +1697,26 @@ func TestAPIMicroservices_AddTrack(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.POST, "/api/v1/playlist/track",
-				strings.NewReader(fmt.Sprintf(`{"track_id": %d, "playlist_id": %d}`, currentTest.trackID, currentTest.playlistID)))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search/tracks"+currentTest.query,
+				strings.NewReader(""))
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
-			if !currentTest.doNotSetUserID {
-				ctx.Set("USER_ID", currentTest.userID)
-			}
+			ctx.Set("USER_ID", currentTest.userID)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
-			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
 			imageServices := image.NewImagesService()
 
 			controller := gomock.NewController(t)
-			playlistsManagerMock := currentTest.mock(controller)
+			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManagerMock)
-			if assert.NoError(t, r.AddTrack(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.SearchTracks(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -1656,7 +1724,7 @@ func TestAPIMicroservices_AddTrack(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_DeletePlaylist(t *testing.T) {
+func TestAPIMicroservices_SearchAlbums(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -1672,129 +1740,96 @@ func TestAPIMicroservices_DeletePlaylist(t *testing.T) {
 		os.Getenv("PROFILE_HOST"),
 		grpc.WithInsecure(),
 	)
-	musicConn, _ := grpc.Dial(
-		os.Getenv("MUSIC_HOST"),
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
 		grpc.WithInsecure(),
 	)
 
 	tests := []struct {
 		name              string
-		mock              func(*gomock.Controller) *playlistsMock.MockPlaylistsClient
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
 		expectedStatus    int
 		expectedJSON      string
 		doNotSetRequestID bool
-		doNotSetUserID    bool
 		userID            int
-		playlistID        int64
-		trackID           int64
+		query             string
 	}{
 		{
 			name: "Handler returned status 200",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).
-					Return(&playlistsMicroservice.DeleteTrackResponse{}, nil)
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().SearchAlbums(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:         "testText",
+					UserID:       1,
+					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{Limit: 5, Cursor: "cursor"},
+				}).Return(&musicMicroservice.Albums{Albums: []*musicMicroservice.Album{{ID: 1, Title: "testTitle"}}}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":200,\"message\":\"Track was successfully deleted from playlist\"}",
-			trackID:        2,
-			playlistID:     1,
-			userID:         3,
+			expectedJSON:   "[{\"id\":1,\"title\":\"testTitle\"}]",
+			userID:         1,
+			query:          "?text=testText&limit=5&cursor=cursor",
 		},
 		{
 			name: "Handler returned status 400",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().SearchAlbums(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:   "testText",
+					UserID: -1,
+					Page:   &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			trackID:        2,
-			playlistID:     1,
-			userID:         3,
+			expectedJSON:   "{\"status\":400,\"message\":\"Invalid cursor\"}",
+			userID:         -1,
+			query:          "?text=testText",
+		},
+		{
+			name: "Wrong type of limit",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			query:          "?text=testText&limit=qwe",
 		},
 		{
 			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus:    http.StatusInternalServerError,
 			doNotSetRequestID: true,
 		},
-		{
-			name: "No UserID",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
-		},
-		{
-			name: "User unauthorized -> UserID = -1",
-			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
-				moq := playlistsMock.NewMockPlaylistsClient(controller)
-				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
-					PlaylistID: 1,
-					TrackID:    2,
-					UserID:     3,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
-			userID:         -1,
-		},
 	}
 
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.DELETE, "/api/v1/playlist/track",
-				strings.NewReader(fmt.Sprintf(`{"track_id": %d, "playlist_id": %d}`, currentTest.trackID, currentTest.playlistID)))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search/albums"+currentTest.query,
+				strings.NewReader(""))
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
-			if !currentTest.doNotSetUserID {
-				ctx.Set("USER_ID", currentTest.userID)
-			}
+			ctx.Set("USER_ID", currentTest.userID)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
-			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
 			imageServices := image.NewImagesService()
 
 			controller := gomock.NewController(t)
-			playlistsManagerMock := currentTest.mock(controller)
+			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManagerMock)
-			if assert.NoError(t, r.DeleteTrack(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.SearchAlbums(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -1802,7 +1837,7 @@ func TestAPIMicroservices_DeletePlaylist(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_GetUserPlaylists(t *testing.T) {
+func TestAPIMicroservices_SearchArtists(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -1829,74 +1864,58 @@ func TestAPIMicroservices_GetUserPlaylists(t *testing.T) {
 		expectedStatus    int
 		expectedJSON      string
 		doNotSetRequestID bool
-		doNotSetUserID    bool
 		userID            int
+		query             string
 	}{
 		{
 			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
-					UserID: 1,
-				}).
-					Return(&musicMicroservice.PlaylistsData{
-						Playlists: []*musicMicroservice.PlaylistData{},
-					}, nil)
+				moq.EXPECT().SearchArtists(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:         "testText",
+					UserID:       1,
+					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{Limit: 5, Cursor: "cursor"},
+				}).Return(&musicMicroservice.Artists{Artists: []*musicMicroservice.Artist{}}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{}",
+			expectedJSON:   "[]",
 			userID:         1,
+			query:          "?text=testText&limit=5&cursor=cursor",
 		},
 		{
 			name: "Handler returned status 400",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
-					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				moq.EXPECT().SearchArtists(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:   "testText",
+					UserID: -1,
+					Page:   &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			userID:         1,
-		},
-		{
-			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
-					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus:    http.StatusInternalServerError,
-			doNotSetRequestID: true,
+			expectedJSON:   "{\"status\":400,\"message\":\"Invalid cursor\"}",
+			userID:         -1,
+			query:          "?text=testText",
 		},
 		{
-			name: "No UserID",
+			name: "Wrong type of limit",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
-					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
+			userID:         1,
+			query:          "?text=testText&limit=qwe",
 		},
 		{
-			name: "User is unauthorized => userId = -1",
+			name: "No RequestID",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
-					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
-			userID:         -1,
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
 		},
 	}
 
@@ -1904,18 +1923,15 @@ func TestAPIMicroservices_GetUserPlaylists(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/playlists",
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search/artists"+currentTest.query,
 				strings.NewReader(""))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
-			if !currentTest.doNotSetUserID {
-				ctx.Set("USER_ID", currentTest.userID)
-			}
+			ctx.Set("USER_ID", currentTest.userID)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -1925,8 +1941,8 @@ func TestAPIMicroservices_GetUserPlaylists(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
-			if assert.NoError(t, r.GetUserPlaylists(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.SearchArtists(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -1934,7 +1950,7 @@ func TestAPIMicroservices_GetUserPlaylists(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_GetPlaylistPage(t *testing.T) {
+func TestAPIMicroservices_SearchPlaylists(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -1956,101 +1972,63 @@ func TestAPIMicroservices_GetPlaylistPage(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                 string
-		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
-		expectedStatus       int
-		expectedJSON         string
-		doNotSetRequestID    bool
-		doNotSetUserID       bool
-		userID               int
-		playlistID           int64
-		wrongTypeOfParameter bool
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		userID            int
+		query             string
 	}{
 		{
 			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
-					PlaylistID: 2,
-					UserID:     1,
-				}).
-					Return(&musicMicroservice.PlaylistPageResponse{
-						PlaylistID: 2,
-						Title:      "testTitle",
-					}, nil)
+				moq.EXPECT().SearchPlaylists(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:         "testText",
+					UserID:       1,
+					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{Limit: 5, Cursor: "cursor"},
+				}).Return(&musicMicroservice.PlaylistsData{Playlists: []*musicMicroservice.PlaylistData{{PlaylistID: 1, Title: "testTitle", IsPublic: true}}}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"id\":2,\"title\":\"testTitle\"}",
+			expectedJSON:   "{\"playlists\":[{\"id\":1,\"title\":\"testTitle\",\"is_public\":true}]}",
 			userID:         1,
-			playlistID:     2,
+			query:          "?text=testText&limit=5&cursor=cursor",
 		},
 		{
 			name: "Handler returned status 400",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
-					PlaylistID: 2,
-					UserID:     1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				moq.EXPECT().SearchPlaylists(gomock.Any(), &musicMicroservice.FindOptions{
+					Text:   "testText",
+					UserID: -1,
+					Page:   &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			userID:         1,
-			playlistID:     2,
-		},
-		{
-			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
-					PlaylistID: 2,
-					UserID:     1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus:    http.StatusInternalServerError,
-			doNotSetRequestID: true,
+			expectedJSON:   "{\"status\":400,\"message\":\"Invalid cursor\"}",
+			userID:         -1,
+			query:          "?text=testText",
 		},
 		{
-			name: "No UserID",
+			name: "Wrong type of limit",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
-					PlaylistID: 2,
-					UserID:     1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
-		},
-		{
-			name: "User is unauthorized => userId = -1",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
-					UserID: -1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			userID:         -1,
+			userID:         1,
+			query:          "?text=testText&limit=qwe",
 		},
 		{
-			name: "Wrong type of param in query",
+			name: "No RequestID",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
-					PlaylistID: 2,
-					UserID:     1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
-			expectedStatus:       http.StatusInternalServerError,
-			wrongTypeOfParameter: true,
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
 		},
 	}
 
@@ -2058,25 +2036,15 @@ func TestAPIMicroservices_GetPlaylistPage(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/playlists/:id",
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search/playlists"+currentTest.query,
 				strings.NewReader(""))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
-			ctx.SetParamNames("id")
-			if currentTest.wrongTypeOfParameter {
-				ctx.SetParamValues("qwe!123scd")
-			} else {
-				ctx.SetParamValues(strconv.FormatInt(currentTest.playlistID, 10))
-			}
-
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
-			if !currentTest.doNotSetUserID {
-				ctx.Set("USER_ID", currentTest.userID)
-			}
+			ctx.Set("USER_ID", currentTest.userID)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -2086,8 +2054,8 @@ func TestAPIMicroservices_GetPlaylistPage(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
-			if assert.NoError(t, r.GetPlaylistPage(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.SearchPlaylists(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -2095,7 +2063,7 @@ func TestAPIMicroservices_GetPlaylistPage(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_ParseErrorByCode(t *testing.T) {
+func TestAPIMicroservices_Suggest(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -2115,49 +2083,68 @@ func TestAPIMicroservices_ParseErrorByCode(t *testing.T) {
 		os.Getenv("PLAYLISTS_HOST"),
 		grpc.WithInsecure(),
 	)
-	musicConn, _ := grpc.Dial(
-		os.Getenv("MUSIC_HOST"),
-		grpc.WithInsecure(),
-	)
 
 	tests := []struct {
-		name           string
-		expectedStatus int
-		expectedJSON   string
-		requestID      string
-		error          error
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		userID            int
+		query             string
 	}{
 		{
-			name:           "Parse 500 error",
-			expectedStatus: http.StatusInternalServerError,
-			requestID:      "1",
-			error:          status.Error(codes.Internal, errors.New("error").Error()),
-		},
-		{
-			name:           "Parse 400 error",
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Suggest(gomock.Any(), &musicMicroservice.SuggestOptions{
+					Prefix:       "la",
+					UserID:       1,
+					IsAuthorized: true,
+					Limit:        5,
+				}).Return(&musicMicroservice.Suggestions{Suggestions: []*musicMicroservice.Suggestion{{
+					Type:       constants.SuggestionTypeArtist,
+					ID:         1,
+					Title:      "Lahaine",
+					Highlights: []*musicMicroservice.MatchRange{{Start: 0, End: 2}},
+				}}}, nil)
+				return moq
+			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			requestID:      "1",
-			error:          status.Error(codes.InvalidArgument, errors.New("error").Error()),
+			expectedJSON:   "[{\"type\":\"artist\",\"id\":1,\"title\":\"Lahaine\",\"highlights\":[{\"start\":0,\"end\":2}]}]",
+			userID:         1,
+			query:          "?prefix=la&limit=5",
 		},
 		{
-			name:           "Parse 403 error",
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":403,\"message\":\"error\"}",
-			requestID:      "1",
-			error:          status.Error(codes.PermissionDenied, errors.New("error").Error()),
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Suggest(gomock.Any(), &musicMicroservice.SuggestOptions{
+					Prefix: "la",
+					UserID: -1,
+				}).Return(nil, status.Error(codes.Internal, "error"))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         -1,
+			query:          "?prefix=la",
 		},
 		{
-			name:           "Parse 404 error",
-			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":404,\"message\":\"error\"}",
-			requestID:      "1",
-			error:          status.Error(codes.NotFound, errors.New("error").Error()),
+			name: "Wrong type of limit",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			query:          "?prefix=la&limit=qwe",
 		},
 		{
-			name:           "Parse unexpected error",
-			expectedStatus: http.StatusOK,
-			error:          nil,
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
 		},
 	}
 
@@ -2165,19 +2152,26 @@ func TestAPIMicroservices_ParseErrorByCode(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/home/album/:id",
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/suggest"+currentTest.query,
 				strings.NewReader(""))
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
-			profileManager := profileMicroservice.NewProfileClient(profileConn)
-			authManager := authMicroservice.NewAuthorizationClient(authConn)
-			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
-			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			ctx.Set("USER_ID", currentTest.userID)
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
 			imageServices := image.NewImagesService()
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager)
-			if assert.NoError(t, r.ParseErrorByCode(ctx, currentTest.requestID, currentTest.error)) {
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.Suggest(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -2185,7 +2179,7 @@ func TestAPIMicroservices_ParseErrorByCode(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_AddTrackToFavorites(t *testing.T) {
+func TestAPIMicroservices_GetRecentSearches(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -2207,99 +2201,74 @@ func TestAPIMicroservices_AddTrackToFavorites(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                 string
-		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
-		expectedStatus       int
-		expectedJSON         string
-		doNotSetRequestID    bool
-		doNotSetUserID       bool
-		userID               int
-		wrongTypeOfParameter bool
-		trackID              int64
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		userID            int
+		query             string
 	}{
 		{
-			name: "Handler returned status 201",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).
-					Return(&musicMicroservice.AddTrackToFavoritesResponse{}, nil)
-				return moq
-			},
-			expectedStatus: http.StatusCreated,
-			expectedJSON:   "{\"status\":201,\"message\":\"Track was successfully added to favorites\"}",
-			trackID:        1,
-			userID:         1,
-		},
-		{
-			name: "Handler returned status 400",
+			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				moq.EXPECT().GetRecentSearches(gomock.Any(), &musicMicroservice.RecentSearchesOptions{
+					UserID: 1,
+					Limit:  5,
+				}).Return(&musicMicroservice.RecentSearches{RecentSearches: []*musicMicroservice.RecentSearch{{
+					ID:          1,
+					Query:       "lahaine",
+					ResultType:  constants.SuggestionTypeTrack,
+					ResultID:    2,
+					ResultTitle: "Lahaine",
+					CreatedAt:   1638792000,
+				}}}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			trackID:        1,
-			userID:         1,
+			expectedJSON: "[{\"id\":1,\"query\":\"lahaine\",\"result_type\":\"track\",\"result_id\":2," +
+				"\"result_title\":\"Lahaine\",\"created_at\":1638792000}]",
+			userID: 1,
+			query:  "?limit=5",
 		},
 		{
-			name: "No RequestID",
+			name: "Handler returned status 500",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				moq.EXPECT().GetRecentSearches(gomock.Any(), &musicMicroservice.RecentSearchesOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.Internal, "error"))
 				return moq
 			},
-			expectedStatus:    http.StatusInternalServerError,
-			doNotSetRequestID: true,
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
 		},
 		{
-			name: "No UserID",
+			name: "Wrong type of limit",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
+			userID:         1,
+			query:          "?limit=qwe",
 		},
 		{
 			name: "Unauthorized: userID = -1",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusOK,
 			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
 			userID:         -1,
 		},
 		{
-			name: "Wrong type of parameter",
+			name: "No RequestID",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
-			expectedStatus:       http.StatusInternalServerError,
-			wrongTypeOfParameter: true,
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
 		},
 	}
 
@@ -2307,25 +2276,15 @@ func TestAPIMicroservices_AddTrackToFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/music/search",
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search/recent"+currentTest.query,
 				strings.NewReader(""))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
-			ctx.SetParamNames("id")
-			if currentTest.wrongTypeOfParameter {
-				ctx.SetParamValues("qwe!123scd")
-			} else {
-				ctx.SetParamValues(strconv.FormatInt(currentTest.trackID, 10))
-			}
-
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
-			if !currentTest.doNotSetUserID {
-				ctx.Set("USER_ID", currentTest.userID)
-			}
+			ctx.Set("USER_ID", currentTest.userID)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -2335,8 +2294,8 @@ func TestAPIMicroservices_AddTrackToFavorites(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
-			if assert.NoError(t, r.AddTrackToFavorites(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetRecentSearches(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -2344,7 +2303,7 @@ func TestAPIMicroservices_AddTrackToFavorites(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_DeleteTrackFromFavorites(t *testing.T) {
+func TestAPIMicroservices_RecordSearchResult(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -2366,99 +2325,73 @@ func TestAPIMicroservices_DeleteTrackFromFavorites(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                 string
-		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
-		expectedStatus       int
-		expectedJSON         string
-		doNotSetRequestID    bool
-		doNotSetUserID       bool
-		userID               int
-		wrongTypeOfParameter bool
-		trackID              int64
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		userID            int
+		query             string
 	}{
 		{
 			name: "Handler returned status 201",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).
-					Return(&musicMicroservice.DeleteTrackFromFavoritesResponse{}, nil)
+				moq.EXPECT().RecordSearchResult(gomock.Any(), &musicMicroservice.RecordSearchResultOptions{
+					UserID:     1,
+					Query:      "lahaine",
+					ResultType: constants.SuggestionTypeTrack,
+					ResultID:   2,
+				}).Return(&musicMicroservice.RecordSearchResultResponse{}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusCreated,
-			expectedJSON:   "{\"status\":201,\"message\":\"Track was successfully deleted from favorites\"}",
-			trackID:        1,
+			expectedJSON:   "{\"status\":201,\"message\":\"Search result was recorded\"}",
 			userID:         1,
+			query:          "?query=lahaine&type=track&id=2",
 		},
 		{
 			name: "Handler returned status 400",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				moq.EXPECT().RecordSearchResult(gomock.Any(), &musicMicroservice.RecordSearchResultOptions{
+					UserID:     1,
+					Query:      "lahaine",
+					ResultType: "genre",
+					ResultID:   2,
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.SearchResultTypeInvalidMessage))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			trackID:        1,
+			expectedJSON:   "{\"status\":400,\"message\":\"Result type must be track, artist, album or playlist\"}",
 			userID:         1,
+			query:          "?query=lahaine&type=genre&id=2",
 		},
 		{
-			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus:    http.StatusInternalServerError,
-			doNotSetRequestID: true,
-		},
-		{
-			name: "No UserID",
+			name: "Wrong type of result id",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
+			userID:         1,
+			query:          "?query=lahaine&type=track&id=qwe",
 		},
 		{
 			name: "Unauthorized: userID = -1",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusOK,
 			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
 			userID:         -1,
 		},
 		{
-			name: "Wrong type of parameter",
+			name: "No RequestID",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
-			expectedStatus:       http.StatusInternalServerError,
-			wrongTypeOfParameter: true,
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
 		},
 	}
 
@@ -2466,25 +2399,15 @@ func TestAPIMicroservices_DeleteTrackFromFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/music/search",
+			req := httptest.NewRequest(echo.POST, "/api/v1/music/search/recent"+currentTest.query,
 				strings.NewReader(""))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
 
-			ctx.SetParamNames("id")
-			if currentTest.wrongTypeOfParameter {
-				ctx.SetParamValues("qwe!123scd")
-			} else {
-				ctx.SetParamValues(strconv.FormatInt(currentTest.trackID, 10))
-			}
-
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
 			}
-			if !currentTest.doNotSetUserID {
-				ctx.Set("USER_ID", currentTest.userID)
-			}
+			ctx.Set("USER_ID", currentTest.userID)
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
@@ -2494,8 +2417,8 @@ func TestAPIMicroservices_DeleteTrackFromFavorites(t *testing.T) {
 			controller := gomock.NewController(t)
 			musicManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
-			if assert.NoError(t, r.DeleteTrackFromFavorites(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.RecordSearchResult(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
@@ -2503,7 +2426,7 @@ func TestAPIMicroservices_DeleteTrackFromFavorites(t *testing.T) {
 	}
 }
 
-func TestAPIMicroservices_GetUserFavorites(t *testing.T) {
+func TestAPIMicroservices_DeleteRecentSearch(t *testing.T) {
 	config := zap.NewDevelopmentConfig()
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	prLogger, _ := config.Build()
@@ -2525,101 +2448,332 @@ func TestAPIMicroservices_GetUserFavorites(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                 string
-		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
-		expectedStatus       int
-		expectedJSON         string
-		doNotSetRequestID    bool
-		doNotSetUserID       bool
-		userID               int
-		wrongTypeOfParameter bool
-		trackID              int64
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		userID            int
+		query             string
+		searchID          string
 	}{
 		{
-			name: "Handler returned status 201",
+			name: "Handler returned status 200",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+				moq.EXPECT().DeleteRecentSearch(gomock.Any(), &musicMicroservice.DeleteRecentSearchOptions{
 					UserID: 1,
-				}).
-					Return(&musicMicroservice.Tracks{}, nil)
+					ID:     3,
+				}).Return(&musicMicroservice.DeleteRecentSearchResponse{}, nil)
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "[]",
-			trackID:        1,
+			expectedJSON:   "{\"status\":200,\"message\":\"Recent search was deleted\"}",
 			userID:         1,
+			searchID:       "3",
 		},
 		{
-			name: "Handler returned status 400",
+			name: "Handler returned status 404",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
 				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+				moq.EXPECT().DeleteRecentSearch(gomock.Any(), &musicMicroservice.DeleteRecentSearchOptions{
 					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+					ID:     3,
+				}).Return(nil, status.Error(codes.NotFound, constants.RecentSearchNotFoundMessage))
 				return moq
 			},
 			expectedStatus: http.StatusOK,
-			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
-			trackID:        1,
+			expectedJSON:   "{\"status\":404,\"message\":\"Recent search not found\"}",
 			userID:         1,
+			searchID:       "3",
 		},
 		{
-			name: "No RequestID",
-			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
-					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
-			},
-			expectedStatus:    http.StatusInternalServerError,
-			doNotSetRequestID: true,
-		},
-		{
-			name: "No UserID",
+			name: "Wrong type of parameter",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
-					UserID:  1,
-					TrackID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusInternalServerError,
-			doNotSetUserID: true,
+			userID:         1,
+			searchID:       "qwe",
 		},
 		{
 			name: "Unauthorized: userID = -1",
 			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
-				moq := musicMock.NewMockMusicClient(controller)
-				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
-					UserID: 1,
-				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
-				return moq
+				return musicMock.NewMockMusicClient(controller)
 			},
 			expectedStatus: http.StatusOK,
 			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
 			userID:         -1,
 		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
 	}
 
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			server := echo.New()
-			req := httptest.NewRequest(echo.GET, "/api/v1/music/search",
+			req := httptest.NewRequest(echo.DELETE, "/api/v1/music/search/recent/"+currentTest.query,
 				strings.NewReader(""))
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := server.NewContext(req, rec)
-
 			ctx.SetParamNames("id")
-			if currentTest.wrongTypeOfParameter {
-				ctx.SetParamValues("qwe!123scd")
-			} else {
-				ctx.SetParamValues(strconv.FormatInt(currentTest.trackID, 10))
+			ctx.SetParamValues(currentTest.searchID)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			ctx.Set("USER_ID", currentTest.userID)
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.DeleteRecentSearch(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_ClearRecentSearches(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		userID            int
+		query             string
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ClearRecentSearches(gomock.Any(), &musicMicroservice.ClearRecentSearchesOptions{
+					UserID: 1,
+				}).Return(&musicMicroservice.ClearRecentSearchesResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":200,\"message\":\"Recent searches were cleared\"}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ClearRecentSearches(gomock.Any(), &musicMicroservice.ClearRecentSearchesOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.Internal, "error"))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.DELETE, "/api/v1/music/search/recent"+currentTest.query,
+				strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
 			}
+			ctx.Set("USER_ID", currentTest.userID)
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.ClearRecentSearches(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_AddTrack(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	musicConn, _ := grpc.Dial(
+		os.Getenv("MUSIC_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *playlistsMock.MockPlaylistsClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+		playlistID        int64
+		trackID           int64
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).
+					Return(&playlistsMicroservice.AddTrackResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"status\":201,\"message\":\"Track was successfully added to playlist\"}",
+			trackID:        2,
+			playlistID:     1,
+			userID:         3,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			trackID:        2,
+			playlistID:     1,
+			userID:         3,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User unauthorized -> UserID = -1",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.POST, "/api/v1/playlist/track",
+				strings.NewReader(fmt.Sprintf(`{"track_id": %d, "playlist_id": %d}`, currentTest.trackID, currentTest.playlistID)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
 
 			if !currentTest.doNotSetRequestID {
 				ctx.Set("REQUEST_ID", "1")
@@ -2630,17 +2784,3958 @@ func TestAPIMicroservices_GetUserFavorites(t *testing.T) {
 
 			profileManager := profileMicroservice.NewProfileClient(profileConn)
 			authManager := authMicroservice.NewAuthorizationClient(authConn)
-			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			musicManager := musicMicroservice.NewMusicClient(musicConn)
 			imageServices := image.NewImagesService()
 
 			controller := gomock.NewController(t)
-			musicManagerMock := currentTest.mock(controller)
+			playlistsManagerMock := currentTest.mock(controller)
 
-			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager)
-			if assert.NoError(t, r.GetUserFavorites(ctx)) {
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManagerMock, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.AddTrack(ctx)) {
 				assert.Equal(t, currentTest.expectedStatus, rec.Code)
 				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
 			}
 		})
 	}
 }
+
+func TestAPIMicroservices_DeletePlaylist(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	musicConn, _ := grpc.Dial(
+		os.Getenv("MUSIC_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *playlistsMock.MockPlaylistsClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+		playlistID        int64
+		trackID           int64
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).
+					Return(&playlistsMicroservice.DeleteTrackResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":200,\"message\":\"Track was successfully deleted from playlist\"}",
+			trackID:        2,
+			playlistID:     1,
+			userID:         3,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			trackID:        2,
+			playlistID:     1,
+			userID:         3,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().AddTrack(gomock.Any(), &playlistsMicroservice.AddTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User unauthorized -> UserID = -1",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().DeleteTrack(gomock.Any(), &playlistsMicroservice.DeleteTrackOptions{
+					PlaylistID: 1,
+					TrackID:    2,
+					UserID:     3,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.DELETE, "/api/v1/playlist/track",
+				strings.NewReader(fmt.Sprintf(`{"track_id": %d, "playlist_id": %d}`, currentTest.trackID, currentTest.playlistID)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			playlistsManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManagerMock, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.DeleteTrack(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetUserPlaylists(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
+					UserID: 1,
+					Page:   &musicMicroservice.PageRequest{},
+				}).
+					Return(&musicMicroservice.PlaylistsData{
+						Playlists: []*musicMicroservice.PlaylistData{},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
+					UserID: 1,
+					Page:   &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User is unauthorized => userId = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().UserPlaylists(gomock.Any(), &musicMicroservice.UserPlaylistsOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/playlists",
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetUserPlaylists(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetPlaylistPage(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name                 string
+		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus       int
+		expectedJSON         string
+		doNotSetRequestID    bool
+		doNotSetUserID       bool
+		userID               int
+		playlistID           int64
+		wrongTypeOfParameter bool
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
+					PlaylistID: 2,
+					UserID:     1,
+					Page:       &musicMicroservice.PageRequest{},
+				}).
+					Return(&musicMicroservice.PlaylistPageResponse{
+						PlaylistID: 2,
+						Title:      "testTitle",
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"id\":2,\"title\":\"testTitle\"}",
+			userID:         1,
+			playlistID:     2,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
+					PlaylistID: 2,
+					UserID:     1,
+					Page:       &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			userID:         1,
+			playlistID:     2,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
+					PlaylistID: 2,
+					UserID:     1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
+					PlaylistID: 2,
+					UserID:     1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User is unauthorized => userId = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
+					UserID: -1,
+					Page:   &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			userID:         -1,
+		},
+		{
+			name: "Wrong type of param in query",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().PlaylistPage(gomock.Any(), &musicMicroservice.PlaylistPageOptions{
+					PlaylistID: 2,
+					UserID:     1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			wrongTypeOfParameter: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/playlists/:id",
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			if currentTest.wrongTypeOfParameter {
+				ctx.SetParamValues("qwe!123scd")
+			} else {
+				ctx.SetParamValues(strconv.FormatInt(currentTest.playlistID, 10))
+			}
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetPlaylistPage(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_ParseErrorByCode(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+	musicConn, _ := grpc.Dial(
+		os.Getenv("MUSIC_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		expectedJSON   string
+		requestID      string
+		error          error
+	}{
+		{
+			name:           "Parse 500 error",
+			expectedStatus: http.StatusInternalServerError,
+			requestID:      "1",
+			error:          status.Error(codes.Internal, errors.New("error").Error()),
+		},
+		{
+			name:           "Parse 400 error",
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			requestID:      "1",
+			error:          status.Error(codes.InvalidArgument, errors.New("error").Error()),
+		},
+		{
+			name:           "Parse 403 error",
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":403,\"message\":\"error\"}",
+			requestID:      "1",
+			error:          status.Error(codes.PermissionDenied, errors.New("error").Error()),
+		},
+		{
+			name:           "Parse 404 error",
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"error\"}",
+			requestID:      "1",
+			error:          status.Error(codes.NotFound, errors.New("error").Error()),
+		},
+		{
+			name:           "Parse unexpected error",
+			expectedStatus: http.StatusOK,
+			error:          nil,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/home/album/:id",
+				strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			imageServices := image.NewImagesService()
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.ParseErrorByCode(ctx, currentTest.requestID, currentTest.error)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_AddTrackToFavorites(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name                 string
+		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus       int
+		expectedJSON         string
+		doNotSetRequestID    bool
+		doNotSetUserID       bool
+		userID               int
+		wrongTypeOfParameter bool
+		trackID              int64
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).
+					Return(&musicMicroservice.AddTrackToFavoritesResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"status\":201,\"message\":\"Track was successfully added to favorites\"}",
+			trackID:        1,
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			trackID:        1,
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			wrongTypeOfParameter: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search",
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			if currentTest.wrongTypeOfParameter {
+				ctx.SetParamValues("qwe!123scd")
+			} else {
+				ctx.SetParamValues(strconv.FormatInt(currentTest.trackID, 10))
+			}
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.AddTrackToFavorites(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_DeleteTrackFromFavorites(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name                 string
+		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus       int
+		expectedJSON         string
+		doNotSetRequestID    bool
+		doNotSetUserID       bool
+		userID               int
+		wrongTypeOfParameter bool
+		trackID              int64
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).
+					Return(&musicMicroservice.DeleteTrackFromFavoritesResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"status\":201,\"message\":\"Track was successfully deleted from favorites\"}",
+			trackID:        1,
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			trackID:        1,
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().AddTrackToFavorites(gomock.Any(), &musicMicroservice.AddTrackToFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			wrongTypeOfParameter: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search",
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			if currentTest.wrongTypeOfParameter {
+				ctx.SetParamValues("qwe!123scd")
+			} else {
+				ctx.SetParamValues(strconv.FormatInt(currentTest.trackID, 10))
+			}
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.DeleteTrackFromFavorites(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_FollowArtist(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name                 string
+		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus       int
+		expectedJSON         string
+		doNotSetRequestID    bool
+		doNotSetUserID       bool
+		userID               int
+		wrongTypeOfParameter bool
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().FollowArtist(gomock.Any(), &musicMicroservice.FollowArtistOptions{UserID: 1, ArtistID: 2}).
+					Return(&musicMicroservice.LibraryResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"status\":201,\"message\":\"Artist was successfully followed\"}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 403",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().FollowArtist(gomock.Any(), &musicMicroservice.FollowArtistOptions{UserID: 1, ArtistID: 2}).
+					Return(nil, status.Error(codes.PermissionDenied, constants.ArtistAlreadyFollowedMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":403,\"message\":\"Artist is already followed\"}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().FollowArtist(gomock.Any(), &musicMicroservice.FollowArtistOptions{UserID: 1, ArtistID: 2}).
+					Return(nil, status.Error(codes.NotFound, constants.ArtistNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Artist not found\"}",
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			userID:               1,
+			wrongTypeOfParameter: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.POST, "/api/v1/artist/follow/:id", strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			if currentTest.wrongTypeOfParameter {
+				ctx.SetParamValues("qwe!123scd")
+			} else {
+				ctx.SetParamValues("2")
+			}
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.FollowArtist(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetSavedAlbums(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetSavedAlbums(gomock.Any(), &musicMicroservice.LibraryOptions{UserID: 1, Page: &musicMicroservice.PageRequest{}}).
+					Return(&musicMicroservice.Albums{
+						Albums: []*musicMicroservice.Album{{ID: 2, Title: "Album", Artist: "Artist", IsSaved: true}},
+						Page:   &musicMicroservice.PageResponse{TotalHint: 1},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[{\"id\":2,\"title\":\"Album\",\"artist\":\"Artist\",\"is_saved\":true}]",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetSavedAlbums(gomock.Any(), &musicMicroservice.LibraryOptions{UserID: 1, Page: &musicMicroservice.PageRequest{}}).
+					Return(nil, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Invalid cursor\"}",
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/library/albums", strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetSavedAlbums(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetReleaseRadar(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus int
+		expectedJSON   string
+		userID         int
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ReleaseRadar(gomock.Any(), &musicMicroservice.ReleaseRadarOptions{UserID: 1}).
+					Return(&musicMicroservice.ReleaseRadarResponse{
+						Albums:     []*musicMicroservice.Album{{ID: 2, Title: "Album", Year: 2021, Artist: "Artist", ReleaseDate: "2021-12-03"}},
+						PlaylistID: 7,
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON: "{\"albums\":[{\"id\":2,\"title\":\"Album\",\"year\":2021,\"artist\":\"Artist\"," +
+				"\"release_date\":\"2021-12-03\"}],\"playlist_id\":7}",
+			userID: 1,
+		},
+		{
+			name: "Handler returned empty release radar",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ReleaseRadar(gomock.Any(), &musicMicroservice.ReleaseRadarOptions{UserID: 1}).
+					Return(&musicMicroservice.ReleaseRadarResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"albums\":[]}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ReleaseRadar(gomock.Any(), &musicMicroservice.ReleaseRadarOptions{UserID: 1}).
+					Return(nil, status.Error(codes.Internal, "error"))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/library/releases", strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, musicManagerMock, nil, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetReleaseRadar(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetRecap(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus int
+		expectedJSON   string
+		userID         int
+		year           string
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Recap(gomock.Any(), &musicMicroservice.RecapOptions{UserID: 1, Year: 2021}).
+					Return(&musicMicroservice.RecapResponse{
+						Year:                 2021,
+						MinutesListened:      1500,
+						Listens:              400,
+						LongestStreak:        12,
+						LongestStreakStart:   "2021-03-01",
+						MostPlayedDay:        "2021-03-05",
+						MostPlayedDayListens: 40,
+						BuiltAt:              1640995200,
+						Artists: []*musicMicroservice.RecapArtist{
+							{Position: 1, Plays: 90, Artist: &musicMicroservice.Artist{ID: 3, Name: "Artist"}},
+						},
+						Genres: []*musicMicroservice.RecapGenre{
+							{Position: 1, Plays: 120, Genre: &musicMicroservice.Genre{ID: 4, Name: "rock"}},
+						},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON: "{\"year\":2021,\"minutes_listened\":1500,\"listens\":400,\"longest_streak\":12," +
+				"\"longest_streak_start\":\"2021-03-01\",\"most_played_day\":\"2021-03-05\",\"most_played_day_listens\":40," +
+				"\"built_at\":1640995200,\"tracks\":[],\"albums\":[]," +
+				"\"artists\":[{\"position\":1,\"plays\":90,\"artist\":{\"id\":3,\"name\":\"Artist\"}}]," +
+				"\"genres\":[{\"position\":1,\"plays\":120,\"genre\":{\"id\":4,\"name\":\"rock\"}}]}",
+			userID: 1,
+			year:   "2021",
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Recap(gomock.Any(), &musicMicroservice.RecapOptions{UserID: 1, Year: 2021}).
+					Return(nil, status.Error(codes.NotFound, constants.RecapNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Recap for this year is not ready\"}",
+			userID:         1,
+			year:           "2021",
+		},
+		{
+			name: "Handler returned status 500, year is not a number",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			year:           "last",
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+			year:           "2021",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/user/recap/", strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.SetParamNames("year")
+			ctx.SetParamValues(currentTest.year)
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, musicManagerMock, nil, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetRecap(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_SaveRecapPlaylist(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *playlistsMock.MockPlaylistsClient
+		expectedStatus int
+		expectedJSON   string
+		userID         int
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().CreateRecapPlaylist(gomock.Any(), &playlistsMicroservice.RecapPlaylistOptions{UserID: 1, Year: 2021}).
+					Return(&playlistsMicroservice.CreatePlaylistResponse{PlaylistID: 5}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"id\":5}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				moq := playlistsMock.NewMockPlaylistsClient(controller)
+				moq.EXPECT().CreateRecapPlaylist(gomock.Any(), &playlistsMicroservice.RecapPlaylistOptions{UserID: 1, Year: 2021}).
+					Return(nil, status.Error(codes.NotFound, constants.RecapNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Recap for this year is not ready\"}",
+			userID:         1,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *playlistsMock.MockPlaylistsClient {
+				return playlistsMock.NewMockPlaylistsClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.POST, "/api/v1/user/recap/2021/playlist", strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.SetParamNames("year")
+			ctx.SetParamValues("2021")
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			controller := gomock.NewController(t)
+			playlistsManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, playlistsManagerMock, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.SaveRecapPlaylist(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_Radio(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+		url               string
+	}{
+		{
+			name: "Handler started radio session",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Radio(gomock.Any(), &musicMicroservice.RadioOptions{SeedType: "artist", SeedID: 2, UserID: 1, Amount: 5}).
+					Return(&musicMicroservice.RadioResponse{
+						Session: "token",
+						Tracks: []*musicMicroservice.Track{{
+							ID:     3,
+							Title:  "Track",
+							Album:  &musicMicroservice.Album{ID: 4},
+							Artist: &musicMicroservice.Artist{ID: 2, Name: "Artist"},
+						}},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"session\":\"token\",\"tracks\":[{\"id\":3,\"title\":\"Track\",\"album\":{\"id\":4},\"artist\":{\"id\":2,\"name\":\"Artist\"}}]}",
+			userID:         1,
+			url:            "/api/v1/radio?seed_type=artist&seed_id=2&limit=5",
+		},
+		{
+			name: "Handler continued radio session",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Radio(gomock.Any(), &musicMicroservice.RadioOptions{Session: "token", UserID: 1}).
+					Return(&musicMicroservice.RadioResponse{Session: "token"}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"session\":\"token\",\"tracks\":[]}",
+			userID:         1,
+			url:            "/api/v1/radio?session=token",
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Radio(gomock.Any(), &musicMicroservice.RadioOptions{Session: "expired", UserID: 1}).
+					Return(nil, status.Error(codes.NotFound, constants.RadioSessionNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Radio session not found or expired\"}",
+			userID:         1,
+			url:            "/api/v1/radio?session=expired",
+		},
+		{
+			name: "Invalid seed id",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			url:            "/api/v1/radio?seed_type=artist&seed_id=qwe",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+			url:               "/api/v1/radio",
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+			url:            "/api/v1/radio",
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+			url:            "/api/v1/radio",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, currentTest.url, strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.Radio(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetUserFavorites(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name                 string
+		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus       int
+		expectedJSON         string
+		doNotSetRequestID    bool
+		doNotSetUserID       bool
+		userID               int
+		wrongTypeOfParameter bool
+		trackID              int64
+		query                string
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+					UserID: 1,
+					Page:   &musicMicroservice.PageRequest{},
+					Filter: &musicMicroservice.FavoritesFilter{},
+				}).
+					Return(&musicMicroservice.Tracks{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[]",
+			trackID:        1,
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+					UserID: 1,
+					Page:   &musicMicroservice.PageRequest{},
+					Filter: &musicMicroservice.FavoritesFilter{},
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"error\"}",
+			trackID:        1,
+			userID:         1,
+		},
+		{
+			name: "Sort and filters",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				lossless := false
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+					UserID: 1,
+					Page:   &musicMicroservice.PageRequest{},
+					SortBy: "title",
+					Filter: &musicMicroservice.FavoritesFilter{ArtistID: 2, GenreID: 3, Lossless: &lossless, Text: "rock"},
+				}).Return(&musicMicroservice.Tracks{Tracks: []*musicMicroservice.Track{{ID: 1, AddedAt: 1638352800, Album: &musicMicroservice.Album{}, Artist: &musicMicroservice.Artist{}}}}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[{\"id\":1,\"album\":{},\"artist\":{\"name\":\"\"},\"added_at\":1638352800}]",
+			userID:         1,
+			query:          "?sort=title&artist=2&genre=3&lossless=false&q=rock",
+		},
+		{
+			name: "Wrong type of explicit filter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			query:          "?explicit=qwe",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().DeleteTrackFromFavorites(gomock.Any(), &musicMicroservice.DeleteTrackFromFavoritesOptions{
+					UserID:  1,
+					TrackID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetFavoriteTracks(gomock.Any(), &musicMicroservice.UserFavoritesOptions{
+					UserID: 1,
+				}).Return(nil, status.Error(codes.InvalidArgument, errors.New("error").Error())).AnyTimes()
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/music/search"+currentTest.query,
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			if currentTest.wrongTypeOfParameter {
+				ctx.SetParamValues("qwe!123scd")
+			} else {
+				ctx.SetParamValues(strconv.FormatInt(currentTest.trackID, 10))
+			}
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetUserFavorites(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetCharts(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+		entity            string
+		genre             string
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Charts(gomock.Any(), &musicMicroservice.ChartsOptions{
+					Period:       constants.ChartPeriodWeekly,
+					Amount:       constants.ChartPreviewAmount,
+					UserID:       1,
+					IsAuthorized: true,
+				}).
+					Return(&musicMicroservice.ChartsResponse{
+						Period:      constants.ChartPeriodWeekly,
+						PeriodStart: "2021-12-06",
+						Artists: []*musicMicroservice.ChartArtist{
+							{Position: 2, PreviousPosition: 5, Plays: 10, Artist: &musicMicroservice.Artist{ID: 1, Name: "artist"}},
+							{Position: 3, Plays: 7, Artist: &musicMicroservice.Artist{ID: 2, Name: "newcomer"}},
+						},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON: "{\"period\":\"weekly\",\"period_start\":\"2021-12-06\",\"artists\":[" +
+				"{\"position\":2,\"previous_position\":5,\"movement\":3,\"plays\":10,\"artist\":{\"id\":1,\"name\":\"artist\"}}," +
+				"{\"position\":3,\"movement\":0,\"is_new\":true,\"plays\":7,\"artist\":{\"id\":2,\"name\":\"newcomer\"}}]}",
+			userID: 1,
+		},
+		{
+			name: "Full chart of one entity filtered by genre",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Charts(gomock.Any(), &musicMicroservice.ChartsOptions{
+					Period:  constants.ChartPeriodWeekly,
+					Entity:  constants.ChartEntityAlbums,
+					GenreID: 3,
+					Amount:  constants.ChartPositionsAmount,
+					UserID:  -1,
+				}).
+					Return(&musicMicroservice.ChartsResponse{
+						Period:      constants.ChartPeriodWeekly,
+						PeriodStart: "2021-12-06",
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"period\":\"weekly\",\"period_start\":\"2021-12-06\"}",
+			userID:         -1,
+			entity:         constants.ChartEntityAlbums,
+			genre:          "3",
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().Charts(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.NotFound, constants.ChartNotReadyMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Chart is not ready yet\"}",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Wrong type of genre",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			genre:          "qwe!123scd",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/charts/:period/:entity?genre="+currentTest.genre,
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("period", "entity")
+			ctx.SetParamValues(constants.ChartPeriodWeekly, currentTest.entity)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetCharts(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetGenres(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ListGenres(gomock.Any(), &musicMicroservice.ListGenresOptions{}).
+					Return(&musicMicroservice.Genres{Genres: []*musicMicroservice.Genre{
+						{ID: 1, Name: "rock", Artwork: "rock_artwork", ArtworkColor: "#000000", TracksAmount: 10},
+					}}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[{\"id\":1,\"name\":\"rock\",\"artwork\":\"rock_artwork\",\"artwork_color\":\"#000000\",\"tracks_count\":10}]",
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ListGenres(gomock.Any(), &musicMicroservice.ListGenresOptions{}).
+					Return(nil, status.Error(codes.Internal, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/genres", strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetGenres(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetGenrePage(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name               string
+		mock               func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus     int
+		expectedJSON       string
+		expectedNextCursor string
+		doNotSetRequestID  bool
+		doNotSetUserID     bool
+		userID             int
+		genreID            string
+		query              string
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GenrePage(gomock.Any(), &musicMicroservice.GenrePageOptions{
+					GenreID:      1,
+					UserID:       2,
+					IsAuthorized: true,
+					Page:         &musicMicroservice.PageRequest{Limit: 10, Cursor: "cursor"},
+				}).
+					Return(&musicMicroservice.GenrePageResponse{
+						Genre:   &musicMicroservice.Genre{ID: 1, Name: "rock"},
+						Artists: []*musicMicroservice.Artist{{ID: 3, Name: "artist"}},
+						Page:    &musicMicroservice.PageResponse{NextCursor: "next", TotalHint: 30},
+					}, nil)
+				return moq
+			},
+			expectedStatus:     http.StatusOK,
+			expectedJSON:       "{\"genre\":{\"id\":1,\"name\":\"rock\"},\"artists\":[{\"id\":3,\"name\":\"artist\"}]}",
+			expectedNextCursor: "next",
+			userID:             2,
+			genreID:            "1",
+			query:              "?limit=10&cursor=cursor",
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GenrePage(gomock.Any(), &musicMicroservice.GenrePageOptions{
+					GenreID: 100,
+					UserID:  -1,
+					Page:    &musicMicroservice.PageRequest{},
+				}).Return(nil, status.Error(codes.NotFound, constants.GenreNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Genre not found\"}",
+			userID:         -1,
+			genreID:        "100",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			genreID:        "qwe!123scd",
+		},
+		{
+			name: "Wrong type of limit",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			genreID:        "1",
+			query:          "?limit=qwe",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/genre/:id"+currentTest.query,
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(currentTest.genreID)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetGenrePage(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+				assert.Equal(t, currentTest.expectedNextCursor, rec.Header().Get(constants.NextCursorHeader))
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetArtistTracks(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+		artistID          string
+		query             string
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ArtistTracks(gomock.Any(), &musicMicroservice.ArtistTracksOptions{
+					ArtistID:     1,
+					UserID:       2,
+					IsAuthorized: true,
+					SortBy:       constants.SortByYear,
+					Page:         &musicMicroservice.PageRequest{Limit: 10},
+				}).
+					Return(&musicMicroservice.Tracks{Tracks: []*musicMicroservice.Track{
+						{ID: 1, Title: "title", Album: &musicMicroservice.Album{}, Artist: &musicMicroservice.Artist{}},
+					}}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[{\"id\":1,\"title\":\"title\",\"album\":{},\"artist\":{\"name\":\"\"}}]",
+			userID:         2,
+			artistID:       "1",
+			query:          "?limit=10&sort=year",
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ArtistTracks(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.InvalidArgument, constants.SortInvalidMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Sort must be popularity, year or title\"}",
+			userID:         -1,
+			artistID:       "1",
+			query:          "?sort=qwe",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			artistID:       "qwe!123scd",
+		},
+		{
+			name: "Wrong type of limit",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			artistID:       "1",
+			query:          "?limit=qwe",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/artist/:id/tracks"+currentTest.query,
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(currentTest.artistID)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetArtistTracks(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetArtistAlbums(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		artistID          string
+		query             string
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ArtistAlbums(gomock.Any(), &musicMicroservice.ArtistAlbumsOptions{
+					ArtistID: 1,
+					SortBy:   constants.SortByTitle,
+					Page:     &musicMicroservice.PageRequest{},
+				}).
+					Return(&musicMicroservice.Albums{Albums: []*musicMicroservice.Album{
+						{ID: 1, Title: "title", Year: 2021},
+					}}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[{\"id\":1,\"title\":\"title\",\"year\":2021}]",
+			artistID:       "1",
+			query:          "?sort=title",
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().ArtistAlbums(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.Internal, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			artistID:       "1",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			artistID:       "qwe!123scd",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/artist/:id/albums"+currentTest.query,
+				strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(currentTest.artistID)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetArtistAlbums(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetTracks(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	const userID = 1
+
+	tests := []struct {
+		name              string
+		ids               string
+		mock              func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+	}{
+		{
+			name: "Handler returned status 200",
+			ids:  "2,1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetTracks(gomock.Any(), &musicMicroservice.GetTracksOptions{IDs: []int64{2, 1}, UserID: userID, IsAuthorized: true}).
+					Return(&musicMicroservice.Tracks{Tracks: []*musicMicroservice.Track{
+						{ID: 2, Title: "Second", Album: &musicMicroservice.Album{ID: 3}, Artist: &musicMicroservice.Artist{ID: 4, Name: "Artist"}, PlaylistIDs: []int64{5}},
+						{ID: 1, Title: "First", Album: &musicMicroservice.Album{ID: 3}, Artist: &musicMicroservice.Artist{ID: 4, Name: "Artist"}},
+					}}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "[{\"id\":2,\"title\":\"Second\",\"album\":{\"id\":3},\"artist\":{\"id\":4,\"name\":\"Artist\"},\"playlists\":[5]},{\"id\":1,\"title\":\"First\",\"album\":{\"id\":3},\"artist\":{\"id\":4,\"name\":\"Artist\"}}]",
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetTracks(gomock.Any(), &musicMicroservice.GetTracksOptions{UserID: userID, IsAuthorized: true}).
+					Return(nil, status.Error(codes.InvalidArgument, constants.TrackIDsRequiredMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Track ids are required\"}",
+		},
+		{
+			name: "Wrong type of parameter",
+			ids:  "1,qwe",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/tracks?ids="+currentTest.ids, strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetTracks(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetTrackLyrics(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	const trackID = 1
+
+	tests := []struct {
+		name                 string
+		mock                 func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus       int
+		expectedJSON         string
+		wrongTypeOfParameter bool
+		doNotSetRequestID    bool
+	}{
+		{
+			name: "Handler returned synced lyrics",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetLyrics(gomock.Any(), &musicMicroservice.LyricsOptions{TrackID: trackID}).
+					Return(&musicMicroservice.Lyrics{
+						TrackID: trackID,
+						Plain:   "First\nSecond",
+						Synced:  true,
+						Lines: []*musicMicroservice.LyricsLine{
+							{Time: 1000, Text: "First"},
+							{Time: 2500, Text: "Second"},
+						},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"track_id\":1,\"plain\":\"First\\nSecond\",\"synced\":true,\"lines\":[{\"time\":1000,\"text\":\"First\"},{\"time\":2500,\"text\":\"Second\"}]}",
+		},
+		{
+			name: "Handler returned plain lyrics",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetLyrics(gomock.Any(), &musicMicroservice.LyricsOptions{TrackID: trackID}).
+					Return(&musicMicroservice.Lyrics{TrackID: trackID, Plain: "First"}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"track_id\":1,\"plain\":\"First\",\"synced\":false}",
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetLyrics(gomock.Any(), &musicMicroservice.LyricsOptions{TrackID: trackID}).
+					Return(nil, status.Error(codes.NotFound, constants.LyricsNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Track has no lyrics\"}",
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().GetLyrics(gomock.Any(), &musicMicroservice.LyricsOptions{TrackID: trackID}).
+					Return(nil, status.Error(codes.Internal, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			wrongTypeOfParameter: true,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/track/:id/lyrics", strings.NewReader(""))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			ctx.SetParamNames("id")
+			if currentTest.wrongTypeOfParameter {
+				ctx.SetParamValues("qwe!123scd")
+			} else {
+				ctx.SetParamValues(strconv.Itoa(trackID))
+			}
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetTrackLyrics(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_StreamTrack(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tracksPath := t.TempDir()
+	_ = os.WriteFile(tracksPath+"/testFile"+constants.TrackLossyExtension, []byte("lossy audio"), 0600)
+	_ = os.WriteFile(tracksPath+"/testFile"+constants.TrackLosslessExtension, []byte("lossless audio"), 0600)
+	info, _ := os.Stat(tracksPath + "/testFile" + constants.TrackLossyExtension)
+	lossyETag := fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size())
+
+	var trackID int64 = 1
+	signer, _ := media.NewURLSigner("test:secret", time.Hour)
+	signedURL := signer.Sign(media.StreamPath+"1", 1, time.Now())
+
+	tests := []struct {
+		name                string
+		mock                func(*gomock.Controller) *musicMock.MockMusicClient
+		expectedStatus      int
+		expectedBody        string
+		expectedContentType string
+		doNotSetRequestID   bool
+		userID              int
+		trackID             string
+		quality             string
+		rangeHeader         string
+		ifNoneMatch         string
+		url                 string
+	}{
+		{
+			name: "Handler returned lossy file",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().TrackFile(gomock.Any(), &musicMicroservice.TrackFileOptions{TrackID: trackID}).
+					Return(&musicMicroservice.TrackFileResponse{File: "testFile", Lossless: true}, nil)
+				moq.EXPECT().IncrementListenCount(gomock.Any(), &musicMicroservice.IncrementListenCountOptions{ID: trackID, UserID: 1, IP: "192.0.2.1"}).
+					Return(&musicMicroservice.IncrementListenCountEmpty{}, nil)
+				return moq
+			},
+			expectedStatus:      http.StatusOK,
+			expectedBody:        "lossy audio",
+			expectedContentType: constants.TrackLossyContentType,
+			userID:              1,
+			trackID:             "1",
+		},
+		{
+			name: "Handler returned range of lossless file",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().TrackFile(gomock.Any(), &musicMicroservice.TrackFileOptions{TrackID: trackID}).
+					Return(&musicMicroservice.TrackFileResponse{File: "testFile", Lossless: true}, nil)
+				return moq
+			},
+			expectedStatus:      http.StatusPartialContent,
+			expectedBody:        "less",
+			expectedContentType: constants.TrackLosslessContentType,
+			userID:              1,
+			trackID:             "1",
+			quality:             constants.StreamQualityLossless,
+			rangeHeader:         "bytes=4-7",
+		},
+		{
+			name: "Lossless requested for lossy track",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().TrackFile(gomock.Any(), &musicMicroservice.TrackFileOptions{TrackID: trackID}).
+					Return(&musicMicroservice.TrackFileResponse{File: "testFile"}, nil)
+				moq.EXPECT().IncrementListenCount(gomock.Any(), &musicMicroservice.IncrementListenCountOptions{ID: trackID, UserID: 1, IP: "192.0.2.1"}).
+					Return(nil, status.Error(codes.Internal, "error"))
+				return moq
+			},
+			expectedStatus:      http.StatusPartialContent,
+			expectedBody:        "lossy",
+			expectedContentType: constants.TrackLossyContentType,
+			userID:              1,
+			trackID:             "1",
+			quality:             constants.StreamQualityLossless,
+			rangeHeader:         "bytes=0-4",
+		},
+		{
+			name: "File is not modified",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().TrackFile(gomock.Any(), &musicMicroservice.TrackFileOptions{TrackID: trackID}).
+					Return(&musicMicroservice.TrackFileResponse{File: "testFile"}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusNotModified,
+			userID:         1,
+			trackID:        "1",
+			ifNoneMatch:    lossyETag,
+		},
+		{
+			name: "File is missing in storage",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().TrackFile(gomock.Any(), &musicMicroservice.TrackFileOptions{TrackID: trackID}).
+					Return(&musicMicroservice.TrackFileResponse{File: "missingFile"}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusNotFound,
+			userID:         1,
+			trackID:        "1",
+		},
+		{
+			name: "Handler returned status 404",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				moq := musicMock.NewMockMusicClient(controller)
+				moq.EXPECT().TrackFile(gomock.Any(), &musicMicroservice.TrackFileOptions{TrackID: trackID}).
+					Return(nil, status.Error(codes.NotFound, constants.TrackNotFound))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":404,\"message\":\"Track not found\"}",
+			userID:         1,
+			trackID:        "1",
+		},
+		{
+			name: "Url signed for other user",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":403,\"message\":\"Invalid media url\"}",
+			userID:         2,
+			trackID:        "1",
+		},
+		{
+			name: "Expired url",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":403,\"message\":\"Media url expired\"}",
+			userID:         1,
+			trackID:        "1",
+			url:            signer.Sign(media.StreamPath+"1", 1, time.Now().Add(-time.Hour*3)),
+		},
+		{
+			name: "Invalid quality",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":400,\"message\":\"Quality must be lossy or lossless\"}",
+			userID:         1,
+			trackID:        "1",
+			quality:        "hires",
+		},
+		{
+			name: "Wrong type of parameter",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+			trackID:        "qwe",
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+			trackID:        "1",
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *musicMock.MockMusicClient {
+				return musicMock.NewMockMusicClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			url := signedURL
+			if len(currentTest.url) != 0 {
+				url = currentTest.url
+			}
+			req := httptest.NewRequest(echo.GET, url+"&quality="+currentTest.quality, nil)
+			if len(currentTest.rangeHeader) != 0 {
+				req.Header.Set("Range", currentTest.rangeHeader)
+			}
+			if len(currentTest.ifNoneMatch) != 0 {
+				req.Header.Set("If-None-Match", currentTest.ifNoneMatch)
+			}
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.SetParamNames("trackID")
+			ctx.SetParamValues(currentTest.trackID)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			ctx.Set("USER_ID", currentTest.userID)
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			musicManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManagerMock, playlistsManager, nil, nil,
+				http.Dir(tracksPath), nil, signer, nil)
+			if assert.NoError(t, r.StreamTrack(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedBody, rec.Body.String())
+				if len(currentTest.expectedContentType) != 0 {
+					assert.Equal(t, currentTest.expectedContentType, rec.Header().Get(echo.HeaderContentType))
+					assert.NotEmpty(t, rec.Header().Get("ETag"))
+					assert.NotEmpty(t, rec.Header().Get("Last-Modified"))
+				}
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetPlaylistArtwork(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	musicConn, _ := grpc.Dial(
+		os.Getenv("MUSIC_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	artworksPath := t.TempDir()
+	artwork := "testArtwork" + constants.PlaylistArtworkExtension384px
+	_ = os.WriteFile(artworksPath+"/"+artwork, []byte("artwork"), 0600)
+	signer, _ := media.NewURLSigner("test:secret", time.Hour)
+
+	tests := []struct {
+		name              string
+		expectedStatus    int
+		expectedBody      string
+		doNotSetRequestID bool
+		userID            int
+		url               string
+	}{
+		{
+			name:           "Handler returned artwork",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "artwork",
+			userID:         1,
+			url:            signer.Sign(media.PlaylistArtworkPath+artwork, 1, time.Now()),
+		},
+		{
+			name:           "Url signed for other user",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":403,\"message\":\"Invalid media url\"}",
+			userID:         2,
+			url:            signer.Sign(media.PlaylistArtworkPath+artwork, 1, time.Now()),
+		},
+		{
+			name:           "Url without signature",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":403,\"message\":\"Invalid media url\"}",
+			userID:         1,
+			url:            media.PlaylistArtworkPath + artwork,
+		},
+		{
+			name:           "Unauthorized: userID = -1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+			url:            signer.Sign(media.PlaylistArtworkPath+artwork, 1, time.Now()),
+		},
+		{
+			name:              "No RequestID",
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+			url:               media.PlaylistArtworkPath + artwork,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, currentTest.url, nil)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.SetParamNames("artwork")
+			ctx.SetParamValues(artwork)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			ctx.Set("USER_ID", currentTest.userID)
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager, nil, nil,
+				nil, http.Dir(artworksPath), signer, nil)
+			if assert.NoError(t, r.GetPlaylistArtwork(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_CreateCatalogGenre(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	musicConn, _ := grpc.Dial(
+		os.Getenv("MUSIC_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *catalogMock.MockCatalogClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+	}{
+		{
+			name: "Handler returned status 201",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), &catalogMicroservice.GenreOptions{
+					AdminID: 1,
+					Genre:   &catalogMicroservice.CatalogGenre{Name: "Rock"},
+				}).
+					Return(&catalogMicroservice.CreateResponse{ID: 5}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"id\":5}",
+			userID:         1,
+		},
+		{
+			name: "User is not admin",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), &catalogMicroservice.GenreOptions{
+					AdminID: 1,
+					Genre:   &catalogMicroservice.CatalogGenre{Name: "Rock"},
+				}).
+					Return(nil, status.Error(codes.PermissionDenied, constants.NotAdminMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":403,\"message\":\"Only administrators can manage catalog\"}",
+			userID:         1,
+		},
+		{
+			name: "Genre name is not unique",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), &catalogMicroservice.GenreOptions{
+					AdminID: 1,
+					Genre:   &catalogMicroservice.CatalogGenre{Name: "Rock"},
+				}).
+					Return(nil, status.Error(codes.InvalidArgument, constants.GenreNameNotUniqueMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Genre name is not unique\"}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), &catalogMicroservice.GenreOptions{
+					AdminID: 1,
+					Genre:   &catalogMicroservice.CatalogGenre{Name: "Rock"},
+				}).
+					Return(nil, status.Error(codes.Internal, errors.New("error").Error()))
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), gomock.Any()).Times(0)
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), gomock.Any()).Times(0)
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User unauthorized -> UserID = -1",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CreateGenre(gomock.Any(), gomock.Any()).Times(0)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.POST, "/api/v1/admin/genres", strings.NewReader("name=Rock"))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			catalogManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager, catalogManagerMock, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.CreateCatalogGenre(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_DeleteCatalogArtist(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+	authConn, _ := grpc.Dial(
+		os.Getenv("AUTH_HOST"),
+		grpc.WithInsecure(),
+	)
+	profileConn, _ := grpc.Dial(
+		os.Getenv("PROFILE_HOST"),
+		grpc.WithInsecure(),
+	)
+	musicConn, _ := grpc.Dial(
+		os.Getenv("MUSIC_HOST"),
+		grpc.WithInsecure(),
+	)
+	playlistsConn, _ := grpc.Dial(
+		os.Getenv("PLAYLISTS_HOST"),
+		grpc.WithInsecure(),
+	)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *catalogMock.MockCatalogClient
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().DeleteArtist(gomock.Any(), &catalogMicroservice.DeleteOptions{
+					AdminID: 1,
+					ID:      3,
+				}).
+					Return(&catalogMicroservice.DeleteResponse{OldFilename: constants.ArtistAvatarDefaultFilename}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":200,\"message\":\"Catalog entry was deleted\"}",
+			userID:         1,
+		},
+		{
+			name: "Artist not found",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().DeleteArtist(gomock.Any(), &catalogMicroservice.DeleteOptions{
+					AdminID: 1,
+					ID:      3,
+				}).
+					Return(nil, status.Error(codes.NotFound, constants.ArtistNotFoundMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":404,\"message\":\"Artist not found\"}",
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().DeleteArtist(gomock.Any(), gomock.Any()).Times(0)
+				return moq
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().DeleteArtist(gomock.Any(), gomock.Any()).Times(0)
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "User unauthorized -> UserID = -1",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().DeleteArtist(gomock.Any(), gomock.Any()).Times(0)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.DELETE, "/api/v1/admin/artists/3", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues("3")
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			profileManager := profileMicroservice.NewProfileClient(profileConn)
+			authManager := authMicroservice.NewAuthorizationClient(authConn)
+			musicManager := musicMicroservice.NewMusicClient(musicConn)
+			playlistsManager := playlistsMicroservice.NewPlaylistsClient(playlistsConn)
+			imageServices := image.NewImagesService()
+
+			controller := gomock.NewController(t)
+			catalogManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, imageServices, authManager, profileManager, musicManager, playlistsManager, catalogManagerMock, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.DeleteCatalogArtist(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_GetSuspiciousAccounts(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *catalogMock.MockCatalogClient
+		expectedStatus int
+		expectedJSON   string
+		userID         int
+	}{
+		{
+			name: "Handler returned status 200",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().SuspiciousAccounts(gomock.Any(), &catalogMicroservice.SuspiciousAccountsOptions{AdminID: 1}).
+					Return(&catalogMicroservice.SuspiciousAccountsResponse{
+						Accounts: []*catalogMicroservice.SuspiciousAccount{{
+							UserID:         7,
+							Nickname:       "bot",
+							Email:          "bot@example.com",
+							Rejections:     120,
+							IPs:            4,
+							Listens:        60,
+							LastRejectedAt: 1640995200,
+						}},
+					}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON: "[{\"user_id\":7,\"nickname\":\"bot\",\"email\":\"bot@example.com\",\"rejections\":120," +
+				"\"ips\":4,\"listens\":60,\"last_rejected_at\":1640995200}]",
+			userID: 1,
+		},
+		{
+			name: "User is not admin",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().SuspiciousAccounts(gomock.Any(), &catalogMicroservice.SuspiciousAccountsOptions{AdminID: 1}).
+					Return(nil, status.Error(codes.PermissionDenied, constants.NotAdminMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":403,\"message\":\"Only administrators can manage catalog\"}",
+			userID:         1,
+		},
+		{
+			name: "User unauthorized -> UserID = -1",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				return catalogMock.NewMockCatalogClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/admin/listens/suspicious", strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			controller := gomock.NewController(t)
+			catalogManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, catalogManagerMock, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.GetSuspiciousAccounts(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_RequireAdmin(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *catalogMock.MockCatalogClient
+		expectedStatus int
+		expectedJSON   string
+		expectedNext   bool
+		doNotSetUserID bool
+		userID         int
+	}{
+		{
+			name: "Admin passes to handler",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CheckAdmin(gomock.Any(), &catalogMicroservice.CheckAdminOptions{AdminID: 1}).
+					Return(&catalogMicroservice.CheckAdminResponse{}, nil)
+				return moq
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedNext:   true,
+			userID:         1,
+		},
+		{
+			name: "User is not admin",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				moq := catalogMock.NewMockCatalogClient(controller)
+				moq.EXPECT().CheckAdmin(gomock.Any(), &catalogMicroservice.CheckAdminOptions{AdminID: 1}).
+					Return(nil, status.Error(codes.PermissionDenied, constants.NotAdminMessage))
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":403,\"message\":\"Only administrators can manage catalog\"}",
+			userID:         1,
+		},
+		{
+			name: "User unauthorized -> handler answers itself",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				return catalogMock.NewMockCatalogClient(controller)
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedNext:   true,
+			userID:         -1,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *catalogMock.MockCatalogClient {
+				return catalogMock.NewMockCatalogClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.POST, "/api/v1/admin/artists", strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.Set("REQUEST_ID", "1")
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			controller := gomock.NewController(t)
+			catalogManagerMock := currentTest.mock(controller)
+
+			var isNextCalled bool
+			next := func(ctx echo.Context) error {
+				isNextCalled = true
+				return ctx.NoContent(http.StatusNoContent)
+			}
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, catalogManagerMock, nil, nil, nil, nil, nil)
+			if assert.NoError(t, r.RequireAdmin(next)(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+				assert.Equal(t, currentTest.expectedNext, isNextCalled)
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_UpdateQueue(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name              string
+		mock              func(*gomock.Controller) *playerMock.MockPlayerClient
+		body              string
+		expectedStatus    int
+		expectedJSON      string
+		doNotSetRequestID bool
+		doNotSetUserID    bool
+		userID            int
+	}{
+		{
+			name: "Handler saved queue",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().UpdateQueue(gomock.Any(), &playerMicroservice.UpdateQueueOptions{
+					UserID: 1,
+					Queue: &playerMicroservice.Queue{TrackIDs: []int64{1, 2}, CurrentIndex: 1, RepeatMode: "all",
+						ContextType: "album", ContextID: 3, Version: 2, DeviceID: "phone"},
+				}).Return(&playerMicroservice.Queue{TrackIDs: []int64{1, 2}, CurrentIndex: 1, RepeatMode: "all",
+					ContextType: "album", ContextID: 3, Version: 3, DeviceID: "phone", UpdatedAt: 1600000000}, nil)
+				return moq
+			},
+			body: "{\"track_ids\":[1,2],\"current_index\":1,\"repeat_mode\":\"all\",\"context_type\":\"album\"," +
+				"\"context_id\":3,\"version\":2,\"device_id\":\"phone\"}",
+			expectedStatus: http.StatusOK,
+			expectedJSON: "{\"track_ids\":[1,2],\"current_index\":1,\"position_ms\":0,\"shuffle\":false,\"repeat_mode\":\"all\"," +
+				"\"context_type\":\"album\",\"context_id\":3,\"version\":3,\"device_id\":\"phone\",\"updated_at\":1600000000}",
+			userID: 1,
+		},
+		{
+			name: "Handler returned status 409",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().UpdateQueue(gomock.Any(), &playerMicroservice.UpdateQueueOptions{
+					UserID: 1,
+					Queue:  &playerMicroservice.Queue{Version: 1},
+				}).Return(nil, status.Error(codes.Aborted, constants.QueueVersionConflictMessage))
+				return moq
+			},
+			body:           "{\"version\":1}",
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":409,\"message\":\"Queue was changed on another device\"}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().UpdateQueue(gomock.Any(), &playerMicroservice.UpdateQueueOptions{
+					UserID: 1,
+					Queue:  &playerMicroservice.Queue{CurrentIndex: 5},
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.QueueIndexInvalidMessage))
+				return moq
+			},
+			body:           "{\"current_index\":5}",
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Current index is out of queue\"}",
+			userID:         1,
+		},
+		{
+			name: "Invalid body",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			body:           "{\"track_ids\":\"qwe\"}",
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+		},
+		{
+			name: "No RequestID",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			doNotSetRequestID: true,
+		},
+		{
+			name: "No UserID",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			doNotSetUserID: true,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.PUT, "/api/v1/queue", strings.NewReader(currentTest.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+
+			if !currentTest.doNotSetRequestID {
+				ctx.Set("REQUEST_ID", "1")
+			}
+			if !currentTest.doNotSetUserID {
+				ctx.Set("USER_ID", currentTest.userID)
+			}
+
+			controller := gomock.NewController(t)
+			playerManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, playerManagerMock, nil, nil, nil, nil)
+			if assert.NoError(t, r.UpdateQueue(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_QueueEvents(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *playerMock.MockPlayerClient
+		expectedStatus int
+		expectedBody   string
+		userID         int
+	}{
+		{
+			name: "Handler sent current queue and its changes",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				stream := playerMock.NewMockPlayer_WatchQueueClient(controller)
+				gomock.InOrder(
+					stream.EXPECT().Recv().Return(&playerMicroservice.Queue{TrackIDs: []int64{1}, RepeatMode: "off", Version: 1}, nil),
+					stream.EXPECT().Recv().Return(&playerMicroservice.Queue{TrackIDs: []int64{1, 2}, RepeatMode: "off", Version: 2}, nil),
+					stream.EXPECT().Recv().Return(nil, io.EOF),
+				)
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().WatchQueue(gomock.Any(), &playerMicroservice.GetQueueOptions{UserID: 1}).Return(stream, nil)
+				return moq
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "id: 1\nevent: queue\ndata: {\"track_ids\":[1],\"current_index\":0,\"position_ms\":0,\"shuffle\":false," +
+				"\"repeat_mode\":\"off\",\"version\":1}\n\n" +
+				"id: 2\nevent: queue\ndata: {\"track_ids\":[1,2],\"current_index\":0,\"position_ms\":0,\"shuffle\":false," +
+				"\"repeat_mode\":\"off\",\"version\":2}\n\n",
+			userID: 1,
+		},
+		{
+			name: "Handler returned status 500",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				stream := playerMock.NewMockPlayer_WatchQueueClient(controller)
+				stream.EXPECT().Recv().Return(nil, status.Error(codes.Internal, "error"))
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().WatchQueue(gomock.Any(), &playerMicroservice.GetQueueOptions{UserID: 1}).Return(stream, nil)
+				return moq
+			},
+			expectedStatus: http.StatusInternalServerError,
+			userID:         1,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/queue/events", strings.NewReader(""))
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			controller := gomock.NewController(t)
+			playerManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, playerManagerMock, nil, nil, nil, nil)
+			if assert.NoError(t, r.QueueEvents(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_Events(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	broker := events.NewMemoryBroker()
+	_ = broker.Publish(1, constants.EventTypePlaylistChanged, &events.PlaylistChanged{PlaylistID: 2, Action: constants.PlaylistActionUpdated})
+	_ = broker.Publish(1, constants.EventTypePlaylistChanged, &events.PlaylistChanged{PlaylistID: 2, Action: constants.PlaylistActionTrackAdded})
+	_ = broker.Publish(1, constants.EventTypeNowPlaying, &events.NowPlaying{TrackID: 3, PositionMs: 100})
+
+	tests := []struct {
+		name           string
+		lastEventID    string
+		expectedStatus int
+		expectedBody   string
+		userID         int
+	}{
+		{
+			name:           "Handler replayed missed events",
+			lastEventID:    "1",
+			expectedStatus: http.StatusOK,
+			expectedBody: "retry: 3000\n\n" +
+				"id: 2\nevent: playlist_changed\ndata: {\"playlist_id\":2,\"action\":\"track_added\"}\n\n" +
+				"id: 3\nevent: now_playing\ndata: {\"track_id\":3,\"position_ms\":100}\n\n",
+			userID: 1,
+		},
+		{
+			name:           "Handler sent nothing to user without events",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "retry: 3000\n\n",
+			userID:         2,
+		},
+		{
+			name:           "Handler returned status 400 (invalid Last-Event-ID)",
+			lastEventID:    "first",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":400,\"message\":\"Last event ID must be an integer\"}",
+			userID:         1,
+		},
+		{
+			name:           "Handler sent resync for unknown Last-Event-ID",
+			lastEventID:    "10",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "retry: 3000\n\nid: 3\nevent: resync\ndata: {}\n\n",
+			userID:         1,
+		},
+		{
+			name:           "Unauthorized: userID = -1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			requestCtx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			server := echo.New()
+			req := httptest.NewRequest(echo.GET, "/api/v1/events", strings.NewReader("")).WithContext(requestCtx)
+			if len(currentTest.lastEventID) != 0 {
+				req.Header.Set(constants.LastEventIDHeader, currentTest.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, nil, nil, nil, nil, broker)
+			if assert.NoError(t, r.Events(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_RegisterDevice(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	tests := []struct {
+		name           string
+		mock           func(*gomock.Controller) *playerMock.MockPlayerClient
+		body           string
+		expectedStatus int
+		expectedJSON   string
+		doNotSetCookie bool
+		userID         int
+	}{
+		{
+			name: "Handler registered device",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().RegisterDevice(gomock.Any(), &playerMicroservice.RegisterDeviceOptions{
+					UserID:  1,
+					Session: "cookie",
+					Name:    "Phone",
+					Type:    "mobile",
+				}).Return(&playerMicroservice.Device{ID: "phone", UserID: 1, Name: "Phone", Type: "mobile", RegisteredAt: 1600000000}, nil)
+				return moq
+			},
+			body:           "{\"name\":\"Phone\",\"type\":\"mobile\"}",
+			expectedStatus: http.StatusCreated,
+			expectedJSON:   "{\"id\":\"phone\",\"name\":\"Phone\",\"type\":\"mobile\",\"online\":false,\"registered_at\":1600000000}",
+			userID:         1,
+		},
+		{
+			name: "Handler returned status 400",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				moq := playerMock.NewMockPlayerClient(controller)
+				moq.EXPECT().RegisterDevice(gomock.Any(), &playerMicroservice.RegisterDeviceOptions{
+					UserID:  1,
+					Session: "cookie",
+					Name:    "Phone",
+					Type:    "fridge",
+				}).Return(nil, status.Error(codes.InvalidArgument, constants.DeviceTypeInvalidMessage))
+				return moq
+			},
+			body:           "{\"name\":\"Phone\",\"type\":\"fridge\"}",
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":400,\"message\":\"Device type must be web, desktop, mobile or speaker\"}",
+			userID:         1,
+		},
+		{
+			name: "No session cookie",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			doNotSetCookie: true,
+			userID:         1,
+		},
+		{
+			name: "Unauthorized: userID = -1",
+			mock: func(controller *gomock.Controller) *playerMock.MockPlayerClient {
+				return playerMock.NewMockPlayerClient(controller)
+			},
+			expectedStatus: http.StatusOK,
+			expectedJSON:   "{\"status\":401,\"message\":\"User is not authorized\"}",
+			userID:         -1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			server := echo.New()
+			req := httptest.NewRequest(echo.POST, "/api/v1/devices", strings.NewReader(currentTest.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if !currentTest.doNotSetCookie {
+				req.AddCookie(&http.Cookie{Name: "Session_cookie", Value: "cookie"})
+			}
+			rec := httptest.NewRecorder()
+			ctx := server.NewContext(req, rec)
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", currentTest.userID)
+
+			controller := gomock.NewController(t)
+			playerManagerMock := currentTest.mock(controller)
+
+			r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, playerManagerMock, nil, nil, nil, nil)
+			if assert.NoError(t, r.RegisterDevice(ctx)) {
+				assert.Equal(t, currentTest.expectedStatus, rec.Code)
+				assert.Equal(t, currentTest.expectedJSON, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIMicroservices_DeviceCommands(t *testing.T) {
+	config := zap.NewDevelopmentConfig()
+	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	prLogger, _ := config.Build()
+	logger := prLogger.Sugar()
+	defer func(prLogger *zap.Logger) {
+		_ = prLogger.Sync()
+	}(prLogger)
+
+	t.Run("Handler returned status 403", func(t *testing.T) {
+		server := echo.New()
+		req := httptest.NewRequest(echo.GET, "/api/v1/devices/phone/commands", strings.NewReader(""))
+		req.AddCookie(&http.Cookie{Name: "Session_cookie", Value: "stolen"})
+		rec := httptest.NewRecorder()
+		ctx := server.NewContext(req, rec)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("phone")
+		ctx.Set("REQUEST_ID", "1")
+		ctx.Set("USER_ID", 1)
+
+		controller := gomock.NewController(t)
+		stream := playerMock.NewMockPlayer_WatchCommandsClient(controller)
+		stream.EXPECT().Recv().Return(nil, status.Error(codes.PermissionDenied, constants.DeviceSessionMismatchMessage))
+		playerManagerMock := playerMock.NewMockPlayerClient(controller)
+		playerManagerMock.EXPECT().WatchCommands(gomock.Any(), &playerMicroservice.WatchCommandsOptions{
+			UserID:   1,
+			Session:  "stolen",
+			DeviceID: "phone",
+		}).Return(stream, nil)
+
+		r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, playerManagerMock, nil, nil, nil, nil)
+		if assert.NoError(t, r.DeviceCommands(ctx)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "{\"status\":403,\"message\":\"Device was registered in another session\"}", rec.Body.String())
+		}
+	})
+
+	t.Run("Handler forwarded commands", func(t *testing.T) {
+		const origin = "https://lostpointer.site"
+		t.Setenv("CORS_ORIGIN", origin)
+
+		controller := gomock.NewController(t)
+		released := make(chan struct{})
+		defer close(released)
+		stream := playerMock.NewMockPlayer_WatchCommandsClient(controller)
+		gomock.InOrder(
+			stream.EXPECT().Recv().Return(&playerMicroservice.DeviceCommand{Type: "connected", TargetDeviceID: "phone"}, nil),
+			stream.EXPECT().Recv().Return(&playerMicroservice.DeviceCommand{Type: "pause", SourceDeviceID: "laptop", TargetDeviceID: "phone"}, nil),
+			stream.EXPECT().Recv().DoAndReturn(func() (*playerMicroservice.DeviceCommand, error) {
+				<-released
+				return nil, io.EOF
+			}),
+		)
+		playerManagerMock := playerMock.NewMockPlayerClient(controller)
+		playerManagerMock.EXPECT().WatchCommands(gomock.Any(), &playerMicroservice.WatchCommandsOptions{
+			UserID:   1,
+			Session:  "cookie",
+			DeviceID: "phone",
+		}).Return(stream, nil)
+		playerManagerMock.EXPECT().SendCommand(gomock.Any(), &playerMicroservice.SendCommandOptions{
+			UserID: 1,
+			Command: &playerMicroservice.DeviceCommand{
+				Type:           "play",
+				SourceDeviceID: "phone",
+				TargetDeviceID: "laptop",
+			},
+		}).Return(nil, status.Error(codes.NotFound, constants.DeviceOfflineMessage))
+
+		r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, playerManagerMock, nil, nil, nil, nil)
+		server := echo.New()
+		server.GET("/api/v1/devices/:id/commands", func(ctx echo.Context) error {
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", 1)
+			return r.DeviceCommands(ctx)
+		})
+		testServer := httptest.NewServer(server)
+		defer testServer.Close()
+
+		wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(testServer.URL, "http")+"/api/v1/devices/phone/commands", origin)
+		assert.NoError(t, err)
+		wsConfig.Header.Set("Cookie", "Session_cookie=cookie")
+		conn, err := websocket.DialConfig(wsConfig)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+
+		var message string
+		assert.NoError(t, websocket.Message.Receive(conn, &message))
+		assert.Equal(t, "{\"type\":\"connected\",\"target_device_id\":\"phone\"}", message)
+		assert.NoError(t, websocket.Message.Receive(conn, &message))
+		assert.Equal(t, "{\"type\":\"pause\",\"source_device_id\":\"laptop\",\"target_device_id\":\"phone\"}", message)
+
+		// Устройство не может выдать себя за другое: источником всегда считается устройство канала
+		assert.NoError(t, websocket.Message.Send(conn, "{\"type\":\"play\",\"source_device_id\":\"tv\",\"target_device_id\":\"laptop\"}"))
+		assert.NoError(t, websocket.Message.Receive(conn, &message))
+		assert.Equal(t, "{\"status\":404,\"message\":\"Device is offline\"}", message)
+	})
+
+	t.Run("Foreign origin is rejected", func(t *testing.T) {
+		t.Setenv("CORS_ORIGIN", "https://lostpointer.site")
+
+		controller := gomock.NewController(t)
+		stream := playerMock.NewMockPlayer_WatchCommandsClient(controller)
+		stream.EXPECT().Recv().Return(&playerMicroservice.DeviceCommand{Type: "connected", TargetDeviceID: "phone"}, nil)
+		playerManagerMock := playerMock.NewMockPlayerClient(controller)
+		playerManagerMock.EXPECT().WatchCommands(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+		r := NewAPIMicroservices(logger, image.NewImagesService(), nil, nil, nil, nil, nil, playerManagerMock, nil, nil, nil, nil)
+		server := echo.New()
+		server.GET("/api/v1/devices/:id/commands", func(ctx echo.Context) error {
+			ctx.Set("REQUEST_ID", "1")
+			ctx.Set("USER_ID", 1)
+			return r.DeviceCommands(ctx)
+		})
+		testServer := httptest.NewServer(server)
+		defer testServer.Close()
+
+		wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(testServer.URL, "http")+"/api/v1/devices/phone/commands", "https://evil.example")
+		assert.NoError(t, err)
+		wsConfig.Header.Set("Cookie", "Session_cookie=cookie")
+		_, err = websocket.DialConfig(wsConfig)
+		assert.Error(t, err)
+	})
+}
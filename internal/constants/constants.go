@@ -10,6 +10,10 @@ const (
 	UserAvatarExtension150px      = "_150px.webp"
 	PlaylistArtworkExtension384px = "_384px.webp"
 	PlaylistArtworkExtension100px = "_100px.webp"
+	AlbumArtworkExtension384px    = "_384px.webp"
+	AlbumArtworkExtension100px    = "_100px.webp"
+	TrackLossyExtension           = ".mp3"
+	TrackLosslessExtension        = ".flac"
 
 	// Atoi
 	PasswordRequiredLength = "8"
@@ -17,6 +21,10 @@ const (
 	MaxNicknameLength      = "15"
 	MinPlaylistTitleLength = "3"
 	MaxPlaylistTitleLength = "30"
+	MinCatalogNameLength   = "1"
+	MaxCatalogNameLength   = "100"
+	MinDeviceNameLength    = "1"
+	MaxDeviceNameLength    = "64"
 
 	// Валидация
 	PasswordInvalidLengthMessage      = "Password must contain at least " + PasswordRequiredLength + " characters"
@@ -26,11 +34,23 @@ const (
 	NicknameInvalidSyntaxMessage      = "Nickname must contain letters or numbers or '_'"
 	PlaylistTitleInvalidLengthMessage = "The length of title must be from " + MinPlaylistTitleLength + " to " + MaxPlaylistTitleLength + " characters"
 	EmailInvalidSyntaxMessage         = "Invalid email"
+	CatalogNameInvalidLengthMessage   = "The length of name must be from " + MinCatalogNameLength + " to " + MaxCatalogNameLength + " characters"
+	AlbumYearInvalidMessage           = "Invalid album year"
+	AlbumReleaseDateInvalidMessage    = "Release date must be YYYY-MM-DD and match album year"
+	TrackNumberInvalidMessage         = "Track number can't be negative"
+	TrackDurationInvalidMessage       = "Track duration must be positive"
+	DeviceNameInvalidLengthMessage    = "The length of device name must be from " + MinDeviceNameLength + " to " + MaxDeviceNameLength + " characters"
 
 	// Значения по умолчанию
 	AvatarDefaultFileName          = "default_avatar"
 	PlaylistArtworkDefaultFilename = "default_playlist_artwork"
 	PlaylistArtworkDefaultColor    = "#8071c2"
+	GenreArtworkDefaultFilename    = "default_genre_artwork"
+	GenreArtworkDefaultColor       = "#8071c2"
+	GenreDefaultName               = "Unknown"
+	ArtistAvatarDefaultFilename    = "no_avatar"
+	ArtistVideoDefaultFilename     = " "
+	AlbumArtworkDefaultColor       = "#4b4b4b"
 
 	// Сообщения
 	EmailNotUniqueMessage            = "Email is not unique"
@@ -56,7 +76,78 @@ const (
 	TrackNotInFavorites              = "Track not in favorites"
 	TrackAddedToFavoritesMessage     = "Track was successfully added to favorites"
 	TrackDeletedFromFavoritesMessage = "Track was successfully deleted from favorites"
+	AlbumAlreadySavedMessage         = "Album is already saved"
+	AlbumNotSavedMessage             = "Album is not saved"
+	AlbumSavedMessage                = "Album was successfully saved"
+	AlbumUnsavedMessage              = "Album was successfully removed from library"
+	ArtistAlreadyFollowedMessage     = "Artist is already followed"
+	ArtistNotFollowedMessage         = "Artist is not followed"
+	ArtistFollowedMessage            = "Artist was successfully followed"
+	ArtistUnfollowedMessage          = "Artist was successfully unfollowed"
 	TrackNotFound                    = "Track not found"
+	TrackIDsRequiredMessage          = "Track ids are required"
+	TrackIDsTooManyMessage           = "Too many track ids"
+	ChartPeriodInvalidMessage        = "Chart period must be daily, weekly or monthly"
+	ChartEntityInvalidMessage        = "Chart entity must be tracks, albums or artists"
+	ChartNotReadyMessage             = "Chart is not ready yet"
+	GenreNotFoundMessage             = "Genre not found"
+	SortInvalidMessage               = "Sort must be popularity, year or title"
+	CursorInvalidMessage             = "Invalid cursor"
+	FavoritesSortInvalidMessage      = "Sort must be date_added, title, artist, duration or listen_count"
+	SearchQueryInvalidMessage        = "Invalid search query"
+	SearchQueryEmptyMessage          = "Search query is empty"
+	SearchResultTypeInvalidMessage   = "Result type must be track, artist, album or playlist"
+	SearchResultRecordedMessage      = "Search result was recorded"
+	RecentSearchNotFoundMessage      = "Recent search not found"
+	RecentSearchDeletedMessage       = "Recent search was deleted"
+	RecentSearchesClearedMessage     = "Recent searches were cleared"
+	StreamQualityInvalidMessage      = "Quality must be lossy or lossless"
+	MediaURLInvalidMessage           = "Invalid media url"
+	MediaURLExpiredMessage           = "Media url expired"
+	MediaFileNotFoundMessage         = "Media file not found"
+	ListenCountDeprecatedMessage     = "Listens are counted when the track is streamed"
+	NotAdminMessage                  = "Only administrators can manage catalog"
+	ArtistNotFoundMessage            = "Artist not found"
+	AlbumNotFoundMessage             = "Album not found"
+	ArtistNameNotUniqueMessage       = "Artist name is not unique"
+	GenreNameNotUniqueMessage        = "Genre name is not unique"
+	GenreHasTracksMessage            = "Genre has tracks, move them to another genre first"
+	TrackFileRequiredMessage         = "Track file is required"
+	TrackFileInvalidMessage          = "Track file must be mp3, lossless file must be flac"
+	CatalogEntryUpdatedMessage       = "Catalog entry was updated"
+	LyricsNotFoundMessage            = "Track has no lyrics"
+	LyricsRequiredMessage            = "Plain or LRC lyrics are required"
+	LyricsInvalidMessage             = "Invalid LRC lyrics"
+	LyricsUpdatedMessage             = "Lyrics were updated"
+	LyricsDeletedMessage             = "Lyrics were deleted"
+	CatalogEntryDeletedMessage       = "Catalog entry was deleted"
+	TrackAlreadyDislikedMessage      = "Track is already disliked"
+	TrackNotDislikedMessage          = "Track is not disliked"
+	TrackDislikedMessage             = "Track was successfully disliked"
+	TrackDislikeDeletedMessage       = "Track dislike was successfully deleted"
+	RadioSeedTypeInvalidMessage      = "Seed type must be track, artist, album or playlist"
+	RadioSeedNotFoundMessage         = "Radio seed not found"
+	RadioSessionNotFoundMessage      = "Radio session not found or expired"
+	QueueTooLongMessage              = "Queue is too long"
+	QueueIndexInvalidMessage         = "Current index is out of queue"
+	QueuePositionInvalidMessage      = "Position must not be negative"
+	QueueRepeatModeInvalidMessage    = "Repeat mode must be off, all or one"
+	QueueContextInvalidMessage       = "Context type must be album, artist, playlist, genre, radio or favorites"
+	QueueVersionConflictMessage      = "Queue was changed on another device"
+	DeviceTypeInvalidMessage         = "Device type must be web, desktop, mobile or speaker"
+	DeviceNotFoundMessage            = "Device not found"
+	DeviceNotOwnedMessage            = "Device belongs to another user"
+	DeviceSessionMismatchMessage     = "Device was registered in another session"
+	DeviceOfflineMessage             = "Device is offline"
+	DeviceCommandInvalidMessage      = "Command must be play, pause, next, previous, seek or transfer"
+	WebSocketOriginForbiddenMessage  = "Origin is not allowed"
+	EventStreamClosedMessage         = "Event stream was closed, client has to reconnect"
+	LastEventIDInvalidMessage        = "Last event ID must be an integer"
+	RecapYearInvalidMessage          = "Invalid recap year"
+	RecapNotFoundMessage             = "Recap for this year is not ready"
+	ListenLimitExceededMessage       = "Too many listens, try again later"
+	SelectionPageInvalidMessage      = "Page must not be negative"
+	SelectionInvalidMessage          = "Seed, generation and page must be integers"
 
 	// Ограничения/лимиты
 	ArtistTracksSelectionAmount    = 10
@@ -67,9 +158,163 @@ const (
 	SearchTracksAmount             = 4
 	SearchArtistsAmount            = 4
 	SearchAlbumsAmount             = 3
+	ChartPreviewAmount             = 10
+	ChartPositionsAmount           = 100
+	GenreTracksSelectionAmount     = 20
+	GenreAlbumsSelectionAmount     = 8
+	GenreArtistsSelectionAmount    = 8
+	ArtistDiscographyPageAmount    = 20
+	PageDefaultAmount              = 50
+	PageMaxAmount                  = 100
+	TracksBatchMaxAmount           = 100
+	SearchPageAmount               = 20
+	SuggestDefaultAmount           = 8
+	SuggestMaxAmount               = 20
+	RecentSearchesDefaultAmount    = 10
+	RecentSearchesMaxAmount        = 20
+	MinAlbumYear                   = 1900
+	ArtistAvatarSize               = 500
+
+	// Чарты
+	ChartPeriodDaily   = "daily"
+	ChartPeriodWeekly  = "weekly"
+	ChartPeriodMonthly = "monthly"
+	ChartEntityTracks  = "tracks"
+	ChartEntityAlbums  = "albums"
+	ChartEntityArtists = "artists"
+	ChartDateLayout    = "2006-01-02"
+
+	// Случайные подборки. Идентификаторы хранятся в Redis в перемешанном виде и периодически пересобираются
+	RandomPoolsRebuildInterval = time.Hour
+	RandomPoolWriteBatch       = 1000
+	RandomPoolLifetime         = RandomPoolsRebuildInterval * 3
+
+	// Подсказки поиска
+	SuggestionTypeTrack              = "track"
+	SuggestionTypeArtist             = "artist"
+	SuggestionTypeAlbum              = "album"
+	SuggestionTypePlaylist           = "playlist"
+	SuggestionsCacheLifetime         = time.Minute * 10
+	PersonalSuggestionsCacheLifetime = time.Minute
+
+	// Радио
+	RadioSeedTrack        = "track"
+	RadioSeedArtist       = "artist"
+	RadioSeedAlbum        = "album"
+	RadioSeedPlaylist     = "playlist"
+	RadioPoolFamiliar     = "familiar"
+	RadioPoolFresh        = "fresh"
+	RadioDefaultAmount    = 10
+	RadioMaxAmount        = 50
+	RadioFamiliarPercent  = 30
+	RadioHistoryMaxAmount = 500
+	RadioCandidatesFactor = 10
+	RadioSessionLifetime  = time.Hour * 6
+
+	// Очередь воспроизведения
+	RepeatModeOff                = "off"
+	RepeatModeAll                = "all"
+	RepeatModeOne                = "one"
+	QueueContextAlbum            = "album"
+	QueueContextArtist           = "artist"
+	QueueContextPlaylist         = "playlist"
+	QueueContextGenre            = "genre"
+	QueueContextRadio            = "radio"
+	QueueContextFavorites        = "favorites"
+	QueueMaxAmount               = 1000
+	EventStreamHeartbeatInterval = time.Second * 30
+	EventStreamContentType       = "text/event-stream"
+
+	// Устройства
+	DeviceTypeWeb                 = "web"
+	DeviceTypeDesktop             = "desktop"
+	DeviceTypeMobile              = "mobile"
+	DeviceTypeSpeaker             = "speaker"
+	DeviceCommandConnected        = "connected"
+	DeviceCommandPlay             = "play"
+	DeviceCommandPause            = "pause"
+	DeviceCommandNext             = "next"
+	DeviceCommandPrevious         = "previous"
+	DeviceCommandSeek             = "seek"
+	DeviceCommandTransfer         = "transfer"
+	DevicePresenceLifetime        = time.Second * 60
+	DevicePresenceRefreshInterval = time.Second * 20
+
+	// События
+	EventTypePlaylistChanged   = "playlist_changed"
+	EventTypeNowPlaying        = "now_playing"
+	EventTypeResync            = "resync"
+	PlaylistActionUpdated      = "updated"
+	PlaylistActionDeleted      = "deleted"
+	PlaylistActionTrackAdded   = "track_added"
+	PlaylistActionTrackRemoved = "track_removed"
+	EventsHistoryAmount        = 100
+	EventsHistoryLifetime      = time.Hour * 24
+	EventsBufferSize           = 64
+	EventStreamRetry           = time.Second * 3
+	EventsRedisDB              = 4
+
+	// Новинки
+	SystemUserID                = 0
+	ReleaseRadarPeriod          = time.Hour * 24 * 28
+	ReleaseRadarListensPeriod   = time.Hour * 24 * 90
+	ReleaseRadarMinListens      = 3
+	ReleaseRadarAlbumsAmount    = 30
+	ReleaseRadarTracksAmount    = 50
+	ReleaseRadarPlaylistTitle   = "Release Radar"
+	ReleaseRadarRebuildInterval = time.Hour * 6
+
+	// Итоги года
+	RecapEntityGenres     = "genres"
+	RecapTopAmount        = 10
+	RecapTopTracksAmount  = 100
+	RecapPlaylistTitle    = "My top tracks %d"
+	RecapsRebuildInterval = time.Hour * 24
+
+	// Защита счётчика прослушиваний. Окно скользящее, лимиты считаются отдельно по пользователю и по IP
+	ListenLimitWindow        = time.Hour
+	ListenUserLimit          = 60
+	ListenIPLimit            = 200
+	ListenRejectUserLimit    = "user_limit"
+	ListenRejectIPLimit      = "ip_limit"
+	SuspiciousAccountsPeriod = time.Hour * 24 * 7
+	SuspiciousMinRejections  = 10
+	SuspiciousAccountsAmount = 100
+
+	// Стриминг
+	StreamQualityLossy       = "lossy"
+	StreamQualityLossless    = "lossless"
+	TrackLossyContentType    = "audio/mpeg"
+	TrackLosslessContentType = "audio/flac"
+	MediaURLLifetime         = time.Hour * 6
+
+	// Аудит каталога
+	CatalogActionCreate = "create"
+	CatalogActionUpdate = "update"
+	CatalogActionDelete = "delete"
+	CatalogEntityArtist = "artist"
+	CatalogEntityAlbum  = "album"
+	CatalogEntityTrack  = "track"
+	CatalogEntityGenre  = "genre"
+	CatalogEntityLyrics = "lyrics"
+
+	// Сортировка
+	SortByPopularity  = "popularity"
+	SortByYear        = "year"
+	SortByTitle       = "title"
+	SortByDateAdded   = "date_added"
+	SortByArtist      = "artist"
+	SortByDuration    = "duration"
+	SortByListenCount = "listen_count"
 
 	// Прочее
-	SaltLength        = 8
-	CookieLifetime    = time.Hour * 24 * 30
-	CSRFTokenLifetime = 900
+	SaltLength                = 8
+	CookieLifetime            = time.Hour * 24 * 30
+	CSRFTokenLifetime         = 900
+	ChartsRebuildInterval     = time.Hour
+	NextCursorHeader          = "X-Next-Cursor"
+	TotalHintHeader           = "X-Total-Hint"
+	SelectionSeedHeader       = "X-Selection-Seed"
+	SelectionGenerationHeader = "X-Selection-Generation"
+	LastEventIDHeader         = "Last-Event-ID"
 )
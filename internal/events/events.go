@@ -0,0 +1,173 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mailru/easyjson"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+// Событие для конкретного пользователя. ID задается брокером и растет на единицу для каждого пользователя,
+// поэтому по Last-Event-ID можно понять, какие события клиент пропустил
+//
+//easyjson:json
+type Event struct {
+	ID        int64               `json:"-"`
+	Type      string              `json:"type"`
+	Data      easyjson.RawMessage `json:"data,omitempty"`
+	CreatedAt int64               `json:"created_at"`
+}
+
+//easyjson:json
+type PlaylistChanged struct {
+	PlaylistID int64  `json:"playlist_id"`
+	Action     string `json:"action"`
+}
+
+//easyjson:json
+type NowPlaying struct {
+	TrackID    int64  `json:"track_id"`
+	DeviceID   string `json:"device_id,omitempty"`
+	PositionMs int64  `json:"position_ms"`
+}
+
+// События отправляются после того, как изменение сохранено, поэтому ошибку Publish вызывающий код
+// игнорирует: она не отменяет изменение, и клиент увидит его при следующей загрузке
+type Publisher interface {
+	Publish(userID int64, eventType string, data easyjson.Marshaler) error
+}
+
+// Subscribe возвращает канал событий пользователя. Если lastEventID больше нуля, сначала отправляются
+// сохраненные события после него. Канал закрывается после отмены ctx или если клиент не успевает читать события:
+// тогда клиент должен переподключиться с последним полученным ID
+type Broker interface {
+	Publisher
+	Subscribe(ctx context.Context, userID int64, lastEventID int64) (<-chan Event, error)
+}
+
+type subscriber struct {
+	events chan Event
+}
+
+// Раздает события подписчикам текущего экземпляра. Отправка не блокируется: подписчик,
+// у которого переполнился буфер, отключается
+type hub struct {
+	mutex       sync.Mutex
+	subscribers map[int64]map[*subscriber]struct{}
+}
+
+func newHub() hub {
+	return hub{subscribers: make(map[int64]map[*subscriber]struct{})}
+}
+
+func (h *hub) add(userID int64) *subscriber {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	current := &subscriber{events: make(chan Event, constants.EventsBufferSize)}
+	if _, ok := h.subscribers[userID]; !ok {
+		h.subscribers[userID] = make(map[*subscriber]struct{})
+	}
+	h.subscribers[userID][current] = struct{}{}
+
+	return current
+}
+
+func (h *hub) remove(userID int64, current *subscriber) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.drop(userID, current)
+}
+
+func (h *hub) drop(userID int64, current *subscriber) {
+	if _, ok := h.subscribers[userID][current]; !ok {
+		return
+	}
+	delete(h.subscribers[userID], current)
+	if len(h.subscribers[userID]) == 0 {
+		delete(h.subscribers, userID)
+	}
+	close(current.events)
+}
+
+func (h *hub) dispatch(userID int64, event Event) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for current := range h.subscribers[userID] {
+		select {
+		case current.events <- event:
+		default:
+			h.drop(userID, current)
+		}
+	}
+}
+
+// Подписка оформляется до чтения истории, а события, уже отправленные из истории, отбрасываются,
+// поэтому событие между чтением истории и подпиской не теряется и не дублируется.
+// history возвращает события после lastEventID и текущий ID последнего события пользователя.
+// Если в истории нет части пропущенных событий или она уже истекла, клиенту отправляется resync
+func (h *hub) subscribe(ctx context.Context, userID int64, lastEventID int64,
+	history func() ([]Event, int64, error)) (<-chan Event, error) {
+	current := h.add(userID)
+
+	var replay []Event
+	if lastEventID > 0 {
+		var sequence int64
+		var err error
+		if replay, sequence, err = history(); err != nil {
+			h.remove(userID, current)
+			return nil, err
+		}
+		switch {
+		case len(replay) != 0 && replay[0].ID > lastEventID+1:
+			replay = append([]Event{{ID: replay[0].ID - 1, Type: constants.EventTypeResync}}, replay...)
+		case len(replay) == 0 && sequence != lastEventID:
+			replay = []Event{{ID: sequence, Type: constants.EventTypeResync}}
+		}
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		defer h.remove(userID, current)
+
+		last := lastEventID
+		send := func(event Event) bool {
+			select {
+			case events <- event:
+				last = event.ID
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, event := range replay {
+			if !send(event) {
+				return
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-current.events:
+				if !ok {
+					return
+				}
+				if event.ID <= last {
+					continue
+				}
+				if !send(event) {
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
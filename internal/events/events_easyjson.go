@@ -0,0 +1,257 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package events
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson692db02bDecode20212LostPointerInternalEvents(in *jlexer.Lexer, out *PlaylistChanged) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "playlist_id":
+			out.PlaylistID = int64(in.Int64())
+		case "action":
+			out.Action = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson692db02bEncode20212LostPointerInternalEvents(out *jwriter.Writer, in PlaylistChanged) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"playlist_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.PlaylistID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistChanged) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson692db02bEncode20212LostPointerInternalEvents(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistChanged) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson692db02bEncode20212LostPointerInternalEvents(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistChanged) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson692db02bDecode20212LostPointerInternalEvents(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistChanged) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson692db02bDecode20212LostPointerInternalEvents(l, v)
+}
+func easyjson692db02bDecode20212LostPointerInternalEvents1(in *jlexer.Lexer, out *NowPlaying) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackID = int64(in.Int64())
+		case "device_id":
+			out.DeviceID = string(in.String())
+		case "position_ms":
+			out.PositionMs = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson692db02bEncode20212LostPointerInternalEvents1(out *jwriter.Writer, in NowPlaying) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TrackID))
+	}
+	if in.DeviceID != "" {
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix)
+		out.String(string(in.DeviceID))
+	}
+	{
+		const prefix string = ",\"position_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PositionMs))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NowPlaying) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson692db02bEncode20212LostPointerInternalEvents1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NowPlaying) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson692db02bEncode20212LostPointerInternalEvents1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NowPlaying) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson692db02bDecode20212LostPointerInternalEvents1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NowPlaying) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson692db02bDecode20212LostPointerInternalEvents1(l, v)
+}
+func easyjson692db02bDecode20212LostPointerInternalEvents2(in *jlexer.Lexer, out *Event) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "data":
+			(out.Data).UnmarshalEasyJSON(in)
+		case "created_at":
+			out.CreatedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson692db02bEncode20212LostPointerInternalEvents2(out *jwriter.Writer, in Event) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Type))
+	}
+	if (in.Data).IsDefined() {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(in.Data).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Event) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson692db02bEncode20212LostPointerInternalEvents2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Event) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson692db02bEncode20212LostPointerInternalEvents2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Event) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson692db02bDecode20212LostPointerInternalEvents2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson692db02bDecode20212LostPointerInternalEvents2(l, v)
+}
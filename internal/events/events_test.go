@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+func TestHub_SubscribeWithExpiredHistory(t *testing.T) {
+	current := newHub()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := current.subscribe(ctx, 1, 3, func() ([]Event, int64, error) {
+		return []Event{}, 7, nil
+	})
+	assert.NoError(t, err)
+
+	event, ok := receive(t, events)
+	assert.True(t, ok)
+	assert.Equal(t, Event{ID: 7, Type: constants.EventTypeResync}, event)
+
+	// События до resync уже учтены в нем и не отправляются повторно
+	current.dispatch(1, Event{ID: 7, Type: constants.EventTypeNowPlaying})
+	current.dispatch(1, Event{ID: 8, Type: constants.EventTypeNowPlaying})
+	event, ok = receive(t, events)
+	assert.True(t, ok)
+	assert.Equal(t, int64(8), event.ID)
+}
+
+func TestHub_SubscribeUpToDate(t *testing.T) {
+	current := newHub()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := current.subscribe(ctx, 1, 7, func() ([]Event, int64, error) {
+		return []Event{}, 7, nil
+	})
+	assert.NoError(t, err)
+
+	current.dispatch(1, Event{ID: 8, Type: constants.EventTypeNowPlaying})
+	event, ok := receive(t, events)
+	assert.True(t, ok)
+	assert.Equal(t, Event{ID: 8, Type: constants.EventTypeNowPlaying}, event)
+}
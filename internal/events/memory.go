@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mailru/easyjson"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+// Брокер внутри одного процесса: для тестов и локального запуска без Redis
+type MemoryBroker struct {
+	hub
+
+	mutex     sync.Mutex
+	sequences map[int64]int64
+	histories map[int64][]Event
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		hub:       newHub(),
+		sequences: make(map[int64]int64),
+		histories: make(map[int64][]Event),
+	}
+}
+
+func (broker *MemoryBroker) Publish(userID int64, eventType string, data easyjson.Marshaler) error {
+	event := Event{Type: eventType, CreatedAt: time.Now().Unix()}
+	if data != nil {
+		raw, err := easyjson.Marshal(data)
+		if err != nil {
+			return err
+		}
+		event.Data = raw
+	}
+
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	broker.sequences[userID]++
+	event.ID = broker.sequences[userID]
+	history := append(broker.histories[userID], event)
+	if len(history) > constants.EventsHistoryAmount {
+		history = history[len(history)-constants.EventsHistoryAmount:]
+	}
+	broker.histories[userID] = history
+	broker.dispatch(userID, event)
+
+	return nil
+}
+
+func (broker *MemoryBroker) Subscribe(ctx context.Context, userID int64, lastEventID int64) (<-chan Event, error) {
+	return broker.subscribe(ctx, userID, lastEventID, func() ([]Event, int64, error) {
+		broker.mutex.Lock()
+		defer broker.mutex.Unlock()
+
+		events := make([]Event, 0)
+		for _, event := range broker.histories[userID] {
+			if event.ID > lastEventID {
+				events = append(events, event)
+			}
+		}
+
+		return events, broker.sequences[userID], nil
+	})
+}
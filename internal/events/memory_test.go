@@ -0,0 +1,131 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+func receive(t *testing.T, events <-chan Event) (Event, bool) {
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(time.Second):
+		t.Fatal("event was not received")
+		return Event{}, false
+	}
+}
+
+func TestMemoryBroker_Subscribe(t *testing.T) {
+	broker := NewMemoryBroker()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := broker.Subscribe(ctx, 1, 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, broker.Publish(2, constants.EventTypeNowPlaying, &NowPlaying{TrackID: 1}))
+	assert.NoError(t, broker.Publish(1, constants.EventTypePlaylistChanged,
+		&PlaylistChanged{PlaylistID: 3, Action: constants.PlaylistActionUpdated}))
+
+	event, ok := receive(t, events)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), event.ID)
+	assert.Equal(t, constants.EventTypePlaylistChanged, event.Type)
+	assert.Equal(t, "{\"playlist_id\":3,\"action\":\"updated\"}", string(event.Data))
+
+	cancel()
+	_, ok = receive(t, events)
+	assert.False(t, ok)
+}
+
+func TestMemoryBroker_SubscribeWithLastEventID(t *testing.T) {
+	tests := []struct {
+		name        string
+		published   int
+		lastEventID int64
+		expectedIDs []int64
+		expectedTyp string
+	}{
+		{
+			name:        "Missed events are replayed",
+			published:   3,
+			lastEventID: 1,
+			expectedIDs: []int64{2, 3},
+			expectedTyp: constants.EventTypeNowPlaying,
+		},
+		{
+			name:        "Resync is sent when history is trimmed",
+			published:   constants.EventsHistoryAmount + 2,
+			lastEventID: 1,
+			expectedIDs: []int64{2, 3, 4},
+			expectedTyp: constants.EventTypeResync,
+		},
+		{
+			name:        "Resync is sent when last event ID is unknown",
+			published:   2,
+			lastEventID: 5,
+			expectedIDs: []int64{2},
+			expectedTyp: constants.EventTypeResync,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			broker := NewMemoryBroker()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			for i := 0; i < currentTest.published; i++ {
+				assert.NoError(t, broker.Publish(1, constants.EventTypeNowPlaying, &NowPlaying{TrackID: int64(i)}))
+			}
+
+			events, err := broker.Subscribe(ctx, 1, currentTest.lastEventID)
+			assert.NoError(t, err)
+
+			first, _ := receive(t, events)
+			assert.Equal(t, currentTest.expectedTyp, first.Type)
+			ids := []int64{first.ID}
+			for len(ids) < len(currentTest.expectedIDs) {
+				event, _ := receive(t, events)
+				ids = append(ids, event.ID)
+			}
+			assert.Equal(t, currentTest.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMemoryBroker_SlowSubscriberIsDropped(t *testing.T) {
+	broker := NewMemoryBroker()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := broker.Subscribe(ctx, 1, 0)
+	assert.NoError(t, err)
+
+	for i := 0; i < constants.EventsBufferSize*2; i++ {
+		assert.NoError(t, broker.Publish(1, constants.EventTypeNowPlaying, nil))
+	}
+
+	var last int64
+	for {
+		event, ok := receive(t, events)
+		if !ok {
+			break
+		}
+		last = event.ID
+	}
+	assert.Less(t, last, int64(constants.EventsBufferSize*2))
+
+	// После переподключения с последним ID клиент получает пропущенные события
+	events, err = broker.Subscribe(ctx, 1, last)
+	assert.NoError(t, err)
+	event, ok := receive(t, events)
+	assert.True(t, ok)
+	assert.Equal(t, last+1, event.ID)
+}
@@ -0,0 +1,139 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/mailru/easyjson"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+const (
+	channelPrefix   = "events:"
+	channelPattern  = "events:*"
+	idSeparator     = " "
+	sequenceSuffix  = ":sequence"
+	historySuffix   = ":history"
+	minScoreExclude = "("
+	maxScore        = "+inf"
+)
+
+// ID, сохранение в историю и публикация выполняются атомарно, поэтому события одного пользователя
+// приходят подписчикам в порядке их ID
+var publishScript = redis.NewScript(`
+local id = redis.call('INCR', KEYS[1])
+local message = id .. ARGV[5] .. ARGV[1]
+redis.call('ZADD', KEYS[2], id, message)
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, -tonumber(ARGV[2]) - 1)
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+redis.call('PUBLISH', ARGV[4], message)
+return id
+`)
+
+// Брокер поверх Redis pub/sub. Публиковать события может любой сервис, а каждый экземпляр шлюза держит
+// одну подписку на события всех пользователей и раздает их своим подписчикам
+type RedisBroker struct {
+	hub
+
+	redis  *redis.Client
+	listen sync.Once
+}
+
+func NewRedisBroker(redis *redis.Client) *RedisBroker {
+	return &RedisBroker{hub: newHub(), redis: redis}
+}
+
+func channel(userID int64) string {
+	return fmt.Sprintf("%s%d", channelPrefix, userID)
+}
+
+func (broker *RedisBroker) Publish(userID int64, eventType string, data easyjson.Marshaler) error {
+	event := Event{Type: eventType, CreatedAt: time.Now().Unix()}
+	if data != nil {
+		raw, err := easyjson.Marshal(data)
+		if err != nil {
+			return err
+		}
+		event.Data = raw
+	}
+	payload, err := easyjson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{channel(userID) + sequenceSuffix, channel(userID) + historySuffix}
+	return publishScript.Run(context.Background(), broker.redis, keys, string(payload), constants.EventsHistoryAmount,
+		int64(constants.EventsHistoryLifetime.Seconds()), channel(userID), idSeparator).Err()
+}
+
+func (broker *RedisBroker) Subscribe(ctx context.Context, userID int64, lastEventID int64) (<-chan Event, error) {
+	broker.listen.Do(func() {
+		go broker.receive(broker.redis.PSubscribe(context.Background(), channelPattern))
+	})
+
+	return broker.subscribe(ctx, userID, lastEventID, func() ([]Event, int64, error) {
+		sequence, err := broker.redis.Get(context.Background(), channel(userID)+sequenceSuffix).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, 0, err
+		}
+
+		messages, err := broker.redis.ZRangeByScore(context.Background(), channel(userID)+historySuffix, &redis.ZRangeBy{
+			Min: minScoreExclude + strconv.FormatInt(lastEventID, 10),
+			Max: maxScore,
+		}).Result()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		events := make([]Event, 0, len(messages))
+		for _, message := range messages {
+			event, err := decode(message)
+			if err != nil {
+				return nil, 0, err
+			}
+			events = append(events, event)
+		}
+
+		return events, sequence, nil
+	})
+}
+
+func (broker *RedisBroker) receive(subscription *redis.PubSub) {
+	for message := range subscription.Channel() {
+		userID, err := strconv.ParseInt(strings.TrimPrefix(message.Channel, channelPrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		event, err := decode(message.Payload)
+		if err != nil {
+			continue
+		}
+		broker.dispatch(userID, event)
+	}
+}
+
+func decode(message string) (Event, error) {
+	parts := strings.SplitN(message, idSeparator, 2)
+	if len(parts) != 2 {
+		return Event{}, fmt.Errorf("invalid event message: %s", message)
+	}
+	id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Event{}, err
+	}
+
+	var event Event
+	if err = easyjson.Unmarshal([]byte(parts[1]), &event); err != nil {
+		return Event{}, err
+	}
+	event.ID = id
+
+	return event, nil
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/assert"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+func TestRedisBroker_Publish(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	broker := NewRedisBroker(redisDB)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "event published",
+			mock: func() {
+				mock.Regexp().ExpectEvalSha(publishScript.Hash(), []string{"events:1:sequence", "events:1:history"},
+					`\{"type":"now_playing","data":\{"track_id":3,"position_ms":0\},"created_at":\d+\}`,
+					constants.EventsHistoryAmount, int64(constants.EventsHistoryLifetime.Seconds()), "events:1", " ").SetVal(int64(1))
+			},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.Regexp().ExpectEvalSha(publishScript.Hash(), []string{"events:1:sequence", "events:1:history"},
+					`.*`, constants.EventsHistoryAmount, int64(constants.EventsHistoryLifetime.Seconds()), "events:1", " ").
+					SetErr(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := broker.Publish(1, constants.EventTypeNowPlaying, &NowPlaying{TrackID: 3})
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name          string
+		message       string
+		expected      Event
+		expectedError bool
+	}{
+		{
+			name:     "valid message",
+			message:  "7 {\"type\":\"playlist_changed\",\"data\":{\"playlist_id\":1},\"created_at\":1600000000}",
+			expected: Event{ID: 7, Type: "playlist_changed", Data: []byte("{\"playlist_id\":1}"), CreatedAt: 1600000000},
+		},
+		{
+			name:          "message without id",
+			message:       "{\"type\":\"playlist_changed\"}",
+			expectedError: true,
+		},
+		{
+			name:          "invalid payload",
+			message:       "7 qwe",
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			event, err := decode(currentTest.message)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, event)
+			}
+		})
+	}
+}
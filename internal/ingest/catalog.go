@@ -0,0 +1,440 @@
+package ingest
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/models"
+	"2021_2_LostPointer/pkg/audiotag"
+)
+
+const (
+	ActionCreate = "+"
+	ActionUpdate = "~"
+)
+
+var ErrMissingLossy = errors.New("flac file requires an mp3 of the same track")
+
+// По тегам известен только год ($2). Альбом текущего года считается вышедшим в день загрузки,
+// иначе он не попадёт в новинки
+const releaseDateByYear = `CASE WHEN $2 < date_part('year', CURRENT_DATE) THEN make_date(GREATEST($2, 1), 1, 1) ELSE CURRENT_DATE END`
+
+// Файл, прочитанный при сканировании каталога
+type Track struct {
+	Path      string
+	Extension string
+	Tags      *audiotag.Tags
+}
+
+type Change struct {
+	Action      string
+	Entity      string
+	Description string
+}
+
+func (change Change) String() string {
+	return fmt.Sprintf("%s %-6s %s", change.Action, change.Entity, change.Description)
+}
+
+type Report struct {
+	Changes   []Change
+	Unchanged int64
+	// Новые файлы треков: путь к исходному файлу и имя файла в хранилище треков без расширения
+	Files map[string]string
+	// Файлы, которые нельзя добавить в каталог, и причина
+	Skipped map[string]error
+	// Имена файлов в хранилище, которые будут скопированы
+	pending map[string]bool
+}
+
+type CoverService interface {
+	CreateImagesFromReader(io.ReadSeeker, string, map[int]string) (*models.ImageData, error)
+}
+
+type Catalog struct {
+	db           *sql.DB
+	images       CoverService
+	artworksPath string
+	tracksPath   string
+}
+
+func NewCatalog(db *sql.DB, images CoverService, artworksPath string, tracksPath string) *Catalog {
+	return &Catalog{db: db, images: images, artworksPath: artworksPath, tracksPath: tracksPath}
+}
+
+type album struct {
+	id      int64
+	year    int64
+	artwork sql.NullString
+}
+
+type track struct {
+	id       int64
+	number   sql.NullInt64
+	genre    sql.NullInt64
+	duration int64
+	lossless bool
+	file     string
+}
+
+// Добавляет в каталог исполнителей, альбомы, жанры и треки из тегов. Записи ищутся без учёта регистра,
+// поэтому повторный запуск на тех же файлах ничего не меняет. При dryRun изменения откатываются,
+// обложки не создаются, а отчёт содержит то, что было бы изменено
+//
+//nolint:cyclop
+func (catalog *Catalog) Ingest(tracks []*Track, dryRun bool) (*Report, error) {
+	tx, err := catalog.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	report := &Report{Files: make(map[string]string), Skipped: make(map[string]error), pending: make(map[string]bool)}
+	tracks, err = catalog.withLossy(tx, tracks, report)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int64]bool)
+	var touchedAlbums []int64
+	for _, file := range tracks {
+		tags := file.Tags
+
+		artistID, err := catalog.artist(tx, tags.Artist, report)
+		if err != nil {
+			return nil, err
+		}
+		albumData, err := catalog.album(tx, artistID, tags, report)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[albumData.id] {
+			touchedAlbums = append(touchedAlbums, albumData.id)
+		}
+		if !seen[albumData.id] && len(albumData.artwork.String) == 0 && len(tags.Cover) != 0 {
+			if err = catalog.cover(tx, albumData.id, tags, dryRun, report); err != nil {
+				return nil, err
+			}
+		}
+		seen[albumData.id] = true
+
+		genreID, err := catalog.genre(tx, tags.Genre, report)
+		if err != nil {
+			return nil, err
+		}
+		if err = catalog.track(tx, artistID, albumData.id, genreID, file, report); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, albumID := range touchedAlbums {
+		_, err = tx.Exec(`UPDATE albums SET track_count = (SELECT COUNT(*) FROM tracks WHERE album = $1) WHERE id = $1`, albumID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	// Файлы копируются до коммита: если копирование не удалось, треки не появятся в базе без аудио
+	// и будут добавлены при повторном запуске
+	for _, file := range tracks {
+		filename, ok := report.Files[file.Path]
+		if !ok {
+			continue
+		}
+		if err = copyFile(file.Path, filepath.Join(catalog.tracksPath, filename+file.Extension)); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Трек по умолчанию отдается в MP3, а FLAC лишь дополняет его. Поэтому MP3 обрабатываются первыми,
+// а FLAC без MP3 того же трека в каталоге или среди файлов пропускается
+func (catalog *Catalog) withLossy(tx *sql.Tx, tracks []*Track, report *Report) ([]*Track, error) {
+	lossy := make(map[string]bool)
+	for _, file := range tracks {
+		if file.Extension != constants.TrackLosslessExtension {
+			lossy[trackKey(file.Tags)] = true
+		}
+	}
+
+	ordered := make([]*Track, 0, len(tracks))
+	var lossless []*Track
+	for _, file := range tracks {
+		if file.Extension != constants.TrackLosslessExtension {
+			ordered = append(ordered, file)
+			continue
+		}
+		if lossy[trackKey(file.Tags)] {
+			lossless = append(lossless, file)
+			continue
+		}
+
+		var exists bool
+		err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM tracks t JOIN albums al ON al.id = t.album JOIN artists a ON a.id = al.artist `+
+			`WHERE lower(a.name) = lower($1) AND lower(al.title) = lower($2) AND lower(t.title) = lower($3))`,
+			file.Tags.Artist, file.Tags.Album, file.Tags.Title).Scan(&exists)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			report.Skipped[file.Path] = ErrMissingLossy
+			continue
+		}
+		lossless = append(lossless, file)
+	}
+
+	return append(ordered, lossless...), nil
+}
+
+func trackKey(tags *audiotag.Tags) string {
+	return strings.ToLower(tags.Artist + "\x00" + tags.Album + "\x00" + tags.Title)
+}
+
+// Проверяет, есть ли файл в хранилище треков или среди уже запланированных к копированию
+func (catalog *Catalog) hasFile(report *Report, name string) (bool, error) {
+	if report.pending[name] {
+		return true, nil
+	}
+	_, err := os.Stat(filepath.Join(catalog.tracksPath, name))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
+
+func addFile(report *Report, file *Track, filename string) {
+	report.Files[file.Path] = filename
+	report.pending[filename+file.Extension] = true
+}
+
+func copyFile(source string, destination string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+
+	out, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func (catalog *Catalog) artist(tx *sql.Tx, name string, report *Report) (int64, error) {
+	var artistID int64
+	err := tx.QueryRow(`SELECT id FROM artists WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`, name).Scan(&artistID)
+	if err == nil {
+		return artistID, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	err = tx.QueryRow(`INSERT INTO artists(name) VALUES ($1) RETURNING id`, name).Scan(&artistID)
+	if err != nil {
+		return 0, err
+	}
+	report.Changes = append(report.Changes, Change{Action: ActionCreate, Entity: "artist", Description: name})
+
+	return artistID, nil
+}
+
+func (catalog *Catalog) album(tx *sql.Tx, artistID int64, tags *audiotag.Tags, report *Report) (*album, error) {
+	description := tags.Artist + " — " + tags.Album
+
+	albumData := &album{}
+	err := tx.QueryRow(`SELECT id, year, artwork FROM albums WHERE artist = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`,
+		artistID, tags.Album).Scan(&albumData.id, &albumData.year, &albumData.artwork)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = tx.QueryRow(`INSERT INTO albums(title, year, artist, track_count, release_date) VALUES ($1, $2, $3, 0, `+
+			releaseDateByYear+`) RETURNING id`,
+			tags.Album, tags.Year, artistID).Scan(&albumData.id)
+		if err != nil {
+			return nil, err
+		}
+		albumData.year = tags.Year
+		report.Changes = append(report.Changes, Change{
+			Action:      ActionCreate,
+			Entity:      "album",
+			Description: fmt.Sprintf("%s (%d)", description, tags.Year),
+		})
+		return albumData, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if tags.Year != 0 && tags.Year != albumData.year {
+		_, err = tx.Exec(`UPDATE albums SET year = $2, release_date = `+releaseDateByYear+` WHERE id = $1`, albumData.id, tags.Year)
+		if err != nil {
+			return nil, err
+		}
+		report.Changes = append(report.Changes, Change{
+			Action:      ActionUpdate,
+			Entity:      "album",
+			Description: fmt.Sprintf("%s: year %d → %d", description, albumData.year, tags.Year),
+		})
+		albumData.year = tags.Year
+	}
+
+	return albumData, nil
+}
+
+// Обложка из тегов проходит через тот же сервис изображений, что и загруженные пользователями,
+// поэтому у альбома появляется и artwork_color
+func (catalog *Catalog) cover(tx *sql.Tx, albumID int64, tags *audiotag.Tags, dryRun bool, report *Report) error {
+	report.Changes = append(report.Changes, Change{
+		Action:      ActionUpdate,
+		Entity:      "album",
+		Description: tags.Artist + " — " + tags.Album + ": cover from tags",
+	})
+	if dryRun {
+		return nil
+	}
+
+	imageData, err := catalog.images.CreateImagesFromReader(
+		bytes.NewReader(tags.Cover),
+		catalog.artworksPath,
+		map[int]string{
+			100: constants.AlbumArtworkExtension100px,
+			384: constants.AlbumArtworkExtension384px,
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`UPDATE albums SET artwork = $2, artwork_color = $3 WHERE id = $1`,
+		albumID, imageData.Filename, imageData.ArtworkColor)
+	return err
+}
+
+func (catalog *Catalog) genre(tx *sql.Tx, name string, report *Report) (sql.NullInt64, error) {
+	var genreID sql.NullInt64
+	if len(strings.TrimSpace(name)) == 0 {
+		return genreID, nil
+	}
+
+	err := tx.QueryRow(`SELECT id FROM genres WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`, name).Scan(&genreID)
+	if err == nil {
+		return genreID, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return genreID, err
+	}
+
+	err = tx.QueryRow(`INSERT INTO genres(name) VALUES ($1) RETURNING id`, name).Scan(&genreID)
+	if err != nil {
+		return genreID, err
+	}
+	report.Changes = append(report.Changes, Change{Action: ActionCreate, Entity: "genre", Description: name})
+
+	return genreID, nil
+}
+
+func (catalog *Catalog) track(tx *sql.Tx, artistID int64, albumID int64, genreID sql.NullInt64, file *Track, report *Report) error {
+	tags := file.Tags
+	description := tags.Artist + " — " + tags.Album + " — " + tags.Title
+	number := sql.NullInt64{Int64: tags.Number, Valid: tags.Number != 0}
+	lossless := file.Extension == constants.TrackLosslessExtension
+
+	existing := &track{}
+	err := tx.QueryRow(`SELECT id, number, genre, duration, lossless, file FROM tracks WHERE album = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`,
+		albumID, tags.Title).Scan(&existing.id, &existing.number, &existing.genre, &existing.duration, &existing.lossless, &existing.file)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Списки треков не допускают пустых номера и жанра: без тегов трек получает номер 0 и жанр по умолчанию
+		if !genreID.Valid {
+			if genreID, err = catalog.genre(tx, constants.GenreDefaultName, report); err != nil {
+				return err
+			}
+		}
+		filename := uuid.NewV4().String()
+		_, err = tx.Exec(`INSERT INTO tracks(title, artist, album, genre, number, file, duration, lossless) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			tags.Title, artistID, albumID, genreID, number.Int64, filename, tags.Duration, lossless)
+		if err != nil {
+			return err
+		}
+		addFile(report, file, filename)
+		report.Changes = append(report.Changes, Change{Action: ActionCreate, Entity: "track", Description: description})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var diff []string
+	// Файл копируется, только если у трека еще нет этого варианта
+	hasFile, err := catalog.hasFile(report, existing.file+file.Extension)
+	if err != nil {
+		return err
+	}
+	if !hasFile {
+		addFile(report, file, existing.file)
+		diff = append(diff, "file "+file.Extension+" added")
+	}
+	if number.Valid && number != existing.number {
+		diff = append(diff, fmt.Sprintf("number %d → %d", existing.number.Int64, number.Int64))
+	} else {
+		number = existing.number
+	}
+	if genreID.Valid && genreID != existing.genre {
+		diff = append(diff, fmt.Sprintf("genre %d → %d", existing.genre.Int64, genreID.Int64))
+	} else {
+		genreID = existing.genre
+	}
+	// Длительность берется из MP3, который отдается по умолчанию, а lossless только появляется с FLAC
+	duration := existing.duration
+	if !lossless && tags.Duration != existing.duration {
+		diff = append(diff, fmt.Sprintf("duration %d → %d", existing.duration, tags.Duration))
+		duration = tags.Duration
+	}
+	if lossless && !existing.lossless {
+		diff = append(diff, "lossless false → true")
+	}
+	lossless = lossless || existing.lossless
+	if len(diff) == 0 {
+		report.Unchanged++
+		return nil
+	}
+
+	_, err = tx.Exec(`UPDATE tracks SET number = $2, genre = $3, duration = $4, lossless = $5 WHERE id = $1`,
+		existing.id, number, genreID, duration, lossless)
+	if err != nil {
+		return err
+	}
+	report.Changes = append(report.Changes, Change{
+		Action:      ActionUpdate,
+		Entity:      "track",
+		Description: description + ": " + strings.Join(diff, ", "),
+	})
+
+	return nil
+}
@@ -0,0 +1,410 @@
+package ingest
+
+import (
+	"database/sql/driver"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"2021_2_LostPointer/pkg/audiotag"
+)
+
+func TestCatalog_Ingest(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	tracksDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tracksDir, "ghetto.mp3"), []byte("audio"), 0600))
+	catalog := NewCatalog(db, nil, "/artworks/", tracksDir)
+
+	const (
+		artistID = 1
+		albumID  = 2
+		genreID  = 3
+		trackID  = 4
+	)
+	artistQuery := `SELECT id FROM artists WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`
+	insertArtistQuery := `INSERT INTO artists(name) VALUES ($1) RETURNING id`
+	albumQuery := `SELECT id, year, artwork FROM albums WHERE artist = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`
+	insertAlbumQuery := `INSERT INTO albums(title, year, artist, track_count, release_date) VALUES ($1, $2, $3, 0, `
+	genreQuery := `SELECT id FROM genres WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`
+	insertGenreQuery := `INSERT INTO genres(name) VALUES ($1) RETURNING id`
+	trackQuery := `SELECT id, number, genre, duration, lossless, file FROM tracks WHERE album = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`
+	insertTrackQuery := `INSERT INTO tracks(title, artist, album, genre, number, file, duration, lossless) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	updateTrackQuery := `UPDATE tracks SET number = $2, genre = $3, duration = $4, lossless = $5 WHERE id = $1`
+	trackCountQuery := `UPDATE albums SET track_count = (SELECT COUNT(*) FROM tracks WHERE album = $1) WHERE id = $1`
+
+	tracks := []*Track{
+		{
+			Path:      "/music/ghetto.mp3",
+			Extension: ".mp3",
+			Tags: &audiotag.Tags{
+				Title:    "Ghetto",
+				Artist:   "Face",
+				Album:    "Hate Love",
+				Genre:    "Rap",
+				Number:   3,
+				Year:     2019,
+				Cover:    []byte{1, 2, 3},
+				Duration: 200,
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		dryRun        bool
+		mock          func()
+		expected      []string
+		unchanged     int64
+		expectedFiles int
+		expectedError bool
+	}{
+		{
+			name:   "dry run reports new entries and rolls back",
+			dryRun: true,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(artistQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(regexp.QuoteMeta(insertArtistQuery)).WithArgs(driver.Value("Face")).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artistID))
+				mock.ExpectQuery(regexp.QuoteMeta(albumQuery)).WillReturnRows(sqlmock.NewRows([]string{"id", "year", "artwork"}))
+				mock.ExpectQuery(regexp.QuoteMeta(insertAlbumQuery)).
+					WithArgs(driver.Value("Hate Love"), driver.Value(2019), driver.Value(artistID)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(albumID))
+				mock.ExpectQuery(regexp.QuoteMeta(genreQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(regexp.QuoteMeta(insertGenreQuery)).WithArgs(driver.Value("Rap")).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(genreID))
+				mock.ExpectQuery(regexp.QuoteMeta(trackQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "number", "genre", "duration", "lossless", "file"}))
+				mock.ExpectExec(regexp.QuoteMeta(insertTrackQuery)).
+					WithArgs(driver.Value("Ghetto"), driver.Value(artistID), driver.Value(albumID), driver.Value(genreID),
+						driver.Value(3), sqlmock.AnyArg(), driver.Value(200), driver.Value(false)).
+					WillReturnResult(sqlmock.NewResult(trackID, 1))
+				mock.ExpectExec(regexp.QuoteMeta(trackCountQuery)).WithArgs(driver.Value(albumID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			expected: []string{
+				"+ artist Face",
+				"+ album  Face — Hate Love (2019)",
+				"~ album  Face — Hate Love: cover from tags",
+				"+ genre  Rap",
+				"+ track  Face — Hate Love — Ghetto",
+			},
+			expectedFiles: 1,
+		},
+		{
+			name: "existing track is updated",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(artistQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artistID))
+				mock.ExpectQuery(regexp.QuoteMeta(albumQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "year", "artwork"}).AddRow(albumID, 2019, "artwork"))
+				mock.ExpectQuery(regexp.QuoteMeta(genreQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(genreID))
+				mock.ExpectQuery(regexp.QuoteMeta(trackQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "number", "genre", "duration", "lossless", "file"}).
+						AddRow(trackID, 3, genreID, 180, false, "ghetto"))
+				mock.ExpectExec(regexp.QuoteMeta(updateTrackQuery)).
+					WithArgs(driver.Value(trackID), driver.Value(3), driver.Value(genreID), driver.Value(200), driver.Value(false)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(trackCountQuery)).WithArgs(driver.Value(albumID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expected: []string{
+				"~ track  Face — Hate Love — Ghetto: duration 180 → 200",
+			},
+		},
+		{
+			name: "unchanged track",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(artistQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artistID))
+				mock.ExpectQuery(regexp.QuoteMeta(albumQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "year", "artwork"}).AddRow(albumID, 2019, "artwork"))
+				mock.ExpectQuery(regexp.QuoteMeta(genreQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(genreID))
+				mock.ExpectQuery(regexp.QuoteMeta(trackQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "number", "genre", "duration", "lossless", "file"}).
+						AddRow(trackID, 3, genreID, 200, true, "ghetto"))
+				mock.ExpectExec(regexp.QuoteMeta(trackCountQuery)).WithArgs(driver.Value(albumID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			unchanged: 1,
+		},
+		{
+			name: "insert artist returns error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(artistQuery)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(regexp.QuoteMeta(insertArtistQuery)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			report, err := catalog.Ingest(tracks, currentTest.dryRun)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				changes := make([]string, 0, len(report.Changes))
+				for _, change := range report.Changes {
+					changes = append(changes, change.String())
+				}
+				assert.ElementsMatch(t, currentTest.expected, changes)
+				assert.Equal(t, currentTest.unchanged, report.Unchanged)
+				assert.Len(t, report.Files, currentTest.expectedFiles)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCatalog_IngestWithoutNumberAndGenre(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	catalog := NewCatalog(db, nil, "/artworks/", "/tracks/")
+
+	const (
+		artistID = 1
+		albumID  = 2
+		genreID  = 3
+	)
+	tracks := []*Track{{
+		Path:      "/music/untagged.mp3",
+		Extension: ".mp3",
+		Tags:      &audiotag.Tags{Title: "Untagged", Artist: "Face", Album: "Hate Love", Year: 2019, Duration: 180},
+	}}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM artists WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artistID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, year, artwork FROM albums WHERE artist = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "year", "artwork"}).AddRow(albumID, 2019, "artwork"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, number, genre, duration, lossless, file FROM tracks WHERE album = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "number", "genre", "duration", "lossless", "file"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM genres WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`)).
+		WithArgs(driver.Value("Unknown")).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(genreID))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO tracks(title, artist, album, genre, number, file, duration, lossless) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`)).
+		WithArgs(driver.Value("Untagged"), driver.Value(artistID), driver.Value(albumID), driver.Value(genreID),
+			driver.Value(0), sqlmock.AnyArg(), driver.Value(180), driver.Value(false)).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE albums SET track_count = (SELECT COUNT(*) FROM tracks WHERE album = $1) WHERE id = $1`)).
+		WithArgs(driver.Value(albumID)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	_, err = catalog.Ingest(tracks, true)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCatalog_IngestCopiesFilesBeforeCommit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	sourceDir := t.TempDir()
+	tracksDir := t.TempDir()
+	catalog := NewCatalog(db, nil, "/artworks/", tracksDir)
+
+	source := filepath.Join(sourceDir, "ghetto.mp3")
+	assert.NoError(t, os.WriteFile(source, []byte("audio"), 0600))
+
+	tests := []struct {
+		name          string
+		path          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "file is copied and transaction is committed",
+			path: source,
+			mock: func() {
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "missing file rolls back transaction",
+			path: filepath.Join(sourceDir, "missing.mp3"),
+			mock: func() {
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM artists WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, year, artwork FROM albums WHERE artist = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "year", "artwork"}).AddRow(2, 2019, "artwork"))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM genres WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, number, genre, duration, lossless, file FROM tracks WHERE album = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "number", "genre", "duration", "lossless", "file"}))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO tracks`)).WillReturnResult(sqlmock.NewResult(4, 1))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE albums SET track_count`)).WillReturnResult(sqlmock.NewResult(0, 1))
+			currentTest.mock()
+
+			tracks := []*Track{{
+				Path:      currentTest.path,
+				Extension: ".mp3",
+				Tags:      &audiotag.Tags{Title: "Ghetto", Artist: "Face", Album: "Hate Love", Genre: "Rap", Number: 1, Year: 2019, Duration: 200},
+			}}
+			report, err := catalog.Ingest(tracks, false)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				_, err = os.Stat(filepath.Join(tracksDir, report.Files[currentTest.path]+".mp3"))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCatalog_IngestLosslessVariant(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	sourceDir := t.TempDir()
+	tracksDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tracksDir, "ghetto.mp3"), []byte("audio"), 0600))
+	catalog := NewCatalog(db, nil, "/artworks/", tracksDir)
+
+	const (
+		artistID = 1
+		albumID  = 2
+		genreID  = 3
+		trackID  = 4
+	)
+	existsQuery := `SELECT EXISTS(SELECT 1 FROM tracks t JOIN albums al ON al.id = t.album JOIN artists a ON a.id = al.artist ` +
+		`WHERE lower(a.name) = lower($1) AND lower(al.title) = lower($2) AND lower(t.title) = lower($3))`
+	expectTrack := func(lossless bool) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM artists WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artistID))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, year, artwork FROM albums WHERE artist = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "year", "artwork"}).AddRow(albumID, 2019, "artwork"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM genres WHERE lower(name) = lower($1) ORDER BY id LIMIT 1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(genreID))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, number, genre, duration, lossless, file FROM tracks WHERE album = $1 AND lower(title) = lower($2) ORDER BY id LIMIT 1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "number", "genre", "duration", "lossless", "file"}).
+				AddRow(trackID, 3, genreID, 200, lossless, "ghetto"))
+	}
+
+	flac := filepath.Join(sourceDir, "ghetto.flac")
+	assert.NoError(t, os.WriteFile(flac, []byte("lossless audio"), 0600))
+	mp3 := filepath.Join(sourceDir, "ghetto.mp3")
+	assert.NoError(t, os.WriteFile(mp3, []byte("audio"), 0600))
+
+	tests := []struct {
+		name            string
+		path            string
+		extension       string
+		duration        int64
+		mock            func()
+		expected        []string
+		unchanged       int64
+		expectedSkipped error
+		expectedFile    string
+	}{
+		{
+			name:      "flac without mp3 of the same track is skipped",
+			path:      flac,
+			extension: ".flac",
+			duration:  201,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existsQuery)).
+					WithArgs(driver.Value("Face"), driver.Value("Hate Love"), driver.Value("Ghetto")).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectCommit()
+			},
+			expectedSkipped: ErrMissingLossy,
+		},
+		{
+			name:      "flac is copied next to existing mp3 and keeps its duration",
+			path:      flac,
+			extension: ".flac",
+			duration:  201,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existsQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				expectTrack(false)
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE tracks SET number = $2, genre = $3, duration = $4, lossless = $5 WHERE id = $1`)).
+					WithArgs(driver.Value(trackID), driver.Value(3), driver.Value(genreID), driver.Value(200), driver.Value(true)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE albums SET track_count`)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expected:     []string{"~ track  Face — Hate Love — Ghetto: file .flac added, lossless false → true"},
+			expectedFile: "ghetto.flac",
+		},
+		{
+			name:      "mp3 does not clear lossless flag",
+			path:      mp3,
+			extension: ".mp3",
+			duration:  200,
+			mock: func() {
+				expectTrack(true)
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE albums SET track_count`)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			unchanged: 1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			currentTest.mock()
+
+			tracks := []*Track{{
+				Path:      currentTest.path,
+				Extension: currentTest.extension,
+				Tags: &audiotag.Tags{Title: "Ghetto", Artist: "Face", Album: "Hate Love", Genre: "Rap", Number: 3, Year: 2019,
+					Duration: currentTest.duration, Lossless: currentTest.extension == ".flac"},
+			}}
+			report, err := catalog.Ingest(tracks, false)
+			assert.NoError(t, err)
+			changes := make([]string, 0, len(report.Changes))
+			for _, change := range report.Changes {
+				changes = append(changes, change.String())
+			}
+			assert.ElementsMatch(t, currentTest.expected, changes)
+			assert.Equal(t, currentTest.unchanged, report.Unchanged)
+			assert.Equal(t, currentTest.expectedSkipped, report.Skipped[currentTest.path])
+			if len(currentTest.expectedFile) != 0 {
+				content, err := os.ReadFile(filepath.Join(tracksDir, currentTest.expectedFile))
+				assert.NoError(t, err)
+				assert.Equal(t, "lossless audio", string(content))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
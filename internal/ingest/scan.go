@@ -0,0 +1,71 @@
+package ingest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"2021_2_LostPointer/pkg/audiotag"
+)
+
+var (
+	ErrMissingTags = errors.New("title, artist and album tags are required")
+	// Треки отдаются только в MP3 и FLAC, перекодирование M4A не поддерживается
+	ErrUnstreamableFormat = errors.New("only mp3 and flac files can be streamed")
+)
+
+// Обходит каталог и читает теги всех поддерживаемых аудиофайлов. Файлы, которые не удалось
+// разобрать, не прерывают обход и возвращаются вместе с причиной
+func Scan(dir string) ([]*Track, map[string]error, error) {
+	var tracks []*Track
+	skipped := make(map[string]error)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		extension := strings.ToLower(filepath.Ext(path))
+		if info.IsDir() || !audiotag.IsSupported(extension) {
+			return nil
+		}
+		if extension == audiotag.FormatM4A {
+			skipped[path] = ErrUnstreamableFormat
+			return nil
+		}
+
+		tags, err := readTags(path, extension)
+		if err != nil {
+			skipped[path] = err
+			return nil
+		}
+		tags.Title = strings.TrimSpace(tags.Title)
+		tags.Artist = strings.TrimSpace(tags.Artist)
+		tags.Album = strings.TrimSpace(tags.Album)
+		tags.Genre = strings.TrimSpace(tags.Genre)
+		if len(tags.Title) == 0 || len(tags.Artist) == 0 || len(tags.Album) == 0 {
+			skipped[path] = ErrMissingTags
+			return nil
+		}
+
+		tracks = append(tracks, &Track{Path: path, Extension: extension, Tags: tags})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tracks, skipped, nil
+}
+
+func readTags(path string, extension string) (*audiotag.Tags, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return audiotag.Read(file, extension)
+}
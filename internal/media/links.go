@@ -0,0 +1,51 @@
+package media
+
+import (
+	"strconv"
+	"time"
+
+	"2021_2_LostPointer/internal/constants"
+)
+
+// Пути, по которым шлюз отдает файлы после проверки подписи
+const (
+	StreamPath          = "/api/v1/stream/"
+	PlaylistArtworkPath = "/api/v1/media/playlists/"
+)
+
+// Строит ссылки на медиафайлы: общедоступные файлы отдаются по статическим префиксам,
+// аудио и обложки приватных плейлистов - по подписанным ссылкам
+type Links struct {
+	signer          *URLSigner
+	artistsPrefix   string
+	videosPrefix    string
+	playlistsPrefix string
+}
+
+func NewLinks(signer *URLSigner, artistsPrefix string, videosPrefix string, playlistsPrefix string) *Links {
+	return &Links{
+		signer:          signer,
+		artistsPrefix:   artistsPrefix,
+		videosPrefix:    videosPrefix,
+		playlistsPrefix: playlistsPrefix,
+	}
+}
+
+func (links *Links) ArtistAvatar(avatar string) string {
+	return links.artistsPrefix + avatar + constants.ImageExtension
+}
+
+func (links *Links) ArtistVideo(video string) string {
+	return links.videosPrefix + video + constants.VideoExtension
+}
+
+func (links *Links) PlaylistArtwork(artwork string, extension string, isPublic bool, userID int64) string {
+	if isPublic {
+		return links.playlistsPrefix + artwork + extension
+	}
+	return links.signer.Sign(PlaylistArtworkPath+artwork+extension, userID, time.Now())
+}
+
+func (links *Links) TrackStream(trackID int64, userID int64) string {
+	return links.signer.Sign(StreamPath+strconv.FormatInt(trackID, 10), userID, time.Now())
+}
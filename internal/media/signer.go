@@ -0,0 +1,113 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	userParam      = "user"
+	expiresParam   = "expires"
+	keyParam       = "key"
+	signatureParam = "signature"
+	keysSeparator  = ","
+	keySeparator   = ":"
+)
+
+var (
+	ErrNoKeys      = errors.New("no signing keys")
+	ErrInvalidKeys = errors.New("invalid signing keys")
+	ErrURLInvalid  = errors.New("invalid signed url")
+	ErrURLExpired  = errors.New("signed url expired")
+)
+
+// Подписывает ссылки на ресурс для конкретного пользователя на ограниченное время.
+// Ссылки подписываются текущим (первым) ключом, проверяются любым из известных,
+// поэтому при ротации новый ключ добавляется первым, а старый удаляется после истечения выданных ссылок
+type URLSigner struct {
+	keys     map[string][]byte
+	current  string
+	lifetime time.Duration
+}
+
+// Ключи задаются строкой вида "id:secret,id:secret"
+func NewURLSigner(keys string, lifetime time.Duration) (*URLSigner, error) {
+	signer := &URLSigner{keys: make(map[string][]byte), lifetime: lifetime}
+	for _, key := range strings.Split(keys, keysSeparator) {
+		key = strings.TrimSpace(key)
+		if len(key) == 0 {
+			continue
+		}
+		parts := strings.SplitN(key, keySeparator, 2)
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return nil, ErrInvalidKeys
+		}
+		if _, exists := signer.keys[parts[0]]; exists {
+			return nil, ErrInvalidKeys
+		}
+		if len(signer.current) == 0 {
+			signer.current = parts[0]
+		}
+		signer.keys[parts[0]] = []byte(parts[1])
+	}
+	if len(signer.current) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return signer, nil
+}
+
+// Срок действия округляется вверх до границы периода, чтобы в течение периода ссылка не менялась и кэшировалась клиентом
+func (signer *URLSigner) Sign(resource string, userID int64, now time.Time) string {
+	expires := now.Truncate(signer.lifetime).Add(signer.lifetime * 2).Unix()
+
+	query := url.Values{}
+	query.Set(userParam, strconv.FormatInt(userID, 10))
+	query.Set(expiresParam, strconv.FormatInt(expires, 10))
+	query.Set(keyParam, signer.current)
+	query.Set(signatureParam, signer.signature(signer.keys[signer.current], resource, userID, expires))
+
+	return resource + "?" + query.Encode()
+}
+
+func (signer *URLSigner) Verify(resource string, query url.Values, userID int64, now time.Time) error {
+	secret, found := signer.keys[query.Get(keyParam)]
+	if !found {
+		return ErrURLInvalid
+	}
+	signedUserID, err := strconv.ParseInt(query.Get(userParam), 10, 64)
+	if err != nil || signedUserID != userID {
+		return ErrURLInvalid
+	}
+	expires, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil {
+		return ErrURLInvalid
+	}
+
+	expectedMAC, err := hex.DecodeString(signer.signature(secret, resource, signedUserID, expires))
+	if err != nil {
+		return err
+	}
+	messageMAC, err := hex.DecodeString(query.Get(signatureParam))
+	if err != nil || !hmac.Equal(messageMAC, expectedMAC) {
+		return ErrURLInvalid
+	}
+	if expires < now.Unix() {
+		return ErrURLExpired
+	}
+
+	return nil
+}
+
+func (signer *URLSigner) signature(secret []byte, resource string, userID int64, expires int64) string {
+	hash := hmac.New(sha256.New, secret)
+	_, _ = hash.Write([]byte(fmt.Sprintf("%s:%d:%d", resource, userID, expires)))
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,146 @@
+package media
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewURLSigner(t *testing.T) {
+	tests := []struct {
+		name          string
+		keys          string
+		expectedKey   string
+		expectedError error
+	}{
+		{
+			name:        "First key is current",
+			keys:        "new:secret2, old:secret1",
+			expectedKey: "new",
+		},
+		{
+			name:          "No keys",
+			keys:          " ",
+			expectedError: ErrNoKeys,
+		},
+		{
+			name:          "Key without secret",
+			keys:          "new:secret2,old",
+			expectedError: ErrInvalidKeys,
+		},
+		{
+			name:          "Duplicated key",
+			keys:          "new:secret2,new:secret1",
+			expectedError: ErrInvalidKeys,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			signer, err := NewURLSigner(currentTest.keys, time.Hour)
+			if currentTest.expectedError != nil {
+				assert.ErrorIs(t, err, currentTest.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedKey, signer.current)
+			}
+		})
+	}
+}
+
+func TestURLSigner_Verify(t *testing.T) {
+	now := time.Date(2021, time.December, 6, 10, 30, 0, 0, time.UTC)
+	oldSigner, _ := NewURLSigner("old:secret1", time.Hour)
+	signer, _ := NewURLSigner("new:secret2,old:secret1", time.Hour)
+	otherSigner, _ := NewURLSigner("new:secret3", time.Hour)
+
+	parse := func(signed string) (string, url.Values) {
+		parts := strings.SplitN(signed, "?", 2)
+		query, _ := url.ParseQuery(parts[1])
+		return parts[0], query
+	}
+
+	tests := []struct {
+		name          string
+		signed        string
+		resource      string
+		userID        int64
+		now           time.Time
+		expectedError error
+	}{
+		{
+			name:   "Valid url",
+			signed: signer.Sign("/api/v1/stream/1", 1, now),
+			userID: 1,
+			now:    now,
+		},
+		{
+			name:   "Url signed by rotated key",
+			signed: oldSigner.Sign("/api/v1/stream/1", 1, now),
+			userID: 1,
+			now:    now,
+		},
+		{
+			name:   "Url is valid until the end of the next period",
+			signed: signer.Sign("/api/v1/stream/1", 1, now),
+			userID: 1,
+			now:    now.Add(time.Minute * 89),
+		},
+		{
+			name:          "Expired url",
+			signed:        signer.Sign("/api/v1/stream/1", 1, now),
+			userID:        1,
+			now:           now.Add(time.Hour * 2),
+			expectedError: ErrURLExpired,
+		},
+		{
+			name:          "Other resource",
+			signed:        signer.Sign("/api/v1/stream/1", 1, now),
+			resource:      "/api/v1/stream/2",
+			userID:        1,
+			now:           now,
+			expectedError: ErrURLInvalid,
+		},
+		{
+			name:          "Other user",
+			signed:        signer.Sign("/api/v1/stream/1", 1, now),
+			userID:        2,
+			now:           now,
+			expectedError: ErrURLInvalid,
+		},
+		{
+			name:          "Unknown secret",
+			signed:        otherSigner.Sign("/api/v1/stream/1", 1, now),
+			userID:        1,
+			now:           now,
+			expectedError: ErrURLInvalid,
+		},
+		{
+			name:          "No signature",
+			signed:        "/api/v1/stream/1?user=1",
+			userID:        1,
+			now:           now,
+			expectedError: ErrURLInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			resource, query := parse(currentTest.signed)
+			if len(currentTest.resource) != 0 {
+				resource = currentTest.resource
+			}
+			err := signer.Verify(resource, query, currentTest.userID, currentTest.now)
+			if currentTest.expectedError != nil {
+				assert.ErrorIs(t, err, currentTest.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proto/catalog.pb.go
+
+// Package mock_proto is a generated GoMock package.
+package mock
+
+import (
+	proto "2021_2_LostPointer/internal/microservices/catalog/proto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCatalogClient is a mock of CatalogClient interface.
+type MockCatalogClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogClientMockRecorder
+}
+
+// MockCatalogClientMockRecorder is the mock recorder for MockCatalogClient.
+type MockCatalogClientMockRecorder struct {
+	mock *MockCatalogClient
+}
+
+// NewMockCatalogClient creates a new mock instance.
+func NewMockCatalogClient(ctrl *gomock.Controller) *MockCatalogClient {
+	mock := &MockCatalogClient{ctrl: ctrl}
+	mock.recorder = &MockCatalogClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogClient) EXPECT() *MockCatalogClientMockRecorder {
+	return m.recorder
+}
+
+// CheckAdmin mocks base method.
+func (m *MockCatalogClient) CheckAdmin(ctx context.Context, in *proto.CheckAdminOptions, opts ...grpc.CallOption) (*proto.CheckAdminResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckAdmin", varargs...)
+	ret0, _ := ret[0].(*proto.CheckAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAdmin indicates an expected call of CheckAdmin.
+func (mr *MockCatalogClientMockRecorder) CheckAdmin(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdmin", reflect.TypeOf((*MockCatalogClient)(nil).CheckAdmin), varargs...)
+}
+
+// CreateAlbum mocks base method.
+func (m *MockCatalogClient) CreateAlbum(ctx context.Context, in *proto.AlbumOptions, opts ...grpc.CallOption) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAlbum", varargs...)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbum indicates an expected call of CreateAlbum.
+func (mr *MockCatalogClientMockRecorder) CreateAlbum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbum", reflect.TypeOf((*MockCatalogClient)(nil).CreateAlbum), varargs...)
+}
+
+// CreateArtist mocks base method.
+func (m *MockCatalogClient) CreateArtist(ctx context.Context, in *proto.ArtistOptions, opts ...grpc.CallOption) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateArtist", varargs...)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArtist indicates an expected call of CreateArtist.
+func (mr *MockCatalogClientMockRecorder) CreateArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArtist", reflect.TypeOf((*MockCatalogClient)(nil).CreateArtist), varargs...)
+}
+
+// CreateGenre mocks base method.
+func (m *MockCatalogClient) CreateGenre(ctx context.Context, in *proto.GenreOptions, opts ...grpc.CallOption) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGenre", varargs...)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGenre indicates an expected call of CreateGenre.
+func (mr *MockCatalogClientMockRecorder) CreateGenre(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenre", reflect.TypeOf((*MockCatalogClient)(nil).CreateGenre), varargs...)
+}
+
+// CreateTrack mocks base method.
+func (m *MockCatalogClient) CreateTrack(ctx context.Context, in *proto.TrackOptions, opts ...grpc.CallOption) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTrack", varargs...)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrack indicates an expected call of CreateTrack.
+func (mr *MockCatalogClientMockRecorder) CreateTrack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrack", reflect.TypeOf((*MockCatalogClient)(nil).CreateTrack), varargs...)
+}
+
+// DeleteAlbum mocks base method.
+func (m *MockCatalogClient) DeleteAlbum(ctx context.Context, in *proto.DeleteOptions, opts ...grpc.CallOption) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAlbum", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlbum indicates an expected call of DeleteAlbum.
+func (mr *MockCatalogClientMockRecorder) DeleteAlbum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockCatalogClient)(nil).DeleteAlbum), varargs...)
+}
+
+// DeleteArtist mocks base method.
+func (m *MockCatalogClient) DeleteArtist(ctx context.Context, in *proto.DeleteOptions, opts ...grpc.CallOption) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteArtist", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteArtist indicates an expected call of DeleteArtist.
+func (mr *MockCatalogClientMockRecorder) DeleteArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtist", reflect.TypeOf((*MockCatalogClient)(nil).DeleteArtist), varargs...)
+}
+
+// DeleteGenre mocks base method.
+func (m *MockCatalogClient) DeleteGenre(ctx context.Context, in *proto.DeleteOptions, opts ...grpc.CallOption) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGenre", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGenre indicates an expected call of DeleteGenre.
+func (mr *MockCatalogClientMockRecorder) DeleteGenre(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGenre", reflect.TypeOf((*MockCatalogClient)(nil).DeleteGenre), varargs...)
+}
+
+// DeleteLyrics mocks base method.
+func (m *MockCatalogClient) DeleteLyrics(ctx context.Context, in *proto.DeleteOptions, opts ...grpc.CallOption) (*proto.LyricsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLyrics", varargs...)
+	ret0, _ := ret[0].(*proto.LyricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLyrics indicates an expected call of DeleteLyrics.
+func (mr *MockCatalogClientMockRecorder) DeleteLyrics(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLyrics", reflect.TypeOf((*MockCatalogClient)(nil).DeleteLyrics), varargs...)
+}
+
+// DeleteTrack mocks base method.
+func (m *MockCatalogClient) DeleteTrack(ctx context.Context, in *proto.DeleteOptions, opts ...grpc.CallOption) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTrack", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrack indicates an expected call of DeleteTrack.
+func (mr *MockCatalogClientMockRecorder) DeleteTrack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockCatalogClient)(nil).DeleteTrack), varargs...)
+}
+
+// SetLyrics mocks base method.
+func (m *MockCatalogClient) SetLyrics(ctx context.Context, in *proto.SetLyricsOptions, opts ...grpc.CallOption) (*proto.LyricsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetLyrics", varargs...)
+	ret0, _ := ret[0].(*proto.LyricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLyrics indicates an expected call of SetLyrics.
+func (mr *MockCatalogClientMockRecorder) SetLyrics(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLyrics", reflect.TypeOf((*MockCatalogClient)(nil).SetLyrics), varargs...)
+}
+
+// SuspiciousAccounts mocks base method.
+func (m *MockCatalogClient) SuspiciousAccounts(ctx context.Context, in *proto.SuspiciousAccountsOptions, opts ...grpc.CallOption) (*proto.SuspiciousAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspiciousAccounts", varargs...)
+	ret0, _ := ret[0].(*proto.SuspiciousAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspiciousAccounts indicates an expected call of SuspiciousAccounts.
+func (mr *MockCatalogClientMockRecorder) SuspiciousAccounts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspiciousAccounts", reflect.TypeOf((*MockCatalogClient)(nil).SuspiciousAccounts), varargs...)
+}
+
+// UpdateAlbum mocks base method.
+func (m *MockCatalogClient) UpdateAlbum(ctx context.Context, in *proto.AlbumOptions, opts ...grpc.CallOption) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAlbum", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlbum indicates an expected call of UpdateAlbum.
+func (mr *MockCatalogClientMockRecorder) UpdateAlbum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbum", reflect.TypeOf((*MockCatalogClient)(nil).UpdateAlbum), varargs...)
+}
+
+// UpdateArtist mocks base method.
+func (m *MockCatalogClient) UpdateArtist(ctx context.Context, in *proto.ArtistOptions, opts ...grpc.CallOption) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateArtist", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArtist indicates an expected call of UpdateArtist.
+func (mr *MockCatalogClientMockRecorder) UpdateArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArtist", reflect.TypeOf((*MockCatalogClient)(nil).UpdateArtist), varargs...)
+}
+
+// UpdateGenre mocks base method.
+func (m *MockCatalogClient) UpdateGenre(ctx context.Context, in *proto.GenreOptions, opts ...grpc.CallOption) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateGenre", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGenre indicates an expected call of UpdateGenre.
+func (mr *MockCatalogClientMockRecorder) UpdateGenre(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGenre", reflect.TypeOf((*MockCatalogClient)(nil).UpdateGenre), varargs...)
+}
+
+// UpdateTrack mocks base method.
+func (m *MockCatalogClient) UpdateTrack(ctx context.Context, in *proto.TrackOptions, opts ...grpc.CallOption) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTrack", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrack indicates an expected call of UpdateTrack.
+func (mr *MockCatalogClientMockRecorder) UpdateTrack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrack", reflect.TypeOf((*MockCatalogClient)(nil).UpdateTrack), varargs...)
+}
+
+// MockCatalogServer is a mock of CatalogServer interface.
+type MockCatalogServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogServerMockRecorder
+}
+
+// MockCatalogServerMockRecorder is the mock recorder for MockCatalogServer.
+type MockCatalogServerMockRecorder struct {
+	mock *MockCatalogServer
+}
+
+// NewMockCatalogServer creates a new mock instance.
+func NewMockCatalogServer(ctrl *gomock.Controller) *MockCatalogServer {
+	mock := &MockCatalogServer{ctrl: ctrl}
+	mock.recorder = &MockCatalogServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogServer) EXPECT() *MockCatalogServerMockRecorder {
+	return m.recorder
+}
+
+// CheckAdmin mocks base method.
+func (m *MockCatalogServer) CheckAdmin(arg0 context.Context, arg1 *proto.CheckAdminOptions) (*proto.CheckAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CheckAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAdmin indicates an expected call of CheckAdmin.
+func (mr *MockCatalogServerMockRecorder) CheckAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdmin", reflect.TypeOf((*MockCatalogServer)(nil).CheckAdmin), arg0, arg1)
+}
+
+// CreateAlbum mocks base method.
+func (m *MockCatalogServer) CreateAlbum(arg0 context.Context, arg1 *proto.AlbumOptions) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbum indicates an expected call of CreateAlbum.
+func (mr *MockCatalogServerMockRecorder) CreateAlbum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbum", reflect.TypeOf((*MockCatalogServer)(nil).CreateAlbum), arg0, arg1)
+}
+
+// CreateArtist mocks base method.
+func (m *MockCatalogServer) CreateArtist(arg0 context.Context, arg1 *proto.ArtistOptions) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArtist", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArtist indicates an expected call of CreateArtist.
+func (mr *MockCatalogServerMockRecorder) CreateArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArtist", reflect.TypeOf((*MockCatalogServer)(nil).CreateArtist), arg0, arg1)
+}
+
+// CreateGenre mocks base method.
+func (m *MockCatalogServer) CreateGenre(arg0 context.Context, arg1 *proto.GenreOptions) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGenre", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGenre indicates an expected call of CreateGenre.
+func (mr *MockCatalogServerMockRecorder) CreateGenre(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenre", reflect.TypeOf((*MockCatalogServer)(nil).CreateGenre), arg0, arg1)
+}
+
+// CreateTrack mocks base method.
+func (m *MockCatalogServer) CreateTrack(arg0 context.Context, arg1 *proto.TrackOptions) (*proto.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrack indicates an expected call of CreateTrack.
+func (mr *MockCatalogServerMockRecorder) CreateTrack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrack", reflect.TypeOf((*MockCatalogServer)(nil).CreateTrack), arg0, arg1)
+}
+
+// DeleteAlbum mocks base method.
+func (m *MockCatalogServer) DeleteAlbum(arg0 context.Context, arg1 *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlbum indicates an expected call of DeleteAlbum.
+func (mr *MockCatalogServerMockRecorder) DeleteAlbum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockCatalogServer)(nil).DeleteAlbum), arg0, arg1)
+}
+
+// DeleteArtist mocks base method.
+func (m *MockCatalogServer) DeleteArtist(arg0 context.Context, arg1 *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArtist", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteArtist indicates an expected call of DeleteArtist.
+func (mr *MockCatalogServerMockRecorder) DeleteArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtist", reflect.TypeOf((*MockCatalogServer)(nil).DeleteArtist), arg0, arg1)
+}
+
+// DeleteGenre mocks base method.
+func (m *MockCatalogServer) DeleteGenre(arg0 context.Context, arg1 *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGenre", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGenre indicates an expected call of DeleteGenre.
+func (mr *MockCatalogServerMockRecorder) DeleteGenre(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGenre", reflect.TypeOf((*MockCatalogServer)(nil).DeleteGenre), arg0, arg1)
+}
+
+// DeleteLyrics mocks base method.
+func (m *MockCatalogServer) DeleteLyrics(arg0 context.Context, arg1 *proto.DeleteOptions) (*proto.LyricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLyrics", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LyricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLyrics indicates an expected call of DeleteLyrics.
+func (mr *MockCatalogServerMockRecorder) DeleteLyrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLyrics", reflect.TypeOf((*MockCatalogServer)(nil).DeleteLyrics), arg0, arg1)
+}
+
+// DeleteTrack mocks base method.
+func (m *MockCatalogServer) DeleteTrack(arg0 context.Context, arg1 *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrack indicates an expected call of DeleteTrack.
+func (mr *MockCatalogServerMockRecorder) DeleteTrack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockCatalogServer)(nil).DeleteTrack), arg0, arg1)
+}
+
+// SetLyrics mocks base method.
+func (m *MockCatalogServer) SetLyrics(arg0 context.Context, arg1 *proto.SetLyricsOptions) (*proto.LyricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLyrics", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LyricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLyrics indicates an expected call of SetLyrics.
+func (mr *MockCatalogServerMockRecorder) SetLyrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLyrics", reflect.TypeOf((*MockCatalogServer)(nil).SetLyrics), arg0, arg1)
+}
+
+// SuspiciousAccounts mocks base method.
+func (m *MockCatalogServer) SuspiciousAccounts(arg0 context.Context, arg1 *proto.SuspiciousAccountsOptions) (*proto.SuspiciousAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspiciousAccounts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SuspiciousAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspiciousAccounts indicates an expected call of SuspiciousAccounts.
+func (mr *MockCatalogServerMockRecorder) SuspiciousAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspiciousAccounts", reflect.TypeOf((*MockCatalogServer)(nil).SuspiciousAccounts), arg0, arg1)
+}
+
+// UpdateAlbum mocks base method.
+func (m *MockCatalogServer) UpdateAlbum(arg0 context.Context, arg1 *proto.AlbumOptions) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlbum indicates an expected call of UpdateAlbum.
+func (mr *MockCatalogServerMockRecorder) UpdateAlbum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbum", reflect.TypeOf((*MockCatalogServer)(nil).UpdateAlbum), arg0, arg1)
+}
+
+// UpdateArtist mocks base method.
+func (m *MockCatalogServer) UpdateArtist(arg0 context.Context, arg1 *proto.ArtistOptions) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArtist", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArtist indicates an expected call of UpdateArtist.
+func (mr *MockCatalogServerMockRecorder) UpdateArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArtist", reflect.TypeOf((*MockCatalogServer)(nil).UpdateArtist), arg0, arg1)
+}
+
+// UpdateGenre mocks base method.
+func (m *MockCatalogServer) UpdateGenre(arg0 context.Context, arg1 *proto.GenreOptions) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGenre", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGenre indicates an expected call of UpdateGenre.
+func (mr *MockCatalogServerMockRecorder) UpdateGenre(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGenre", reflect.TypeOf((*MockCatalogServer)(nil).UpdateGenre), arg0, arg1)
+}
+
+// UpdateTrack mocks base method.
+func (m *MockCatalogServer) UpdateTrack(arg0 context.Context, arg1 *proto.TrackOptions) (*proto.UpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrack indicates an expected call of UpdateTrack.
+func (mr *MockCatalogServerMockRecorder) UpdateTrack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrack", reflect.TypeOf((*MockCatalogServer)(nil).UpdateTrack), arg0, arg1)
+}
@@ -0,0 +1,2324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.17.3
+// source: catalog.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CatalogArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Bio         string `protobuf:"bytes,3,opt,name=Bio,proto3" json:"Bio,omitempty"`
+	Avatar      string `protobuf:"bytes,4,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	AvatarColor string `protobuf:"bytes,5,opt,name=AvatarColor,proto3" json:"AvatarColor,omitempty"`
+	Video       string `protobuf:"bytes,6,opt,name=Video,proto3" json:"Video,omitempty"`
+}
+
+func (x *CatalogArtist) Reset() {
+	*x = CatalogArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogArtist) ProtoMessage() {}
+
+func (x *CatalogArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogArtist.ProtoReflect.Descriptor instead.
+func (*CatalogArtist) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CatalogArtist) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CatalogArtist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogArtist) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CatalogArtist) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *CatalogArtist) GetAvatarColor() string {
+	if x != nil {
+		return x.AvatarColor
+	}
+	return ""
+}
+
+func (x *CatalogArtist) GetVideo() string {
+	if x != nil {
+		return x.Video
+	}
+	return ""
+}
+
+type CatalogAlbum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title        string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Year         int64  `protobuf:"varint,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	ArtistID     int64  `protobuf:"varint,4,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	Artwork      string `protobuf:"bytes,5,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	ArtworkColor string `protobuf:"bytes,6,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+	ReleaseDate  string `protobuf:"bytes,7,opt,name=ReleaseDate,proto3" json:"ReleaseDate,omitempty"`
+}
+
+func (x *CatalogAlbum) Reset() {
+	*x = CatalogAlbum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogAlbum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogAlbum) ProtoMessage() {}
+
+func (x *CatalogAlbum) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogAlbum.ProtoReflect.Descriptor instead.
+func (*CatalogAlbum) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CatalogAlbum) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CatalogAlbum) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CatalogAlbum) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CatalogAlbum) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *CatalogAlbum) GetArtwork() string {
+	if x != nil {
+		return x.Artwork
+	}
+	return ""
+}
+
+func (x *CatalogAlbum) GetArtworkColor() string {
+	if x != nil {
+		return x.ArtworkColor
+	}
+	return ""
+}
+
+func (x *CatalogAlbum) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+type CatalogTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	ArtistID int64  `protobuf:"varint,3,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	AlbumID  int64  `protobuf:"varint,4,opt,name=AlbumID,proto3" json:"AlbumID,omitempty"`
+	GenreID  int64  `protobuf:"varint,5,opt,name=GenreID,proto3" json:"GenreID,omitempty"`
+	Number   int64  `protobuf:"varint,6,opt,name=Number,proto3" json:"Number,omitempty"`
+	Explicit bool   `protobuf:"varint,7,opt,name=Explicit,proto3" json:"Explicit,omitempty"`
+	File     string `protobuf:"bytes,8,opt,name=File,proto3" json:"File,omitempty"`
+	Duration int64  `protobuf:"varint,9,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Lossless bool   `protobuf:"varint,10,opt,name=Lossless,proto3" json:"Lossless,omitempty"`
+}
+
+func (x *CatalogTrack) Reset() {
+	*x = CatalogTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogTrack) ProtoMessage() {}
+
+func (x *CatalogTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogTrack.ProtoReflect.Descriptor instead.
+func (*CatalogTrack) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CatalogTrack) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CatalogTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CatalogTrack) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *CatalogTrack) GetAlbumID() int64 {
+	if x != nil {
+		return x.AlbumID
+	}
+	return 0
+}
+
+func (x *CatalogTrack) GetGenreID() int64 {
+	if x != nil {
+		return x.GenreID
+	}
+	return 0
+}
+
+func (x *CatalogTrack) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CatalogTrack) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *CatalogTrack) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *CatalogTrack) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CatalogTrack) GetLossless() bool {
+	if x != nil {
+		return x.Lossless
+	}
+	return false
+}
+
+type CatalogGenre struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Artwork      string `protobuf:"bytes,3,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	ArtworkColor string `protobuf:"bytes,4,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+}
+
+func (x *CatalogGenre) Reset() {
+	*x = CatalogGenre{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogGenre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogGenre) ProtoMessage() {}
+
+func (x *CatalogGenre) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogGenre.ProtoReflect.Descriptor instead.
+func (*CatalogGenre) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CatalogGenre) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CatalogGenre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogGenre) GetArtwork() string {
+	if x != nil {
+		return x.Artwork
+	}
+	return ""
+}
+
+func (x *CatalogGenre) GetArtworkColor() string {
+	if x != nil {
+		return x.ArtworkColor
+	}
+	return ""
+}
+
+type ArtistOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64          `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Artist  *CatalogArtist `protobuf:"bytes,2,opt,name=Artist,proto3" json:"Artist,omitempty"`
+}
+
+func (x *ArtistOptions) Reset() {
+	*x = ArtistOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistOptions) ProtoMessage() {}
+
+func (x *ArtistOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistOptions.ProtoReflect.Descriptor instead.
+func (*ArtistOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ArtistOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+func (x *ArtistOptions) GetArtist() *CatalogArtist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type AlbumOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64         `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Album   *CatalogAlbum `protobuf:"bytes,2,opt,name=Album,proto3" json:"Album,omitempty"`
+}
+
+func (x *AlbumOptions) Reset() {
+	*x = AlbumOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlbumOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlbumOptions) ProtoMessage() {}
+
+func (x *AlbumOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlbumOptions.ProtoReflect.Descriptor instead.
+func (*AlbumOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AlbumOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+func (x *AlbumOptions) GetAlbum() *CatalogAlbum {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+type TrackOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64         `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Track   *CatalogTrack `protobuf:"bytes,2,opt,name=Track,proto3" json:"Track,omitempty"`
+}
+
+func (x *TrackOptions) Reset() {
+	*x = TrackOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackOptions) ProtoMessage() {}
+
+func (x *TrackOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackOptions.ProtoReflect.Descriptor instead.
+func (*TrackOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrackOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+func (x *TrackOptions) GetTrack() *CatalogTrack {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+type GenreOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64         `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	Genre   *CatalogGenre `protobuf:"bytes,2,opt,name=Genre,proto3" json:"Genre,omitempty"`
+}
+
+func (x *GenreOptions) Reset() {
+	*x = GenreOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenreOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenreOptions) ProtoMessage() {}
+
+func (x *GenreOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenreOptions.ProtoReflect.Descriptor instead.
+func (*GenreOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GenreOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+func (x *GenreOptions) GetGenre() *CatalogGenre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type DeleteOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64 `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	ID      int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteOptions) Reset() {
+	*x = DeleteOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOptions) ProtoMessage() {}
+
+func (x *DeleteOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOptions.ProtoReflect.Descriptor instead.
+func (*DeleteOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+func (x *DeleteOptions) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldFilename string `protobuf:"bytes,1,opt,name=OldFilename,proto3" json:"OldFilename,omitempty"`
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateResponse) GetOldFilename() string {
+	if x != nil {
+		return x.OldFilename
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldFilename string `protobuf:"bytes,1,opt,name=OldFilename,proto3" json:"OldFilename,omitempty"`
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteResponse) GetOldFilename() string {
+	if x != nil {
+		return x.OldFilename
+	}
+	return ""
+}
+
+type SetLyricsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64  `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+	TrackID int64  `protobuf:"varint,2,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+	Plain   string `protobuf:"bytes,3,opt,name=Plain,proto3" json:"Plain,omitempty"`
+	Synced  string `protobuf:"bytes,4,opt,name=Synced,proto3" json:"Synced,omitempty"`
+}
+
+func (x *SetLyricsOptions) Reset() {
+	*x = SetLyricsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLyricsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLyricsOptions) ProtoMessage() {}
+
+func (x *SetLyricsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLyricsOptions.ProtoReflect.Descriptor instead.
+func (*SetLyricsOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetLyricsOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+func (x *SetLyricsOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+func (x *SetLyricsOptions) GetPlain() string {
+	if x != nil {
+		return x.Plain
+	}
+	return ""
+}
+
+func (x *SetLyricsOptions) GetSynced() string {
+	if x != nil {
+		return x.Synced
+	}
+	return ""
+}
+
+type LyricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LyricsResponse) Reset() {
+	*x = LyricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LyricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LyricsResponse) ProtoMessage() {}
+
+func (x *LyricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LyricsResponse.ProtoReflect.Descriptor instead.
+func (*LyricsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{13}
+}
+
+type SuspiciousAccountsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64 `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+}
+
+func (x *SuspiciousAccountsOptions) Reset() {
+	*x = SuspiciousAccountsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspiciousAccountsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspiciousAccountsOptions) ProtoMessage() {}
+
+func (x *SuspiciousAccountsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspiciousAccountsOptions.ProtoReflect.Descriptor instead.
+func (*SuspiciousAccountsOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuspiciousAccountsOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+type SuspiciousAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Nickname       string `protobuf:"bytes,2,opt,name=Nickname,proto3" json:"Nickname,omitempty"`
+	Email          string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Rejections     int64  `protobuf:"varint,4,opt,name=Rejections,proto3" json:"Rejections,omitempty"`
+	IPs            int64  `protobuf:"varint,6,opt,name=IPs,proto3" json:"IPs,omitempty"`
+	Listens        int64  `protobuf:"varint,7,opt,name=Listens,proto3" json:"Listens,omitempty"`
+	LastRejectedAt int64  `protobuf:"varint,8,opt,name=LastRejectedAt,proto3" json:"LastRejectedAt,omitempty"`
+}
+
+func (x *SuspiciousAccount) Reset() {
+	*x = SuspiciousAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspiciousAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspiciousAccount) ProtoMessage() {}
+
+func (x *SuspiciousAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspiciousAccount.ProtoReflect.Descriptor instead.
+func (*SuspiciousAccount) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SuspiciousAccount) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SuspiciousAccount) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *SuspiciousAccount) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SuspiciousAccount) GetRejections() int64 {
+	if x != nil {
+		return x.Rejections
+	}
+	return 0
+}
+
+func (x *SuspiciousAccount) GetIPs() int64 {
+	if x != nil {
+		return x.IPs
+	}
+	return 0
+}
+
+func (x *SuspiciousAccount) GetListens() int64 {
+	if x != nil {
+		return x.Listens
+	}
+	return 0
+}
+
+func (x *SuspiciousAccount) GetLastRejectedAt() int64 {
+	if x != nil {
+		return x.LastRejectedAt
+	}
+	return 0
+}
+
+type SuspiciousAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []*SuspiciousAccount `protobuf:"bytes,1,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+}
+
+func (x *SuspiciousAccountsResponse) Reset() {
+	*x = SuspiciousAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspiciousAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspiciousAccountsResponse) ProtoMessage() {}
+
+func (x *SuspiciousAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspiciousAccountsResponse.ProtoReflect.Descriptor instead.
+func (*SuspiciousAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SuspiciousAccountsResponse) GetAccounts() []*SuspiciousAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type CheckAdminOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminID int64 `protobuf:"varint,1,opt,name=AdminID,proto3" json:"AdminID,omitempty"`
+}
+
+func (x *CheckAdminOptions) Reset() {
+	*x = CheckAdminOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAdminOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAdminOptions) ProtoMessage() {}
+
+func (x *CheckAdminOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAdminOptions.ProtoReflect.Descriptor instead.
+func (*CheckAdminOptions) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckAdminOptions) GetAdminID() int64 {
+	if x != nil {
+		return x.AdminID
+	}
+	return 0
+}
+
+type CheckAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckAdminResponse) Reset() {
+	*x = CheckAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_catalog_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAdminResponse) ProtoMessage() {}
+
+func (x *CheckAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAdminResponse.ProtoReflect.Descriptor instead.
+func (*CheckAdminResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_proto_rawDescGZIP(), []int{18}
+}
+
+var File_catalog_proto protoreflect.FileDescriptor
+
+var file_catalog_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x95, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x42, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x42, 0x69, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x22, 0xc4, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65,
+	0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x84,
+	0x02, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x65,
+	0x6e, 0x72, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x73,
+	0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4c, 0x6f, 0x73,
+	0x73, 0x6c, 0x65, 0x73, 0x73, 0x22, 0x70, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x51, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x0c, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x52, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x22, 0x4d, 0x0a, 0x0c, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x52, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0x20, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x32, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x6c, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x6c, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x6c,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4f, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x74, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x79,
+	0x6e, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x19, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f,
+	0x75, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x22, 0xd1, 0x01, 0x0a, 0x11,
+	0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x49,
+	0x50, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x49, 0x50, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x4c, 0x0a, 0x1a, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2d, 0x0a,
+	0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x14, 0x0a, 0x12,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xce, 0x06, 0x0a, 0x07, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x37,
+	0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x13, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x2f, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12,
+	0x0d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x12, 0x0d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x0f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x12, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x0f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09,
+	0x53, 0x65, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x11, 0x2e, 0x53, 0x65, 0x74, 0x4c,
+	0x79, 0x72, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x4c,
+	0x79, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x0f, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x69,
+	0x63, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1b, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75,
+	0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x1d, 0x5a, 0x1b, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_catalog_proto_rawDescOnce sync.Once
+	file_catalog_proto_rawDescData = file_catalog_proto_rawDesc
+)
+
+func file_catalog_proto_rawDescGZIP() []byte {
+	file_catalog_proto_rawDescOnce.Do(func() {
+		file_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(file_catalog_proto_rawDescData)
+	})
+	return file_catalog_proto_rawDescData
+}
+
+var file_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_catalog_proto_goTypes = []interface{}{
+	(*CatalogArtist)(nil),              // 0: CatalogArtist
+	(*CatalogAlbum)(nil),               // 1: CatalogAlbum
+	(*CatalogTrack)(nil),               // 2: CatalogTrack
+	(*CatalogGenre)(nil),               // 3: CatalogGenre
+	(*ArtistOptions)(nil),              // 4: ArtistOptions
+	(*AlbumOptions)(nil),               // 5: AlbumOptions
+	(*TrackOptions)(nil),               // 6: TrackOptions
+	(*GenreOptions)(nil),               // 7: GenreOptions
+	(*DeleteOptions)(nil),              // 8: DeleteOptions
+	(*CreateResponse)(nil),             // 9: CreateResponse
+	(*UpdateResponse)(nil),             // 10: UpdateResponse
+	(*DeleteResponse)(nil),             // 11: DeleteResponse
+	(*SetLyricsOptions)(nil),           // 12: SetLyricsOptions
+	(*LyricsResponse)(nil),             // 13: LyricsResponse
+	(*SuspiciousAccountsOptions)(nil),  // 14: SuspiciousAccountsOptions
+	(*SuspiciousAccount)(nil),          // 15: SuspiciousAccount
+	(*SuspiciousAccountsResponse)(nil), // 16: SuspiciousAccountsResponse
+	(*CheckAdminOptions)(nil),          // 17: CheckAdminOptions
+	(*CheckAdminResponse)(nil),         // 18: CheckAdminResponse
+}
+var file_catalog_proto_depIdxs = []int32{
+	0,  // 0: ArtistOptions.Artist:type_name -> CatalogArtist
+	1,  // 1: AlbumOptions.Album:type_name -> CatalogAlbum
+	2,  // 2: TrackOptions.Track:type_name -> CatalogTrack
+	3,  // 3: GenreOptions.Genre:type_name -> CatalogGenre
+	15, // 4: SuspiciousAccountsResponse.Accounts:type_name -> SuspiciousAccount
+	17, // 5: Catalog.CheckAdmin:input_type -> CheckAdminOptions
+	4,  // 6: Catalog.CreateArtist:input_type -> ArtistOptions
+	4,  // 7: Catalog.UpdateArtist:input_type -> ArtistOptions
+	8,  // 8: Catalog.DeleteArtist:input_type -> DeleteOptions
+	5,  // 9: Catalog.CreateAlbum:input_type -> AlbumOptions
+	5,  // 10: Catalog.UpdateAlbum:input_type -> AlbumOptions
+	8,  // 11: Catalog.DeleteAlbum:input_type -> DeleteOptions
+	6,  // 12: Catalog.CreateTrack:input_type -> TrackOptions
+	6,  // 13: Catalog.UpdateTrack:input_type -> TrackOptions
+	8,  // 14: Catalog.DeleteTrack:input_type -> DeleteOptions
+	7,  // 15: Catalog.CreateGenre:input_type -> GenreOptions
+	7,  // 16: Catalog.UpdateGenre:input_type -> GenreOptions
+	8,  // 17: Catalog.DeleteGenre:input_type -> DeleteOptions
+	12, // 18: Catalog.SetLyrics:input_type -> SetLyricsOptions
+	8,  // 19: Catalog.DeleteLyrics:input_type -> DeleteOptions
+	14, // 20: Catalog.SuspiciousAccounts:input_type -> SuspiciousAccountsOptions
+	18, // 21: Catalog.CheckAdmin:output_type -> CheckAdminResponse
+	9,  // 22: Catalog.CreateArtist:output_type -> CreateResponse
+	10, // 23: Catalog.UpdateArtist:output_type -> UpdateResponse
+	11, // 24: Catalog.DeleteArtist:output_type -> DeleteResponse
+	9,  // 25: Catalog.CreateAlbum:output_type -> CreateResponse
+	10, // 26: Catalog.UpdateAlbum:output_type -> UpdateResponse
+	11, // 27: Catalog.DeleteAlbum:output_type -> DeleteResponse
+	9,  // 28: Catalog.CreateTrack:output_type -> CreateResponse
+	10, // 29: Catalog.UpdateTrack:output_type -> UpdateResponse
+	11, // 30: Catalog.DeleteTrack:output_type -> DeleteResponse
+	9,  // 31: Catalog.CreateGenre:output_type -> CreateResponse
+	10, // 32: Catalog.UpdateGenre:output_type -> UpdateResponse
+	11, // 33: Catalog.DeleteGenre:output_type -> DeleteResponse
+	13, // 34: Catalog.SetLyrics:output_type -> LyricsResponse
+	13, // 35: Catalog.DeleteLyrics:output_type -> LyricsResponse
+	16, // 36: Catalog.SuspiciousAccounts:output_type -> SuspiciousAccountsResponse
+	21, // [21:37] is the sub-list for method output_type
+	5,  // [5:21] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_catalog_proto_init() }
+func file_catalog_proto_init() {
+	if File_catalog_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_catalog_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogAlbum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogGenre); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenreOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLyricsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LyricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspiciousAccountsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspiciousAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspiciousAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_catalog_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_catalog_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_catalog_proto_goTypes,
+		DependencyIndexes: file_catalog_proto_depIdxs,
+		MessageInfos:      file_catalog_proto_msgTypes,
+	}.Build()
+	File_catalog_proto = out.File
+	file_catalog_proto_rawDesc = nil
+	file_catalog_proto_goTypes = nil
+	file_catalog_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CatalogClient is the client API for Catalog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CatalogClient interface {
+	CheckAdmin(ctx context.Context, in *CheckAdminOptions, opts ...grpc.CallOption) (*CheckAdminResponse, error)
+	CreateArtist(ctx context.Context, in *ArtistOptions, opts ...grpc.CallOption) (*CreateResponse, error)
+	UpdateArtist(ctx context.Context, in *ArtistOptions, opts ...grpc.CallOption) (*UpdateResponse, error)
+	DeleteArtist(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreateAlbum(ctx context.Context, in *AlbumOptions, opts ...grpc.CallOption) (*CreateResponse, error)
+	UpdateAlbum(ctx context.Context, in *AlbumOptions, opts ...grpc.CallOption) (*UpdateResponse, error)
+	DeleteAlbum(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreateTrack(ctx context.Context, in *TrackOptions, opts ...grpc.CallOption) (*CreateResponse, error)
+	UpdateTrack(ctx context.Context, in *TrackOptions, opts ...grpc.CallOption) (*UpdateResponse, error)
+	DeleteTrack(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreateGenre(ctx context.Context, in *GenreOptions, opts ...grpc.CallOption) (*CreateResponse, error)
+	UpdateGenre(ctx context.Context, in *GenreOptions, opts ...grpc.CallOption) (*UpdateResponse, error)
+	DeleteGenre(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error)
+	SetLyrics(ctx context.Context, in *SetLyricsOptions, opts ...grpc.CallOption) (*LyricsResponse, error)
+	DeleteLyrics(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*LyricsResponse, error)
+	SuspiciousAccounts(ctx context.Context, in *SuspiciousAccountsOptions, opts ...grpc.CallOption) (*SuspiciousAccountsResponse, error)
+}
+
+type catalogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCatalogClient(cc grpc.ClientConnInterface) CatalogClient {
+	return &catalogClient{cc}
+}
+
+func (c *catalogClient) CheckAdmin(ctx context.Context, in *CheckAdminOptions, opts ...grpc.CallOption) (*CheckAdminResponse, error) {
+	out := new(CheckAdminResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/CheckAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateArtist(ctx context.Context, in *ArtistOptions, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/CreateArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateArtist(ctx context.Context, in *ArtistOptions, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/UpdateArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) DeleteArtist(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/DeleteArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateAlbum(ctx context.Context, in *AlbumOptions, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/CreateAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateAlbum(ctx context.Context, in *AlbumOptions, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/UpdateAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) DeleteAlbum(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/DeleteAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateTrack(ctx context.Context, in *TrackOptions, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/CreateTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateTrack(ctx context.Context, in *TrackOptions, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/UpdateTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) DeleteTrack(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/DeleteTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) CreateGenre(ctx context.Context, in *GenreOptions, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/CreateGenre", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) UpdateGenre(ctx context.Context, in *GenreOptions, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/UpdateGenre", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) DeleteGenre(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/DeleteGenre", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) SetLyrics(ctx context.Context, in *SetLyricsOptions, opts ...grpc.CallOption) (*LyricsResponse, error) {
+	out := new(LyricsResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/SetLyrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) DeleteLyrics(ctx context.Context, in *DeleteOptions, opts ...grpc.CallOption) (*LyricsResponse, error) {
+	out := new(LyricsResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/DeleteLyrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogClient) SuspiciousAccounts(ctx context.Context, in *SuspiciousAccountsOptions, opts ...grpc.CallOption) (*SuspiciousAccountsResponse, error) {
+	out := new(SuspiciousAccountsResponse)
+	err := c.cc.Invoke(ctx, "/Catalog/SuspiciousAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CatalogServer is the server API for Catalog service.
+type CatalogServer interface {
+	CheckAdmin(context.Context, *CheckAdminOptions) (*CheckAdminResponse, error)
+	CreateArtist(context.Context, *ArtistOptions) (*CreateResponse, error)
+	UpdateArtist(context.Context, *ArtistOptions) (*UpdateResponse, error)
+	DeleteArtist(context.Context, *DeleteOptions) (*DeleteResponse, error)
+	CreateAlbum(context.Context, *AlbumOptions) (*CreateResponse, error)
+	UpdateAlbum(context.Context, *AlbumOptions) (*UpdateResponse, error)
+	DeleteAlbum(context.Context, *DeleteOptions) (*DeleteResponse, error)
+	CreateTrack(context.Context, *TrackOptions) (*CreateResponse, error)
+	UpdateTrack(context.Context, *TrackOptions) (*UpdateResponse, error)
+	DeleteTrack(context.Context, *DeleteOptions) (*DeleteResponse, error)
+	CreateGenre(context.Context, *GenreOptions) (*CreateResponse, error)
+	UpdateGenre(context.Context, *GenreOptions) (*UpdateResponse, error)
+	DeleteGenre(context.Context, *DeleteOptions) (*DeleteResponse, error)
+	SetLyrics(context.Context, *SetLyricsOptions) (*LyricsResponse, error)
+	DeleteLyrics(context.Context, *DeleteOptions) (*LyricsResponse, error)
+	SuspiciousAccounts(context.Context, *SuspiciousAccountsOptions) (*SuspiciousAccountsResponse, error)
+}
+
+// UnimplementedCatalogServer can be embedded to have forward compatible implementations.
+type UnimplementedCatalogServer struct {
+}
+
+func (*UnimplementedCatalogServer) CheckAdmin(context.Context, *CheckAdminOptions) (*CheckAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAdmin not implemented")
+}
+func (*UnimplementedCatalogServer) CreateArtist(context.Context, *ArtistOptions) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArtist not implemented")
+}
+func (*UnimplementedCatalogServer) UpdateArtist(context.Context, *ArtistOptions) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArtist not implemented")
+}
+func (*UnimplementedCatalogServer) DeleteArtist(context.Context, *DeleteOptions) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtist not implemented")
+}
+func (*UnimplementedCatalogServer) CreateAlbum(context.Context, *AlbumOptions) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlbum not implemented")
+}
+func (*UnimplementedCatalogServer) UpdateAlbum(context.Context, *AlbumOptions) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlbum not implemented")
+}
+func (*UnimplementedCatalogServer) DeleteAlbum(context.Context, *DeleteOptions) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlbum not implemented")
+}
+func (*UnimplementedCatalogServer) CreateTrack(context.Context, *TrackOptions) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrack not implemented")
+}
+func (*UnimplementedCatalogServer) UpdateTrack(context.Context, *TrackOptions) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrack not implemented")
+}
+func (*UnimplementedCatalogServer) DeleteTrack(context.Context, *DeleteOptions) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrack not implemented")
+}
+func (*UnimplementedCatalogServer) CreateGenre(context.Context, *GenreOptions) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGenre not implemented")
+}
+func (*UnimplementedCatalogServer) UpdateGenre(context.Context, *GenreOptions) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGenre not implemented")
+}
+func (*UnimplementedCatalogServer) DeleteGenre(context.Context, *DeleteOptions) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGenre not implemented")
+}
+func (*UnimplementedCatalogServer) SetLyrics(context.Context, *SetLyricsOptions) (*LyricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLyrics not implemented")
+}
+func (*UnimplementedCatalogServer) DeleteLyrics(context.Context, *DeleteOptions) (*LyricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLyrics not implemented")
+}
+func (*UnimplementedCatalogServer) SuspiciousAccounts(context.Context, *SuspiciousAccountsOptions) (*SuspiciousAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspiciousAccounts not implemented")
+}
+
+func RegisterCatalogServer(s *grpc.Server, srv CatalogServer) {
+	s.RegisterService(&_Catalog_serviceDesc, srv)
+}
+
+func _Catalog_CheckAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CheckAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/CheckAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CheckAdmin(ctx, req.(*CheckAdminOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtistOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/CreateArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateArtist(ctx, req.(*ArtistOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtistOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/UpdateArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateArtist(ctx, req.(*ArtistOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_DeleteArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).DeleteArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/DeleteArtist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).DeleteArtist(ctx, req.(*DeleteOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/CreateAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateAlbum(ctx, req.(*AlbumOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/UpdateAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateAlbum(ctx, req.(*AlbumOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_DeleteAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).DeleteAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/DeleteAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).DeleteAlbum(ctx, req.(*DeleteOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/CreateTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateTrack(ctx, req.(*TrackOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/UpdateTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateTrack(ctx, req.(*TrackOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_DeleteTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).DeleteTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/DeleteTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).DeleteTrack(ctx, req.(*DeleteOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_CreateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenreOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).CreateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/CreateGenre",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).CreateGenre(ctx, req.(*GenreOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_UpdateGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenreOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).UpdateGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/UpdateGenre",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).UpdateGenre(ctx, req.(*GenreOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_DeleteGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).DeleteGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/DeleteGenre",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).DeleteGenre(ctx, req.(*DeleteOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_SetLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLyricsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).SetLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/SetLyrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).SetLyrics(ctx, req.(*SetLyricsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_DeleteLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).DeleteLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/DeleteLyrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).DeleteLyrics(ctx, req.(*DeleteOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Catalog_SuspiciousAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspiciousAccountsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServer).SuspiciousAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Catalog/SuspiciousAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServer).SuspiciousAccounts(ctx, req.(*SuspiciousAccountsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Catalog_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Catalog",
+	HandlerType: (*CatalogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckAdmin",
+			Handler:    _Catalog_CheckAdmin_Handler,
+		},
+		{
+			MethodName: "CreateArtist",
+			Handler:    _Catalog_CreateArtist_Handler,
+		},
+		{
+			MethodName: "UpdateArtist",
+			Handler:    _Catalog_UpdateArtist_Handler,
+		},
+		{
+			MethodName: "DeleteArtist",
+			Handler:    _Catalog_DeleteArtist_Handler,
+		},
+		{
+			MethodName: "CreateAlbum",
+			Handler:    _Catalog_CreateAlbum_Handler,
+		},
+		{
+			MethodName: "UpdateAlbum",
+			Handler:    _Catalog_UpdateAlbum_Handler,
+		},
+		{
+			MethodName: "DeleteAlbum",
+			Handler:    _Catalog_DeleteAlbum_Handler,
+		},
+		{
+			MethodName: "CreateTrack",
+			Handler:    _Catalog_CreateTrack_Handler,
+		},
+		{
+			MethodName: "UpdateTrack",
+			Handler:    _Catalog_UpdateTrack_Handler,
+		},
+		{
+			MethodName: "DeleteTrack",
+			Handler:    _Catalog_DeleteTrack_Handler,
+		},
+		{
+			MethodName: "CreateGenre",
+			Handler:    _Catalog_CreateGenre_Handler,
+		},
+		{
+			MethodName: "UpdateGenre",
+			Handler:    _Catalog_UpdateGenre_Handler,
+		},
+		{
+			MethodName: "DeleteGenre",
+			Handler:    _Catalog_DeleteGenre_Handler,
+		},
+		{
+			MethodName: "SetLyrics",
+			Handler:    _Catalog_SetLyrics_Handler,
+		},
+		{
+			MethodName: "DeleteLyrics",
+			Handler:    _Catalog_DeleteLyrics_Handler,
+		},
+		{
+			MethodName: "SuspiciousAccounts",
+			Handler:    _Catalog_SuspiciousAccounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "catalog.proto",
+}
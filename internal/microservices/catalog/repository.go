@@ -0,0 +1,35 @@
+package catalog
+
+import (
+	"time"
+
+	"2021_2_LostPointer/internal/microservices/catalog/proto"
+	"2021_2_LostPointer/pkg/lrc"
+)
+
+//go:generate moq -out ./mock/catalog_repo_mock.go -pkg mock . Storage:MockStorage
+type Storage interface {
+	IsAdmin(int64) (bool, error)
+	DoesArtistExist(int64) (bool, error)
+	DoesAlbumExist(int64) (bool, error)
+	DoesTrackExist(int64) (bool, error)
+	DoesGenreExist(int64) (bool, error)
+	IsGenreUsed(int64) (bool, error)
+	IsArtistNameTaken(string, int64) (bool, error)
+	IsGenreNameTaken(string, int64) (bool, error)
+	CreateArtist(int64, *proto.CatalogArtist) (int64, error)
+	UpdateArtist(int64, *proto.CatalogArtist) (string, error)
+	DeleteArtist(int64, int64) (string, error)
+	CreateAlbum(int64, *proto.CatalogAlbum) (int64, error)
+	UpdateAlbum(int64, *proto.CatalogAlbum) (string, error)
+	DeleteAlbum(int64, int64) (string, error)
+	CreateTrack(int64, *proto.CatalogTrack) (int64, error)
+	UpdateTrack(int64, *proto.CatalogTrack) (string, error)
+	DeleteTrack(int64, int64) (string, error)
+	CreateGenre(int64, *proto.CatalogGenre) (int64, error)
+	UpdateGenre(int64, *proto.CatalogGenre) (string, error)
+	DeleteGenre(int64, int64) (string, error)
+	SetLyrics(int64, int64, string, []lrc.Line) error
+	DeleteLyrics(int64, int64) error
+	SuspiciousAccounts(since time.Time, minRejections int64, amount int64) ([]*proto.SuspiciousAccount, error)
+}
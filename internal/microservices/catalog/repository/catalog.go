@@ -0,0 +1,431 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/microservices/catalog/proto"
+	"2021_2_LostPointer/pkg/lrc"
+)
+
+type CatalogStorage struct {
+	db *sql.DB
+}
+
+func NewCatalogStorage(db *sql.DB) *CatalogStorage {
+	return &CatalogStorage{db: db}
+}
+
+// Изменение каталога и запись в аудит выполняются одним запросом, поэтому аудит не расходится с данными.
+// В аудит попадают строки до и после изменения. $1 - администратор, $2 - значение ключа key изменяемой записи.
+// Поисковый индекс треков обновляется триггерами на tracks, albums и artists
+func auditedQuery(action string, entity string, table string, key string, change string, result string) string {
+	var old, data string
+	switch action {
+	case constants.CatalogActionCreate:
+		data = `jsonb_build_object('new', to_jsonb(changed))`
+	case constants.CatalogActionUpdate:
+		old = `old AS (SELECT * FROM ` + table + ` WHERE ` + key + ` = $2), `
+		data = `jsonb_build_object('old', (SELECT to_jsonb(old) FROM old), 'new', to_jsonb(changed))`
+	case constants.CatalogActionDelete:
+		data = `jsonb_build_object('old', to_jsonb(changed))`
+	}
+
+	return `
+		WITH ` + old + `changed AS (` + change + ` RETURNING *), audit AS (
+			INSERT INTO catalog_audit(admin_id, action, entity, entity_id, data)
+			SELECT $1, '` + action + `', '` + entity + `', ` + key + `, ` + data + ` FROM changed
+		)
+		SELECT ` + result + ` FROM changed`
+}
+
+func (storage *CatalogStorage) IsAdmin(userID int64) (bool, error) {
+	var isAdmin bool
+	err := storage.db.QueryRow(`SELECT is_admin FROM users WHERE id = $1`, userID).Scan(&isAdmin)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return isAdmin, nil
+}
+
+func (storage *CatalogStorage) doesExist(query string, args ...interface{}) (bool, error) {
+	var exists bool
+	err := storage.db.QueryRow(query, args...).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (storage *CatalogStorage) DoesArtistExist(artistID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM artists WHERE id = $1)`, artistID)
+}
+
+func (storage *CatalogStorage) DoesAlbumExist(albumID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM albums WHERE id = $1)`, albumID)
+}
+
+func (storage *CatalogStorage) DoesTrackExist(trackID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM tracks WHERE id = $1)`, trackID)
+}
+
+func (storage *CatalogStorage) DoesGenreExist(genreID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM genres WHERE id = $1)`, genreID)
+}
+
+func (storage *CatalogStorage) IsGenreUsed(genreID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM tracks WHERE genre = $1)`, genreID)
+}
+
+func (storage *CatalogStorage) IsArtistNameTaken(name string, artistID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM artists WHERE name = $1 AND id <> $2)`, name, artistID)
+}
+
+func (storage *CatalogStorage) IsGenreNameTaken(name string, genreID int64) (bool, error) {
+	return storage.doesExist(`SELECT EXISTS(SELECT 1 FROM genres WHERE name = $1 AND id <> $2)`, name, genreID)
+}
+
+func (storage *CatalogStorage) CreateArtist(adminID int64, artist *proto.CatalogArtist) (int64, error) {
+	query := auditedQuery(constants.CatalogActionCreate, constants.CatalogEntityArtist, "artists", "id",
+		`INSERT INTO artists(name, bio, avatar, avatar_color, video) VALUES ($2, NULLIF($3, ''), $4, NULLIF($5, ''), $6)`,
+		`id`)
+
+	var artistID int64
+	err := storage.db.QueryRow(query, adminID, artist.Name, artist.Bio, artist.Avatar, artist.AvatarColor, artist.Video).Scan(&artistID)
+	if err != nil {
+		return 0, err
+	}
+
+	return artistID, nil
+}
+
+// Пустой Avatar оставляет прежний аватар. Возвращает прежний аватар
+func (storage *CatalogStorage) UpdateArtist(adminID int64, artist *proto.CatalogArtist) (string, error) {
+	query := auditedQuery(constants.CatalogActionUpdate, constants.CatalogEntityArtist, "artists", "id", `
+		UPDATE artists SET name = $3, bio = NULLIF($4, ''), avatar = COALESCE(NULLIF($5, ''), avatar),
+		avatar_color = CASE WHEN $5 = '' THEN avatar_color ELSE NULLIF($6, '') END, video = $7
+		WHERE id = $2`,
+		`(SELECT avatar FROM old)`)
+
+	var oldAvatar string
+	err := storage.db.QueryRow(query, adminID, artist.ID, artist.Name, artist.Bio, artist.Avatar, artist.AvatarColor, artist.Video).
+		Scan(&oldAvatar)
+	if err != nil {
+		return "", err
+	}
+
+	return oldAvatar, nil
+}
+
+// Альбомы и треки исполнителя удаляются каскадно
+func (storage *CatalogStorage) DeleteArtist(adminID int64, artistID int64) (string, error) {
+	query := auditedQuery(constants.CatalogActionDelete, constants.CatalogEntityArtist, "artists", "id",
+		`DELETE FROM artists WHERE id = $2`,
+		`avatar`)
+
+	var avatar string
+	err := storage.db.QueryRow(query, adminID, artistID).Scan(&avatar)
+	if err != nil {
+		return "", err
+	}
+
+	return avatar, nil
+}
+
+func (storage *CatalogStorage) CreateAlbum(adminID int64, album *proto.CatalogAlbum) (int64, error) {
+	query := auditedQuery(constants.CatalogActionCreate, constants.CatalogEntityAlbum, "albums", "id",
+		`INSERT INTO albums(title, year, artist, artwork, artwork_color, track_count, release_date)
+		VALUES ($2, $3, $4, NULLIF($5, ''), $6, 0, COALESCE(NULLIF($7, '')::date, make_date($3, 1, 1)))`,
+		`id`)
+
+	var albumID int64
+	err := storage.db.QueryRow(query, adminID, album.Title, album.Year, album.ArtistID, album.Artwork, album.ArtworkColor,
+		album.ReleaseDate).Scan(&albumID)
+	if err != nil {
+		return 0, err
+	}
+
+	return albumID, nil
+}
+
+// Пустой Artwork оставляет прежнюю обложку, пустой ReleaseDate — прежнюю дату, если не сменился год.
+// Возвращает прежнюю обложку
+func (storage *CatalogStorage) UpdateAlbum(adminID int64, album *proto.CatalogAlbum) (string, error) {
+	query := auditedQuery(constants.CatalogActionUpdate, constants.CatalogEntityAlbum, "albums", "id", `
+		UPDATE albums SET title = $3, year = $4, artist = $5, artwork = COALESCE(NULLIF($6, ''), artwork),
+		artwork_color = CASE WHEN $6 = '' THEN artwork_color ELSE $7 END,
+		release_date = CASE
+			WHEN $8 <> '' THEN $8::date
+			WHEN date_part('year', release_date) = $4 THEN release_date
+			ELSE make_date($4, 1, 1)
+		END
+		WHERE id = $2`,
+		`COALESCE((SELECT artwork FROM old), '')`)
+
+	var oldArtwork string
+	err := storage.db.QueryRow(query, adminID, album.ID, album.Title, album.Year, album.ArtistID, album.Artwork, album.ArtworkColor,
+		album.ReleaseDate).Scan(&oldArtwork)
+	if err != nil {
+		return "", err
+	}
+
+	return oldArtwork, nil
+}
+
+// Треки альбома удаляются каскадно
+func (storage *CatalogStorage) DeleteAlbum(adminID int64, albumID int64) (string, error) {
+	query := auditedQuery(constants.CatalogActionDelete, constants.CatalogEntityAlbum, "albums", "id",
+		`DELETE FROM albums WHERE id = $2`,
+		`COALESCE(artwork, '')`)
+
+	var artwork string
+	err := storage.db.QueryRow(query, adminID, albumID).Scan(&artwork)
+	if err != nil {
+		return "", err
+	}
+
+	return artwork, nil
+}
+
+// Количество треков в альбомах пересчитывается в той же транзакции, что и изменение трека
+func (storage *CatalogStorage) updateTrackCount(tx *sql.Tx, albumIDs ...int64) error {
+	for _, albumID := range albumIDs {
+		_, err := tx.Exec(`UPDATE albums SET track_count = (SELECT COUNT(*) FROM tracks WHERE album = $1) WHERE id = $1`, albumID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (storage *CatalogStorage) CreateTrack(adminID int64, track *proto.CatalogTrack) (int64, error) {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := auditedQuery(constants.CatalogActionCreate, constants.CatalogEntityTrack, "tracks", "id", `
+		INSERT INTO tracks(title, artist, album, genre, number, explicit, file, duration, lossless)
+		VALUES ($2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		`id`)
+
+	var trackID int64
+	err = tx.QueryRow(query, adminID, track.Title, track.ArtistID, track.AlbumID, track.GenreID, track.Number,
+		track.Explicit, track.File, track.Duration, track.Lossless).Scan(&trackID)
+	if err != nil {
+		return 0, err
+	}
+	if err = storage.updateTrackCount(tx, track.AlbumID); err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return trackID, nil
+}
+
+// Пустой File оставляет прежний файл вместе с длительностью и признаком lossless. Возвращает прежний файл
+func (storage *CatalogStorage) UpdateTrack(adminID int64, track *proto.CatalogTrack) (string, error) {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := auditedQuery(constants.CatalogActionUpdate, constants.CatalogEntityTrack, "tracks", "id", `
+		UPDATE tracks SET title = $3, artist = $4, album = $5, genre = $6, number = $7,
+		explicit = $8, file = COALESCE(NULLIF($9, ''), file),
+		duration = CASE WHEN $9 = '' THEN duration ELSE $10 END,
+		lossless = CASE WHEN $9 = '' THEN lossless ELSE $11 END
+		WHERE id = $2`,
+		`(SELECT file FROM old), (SELECT album FROM old)`)
+
+	var (
+		oldFile    string
+		oldAlbumID int64
+	)
+	err = tx.QueryRow(query, adminID, track.ID, track.Title, track.ArtistID, track.AlbumID, track.GenreID, track.Number,
+		track.Explicit, track.File, track.Duration, track.Lossless).Scan(&oldFile, &oldAlbumID)
+	if err != nil {
+		return "", err
+	}
+	albumIDs := []int64{track.AlbumID}
+	if oldAlbumID != track.AlbumID {
+		albumIDs = append(albumIDs, oldAlbumID)
+	}
+	if err = storage.updateTrackCount(tx, albumIDs...); err != nil {
+		return "", err
+	}
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return oldFile, nil
+}
+
+// Трек удаляется из плейлистов и избранного каскадно. Возвращает файл трека
+func (storage *CatalogStorage) DeleteTrack(adminID int64, trackID int64) (string, error) {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := auditedQuery(constants.CatalogActionDelete, constants.CatalogEntityTrack, "tracks", "id",
+		`DELETE FROM tracks WHERE id = $2`,
+		`file, album`)
+
+	var (
+		file    string
+		albumID int64
+	)
+	if err = tx.QueryRow(query, adminID, trackID).Scan(&file, &albumID); err != nil {
+		return "", err
+	}
+	if err = storage.updateTrackCount(tx, albumID); err != nil {
+		return "", err
+	}
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return file, nil
+}
+
+func (storage *CatalogStorage) CreateGenre(adminID int64, genre *proto.CatalogGenre) (int64, error) {
+	query := auditedQuery(constants.CatalogActionCreate, constants.CatalogEntityGenre, "genres", "id",
+		`INSERT INTO genres(name, artwork, artwork_color) VALUES ($2, $3, $4)`,
+		`id`)
+
+	var genreID int64
+	err := storage.db.QueryRow(query, adminID, genre.Name, genre.Artwork, genre.ArtworkColor).Scan(&genreID)
+	if err != nil {
+		return 0, err
+	}
+
+	return genreID, nil
+}
+
+// Пустой Artwork оставляет прежнюю обложку. Возвращает прежнюю обложку
+func (storage *CatalogStorage) UpdateGenre(adminID int64, genre *proto.CatalogGenre) (string, error) {
+	query := auditedQuery(constants.CatalogActionUpdate, constants.CatalogEntityGenre, "genres", "id", `
+		UPDATE genres SET name = $3, artwork = COALESCE(NULLIF($4, ''), artwork),
+		artwork_color = CASE WHEN $4 = '' THEN artwork_color ELSE $5 END
+		WHERE id = $2`,
+		`(SELECT artwork FROM old)`)
+
+	var oldArtwork string
+	err := storage.db.QueryRow(query, adminID, genre.ID, genre.Name, genre.Artwork, genre.ArtworkColor).Scan(&oldArtwork)
+	if err != nil {
+		return "", err
+	}
+
+	return oldArtwork, nil
+}
+
+// Треки жанра остаются без жанра
+func (storage *CatalogStorage) DeleteGenre(adminID int64, genreID int64) (string, error) {
+	query := auditedQuery(constants.CatalogActionDelete, constants.CatalogEntityGenre, "genres", "id",
+		`DELETE FROM genres WHERE id = $2`,
+		`artwork`)
+
+	var artwork string
+	err := storage.db.QueryRow(query, adminID, genreID).Scan(&artwork)
+	if err != nil {
+		return "", err
+	}
+
+	return artwork, nil
+}
+
+// Текст трека хранится одной записью, повторная загрузка заменяет его.
+// Синхронизированные строки хранятся в jsonb, для обычного текста lines = NULL
+func (storage *CatalogStorage) SetLyrics(adminID int64, trackID int64, plain string, lines []lrc.Line) error {
+	var rawLines interface{}
+	if len(lines) != 0 {
+		data, err := json.Marshal(lines)
+		if err != nil {
+			return err
+		}
+		rawLines = string(data)
+	}
+
+	query := auditedQuery(constants.CatalogActionUpdate, constants.CatalogEntityLyrics, "lyrics", "track_id", `
+		INSERT INTO lyrics(track_id, plain, lines) VALUES ($2, $3, $4)
+		ON CONFLICT (track_id) DO UPDATE SET plain = EXCLUDED.plain, lines = EXCLUDED.lines, updated_at = now()`,
+		`track_id`)
+
+	var id int64
+	return storage.db.QueryRow(query, adminID, trackID, plain, rawLines).Scan(&id)
+}
+
+func (storage *CatalogStorage) DeleteLyrics(adminID int64, trackID int64) error {
+	query := auditedQuery(constants.CatalogActionDelete, constants.CatalogEntityLyrics, "lyrics", "track_id",
+		`DELETE FROM lyrics WHERE track_id = $2`,
+		`track_id`)
+
+	var id int64
+	return storage.db.QueryRow(query, adminID, trackID).Scan(&id)
+}
+
+// Аккаунты, у которых с момента since отклонено не меньше minRejections прослушиваний.
+// Для сравнения возвращается и число засчитанных прослушиваний за тот же период
+func (storage *CatalogStorage) SuspiciousAccounts(since time.Time, minRejections int64, amount int64) ([]*proto.SuspiciousAccount, error) {
+	query := `
+		SELECT r.user_id, u.nickname, u.email, COUNT(*) AS rejections, COUNT(DISTINCT r.ip) AS ips,
+		(SELECT COUNT(*) FROM listens l WHERE l.user_id = r.user_id AND l.listened_at >= $1) AS listens,
+		MAX(r.rejected_at) AS last_rejected_at
+		FROM listen_rejections r
+		JOIN users u ON u.id = r.user_id
+		WHERE r.rejected_at >= $1
+		GROUP BY r.user_id, u.nickname, u.email
+		HAVING COUNT(*) >= $2
+		ORDER BY rejections DESC, r.user_id LIMIT $3`
+
+	rows, err := storage.db.Query(query, since, minRejections, amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	accounts := make([]*proto.SuspiciousAccount, 0)
+	for rows.Next() {
+		account := &proto.SuspiciousAccount{}
+		var lastRejectedAt time.Time
+		if err = rows.Scan(&account.UserID, &account.Nickname, &account.Email, &account.Rejections, &account.IPs,
+			&account.Listens, &lastRejectedAt); err != nil {
+			return nil, err
+		}
+		account.LastRejectedAt = lastRejectedAt.Unix()
+		accounts = append(accounts, account)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,403 @@
+package repository
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/microservices/catalog/proto"
+	"2021_2_LostPointer/pkg/lrc"
+)
+
+func TestCatalogStorage_IsAdmin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewCatalogStorage(db)
+
+	const userID = 1
+	query := `SELECT is_admin FROM users WHERE id = $1`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "user is admin",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).
+					WillReturnRows(sqlmock.NewRows([]string{"is_admin"}).AddRow(true))
+			},
+			expected: true,
+		},
+		{
+			name: "user doesn't exist",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).
+					WillReturnRows(sqlmock.NewRows([]string{"is_admin"}))
+			},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.IsAdmin(userID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestCatalogStorage_CreateArtist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewCatalogStorage(db)
+
+	const (
+		adminID  = 1
+		artistID = 2
+	)
+	artist := &proto.CatalogArtist{Name: "Face", Avatar: constants.ArtistAvatarDefaultFilename, Video: constants.ArtistVideoDefaultFilename}
+	query := `INSERT INTO artists(name, bio, avatar, avatar_color, video) VALUES ($2, NULLIF($3, ''), $4, NULLIF($5, ''), $6) RETURNING *), audit AS (
+			INSERT INTO catalog_audit(admin_id, action, entity, entity_id, data)
+			SELECT $1, 'create', 'artist', id, jsonb_build_object('new', to_jsonb(changed)) FROM changed`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      int64
+		expectedError bool
+	}{
+		{
+			name: "artist created and audited",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(driver.Value(adminID), driver.Value(artist.Name), driver.Value(""),
+						driver.Value(artist.Avatar), driver.Value(""), driver.Value(artist.Video)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(artistID))
+			},
+			expected: artistID,
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.CreateArtist(adminID, artist)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestCatalogStorage_UpdateTrack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewCatalogStorage(db)
+
+	const (
+		adminID    = 1
+		oldAlbumID = 3
+		oldFile    = "oldFile"
+	)
+	track := &proto.CatalogTrack{ID: 1, Title: "Ghetto", ArtistID: 2, AlbumID: 4, File: "newFile", Duration: 200, Lossless: true}
+	query := `WITH old AS (SELECT * FROM tracks WHERE id = $2), changed AS (`
+	auditQuery := `SELECT $1, 'update', 'track', id, jsonb_build_object('old', (SELECT to_jsonb(old) FROM old), 'new', to_jsonb(changed)) FROM changed`
+	trackCountQuery := `UPDATE albums SET track_count = (SELECT COUNT(*) FROM tracks WHERE album = $1) WHERE id = $1`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      string
+		expectedError bool
+	}{
+		{
+			name: "track moved to another album",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)+".*"+regexp.QuoteMeta(auditQuery)).
+					WithArgs(driver.Value(adminID), driver.Value(track.ID), driver.Value(track.Title), driver.Value(track.ArtistID),
+						driver.Value(track.AlbumID), driver.Value(0), driver.Value(0), driver.Value(false),
+						driver.Value(track.File), driver.Value(track.Duration), driver.Value(true)).
+					WillReturnRows(sqlmock.NewRows([]string{"file", "album"}).AddRow(oldFile, oldAlbumID))
+				mock.ExpectExec(regexp.QuoteMeta(trackCountQuery)).WithArgs(driver.Value(track.AlbumID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(trackCountQuery)).WithArgs(driver.Value(oldAlbumID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expected: oldFile,
+		},
+		{
+			name: "track doesn't exist",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"file", "album"}))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+		{
+			name: "track count update returns error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WillReturnRows(sqlmock.NewRows([]string{"file", "album"}).AddRow(oldFile, oldAlbumID))
+				mock.ExpectExec(regexp.QuoteMeta(trackCountQuery)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+		{
+			name: "begin returns error",
+			mock: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.UpdateTrack(adminID, track)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCatalogStorage_DeleteGenre(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewCatalogStorage(db)
+
+	const (
+		adminID = 1
+		genreID = 2
+		artwork = "artwork"
+	)
+	query := `DELETE FROM genres WHERE id = $2 RETURNING *), audit AS (
+			INSERT INTO catalog_audit(admin_id, action, entity, entity_id, data)
+			SELECT $1, 'delete', 'genre', id, jsonb_build_object('old', to_jsonb(changed)) FROM changed`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      string
+		expectedError error
+	}{
+		{
+			name: "genre deleted and audited",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(adminID), driver.Value(genreID)).
+					WillReturnRows(sqlmock.NewRows([]string{"artwork"}).AddRow(artwork))
+			},
+			expected: artwork,
+		},
+		{
+			name: "genre doesn't exist",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(adminID), driver.Value(genreID)).
+					WillReturnRows(sqlmock.NewRows([]string{"artwork"}))
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.DeleteGenre(adminID, genreID)
+			if currentTest.expectedError != nil {
+				assert.ErrorIs(t, err, currentTest.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestCatalogStorage_SetLyrics(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewCatalogStorage(db)
+
+	const (
+		adminID = 1
+		trackID = 2
+		plain   = "First\nSecond"
+	)
+	lines := []lrc.Line{{Time: 1000, Text: "First"}, {Time: 2500, Text: "Second"}}
+	query := `WITH old AS (SELECT * FROM lyrics WHERE track_id = $2), changed AS (
+		INSERT INTO lyrics(track_id, plain, lines) VALUES ($2, $3, $4)
+		ON CONFLICT (track_id) DO UPDATE SET plain = EXCLUDED.plain, lines = EXCLUDED.lines, updated_at = now() RETURNING *), audit AS (
+			INSERT INTO catalog_audit(admin_id, action, entity, entity_id, data)
+			SELECT $1, 'update', 'lyrics', track_id,`
+
+	tests := []struct {
+		name          string
+		lines         []lrc.Line
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name:  "synced lyrics saved and audited",
+			lines: lines,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(driver.Value(adminID), driver.Value(trackID), driver.Value(plain),
+						driver.Value(`[{"time":1000,"text":"First"},{"time":2500,"text":"Second"}]`)).
+					WillReturnRows(sqlmock.NewRows([]string{"track_id"}).AddRow(trackID))
+			},
+		},
+		{
+			name: "plain lyrics saved without lines",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(driver.Value(adminID), driver.Value(trackID), driver.Value(plain), nil).
+					WillReturnRows(sqlmock.NewRows([]string{"track_id"}).AddRow(trackID))
+			},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.SetLyrics(adminID, trackID, plain, currentTest.lines)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCatalogStorage_SuspiciousAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewCatalogStorage(db)
+
+	since := time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)
+	lastRejectedAt := time.Date(2021, time.December, 6, 12, 0, 0, 0, time.UTC)
+	account := &proto.SuspiciousAccount{
+		UserID:         7,
+		Nickname:       "bot",
+		Email:          "bot@example.com",
+		Rejections:     120,
+		IPs:            4,
+		Listens:        60,
+		LastRejectedAt: lastRejectedAt.Unix(),
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.SuspiciousAccount
+		expectedError bool
+	}{
+		{
+			name: "suspicious accounts found",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"user_id", "nickname", "email", "rejections", "ips", "listens",
+					"last_rejected_at"})
+				rows.AddRow(account.UserID, account.Nickname, account.Email, account.Rejections, account.IPs,
+					account.Listens, lastRejectedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listen_rejections r`)).
+					WithArgs(since, 10, 100).WillReturnRows(rows)
+			},
+			expected: []*proto.SuspiciousAccount{account},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listen_rejections r`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.SuspiciousAccounts(since, 10, 100)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,460 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/microservices/catalog"
+	"2021_2_LostPointer/internal/microservices/catalog/proto"
+	"2021_2_LostPointer/pkg/lrc"
+	"2021_2_LostPointer/pkg/validation"
+)
+
+type CatalogService struct {
+	storage catalog.Storage
+}
+
+func NewCatalogService(storage catalog.Storage) *CatalogService {
+	return &CatalogService{storage: storage}
+}
+
+func (service *CatalogService) checkAdmin(adminID int64) error {
+	isAdmin, err := service.storage.IsAdmin(adminID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !isAdmin {
+		return status.Error(codes.PermissionDenied, constants.NotAdminMessage)
+	}
+
+	return nil
+}
+
+// Шлюз проверяет права до разбора загружаемых файлов, чтобы не сохранять их для обычных пользователей
+func (service *CatalogService) CheckAdmin(ctx context.Context, data *proto.CheckAdminOptions) (*proto.CheckAdminResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.CheckAdminResponse{}, err
+	}
+
+	return &proto.CheckAdminResponse{}, nil
+}
+
+func validationError(isValid bool, msg string, err error) error {
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !isValid {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+
+	return nil
+}
+
+// Запись, на которую ссылается изменяемая, должна существовать, иначе это ошибка в запросе
+func referenceError(doesExist func(int64) (bool, error), id int64, msg string) error {
+	exists, err := doesExist(id)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !exists {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+
+	return nil
+}
+
+func storageError(err error, notFoundMessage string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, notFoundMessage)
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (service *CatalogService) validateArtist(artist *proto.CatalogArtist) error {
+	artist.Name = strings.TrimSpace(artist.Name)
+	if err := validationError(validation.ValidateCatalogName(artist.Name)); err != nil {
+		return err
+	}
+
+	isTaken, err := service.storage.IsArtistNameTaken(artist.Name, artist.ID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if isTaken {
+		return status.Error(codes.InvalidArgument, constants.ArtistNameNotUniqueMessage)
+	}
+
+	if len(artist.Video) == 0 {
+		artist.Video = constants.ArtistVideoDefaultFilename
+	}
+
+	return nil
+}
+
+func (service *CatalogService) validateAlbum(album *proto.CatalogAlbum) error {
+	album.Title = strings.TrimSpace(album.Title)
+	if err := validationError(validation.ValidateCatalogName(album.Title)); err != nil {
+		return err
+	}
+	if err := validationError(validation.ValidateAlbumYear(album.Year)); err != nil {
+		return err
+	}
+	if err := validationError(validation.ValidateAlbumReleaseDate(album.ReleaseDate, album.Year)); err != nil {
+		return err
+	}
+
+	return referenceError(service.storage.DoesArtistExist, album.ArtistID, constants.ArtistNotFoundMessage)
+}
+
+func (service *CatalogService) validateTrack(track *proto.CatalogTrack) error {
+	track.Title = strings.TrimSpace(track.Title)
+	if err := validationError(validation.ValidateTrack(track.Title, track.Number)); err != nil {
+		return err
+	}
+	if len(track.File) != 0 && track.Duration <= 0 {
+		return status.Error(codes.InvalidArgument, constants.TrackDurationInvalidMessage)
+	}
+
+	if err := referenceError(service.storage.DoesArtistExist, track.ArtistID, constants.ArtistNotFoundMessage); err != nil {
+		return err
+	}
+	if err := referenceError(service.storage.DoesAlbumExist, track.AlbumID, constants.AlbumNotFoundMessage); err != nil {
+		return err
+	}
+	return referenceError(service.storage.DoesGenreExist, track.GenreID, constants.GenreNotFoundMessage)
+}
+
+func (service *CatalogService) validateGenre(genre *proto.CatalogGenre) error {
+	genre.Name = strings.TrimSpace(genre.Name)
+	if err := validationError(validation.ValidateCatalogName(genre.Name)); err != nil {
+		return err
+	}
+
+	isTaken, err := service.storage.IsGenreNameTaken(genre.Name, genre.ID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if isTaken {
+		return status.Error(codes.InvalidArgument, constants.GenreNameNotUniqueMessage)
+	}
+
+	return nil
+}
+
+func (service *CatalogService) CreateArtist(ctx context.Context, data *proto.ArtistOptions) (*proto.CreateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+	artist := data.GetArtist()
+	if artist == nil {
+		artist = &proto.CatalogArtist{}
+	}
+	artist.ID = 0
+	if err := service.validateArtist(artist); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+
+	if len(artist.Avatar) == 0 {
+		artist.Avatar = constants.ArtistAvatarDefaultFilename
+		artist.AvatarColor = ""
+	}
+	artistID, err := service.storage.CreateArtist(data.AdminID, artist)
+	if err != nil {
+		return &proto.CreateResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.CreateResponse{ID: artistID}, nil
+}
+
+func (service *CatalogService) UpdateArtist(ctx context.Context, data *proto.ArtistOptions) (*proto.UpdateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+	artist := data.GetArtist()
+	if artist == nil {
+		artist = &proto.CatalogArtist{}
+	}
+	if err := service.validateArtist(artist); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+
+	oldAvatar, err := service.storage.UpdateArtist(data.AdminID, artist)
+	if err != nil {
+		return &proto.UpdateResponse{}, storageError(err, constants.ArtistNotFoundMessage)
+	}
+
+	response := &proto.UpdateResponse{}
+	if len(artist.Avatar) != 0 {
+		response.OldFilename = oldAvatar
+	}
+	return response, nil
+}
+
+func (service *CatalogService) DeleteArtist(ctx context.Context, data *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.DeleteResponse{}, err
+	}
+
+	avatar, err := service.storage.DeleteArtist(data.AdminID, data.ID)
+	if err != nil {
+		return &proto.DeleteResponse{}, storageError(err, constants.ArtistNotFoundMessage)
+	}
+
+	return &proto.DeleteResponse{OldFilename: avatar}, nil
+}
+
+func (service *CatalogService) CreateAlbum(ctx context.Context, data *proto.AlbumOptions) (*proto.CreateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+	album := data.GetAlbum()
+	if album == nil {
+		album = &proto.CatalogAlbum{}
+	}
+	album.ID = 0
+	if err := service.validateAlbum(album); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+
+	if len(album.Artwork) == 0 {
+		album.ArtworkColor = constants.AlbumArtworkDefaultColor
+	}
+	albumID, err := service.storage.CreateAlbum(data.AdminID, album)
+	if err != nil {
+		return &proto.CreateResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.CreateResponse{ID: albumID}, nil
+}
+
+func (service *CatalogService) UpdateAlbum(ctx context.Context, data *proto.AlbumOptions) (*proto.UpdateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+	album := data.GetAlbum()
+	if album == nil {
+		album = &proto.CatalogAlbum{}
+	}
+	if err := service.validateAlbum(album); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+
+	oldArtwork, err := service.storage.UpdateAlbum(data.AdminID, album)
+	if err != nil {
+		return &proto.UpdateResponse{}, storageError(err, constants.AlbumNotFoundMessage)
+	}
+
+	response := &proto.UpdateResponse{}
+	if len(album.Artwork) != 0 {
+		response.OldFilename = oldArtwork
+	}
+	return response, nil
+}
+
+func (service *CatalogService) DeleteAlbum(ctx context.Context, data *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.DeleteResponse{}, err
+	}
+
+	artwork, err := service.storage.DeleteAlbum(data.AdminID, data.ID)
+	if err != nil {
+		return &proto.DeleteResponse{}, storageError(err, constants.AlbumNotFoundMessage)
+	}
+
+	return &proto.DeleteResponse{OldFilename: artwork}, nil
+}
+
+func (service *CatalogService) CreateTrack(ctx context.Context, data *proto.TrackOptions) (*proto.CreateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+	track := data.GetTrack()
+	if track == nil {
+		track = &proto.CatalogTrack{}
+	}
+	track.ID = 0
+	if len(track.File) == 0 {
+		return &proto.CreateResponse{}, status.Error(codes.InvalidArgument, constants.TrackFileRequiredMessage)
+	}
+	if err := service.validateTrack(track); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+
+	trackID, err := service.storage.CreateTrack(data.AdminID, track)
+	if err != nil {
+		return &proto.CreateResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.CreateResponse{ID: trackID}, nil
+}
+
+func (service *CatalogService) UpdateTrack(ctx context.Context, data *proto.TrackOptions) (*proto.UpdateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+	track := data.GetTrack()
+	if track == nil {
+		track = &proto.CatalogTrack{}
+	}
+	if err := service.validateTrack(track); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+
+	oldFile, err := service.storage.UpdateTrack(data.AdminID, track)
+	if err != nil {
+		return &proto.UpdateResponse{}, storageError(err, constants.TrackNotFound)
+	}
+
+	response := &proto.UpdateResponse{}
+	if len(track.File) != 0 {
+		response.OldFilename = oldFile
+	}
+	return response, nil
+}
+
+func (service *CatalogService) DeleteTrack(ctx context.Context, data *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.DeleteResponse{}, err
+	}
+
+	file, err := service.storage.DeleteTrack(data.AdminID, data.ID)
+	if err != nil {
+		return &proto.DeleteResponse{}, storageError(err, constants.TrackNotFound)
+	}
+
+	return &proto.DeleteResponse{OldFilename: file}, nil
+}
+
+func (service *CatalogService) CreateGenre(ctx context.Context, data *proto.GenreOptions) (*proto.CreateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+	genre := data.GetGenre()
+	if genre == nil {
+		genre = &proto.CatalogGenre{}
+	}
+	genre.ID = 0
+	if err := service.validateGenre(genre); err != nil {
+		return &proto.CreateResponse{}, err
+	}
+
+	if len(genre.Artwork) == 0 {
+		genre.Artwork = constants.GenreArtworkDefaultFilename
+		genre.ArtworkColor = constants.GenreArtworkDefaultColor
+	}
+	genreID, err := service.storage.CreateGenre(data.AdminID, genre)
+	if err != nil {
+		return &proto.CreateResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.CreateResponse{ID: genreID}, nil
+}
+
+func (service *CatalogService) UpdateGenre(ctx context.Context, data *proto.GenreOptions) (*proto.UpdateResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+	genre := data.GetGenre()
+	if genre == nil {
+		genre = &proto.CatalogGenre{}
+	}
+	if err := service.validateGenre(genre); err != nil {
+		return &proto.UpdateResponse{}, err
+	}
+
+	oldArtwork, err := service.storage.UpdateGenre(data.AdminID, genre)
+	if err != nil {
+		return &proto.UpdateResponse{}, storageError(err, constants.GenreNotFoundMessage)
+	}
+
+	response := &proto.UpdateResponse{}
+	if len(genre.Artwork) != 0 {
+		response.OldFilename = oldArtwork
+	}
+	return response, nil
+}
+
+func (service *CatalogService) DeleteGenre(ctx context.Context, data *proto.DeleteOptions) (*proto.DeleteResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.DeleteResponse{}, err
+	}
+	isUsed, err := service.storage.IsGenreUsed(data.ID)
+	if err != nil {
+		return &proto.DeleteResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if isUsed {
+		return &proto.DeleteResponse{}, status.Error(codes.InvalidArgument, constants.GenreHasTracksMessage)
+	}
+
+	artwork, err := service.storage.DeleteGenre(data.AdminID, data.ID)
+	if err != nil {
+		return &proto.DeleteResponse{}, storageError(err, constants.GenreNotFoundMessage)
+	}
+
+	return &proto.DeleteResponse{OldFilename: artwork}, nil
+}
+
+// Синхронизированный текст принимается в формате LRC. Если обычный текст не передан, он собирается из строк LRC
+func (service *CatalogService) SetLyrics(ctx context.Context, data *proto.SetLyricsOptions) (*proto.LyricsResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.LyricsResponse{}, err
+	}
+	if err := referenceError(service.storage.DoesTrackExist, data.TrackID, constants.TrackNotFound); err != nil {
+		return &proto.LyricsResponse{}, err
+	}
+
+	var lines []lrc.Line
+	if len(strings.TrimSpace(data.Synced)) != 0 {
+		var err error
+		if lines, err = lrc.Parse(data.Synced); err != nil {
+			return &proto.LyricsResponse{}, status.Error(codes.InvalidArgument, constants.LyricsInvalidMessage+": "+err.Error())
+		}
+	}
+	plain := strings.TrimSpace(data.Plain)
+	if len(plain) == 0 {
+		plain = lrc.Plain(lines)
+	}
+	if len(plain) == 0 {
+		return &proto.LyricsResponse{}, status.Error(codes.InvalidArgument, constants.LyricsRequiredMessage)
+	}
+
+	if err := service.storage.SetLyrics(data.AdminID, data.TrackID, plain, lines); err != nil {
+		return &proto.LyricsResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.LyricsResponse{}, nil
+}
+
+func (service *CatalogService) DeleteLyrics(ctx context.Context, data *proto.DeleteOptions) (*proto.LyricsResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.LyricsResponse{}, err
+	}
+
+	if err := service.storage.DeleteLyrics(data.AdminID, data.ID); err != nil {
+		return &proto.LyricsResponse{}, storageError(err, constants.LyricsNotFoundMessage)
+	}
+
+	return &proto.LyricsResponse{}, nil
+}
+
+func (service *CatalogService) SuspiciousAccounts(ctx context.Context, data *proto.SuspiciousAccountsOptions) (*proto.SuspiciousAccountsResponse, error) {
+	if err := service.checkAdmin(data.AdminID); err != nil {
+		return &proto.SuspiciousAccountsResponse{}, err
+	}
+
+	accounts, err := service.storage.SuspiciousAccounts(time.Now().Add(-constants.SuspiciousAccountsPeriod),
+		constants.SuspiciousMinRejections, constants.SuspiciousAccountsAmount)
+	if err != nil {
+		return &proto.SuspiciousAccountsResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.SuspiciousAccountsResponse{Accounts: accounts}, nil
+}
@@ -0,0 +1,592 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/microservices/catalog/mock"
+	"2021_2_LostPointer/internal/microservices/catalog/proto"
+	"2021_2_LostPointer/pkg/lrc"
+)
+
+const testImageFilename = "lahaine"
+
+func isAdmin(int64) (bool, error) {
+	return true, nil
+}
+
+func exists(int64) (bool, error) {
+	return true, nil
+}
+
+func notUsed(int64) (bool, error) {
+	return false, nil
+}
+
+func notTaken(string, int64) (bool, error) {
+	return false, nil
+}
+
+func TestCatalogService_CreateArtist(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.ArtistOptions
+		expected    *proto.CreateResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:           isAdmin,
+				IsArtistNameTakenFunc: notTaken,
+				CreateArtistFunc: func(adminID int64, artist *proto.CatalogArtist) (int64, error) {
+					if artist.Name != "Face" || artist.Avatar != constants.ArtistAvatarDefaultFilename ||
+						artist.Video != constants.ArtistVideoDefaultFilename {
+						return 0, errors.New("unexpected artist")
+					}
+					return 1, nil
+				},
+			},
+			input:    &proto.ArtistOptions{AdminID: 1, Artist: &proto.CatalogArtist{Name: " Face "}},
+			expected: &proto.CreateResponse{ID: 1},
+		},
+		{
+			name: "Error 403. User is not admin",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.ArtistOptions{AdminID: 1, Artist: &proto.CatalogArtist{Name: "Face"}},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.NotAdminMessage),
+		},
+		{
+			name: "Error 400. Name is not valid",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+			},
+			input:       &proto.ArtistOptions{AdminID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.CatalogNameInvalidLengthMessage),
+		},
+		{
+			name: "Error 400. Name is taken",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+				IsArtistNameTakenFunc: func(string, int64) (bool, error) {
+					return true, nil
+				},
+			},
+			input:       &proto.ArtistOptions{AdminID: 1, Artist: &proto.CatalogArtist{Name: "Face"}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.ArtistNameNotUniqueMessage),
+		},
+		{
+			name: "Error 500. Storage error",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:           isAdmin,
+				IsArtistNameTakenFunc: notTaken,
+				CreateArtistFunc: func(int64, *proto.CatalogArtist) (int64, error) {
+					return 0, errors.New("error")
+				},
+			},
+			input:       &proto.ArtistOptions{AdminID: 1, Artist: &proto.CatalogArtist{Name: "Face"}},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.CreateArtist(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestCatalogService_UpdateAlbum(t *testing.T) {
+	album := &proto.CatalogAlbum{ID: 1, Title: "Hate Love", Year: 2019, ArtistID: 1, Artwork: "new", ArtworkColor: "#000000"}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.AlbumOptions
+		expected    *proto.UpdateResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success. Old artwork is returned",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:         isAdmin,
+				DoesArtistExistFunc: exists,
+				UpdateAlbumFunc: func(int64, *proto.CatalogAlbum) (string, error) {
+					return testImageFilename, nil
+				},
+			},
+			input:    &proto.AlbumOptions{AdminID: 1, Album: album},
+			expected: &proto.UpdateResponse{OldFilename: testImageFilename},
+		},
+		{
+			name: "Success. Artwork is kept",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:         isAdmin,
+				DoesArtistExistFunc: exists,
+				UpdateAlbumFunc: func(int64, *proto.CatalogAlbum) (string, error) {
+					return testImageFilename, nil
+				},
+			},
+			input:    &proto.AlbumOptions{AdminID: 1, Album: &proto.CatalogAlbum{ID: 1, Title: "Hate Love", Year: 2019, ArtistID: 1}},
+			expected: &proto.UpdateResponse{},
+		},
+		{
+			name: "Error 400. Year is not valid",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+			},
+			input:       &proto.AlbumOptions{AdminID: 1, Album: &proto.CatalogAlbum{ID: 1, Title: "Hate Love", Year: 1800, ArtistID: 1}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.AlbumYearInvalidMessage),
+		},
+		{
+			name: "Error 400. Release date doesn't match year",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+			},
+			input: &proto.AlbumOptions{AdminID: 1, Album: &proto.CatalogAlbum{ID: 1, Title: "Hate Love", Year: 2019, ArtistID: 1,
+				ReleaseDate: "2018-03-15"}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.AlbumReleaseDateInvalidMessage),
+		},
+		{
+			name: "Error 400. Artist doesn't exist",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+				DoesArtistExistFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.AlbumOptions{AdminID: 1, Album: album},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.ArtistNotFoundMessage),
+		},
+		{
+			name: "Error 404. Album doesn't exist",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:         isAdmin,
+				DoesArtistExistFunc: exists,
+				UpdateAlbumFunc: func(int64, *proto.CatalogAlbum) (string, error) {
+					return "", sql.ErrNoRows
+				},
+			},
+			input:       &proto.AlbumOptions{AdminID: 1, Album: album},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.AlbumNotFoundMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.UpdateAlbum(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestCatalogService_CheckAdmin(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+			},
+		},
+		{
+			name: "Error 403. User is not admin",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.NotAdminMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.CheckAdmin(context.Background(), &proto.CheckAdminOptions{AdminID: 1})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &proto.CheckAdminResponse{}, res)
+			}
+		})
+	}
+}
+
+func TestCatalogService_CreateTrack(t *testing.T) {
+	track := func() *proto.CatalogTrack {
+		return &proto.CatalogTrack{Title: "Ghetto", ArtistID: 1, AlbumID: 2, GenreID: 3, Number: 1, File: "file", Duration: 200}
+	}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.TrackOptions
+		expected    *proto.CreateResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:         isAdmin,
+				DoesArtistExistFunc: exists,
+				DoesAlbumExistFunc:  exists,
+				DoesGenreExistFunc:  exists,
+				CreateTrackFunc: func(int64, *proto.CatalogTrack) (int64, error) {
+					return 4, nil
+				},
+			},
+			input:    &proto.TrackOptions{AdminID: 1, Track: track()},
+			expected: &proto.CreateResponse{ID: 4},
+		},
+		{
+			name: "Error 400. File is required",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+			},
+			input:       &proto.TrackOptions{AdminID: 1, Track: &proto.CatalogTrack{Title: "Ghetto", ArtistID: 1, AlbumID: 2}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.TrackFileRequiredMessage),
+		},
+		{
+			name: "Error 400. Duration is not valid",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+			},
+			input:       &proto.TrackOptions{AdminID: 1, Track: &proto.CatalogTrack{Title: "Ghetto", ArtistID: 1, AlbumID: 2, File: "file"}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.TrackDurationInvalidMessage),
+		},
+		{
+			name: "Error 400. Genre doesn't exist",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:         isAdmin,
+				DoesArtistExistFunc: exists,
+				DoesAlbumExistFunc:  exists,
+				DoesGenreExistFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.TrackOptions{AdminID: 1, Track: track()},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.GenreNotFoundMessage),
+		},
+		{
+			name: "Error 500. Admin check failed",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: func(int64) (bool, error) {
+					return false, errors.New("error")
+				},
+			},
+			input:       &proto.TrackOptions{AdminID: 1, Track: track()},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.CreateTrack(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestCatalogService_DeleteGenre(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.DeleteOptions
+		expected    *proto.DeleteResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:     isAdmin,
+				IsGenreUsedFunc: notUsed,
+				DeleteGenreFunc: func(int64, int64) (string, error) {
+					return testImageFilename, nil
+				},
+			},
+			input:    &proto.DeleteOptions{AdminID: 1, ID: 3},
+			expected: &proto.DeleteResponse{OldFilename: testImageFilename},
+		},
+		{
+			name: "Error 404. Genre doesn't exist",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:     isAdmin,
+				IsGenreUsedFunc: notUsed,
+				DeleteGenreFunc: func(int64, int64) (string, error) {
+					return "", sql.ErrNoRows
+				},
+			},
+			input:       &proto.DeleteOptions{AdminID: 1, ID: 3},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.GenreNotFoundMessage),
+		},
+		{
+			name: "Error 500. Storage error",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:     isAdmin,
+				IsGenreUsedFunc: notUsed,
+				DeleteGenreFunc: func(int64, int64) (string, error) {
+					return "", errors.New("error")
+				},
+			},
+			input:       &proto.DeleteOptions{AdminID: 1, ID: 3},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 400. Genre has tracks",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+				IsGenreUsedFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+			},
+			input:       &proto.DeleteOptions{AdminID: 1, ID: 3},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.GenreHasTracksMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.DeleteGenre(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestCatalogService_SetLyrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		storageMock   *mock.MockStorage
+		input         *proto.SetLyricsOptions
+		expectedPlain string
+		expectedLines []lrc.Line
+		expectedErr   bool
+		err           error
+	}{
+		{
+			name: "Success. Plain text is built from LRC",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:        isAdmin,
+				DoesTrackExistFunc: exists,
+			},
+			input:         &proto.SetLyricsOptions{AdminID: 1, TrackID: 2, Synced: "[00:01.00]First\n[00:02.50]Second"},
+			expectedPlain: "First\nSecond",
+			expectedLines: []lrc.Line{{Time: 1000, Text: "First"}, {Time: 2500, Text: "Second"}},
+		},
+		{
+			name: "Success. Plain text only",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:        isAdmin,
+				DoesTrackExistFunc: exists,
+			},
+			input:         &proto.SetLyricsOptions{AdminID: 1, TrackID: 2, Plain: " First\nSecond "},
+			expectedPlain: "First\nSecond",
+		},
+		{
+			name: "Error 400. Invalid LRC",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:        isAdmin,
+				DoesTrackExistFunc: exists,
+			},
+			input:       &proto.SetLyricsOptions{AdminID: 1, TrackID: 2, Synced: "First"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.LyricsInvalidMessage+": line 1: line has no timestamp"),
+		},
+		{
+			name: "Error 400. Empty lyrics",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc:        isAdmin,
+				DoesTrackExistFunc: exists,
+			},
+			input:       &proto.SetLyricsOptions{AdminID: 1, TrackID: 2, Plain: "  "},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.LyricsRequiredMessage),
+		},
+		{
+			name: "Error 400. Track doesn't exist",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+				DoesTrackExistFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.SetLyricsOptions{AdminID: 1, TrackID: 2, Plain: "First"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.TrackNotFound),
+		},
+		{
+			name: "Error 403. User is not admin",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.SetLyricsOptions{AdminID: 1, TrackID: 2, Plain: "First"},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.NotAdminMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.storageMock.SetLyricsFunc = func(adminID int64, trackID int64, plain string, lines []lrc.Line) error {
+				assert.Equal(t, currentTest.input.TrackID, trackID)
+				assert.Equal(t, currentTest.expectedPlain, plain)
+				assert.Equal(t, currentTest.expectedLines, lines)
+				return nil
+			}
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.SetLyrics(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &proto.LyricsResponse{}, res)
+			}
+		})
+	}
+}
+
+func TestCatalogService_SuspiciousAccounts(t *testing.T) {
+	accounts := []*proto.SuspiciousAccount{{UserID: 7, Rejections: 120}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		expected    *proto.SuspiciousAccountsResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+				SuspiciousAccountsFunc: func(time.Time, int64, int64) ([]*proto.SuspiciousAccount, error) {
+					return accounts, nil
+				},
+			},
+			expected: &proto.SuspiciousAccountsResponse{Accounts: accounts},
+		},
+		{
+			name: "Error 403. User is not admin",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.NotAdminMessage),
+		},
+		{
+			name: "Error 500. Storage returns error",
+			storageMock: &mock.MockStorage{
+				IsAdminFunc: isAdmin,
+				SuspiciousAccountsFunc: func(time.Time, int64, int64) ([]*proto.SuspiciousAccount, error) {
+					return nil, errors.New("error")
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewCatalogService(currentTest.storageMock)
+
+			res, err := service.SuspiciousAccounts(context.Background(), &proto.SuspiciousAccountsOptions{AdminID: 1})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+				call := currentTest.storageMock.SuspiciousAccountsCalls()[0]
+				assert.Equal(t, int64(constants.SuspiciousMinRejections), call.MinRejections)
+				assert.WithinDuration(t, time.Now().Add(-constants.SuspiciousAccountsPeriod), call.Since, time.Minute)
+			}
+		})
+	}
+}
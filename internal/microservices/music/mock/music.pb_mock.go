@@ -76,6 +76,26 @@ func (mr *MockMusicClientMockRecorder) AlbumPage(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlbumPage", reflect.TypeOf((*MockMusicClient)(nil).AlbumPage), varargs...)
 }
 
+// ArtistAlbums mocks base method.
+func (m *MockMusicClient) ArtistAlbums(ctx context.Context, in *proto.ArtistAlbumsOptions, opts ...grpc.CallOption) (*proto.Albums, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArtistAlbums", varargs...)
+	ret0, _ := ret[0].(*proto.Albums)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistAlbums indicates an expected call of ArtistAlbums.
+func (mr *MockMusicClientMockRecorder) ArtistAlbums(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistAlbums", reflect.TypeOf((*MockMusicClient)(nil).ArtistAlbums), varargs...)
+}
+
 // ArtistProfile mocks base method.
 func (m *MockMusicClient) ArtistProfile(ctx context.Context, in *proto.ArtistProfileOptions, opts ...grpc.CallOption) (*proto.Artist, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +116,106 @@ func (mr *MockMusicClientMockRecorder) ArtistProfile(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistProfile", reflect.TypeOf((*MockMusicClient)(nil).ArtistProfile), varargs...)
 }
 
+// ArtistTracks mocks base method.
+func (m *MockMusicClient) ArtistTracks(ctx context.Context, in *proto.ArtistTracksOptions, opts ...grpc.CallOption) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArtistTracks", varargs...)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistTracks indicates an expected call of ArtistTracks.
+func (mr *MockMusicClientMockRecorder) ArtistTracks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistTracks", reflect.TypeOf((*MockMusicClient)(nil).ArtistTracks), varargs...)
+}
+
+// Charts mocks base method.
+func (m *MockMusicClient) Charts(ctx context.Context, in *proto.ChartsOptions, opts ...grpc.CallOption) (*proto.ChartsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Charts", varargs...)
+	ret0, _ := ret[0].(*proto.ChartsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Charts indicates an expected call of Charts.
+func (mr *MockMusicClientMockRecorder) Charts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charts", reflect.TypeOf((*MockMusicClient)(nil).Charts), varargs...)
+}
+
+// ClearRecentSearches mocks base method.
+func (m *MockMusicClient) ClearRecentSearches(ctx context.Context, in *proto.ClearRecentSearchesOptions, opts ...grpc.CallOption) (*proto.ClearRecentSearchesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClearRecentSearches", varargs...)
+	ret0, _ := ret[0].(*proto.ClearRecentSearchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearRecentSearches indicates an expected call of ClearRecentSearches.
+func (mr *MockMusicClientMockRecorder) ClearRecentSearches(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecentSearches", reflect.TypeOf((*MockMusicClient)(nil).ClearRecentSearches), varargs...)
+}
+
+// DeleteRecentSearch mocks base method.
+func (m *MockMusicClient) DeleteRecentSearch(ctx context.Context, in *proto.DeleteRecentSearchOptions, opts ...grpc.CallOption) (*proto.DeleteRecentSearchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRecentSearch", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteRecentSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecentSearch indicates an expected call of DeleteRecentSearch.
+func (mr *MockMusicClientMockRecorder) DeleteRecentSearch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecentSearch", reflect.TypeOf((*MockMusicClient)(nil).DeleteRecentSearch), varargs...)
+}
+
+// DeleteTrackDislike mocks base method.
+func (m *MockMusicClient) DeleteTrackDislike(ctx context.Context, in *proto.DislikeTrackOptions, opts ...grpc.CallOption) (*proto.DislikeTrackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTrackDislike", varargs...)
+	ret0, _ := ret[0].(*proto.DislikeTrackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrackDislike indicates an expected call of DeleteTrackDislike.
+func (mr *MockMusicClientMockRecorder) DeleteTrackDislike(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackDislike", reflect.TypeOf((*MockMusicClient)(nil).DeleteTrackDislike), varargs...)
+}
+
 // DeleteTrackFromFavorites mocks base method.
 func (m *MockMusicClient) DeleteTrackFromFavorites(ctx context.Context, in *proto.DeleteTrackFromFavoritesOptions, opts ...grpc.CallOption) (*proto.DeleteTrackFromFavoritesResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +236,26 @@ func (mr *MockMusicClientMockRecorder) DeleteTrackFromFavorites(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackFromFavorites", reflect.TypeOf((*MockMusicClient)(nil).DeleteTrackFromFavorites), varargs...)
 }
 
+// DislikeTrack mocks base method.
+func (m *MockMusicClient) DislikeTrack(ctx context.Context, in *proto.DislikeTrackOptions, opts ...grpc.CallOption) (*proto.DislikeTrackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DislikeTrack", varargs...)
+	ret0, _ := ret[0].(*proto.DislikeTrackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DislikeTrack indicates an expected call of DislikeTrack.
+func (mr *MockMusicClientMockRecorder) DislikeTrack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DislikeTrack", reflect.TypeOf((*MockMusicClient)(nil).DislikeTrack), varargs...)
+}
+
 // Find mocks base method.
 func (m *MockMusicClient) Find(ctx context.Context, in *proto.FindOptions, opts ...grpc.CallOption) (*proto.FindResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +276,46 @@ func (mr *MockMusicClientMockRecorder) Find(ctx, in interface{}, opts ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockMusicClient)(nil).Find), varargs...)
 }
 
+// FollowArtist mocks base method.
+func (m *MockMusicClient) FollowArtist(ctx context.Context, in *proto.FollowArtistOptions, opts ...grpc.CallOption) (*proto.LibraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FollowArtist", varargs...)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowArtist indicates an expected call of FollowArtist.
+func (mr *MockMusicClientMockRecorder) FollowArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowArtist", reflect.TypeOf((*MockMusicClient)(nil).FollowArtist), varargs...)
+}
+
+// GenrePage mocks base method.
+func (m *MockMusicClient) GenrePage(ctx context.Context, in *proto.GenrePageOptions, opts ...grpc.CallOption) (*proto.GenrePageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenrePage", varargs...)
+	ret0, _ := ret[0].(*proto.GenrePageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenrePage indicates an expected call of GenrePage.
+func (mr *MockMusicClientMockRecorder) GenrePage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenrePage", reflect.TypeOf((*MockMusicClient)(nil).GenrePage), varargs...)
+}
+
 // GetFavoriteTracks mocks base method.
 func (m *MockMusicClient) GetFavoriteTracks(ctx context.Context, in *proto.UserFavoritesOptions, opts ...grpc.CallOption) (*proto.Tracks, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +336,126 @@ func (mr *MockMusicClientMockRecorder) GetFavoriteTracks(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockMusicClient)(nil).GetFavoriteTracks), varargs...)
 }
 
+// GetFollowedArtists mocks base method.
+func (m *MockMusicClient) GetFollowedArtists(ctx context.Context, in *proto.LibraryOptions, opts ...grpc.CallOption) (*proto.Artists, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowedArtists", varargs...)
+	ret0, _ := ret[0].(*proto.Artists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowedArtists indicates an expected call of GetFollowedArtists.
+func (mr *MockMusicClientMockRecorder) GetFollowedArtists(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowedArtists", reflect.TypeOf((*MockMusicClient)(nil).GetFollowedArtists), varargs...)
+}
+
+// GetLyrics mocks base method.
+func (m *MockMusicClient) GetLyrics(ctx context.Context, in *proto.LyricsOptions, opts ...grpc.CallOption) (*proto.Lyrics, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLyrics", varargs...)
+	ret0, _ := ret[0].(*proto.Lyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLyrics indicates an expected call of GetLyrics.
+func (mr *MockMusicClientMockRecorder) GetLyrics(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockMusicClient)(nil).GetLyrics), varargs...)
+}
+
+// GetRecentSearches mocks base method.
+func (m *MockMusicClient) GetRecentSearches(ctx context.Context, in *proto.RecentSearchesOptions, opts ...grpc.CallOption) (*proto.RecentSearches, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecentSearches", varargs...)
+	ret0, _ := ret[0].(*proto.RecentSearches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentSearches indicates an expected call of GetRecentSearches.
+func (mr *MockMusicClientMockRecorder) GetRecentSearches(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentSearches", reflect.TypeOf((*MockMusicClient)(nil).GetRecentSearches), varargs...)
+}
+
+// GetSavedAlbums mocks base method.
+func (m *MockMusicClient) GetSavedAlbums(ctx context.Context, in *proto.LibraryOptions, opts ...grpc.CallOption) (*proto.Albums, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSavedAlbums", varargs...)
+	ret0, _ := ret[0].(*proto.Albums)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedAlbums indicates an expected call of GetSavedAlbums.
+func (mr *MockMusicClientMockRecorder) GetSavedAlbums(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedAlbums", reflect.TypeOf((*MockMusicClient)(nil).GetSavedAlbums), varargs...)
+}
+
+// GetTrack mocks base method.
+func (m *MockMusicClient) GetTrack(ctx context.Context, in *proto.GetTrackOptions, opts ...grpc.CallOption) (*proto.Track, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrack", varargs...)
+	ret0, _ := ret[0].(*proto.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrack indicates an expected call of GetTrack.
+func (mr *MockMusicClientMockRecorder) GetTrack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrack", reflect.TypeOf((*MockMusicClient)(nil).GetTrack), varargs...)
+}
+
+// GetTracks mocks base method.
+func (m *MockMusicClient) GetTracks(ctx context.Context, in *proto.GetTracksOptions, opts ...grpc.CallOption) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTracks", varargs...)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTracks indicates an expected call of GetTracks.
+func (mr *MockMusicClientMockRecorder) GetTracks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracks", reflect.TypeOf((*MockMusicClient)(nil).GetTracks), varargs...)
+}
+
 // IncrementListenCount mocks base method.
 func (m *MockMusicClient) IncrementListenCount(ctx context.Context, in *proto.IncrementListenCountOptions, opts ...grpc.CallOption) (*proto.IncrementListenCountEmpty, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +476,26 @@ func (mr *MockMusicClientMockRecorder) IncrementListenCount(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementListenCount", reflect.TypeOf((*MockMusicClient)(nil).IncrementListenCount), varargs...)
 }
 
+// ListGenres mocks base method.
+func (m *MockMusicClient) ListGenres(ctx context.Context, in *proto.ListGenresOptions, opts ...grpc.CallOption) (*proto.Genres, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGenres", varargs...)
+	ret0, _ := ret[0].(*proto.Genres)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGenres indicates an expected call of ListGenres.
+func (mr *MockMusicClientMockRecorder) ListGenres(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGenres", reflect.TypeOf((*MockMusicClient)(nil).ListGenres), varargs...)
+}
+
 // PlaylistPage mocks base method.
 func (m *MockMusicClient) PlaylistPage(ctx context.Context, in *proto.PlaylistPageOptions, opts ...grpc.CallOption) (*proto.PlaylistPageResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +516,26 @@ func (mr *MockMusicClientMockRecorder) PlaylistPage(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistPage", reflect.TypeOf((*MockMusicClient)(nil).PlaylistPage), varargs...)
 }
 
+// Radio mocks base method.
+func (m *MockMusicClient) Radio(ctx context.Context, in *proto.RadioOptions, opts ...grpc.CallOption) (*proto.RadioResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Radio", varargs...)
+	ret0, _ := ret[0].(*proto.RadioResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Radio indicates an expected call of Radio.
+func (mr *MockMusicClientMockRecorder) Radio(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Radio", reflect.TypeOf((*MockMusicClient)(nil).Radio), varargs...)
+}
+
 // RandomAlbums mocks base method.
 func (m *MockMusicClient) RandomAlbums(ctx context.Context, in *proto.RandomAlbumsOptions, opts ...grpc.CallOption) (*proto.Albums, error) {
 	m.ctrl.T.Helper()
@@ -213,47 +553,287 @@ func (m *MockMusicClient) RandomAlbums(ctx context.Context, in *proto.RandomAlbu
 func (mr *MockMusicClientMockRecorder) RandomAlbums(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomAlbums", reflect.TypeOf((*MockMusicClient)(nil).RandomAlbums), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomAlbums", reflect.TypeOf((*MockMusicClient)(nil).RandomAlbums), varargs...)
+}
+
+// RandomArtists mocks base method.
+func (m *MockMusicClient) RandomArtists(ctx context.Context, in *proto.RandomArtistsOptions, opts ...grpc.CallOption) (*proto.Artists, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RandomArtists", varargs...)
+	ret0, _ := ret[0].(*proto.Artists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RandomArtists indicates an expected call of RandomArtists.
+func (mr *MockMusicClientMockRecorder) RandomArtists(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomArtists", reflect.TypeOf((*MockMusicClient)(nil).RandomArtists), varargs...)
+}
+
+// RandomTracks mocks base method.
+func (m *MockMusicClient) RandomTracks(ctx context.Context, in *proto.RandomTracksOptions, opts ...grpc.CallOption) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RandomTracks", varargs...)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RandomTracks indicates an expected call of RandomTracks.
+func (mr *MockMusicClientMockRecorder) RandomTracks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomTracks", reflect.TypeOf((*MockMusicClient)(nil).RandomTracks), varargs...)
+}
+
+// Recap mocks base method.
+func (m *MockMusicClient) Recap(ctx context.Context, in *proto.RecapOptions, opts ...grpc.CallOption) (*proto.RecapResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Recap", varargs...)
+	ret0, _ := ret[0].(*proto.RecapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recap indicates an expected call of Recap.
+func (mr *MockMusicClientMockRecorder) Recap(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recap", reflect.TypeOf((*MockMusicClient)(nil).Recap), varargs...)
+}
+
+// RecordSearchResult mocks base method.
+func (m *MockMusicClient) RecordSearchResult(ctx context.Context, in *proto.RecordSearchResultOptions, opts ...grpc.CallOption) (*proto.RecordSearchResultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordSearchResult", varargs...)
+	ret0, _ := ret[0].(*proto.RecordSearchResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordSearchResult indicates an expected call of RecordSearchResult.
+func (mr *MockMusicClientMockRecorder) RecordSearchResult(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSearchResult", reflect.TypeOf((*MockMusicClient)(nil).RecordSearchResult), varargs...)
+}
+
+// ReleaseRadar mocks base method.
+func (m *MockMusicClient) ReleaseRadar(ctx context.Context, in *proto.ReleaseRadarOptions, opts ...grpc.CallOption) (*proto.ReleaseRadarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseRadar", varargs...)
+	ret0, _ := ret[0].(*proto.ReleaseRadarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseRadar indicates an expected call of ReleaseRadar.
+func (mr *MockMusicClientMockRecorder) ReleaseRadar(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRadar", reflect.TypeOf((*MockMusicClient)(nil).ReleaseRadar), varargs...)
+}
+
+// SaveAlbum mocks base method.
+func (m *MockMusicClient) SaveAlbum(ctx context.Context, in *proto.SaveAlbumOptions, opts ...grpc.CallOption) (*proto.LibraryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveAlbum", varargs...)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAlbum indicates an expected call of SaveAlbum.
+func (mr *MockMusicClientMockRecorder) SaveAlbum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAlbum", reflect.TypeOf((*MockMusicClient)(nil).SaveAlbum), varargs...)
+}
+
+// SearchAlbums mocks base method.
+func (m *MockMusicClient) SearchAlbums(ctx context.Context, in *proto.FindOptions, opts ...grpc.CallOption) (*proto.Albums, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchAlbums", varargs...)
+	ret0, _ := ret[0].(*proto.Albums)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAlbums indicates an expected call of SearchAlbums.
+func (mr *MockMusicClientMockRecorder) SearchAlbums(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockMusicClient)(nil).SearchAlbums), varargs...)
+}
+
+// SearchArtists mocks base method.
+func (m *MockMusicClient) SearchArtists(ctx context.Context, in *proto.FindOptions, opts ...grpc.CallOption) (*proto.Artists, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchArtists", varargs...)
+	ret0, _ := ret[0].(*proto.Artists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchArtists indicates an expected call of SearchArtists.
+func (mr *MockMusicClientMockRecorder) SearchArtists(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockMusicClient)(nil).SearchArtists), varargs...)
+}
+
+// SearchPlaylists mocks base method.
+func (m *MockMusicClient) SearchPlaylists(ctx context.Context, in *proto.FindOptions, opts ...grpc.CallOption) (*proto.PlaylistsData, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchPlaylists", varargs...)
+	ret0, _ := ret[0].(*proto.PlaylistsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPlaylists indicates an expected call of SearchPlaylists.
+func (mr *MockMusicClientMockRecorder) SearchPlaylists(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPlaylists", reflect.TypeOf((*MockMusicClient)(nil).SearchPlaylists), varargs...)
+}
+
+// SearchTracks mocks base method.
+func (m *MockMusicClient) SearchTracks(ctx context.Context, in *proto.FindOptions, opts ...grpc.CallOption) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchTracks", varargs...)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTracks indicates an expected call of SearchTracks.
+func (mr *MockMusicClientMockRecorder) SearchTracks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockMusicClient)(nil).SearchTracks), varargs...)
+}
+
+// Suggest mocks base method.
+func (m *MockMusicClient) Suggest(ctx context.Context, in *proto.SuggestOptions, opts ...grpc.CallOption) (*proto.Suggestions, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Suggest", varargs...)
+	ret0, _ := ret[0].(*proto.Suggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockMusicClientMockRecorder) Suggest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockMusicClient)(nil).Suggest), varargs...)
+}
+
+// TrackFile mocks base method.
+func (m *MockMusicClient) TrackFile(ctx context.Context, in *proto.TrackFileOptions, opts ...grpc.CallOption) (*proto.TrackFileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TrackFile", varargs...)
+	ret0, _ := ret[0].(*proto.TrackFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrackFile indicates an expected call of TrackFile.
+func (mr *MockMusicClientMockRecorder) TrackFile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackFile", reflect.TypeOf((*MockMusicClient)(nil).TrackFile), varargs...)
 }
 
-// RandomArtists mocks base method.
-func (m *MockMusicClient) RandomArtists(ctx context.Context, in *proto.RandomArtistsOptions, opts ...grpc.CallOption) (*proto.Artists, error) {
+// UnfollowArtist mocks base method.
+func (m *MockMusicClient) UnfollowArtist(ctx context.Context, in *proto.FollowArtistOptions, opts ...grpc.CallOption) (*proto.LibraryResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RandomArtists", varargs...)
-	ret0, _ := ret[0].(*proto.Artists)
+	ret := m.ctrl.Call(m, "UnfollowArtist", varargs...)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RandomArtists indicates an expected call of RandomArtists.
-func (mr *MockMusicClientMockRecorder) RandomArtists(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// UnfollowArtist indicates an expected call of UnfollowArtist.
+func (mr *MockMusicClientMockRecorder) UnfollowArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomArtists", reflect.TypeOf((*MockMusicClient)(nil).RandomArtists), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowArtist", reflect.TypeOf((*MockMusicClient)(nil).UnfollowArtist), varargs...)
 }
 
-// RandomTracks mocks base method.
-func (m *MockMusicClient) RandomTracks(ctx context.Context, in *proto.RandomTracksOptions, opts ...grpc.CallOption) (*proto.Tracks, error) {
+// UnsaveAlbum mocks base method.
+func (m *MockMusicClient) UnsaveAlbum(ctx context.Context, in *proto.SaveAlbumOptions, opts ...grpc.CallOption) (*proto.LibraryResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RandomTracks", varargs...)
-	ret0, _ := ret[0].(*proto.Tracks)
+	ret := m.ctrl.Call(m, "UnsaveAlbum", varargs...)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RandomTracks indicates an expected call of RandomTracks.
-func (mr *MockMusicClientMockRecorder) RandomTracks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// UnsaveAlbum indicates an expected call of UnsaveAlbum.
+func (mr *MockMusicClientMockRecorder) UnsaveAlbum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomTracks", reflect.TypeOf((*MockMusicClient)(nil).RandomTracks), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsaveAlbum", reflect.TypeOf((*MockMusicClient)(nil).UnsaveAlbum), varargs...)
 }
 
 // UserPlaylists mocks base method.
@@ -329,6 +909,21 @@ func (mr *MockMusicServerMockRecorder) AlbumPage(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlbumPage", reflect.TypeOf((*MockMusicServer)(nil).AlbumPage), arg0, arg1)
 }
 
+// ArtistAlbums mocks base method.
+func (m *MockMusicServer) ArtistAlbums(arg0 context.Context, arg1 *proto.ArtistAlbumsOptions) (*proto.Albums, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistAlbums", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Albums)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistAlbums indicates an expected call of ArtistAlbums.
+func (mr *MockMusicServerMockRecorder) ArtistAlbums(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistAlbums", reflect.TypeOf((*MockMusicServer)(nil).ArtistAlbums), arg0, arg1)
+}
+
 // ArtistProfile mocks base method.
 func (m *MockMusicServer) ArtistProfile(arg0 context.Context, arg1 *proto.ArtistProfileOptions) (*proto.Artist, error) {
 	m.ctrl.T.Helper()
@@ -344,6 +939,81 @@ func (mr *MockMusicServerMockRecorder) ArtistProfile(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistProfile", reflect.TypeOf((*MockMusicServer)(nil).ArtistProfile), arg0, arg1)
 }
 
+// ArtistTracks mocks base method.
+func (m *MockMusicServer) ArtistTracks(arg0 context.Context, arg1 *proto.ArtistTracksOptions) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistTracks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArtistTracks indicates an expected call of ArtistTracks.
+func (mr *MockMusicServerMockRecorder) ArtistTracks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistTracks", reflect.TypeOf((*MockMusicServer)(nil).ArtistTracks), arg0, arg1)
+}
+
+// Charts mocks base method.
+func (m *MockMusicServer) Charts(arg0 context.Context, arg1 *proto.ChartsOptions) (*proto.ChartsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Charts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ChartsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Charts indicates an expected call of Charts.
+func (mr *MockMusicServerMockRecorder) Charts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charts", reflect.TypeOf((*MockMusicServer)(nil).Charts), arg0, arg1)
+}
+
+// ClearRecentSearches mocks base method.
+func (m *MockMusicServer) ClearRecentSearches(arg0 context.Context, arg1 *proto.ClearRecentSearchesOptions) (*proto.ClearRecentSearchesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRecentSearches", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ClearRecentSearchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearRecentSearches indicates an expected call of ClearRecentSearches.
+func (mr *MockMusicServerMockRecorder) ClearRecentSearches(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecentSearches", reflect.TypeOf((*MockMusicServer)(nil).ClearRecentSearches), arg0, arg1)
+}
+
+// DeleteRecentSearch mocks base method.
+func (m *MockMusicServer) DeleteRecentSearch(arg0 context.Context, arg1 *proto.DeleteRecentSearchOptions) (*proto.DeleteRecentSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecentSearch", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteRecentSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecentSearch indicates an expected call of DeleteRecentSearch.
+func (mr *MockMusicServerMockRecorder) DeleteRecentSearch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecentSearch", reflect.TypeOf((*MockMusicServer)(nil).DeleteRecentSearch), arg0, arg1)
+}
+
+// DeleteTrackDislike mocks base method.
+func (m *MockMusicServer) DeleteTrackDislike(arg0 context.Context, arg1 *proto.DislikeTrackOptions) (*proto.DislikeTrackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrackDislike", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DislikeTrackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrackDislike indicates an expected call of DeleteTrackDislike.
+func (mr *MockMusicServerMockRecorder) DeleteTrackDislike(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackDislike", reflect.TypeOf((*MockMusicServer)(nil).DeleteTrackDislike), arg0, arg1)
+}
+
 // DeleteTrackFromFavorites mocks base method.
 func (m *MockMusicServer) DeleteTrackFromFavorites(arg0 context.Context, arg1 *proto.DeleteTrackFromFavoritesOptions) (*proto.DeleteTrackFromFavoritesResponse, error) {
 	m.ctrl.T.Helper()
@@ -359,6 +1029,21 @@ func (mr *MockMusicServerMockRecorder) DeleteTrackFromFavorites(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackFromFavorites", reflect.TypeOf((*MockMusicServer)(nil).DeleteTrackFromFavorites), arg0, arg1)
 }
 
+// DislikeTrack mocks base method.
+func (m *MockMusicServer) DislikeTrack(arg0 context.Context, arg1 *proto.DislikeTrackOptions) (*proto.DislikeTrackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DislikeTrack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DislikeTrackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DislikeTrack indicates an expected call of DislikeTrack.
+func (mr *MockMusicServerMockRecorder) DislikeTrack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DislikeTrack", reflect.TypeOf((*MockMusicServer)(nil).DislikeTrack), arg0, arg1)
+}
+
 // Find mocks base method.
 func (m *MockMusicServer) Find(arg0 context.Context, arg1 *proto.FindOptions) (*proto.FindResponse, error) {
 	m.ctrl.T.Helper()
@@ -374,6 +1059,36 @@ func (mr *MockMusicServerMockRecorder) Find(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockMusicServer)(nil).Find), arg0, arg1)
 }
 
+// FollowArtist mocks base method.
+func (m *MockMusicServer) FollowArtist(arg0 context.Context, arg1 *proto.FollowArtistOptions) (*proto.LibraryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowArtist", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowArtist indicates an expected call of FollowArtist.
+func (mr *MockMusicServerMockRecorder) FollowArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowArtist", reflect.TypeOf((*MockMusicServer)(nil).FollowArtist), arg0, arg1)
+}
+
+// GenrePage mocks base method.
+func (m *MockMusicServer) GenrePage(arg0 context.Context, arg1 *proto.GenrePageOptions) (*proto.GenrePageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenrePage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GenrePageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenrePage indicates an expected call of GenrePage.
+func (mr *MockMusicServerMockRecorder) GenrePage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenrePage", reflect.TypeOf((*MockMusicServer)(nil).GenrePage), arg0, arg1)
+}
+
 // GetFavoriteTracks mocks base method.
 func (m *MockMusicServer) GetFavoriteTracks(arg0 context.Context, arg1 *proto.UserFavoritesOptions) (*proto.Tracks, error) {
 	m.ctrl.T.Helper()
@@ -389,6 +1104,96 @@ func (mr *MockMusicServerMockRecorder) GetFavoriteTracks(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockMusicServer)(nil).GetFavoriteTracks), arg0, arg1)
 }
 
+// GetFollowedArtists mocks base method.
+func (m *MockMusicServer) GetFollowedArtists(arg0 context.Context, arg1 *proto.LibraryOptions) (*proto.Artists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowedArtists", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Artists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowedArtists indicates an expected call of GetFollowedArtists.
+func (mr *MockMusicServerMockRecorder) GetFollowedArtists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowedArtists", reflect.TypeOf((*MockMusicServer)(nil).GetFollowedArtists), arg0, arg1)
+}
+
+// GetLyrics mocks base method.
+func (m *MockMusicServer) GetLyrics(arg0 context.Context, arg1 *proto.LyricsOptions) (*proto.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLyrics", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Lyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLyrics indicates an expected call of GetLyrics.
+func (mr *MockMusicServerMockRecorder) GetLyrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockMusicServer)(nil).GetLyrics), arg0, arg1)
+}
+
+// GetRecentSearches mocks base method.
+func (m *MockMusicServer) GetRecentSearches(arg0 context.Context, arg1 *proto.RecentSearchesOptions) (*proto.RecentSearches, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentSearches", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RecentSearches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentSearches indicates an expected call of GetRecentSearches.
+func (mr *MockMusicServerMockRecorder) GetRecentSearches(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentSearches", reflect.TypeOf((*MockMusicServer)(nil).GetRecentSearches), arg0, arg1)
+}
+
+// GetSavedAlbums mocks base method.
+func (m *MockMusicServer) GetSavedAlbums(arg0 context.Context, arg1 *proto.LibraryOptions) (*proto.Albums, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedAlbums", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Albums)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedAlbums indicates an expected call of GetSavedAlbums.
+func (mr *MockMusicServerMockRecorder) GetSavedAlbums(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedAlbums", reflect.TypeOf((*MockMusicServer)(nil).GetSavedAlbums), arg0, arg1)
+}
+
+// GetTrack mocks base method.
+func (m *MockMusicServer) GetTrack(arg0 context.Context, arg1 *proto.GetTrackOptions) (*proto.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrack", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrack indicates an expected call of GetTrack.
+func (mr *MockMusicServerMockRecorder) GetTrack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrack", reflect.TypeOf((*MockMusicServer)(nil).GetTrack), arg0, arg1)
+}
+
+// GetTracks mocks base method.
+func (m *MockMusicServer) GetTracks(arg0 context.Context, arg1 *proto.GetTracksOptions) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTracks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTracks indicates an expected call of GetTracks.
+func (mr *MockMusicServerMockRecorder) GetTracks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracks", reflect.TypeOf((*MockMusicServer)(nil).GetTracks), arg0, arg1)
+}
+
 // IncrementListenCount mocks base method.
 func (m *MockMusicServer) IncrementListenCount(arg0 context.Context, arg1 *proto.IncrementListenCountOptions) (*proto.IncrementListenCountEmpty, error) {
 	m.ctrl.T.Helper()
@@ -404,6 +1209,21 @@ func (mr *MockMusicServerMockRecorder) IncrementListenCount(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementListenCount", reflect.TypeOf((*MockMusicServer)(nil).IncrementListenCount), arg0, arg1)
 }
 
+// ListGenres mocks base method.
+func (m *MockMusicServer) ListGenres(arg0 context.Context, arg1 *proto.ListGenresOptions) (*proto.Genres, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGenres", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Genres)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGenres indicates an expected call of ListGenres.
+func (mr *MockMusicServerMockRecorder) ListGenres(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGenres", reflect.TypeOf((*MockMusicServer)(nil).ListGenres), arg0, arg1)
+}
+
 // PlaylistPage mocks base method.
 func (m *MockMusicServer) PlaylistPage(arg0 context.Context, arg1 *proto.PlaylistPageOptions) (*proto.PlaylistPageResponse, error) {
 	m.ctrl.T.Helper()
@@ -419,6 +1239,21 @@ func (mr *MockMusicServerMockRecorder) PlaylistPage(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistPage", reflect.TypeOf((*MockMusicServer)(nil).PlaylistPage), arg0, arg1)
 }
 
+// Radio mocks base method.
+func (m *MockMusicServer) Radio(arg0 context.Context, arg1 *proto.RadioOptions) (*proto.RadioResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Radio", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RadioResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Radio indicates an expected call of Radio.
+func (mr *MockMusicServerMockRecorder) Radio(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Radio", reflect.TypeOf((*MockMusicServer)(nil).Radio), arg0, arg1)
+}
+
 // RandomAlbums mocks base method.
 func (m *MockMusicServer) RandomAlbums(arg0 context.Context, arg1 *proto.RandomAlbumsOptions) (*proto.Albums, error) {
 	m.ctrl.T.Helper()
@@ -464,6 +1299,186 @@ func (mr *MockMusicServerMockRecorder) RandomTracks(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomTracks", reflect.TypeOf((*MockMusicServer)(nil).RandomTracks), arg0, arg1)
 }
 
+// Recap mocks base method.
+func (m *MockMusicServer) Recap(arg0 context.Context, arg1 *proto.RecapOptions) (*proto.RecapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recap", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RecapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recap indicates an expected call of Recap.
+func (mr *MockMusicServerMockRecorder) Recap(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recap", reflect.TypeOf((*MockMusicServer)(nil).Recap), arg0, arg1)
+}
+
+// RecordSearchResult mocks base method.
+func (m *MockMusicServer) RecordSearchResult(arg0 context.Context, arg1 *proto.RecordSearchResultOptions) (*proto.RecordSearchResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSearchResult", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RecordSearchResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordSearchResult indicates an expected call of RecordSearchResult.
+func (mr *MockMusicServerMockRecorder) RecordSearchResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSearchResult", reflect.TypeOf((*MockMusicServer)(nil).RecordSearchResult), arg0, arg1)
+}
+
+// ReleaseRadar mocks base method.
+func (m *MockMusicServer) ReleaseRadar(arg0 context.Context, arg1 *proto.ReleaseRadarOptions) (*proto.ReleaseRadarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRadar", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ReleaseRadarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseRadar indicates an expected call of ReleaseRadar.
+func (mr *MockMusicServerMockRecorder) ReleaseRadar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRadar", reflect.TypeOf((*MockMusicServer)(nil).ReleaseRadar), arg0, arg1)
+}
+
+// SaveAlbum mocks base method.
+func (m *MockMusicServer) SaveAlbum(arg0 context.Context, arg1 *proto.SaveAlbumOptions) (*proto.LibraryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAlbum indicates an expected call of SaveAlbum.
+func (mr *MockMusicServerMockRecorder) SaveAlbum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAlbum", reflect.TypeOf((*MockMusicServer)(nil).SaveAlbum), arg0, arg1)
+}
+
+// SearchAlbums mocks base method.
+func (m *MockMusicServer) SearchAlbums(arg0 context.Context, arg1 *proto.FindOptions) (*proto.Albums, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAlbums", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Albums)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAlbums indicates an expected call of SearchAlbums.
+func (mr *MockMusicServerMockRecorder) SearchAlbums(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockMusicServer)(nil).SearchAlbums), arg0, arg1)
+}
+
+// SearchArtists mocks base method.
+func (m *MockMusicServer) SearchArtists(arg0 context.Context, arg1 *proto.FindOptions) (*proto.Artists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchArtists", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Artists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchArtists indicates an expected call of SearchArtists.
+func (mr *MockMusicServerMockRecorder) SearchArtists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockMusicServer)(nil).SearchArtists), arg0, arg1)
+}
+
+// SearchPlaylists mocks base method.
+func (m *MockMusicServer) SearchPlaylists(arg0 context.Context, arg1 *proto.FindOptions) (*proto.PlaylistsData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPlaylists", arg0, arg1)
+	ret0, _ := ret[0].(*proto.PlaylistsData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPlaylists indicates an expected call of SearchPlaylists.
+func (mr *MockMusicServerMockRecorder) SearchPlaylists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPlaylists", reflect.TypeOf((*MockMusicServer)(nil).SearchPlaylists), arg0, arg1)
+}
+
+// SearchTracks mocks base method.
+func (m *MockMusicServer) SearchTracks(arg0 context.Context, arg1 *proto.FindOptions) (*proto.Tracks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTracks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Tracks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTracks indicates an expected call of SearchTracks.
+func (mr *MockMusicServerMockRecorder) SearchTracks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockMusicServer)(nil).SearchTracks), arg0, arg1)
+}
+
+// Suggest mocks base method.
+func (m *MockMusicServer) Suggest(arg0 context.Context, arg1 *proto.SuggestOptions) (*proto.Suggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Suggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockMusicServerMockRecorder) Suggest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockMusicServer)(nil).Suggest), arg0, arg1)
+}
+
+// TrackFile mocks base method.
+func (m *MockMusicServer) TrackFile(arg0 context.Context, arg1 *proto.TrackFileOptions) (*proto.TrackFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackFile", arg0, arg1)
+	ret0, _ := ret[0].(*proto.TrackFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrackFile indicates an expected call of TrackFile.
+func (mr *MockMusicServerMockRecorder) TrackFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackFile", reflect.TypeOf((*MockMusicServer)(nil).TrackFile), arg0, arg1)
+}
+
+// UnfollowArtist mocks base method.
+func (m *MockMusicServer) UnfollowArtist(arg0 context.Context, arg1 *proto.FollowArtistOptions) (*proto.LibraryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowArtist", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowArtist indicates an expected call of UnfollowArtist.
+func (mr *MockMusicServerMockRecorder) UnfollowArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowArtist", reflect.TypeOf((*MockMusicServer)(nil).UnfollowArtist), arg0, arg1)
+}
+
+// UnsaveAlbum mocks base method.
+func (m *MockMusicServer) UnsaveAlbum(arg0 context.Context, arg1 *proto.SaveAlbumOptions) (*proto.LibraryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsaveAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LibraryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsaveAlbum indicates an expected call of UnsaveAlbum.
+func (mr *MockMusicServerMockRecorder) UnsaveAlbum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsaveAlbum", reflect.TypeOf((*MockMusicServer)(nil).UnsaveAlbum), arg0, arg1)
+}
+
 // UserPlaylists mocks base method.
 func (m *MockMusicServer) UserPlaylists(arg0 context.Context, arg1 *proto.UserPlaylistsOptions) (*proto.PlaylistsData, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ import (
 	"2021_2_LostPointer/internal/microservices/music"
 	"2021_2_LostPointer/internal/microservices/music/proto"
 	"sync"
+	"time"
 )
 
 // Ensure, that MockStorage does implement music.Storage.
@@ -22,70 +23,244 @@ var _ music.Storage = &MockStorage{}
 // 			AddTrackToFavoriteFunc: func(userID int64, trackID int64) error {
 // 				panic("mock out the AddTrackToFavorite method")
 // 			},
-// 			AlbumDataFunc: func(n int64) (*proto.AlbumPageResponse, error) {
+// 			AlbumDataFunc: func(n1 int64, n2 int64) (*proto.AlbumPageResponse, error) {
 // 				panic("mock out the AlbumData method")
 // 			},
-// 			AlbumTracksFunc: func(n1 int64, n2 int64, b bool) ([]*proto.AlbumTrack, error) {
+// 			AlbumTracksFunc: func(n1 int64, n2 int64, b bool, pageRequest *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error) {
 // 				panic("mock out the AlbumTracks method")
 // 			},
 // 			ArtistAlbumsFunc: func(n1 int64, n2 int64) ([]*proto.Album, error) {
 // 				panic("mock out the ArtistAlbums method")
 // 			},
-// 			ArtistInfoFunc: func(n int64) (*proto.Artist, error) {
+// 			ArtistAlbumsPageFunc: func(artistID int64, sortBy string, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+// 				panic("mock out the ArtistAlbumsPage method")
+// 			},
+// 			ArtistInfoFunc: func(n1 int64, n2 int64) (*proto.Artist, error) {
 // 				panic("mock out the ArtistInfo method")
 // 			},
 // 			ArtistTracksFunc: func(n1 int64, n2 int64, b bool, n3 int64) ([]*proto.Track, error) {
 // 				panic("mock out the ArtistTracks method")
 // 			},
+// 			ArtistTracksPageFunc: func(artistID int64, userID int64, isAuthorized bool, sortBy string, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+// 				panic("mock out the ArtistTracksPage method")
+// 			},
+// 			CacheSuggestionsFunc: func(s string, suggestions []*proto.Suggestion, duration time.Duration) error {
+// 				panic("mock out the CacheSuggestions method")
+// 			},
+// 			CachedSuggestionsFunc: func(s string) ([]*proto.Suggestion, bool, error) {
+// 				panic("mock out the CachedSuggestions method")
+// 			},
+// 			ChartAlbumsFunc: func(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartAlbum, error) {
+// 				panic("mock out the ChartAlbums method")
+// 			},
+// 			ChartArtistsFunc: func(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartArtist, error) {
+// 				panic("mock out the ChartArtists method")
+// 			},
+// 			ChartPeriodStartFunc: func(period string) (time.Time, error) {
+// 				panic("mock out the ChartPeriodStart method")
+// 			},
+// 			ChartTracksFunc: func(period string, periodStart time.Time, genreID int64, amount int64, userID int64, isAuthorized bool) ([]*proto.ChartTrack, error) {
+// 				panic("mock out the ChartTracks method")
+// 			},
+// 			ClearRecentSearchesFunc: func(n int64) error {
+// 				panic("mock out the ClearRecentSearches method")
+// 			},
+// 			DeleteRecentSearchFunc: func(n1 int64, n2 int64) (bool, error) {
+// 				panic("mock out the DeleteRecentSearch method")
+// 			},
+// 			DeleteTrackDislikeFunc: func(userID int64, trackID int64) error {
+// 				panic("mock out the DeleteTrackDislike method")
+// 			},
 // 			DeleteTrackFromFavoritesFunc: func(userID int64, trackID int64) error {
 // 				panic("mock out the DeleteTrackFromFavorites method")
 // 			},
+// 			DislikeTrackFunc: func(userID int64, trackID int64) error {
+// 				panic("mock out the DislikeTrack method")
+// 			},
 // 			DoesPlaylistExistFunc: func(n int64) (bool, error) {
 // 				panic("mock out the DoesPlaylistExist method")
 // 			},
-// 			FindAlbumsFunc: func(s string) ([]*proto.Album, error) {
-// 				panic("mock out the FindAlbums method")
+// 			FollowArtistFunc: func(userID int64, artistID int64) error {
+// 				panic("mock out the FollowArtist method")
 // 			},
-// 			FindArtistsFunc: func(s string) ([]*proto.Artist, error) {
-// 				panic("mock out the FindArtists method")
+// 			FollowedArtistIDsFunc: func(userID int64, artistIDs []int64) ([]int64, error) {
+// 				panic("mock out the FollowedArtistIDs method")
 // 			},
-// 			FindTracksByFullWordFunc: func(s string, n int64, b bool) ([]*proto.Track, error) {
-// 				panic("mock out the FindTracksByFullWord method")
+// 			FollowedArtistsFunc: func(userID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+// 				panic("mock out the FollowedArtists method")
 // 			},
-// 			FindTracksByPartialFunc: func(s string, n int64, b bool) ([]*proto.Track, error) {
-// 				panic("mock out the FindTracksByPartial method")
+// 			GenreAlbumsFunc: func(genreID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+// 				panic("mock out the GenreAlbums method")
 // 			},
-// 			GetFavoritesFunc: func(userID int64) ([]*proto.Track, error) {
+// 			GenreArtistsFunc: func(genreID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+// 				panic("mock out the GenreArtists method")
+// 			},
+// 			GenreInfoFunc: func(genreID int64) (*proto.Genre, error) {
+// 				panic("mock out the GenreInfo method")
+// 			},
+// 			GenreTracksFunc: func(genreID int64, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+// 				panic("mock out the GenreTracks method")
+// 			},
+// 			GetFavoritesFunc: func(userID int64, sortBy string, filter *proto.FavoritesFilter, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
 // 				panic("mock out the GetFavorites method")
 // 			},
-// 			IncrementListenCountFunc: func(n int64) error {
+// 			IncrementListenCountFunc: func(n1 int64, n2 int64) error {
 // 				panic("mock out the IncrementListenCount method")
 // 			},
+// 			IsAlbumSavedFunc: func(userID int64, albumID int64) (bool, error) {
+// 				panic("mock out the IsAlbumSaved method")
+// 			},
+// 			IsArtistFollowedFunc: func(userID int64, artistID int64) (bool, error) {
+// 				panic("mock out the IsArtistFollowed method")
+// 			},
 // 			IsPlaylistOwnerFunc: func(n1 int64, n2 int64) (bool, error) {
 // 				panic("mock out the IsPlaylistOwner method")
 // 			},
 // 			IsPlaylistPublicFunc: func(n int64) (bool, error) {
 // 				panic("mock out the IsPlaylistPublic method")
 // 			},
+// 			IsReleaseRadarPlaylistFunc: func(playlistID int64, userID int64) (bool, error) {
+// 				panic("mock out the IsReleaseRadarPlaylist method")
+// 			},
+// 			IsTrackDislikedFunc: func(userID int64, trackID int64) (bool, error) {
+// 				panic("mock out the IsTrackDisliked method")
+// 			},
 // 			IsTrackInFavoritesFunc: func(userID int64, trackID int64) (bool, error) {
 // 				panic("mock out the IsTrackInFavorites method")
 // 			},
-// 			PlaylistInfoFunc: func(n int64) (*proto.PlaylistData, error) {
+// 			ListGenresFunc: func() ([]*proto.Genre, error) {
+// 				panic("mock out the ListGenres method")
+// 			},
+// 			LyricsFunc: func(n int64) (*proto.Lyrics, error) {
+// 				panic("mock out the Lyrics method")
+// 			},
+// 			PlaylistInfoFunc: func(n1 int64, n2 int64) (*proto.PlaylistData, error) {
 // 				panic("mock out the PlaylistInfo method")
 // 			},
-// 			PlaylistTracksFunc: func(n1 int64, n2 int64) ([]*proto.Track, error) {
+// 			PlaylistTracksFunc: func(n1 int64, n2 int64, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
 // 				panic("mock out the PlaylistTracks method")
 // 			},
-// 			RandomAlbumsFunc: func(n int64) (*proto.Albums, error) {
+// 			RadioSeedFunc: func(seedType string, seedID int64, userID int64) (*proto.RadioSession, error) {
+// 				panic("mock out the RadioSeed method")
+// 			},
+// 			RadioSessionFunc: func(key string) (*proto.RadioSession, bool, error) {
+// 				panic("mock out the RadioSession method")
+// 			},
+// 			RadioTracksFunc: func(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64, candidates []int64, amount int64) ([]*proto.Track, error) {
+// 				panic("mock out the RadioTracks method")
+// 			},
+// 			RandomAlbumsFunc: func(albumIDs []int64) (*proto.Albums, error) {
 // 				panic("mock out the RandomAlbums method")
 // 			},
-// 			RandomArtistsFunc: func(n int64) (*proto.Artists, error) {
+// 			RandomArtistsFunc: func(artistIDs []int64) (*proto.Artists, error) {
 // 				panic("mock out the RandomArtists method")
 // 			},
-// 			RandomTracksFunc: func(n1 int64, n2 int64, b bool) (*proto.Tracks, error) {
+// 			RandomPoolGenerationFunc: func(entity string) (int64, error) {
+// 				panic("mock out the RandomPoolGeneration method")
+// 			},
+// 			RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+// 				panic("mock out the RandomPoolIDs method")
+// 			},
+// 			RandomPoolSizeFunc: func(entity string, generation int64) (int64, error) {
+// 				panic("mock out the RandomPoolSize method")
+// 			},
+// 			RandomTracksFunc: func(trackIDs []int64, userID int64, isAuthorized bool) (*proto.Tracks, error) {
 // 				panic("mock out the RandomTracks method")
 // 			},
-// 			UserPlaylistsFunc: func(n int64) ([]*proto.PlaylistData, error) {
+// 			RebuildChartFunc: func(period string, start time.Time, end time.Time, previousStart time.Time) error {
+// 				panic("mock out the RebuildChart method")
+// 			},
+// 			RebuildRandomPoolFunc: func(entity string) (int64, error) {
+// 				panic("mock out the RebuildRandomPool method")
+// 			},
+// 			RebuildRecapsFunc: func(year int64, start time.Time, end time.Time) error {
+// 				panic("mock out the RebuildRecaps method")
+// 			},
+// 			RecapFunc: func(userID int64, year int64) (*proto.RecapResponse, error) {
+// 				panic("mock out the Recap method")
+// 			},
+// 			RecapBuiltAtFunc: func(year int64) (time.Time, error) {
+// 				panic("mock out the RecapBuiltAt method")
+// 			},
+// 			RecapTopAlbumsFunc: func(userID int64, year int64, amount int64) ([]*proto.RecapAlbum, error) {
+// 				panic("mock out the RecapTopAlbums method")
+// 			},
+// 			RecapTopArtistsFunc: func(userID int64, year int64, amount int64) ([]*proto.RecapArtist, error) {
+// 				panic("mock out the RecapTopArtists method")
+// 			},
+// 			RecapTopGenresFunc: func(userID int64, year int64, amount int64) ([]*proto.RecapGenre, error) {
+// 				panic("mock out the RecapTopGenres method")
+// 			},
+// 			RecapTopTracksFunc: func(userID int64, year int64, amount int64) ([]*proto.RecapTrack, error) {
+// 				panic("mock out the RecapTopTracks method")
+// 			},
+// 			RecentSearchesFunc: func(n1 int64, n2 int64) ([]*proto.RecentSearch, error) {
+// 				panic("mock out the RecentSearches method")
+// 			},
+// 			RecordListenRejectionFunc: func(userID int64, ip string, trackID int64, reason string) error {
+// 				panic("mock out the RecordListenRejection method")
+// 			},
+// 			RecordSearchFunc: func(n1 int64, s1 string, s2 string, n2 int64) error {
+// 				panic("mock out the RecordSearch method")
+// 			},
+// 			ReleaseRadarFunc: func(userID int64, now time.Time, amount int64) ([]*proto.Album, error) {
+// 				panic("mock out the ReleaseRadar method")
+// 			},
+// 			ReleaseRadarListenersFunc: func(now time.Time, weekStart time.Time) ([]int64, error) {
+// 				panic("mock out the ReleaseRadarListeners method")
+// 			},
+// 			ReleaseRadarPlaylistFunc: func(userID int64) (int64, error) {
+// 				panic("mock out the ReleaseRadarPlaylist method")
+// 			},
+// 			ReleaseRadarTracksFunc: func(userID int64, now time.Time, amount int64) ([]int64, error) {
+// 				panic("mock out the ReleaseRadarTracks method")
+// 			},
+// 			ReserveListenFunc: func(userID int64, ip string, trackID int64, now time.Time) (string, error) {
+// 				panic("mock out the ReserveListen method")
+// 			},
+// 			SaveAlbumFunc: func(userID int64, albumID int64) error {
+// 				panic("mock out the SaveAlbum method")
+// 			},
+// 			SaveRadioSessionFunc: func(key string, session *proto.RadioSession, lifetime time.Duration) error {
+// 				panic("mock out the SaveRadioSession method")
+// 			},
+// 			SaveReleaseRadarPlaylistFunc: func(userID int64, weekStart time.Time, trackIDs []int64) error {
+// 				panic("mock out the SaveReleaseRadarPlaylist method")
+// 			},
+// 			SavedAlbumIDsFunc: func(userID int64, albumIDs []int64) ([]int64, error) {
+// 				panic("mock out the SavedAlbumIDs method")
+// 			},
+// 			SavedAlbumsFunc: func(userID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+// 				panic("mock out the SavedAlbums method")
+// 			},
+// 			SearchAlbumsFunc: func(s string, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+// 				panic("mock out the SearchAlbums method")
+// 			},
+// 			SearchArtistsFunc: func(s string, pageRequest *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+// 				panic("mock out the SearchArtists method")
+// 			},
+// 			SearchPlaylistsFunc: func(s string, n int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+// 				panic("mock out the SearchPlaylists method")
+// 			},
+// 			SearchTracksFunc: func(s string, searchFilter *proto.SearchFilter, n int64, b bool, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+// 				panic("mock out the SearchTracks method")
+// 			},
+// 			SuggestFunc: func(s string, n1 int64, n2 int64) ([]*proto.Suggestion, error) {
+// 				panic("mock out the Suggest method")
+// 			},
+// 			TrackFileFunc: func(n int64) (*proto.TrackFileResponse, error) {
+// 				panic("mock out the TrackFile method")
+// 			},
+// 			TracksByIDsFunc: func(int64s []int64, n int64, b bool) ([]*proto.Track, error) {
+// 				panic("mock out the TracksByIDs method")
+// 			},
+// 			UnfollowArtistFunc: func(userID int64, artistID int64) error {
+// 				panic("mock out the UnfollowArtist method")
+// 			},
+// 			UnsaveAlbumFunc: func(userID int64, albumID int64) error {
+// 				panic("mock out the UnsaveAlbum method")
+// 			},
+// 			UserPlaylistsFunc: func(n int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
 // 				panic("mock out the UserPlaylists method")
 // 			},
 // 		}
@@ -99,43 +274,94 @@ type MockStorage struct {
 	AddTrackToFavoriteFunc func(userID int64, trackID int64) error
 
 	// AlbumDataFunc mocks the AlbumData method.
-	AlbumDataFunc func(n int64) (*proto.AlbumPageResponse, error)
+	AlbumDataFunc func(n1 int64, n2 int64) (*proto.AlbumPageResponse, error)
 
 	// AlbumTracksFunc mocks the AlbumTracks method.
-	AlbumTracksFunc func(n1 int64, n2 int64, b bool) ([]*proto.AlbumTrack, error)
+	AlbumTracksFunc func(n1 int64, n2 int64, b bool, pageRequest *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error)
 
 	// ArtistAlbumsFunc mocks the ArtistAlbums method.
 	ArtistAlbumsFunc func(n1 int64, n2 int64) ([]*proto.Album, error)
 
+	// ArtistAlbumsPageFunc mocks the ArtistAlbumsPage method.
+	ArtistAlbumsPageFunc func(artistID int64, sortBy string, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+
 	// ArtistInfoFunc mocks the ArtistInfo method.
-	ArtistInfoFunc func(n int64) (*proto.Artist, error)
+	ArtistInfoFunc func(n1 int64, n2 int64) (*proto.Artist, error)
 
 	// ArtistTracksFunc mocks the ArtistTracks method.
 	ArtistTracksFunc func(n1 int64, n2 int64, b bool, n3 int64) ([]*proto.Track, error)
 
+	// ArtistTracksPageFunc mocks the ArtistTracksPage method.
+	ArtistTracksPageFunc func(artistID int64, userID int64, isAuthorized bool, sortBy string, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+
+	// CacheSuggestionsFunc mocks the CacheSuggestions method.
+	CacheSuggestionsFunc func(s string, suggestions []*proto.Suggestion, duration time.Duration) error
+
+	// CachedSuggestionsFunc mocks the CachedSuggestions method.
+	CachedSuggestionsFunc func(s string) ([]*proto.Suggestion, bool, error)
+
+	// ChartAlbumsFunc mocks the ChartAlbums method.
+	ChartAlbumsFunc func(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartAlbum, error)
+
+	// ChartArtistsFunc mocks the ChartArtists method.
+	ChartArtistsFunc func(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartArtist, error)
+
+	// ChartPeriodStartFunc mocks the ChartPeriodStart method.
+	ChartPeriodStartFunc func(period string) (time.Time, error)
+
+	// ChartTracksFunc mocks the ChartTracks method.
+	ChartTracksFunc func(period string, periodStart time.Time, genreID int64, amount int64, userID int64, isAuthorized bool) ([]*proto.ChartTrack, error)
+
+	// ClearRecentSearchesFunc mocks the ClearRecentSearches method.
+	ClearRecentSearchesFunc func(n int64) error
+
+	// DeleteRecentSearchFunc mocks the DeleteRecentSearch method.
+	DeleteRecentSearchFunc func(n1 int64, n2 int64) (bool, error)
+
+	// DeleteTrackDislikeFunc mocks the DeleteTrackDislike method.
+	DeleteTrackDislikeFunc func(userID int64, trackID int64) error
+
 	// DeleteTrackFromFavoritesFunc mocks the DeleteTrackFromFavorites method.
 	DeleteTrackFromFavoritesFunc func(userID int64, trackID int64) error
 
+	// DislikeTrackFunc mocks the DislikeTrack method.
+	DislikeTrackFunc func(userID int64, trackID int64) error
+
 	// DoesPlaylistExistFunc mocks the DoesPlaylistExist method.
 	DoesPlaylistExistFunc func(n int64) (bool, error)
 
-	// FindAlbumsFunc mocks the FindAlbums method.
-	FindAlbumsFunc func(s string) ([]*proto.Album, error)
+	// FollowArtistFunc mocks the FollowArtist method.
+	FollowArtistFunc func(userID int64, artistID int64) error
+
+	// FollowedArtistIDsFunc mocks the FollowedArtistIDs method.
+	FollowedArtistIDsFunc func(userID int64, artistIDs []int64) ([]int64, error)
+
+	// FollowedArtistsFunc mocks the FollowedArtists method.
+	FollowedArtistsFunc func(userID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error)
 
-	// FindArtistsFunc mocks the FindArtists method.
-	FindArtistsFunc func(s string) ([]*proto.Artist, error)
+	// GenreAlbumsFunc mocks the GenreAlbums method.
+	GenreAlbumsFunc func(genreID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
 
-	// FindTracksByFullWordFunc mocks the FindTracksByFullWord method.
-	FindTracksByFullWordFunc func(s string, n int64, b bool) ([]*proto.Track, error)
+	// GenreArtistsFunc mocks the GenreArtists method.
+	GenreArtistsFunc func(genreID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error)
 
-	// FindTracksByPartialFunc mocks the FindTracksByPartial method.
-	FindTracksByPartialFunc func(s string, n int64, b bool) ([]*proto.Track, error)
+	// GenreInfoFunc mocks the GenreInfo method.
+	GenreInfoFunc func(genreID int64) (*proto.Genre, error)
+
+	// GenreTracksFunc mocks the GenreTracks method.
+	GenreTracksFunc func(genreID int64, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
 
 	// GetFavoritesFunc mocks the GetFavorites method.
-	GetFavoritesFunc func(userID int64) ([]*proto.Track, error)
+	GetFavoritesFunc func(userID int64, sortBy string, filter *proto.FavoritesFilter, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
 
 	// IncrementListenCountFunc mocks the IncrementListenCount method.
-	IncrementListenCountFunc func(n int64) error
+	IncrementListenCountFunc func(n1 int64, n2 int64) error
+
+	// IsAlbumSavedFunc mocks the IsAlbumSaved method.
+	IsAlbumSavedFunc func(userID int64, albumID int64) (bool, error)
+
+	// IsArtistFollowedFunc mocks the IsArtistFollowed method.
+	IsArtistFollowedFunc func(userID int64, artistID int64) (bool, error)
 
 	// IsPlaylistOwnerFunc mocks the IsPlaylistOwner method.
 	IsPlaylistOwnerFunc func(n1 int64, n2 int64) (bool, error)
@@ -143,26 +369,149 @@ type MockStorage struct {
 	// IsPlaylistPublicFunc mocks the IsPlaylistPublic method.
 	IsPlaylistPublicFunc func(n int64) (bool, error)
 
+	// IsReleaseRadarPlaylistFunc mocks the IsReleaseRadarPlaylist method.
+	IsReleaseRadarPlaylistFunc func(playlistID int64, userID int64) (bool, error)
+
+	// IsTrackDislikedFunc mocks the IsTrackDisliked method.
+	IsTrackDislikedFunc func(userID int64, trackID int64) (bool, error)
+
 	// IsTrackInFavoritesFunc mocks the IsTrackInFavorites method.
 	IsTrackInFavoritesFunc func(userID int64, trackID int64) (bool, error)
 
+	// ListGenresFunc mocks the ListGenres method.
+	ListGenresFunc func() ([]*proto.Genre, error)
+
+	// LyricsFunc mocks the Lyrics method.
+	LyricsFunc func(n int64) (*proto.Lyrics, error)
+
 	// PlaylistInfoFunc mocks the PlaylistInfo method.
-	PlaylistInfoFunc func(n int64) (*proto.PlaylistData, error)
+	PlaylistInfoFunc func(n1 int64, n2 int64) (*proto.PlaylistData, error)
 
 	// PlaylistTracksFunc mocks the PlaylistTracks method.
-	PlaylistTracksFunc func(n1 int64, n2 int64) ([]*proto.Track, error)
+	PlaylistTracksFunc func(n1 int64, n2 int64, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+
+	// RadioSeedFunc mocks the RadioSeed method.
+	RadioSeedFunc func(seedType string, seedID int64, userID int64) (*proto.RadioSession, error)
+
+	// RadioSessionFunc mocks the RadioSession method.
+	RadioSessionFunc func(key string) (*proto.RadioSession, bool, error)
+
+	// RadioTracksFunc mocks the RadioTracks method.
+	RadioTracksFunc func(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64, candidates []int64, amount int64) ([]*proto.Track, error)
 
 	// RandomAlbumsFunc mocks the RandomAlbums method.
-	RandomAlbumsFunc func(n int64) (*proto.Albums, error)
+	RandomAlbumsFunc func(albumIDs []int64) (*proto.Albums, error)
 
 	// RandomArtistsFunc mocks the RandomArtists method.
-	RandomArtistsFunc func(n int64) (*proto.Artists, error)
+	RandomArtistsFunc func(artistIDs []int64) (*proto.Artists, error)
+
+	// RandomPoolGenerationFunc mocks the RandomPoolGeneration method.
+	RandomPoolGenerationFunc func(entity string) (int64, error)
+
+	// RandomPoolIDsFunc mocks the RandomPoolIDs method.
+	RandomPoolIDsFunc func(entity string, generation int64, positions []int64) ([]int64, error)
+
+	// RandomPoolSizeFunc mocks the RandomPoolSize method.
+	RandomPoolSizeFunc func(entity string, generation int64) (int64, error)
 
 	// RandomTracksFunc mocks the RandomTracks method.
-	RandomTracksFunc func(n1 int64, n2 int64, b bool) (*proto.Tracks, error)
+	RandomTracksFunc func(trackIDs []int64, userID int64, isAuthorized bool) (*proto.Tracks, error)
+
+	// RebuildChartFunc mocks the RebuildChart method.
+	RebuildChartFunc func(period string, start time.Time, end time.Time, previousStart time.Time) error
+
+	// RebuildRandomPoolFunc mocks the RebuildRandomPool method.
+	RebuildRandomPoolFunc func(entity string) (int64, error)
+
+	// RebuildRecapsFunc mocks the RebuildRecaps method.
+	RebuildRecapsFunc func(year int64, start time.Time, end time.Time) error
+
+	// RecapFunc mocks the Recap method.
+	RecapFunc func(userID int64, year int64) (*proto.RecapResponse, error)
+
+	// RecapBuiltAtFunc mocks the RecapBuiltAt method.
+	RecapBuiltAtFunc func(year int64) (time.Time, error)
+
+	// RecapTopAlbumsFunc mocks the RecapTopAlbums method.
+	RecapTopAlbumsFunc func(userID int64, year int64, amount int64) ([]*proto.RecapAlbum, error)
+
+	// RecapTopArtistsFunc mocks the RecapTopArtists method.
+	RecapTopArtistsFunc func(userID int64, year int64, amount int64) ([]*proto.RecapArtist, error)
+
+	// RecapTopGenresFunc mocks the RecapTopGenres method.
+	RecapTopGenresFunc func(userID int64, year int64, amount int64) ([]*proto.RecapGenre, error)
+
+	// RecapTopTracksFunc mocks the RecapTopTracks method.
+	RecapTopTracksFunc func(userID int64, year int64, amount int64) ([]*proto.RecapTrack, error)
+
+	// RecentSearchesFunc mocks the RecentSearches method.
+	RecentSearchesFunc func(n1 int64, n2 int64) ([]*proto.RecentSearch, error)
+
+	// RecordListenRejectionFunc mocks the RecordListenRejection method.
+	RecordListenRejectionFunc func(userID int64, ip string, trackID int64, reason string) error
+
+	// RecordSearchFunc mocks the RecordSearch method.
+	RecordSearchFunc func(n1 int64, s1 string, s2 string, n2 int64) error
+
+	// ReleaseRadarFunc mocks the ReleaseRadar method.
+	ReleaseRadarFunc func(userID int64, now time.Time, amount int64) ([]*proto.Album, error)
+
+	// ReleaseRadarListenersFunc mocks the ReleaseRadarListeners method.
+	ReleaseRadarListenersFunc func(now time.Time, weekStart time.Time) ([]int64, error)
+
+	// ReleaseRadarPlaylistFunc mocks the ReleaseRadarPlaylist method.
+	ReleaseRadarPlaylistFunc func(userID int64) (int64, error)
+
+	// ReleaseRadarTracksFunc mocks the ReleaseRadarTracks method.
+	ReleaseRadarTracksFunc func(userID int64, now time.Time, amount int64) ([]int64, error)
+
+	// ReserveListenFunc mocks the ReserveListen method.
+	ReserveListenFunc func(userID int64, ip string, trackID int64, now time.Time) (string, error)
+
+	// SaveAlbumFunc mocks the SaveAlbum method.
+	SaveAlbumFunc func(userID int64, albumID int64) error
+
+	// SaveRadioSessionFunc mocks the SaveRadioSession method.
+	SaveRadioSessionFunc func(key string, session *proto.RadioSession, lifetime time.Duration) error
+
+	// SaveReleaseRadarPlaylistFunc mocks the SaveReleaseRadarPlaylist method.
+	SaveReleaseRadarPlaylistFunc func(userID int64, weekStart time.Time, trackIDs []int64) error
+
+	// SavedAlbumIDsFunc mocks the SavedAlbumIDs method.
+	SavedAlbumIDsFunc func(userID int64, albumIDs []int64) ([]int64, error)
+
+	// SavedAlbumsFunc mocks the SavedAlbums method.
+	SavedAlbumsFunc func(userID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+
+	// SearchAlbumsFunc mocks the SearchAlbums method.
+	SearchAlbumsFunc func(s string, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+
+	// SearchArtistsFunc mocks the SearchArtists method.
+	SearchArtistsFunc func(s string, pageRequest *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error)
+
+	// SearchPlaylistsFunc mocks the SearchPlaylists method.
+	SearchPlaylistsFunc func(s string, n int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error)
+
+	// SearchTracksFunc mocks the SearchTracks method.
+	SearchTracksFunc func(s string, searchFilter *proto.SearchFilter, n int64, b bool, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+
+	// SuggestFunc mocks the Suggest method.
+	SuggestFunc func(s string, n1 int64, n2 int64) ([]*proto.Suggestion, error)
+
+	// TrackFileFunc mocks the TrackFile method.
+	TrackFileFunc func(n int64) (*proto.TrackFileResponse, error)
+
+	// TracksByIDsFunc mocks the TracksByIDs method.
+	TracksByIDsFunc func(int64s []int64, n int64, b bool) ([]*proto.Track, error)
+
+	// UnfollowArtistFunc mocks the UnfollowArtist method.
+	UnfollowArtistFunc func(userID int64, artistID int64) error
+
+	// UnsaveAlbumFunc mocks the UnsaveAlbum method.
+	UnsaveAlbumFunc func(userID int64, albumID int64) error
 
 	// UserPlaylistsFunc mocks the UserPlaylists method.
-	UserPlaylistsFunc func(n int64) ([]*proto.PlaylistData, error)
+	UserPlaylistsFunc func(n int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -175,8 +524,10 @@ type MockStorage struct {
 		}
 		// AlbumData holds details about calls to the AlbumData method.
 		AlbumData []struct {
-			// N is the n argument value.
-			N int64
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
 		}
 		// AlbumTracks holds details about calls to the AlbumTracks method.
 		AlbumTracks []struct {
@@ -186,6 +537,8 @@ type MockStorage struct {
 			N2 int64
 			// B is the b argument value.
 			B bool
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
 		}
 		// ArtistAlbums holds details about calls to the ArtistAlbums method.
 		ArtistAlbums []struct {
@@ -194,10 +547,21 @@ type MockStorage struct {
 			// N2 is the n2 argument value.
 			N2 int64
 		}
+		// ArtistAlbumsPage holds details about calls to the ArtistAlbumsPage method.
+		ArtistAlbumsPage []struct {
+			// ArtistID is the artistID argument value.
+			ArtistID int64
+			// SortBy is the sortBy argument value.
+			SortBy string
+			// Page is the page argument value.
+			Page *proto.PageRequest
+		}
 		// ArtistInfo holds details about calls to the ArtistInfo method.
 		ArtistInfo []struct {
-			// N is the n argument value.
-			N int64
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
 		}
 		// ArtistTracks holds details about calls to the ArtistTracks method.
 		ArtistTracks []struct {
@@ -210,6 +574,94 @@ type MockStorage struct {
 			// N3 is the n3 argument value.
 			N3 int64
 		}
+		// ArtistTracksPage holds details about calls to the ArtistTracksPage method.
+		ArtistTracksPage []struct {
+			// ArtistID is the artistID argument value.
+			ArtistID int64
+			// UserID is the userID argument value.
+			UserID int64
+			// IsAuthorized is the isAuthorized argument value.
+			IsAuthorized bool
+			// SortBy is the sortBy argument value.
+			SortBy string
+			// Page is the page argument value.
+			Page *proto.PageRequest
+		}
+		// CacheSuggestions holds details about calls to the CacheSuggestions method.
+		CacheSuggestions []struct {
+			// S is the s argument value.
+			S string
+			// Suggestions is the suggestions argument value.
+			Suggestions []*proto.Suggestion
+			// Duration is the duration argument value.
+			Duration time.Duration
+		}
+		// CachedSuggestions holds details about calls to the CachedSuggestions method.
+		CachedSuggestions []struct {
+			// S is the s argument value.
+			S string
+		}
+		// ChartAlbums holds details about calls to the ChartAlbums method.
+		ChartAlbums []struct {
+			// Period is the period argument value.
+			Period string
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+			// GenreID is the genreID argument value.
+			GenreID int64
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// ChartArtists holds details about calls to the ChartArtists method.
+		ChartArtists []struct {
+			// Period is the period argument value.
+			Period string
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+			// GenreID is the genreID argument value.
+			GenreID int64
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// ChartPeriodStart holds details about calls to the ChartPeriodStart method.
+		ChartPeriodStart []struct {
+			// Period is the period argument value.
+			Period string
+		}
+		// ChartTracks holds details about calls to the ChartTracks method.
+		ChartTracks []struct {
+			// Period is the period argument value.
+			Period string
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+			// GenreID is the genreID argument value.
+			GenreID int64
+			// Amount is the amount argument value.
+			Amount int64
+			// UserID is the userID argument value.
+			UserID int64
+			// IsAuthorized is the isAuthorized argument value.
+			IsAuthorized bool
+		}
+		// ClearRecentSearches holds details about calls to the ClearRecentSearches method.
+		ClearRecentSearches []struct {
+			// N is the n argument value.
+			N int64
+		}
+		// DeleteRecentSearch holds details about calls to the DeleteRecentSearch method.
+		DeleteRecentSearch []struct {
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
+		}
+		// DeleteTrackDislike holds details about calls to the DeleteTrackDislike method.
+		DeleteTrackDislike []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// TrackID is the trackID argument value.
+			TrackID int64
+		}
 		// DeleteTrackFromFavorites holds details about calls to the DeleteTrackFromFavorites method.
 		DeleteTrackFromFavorites []struct {
 			// UserID is the userID argument value.
@@ -217,48 +669,100 @@ type MockStorage struct {
 			// TrackID is the trackID argument value.
 			TrackID int64
 		}
+		// DislikeTrack holds details about calls to the DislikeTrack method.
+		DislikeTrack []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// TrackID is the trackID argument value.
+			TrackID int64
+		}
 		// DoesPlaylistExist holds details about calls to the DoesPlaylistExist method.
 		DoesPlaylistExist []struct {
 			// N is the n argument value.
 			N int64
 		}
-		// FindAlbums holds details about calls to the FindAlbums method.
-		FindAlbums []struct {
-			// S is the s argument value.
-			S string
+		// FollowArtist holds details about calls to the FollowArtist method.
+		FollowArtist []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// ArtistID is the artistID argument value.
+			ArtistID int64
 		}
-		// FindArtists holds details about calls to the FindArtists method.
-		FindArtists []struct {
-			// S is the s argument value.
-			S string
+		// FollowedArtistIDs holds details about calls to the FollowedArtistIDs method.
+		FollowedArtistIDs []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// ArtistIDs is the artistIDs argument value.
+			ArtistIDs []int64
 		}
-		// FindTracksByFullWord holds details about calls to the FindTracksByFullWord method.
-		FindTracksByFullWord []struct {
-			// S is the s argument value.
-			S string
-			// N is the n argument value.
-			N int64
-			// B is the b argument value.
-			B bool
+		// FollowedArtists holds details about calls to the FollowedArtists method.
+		FollowedArtists []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Page is the page argument value.
+			Page *proto.PageRequest
 		}
-		// FindTracksByPartial holds details about calls to the FindTracksByPartial method.
-		FindTracksByPartial []struct {
-			// S is the s argument value.
-			S string
-			// N is the n argument value.
-			N int64
-			// B is the b argument value.
-			B bool
+		// GenreAlbums holds details about calls to the GenreAlbums method.
+		GenreAlbums []struct {
+			// GenreID is the genreID argument value.
+			GenreID int64
+			// Page is the page argument value.
+			Page *proto.PageRequest
+		}
+		// GenreArtists holds details about calls to the GenreArtists method.
+		GenreArtists []struct {
+			// GenreID is the genreID argument value.
+			GenreID int64
+			// Page is the page argument value.
+			Page *proto.PageRequest
+		}
+		// GenreInfo holds details about calls to the GenreInfo method.
+		GenreInfo []struct {
+			// GenreID is the genreID argument value.
+			GenreID int64
+		}
+		// GenreTracks holds details about calls to the GenreTracks method.
+		GenreTracks []struct {
+			// GenreID is the genreID argument value.
+			GenreID int64
+			// UserID is the userID argument value.
+			UserID int64
+			// IsAuthorized is the isAuthorized argument value.
+			IsAuthorized bool
+			// Page is the page argument value.
+			Page *proto.PageRequest
 		}
 		// GetFavorites holds details about calls to the GetFavorites method.
 		GetFavorites []struct {
 			// UserID is the userID argument value.
 			UserID int64
+			// SortBy is the sortBy argument value.
+			SortBy string
+			// Filter is the filter argument value.
+			Filter *proto.FavoritesFilter
+			// Page is the page argument value.
+			Page *proto.PageRequest
 		}
 		// IncrementListenCount holds details about calls to the IncrementListenCount method.
 		IncrementListenCount []struct {
-			// N is the n argument value.
-			N int64
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
+		}
+		// IsAlbumSaved holds details about calls to the IsAlbumSaved method.
+		IsAlbumSaved []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// AlbumID is the albumID argument value.
+			AlbumID int64
+		}
+		// IsArtistFollowed holds details about calls to the IsArtistFollowed method.
+		IsArtistFollowed []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// ArtistID is the artistID argument value.
+			ArtistID int64
 		}
 		// IsPlaylistOwner holds details about calls to the IsPlaylistOwner method.
 		IsPlaylistOwner []struct {
@@ -272,6 +776,20 @@ type MockStorage struct {
 			// N is the n argument value.
 			N int64
 		}
+		// IsReleaseRadarPlaylist holds details about calls to the IsReleaseRadarPlaylist method.
+		IsReleaseRadarPlaylist []struct {
+			// PlaylistID is the playlistID argument value.
+			PlaylistID int64
+			// UserID is the userID argument value.
+			UserID int64
+		}
+		// IsTrackDisliked holds details about calls to the IsTrackDisliked method.
+		IsTrackDisliked []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// TrackID is the trackID argument value.
+			TrackID int64
+		}
 		// IsTrackInFavorites holds details about calls to the IsTrackInFavorites method.
 		IsTrackInFavorites []struct {
 			// UserID is the userID argument value.
@@ -279,65 +797,444 @@ type MockStorage struct {
 			// TrackID is the trackID argument value.
 			TrackID int64
 		}
-		// PlaylistInfo holds details about calls to the PlaylistInfo method.
-		PlaylistInfo []struct {
+		// ListGenres holds details about calls to the ListGenres method.
+		ListGenres []struct {
+		}
+		// Lyrics holds details about calls to the Lyrics method.
+		Lyrics []struct {
 			// N is the n argument value.
 			N int64
 		}
+		// PlaylistInfo holds details about calls to the PlaylistInfo method.
+		PlaylistInfo []struct {
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
+		}
 		// PlaylistTracks holds details about calls to the PlaylistTracks method.
 		PlaylistTracks []struct {
 			// N1 is the n1 argument value.
 			N1 int64
 			// N2 is the n2 argument value.
 			N2 int64
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
+		}
+		// RadioSeed holds details about calls to the RadioSeed method.
+		RadioSeed []struct {
+			// SeedType is the seedType argument value.
+			SeedType string
+			// SeedID is the seedID argument value.
+			SeedID int64
+			// UserID is the userID argument value.
+			UserID int64
+		}
+		// RadioSession holds details about calls to the RadioSession method.
+		RadioSession []struct {
+			// Key is the key argument value.
+			Key string
+		}
+		// RadioTracks holds details about calls to the RadioTracks method.
+		RadioTracks []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// ArtistIDs is the artistIDs argument value.
+			ArtistIDs []int64
+			// GenreIDs is the genreIDs argument value.
+			GenreIDs []int64
+			// Pool is the pool argument value.
+			Pool string
+			// Exclude is the exclude argument value.
+			Exclude []int64
+			// Candidates is the candidates argument value.
+			Candidates []int64
+			// Amount is the amount argument value.
+			Amount int64
 		}
 		// RandomAlbums holds details about calls to the RandomAlbums method.
 		RandomAlbums []struct {
-			// N is the n argument value.
-			N int64
+			// AlbumIDs is the albumIDs argument value.
+			AlbumIDs []int64
 		}
 		// RandomArtists holds details about calls to the RandomArtists method.
 		RandomArtists []struct {
-			// N is the n argument value.
-			N int64
+			// ArtistIDs is the artistIDs argument value.
+			ArtistIDs []int64
+		}
+		// RandomPoolGeneration holds details about calls to the RandomPoolGeneration method.
+		RandomPoolGeneration []struct {
+			// Entity is the entity argument value.
+			Entity string
+		}
+		// RandomPoolIDs holds details about calls to the RandomPoolIDs method.
+		RandomPoolIDs []struct {
+			// Entity is the entity argument value.
+			Entity string
+			// Generation is the generation argument value.
+			Generation int64
+			// Positions is the positions argument value.
+			Positions []int64
+		}
+		// RandomPoolSize holds details about calls to the RandomPoolSize method.
+		RandomPoolSize []struct {
+			// Entity is the entity argument value.
+			Entity string
+			// Generation is the generation argument value.
+			Generation int64
 		}
 		// RandomTracks holds details about calls to the RandomTracks method.
 		RandomTracks []struct {
+			// TrackIDs is the trackIDs argument value.
+			TrackIDs []int64
+			// UserID is the userID argument value.
+			UserID int64
+			// IsAuthorized is the isAuthorized argument value.
+			IsAuthorized bool
+		}
+		// RebuildChart holds details about calls to the RebuildChart method.
+		RebuildChart []struct {
+			// Period is the period argument value.
+			Period string
+			// Start is the start argument value.
+			Start time.Time
+			// End is the end argument value.
+			End time.Time
+			// PreviousStart is the previousStart argument value.
+			PreviousStart time.Time
+		}
+		// RebuildRandomPool holds details about calls to the RebuildRandomPool method.
+		RebuildRandomPool []struct {
+			// Entity is the entity argument value.
+			Entity string
+		}
+		// RebuildRecaps holds details about calls to the RebuildRecaps method.
+		RebuildRecaps []struct {
+			// Year is the year argument value.
+			Year int64
+			// Start is the start argument value.
+			Start time.Time
+			// End is the end argument value.
+			End time.Time
+		}
+		// Recap holds details about calls to the Recap method.
+		Recap []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Year is the year argument value.
+			Year int64
+		}
+		// RecapBuiltAt holds details about calls to the RecapBuiltAt method.
+		RecapBuiltAt []struct {
+			// Year is the year argument value.
+			Year int64
+		}
+		// RecapTopAlbums holds details about calls to the RecapTopAlbums method.
+		RecapTopAlbums []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Year is the year argument value.
+			Year int64
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// RecapTopArtists holds details about calls to the RecapTopArtists method.
+		RecapTopArtists []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Year is the year argument value.
+			Year int64
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// RecapTopGenres holds details about calls to the RecapTopGenres method.
+		RecapTopGenres []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Year is the year argument value.
+			Year int64
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// RecapTopTracks holds details about calls to the RecapTopTracks method.
+		RecapTopTracks []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Year is the year argument value.
+			Year int64
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// RecentSearches holds details about calls to the RecentSearches method.
+		RecentSearches []struct {
 			// N1 is the n1 argument value.
 			N1 int64
 			// N2 is the n2 argument value.
 			N2 int64
-			// B is the b argument value.
+		}
+		// RecordListenRejection holds details about calls to the RecordListenRejection method.
+		RecordListenRejection []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Ip is the ip argument value.
+			Ip string
+			// TrackID is the trackID argument value.
+			TrackID int64
+			// Reason is the reason argument value.
+			Reason string
+		}
+		// RecordSearch holds details about calls to the RecordSearch method.
+		RecordSearch []struct {
+			// N1 is the n1 argument value.
+			N1 int64
+			// S1 is the s1 argument value.
+			S1 string
+			// S2 is the s2 argument value.
+			S2 string
+			// N2 is the n2 argument value.
+			N2 int64
+		}
+		// ReleaseRadar holds details about calls to the ReleaseRadar method.
+		ReleaseRadar []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Now is the now argument value.
+			Now time.Time
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// ReleaseRadarListeners holds details about calls to the ReleaseRadarListeners method.
+		ReleaseRadarListeners []struct {
+			// Now is the now argument value.
+			Now time.Time
+			// WeekStart is the weekStart argument value.
+			WeekStart time.Time
+		}
+		// ReleaseRadarPlaylist holds details about calls to the ReleaseRadarPlaylist method.
+		ReleaseRadarPlaylist []struct {
+			// UserID is the userID argument value.
+			UserID int64
+		}
+		// ReleaseRadarTracks holds details about calls to the ReleaseRadarTracks method.
+		ReleaseRadarTracks []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Now is the now argument value.
+			Now time.Time
+			// Amount is the amount argument value.
+			Amount int64
+		}
+		// ReserveListen holds details about calls to the ReserveListen method.
+		ReserveListen []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Ip is the ip argument value.
+			Ip string
+			// TrackID is the trackID argument value.
+			TrackID int64
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// SaveAlbum holds details about calls to the SaveAlbum method.
+		SaveAlbum []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// AlbumID is the albumID argument value.
+			AlbumID int64
+		}
+		// SaveRadioSession holds details about calls to the SaveRadioSession method.
+		SaveRadioSession []struct {
+			// Key is the key argument value.
+			Key string
+			// Session is the session argument value.
+			Session *proto.RadioSession
+			// Lifetime is the lifetime argument value.
+			Lifetime time.Duration
+		}
+		// SaveReleaseRadarPlaylist holds details about calls to the SaveReleaseRadarPlaylist method.
+		SaveReleaseRadarPlaylist []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// WeekStart is the weekStart argument value.
+			WeekStart time.Time
+			// TrackIDs is the trackIDs argument value.
+			TrackIDs []int64
+		}
+		// SavedAlbumIDs holds details about calls to the SavedAlbumIDs method.
+		SavedAlbumIDs []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// AlbumIDs is the albumIDs argument value.
+			AlbumIDs []int64
+		}
+		// SavedAlbums holds details about calls to the SavedAlbums method.
+		SavedAlbums []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// Page is the page argument value.
+			Page *proto.PageRequest
+		}
+		// SearchAlbums holds details about calls to the SearchAlbums method.
+		SearchAlbums []struct {
+			// S is the s argument value.
+			S string
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
+		}
+		// SearchArtists holds details about calls to the SearchArtists method.
+		SearchArtists []struct {
+			// S is the s argument value.
+			S string
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
+		}
+		// SearchPlaylists holds details about calls to the SearchPlaylists method.
+		SearchPlaylists []struct {
+			// S is the s argument value.
+			S string
+			// N is the n argument value.
+			N int64
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
+		}
+		// SearchTracks holds details about calls to the SearchTracks method.
+		SearchTracks []struct {
+			// S is the s argument value.
+			S string
+			// SearchFilter is the searchFilter argument value.
+			SearchFilter *proto.SearchFilter
+			// N is the n argument value.
+			N int64
+			// B is the b argument value.
+			B bool
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
+		}
+		// Suggest holds details about calls to the Suggest method.
+		Suggest []struct {
+			// S is the s argument value.
+			S string
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
+		}
+		// TrackFile holds details about calls to the TrackFile method.
+		TrackFile []struct {
+			// N is the n argument value.
+			N int64
+		}
+		// TracksByIDs holds details about calls to the TracksByIDs method.
+		TracksByIDs []struct {
+			// Int64s is the int64s argument value.
+			Int64s []int64
+			// N is the n argument value.
+			N int64
+			// B is the b argument value.
 			B bool
 		}
+		// UnfollowArtist holds details about calls to the UnfollowArtist method.
+		UnfollowArtist []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// ArtistID is the artistID argument value.
+			ArtistID int64
+		}
+		// UnsaveAlbum holds details about calls to the UnsaveAlbum method.
+		UnsaveAlbum []struct {
+			// UserID is the userID argument value.
+			UserID int64
+			// AlbumID is the albumID argument value.
+			AlbumID int64
+		}
 		// UserPlaylists holds details about calls to the UserPlaylists method.
 		UserPlaylists []struct {
 			// N is the n argument value.
 			N int64
+			// PageRequest is the pageRequest argument value.
+			PageRequest *proto.PageRequest
 		}
 	}
 	lockAddTrackToFavorite       sync.RWMutex
 	lockAlbumData                sync.RWMutex
 	lockAlbumTracks              sync.RWMutex
 	lockArtistAlbums             sync.RWMutex
+	lockArtistAlbumsPage         sync.RWMutex
 	lockArtistInfo               sync.RWMutex
 	lockArtistTracks             sync.RWMutex
+	lockArtistTracksPage         sync.RWMutex
+	lockCacheSuggestions         sync.RWMutex
+	lockCachedSuggestions        sync.RWMutex
+	lockChartAlbums              sync.RWMutex
+	lockChartArtists             sync.RWMutex
+	lockChartPeriodStart         sync.RWMutex
+	lockChartTracks              sync.RWMutex
+	lockClearRecentSearches      sync.RWMutex
+	lockDeleteRecentSearch       sync.RWMutex
+	lockDeleteTrackDislike       sync.RWMutex
 	lockDeleteTrackFromFavorites sync.RWMutex
+	lockDislikeTrack             sync.RWMutex
 	lockDoesPlaylistExist        sync.RWMutex
-	lockFindAlbums               sync.RWMutex
-	lockFindArtists              sync.RWMutex
-	lockFindTracksByFullWord     sync.RWMutex
-	lockFindTracksByPartial      sync.RWMutex
+	lockFollowArtist             sync.RWMutex
+	lockFollowedArtistIDs        sync.RWMutex
+	lockFollowedArtists          sync.RWMutex
+	lockGenreAlbums              sync.RWMutex
+	lockGenreArtists             sync.RWMutex
+	lockGenreInfo                sync.RWMutex
+	lockGenreTracks              sync.RWMutex
 	lockGetFavorites             sync.RWMutex
 	lockIncrementListenCount     sync.RWMutex
+	lockIsAlbumSaved             sync.RWMutex
+	lockIsArtistFollowed         sync.RWMutex
 	lockIsPlaylistOwner          sync.RWMutex
 	lockIsPlaylistPublic         sync.RWMutex
+	lockIsReleaseRadarPlaylist   sync.RWMutex
+	lockIsTrackDisliked          sync.RWMutex
 	lockIsTrackInFavorites       sync.RWMutex
+	lockListGenres               sync.RWMutex
+	lockLyrics                   sync.RWMutex
 	lockPlaylistInfo             sync.RWMutex
 	lockPlaylistTracks           sync.RWMutex
+	lockRadioSeed                sync.RWMutex
+	lockRadioSession             sync.RWMutex
+	lockRadioTracks              sync.RWMutex
 	lockRandomAlbums             sync.RWMutex
 	lockRandomArtists            sync.RWMutex
+	lockRandomPoolGeneration     sync.RWMutex
+	lockRandomPoolIDs            sync.RWMutex
+	lockRandomPoolSize           sync.RWMutex
 	lockRandomTracks             sync.RWMutex
+	lockRebuildChart             sync.RWMutex
+	lockRebuildRandomPool        sync.RWMutex
+	lockRebuildRecaps            sync.RWMutex
+	lockRecap                    sync.RWMutex
+	lockRecapBuiltAt             sync.RWMutex
+	lockRecapTopAlbums           sync.RWMutex
+	lockRecapTopArtists          sync.RWMutex
+	lockRecapTopGenres           sync.RWMutex
+	lockRecapTopTracks           sync.RWMutex
+	lockRecentSearches           sync.RWMutex
+	lockRecordListenRejection    sync.RWMutex
+	lockRecordSearch             sync.RWMutex
+	lockReleaseRadar             sync.RWMutex
+	lockReleaseRadarListeners    sync.RWMutex
+	lockReleaseRadarPlaylist     sync.RWMutex
+	lockReleaseRadarTracks       sync.RWMutex
+	lockReserveListen            sync.RWMutex
+	lockSaveAlbum                sync.RWMutex
+	lockSaveRadioSession         sync.RWMutex
+	lockSaveReleaseRadarPlaylist sync.RWMutex
+	lockSavedAlbumIDs            sync.RWMutex
+	lockSavedAlbums              sync.RWMutex
+	lockSearchAlbums             sync.RWMutex
+	lockSearchArtists            sync.RWMutex
+	lockSearchPlaylists          sync.RWMutex
+	lockSearchTracks             sync.RWMutex
+	lockSuggest                  sync.RWMutex
+	lockTrackFile                sync.RWMutex
+	lockTracksByIDs              sync.RWMutex
+	lockUnfollowArtist           sync.RWMutex
+	lockUnsaveAlbum              sync.RWMutex
 	lockUserPlaylists            sync.RWMutex
 }
 
@@ -377,29 +1274,33 @@ func (mock *MockStorage) AddTrackToFavoriteCalls() []struct {
 }
 
 // AlbumData calls AlbumDataFunc.
-func (mock *MockStorage) AlbumData(n int64) (*proto.AlbumPageResponse, error) {
+func (mock *MockStorage) AlbumData(n1 int64, n2 int64) (*proto.AlbumPageResponse, error) {
 	if mock.AlbumDataFunc == nil {
 		panic("MockStorage.AlbumDataFunc: method is nil but Storage.AlbumData was just called")
 	}
 	callInfo := struct {
-		N int64
+		N1 int64
+		N2 int64
 	}{
-		N: n,
+		N1: n1,
+		N2: n2,
 	}
 	mock.lockAlbumData.Lock()
 	mock.calls.AlbumData = append(mock.calls.AlbumData, callInfo)
 	mock.lockAlbumData.Unlock()
-	return mock.AlbumDataFunc(n)
+	return mock.AlbumDataFunc(n1, n2)
 }
 
 // AlbumDataCalls gets all the calls that were made to AlbumData.
 // Check the length with:
 //     len(mockedStorage.AlbumDataCalls())
 func (mock *MockStorage) AlbumDataCalls() []struct {
-	N int64
+	N1 int64
+	N2 int64
 } {
 	var calls []struct {
-		N int64
+		N1 int64
+		N2 int64
 	}
 	mock.lockAlbumData.RLock()
 	calls = mock.calls.AlbumData
@@ -408,37 +1309,41 @@ func (mock *MockStorage) AlbumDataCalls() []struct {
 }
 
 // AlbumTracks calls AlbumTracksFunc.
-func (mock *MockStorage) AlbumTracks(n1 int64, n2 int64, b bool) ([]*proto.AlbumTrack, error) {
+func (mock *MockStorage) AlbumTracks(n1 int64, n2 int64, b bool, pageRequest *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error) {
 	if mock.AlbumTracksFunc == nil {
 		panic("MockStorage.AlbumTracksFunc: method is nil but Storage.AlbumTracks was just called")
 	}
 	callInfo := struct {
-		N1 int64
-		N2 int64
-		B  bool
+		N1          int64
+		N2          int64
+		B           bool
+		PageRequest *proto.PageRequest
 	}{
-		N1: n1,
-		N2: n2,
-		B:  b,
+		N1:          n1,
+		N2:          n2,
+		B:           b,
+		PageRequest: pageRequest,
 	}
 	mock.lockAlbumTracks.Lock()
 	mock.calls.AlbumTracks = append(mock.calls.AlbumTracks, callInfo)
 	mock.lockAlbumTracks.Unlock()
-	return mock.AlbumTracksFunc(n1, n2, b)
+	return mock.AlbumTracksFunc(n1, n2, b, pageRequest)
 }
 
 // AlbumTracksCalls gets all the calls that were made to AlbumTracks.
 // Check the length with:
 //     len(mockedStorage.AlbumTracksCalls())
 func (mock *MockStorage) AlbumTracksCalls() []struct {
-	N1 int64
-	N2 int64
-	B  bool
+	N1          int64
+	N2          int64
+	B           bool
+	PageRequest *proto.PageRequest
 } {
 	var calls []struct {
-		N1 int64
-		N2 int64
-		B  bool
+		N1          int64
+		N2          int64
+		B           bool
+		PageRequest *proto.PageRequest
 	}
 	mock.lockAlbumTracks.RLock()
 	calls = mock.calls.AlbumTracks
@@ -481,30 +1386,73 @@ func (mock *MockStorage) ArtistAlbumsCalls() []struct {
 	return calls
 }
 
+// ArtistAlbumsPage calls ArtistAlbumsPageFunc.
+func (mock *MockStorage) ArtistAlbumsPage(artistID int64, sortBy string, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	if mock.ArtistAlbumsPageFunc == nil {
+		panic("MockStorage.ArtistAlbumsPageFunc: method is nil but Storage.ArtistAlbumsPage was just called")
+	}
+	callInfo := struct {
+		ArtistID int64
+		SortBy   string
+		Page     *proto.PageRequest
+	}{
+		ArtistID: artistID,
+		SortBy:   sortBy,
+		Page:     page,
+	}
+	mock.lockArtistAlbumsPage.Lock()
+	mock.calls.ArtistAlbumsPage = append(mock.calls.ArtistAlbumsPage, callInfo)
+	mock.lockArtistAlbumsPage.Unlock()
+	return mock.ArtistAlbumsPageFunc(artistID, sortBy, page)
+}
+
+// ArtistAlbumsPageCalls gets all the calls that were made to ArtistAlbumsPage.
+// Check the length with:
+//     len(mockedStorage.ArtistAlbumsPageCalls())
+func (mock *MockStorage) ArtistAlbumsPageCalls() []struct {
+	ArtistID int64
+	SortBy   string
+	Page     *proto.PageRequest
+} {
+	var calls []struct {
+		ArtistID int64
+		SortBy   string
+		Page     *proto.PageRequest
+	}
+	mock.lockArtistAlbumsPage.RLock()
+	calls = mock.calls.ArtistAlbumsPage
+	mock.lockArtistAlbumsPage.RUnlock()
+	return calls
+}
+
 // ArtistInfo calls ArtistInfoFunc.
-func (mock *MockStorage) ArtistInfo(n int64) (*proto.Artist, error) {
+func (mock *MockStorage) ArtistInfo(n1 int64, n2 int64) (*proto.Artist, error) {
 	if mock.ArtistInfoFunc == nil {
 		panic("MockStorage.ArtistInfoFunc: method is nil but Storage.ArtistInfo was just called")
 	}
 	callInfo := struct {
-		N int64
+		N1 int64
+		N2 int64
 	}{
-		N: n,
+		N1: n1,
+		N2: n2,
 	}
 	mock.lockArtistInfo.Lock()
 	mock.calls.ArtistInfo = append(mock.calls.ArtistInfo, callInfo)
 	mock.lockArtistInfo.Unlock()
-	return mock.ArtistInfoFunc(n)
+	return mock.ArtistInfoFunc(n1, n2)
 }
 
 // ArtistInfoCalls gets all the calls that were made to ArtistInfo.
 // Check the length with:
 //     len(mockedStorage.ArtistInfoCalls())
 func (mock *MockStorage) ArtistInfoCalls() []struct {
-	N int64
+	N1 int64
+	N2 int64
 } {
 	var calls []struct {
-		N int64
+		N1 int64
+		N2 int64
 	}
 	mock.lockArtistInfo.RLock()
 	calls = mock.calls.ArtistInfo
@@ -555,566 +1503,2712 @@ func (mock *MockStorage) ArtistTracksCalls() []struct {
 	return calls
 }
 
-// DeleteTrackFromFavorites calls DeleteTrackFromFavoritesFunc.
-func (mock *MockStorage) DeleteTrackFromFavorites(userID int64, trackID int64) error {
-	if mock.DeleteTrackFromFavoritesFunc == nil {
-		panic("MockStorage.DeleteTrackFromFavoritesFunc: method is nil but Storage.DeleteTrackFromFavorites was just called")
+// ArtistTracksPage calls ArtistTracksPageFunc.
+func (mock *MockStorage) ArtistTracksPage(artistID int64, userID int64, isAuthorized bool, sortBy string, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	if mock.ArtistTracksPageFunc == nil {
+		panic("MockStorage.ArtistTracksPageFunc: method is nil but Storage.ArtistTracksPage was just called")
 	}
 	callInfo := struct {
-		UserID  int64
-		TrackID int64
+		ArtistID     int64
+		UserID       int64
+		IsAuthorized bool
+		SortBy       string
+		Page         *proto.PageRequest
 	}{
-		UserID:  userID,
-		TrackID: trackID,
+		ArtistID:     artistID,
+		UserID:       userID,
+		IsAuthorized: isAuthorized,
+		SortBy:       sortBy,
+		Page:         page,
 	}
-	mock.lockDeleteTrackFromFavorites.Lock()
-	mock.calls.DeleteTrackFromFavorites = append(mock.calls.DeleteTrackFromFavorites, callInfo)
-	mock.lockDeleteTrackFromFavorites.Unlock()
-	return mock.DeleteTrackFromFavoritesFunc(userID, trackID)
+	mock.lockArtistTracksPage.Lock()
+	mock.calls.ArtistTracksPage = append(mock.calls.ArtistTracksPage, callInfo)
+	mock.lockArtistTracksPage.Unlock()
+	return mock.ArtistTracksPageFunc(artistID, userID, isAuthorized, sortBy, page)
 }
 
-// DeleteTrackFromFavoritesCalls gets all the calls that were made to DeleteTrackFromFavorites.
+// ArtistTracksPageCalls gets all the calls that were made to ArtistTracksPage.
 // Check the length with:
-//     len(mockedStorage.DeleteTrackFromFavoritesCalls())
-func (mock *MockStorage) DeleteTrackFromFavoritesCalls() []struct {
-	UserID  int64
-	TrackID int64
+//     len(mockedStorage.ArtistTracksPageCalls())
+func (mock *MockStorage) ArtistTracksPageCalls() []struct {
+	ArtistID     int64
+	UserID       int64
+	IsAuthorized bool
+	SortBy       string
+	Page         *proto.PageRequest
 } {
 	var calls []struct {
-		UserID  int64
-		TrackID int64
+		ArtistID     int64
+		UserID       int64
+		IsAuthorized bool
+		SortBy       string
+		Page         *proto.PageRequest
 	}
-	mock.lockDeleteTrackFromFavorites.RLock()
-	calls = mock.calls.DeleteTrackFromFavorites
-	mock.lockDeleteTrackFromFavorites.RUnlock()
+	mock.lockArtistTracksPage.RLock()
+	calls = mock.calls.ArtistTracksPage
+	mock.lockArtistTracksPage.RUnlock()
 	return calls
 }
 
-// DoesPlaylistExist calls DoesPlaylistExistFunc.
-func (mock *MockStorage) DoesPlaylistExist(n int64) (bool, error) {
-	if mock.DoesPlaylistExistFunc == nil {
-		panic("MockStorage.DoesPlaylistExistFunc: method is nil but Storage.DoesPlaylistExist was just called")
+// CacheSuggestions calls CacheSuggestionsFunc.
+func (mock *MockStorage) CacheSuggestions(s string, suggestions []*proto.Suggestion, duration time.Duration) error {
+	if mock.CacheSuggestionsFunc == nil {
+		panic("MockStorage.CacheSuggestionsFunc: method is nil but Storage.CacheSuggestions was just called")
+	}
+	callInfo := struct {
+		S           string
+		Suggestions []*proto.Suggestion
+		Duration    time.Duration
+	}{
+		S:           s,
+		Suggestions: suggestions,
+		Duration:    duration,
+	}
+	mock.lockCacheSuggestions.Lock()
+	mock.calls.CacheSuggestions = append(mock.calls.CacheSuggestions, callInfo)
+	mock.lockCacheSuggestions.Unlock()
+	return mock.CacheSuggestionsFunc(s, suggestions, duration)
+}
+
+// CacheSuggestionsCalls gets all the calls that were made to CacheSuggestions.
+// Check the length with:
+//     len(mockedStorage.CacheSuggestionsCalls())
+func (mock *MockStorage) CacheSuggestionsCalls() []struct {
+	S           string
+	Suggestions []*proto.Suggestion
+	Duration    time.Duration
+} {
+	var calls []struct {
+		S           string
+		Suggestions []*proto.Suggestion
+		Duration    time.Duration
+	}
+	mock.lockCacheSuggestions.RLock()
+	calls = mock.calls.CacheSuggestions
+	mock.lockCacheSuggestions.RUnlock()
+	return calls
+}
+
+// CachedSuggestions calls CachedSuggestionsFunc.
+func (mock *MockStorage) CachedSuggestions(s string) ([]*proto.Suggestion, bool, error) {
+	if mock.CachedSuggestionsFunc == nil {
+		panic("MockStorage.CachedSuggestionsFunc: method is nil but Storage.CachedSuggestions was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockCachedSuggestions.Lock()
+	mock.calls.CachedSuggestions = append(mock.calls.CachedSuggestions, callInfo)
+	mock.lockCachedSuggestions.Unlock()
+	return mock.CachedSuggestionsFunc(s)
+}
+
+// CachedSuggestionsCalls gets all the calls that were made to CachedSuggestions.
+// Check the length with:
+//     len(mockedStorage.CachedSuggestionsCalls())
+func (mock *MockStorage) CachedSuggestionsCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockCachedSuggestions.RLock()
+	calls = mock.calls.CachedSuggestions
+	mock.lockCachedSuggestions.RUnlock()
+	return calls
+}
+
+// ChartAlbums calls ChartAlbumsFunc.
+func (mock *MockStorage) ChartAlbums(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartAlbum, error) {
+	if mock.ChartAlbumsFunc == nil {
+		panic("MockStorage.ChartAlbumsFunc: method is nil but Storage.ChartAlbums was just called")
+	}
+	callInfo := struct {
+		Period      string
+		PeriodStart time.Time
+		GenreID     int64
+		Amount      int64
+	}{
+		Period:      period,
+		PeriodStart: periodStart,
+		GenreID:     genreID,
+		Amount:      amount,
+	}
+	mock.lockChartAlbums.Lock()
+	mock.calls.ChartAlbums = append(mock.calls.ChartAlbums, callInfo)
+	mock.lockChartAlbums.Unlock()
+	return mock.ChartAlbumsFunc(period, periodStart, genreID, amount)
+}
+
+// ChartAlbumsCalls gets all the calls that were made to ChartAlbums.
+// Check the length with:
+//     len(mockedStorage.ChartAlbumsCalls())
+func (mock *MockStorage) ChartAlbumsCalls() []struct {
+	Period      string
+	PeriodStart time.Time
+	GenreID     int64
+	Amount      int64
+} {
+	var calls []struct {
+		Period      string
+		PeriodStart time.Time
+		GenreID     int64
+		Amount      int64
+	}
+	mock.lockChartAlbums.RLock()
+	calls = mock.calls.ChartAlbums
+	mock.lockChartAlbums.RUnlock()
+	return calls
+}
+
+// ChartArtists calls ChartArtistsFunc.
+func (mock *MockStorage) ChartArtists(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartArtist, error) {
+	if mock.ChartArtistsFunc == nil {
+		panic("MockStorage.ChartArtistsFunc: method is nil but Storage.ChartArtists was just called")
+	}
+	callInfo := struct {
+		Period      string
+		PeriodStart time.Time
+		GenreID     int64
+		Amount      int64
+	}{
+		Period:      period,
+		PeriodStart: periodStart,
+		GenreID:     genreID,
+		Amount:      amount,
+	}
+	mock.lockChartArtists.Lock()
+	mock.calls.ChartArtists = append(mock.calls.ChartArtists, callInfo)
+	mock.lockChartArtists.Unlock()
+	return mock.ChartArtistsFunc(period, periodStart, genreID, amount)
+}
+
+// ChartArtistsCalls gets all the calls that were made to ChartArtists.
+// Check the length with:
+//     len(mockedStorage.ChartArtistsCalls())
+func (mock *MockStorage) ChartArtistsCalls() []struct {
+	Period      string
+	PeriodStart time.Time
+	GenreID     int64
+	Amount      int64
+} {
+	var calls []struct {
+		Period      string
+		PeriodStart time.Time
+		GenreID     int64
+		Amount      int64
+	}
+	mock.lockChartArtists.RLock()
+	calls = mock.calls.ChartArtists
+	mock.lockChartArtists.RUnlock()
+	return calls
+}
+
+// ChartPeriodStart calls ChartPeriodStartFunc.
+func (mock *MockStorage) ChartPeriodStart(period string) (time.Time, error) {
+	if mock.ChartPeriodStartFunc == nil {
+		panic("MockStorage.ChartPeriodStartFunc: method is nil but Storage.ChartPeriodStart was just called")
+	}
+	callInfo := struct {
+		Period string
+	}{
+		Period: period,
+	}
+	mock.lockChartPeriodStart.Lock()
+	mock.calls.ChartPeriodStart = append(mock.calls.ChartPeriodStart, callInfo)
+	mock.lockChartPeriodStart.Unlock()
+	return mock.ChartPeriodStartFunc(period)
+}
+
+// ChartPeriodStartCalls gets all the calls that were made to ChartPeriodStart.
+// Check the length with:
+//     len(mockedStorage.ChartPeriodStartCalls())
+func (mock *MockStorage) ChartPeriodStartCalls() []struct {
+	Period string
+} {
+	var calls []struct {
+		Period string
+	}
+	mock.lockChartPeriodStart.RLock()
+	calls = mock.calls.ChartPeriodStart
+	mock.lockChartPeriodStart.RUnlock()
+	return calls
+}
+
+// ChartTracks calls ChartTracksFunc.
+func (mock *MockStorage) ChartTracks(period string, periodStart time.Time, genreID int64, amount int64, userID int64, isAuthorized bool) ([]*proto.ChartTrack, error) {
+	if mock.ChartTracksFunc == nil {
+		panic("MockStorage.ChartTracksFunc: method is nil but Storage.ChartTracks was just called")
+	}
+	callInfo := struct {
+		Period       string
+		PeriodStart  time.Time
+		GenreID      int64
+		Amount       int64
+		UserID       int64
+		IsAuthorized bool
+	}{
+		Period:       period,
+		PeriodStart:  periodStart,
+		GenreID:      genreID,
+		Amount:       amount,
+		UserID:       userID,
+		IsAuthorized: isAuthorized,
+	}
+	mock.lockChartTracks.Lock()
+	mock.calls.ChartTracks = append(mock.calls.ChartTracks, callInfo)
+	mock.lockChartTracks.Unlock()
+	return mock.ChartTracksFunc(period, periodStart, genreID, amount, userID, isAuthorized)
+}
+
+// ChartTracksCalls gets all the calls that were made to ChartTracks.
+// Check the length with:
+//     len(mockedStorage.ChartTracksCalls())
+func (mock *MockStorage) ChartTracksCalls() []struct {
+	Period       string
+	PeriodStart  time.Time
+	GenreID      int64
+	Amount       int64
+	UserID       int64
+	IsAuthorized bool
+} {
+	var calls []struct {
+		Period       string
+		PeriodStart  time.Time
+		GenreID      int64
+		Amount       int64
+		UserID       int64
+		IsAuthorized bool
+	}
+	mock.lockChartTracks.RLock()
+	calls = mock.calls.ChartTracks
+	mock.lockChartTracks.RUnlock()
+	return calls
+}
+
+// ClearRecentSearches calls ClearRecentSearchesFunc.
+func (mock *MockStorage) ClearRecentSearches(n int64) error {
+	if mock.ClearRecentSearchesFunc == nil {
+		panic("MockStorage.ClearRecentSearchesFunc: method is nil but Storage.ClearRecentSearches was just called")
+	}
+	callInfo := struct {
+		N int64
+	}{
+		N: n,
+	}
+	mock.lockClearRecentSearches.Lock()
+	mock.calls.ClearRecentSearches = append(mock.calls.ClearRecentSearches, callInfo)
+	mock.lockClearRecentSearches.Unlock()
+	return mock.ClearRecentSearchesFunc(n)
+}
+
+// ClearRecentSearchesCalls gets all the calls that were made to ClearRecentSearches.
+// Check the length with:
+//     len(mockedStorage.ClearRecentSearchesCalls())
+func (mock *MockStorage) ClearRecentSearchesCalls() []struct {
+	N int64
+} {
+	var calls []struct {
+		N int64
+	}
+	mock.lockClearRecentSearches.RLock()
+	calls = mock.calls.ClearRecentSearches
+	mock.lockClearRecentSearches.RUnlock()
+	return calls
+}
+
+// DeleteRecentSearch calls DeleteRecentSearchFunc.
+func (mock *MockStorage) DeleteRecentSearch(n1 int64, n2 int64) (bool, error) {
+	if mock.DeleteRecentSearchFunc == nil {
+		panic("MockStorage.DeleteRecentSearchFunc: method is nil but Storage.DeleteRecentSearch was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		N2 int64
+	}{
+		N1: n1,
+		N2: n2,
+	}
+	mock.lockDeleteRecentSearch.Lock()
+	mock.calls.DeleteRecentSearch = append(mock.calls.DeleteRecentSearch, callInfo)
+	mock.lockDeleteRecentSearch.Unlock()
+	return mock.DeleteRecentSearchFunc(n1, n2)
+}
+
+// DeleteRecentSearchCalls gets all the calls that were made to DeleteRecentSearch.
+// Check the length with:
+//     len(mockedStorage.DeleteRecentSearchCalls())
+func (mock *MockStorage) DeleteRecentSearchCalls() []struct {
+	N1 int64
+	N2 int64
+} {
+	var calls []struct {
+		N1 int64
+		N2 int64
+	}
+	mock.lockDeleteRecentSearch.RLock()
+	calls = mock.calls.DeleteRecentSearch
+	mock.lockDeleteRecentSearch.RUnlock()
+	return calls
+}
+
+// DeleteTrackDislike calls DeleteTrackDislikeFunc.
+func (mock *MockStorage) DeleteTrackDislike(userID int64, trackID int64) error {
+	if mock.DeleteTrackDislikeFunc == nil {
+		panic("MockStorage.DeleteTrackDislikeFunc: method is nil but Storage.DeleteTrackDislike was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		TrackID int64
+	}{
+		UserID:  userID,
+		TrackID: trackID,
+	}
+	mock.lockDeleteTrackDislike.Lock()
+	mock.calls.DeleteTrackDislike = append(mock.calls.DeleteTrackDislike, callInfo)
+	mock.lockDeleteTrackDislike.Unlock()
+	return mock.DeleteTrackDislikeFunc(userID, trackID)
+}
+
+// DeleteTrackDislikeCalls gets all the calls that were made to DeleteTrackDislike.
+// Check the length with:
+//     len(mockedStorage.DeleteTrackDislikeCalls())
+func (mock *MockStorage) DeleteTrackDislikeCalls() []struct {
+	UserID  int64
+	TrackID int64
+} {
+	var calls []struct {
+		UserID  int64
+		TrackID int64
+	}
+	mock.lockDeleteTrackDislike.RLock()
+	calls = mock.calls.DeleteTrackDislike
+	mock.lockDeleteTrackDislike.RUnlock()
+	return calls
+}
+
+// DeleteTrackFromFavorites calls DeleteTrackFromFavoritesFunc.
+func (mock *MockStorage) DeleteTrackFromFavorites(userID int64, trackID int64) error {
+	if mock.DeleteTrackFromFavoritesFunc == nil {
+		panic("MockStorage.DeleteTrackFromFavoritesFunc: method is nil but Storage.DeleteTrackFromFavorites was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		TrackID int64
+	}{
+		UserID:  userID,
+		TrackID: trackID,
+	}
+	mock.lockDeleteTrackFromFavorites.Lock()
+	mock.calls.DeleteTrackFromFavorites = append(mock.calls.DeleteTrackFromFavorites, callInfo)
+	mock.lockDeleteTrackFromFavorites.Unlock()
+	return mock.DeleteTrackFromFavoritesFunc(userID, trackID)
+}
+
+// DeleteTrackFromFavoritesCalls gets all the calls that were made to DeleteTrackFromFavorites.
+// Check the length with:
+//     len(mockedStorage.DeleteTrackFromFavoritesCalls())
+func (mock *MockStorage) DeleteTrackFromFavoritesCalls() []struct {
+	UserID  int64
+	TrackID int64
+} {
+	var calls []struct {
+		UserID  int64
+		TrackID int64
+	}
+	mock.lockDeleteTrackFromFavorites.RLock()
+	calls = mock.calls.DeleteTrackFromFavorites
+	mock.lockDeleteTrackFromFavorites.RUnlock()
+	return calls
+}
+
+// DislikeTrack calls DislikeTrackFunc.
+func (mock *MockStorage) DislikeTrack(userID int64, trackID int64) error {
+	if mock.DislikeTrackFunc == nil {
+		panic("MockStorage.DislikeTrackFunc: method is nil but Storage.DislikeTrack was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		TrackID int64
+	}{
+		UserID:  userID,
+		TrackID: trackID,
+	}
+	mock.lockDislikeTrack.Lock()
+	mock.calls.DislikeTrack = append(mock.calls.DislikeTrack, callInfo)
+	mock.lockDislikeTrack.Unlock()
+	return mock.DislikeTrackFunc(userID, trackID)
+}
+
+// DislikeTrackCalls gets all the calls that were made to DislikeTrack.
+// Check the length with:
+//     len(mockedStorage.DislikeTrackCalls())
+func (mock *MockStorage) DislikeTrackCalls() []struct {
+	UserID  int64
+	TrackID int64
+} {
+	var calls []struct {
+		UserID  int64
+		TrackID int64
+	}
+	mock.lockDislikeTrack.RLock()
+	calls = mock.calls.DislikeTrack
+	mock.lockDislikeTrack.RUnlock()
+	return calls
+}
+
+// DoesPlaylistExist calls DoesPlaylistExistFunc.
+func (mock *MockStorage) DoesPlaylistExist(n int64) (bool, error) {
+	if mock.DoesPlaylistExistFunc == nil {
+		panic("MockStorage.DoesPlaylistExistFunc: method is nil but Storage.DoesPlaylistExist was just called")
+	}
+	callInfo := struct {
+		N int64
+	}{
+		N: n,
+	}
+	mock.lockDoesPlaylistExist.Lock()
+	mock.calls.DoesPlaylistExist = append(mock.calls.DoesPlaylistExist, callInfo)
+	mock.lockDoesPlaylistExist.Unlock()
+	return mock.DoesPlaylistExistFunc(n)
+}
+
+// DoesPlaylistExistCalls gets all the calls that were made to DoesPlaylistExist.
+// Check the length with:
+//     len(mockedStorage.DoesPlaylistExistCalls())
+func (mock *MockStorage) DoesPlaylistExistCalls() []struct {
+	N int64
+} {
+	var calls []struct {
+		N int64
+	}
+	mock.lockDoesPlaylistExist.RLock()
+	calls = mock.calls.DoesPlaylistExist
+	mock.lockDoesPlaylistExist.RUnlock()
+	return calls
+}
+
+// FollowArtist calls FollowArtistFunc.
+func (mock *MockStorage) FollowArtist(userID int64, artistID int64) error {
+	if mock.FollowArtistFunc == nil {
+		panic("MockStorage.FollowArtistFunc: method is nil but Storage.FollowArtist was just called")
+	}
+	callInfo := struct {
+		UserID   int64
+		ArtistID int64
+	}{
+		UserID:   userID,
+		ArtistID: artistID,
+	}
+	mock.lockFollowArtist.Lock()
+	mock.calls.FollowArtist = append(mock.calls.FollowArtist, callInfo)
+	mock.lockFollowArtist.Unlock()
+	return mock.FollowArtistFunc(userID, artistID)
+}
+
+// FollowArtistCalls gets all the calls that were made to FollowArtist.
+// Check the length with:
+//     len(mockedStorage.FollowArtistCalls())
+func (mock *MockStorage) FollowArtistCalls() []struct {
+	UserID   int64
+	ArtistID int64
+} {
+	var calls []struct {
+		UserID   int64
+		ArtistID int64
+	}
+	mock.lockFollowArtist.RLock()
+	calls = mock.calls.FollowArtist
+	mock.lockFollowArtist.RUnlock()
+	return calls
+}
+
+// FollowedArtistIDs calls FollowedArtistIDsFunc.
+func (mock *MockStorage) FollowedArtistIDs(userID int64, artistIDs []int64) ([]int64, error) {
+	if mock.FollowedArtistIDsFunc == nil {
+		panic("MockStorage.FollowedArtistIDsFunc: method is nil but Storage.FollowedArtistIDs was just called")
+	}
+	callInfo := struct {
+		UserID    int64
+		ArtistIDs []int64
+	}{
+		UserID:    userID,
+		ArtistIDs: artistIDs,
+	}
+	mock.lockFollowedArtistIDs.Lock()
+	mock.calls.FollowedArtistIDs = append(mock.calls.FollowedArtistIDs, callInfo)
+	mock.lockFollowedArtistIDs.Unlock()
+	return mock.FollowedArtistIDsFunc(userID, artistIDs)
+}
+
+// FollowedArtistIDsCalls gets all the calls that were made to FollowedArtistIDs.
+// Check the length with:
+//     len(mockedStorage.FollowedArtistIDsCalls())
+func (mock *MockStorage) FollowedArtistIDsCalls() []struct {
+	UserID    int64
+	ArtistIDs []int64
+} {
+	var calls []struct {
+		UserID    int64
+		ArtistIDs []int64
+	}
+	mock.lockFollowedArtistIDs.RLock()
+	calls = mock.calls.FollowedArtistIDs
+	mock.lockFollowedArtistIDs.RUnlock()
+	return calls
+}
+
+// FollowedArtists calls FollowedArtistsFunc.
+func (mock *MockStorage) FollowedArtists(userID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+	if mock.FollowedArtistsFunc == nil {
+		panic("MockStorage.FollowedArtistsFunc: method is nil but Storage.FollowedArtists was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Page   *proto.PageRequest
+	}{
+		UserID: userID,
+		Page:   page,
+	}
+	mock.lockFollowedArtists.Lock()
+	mock.calls.FollowedArtists = append(mock.calls.FollowedArtists, callInfo)
+	mock.lockFollowedArtists.Unlock()
+	return mock.FollowedArtistsFunc(userID, page)
+}
+
+// FollowedArtistsCalls gets all the calls that were made to FollowedArtists.
+// Check the length with:
+//     len(mockedStorage.FollowedArtistsCalls())
+func (mock *MockStorage) FollowedArtistsCalls() []struct {
+	UserID int64
+	Page   *proto.PageRequest
+} {
+	var calls []struct {
+		UserID int64
+		Page   *proto.PageRequest
+	}
+	mock.lockFollowedArtists.RLock()
+	calls = mock.calls.FollowedArtists
+	mock.lockFollowedArtists.RUnlock()
+	return calls
+}
+
+// GenreAlbums calls GenreAlbumsFunc.
+func (mock *MockStorage) GenreAlbums(genreID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	if mock.GenreAlbumsFunc == nil {
+		panic("MockStorage.GenreAlbumsFunc: method is nil but Storage.GenreAlbums was just called")
+	}
+	callInfo := struct {
+		GenreID int64
+		Page    *proto.PageRequest
+	}{
+		GenreID: genreID,
+		Page:    page,
+	}
+	mock.lockGenreAlbums.Lock()
+	mock.calls.GenreAlbums = append(mock.calls.GenreAlbums, callInfo)
+	mock.lockGenreAlbums.Unlock()
+	return mock.GenreAlbumsFunc(genreID, page)
+}
+
+// GenreAlbumsCalls gets all the calls that were made to GenreAlbums.
+// Check the length with:
+//     len(mockedStorage.GenreAlbumsCalls())
+func (mock *MockStorage) GenreAlbumsCalls() []struct {
+	GenreID int64
+	Page    *proto.PageRequest
+} {
+	var calls []struct {
+		GenreID int64
+		Page    *proto.PageRequest
+	}
+	mock.lockGenreAlbums.RLock()
+	calls = mock.calls.GenreAlbums
+	mock.lockGenreAlbums.RUnlock()
+	return calls
+}
+
+// GenreArtists calls GenreArtistsFunc.
+func (mock *MockStorage) GenreArtists(genreID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+	if mock.GenreArtistsFunc == nil {
+		panic("MockStorage.GenreArtistsFunc: method is nil but Storage.GenreArtists was just called")
+	}
+	callInfo := struct {
+		GenreID int64
+		Page    *proto.PageRequest
+	}{
+		GenreID: genreID,
+		Page:    page,
+	}
+	mock.lockGenreArtists.Lock()
+	mock.calls.GenreArtists = append(mock.calls.GenreArtists, callInfo)
+	mock.lockGenreArtists.Unlock()
+	return mock.GenreArtistsFunc(genreID, page)
+}
+
+// GenreArtistsCalls gets all the calls that were made to GenreArtists.
+// Check the length with:
+//     len(mockedStorage.GenreArtistsCalls())
+func (mock *MockStorage) GenreArtistsCalls() []struct {
+	GenreID int64
+	Page    *proto.PageRequest
+} {
+	var calls []struct {
+		GenreID int64
+		Page    *proto.PageRequest
+	}
+	mock.lockGenreArtists.RLock()
+	calls = mock.calls.GenreArtists
+	mock.lockGenreArtists.RUnlock()
+	return calls
+}
+
+// GenreInfo calls GenreInfoFunc.
+func (mock *MockStorage) GenreInfo(genreID int64) (*proto.Genre, error) {
+	if mock.GenreInfoFunc == nil {
+		panic("MockStorage.GenreInfoFunc: method is nil but Storage.GenreInfo was just called")
+	}
+	callInfo := struct {
+		GenreID int64
+	}{
+		GenreID: genreID,
+	}
+	mock.lockGenreInfo.Lock()
+	mock.calls.GenreInfo = append(mock.calls.GenreInfo, callInfo)
+	mock.lockGenreInfo.Unlock()
+	return mock.GenreInfoFunc(genreID)
+}
+
+// GenreInfoCalls gets all the calls that were made to GenreInfo.
+// Check the length with:
+//     len(mockedStorage.GenreInfoCalls())
+func (mock *MockStorage) GenreInfoCalls() []struct {
+	GenreID int64
+} {
+	var calls []struct {
+		GenreID int64
+	}
+	mock.lockGenreInfo.RLock()
+	calls = mock.calls.GenreInfo
+	mock.lockGenreInfo.RUnlock()
+	return calls
+}
+
+// GenreTracks calls GenreTracksFunc.
+func (mock *MockStorage) GenreTracks(genreID int64, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	if mock.GenreTracksFunc == nil {
+		panic("MockStorage.GenreTracksFunc: method is nil but Storage.GenreTracks was just called")
+	}
+	callInfo := struct {
+		GenreID      int64
+		UserID       int64
+		IsAuthorized bool
+		Page         *proto.PageRequest
+	}{
+		GenreID:      genreID,
+		UserID:       userID,
+		IsAuthorized: isAuthorized,
+		Page:         page,
+	}
+	mock.lockGenreTracks.Lock()
+	mock.calls.GenreTracks = append(mock.calls.GenreTracks, callInfo)
+	mock.lockGenreTracks.Unlock()
+	return mock.GenreTracksFunc(genreID, userID, isAuthorized, page)
+}
+
+// GenreTracksCalls gets all the calls that were made to GenreTracks.
+// Check the length with:
+//     len(mockedStorage.GenreTracksCalls())
+func (mock *MockStorage) GenreTracksCalls() []struct {
+	GenreID      int64
+	UserID       int64
+	IsAuthorized bool
+	Page         *proto.PageRequest
+} {
+	var calls []struct {
+		GenreID      int64
+		UserID       int64
+		IsAuthorized bool
+		Page         *proto.PageRequest
+	}
+	mock.lockGenreTracks.RLock()
+	calls = mock.calls.GenreTracks
+	mock.lockGenreTracks.RUnlock()
+	return calls
+}
+
+// GetFavorites calls GetFavoritesFunc.
+func (mock *MockStorage) GetFavorites(userID int64, sortBy string, filter *proto.FavoritesFilter, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	if mock.GetFavoritesFunc == nil {
+		panic("MockStorage.GetFavoritesFunc: method is nil but Storage.GetFavorites was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		SortBy string
+		Filter *proto.FavoritesFilter
+		Page   *proto.PageRequest
+	}{
+		UserID: userID,
+		SortBy: sortBy,
+		Filter: filter,
+		Page:   page,
+	}
+	mock.lockGetFavorites.Lock()
+	mock.calls.GetFavorites = append(mock.calls.GetFavorites, callInfo)
+	mock.lockGetFavorites.Unlock()
+	return mock.GetFavoritesFunc(userID, sortBy, filter, page)
+}
+
+// GetFavoritesCalls gets all the calls that were made to GetFavorites.
+// Check the length with:
+//     len(mockedStorage.GetFavoritesCalls())
+func (mock *MockStorage) GetFavoritesCalls() []struct {
+	UserID int64
+	SortBy string
+	Filter *proto.FavoritesFilter
+	Page   *proto.PageRequest
+} {
+	var calls []struct {
+		UserID int64
+		SortBy string
+		Filter *proto.FavoritesFilter
+		Page   *proto.PageRequest
+	}
+	mock.lockGetFavorites.RLock()
+	calls = mock.calls.GetFavorites
+	mock.lockGetFavorites.RUnlock()
+	return calls
+}
+
+// IncrementListenCount calls IncrementListenCountFunc.
+func (mock *MockStorage) IncrementListenCount(n1 int64, n2 int64) error {
+	if mock.IncrementListenCountFunc == nil {
+		panic("MockStorage.IncrementListenCountFunc: method is nil but Storage.IncrementListenCount was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		N2 int64
+	}{
+		N1: n1,
+		N2: n2,
+	}
+	mock.lockIncrementListenCount.Lock()
+	mock.calls.IncrementListenCount = append(mock.calls.IncrementListenCount, callInfo)
+	mock.lockIncrementListenCount.Unlock()
+	return mock.IncrementListenCountFunc(n1, n2)
+}
+
+// IncrementListenCountCalls gets all the calls that were made to IncrementListenCount.
+// Check the length with:
+//     len(mockedStorage.IncrementListenCountCalls())
+func (mock *MockStorage) IncrementListenCountCalls() []struct {
+	N1 int64
+	N2 int64
+} {
+	var calls []struct {
+		N1 int64
+		N2 int64
+	}
+	mock.lockIncrementListenCount.RLock()
+	calls = mock.calls.IncrementListenCount
+	mock.lockIncrementListenCount.RUnlock()
+	return calls
+}
+
+// IsAlbumSaved calls IsAlbumSavedFunc.
+func (mock *MockStorage) IsAlbumSaved(userID int64, albumID int64) (bool, error) {
+	if mock.IsAlbumSavedFunc == nil {
+		panic("MockStorage.IsAlbumSavedFunc: method is nil but Storage.IsAlbumSaved was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		AlbumID int64
+	}{
+		UserID:  userID,
+		AlbumID: albumID,
+	}
+	mock.lockIsAlbumSaved.Lock()
+	mock.calls.IsAlbumSaved = append(mock.calls.IsAlbumSaved, callInfo)
+	mock.lockIsAlbumSaved.Unlock()
+	return mock.IsAlbumSavedFunc(userID, albumID)
+}
+
+// IsAlbumSavedCalls gets all the calls that were made to IsAlbumSaved.
+// Check the length with:
+//     len(mockedStorage.IsAlbumSavedCalls())
+func (mock *MockStorage) IsAlbumSavedCalls() []struct {
+	UserID  int64
+	AlbumID int64
+} {
+	var calls []struct {
+		UserID  int64
+		AlbumID int64
+	}
+	mock.lockIsAlbumSaved.RLock()
+	calls = mock.calls.IsAlbumSaved
+	mock.lockIsAlbumSaved.RUnlock()
+	return calls
+}
+
+// IsArtistFollowed calls IsArtistFollowedFunc.
+func (mock *MockStorage) IsArtistFollowed(userID int64, artistID int64) (bool, error) {
+	if mock.IsArtistFollowedFunc == nil {
+		panic("MockStorage.IsArtistFollowedFunc: method is nil but Storage.IsArtistFollowed was just called")
+	}
+	callInfo := struct {
+		UserID   int64
+		ArtistID int64
+	}{
+		UserID:   userID,
+		ArtistID: artistID,
+	}
+	mock.lockIsArtistFollowed.Lock()
+	mock.calls.IsArtistFollowed = append(mock.calls.IsArtistFollowed, callInfo)
+	mock.lockIsArtistFollowed.Unlock()
+	return mock.IsArtistFollowedFunc(userID, artistID)
+}
+
+// IsArtistFollowedCalls gets all the calls that were made to IsArtistFollowed.
+// Check the length with:
+//     len(mockedStorage.IsArtistFollowedCalls())
+func (mock *MockStorage) IsArtistFollowedCalls() []struct {
+	UserID   int64
+	ArtistID int64
+} {
+	var calls []struct {
+		UserID   int64
+		ArtistID int64
+	}
+	mock.lockIsArtistFollowed.RLock()
+	calls = mock.calls.IsArtistFollowed
+	mock.lockIsArtistFollowed.RUnlock()
+	return calls
+}
+
+// IsPlaylistOwner calls IsPlaylistOwnerFunc.
+func (mock *MockStorage) IsPlaylistOwner(n1 int64, n2 int64) (bool, error) {
+	if mock.IsPlaylistOwnerFunc == nil {
+		panic("MockStorage.IsPlaylistOwnerFunc: method is nil but Storage.IsPlaylistOwner was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		N2 int64
+	}{
+		N1: n1,
+		N2: n2,
+	}
+	mock.lockIsPlaylistOwner.Lock()
+	mock.calls.IsPlaylistOwner = append(mock.calls.IsPlaylistOwner, callInfo)
+	mock.lockIsPlaylistOwner.Unlock()
+	return mock.IsPlaylistOwnerFunc(n1, n2)
+}
+
+// IsPlaylistOwnerCalls gets all the calls that were made to IsPlaylistOwner.
+// Check the length with:
+//     len(mockedStorage.IsPlaylistOwnerCalls())
+func (mock *MockStorage) IsPlaylistOwnerCalls() []struct {
+	N1 int64
+	N2 int64
+} {
+	var calls []struct {
+		N1 int64
+		N2 int64
+	}
+	mock.lockIsPlaylistOwner.RLock()
+	calls = mock.calls.IsPlaylistOwner
+	mock.lockIsPlaylistOwner.RUnlock()
+	return calls
+}
+
+// IsPlaylistPublic calls IsPlaylistPublicFunc.
+func (mock *MockStorage) IsPlaylistPublic(n int64) (bool, error) {
+	if mock.IsPlaylistPublicFunc == nil {
+		panic("MockStorage.IsPlaylistPublicFunc: method is nil but Storage.IsPlaylistPublic was just called")
+	}
+	callInfo := struct {
+		N int64
+	}{
+		N: n,
+	}
+	mock.lockIsPlaylistPublic.Lock()
+	mock.calls.IsPlaylistPublic = append(mock.calls.IsPlaylistPublic, callInfo)
+	mock.lockIsPlaylistPublic.Unlock()
+	return mock.IsPlaylistPublicFunc(n)
+}
+
+// IsPlaylistPublicCalls gets all the calls that were made to IsPlaylistPublic.
+// Check the length with:
+//     len(mockedStorage.IsPlaylistPublicCalls())
+func (mock *MockStorage) IsPlaylistPublicCalls() []struct {
+	N int64
+} {
+	var calls []struct {
+		N int64
+	}
+	mock.lockIsPlaylistPublic.RLock()
+	calls = mock.calls.IsPlaylistPublic
+	mock.lockIsPlaylistPublic.RUnlock()
+	return calls
+}
+
+// IsReleaseRadarPlaylist calls IsReleaseRadarPlaylistFunc.
+func (mock *MockStorage) IsReleaseRadarPlaylist(playlistID int64, userID int64) (bool, error) {
+	if mock.IsReleaseRadarPlaylistFunc == nil {
+		panic("MockStorage.IsReleaseRadarPlaylistFunc: method is nil but Storage.IsReleaseRadarPlaylist was just called")
+	}
+	callInfo := struct {
+		PlaylistID int64
+		UserID     int64
+	}{
+		PlaylistID: playlistID,
+		UserID:     userID,
+	}
+	mock.lockIsReleaseRadarPlaylist.Lock()
+	mock.calls.IsReleaseRadarPlaylist = append(mock.calls.IsReleaseRadarPlaylist, callInfo)
+	mock.lockIsReleaseRadarPlaylist.Unlock()
+	return mock.IsReleaseRadarPlaylistFunc(playlistID, userID)
+}
+
+// IsReleaseRadarPlaylistCalls gets all the calls that were made to IsReleaseRadarPlaylist.
+// Check the length with:
+//     len(mockedStorage.IsReleaseRadarPlaylistCalls())
+func (mock *MockStorage) IsReleaseRadarPlaylistCalls() []struct {
+	PlaylistID int64
+	UserID     int64
+} {
+	var calls []struct {
+		PlaylistID int64
+		UserID     int64
+	}
+	mock.lockIsReleaseRadarPlaylist.RLock()
+	calls = mock.calls.IsReleaseRadarPlaylist
+	mock.lockIsReleaseRadarPlaylist.RUnlock()
+	return calls
+}
+
+// IsTrackDisliked calls IsTrackDislikedFunc.
+func (mock *MockStorage) IsTrackDisliked(userID int64, trackID int64) (bool, error) {
+	if mock.IsTrackDislikedFunc == nil {
+		panic("MockStorage.IsTrackDislikedFunc: method is nil but Storage.IsTrackDisliked was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		TrackID int64
+	}{
+		UserID:  userID,
+		TrackID: trackID,
+	}
+	mock.lockIsTrackDisliked.Lock()
+	mock.calls.IsTrackDisliked = append(mock.calls.IsTrackDisliked, callInfo)
+	mock.lockIsTrackDisliked.Unlock()
+	return mock.IsTrackDislikedFunc(userID, trackID)
+}
+
+// IsTrackDislikedCalls gets all the calls that were made to IsTrackDisliked.
+// Check the length with:
+//     len(mockedStorage.IsTrackDislikedCalls())
+func (mock *MockStorage) IsTrackDislikedCalls() []struct {
+	UserID  int64
+	TrackID int64
+} {
+	var calls []struct {
+		UserID  int64
+		TrackID int64
+	}
+	mock.lockIsTrackDisliked.RLock()
+	calls = mock.calls.IsTrackDisliked
+	mock.lockIsTrackDisliked.RUnlock()
+	return calls
+}
+
+// IsTrackInFavorites calls IsTrackInFavoritesFunc.
+func (mock *MockStorage) IsTrackInFavorites(userID int64, trackID int64) (bool, error) {
+	if mock.IsTrackInFavoritesFunc == nil {
+		panic("MockStorage.IsTrackInFavoritesFunc: method is nil but Storage.IsTrackInFavorites was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		TrackID int64
+	}{
+		UserID:  userID,
+		TrackID: trackID,
+	}
+	mock.lockIsTrackInFavorites.Lock()
+	mock.calls.IsTrackInFavorites = append(mock.calls.IsTrackInFavorites, callInfo)
+	mock.lockIsTrackInFavorites.Unlock()
+	return mock.IsTrackInFavoritesFunc(userID, trackID)
+}
+
+// IsTrackInFavoritesCalls gets all the calls that were made to IsTrackInFavorites.
+// Check the length with:
+//     len(mockedStorage.IsTrackInFavoritesCalls())
+func (mock *MockStorage) IsTrackInFavoritesCalls() []struct {
+	UserID  int64
+	TrackID int64
+} {
+	var calls []struct {
+		UserID  int64
+		TrackID int64
+	}
+	mock.lockIsTrackInFavorites.RLock()
+	calls = mock.calls.IsTrackInFavorites
+	mock.lockIsTrackInFavorites.RUnlock()
+	return calls
+}
+
+// ListGenres calls ListGenresFunc.
+func (mock *MockStorage) ListGenres() ([]*proto.Genre, error) {
+	if mock.ListGenresFunc == nil {
+		panic("MockStorage.ListGenresFunc: method is nil but Storage.ListGenres was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListGenres.Lock()
+	mock.calls.ListGenres = append(mock.calls.ListGenres, callInfo)
+	mock.lockListGenres.Unlock()
+	return mock.ListGenresFunc()
+}
+
+// ListGenresCalls gets all the calls that were made to ListGenres.
+// Check the length with:
+//     len(mockedStorage.ListGenresCalls())
+func (mock *MockStorage) ListGenresCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListGenres.RLock()
+	calls = mock.calls.ListGenres
+	mock.lockListGenres.RUnlock()
+	return calls
+}
+
+// Lyrics calls LyricsFunc.
+func (mock *MockStorage) Lyrics(n int64) (*proto.Lyrics, error) {
+	if mock.LyricsFunc == nil {
+		panic("MockStorage.LyricsFunc: method is nil but Storage.Lyrics was just called")
+	}
+	callInfo := struct {
+		N int64
+	}{
+		N: n,
+	}
+	mock.lockLyrics.Lock()
+	mock.calls.Lyrics = append(mock.calls.Lyrics, callInfo)
+	mock.lockLyrics.Unlock()
+	return mock.LyricsFunc(n)
+}
+
+// LyricsCalls gets all the calls that were made to Lyrics.
+// Check the length with:
+//     len(mockedStorage.LyricsCalls())
+func (mock *MockStorage) LyricsCalls() []struct {
+	N int64
+} {
+	var calls []struct {
+		N int64
+	}
+	mock.lockLyrics.RLock()
+	calls = mock.calls.Lyrics
+	mock.lockLyrics.RUnlock()
+	return calls
+}
+
+// PlaylistInfo calls PlaylistInfoFunc.
+func (mock *MockStorage) PlaylistInfo(n1 int64, n2 int64) (*proto.PlaylistData, error) {
+	if mock.PlaylistInfoFunc == nil {
+		panic("MockStorage.PlaylistInfoFunc: method is nil but Storage.PlaylistInfo was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		N2 int64
+	}{
+		N1: n1,
+		N2: n2,
+	}
+	mock.lockPlaylistInfo.Lock()
+	mock.calls.PlaylistInfo = append(mock.calls.PlaylistInfo, callInfo)
+	mock.lockPlaylistInfo.Unlock()
+	return mock.PlaylistInfoFunc(n1, n2)
+}
+
+// PlaylistInfoCalls gets all the calls that were made to PlaylistInfo.
+// Check the length with:
+//     len(mockedStorage.PlaylistInfoCalls())
+func (mock *MockStorage) PlaylistInfoCalls() []struct {
+	N1 int64
+	N2 int64
+} {
+	var calls []struct {
+		N1 int64
+		N2 int64
+	}
+	mock.lockPlaylistInfo.RLock()
+	calls = mock.calls.PlaylistInfo
+	mock.lockPlaylistInfo.RUnlock()
+	return calls
+}
+
+// PlaylistTracks calls PlaylistTracksFunc.
+func (mock *MockStorage) PlaylistTracks(n1 int64, n2 int64, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	if mock.PlaylistTracksFunc == nil {
+		panic("MockStorage.PlaylistTracksFunc: method is nil but Storage.PlaylistTracks was just called")
+	}
+	callInfo := struct {
+		N1          int64
+		N2          int64
+		PageRequest *proto.PageRequest
+	}{
+		N1:          n1,
+		N2:          n2,
+		PageRequest: pageRequest,
+	}
+	mock.lockPlaylistTracks.Lock()
+	mock.calls.PlaylistTracks = append(mock.calls.PlaylistTracks, callInfo)
+	mock.lockPlaylistTracks.Unlock()
+	return mock.PlaylistTracksFunc(n1, n2, pageRequest)
+}
+
+// PlaylistTracksCalls gets all the calls that were made to PlaylistTracks.
+// Check the length with:
+//     len(mockedStorage.PlaylistTracksCalls())
+func (mock *MockStorage) PlaylistTracksCalls() []struct {
+	N1          int64
+	N2          int64
+	PageRequest *proto.PageRequest
+} {
+	var calls []struct {
+		N1          int64
+		N2          int64
+		PageRequest *proto.PageRequest
+	}
+	mock.lockPlaylistTracks.RLock()
+	calls = mock.calls.PlaylistTracks
+	mock.lockPlaylistTracks.RUnlock()
+	return calls
+}
+
+// RadioSeed calls RadioSeedFunc.
+func (mock *MockStorage) RadioSeed(seedType string, seedID int64, userID int64) (*proto.RadioSession, error) {
+	if mock.RadioSeedFunc == nil {
+		panic("MockStorage.RadioSeedFunc: method is nil but Storage.RadioSeed was just called")
+	}
+	callInfo := struct {
+		SeedType string
+		SeedID   int64
+		UserID   int64
+	}{
+		SeedType: seedType,
+		SeedID:   seedID,
+		UserID:   userID,
+	}
+	mock.lockRadioSeed.Lock()
+	mock.calls.RadioSeed = append(mock.calls.RadioSeed, callInfo)
+	mock.lockRadioSeed.Unlock()
+	return mock.RadioSeedFunc(seedType, seedID, userID)
+}
+
+// RadioSeedCalls gets all the calls that were made to RadioSeed.
+// Check the length with:
+//     len(mockedStorage.RadioSeedCalls())
+func (mock *MockStorage) RadioSeedCalls() []struct {
+	SeedType string
+	SeedID   int64
+	UserID   int64
+} {
+	var calls []struct {
+		SeedType string
+		SeedID   int64
+		UserID   int64
+	}
+	mock.lockRadioSeed.RLock()
+	calls = mock.calls.RadioSeed
+	mock.lockRadioSeed.RUnlock()
+	return calls
+}
+
+// RadioSession calls RadioSessionFunc.
+func (mock *MockStorage) RadioSession(key string) (*proto.RadioSession, bool, error) {
+	if mock.RadioSessionFunc == nil {
+		panic("MockStorage.RadioSessionFunc: method is nil but Storage.RadioSession was just called")
+	}
+	callInfo := struct {
+		Key string
+	}{
+		Key: key,
+	}
+	mock.lockRadioSession.Lock()
+	mock.calls.RadioSession = append(mock.calls.RadioSession, callInfo)
+	mock.lockRadioSession.Unlock()
+	return mock.RadioSessionFunc(key)
+}
+
+// RadioSessionCalls gets all the calls that were made to RadioSession.
+// Check the length with:
+//     len(mockedStorage.RadioSessionCalls())
+func (mock *MockStorage) RadioSessionCalls() []struct {
+	Key string
+} {
+	var calls []struct {
+		Key string
+	}
+	mock.lockRadioSession.RLock()
+	calls = mock.calls.RadioSession
+	mock.lockRadioSession.RUnlock()
+	return calls
+}
+
+// RadioTracks calls RadioTracksFunc.
+func (mock *MockStorage) RadioTracks(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64, candidates []int64, amount int64) ([]*proto.Track, error) {
+	if mock.RadioTracksFunc == nil {
+		panic("MockStorage.RadioTracksFunc: method is nil but Storage.RadioTracks was just called")
+	}
+	callInfo := struct {
+		UserID     int64
+		ArtistIDs  []int64
+		GenreIDs   []int64
+		Pool       string
+		Exclude    []int64
+		Candidates []int64
+		Amount     int64
+	}{
+		UserID:     userID,
+		ArtistIDs:  artistIDs,
+		GenreIDs:   genreIDs,
+		Pool:       pool,
+		Exclude:    exclude,
+		Candidates: candidates,
+		Amount:     amount,
+	}
+	mock.lockRadioTracks.Lock()
+	mock.calls.RadioTracks = append(mock.calls.RadioTracks, callInfo)
+	mock.lockRadioTracks.Unlock()
+	return mock.RadioTracksFunc(userID, artistIDs, genreIDs, pool, exclude, candidates, amount)
+}
+
+// RadioTracksCalls gets all the calls that were made to RadioTracks.
+// Check the length with:
+//     len(mockedStorage.RadioTracksCalls())
+func (mock *MockStorage) RadioTracksCalls() []struct {
+	UserID     int64
+	ArtistIDs  []int64
+	GenreIDs   []int64
+	Pool       string
+	Exclude    []int64
+	Candidates []int64
+	Amount     int64
+} {
+	var calls []struct {
+		UserID     int64
+		ArtistIDs  []int64
+		GenreIDs   []int64
+		Pool       string
+		Exclude    []int64
+		Candidates []int64
+		Amount     int64
+	}
+	mock.lockRadioTracks.RLock()
+	calls = mock.calls.RadioTracks
+	mock.lockRadioTracks.RUnlock()
+	return calls
+}
+
+// RandomAlbums calls RandomAlbumsFunc.
+func (mock *MockStorage) RandomAlbums(albumIDs []int64) (*proto.Albums, error) {
+	if mock.RandomAlbumsFunc == nil {
+		panic("MockStorage.RandomAlbumsFunc: method is nil but Storage.RandomAlbums was just called")
+	}
+	callInfo := struct {
+		AlbumIDs []int64
+	}{
+		AlbumIDs: albumIDs,
+	}
+	mock.lockRandomAlbums.Lock()
+	mock.calls.RandomAlbums = append(mock.calls.RandomAlbums, callInfo)
+	mock.lockRandomAlbums.Unlock()
+	return mock.RandomAlbumsFunc(albumIDs)
+}
+
+// RandomAlbumsCalls gets all the calls that were made to RandomAlbums.
+// Check the length with:
+//     len(mockedStorage.RandomAlbumsCalls())
+func (mock *MockStorage) RandomAlbumsCalls() []struct {
+	AlbumIDs []int64
+} {
+	var calls []struct {
+		AlbumIDs []int64
+	}
+	mock.lockRandomAlbums.RLock()
+	calls = mock.calls.RandomAlbums
+	mock.lockRandomAlbums.RUnlock()
+	return calls
+}
+
+// RandomArtists calls RandomArtistsFunc.
+func (mock *MockStorage) RandomArtists(artistIDs []int64) (*proto.Artists, error) {
+	if mock.RandomArtistsFunc == nil {
+		panic("MockStorage.RandomArtistsFunc: method is nil but Storage.RandomArtists was just called")
+	}
+	callInfo := struct {
+		ArtistIDs []int64
+	}{
+		ArtistIDs: artistIDs,
+	}
+	mock.lockRandomArtists.Lock()
+	mock.calls.RandomArtists = append(mock.calls.RandomArtists, callInfo)
+	mock.lockRandomArtists.Unlock()
+	return mock.RandomArtistsFunc(artistIDs)
+}
+
+// RandomArtistsCalls gets all the calls that were made to RandomArtists.
+// Check the length with:
+//     len(mockedStorage.RandomArtistsCalls())
+func (mock *MockStorage) RandomArtistsCalls() []struct {
+	ArtistIDs []int64
+} {
+	var calls []struct {
+		ArtistIDs []int64
+	}
+	mock.lockRandomArtists.RLock()
+	calls = mock.calls.RandomArtists
+	mock.lockRandomArtists.RUnlock()
+	return calls
+}
+
+// RandomPoolGeneration calls RandomPoolGenerationFunc.
+func (mock *MockStorage) RandomPoolGeneration(entity string) (int64, error) {
+	if mock.RandomPoolGenerationFunc == nil {
+		panic("MockStorage.RandomPoolGenerationFunc: method is nil but Storage.RandomPoolGeneration was just called")
+	}
+	callInfo := struct {
+		Entity string
+	}{
+		Entity: entity,
+	}
+	mock.lockRandomPoolGeneration.Lock()
+	mock.calls.RandomPoolGeneration = append(mock.calls.RandomPoolGeneration, callInfo)
+	mock.lockRandomPoolGeneration.Unlock()
+	return mock.RandomPoolGenerationFunc(entity)
+}
+
+// RandomPoolGenerationCalls gets all the calls that were made to RandomPoolGeneration.
+// Check the length with:
+//     len(mockedStorage.RandomPoolGenerationCalls())
+func (mock *MockStorage) RandomPoolGenerationCalls() []struct {
+	Entity string
+} {
+	var calls []struct {
+		Entity string
+	}
+	mock.lockRandomPoolGeneration.RLock()
+	calls = mock.calls.RandomPoolGeneration
+	mock.lockRandomPoolGeneration.RUnlock()
+	return calls
+}
+
+// RandomPoolIDs calls RandomPoolIDsFunc.
+func (mock *MockStorage) RandomPoolIDs(entity string, generation int64, positions []int64) ([]int64, error) {
+	if mock.RandomPoolIDsFunc == nil {
+		panic("MockStorage.RandomPoolIDsFunc: method is nil but Storage.RandomPoolIDs was just called")
+	}
+	callInfo := struct {
+		Entity     string
+		Generation int64
+		Positions  []int64
+	}{
+		Entity:     entity,
+		Generation: generation,
+		Positions:  positions,
+	}
+	mock.lockRandomPoolIDs.Lock()
+	mock.calls.RandomPoolIDs = append(mock.calls.RandomPoolIDs, callInfo)
+	mock.lockRandomPoolIDs.Unlock()
+	return mock.RandomPoolIDsFunc(entity, generation, positions)
+}
+
+// RandomPoolIDsCalls gets all the calls that were made to RandomPoolIDs.
+// Check the length with:
+//     len(mockedStorage.RandomPoolIDsCalls())
+func (mock *MockStorage) RandomPoolIDsCalls() []struct {
+	Entity     string
+	Generation int64
+	Positions  []int64
+} {
+	var calls []struct {
+		Entity     string
+		Generation int64
+		Positions  []int64
+	}
+	mock.lockRandomPoolIDs.RLock()
+	calls = mock.calls.RandomPoolIDs
+	mock.lockRandomPoolIDs.RUnlock()
+	return calls
+}
+
+// RandomPoolSize calls RandomPoolSizeFunc.
+func (mock *MockStorage) RandomPoolSize(entity string, generation int64) (int64, error) {
+	if mock.RandomPoolSizeFunc == nil {
+		panic("MockStorage.RandomPoolSizeFunc: method is nil but Storage.RandomPoolSize was just called")
+	}
+	callInfo := struct {
+		Entity     string
+		Generation int64
+	}{
+		Entity:     entity,
+		Generation: generation,
+	}
+	mock.lockRandomPoolSize.Lock()
+	mock.calls.RandomPoolSize = append(mock.calls.RandomPoolSize, callInfo)
+	mock.lockRandomPoolSize.Unlock()
+	return mock.RandomPoolSizeFunc(entity, generation)
+}
+
+// RandomPoolSizeCalls gets all the calls that were made to RandomPoolSize.
+// Check the length with:
+//     len(mockedStorage.RandomPoolSizeCalls())
+func (mock *MockStorage) RandomPoolSizeCalls() []struct {
+	Entity     string
+	Generation int64
+} {
+	var calls []struct {
+		Entity     string
+		Generation int64
+	}
+	mock.lockRandomPoolSize.RLock()
+	calls = mock.calls.RandomPoolSize
+	mock.lockRandomPoolSize.RUnlock()
+	return calls
+}
+
+// RandomTracks calls RandomTracksFunc.
+func (mock *MockStorage) RandomTracks(trackIDs []int64, userID int64, isAuthorized bool) (*proto.Tracks, error) {
+	if mock.RandomTracksFunc == nil {
+		panic("MockStorage.RandomTracksFunc: method is nil but Storage.RandomTracks was just called")
+	}
+	callInfo := struct {
+		TrackIDs     []int64
+		UserID       int64
+		IsAuthorized bool
+	}{
+		TrackIDs:     trackIDs,
+		UserID:       userID,
+		IsAuthorized: isAuthorized,
+	}
+	mock.lockRandomTracks.Lock()
+	mock.calls.RandomTracks = append(mock.calls.RandomTracks, callInfo)
+	mock.lockRandomTracks.Unlock()
+	return mock.RandomTracksFunc(trackIDs, userID, isAuthorized)
+}
+
+// RandomTracksCalls gets all the calls that were made to RandomTracks.
+// Check the length with:
+//     len(mockedStorage.RandomTracksCalls())
+func (mock *MockStorage) RandomTracksCalls() []struct {
+	TrackIDs     []int64
+	UserID       int64
+	IsAuthorized bool
+} {
+	var calls []struct {
+		TrackIDs     []int64
+		UserID       int64
+		IsAuthorized bool
+	}
+	mock.lockRandomTracks.RLock()
+	calls = mock.calls.RandomTracks
+	mock.lockRandomTracks.RUnlock()
+	return calls
+}
+
+// RebuildChart calls RebuildChartFunc.
+func (mock *MockStorage) RebuildChart(period string, start time.Time, end time.Time, previousStart time.Time) error {
+	if mock.RebuildChartFunc == nil {
+		panic("MockStorage.RebuildChartFunc: method is nil but Storage.RebuildChart was just called")
+	}
+	callInfo := struct {
+		Period        string
+		Start         time.Time
+		End           time.Time
+		PreviousStart time.Time
+	}{
+		Period:        period,
+		Start:         start,
+		End:           end,
+		PreviousStart: previousStart,
+	}
+	mock.lockRebuildChart.Lock()
+	mock.calls.RebuildChart = append(mock.calls.RebuildChart, callInfo)
+	mock.lockRebuildChart.Unlock()
+	return mock.RebuildChartFunc(period, start, end, previousStart)
+}
+
+// RebuildChartCalls gets all the calls that were made to RebuildChart.
+// Check the length with:
+//     len(mockedStorage.RebuildChartCalls())
+func (mock *MockStorage) RebuildChartCalls() []struct {
+	Period        string
+	Start         time.Time
+	End           time.Time
+	PreviousStart time.Time
+} {
+	var calls []struct {
+		Period        string
+		Start         time.Time
+		End           time.Time
+		PreviousStart time.Time
+	}
+	mock.lockRebuildChart.RLock()
+	calls = mock.calls.RebuildChart
+	mock.lockRebuildChart.RUnlock()
+	return calls
+}
+
+// RebuildRandomPool calls RebuildRandomPoolFunc.
+func (mock *MockStorage) RebuildRandomPool(entity string) (int64, error) {
+	if mock.RebuildRandomPoolFunc == nil {
+		panic("MockStorage.RebuildRandomPoolFunc: method is nil but Storage.RebuildRandomPool was just called")
+	}
+	callInfo := struct {
+		Entity string
+	}{
+		Entity: entity,
+	}
+	mock.lockRebuildRandomPool.Lock()
+	mock.calls.RebuildRandomPool = append(mock.calls.RebuildRandomPool, callInfo)
+	mock.lockRebuildRandomPool.Unlock()
+	return mock.RebuildRandomPoolFunc(entity)
+}
+
+// RebuildRandomPoolCalls gets all the calls that were made to RebuildRandomPool.
+// Check the length with:
+//     len(mockedStorage.RebuildRandomPoolCalls())
+func (mock *MockStorage) RebuildRandomPoolCalls() []struct {
+	Entity string
+} {
+	var calls []struct {
+		Entity string
+	}
+	mock.lockRebuildRandomPool.RLock()
+	calls = mock.calls.RebuildRandomPool
+	mock.lockRebuildRandomPool.RUnlock()
+	return calls
+}
+
+// RebuildRecaps calls RebuildRecapsFunc.
+func (mock *MockStorage) RebuildRecaps(year int64, start time.Time, end time.Time) error {
+	if mock.RebuildRecapsFunc == nil {
+		panic("MockStorage.RebuildRecapsFunc: method is nil but Storage.RebuildRecaps was just called")
+	}
+	callInfo := struct {
+		Year  int64
+		Start time.Time
+		End   time.Time
+	}{
+		Year:  year,
+		Start: start,
+		End:   end,
+	}
+	mock.lockRebuildRecaps.Lock()
+	mock.calls.RebuildRecaps = append(mock.calls.RebuildRecaps, callInfo)
+	mock.lockRebuildRecaps.Unlock()
+	return mock.RebuildRecapsFunc(year, start, end)
+}
+
+// RebuildRecapsCalls gets all the calls that were made to RebuildRecaps.
+// Check the length with:
+//     len(mockedStorage.RebuildRecapsCalls())
+func (mock *MockStorage) RebuildRecapsCalls() []struct {
+	Year  int64
+	Start time.Time
+	End   time.Time
+} {
+	var calls []struct {
+		Year  int64
+		Start time.Time
+		End   time.Time
+	}
+	mock.lockRebuildRecaps.RLock()
+	calls = mock.calls.RebuildRecaps
+	mock.lockRebuildRecaps.RUnlock()
+	return calls
+}
+
+// Recap calls RecapFunc.
+func (mock *MockStorage) Recap(userID int64, year int64) (*proto.RecapResponse, error) {
+	if mock.RecapFunc == nil {
+		panic("MockStorage.RecapFunc: method is nil but Storage.Recap was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Year   int64
+	}{
+		UserID: userID,
+		Year:   year,
+	}
+	mock.lockRecap.Lock()
+	mock.calls.Recap = append(mock.calls.Recap, callInfo)
+	mock.lockRecap.Unlock()
+	return mock.RecapFunc(userID, year)
+}
+
+// RecapCalls gets all the calls that were made to Recap.
+// Check the length with:
+//     len(mockedStorage.RecapCalls())
+func (mock *MockStorage) RecapCalls() []struct {
+	UserID int64
+	Year   int64
+} {
+	var calls []struct {
+		UserID int64
+		Year   int64
+	}
+	mock.lockRecap.RLock()
+	calls = mock.calls.Recap
+	mock.lockRecap.RUnlock()
+	return calls
+}
+
+// RecapBuiltAt calls RecapBuiltAtFunc.
+func (mock *MockStorage) RecapBuiltAt(year int64) (time.Time, error) {
+	if mock.RecapBuiltAtFunc == nil {
+		panic("MockStorage.RecapBuiltAtFunc: method is nil but Storage.RecapBuiltAt was just called")
+	}
+	callInfo := struct {
+		Year int64
+	}{
+		Year: year,
+	}
+	mock.lockRecapBuiltAt.Lock()
+	mock.calls.RecapBuiltAt = append(mock.calls.RecapBuiltAt, callInfo)
+	mock.lockRecapBuiltAt.Unlock()
+	return mock.RecapBuiltAtFunc(year)
+}
+
+// RecapBuiltAtCalls gets all the calls that were made to RecapBuiltAt.
+// Check the length with:
+//     len(mockedStorage.RecapBuiltAtCalls())
+func (mock *MockStorage) RecapBuiltAtCalls() []struct {
+	Year int64
+} {
+	var calls []struct {
+		Year int64
+	}
+	mock.lockRecapBuiltAt.RLock()
+	calls = mock.calls.RecapBuiltAt
+	mock.lockRecapBuiltAt.RUnlock()
+	return calls
+}
+
+// RecapTopAlbums calls RecapTopAlbumsFunc.
+func (mock *MockStorage) RecapTopAlbums(userID int64, year int64, amount int64) ([]*proto.RecapAlbum, error) {
+	if mock.RecapTopAlbumsFunc == nil {
+		panic("MockStorage.RecapTopAlbumsFunc: method is nil but Storage.RecapTopAlbums was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}{
+		UserID: userID,
+		Year:   year,
+		Amount: amount,
+	}
+	mock.lockRecapTopAlbums.Lock()
+	mock.calls.RecapTopAlbums = append(mock.calls.RecapTopAlbums, callInfo)
+	mock.lockRecapTopAlbums.Unlock()
+	return mock.RecapTopAlbumsFunc(userID, year, amount)
+}
+
+// RecapTopAlbumsCalls gets all the calls that were made to RecapTopAlbums.
+// Check the length with:
+//     len(mockedStorage.RecapTopAlbumsCalls())
+func (mock *MockStorage) RecapTopAlbumsCalls() []struct {
+	UserID int64
+	Year   int64
+	Amount int64
+} {
+	var calls []struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}
+	mock.lockRecapTopAlbums.RLock()
+	calls = mock.calls.RecapTopAlbums
+	mock.lockRecapTopAlbums.RUnlock()
+	return calls
+}
+
+// RecapTopArtists calls RecapTopArtistsFunc.
+func (mock *MockStorage) RecapTopArtists(userID int64, year int64, amount int64) ([]*proto.RecapArtist, error) {
+	if mock.RecapTopArtistsFunc == nil {
+		panic("MockStorage.RecapTopArtistsFunc: method is nil but Storage.RecapTopArtists was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}{
+		UserID: userID,
+		Year:   year,
+		Amount: amount,
+	}
+	mock.lockRecapTopArtists.Lock()
+	mock.calls.RecapTopArtists = append(mock.calls.RecapTopArtists, callInfo)
+	mock.lockRecapTopArtists.Unlock()
+	return mock.RecapTopArtistsFunc(userID, year, amount)
+}
+
+// RecapTopArtistsCalls gets all the calls that were made to RecapTopArtists.
+// Check the length with:
+//     len(mockedStorage.RecapTopArtistsCalls())
+func (mock *MockStorage) RecapTopArtistsCalls() []struct {
+	UserID int64
+	Year   int64
+	Amount int64
+} {
+	var calls []struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}
+	mock.lockRecapTopArtists.RLock()
+	calls = mock.calls.RecapTopArtists
+	mock.lockRecapTopArtists.RUnlock()
+	return calls
+}
+
+// RecapTopGenres calls RecapTopGenresFunc.
+func (mock *MockStorage) RecapTopGenres(userID int64, year int64, amount int64) ([]*proto.RecapGenre, error) {
+	if mock.RecapTopGenresFunc == nil {
+		panic("MockStorage.RecapTopGenresFunc: method is nil but Storage.RecapTopGenres was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}{
+		UserID: userID,
+		Year:   year,
+		Amount: amount,
+	}
+	mock.lockRecapTopGenres.Lock()
+	mock.calls.RecapTopGenres = append(mock.calls.RecapTopGenres, callInfo)
+	mock.lockRecapTopGenres.Unlock()
+	return mock.RecapTopGenresFunc(userID, year, amount)
+}
+
+// RecapTopGenresCalls gets all the calls that were made to RecapTopGenres.
+// Check the length with:
+//     len(mockedStorage.RecapTopGenresCalls())
+func (mock *MockStorage) RecapTopGenresCalls() []struct {
+	UserID int64
+	Year   int64
+	Amount int64
+} {
+	var calls []struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}
+	mock.lockRecapTopGenres.RLock()
+	calls = mock.calls.RecapTopGenres
+	mock.lockRecapTopGenres.RUnlock()
+	return calls
+}
+
+// RecapTopTracks calls RecapTopTracksFunc.
+func (mock *MockStorage) RecapTopTracks(userID int64, year int64, amount int64) ([]*proto.RecapTrack, error) {
+	if mock.RecapTopTracksFunc == nil {
+		panic("MockStorage.RecapTopTracksFunc: method is nil but Storage.RecapTopTracks was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}{
+		UserID: userID,
+		Year:   year,
+		Amount: amount,
+	}
+	mock.lockRecapTopTracks.Lock()
+	mock.calls.RecapTopTracks = append(mock.calls.RecapTopTracks, callInfo)
+	mock.lockRecapTopTracks.Unlock()
+	return mock.RecapTopTracksFunc(userID, year, amount)
+}
+
+// RecapTopTracksCalls gets all the calls that were made to RecapTopTracks.
+// Check the length with:
+//     len(mockedStorage.RecapTopTracksCalls())
+func (mock *MockStorage) RecapTopTracksCalls() []struct {
+	UserID int64
+	Year   int64
+	Amount int64
+} {
+	var calls []struct {
+		UserID int64
+		Year   int64
+		Amount int64
+	}
+	mock.lockRecapTopTracks.RLock()
+	calls = mock.calls.RecapTopTracks
+	mock.lockRecapTopTracks.RUnlock()
+	return calls
+}
+
+// RecentSearches calls RecentSearchesFunc.
+func (mock *MockStorage) RecentSearches(n1 int64, n2 int64) ([]*proto.RecentSearch, error) {
+	if mock.RecentSearchesFunc == nil {
+		panic("MockStorage.RecentSearchesFunc: method is nil but Storage.RecentSearches was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		N2 int64
+	}{
+		N1: n1,
+		N2: n2,
+	}
+	mock.lockRecentSearches.Lock()
+	mock.calls.RecentSearches = append(mock.calls.RecentSearches, callInfo)
+	mock.lockRecentSearches.Unlock()
+	return mock.RecentSearchesFunc(n1, n2)
+}
+
+// RecentSearchesCalls gets all the calls that were made to RecentSearches.
+// Check the length with:
+//     len(mockedStorage.RecentSearchesCalls())
+func (mock *MockStorage) RecentSearchesCalls() []struct {
+	N1 int64
+	N2 int64
+} {
+	var calls []struct {
+		N1 int64
+		N2 int64
+	}
+	mock.lockRecentSearches.RLock()
+	calls = mock.calls.RecentSearches
+	mock.lockRecentSearches.RUnlock()
+	return calls
+}
+
+// RecordListenRejection calls RecordListenRejectionFunc.
+func (mock *MockStorage) RecordListenRejection(userID int64, ip string, trackID int64, reason string) error {
+	if mock.RecordListenRejectionFunc == nil {
+		panic("MockStorage.RecordListenRejectionFunc: method is nil but Storage.RecordListenRejection was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		Ip      string
+		TrackID int64
+		Reason  string
+	}{
+		UserID:  userID,
+		Ip:      ip,
+		TrackID: trackID,
+		Reason:  reason,
+	}
+	mock.lockRecordListenRejection.Lock()
+	mock.calls.RecordListenRejection = append(mock.calls.RecordListenRejection, callInfo)
+	mock.lockRecordListenRejection.Unlock()
+	return mock.RecordListenRejectionFunc(userID, ip, trackID, reason)
+}
+
+// RecordListenRejectionCalls gets all the calls that were made to RecordListenRejection.
+// Check the length with:
+//     len(mockedStorage.RecordListenRejectionCalls())
+func (mock *MockStorage) RecordListenRejectionCalls() []struct {
+	UserID  int64
+	Ip      string
+	TrackID int64
+	Reason  string
+} {
+	var calls []struct {
+		UserID  int64
+		Ip      string
+		TrackID int64
+		Reason  string
+	}
+	mock.lockRecordListenRejection.RLock()
+	calls = mock.calls.RecordListenRejection
+	mock.lockRecordListenRejection.RUnlock()
+	return calls
+}
+
+// RecordSearch calls RecordSearchFunc.
+func (mock *MockStorage) RecordSearch(n1 int64, s1 string, s2 string, n2 int64) error {
+	if mock.RecordSearchFunc == nil {
+		panic("MockStorage.RecordSearchFunc: method is nil but Storage.RecordSearch was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		S1 string
+		S2 string
+		N2 int64
+	}{
+		N1: n1,
+		S1: s1,
+		S2: s2,
+		N2: n2,
+	}
+	mock.lockRecordSearch.Lock()
+	mock.calls.RecordSearch = append(mock.calls.RecordSearch, callInfo)
+	mock.lockRecordSearch.Unlock()
+	return mock.RecordSearchFunc(n1, s1, s2, n2)
+}
+
+// RecordSearchCalls gets all the calls that were made to RecordSearch.
+// Check the length with:
+//     len(mockedStorage.RecordSearchCalls())
+func (mock *MockStorage) RecordSearchCalls() []struct {
+	N1 int64
+	S1 string
+	S2 string
+	N2 int64
+} {
+	var calls []struct {
+		N1 int64
+		S1 string
+		S2 string
+		N2 int64
+	}
+	mock.lockRecordSearch.RLock()
+	calls = mock.calls.RecordSearch
+	mock.lockRecordSearch.RUnlock()
+	return calls
+}
+
+// ReleaseRadar calls ReleaseRadarFunc.
+func (mock *MockStorage) ReleaseRadar(userID int64, now time.Time, amount int64) ([]*proto.Album, error) {
+	if mock.ReleaseRadarFunc == nil {
+		panic("MockStorage.ReleaseRadarFunc: method is nil but Storage.ReleaseRadar was just called")
+	}
+	callInfo := struct {
+		UserID int64
+		Now    time.Time
+		Amount int64
+	}{
+		UserID: userID,
+		Now:    now,
+		Amount: amount,
+	}
+	mock.lockReleaseRadar.Lock()
+	mock.calls.ReleaseRadar = append(mock.calls.ReleaseRadar, callInfo)
+	mock.lockReleaseRadar.Unlock()
+	return mock.ReleaseRadarFunc(userID, now, amount)
+}
+
+// ReleaseRadarCalls gets all the calls that were made to ReleaseRadar.
+// Check the length with:
+//     len(mockedStorage.ReleaseRadarCalls())
+func (mock *MockStorage) ReleaseRadarCalls() []struct {
+	UserID int64
+	Now    time.Time
+	Amount int64
+} {
+	var calls []struct {
+		UserID int64
+		Now    time.Time
+		Amount int64
+	}
+	mock.lockReleaseRadar.RLock()
+	calls = mock.calls.ReleaseRadar
+	mock.lockReleaseRadar.RUnlock()
+	return calls
+}
+
+// ReleaseRadarListeners calls ReleaseRadarListenersFunc.
+func (mock *MockStorage) ReleaseRadarListeners(now time.Time, weekStart time.Time) ([]int64, error) {
+	if mock.ReleaseRadarListenersFunc == nil {
+		panic("MockStorage.ReleaseRadarListenersFunc: method is nil but Storage.ReleaseRadarListeners was just called")
+	}
+	callInfo := struct {
+		Now       time.Time
+		WeekStart time.Time
+	}{
+		Now:       now,
+		WeekStart: weekStart,
+	}
+	mock.lockReleaseRadarListeners.Lock()
+	mock.calls.ReleaseRadarListeners = append(mock.calls.ReleaseRadarListeners, callInfo)
+	mock.lockReleaseRadarListeners.Unlock()
+	return mock.ReleaseRadarListenersFunc(now, weekStart)
+}
+
+// ReleaseRadarListenersCalls gets all the calls that were made to ReleaseRadarListeners.
+// Check the length with:
+//     len(mockedStorage.ReleaseRadarListenersCalls())
+func (mock *MockStorage) ReleaseRadarListenersCalls() []struct {
+	Now       time.Time
+	WeekStart time.Time
+} {
+	var calls []struct {
+		Now       time.Time
+		WeekStart time.Time
+	}
+	mock.lockReleaseRadarListeners.RLock()
+	calls = mock.calls.ReleaseRadarListeners
+	mock.lockReleaseRadarListeners.RUnlock()
+	return calls
+}
+
+// ReleaseRadarPlaylist calls ReleaseRadarPlaylistFunc.
+func (mock *MockStorage) ReleaseRadarPlaylist(userID int64) (int64, error) {
+	if mock.ReleaseRadarPlaylistFunc == nil {
+		panic("MockStorage.ReleaseRadarPlaylistFunc: method is nil but Storage.ReleaseRadarPlaylist was just called")
+	}
+	callInfo := struct {
+		UserID int64
+	}{
+		UserID: userID,
+	}
+	mock.lockReleaseRadarPlaylist.Lock()
+	mock.calls.ReleaseRadarPlaylist = append(mock.calls.ReleaseRadarPlaylist, callInfo)
+	mock.lockReleaseRadarPlaylist.Unlock()
+	return mock.ReleaseRadarPlaylistFunc(userID)
+}
+
+// ReleaseRadarPlaylistCalls gets all the calls that were made to ReleaseRadarPlaylist.
+// Check the length with:
+//     len(mockedStorage.ReleaseRadarPlaylistCalls())
+func (mock *MockStorage) ReleaseRadarPlaylistCalls() []struct {
+	UserID int64
+} {
+	var calls []struct {
+		UserID int64
+	}
+	mock.lockReleaseRadarPlaylist.RLock()
+	calls = mock.calls.ReleaseRadarPlaylist
+	mock.lockReleaseRadarPlaylist.RUnlock()
+	return calls
+}
+
+// ReleaseRadarTracks calls ReleaseRadarTracksFunc.
+func (mock *MockStorage) ReleaseRadarTracks(userID int64, now time.Time, amount int64) ([]int64, error) {
+	if mock.ReleaseRadarTracksFunc == nil {
+		panic("MockStorage.ReleaseRadarTracksFunc: method is nil but Storage.ReleaseRadarTracks was just called")
 	}
 	callInfo := struct {
-		N int64
+		UserID int64
+		Now    time.Time
+		Amount int64
 	}{
-		N: n,
+		UserID: userID,
+		Now:    now,
+		Amount: amount,
 	}
-	mock.lockDoesPlaylistExist.Lock()
-	mock.calls.DoesPlaylistExist = append(mock.calls.DoesPlaylistExist, callInfo)
-	mock.lockDoesPlaylistExist.Unlock()
-	return mock.DoesPlaylistExistFunc(n)
+	mock.lockReleaseRadarTracks.Lock()
+	mock.calls.ReleaseRadarTracks = append(mock.calls.ReleaseRadarTracks, callInfo)
+	mock.lockReleaseRadarTracks.Unlock()
+	return mock.ReleaseRadarTracksFunc(userID, now, amount)
 }
 
-// DoesPlaylistExistCalls gets all the calls that were made to DoesPlaylistExist.
+// ReleaseRadarTracksCalls gets all the calls that were made to ReleaseRadarTracks.
 // Check the length with:
-//     len(mockedStorage.DoesPlaylistExistCalls())
-func (mock *MockStorage) DoesPlaylistExistCalls() []struct {
-	N int64
+//     len(mockedStorage.ReleaseRadarTracksCalls())
+func (mock *MockStorage) ReleaseRadarTracksCalls() []struct {
+	UserID int64
+	Now    time.Time
+	Amount int64
 } {
 	var calls []struct {
-		N int64
+		UserID int64
+		Now    time.Time
+		Amount int64
 	}
-	mock.lockDoesPlaylistExist.RLock()
-	calls = mock.calls.DoesPlaylistExist
-	mock.lockDoesPlaylistExist.RUnlock()
+	mock.lockReleaseRadarTracks.RLock()
+	calls = mock.calls.ReleaseRadarTracks
+	mock.lockReleaseRadarTracks.RUnlock()
 	return calls
 }
 
-// FindAlbums calls FindAlbumsFunc.
-func (mock *MockStorage) FindAlbums(s string) ([]*proto.Album, error) {
-	if mock.FindAlbumsFunc == nil {
-		panic("MockStorage.FindAlbumsFunc: method is nil but Storage.FindAlbums was just called")
+// ReserveListen calls ReserveListenFunc.
+func (mock *MockStorage) ReserveListen(userID int64, ip string, trackID int64, now time.Time) (string, error) {
+	if mock.ReserveListenFunc == nil {
+		panic("MockStorage.ReserveListenFunc: method is nil but Storage.ReserveListen was just called")
 	}
 	callInfo := struct {
-		S string
+		UserID  int64
+		Ip      string
+		TrackID int64
+		Now     time.Time
 	}{
-		S: s,
+		UserID:  userID,
+		Ip:      ip,
+		TrackID: trackID,
+		Now:     now,
 	}
-	mock.lockFindAlbums.Lock()
-	mock.calls.FindAlbums = append(mock.calls.FindAlbums, callInfo)
-	mock.lockFindAlbums.Unlock()
-	return mock.FindAlbumsFunc(s)
+	mock.lockReserveListen.Lock()
+	mock.calls.ReserveListen = append(mock.calls.ReserveListen, callInfo)
+	mock.lockReserveListen.Unlock()
+	return mock.ReserveListenFunc(userID, ip, trackID, now)
 }
 
-// FindAlbumsCalls gets all the calls that were made to FindAlbums.
+// ReserveListenCalls gets all the calls that were made to ReserveListen.
 // Check the length with:
-//     len(mockedStorage.FindAlbumsCalls())
-func (mock *MockStorage) FindAlbumsCalls() []struct {
-	S string
+//     len(mockedStorage.ReserveListenCalls())
+func (mock *MockStorage) ReserveListenCalls() []struct {
+	UserID  int64
+	Ip      string
+	TrackID int64
+	Now     time.Time
 } {
 	var calls []struct {
-		S string
+		UserID  int64
+		Ip      string
+		TrackID int64
+		Now     time.Time
 	}
-	mock.lockFindAlbums.RLock()
-	calls = mock.calls.FindAlbums
-	mock.lockFindAlbums.RUnlock()
+	mock.lockReserveListen.RLock()
+	calls = mock.calls.ReserveListen
+	mock.lockReserveListen.RUnlock()
 	return calls
 }
 
-// FindArtists calls FindArtistsFunc.
-func (mock *MockStorage) FindArtists(s string) ([]*proto.Artist, error) {
-	if mock.FindArtistsFunc == nil {
-		panic("MockStorage.FindArtistsFunc: method is nil but Storage.FindArtists was just called")
+// SaveAlbum calls SaveAlbumFunc.
+func (mock *MockStorage) SaveAlbum(userID int64, albumID int64) error {
+	if mock.SaveAlbumFunc == nil {
+		panic("MockStorage.SaveAlbumFunc: method is nil but Storage.SaveAlbum was just called")
 	}
 	callInfo := struct {
-		S string
+		UserID  int64
+		AlbumID int64
 	}{
-		S: s,
+		UserID:  userID,
+		AlbumID: albumID,
 	}
-	mock.lockFindArtists.Lock()
-	mock.calls.FindArtists = append(mock.calls.FindArtists, callInfo)
-	mock.lockFindArtists.Unlock()
-	return mock.FindArtistsFunc(s)
+	mock.lockSaveAlbum.Lock()
+	mock.calls.SaveAlbum = append(mock.calls.SaveAlbum, callInfo)
+	mock.lockSaveAlbum.Unlock()
+	return mock.SaveAlbumFunc(userID, albumID)
 }
 
-// FindArtistsCalls gets all the calls that were made to FindArtists.
+// SaveAlbumCalls gets all the calls that were made to SaveAlbum.
 // Check the length with:
-//     len(mockedStorage.FindArtistsCalls())
-func (mock *MockStorage) FindArtistsCalls() []struct {
-	S string
+//     len(mockedStorage.SaveAlbumCalls())
+func (mock *MockStorage) SaveAlbumCalls() []struct {
+	UserID  int64
+	AlbumID int64
 } {
 	var calls []struct {
-		S string
+		UserID  int64
+		AlbumID int64
 	}
-	mock.lockFindArtists.RLock()
-	calls = mock.calls.FindArtists
-	mock.lockFindArtists.RUnlock()
+	mock.lockSaveAlbum.RLock()
+	calls = mock.calls.SaveAlbum
+	mock.lockSaveAlbum.RUnlock()
 	return calls
 }
 
-// FindTracksByFullWord calls FindTracksByFullWordFunc.
-func (mock *MockStorage) FindTracksByFullWord(s string, n int64, b bool) ([]*proto.Track, error) {
-	if mock.FindTracksByFullWordFunc == nil {
-		panic("MockStorage.FindTracksByFullWordFunc: method is nil but Storage.FindTracksByFullWord was just called")
+// SaveRadioSession calls SaveRadioSessionFunc.
+func (mock *MockStorage) SaveRadioSession(key string, session *proto.RadioSession, lifetime time.Duration) error {
+	if mock.SaveRadioSessionFunc == nil {
+		panic("MockStorage.SaveRadioSessionFunc: method is nil but Storage.SaveRadioSession was just called")
 	}
 	callInfo := struct {
-		S string
-		N int64
-		B bool
+		Key      string
+		Session  *proto.RadioSession
+		Lifetime time.Duration
 	}{
-		S: s,
-		N: n,
-		B: b,
+		Key:      key,
+		Session:  session,
+		Lifetime: lifetime,
 	}
-	mock.lockFindTracksByFullWord.Lock()
-	mock.calls.FindTracksByFullWord = append(mock.calls.FindTracksByFullWord, callInfo)
-	mock.lockFindTracksByFullWord.Unlock()
-	return mock.FindTracksByFullWordFunc(s, n, b)
+	mock.lockSaveRadioSession.Lock()
+	mock.calls.SaveRadioSession = append(mock.calls.SaveRadioSession, callInfo)
+	mock.lockSaveRadioSession.Unlock()
+	return mock.SaveRadioSessionFunc(key, session, lifetime)
 }
 
-// FindTracksByFullWordCalls gets all the calls that were made to FindTracksByFullWord.
+// SaveRadioSessionCalls gets all the calls that were made to SaveRadioSession.
 // Check the length with:
-//     len(mockedStorage.FindTracksByFullWordCalls())
-func (mock *MockStorage) FindTracksByFullWordCalls() []struct {
-	S string
-	N int64
-	B bool
+//     len(mockedStorage.SaveRadioSessionCalls())
+func (mock *MockStorage) SaveRadioSessionCalls() []struct {
+	Key      string
+	Session  *proto.RadioSession
+	Lifetime time.Duration
 } {
 	var calls []struct {
-		S string
-		N int64
-		B bool
+		Key      string
+		Session  *proto.RadioSession
+		Lifetime time.Duration
 	}
-	mock.lockFindTracksByFullWord.RLock()
-	calls = mock.calls.FindTracksByFullWord
-	mock.lockFindTracksByFullWord.RUnlock()
+	mock.lockSaveRadioSession.RLock()
+	calls = mock.calls.SaveRadioSession
+	mock.lockSaveRadioSession.RUnlock()
 	return calls
 }
 
-// FindTracksByPartial calls FindTracksByPartialFunc.
-func (mock *MockStorage) FindTracksByPartial(s string, n int64, b bool) ([]*proto.Track, error) {
-	if mock.FindTracksByPartialFunc == nil {
-		panic("MockStorage.FindTracksByPartialFunc: method is nil but Storage.FindTracksByPartial was just called")
+// SaveReleaseRadarPlaylist calls SaveReleaseRadarPlaylistFunc.
+func (mock *MockStorage) SaveReleaseRadarPlaylist(userID int64, weekStart time.Time, trackIDs []int64) error {
+	if mock.SaveReleaseRadarPlaylistFunc == nil {
+		panic("MockStorage.SaveReleaseRadarPlaylistFunc: method is nil but Storage.SaveReleaseRadarPlaylist was just called")
 	}
 	callInfo := struct {
-		S string
-		N int64
-		B bool
+		UserID    int64
+		WeekStart time.Time
+		TrackIDs  []int64
 	}{
-		S: s,
-		N: n,
-		B: b,
+		UserID:    userID,
+		WeekStart: weekStart,
+		TrackIDs:  trackIDs,
 	}
-	mock.lockFindTracksByPartial.Lock()
-	mock.calls.FindTracksByPartial = append(mock.calls.FindTracksByPartial, callInfo)
-	mock.lockFindTracksByPartial.Unlock()
-	return mock.FindTracksByPartialFunc(s, n, b)
+	mock.lockSaveReleaseRadarPlaylist.Lock()
+	mock.calls.SaveReleaseRadarPlaylist = append(mock.calls.SaveReleaseRadarPlaylist, callInfo)
+	mock.lockSaveReleaseRadarPlaylist.Unlock()
+	return mock.SaveReleaseRadarPlaylistFunc(userID, weekStart, trackIDs)
 }
 
-// FindTracksByPartialCalls gets all the calls that were made to FindTracksByPartial.
+// SaveReleaseRadarPlaylistCalls gets all the calls that were made to SaveReleaseRadarPlaylist.
 // Check the length with:
-//     len(mockedStorage.FindTracksByPartialCalls())
-func (mock *MockStorage) FindTracksByPartialCalls() []struct {
-	S string
-	N int64
-	B bool
+//     len(mockedStorage.SaveReleaseRadarPlaylistCalls())
+func (mock *MockStorage) SaveReleaseRadarPlaylistCalls() []struct {
+	UserID    int64
+	WeekStart time.Time
+	TrackIDs  []int64
 } {
 	var calls []struct {
-		S string
-		N int64
-		B bool
+		UserID    int64
+		WeekStart time.Time
+		TrackIDs  []int64
 	}
-	mock.lockFindTracksByPartial.RLock()
-	calls = mock.calls.FindTracksByPartial
-	mock.lockFindTracksByPartial.RUnlock()
+	mock.lockSaveReleaseRadarPlaylist.RLock()
+	calls = mock.calls.SaveReleaseRadarPlaylist
+	mock.lockSaveReleaseRadarPlaylist.RUnlock()
 	return calls
 }
 
-// GetFavorites calls GetFavoritesFunc.
-func (mock *MockStorage) GetFavorites(userID int64) ([]*proto.Track, error) {
-	if mock.GetFavoritesFunc == nil {
-		panic("MockStorage.GetFavoritesFunc: method is nil but Storage.GetFavorites was just called")
+// SavedAlbumIDs calls SavedAlbumIDsFunc.
+func (mock *MockStorage) SavedAlbumIDs(userID int64, albumIDs []int64) ([]int64, error) {
+	if mock.SavedAlbumIDsFunc == nil {
+		panic("MockStorage.SavedAlbumIDsFunc: method is nil but Storage.SavedAlbumIDs was just called")
 	}
 	callInfo := struct {
-		UserID int64
+		UserID   int64
+		AlbumIDs []int64
 	}{
-		UserID: userID,
+		UserID:   userID,
+		AlbumIDs: albumIDs,
 	}
-	mock.lockGetFavorites.Lock()
-	mock.calls.GetFavorites = append(mock.calls.GetFavorites, callInfo)
-	mock.lockGetFavorites.Unlock()
-	return mock.GetFavoritesFunc(userID)
+	mock.lockSavedAlbumIDs.Lock()
+	mock.calls.SavedAlbumIDs = append(mock.calls.SavedAlbumIDs, callInfo)
+	mock.lockSavedAlbumIDs.Unlock()
+	return mock.SavedAlbumIDsFunc(userID, albumIDs)
 }
 
-// GetFavoritesCalls gets all the calls that were made to GetFavorites.
+// SavedAlbumIDsCalls gets all the calls that were made to SavedAlbumIDs.
 // Check the length with:
-//     len(mockedStorage.GetFavoritesCalls())
-func (mock *MockStorage) GetFavoritesCalls() []struct {
-	UserID int64
+//     len(mockedStorage.SavedAlbumIDsCalls())
+func (mock *MockStorage) SavedAlbumIDsCalls() []struct {
+	UserID   int64
+	AlbumIDs []int64
 } {
 	var calls []struct {
-		UserID int64
+		UserID   int64
+		AlbumIDs []int64
 	}
-	mock.lockGetFavorites.RLock()
-	calls = mock.calls.GetFavorites
-	mock.lockGetFavorites.RUnlock()
+	mock.lockSavedAlbumIDs.RLock()
+	calls = mock.calls.SavedAlbumIDs
+	mock.lockSavedAlbumIDs.RUnlock()
 	return calls
 }
 
-// IncrementListenCount calls IncrementListenCountFunc.
-func (mock *MockStorage) IncrementListenCount(n int64) error {
-	if mock.IncrementListenCountFunc == nil {
-		panic("MockStorage.IncrementListenCountFunc: method is nil but Storage.IncrementListenCount was just called")
+// SavedAlbums calls SavedAlbumsFunc.
+func (mock *MockStorage) SavedAlbums(userID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	if mock.SavedAlbumsFunc == nil {
+		panic("MockStorage.SavedAlbumsFunc: method is nil but Storage.SavedAlbums was just called")
 	}
 	callInfo := struct {
-		N int64
+		UserID int64
+		Page   *proto.PageRequest
 	}{
-		N: n,
+		UserID: userID,
+		Page:   page,
 	}
-	mock.lockIncrementListenCount.Lock()
-	mock.calls.IncrementListenCount = append(mock.calls.IncrementListenCount, callInfo)
-	mock.lockIncrementListenCount.Unlock()
-	return mock.IncrementListenCountFunc(n)
+	mock.lockSavedAlbums.Lock()
+	mock.calls.SavedAlbums = append(mock.calls.SavedAlbums, callInfo)
+	mock.lockSavedAlbums.Unlock()
+	return mock.SavedAlbumsFunc(userID, page)
 }
 
-// IncrementListenCountCalls gets all the calls that were made to IncrementListenCount.
+// SavedAlbumsCalls gets all the calls that were made to SavedAlbums.
 // Check the length with:
-//     len(mockedStorage.IncrementListenCountCalls())
-func (mock *MockStorage) IncrementListenCountCalls() []struct {
-	N int64
+//     len(mockedStorage.SavedAlbumsCalls())
+func (mock *MockStorage) SavedAlbumsCalls() []struct {
+	UserID int64
+	Page   *proto.PageRequest
 } {
 	var calls []struct {
-		N int64
+		UserID int64
+		Page   *proto.PageRequest
 	}
-	mock.lockIncrementListenCount.RLock()
-	calls = mock.calls.IncrementListenCount
-	mock.lockIncrementListenCount.RUnlock()
+	mock.lockSavedAlbums.RLock()
+	calls = mock.calls.SavedAlbums
+	mock.lockSavedAlbums.RUnlock()
 	return calls
 }
 
-// IsPlaylistOwner calls IsPlaylistOwnerFunc.
-func (mock *MockStorage) IsPlaylistOwner(n1 int64, n2 int64) (bool, error) {
-	if mock.IsPlaylistOwnerFunc == nil {
-		panic("MockStorage.IsPlaylistOwnerFunc: method is nil but Storage.IsPlaylistOwner was just called")
+// SearchAlbums calls SearchAlbumsFunc.
+func (mock *MockStorage) SearchAlbums(s string, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	if mock.SearchAlbumsFunc == nil {
+		panic("MockStorage.SearchAlbumsFunc: method is nil but Storage.SearchAlbums was just called")
 	}
 	callInfo := struct {
-		N1 int64
-		N2 int64
+		S           string
+		PageRequest *proto.PageRequest
 	}{
-		N1: n1,
-		N2: n2,
+		S:           s,
+		PageRequest: pageRequest,
 	}
-	mock.lockIsPlaylistOwner.Lock()
-	mock.calls.IsPlaylistOwner = append(mock.calls.IsPlaylistOwner, callInfo)
-	mock.lockIsPlaylistOwner.Unlock()
-	return mock.IsPlaylistOwnerFunc(n1, n2)
+	mock.lockSearchAlbums.Lock()
+	mock.calls.SearchAlbums = append(mock.calls.SearchAlbums, callInfo)
+	mock.lockSearchAlbums.Unlock()
+	return mock.SearchAlbumsFunc(s, pageRequest)
 }
 
-// IsPlaylistOwnerCalls gets all the calls that were made to IsPlaylistOwner.
+// SearchAlbumsCalls gets all the calls that were made to SearchAlbums.
 // Check the length with:
-//     len(mockedStorage.IsPlaylistOwnerCalls())
-func (mock *MockStorage) IsPlaylistOwnerCalls() []struct {
-	N1 int64
-	N2 int64
+//     len(mockedStorage.SearchAlbumsCalls())
+func (mock *MockStorage) SearchAlbumsCalls() []struct {
+	S           string
+	PageRequest *proto.PageRequest
 } {
 	var calls []struct {
-		N1 int64
-		N2 int64
+		S           string
+		PageRequest *proto.PageRequest
 	}
-	mock.lockIsPlaylistOwner.RLock()
-	calls = mock.calls.IsPlaylistOwner
-	mock.lockIsPlaylistOwner.RUnlock()
+	mock.lockSearchAlbums.RLock()
+	calls = mock.calls.SearchAlbums
+	mock.lockSearchAlbums.RUnlock()
 	return calls
 }
 
-// IsPlaylistPublic calls IsPlaylistPublicFunc.
-func (mock *MockStorage) IsPlaylistPublic(n int64) (bool, error) {
-	if mock.IsPlaylistPublicFunc == nil {
-		panic("MockStorage.IsPlaylistPublicFunc: method is nil but Storage.IsPlaylistPublic was just called")
+// SearchArtists calls SearchArtistsFunc.
+func (mock *MockStorage) SearchArtists(s string, pageRequest *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+	if mock.SearchArtistsFunc == nil {
+		panic("MockStorage.SearchArtistsFunc: method is nil but Storage.SearchArtists was just called")
 	}
 	callInfo := struct {
-		N int64
+		S           string
+		PageRequest *proto.PageRequest
 	}{
-		N: n,
+		S:           s,
+		PageRequest: pageRequest,
 	}
-	mock.lockIsPlaylistPublic.Lock()
-	mock.calls.IsPlaylistPublic = append(mock.calls.IsPlaylistPublic, callInfo)
-	mock.lockIsPlaylistPublic.Unlock()
-	return mock.IsPlaylistPublicFunc(n)
+	mock.lockSearchArtists.Lock()
+	mock.calls.SearchArtists = append(mock.calls.SearchArtists, callInfo)
+	mock.lockSearchArtists.Unlock()
+	return mock.SearchArtistsFunc(s, pageRequest)
 }
 
-// IsPlaylistPublicCalls gets all the calls that were made to IsPlaylistPublic.
+// SearchArtistsCalls gets all the calls that were made to SearchArtists.
 // Check the length with:
-//     len(mockedStorage.IsPlaylistPublicCalls())
-func (mock *MockStorage) IsPlaylistPublicCalls() []struct {
-	N int64
+//     len(mockedStorage.SearchArtistsCalls())
+func (mock *MockStorage) SearchArtistsCalls() []struct {
+	S           string
+	PageRequest *proto.PageRequest
 } {
 	var calls []struct {
-		N int64
+		S           string
+		PageRequest *proto.PageRequest
 	}
-	mock.lockIsPlaylistPublic.RLock()
-	calls = mock.calls.IsPlaylistPublic
-	mock.lockIsPlaylistPublic.RUnlock()
+	mock.lockSearchArtists.RLock()
+	calls = mock.calls.SearchArtists
+	mock.lockSearchArtists.RUnlock()
 	return calls
 }
 
-// IsTrackInFavorites calls IsTrackInFavoritesFunc.
-func (mock *MockStorage) IsTrackInFavorites(userID int64, trackID int64) (bool, error) {
-	if mock.IsTrackInFavoritesFunc == nil {
-		panic("MockStorage.IsTrackInFavoritesFunc: method is nil but Storage.IsTrackInFavorites was just called")
+// SearchPlaylists calls SearchPlaylistsFunc.
+func (mock *MockStorage) SearchPlaylists(s string, n int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+	if mock.SearchPlaylistsFunc == nil {
+		panic("MockStorage.SearchPlaylistsFunc: method is nil but Storage.SearchPlaylists was just called")
 	}
 	callInfo := struct {
-		UserID  int64
-		TrackID int64
+		S           string
+		N           int64
+		PageRequest *proto.PageRequest
 	}{
-		UserID:  userID,
-		TrackID: trackID,
+		S:           s,
+		N:           n,
+		PageRequest: pageRequest,
 	}
-	mock.lockIsTrackInFavorites.Lock()
-	mock.calls.IsTrackInFavorites = append(mock.calls.IsTrackInFavorites, callInfo)
-	mock.lockIsTrackInFavorites.Unlock()
-	return mock.IsTrackInFavoritesFunc(userID, trackID)
+	mock.lockSearchPlaylists.Lock()
+	mock.calls.SearchPlaylists = append(mock.calls.SearchPlaylists, callInfo)
+	mock.lockSearchPlaylists.Unlock()
+	return mock.SearchPlaylistsFunc(s, n, pageRequest)
 }
 
-// IsTrackInFavoritesCalls gets all the calls that were made to IsTrackInFavorites.
+// SearchPlaylistsCalls gets all the calls that were made to SearchPlaylists.
 // Check the length with:
-//     len(mockedStorage.IsTrackInFavoritesCalls())
-func (mock *MockStorage) IsTrackInFavoritesCalls() []struct {
-	UserID  int64
-	TrackID int64
+//     len(mockedStorage.SearchPlaylistsCalls())
+func (mock *MockStorage) SearchPlaylistsCalls() []struct {
+	S           string
+	N           int64
+	PageRequest *proto.PageRequest
 } {
 	var calls []struct {
-		UserID  int64
-		TrackID int64
+		S           string
+		N           int64
+		PageRequest *proto.PageRequest
 	}
-	mock.lockIsTrackInFavorites.RLock()
-	calls = mock.calls.IsTrackInFavorites
-	mock.lockIsTrackInFavorites.RUnlock()
+	mock.lockSearchPlaylists.RLock()
+	calls = mock.calls.SearchPlaylists
+	mock.lockSearchPlaylists.RUnlock()
 	return calls
 }
 
-// PlaylistInfo calls PlaylistInfoFunc.
-func (mock *MockStorage) PlaylistInfo(n int64) (*proto.PlaylistData, error) {
-	if mock.PlaylistInfoFunc == nil {
-		panic("MockStorage.PlaylistInfoFunc: method is nil but Storage.PlaylistInfo was just called")
+// SearchTracks calls SearchTracksFunc.
+func (mock *MockStorage) SearchTracks(s string, searchFilter *proto.SearchFilter, n int64, b bool, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	if mock.SearchTracksFunc == nil {
+		panic("MockStorage.SearchTracksFunc: method is nil but Storage.SearchTracks was just called")
 	}
 	callInfo := struct {
-		N int64
+		S            string
+		SearchFilter *proto.SearchFilter
+		N            int64
+		B            bool
+		PageRequest  *proto.PageRequest
 	}{
-		N: n,
+		S:            s,
+		SearchFilter: searchFilter,
+		N:            n,
+		B:            b,
+		PageRequest:  pageRequest,
 	}
-	mock.lockPlaylistInfo.Lock()
-	mock.calls.PlaylistInfo = append(mock.calls.PlaylistInfo, callInfo)
-	mock.lockPlaylistInfo.Unlock()
-	return mock.PlaylistInfoFunc(n)
+	mock.lockSearchTracks.Lock()
+	mock.calls.SearchTracks = append(mock.calls.SearchTracks, callInfo)
+	mock.lockSearchTracks.Unlock()
+	return mock.SearchTracksFunc(s, searchFilter, n, b, pageRequest)
 }
 
-// PlaylistInfoCalls gets all the calls that were made to PlaylistInfo.
+// SearchTracksCalls gets all the calls that were made to SearchTracks.
 // Check the length with:
-//     len(mockedStorage.PlaylistInfoCalls())
-func (mock *MockStorage) PlaylistInfoCalls() []struct {
-	N int64
+//     len(mockedStorage.SearchTracksCalls())
+func (mock *MockStorage) SearchTracksCalls() []struct {
+	S            string
+	SearchFilter *proto.SearchFilter
+	N            int64
+	B            bool
+	PageRequest  *proto.PageRequest
 } {
 	var calls []struct {
-		N int64
+		S            string
+		SearchFilter *proto.SearchFilter
+		N            int64
+		B            bool
+		PageRequest  *proto.PageRequest
 	}
-	mock.lockPlaylistInfo.RLock()
-	calls = mock.calls.PlaylistInfo
-	mock.lockPlaylistInfo.RUnlock()
+	mock.lockSearchTracks.RLock()
+	calls = mock.calls.SearchTracks
+	mock.lockSearchTracks.RUnlock()
 	return calls
 }
 
-// PlaylistTracks calls PlaylistTracksFunc.
-func (mock *MockStorage) PlaylistTracks(n1 int64, n2 int64) ([]*proto.Track, error) {
-	if mock.PlaylistTracksFunc == nil {
-		panic("MockStorage.PlaylistTracksFunc: method is nil but Storage.PlaylistTracks was just called")
+// Suggest calls SuggestFunc.
+func (mock *MockStorage) Suggest(s string, n1 int64, n2 int64) ([]*proto.Suggestion, error) {
+	if mock.SuggestFunc == nil {
+		panic("MockStorage.SuggestFunc: method is nil but Storage.Suggest was just called")
 	}
 	callInfo := struct {
+		S  string
 		N1 int64
 		N2 int64
 	}{
+		S:  s,
 		N1: n1,
 		N2: n2,
 	}
-	mock.lockPlaylistTracks.Lock()
-	mock.calls.PlaylistTracks = append(mock.calls.PlaylistTracks, callInfo)
-	mock.lockPlaylistTracks.Unlock()
-	return mock.PlaylistTracksFunc(n1, n2)
+	mock.lockSuggest.Lock()
+	mock.calls.Suggest = append(mock.calls.Suggest, callInfo)
+	mock.lockSuggest.Unlock()
+	return mock.SuggestFunc(s, n1, n2)
 }
 
-// PlaylistTracksCalls gets all the calls that were made to PlaylistTracks.
+// SuggestCalls gets all the calls that were made to Suggest.
 // Check the length with:
-//     len(mockedStorage.PlaylistTracksCalls())
-func (mock *MockStorage) PlaylistTracksCalls() []struct {
+//     len(mockedStorage.SuggestCalls())
+func (mock *MockStorage) SuggestCalls() []struct {
+	S  string
 	N1 int64
 	N2 int64
 } {
 	var calls []struct {
+		S  string
 		N1 int64
 		N2 int64
 	}
-	mock.lockPlaylistTracks.RLock()
-	calls = mock.calls.PlaylistTracks
-	mock.lockPlaylistTracks.RUnlock()
+	mock.lockSuggest.RLock()
+	calls = mock.calls.Suggest
+	mock.lockSuggest.RUnlock()
 	return calls
 }
 
-// RandomAlbums calls RandomAlbumsFunc.
-func (mock *MockStorage) RandomAlbums(n int64) (*proto.Albums, error) {
-	if mock.RandomAlbumsFunc == nil {
-		panic("MockStorage.RandomAlbumsFunc: method is nil but Storage.RandomAlbums was just called")
+// TrackFile calls TrackFileFunc.
+func (mock *MockStorage) TrackFile(n int64) (*proto.TrackFileResponse, error) {
+	if mock.TrackFileFunc == nil {
+		panic("MockStorage.TrackFileFunc: method is nil but Storage.TrackFile was just called")
 	}
 	callInfo := struct {
 		N int64
 	}{
 		N: n,
 	}
-	mock.lockRandomAlbums.Lock()
-	mock.calls.RandomAlbums = append(mock.calls.RandomAlbums, callInfo)
-	mock.lockRandomAlbums.Unlock()
-	return mock.RandomAlbumsFunc(n)
+	mock.lockTrackFile.Lock()
+	mock.calls.TrackFile = append(mock.calls.TrackFile, callInfo)
+	mock.lockTrackFile.Unlock()
+	return mock.TrackFileFunc(n)
 }
 
-// RandomAlbumsCalls gets all the calls that were made to RandomAlbums.
+// TrackFileCalls gets all the calls that were made to TrackFile.
 // Check the length with:
-//     len(mockedStorage.RandomAlbumsCalls())
-func (mock *MockStorage) RandomAlbumsCalls() []struct {
+//     len(mockedStorage.TrackFileCalls())
+func (mock *MockStorage) TrackFileCalls() []struct {
 	N int64
 } {
 	var calls []struct {
 		N int64
 	}
-	mock.lockRandomAlbums.RLock()
-	calls = mock.calls.RandomAlbums
-	mock.lockRandomAlbums.RUnlock()
+	mock.lockTrackFile.RLock()
+	calls = mock.calls.TrackFile
+	mock.lockTrackFile.RUnlock()
 	return calls
 }
 
-// RandomArtists calls RandomArtistsFunc.
-func (mock *MockStorage) RandomArtists(n int64) (*proto.Artists, error) {
-	if mock.RandomArtistsFunc == nil {
-		panic("MockStorage.RandomArtistsFunc: method is nil but Storage.RandomArtists was just called")
+// TracksByIDs calls TracksByIDsFunc.
+func (mock *MockStorage) TracksByIDs(int64s []int64, n int64, b bool) ([]*proto.Track, error) {
+	if mock.TracksByIDsFunc == nil {
+		panic("MockStorage.TracksByIDsFunc: method is nil but Storage.TracksByIDs was just called")
 	}
 	callInfo := struct {
-		N int64
+		Int64s []int64
+		N      int64
+		B      bool
 	}{
-		N: n,
+		Int64s: int64s,
+		N:      n,
+		B:      b,
 	}
-	mock.lockRandomArtists.Lock()
-	mock.calls.RandomArtists = append(mock.calls.RandomArtists, callInfo)
-	mock.lockRandomArtists.Unlock()
-	return mock.RandomArtistsFunc(n)
+	mock.lockTracksByIDs.Lock()
+	mock.calls.TracksByIDs = append(mock.calls.TracksByIDs, callInfo)
+	mock.lockTracksByIDs.Unlock()
+	return mock.TracksByIDsFunc(int64s, n, b)
 }
 
-// RandomArtistsCalls gets all the calls that were made to RandomArtists.
+// TracksByIDsCalls gets all the calls that were made to TracksByIDs.
 // Check the length with:
-//     len(mockedStorage.RandomArtistsCalls())
-func (mock *MockStorage) RandomArtistsCalls() []struct {
-	N int64
+//     len(mockedStorage.TracksByIDsCalls())
+func (mock *MockStorage) TracksByIDsCalls() []struct {
+	Int64s []int64
+	N      int64
+	B      bool
 } {
 	var calls []struct {
-		N int64
+		Int64s []int64
+		N      int64
+		B      bool
 	}
-	mock.lockRandomArtists.RLock()
-	calls = mock.calls.RandomArtists
-	mock.lockRandomArtists.RUnlock()
+	mock.lockTracksByIDs.RLock()
+	calls = mock.calls.TracksByIDs
+	mock.lockTracksByIDs.RUnlock()
 	return calls
 }
 
-// RandomTracks calls RandomTracksFunc.
-func (mock *MockStorage) RandomTracks(n1 int64, n2 int64, b bool) (*proto.Tracks, error) {
-	if mock.RandomTracksFunc == nil {
-		panic("MockStorage.RandomTracksFunc: method is nil but Storage.RandomTracks was just called")
+// UnfollowArtist calls UnfollowArtistFunc.
+func (mock *MockStorage) UnfollowArtist(userID int64, artistID int64) error {
+	if mock.UnfollowArtistFunc == nil {
+		panic("MockStorage.UnfollowArtistFunc: method is nil but Storage.UnfollowArtist was just called")
 	}
 	callInfo := struct {
-		N1 int64
-		N2 int64
-		B  bool
+		UserID   int64
+		ArtistID int64
 	}{
-		N1: n1,
-		N2: n2,
-		B:  b,
+		UserID:   userID,
+		ArtistID: artistID,
 	}
-	mock.lockRandomTracks.Lock()
-	mock.calls.RandomTracks = append(mock.calls.RandomTracks, callInfo)
-	mock.lockRandomTracks.Unlock()
-	return mock.RandomTracksFunc(n1, n2, b)
+	mock.lockUnfollowArtist.Lock()
+	mock.calls.UnfollowArtist = append(mock.calls.UnfollowArtist, callInfo)
+	mock.lockUnfollowArtist.Unlock()
+	return mock.UnfollowArtistFunc(userID, artistID)
 }
 
-// RandomTracksCalls gets all the calls that were made to RandomTracks.
+// UnfollowArtistCalls gets all the calls that were made to UnfollowArtist.
 // Check the length with:
-//     len(mockedStorage.RandomTracksCalls())
-func (mock *MockStorage) RandomTracksCalls() []struct {
-	N1 int64
-	N2 int64
-	B  bool
+//     len(mockedStorage.UnfollowArtistCalls())
+func (mock *MockStorage) UnfollowArtistCalls() []struct {
+	UserID   int64
+	ArtistID int64
 } {
 	var calls []struct {
-		N1 int64
-		N2 int64
-		B  bool
+		UserID   int64
+		ArtistID int64
 	}
-	mock.lockRandomTracks.RLock()
-	calls = mock.calls.RandomTracks
-	mock.lockRandomTracks.RUnlock()
+	mock.lockUnfollowArtist.RLock()
+	calls = mock.calls.UnfollowArtist
+	mock.lockUnfollowArtist.RUnlock()
+	return calls
+}
+
+// UnsaveAlbum calls UnsaveAlbumFunc.
+func (mock *MockStorage) UnsaveAlbum(userID int64, albumID int64) error {
+	if mock.UnsaveAlbumFunc == nil {
+		panic("MockStorage.UnsaveAlbumFunc: method is nil but Storage.UnsaveAlbum was just called")
+	}
+	callInfo := struct {
+		UserID  int64
+		AlbumID int64
+	}{
+		UserID:  userID,
+		AlbumID: albumID,
+	}
+	mock.lockUnsaveAlbum.Lock()
+	mock.calls.UnsaveAlbum = append(mock.calls.UnsaveAlbum, callInfo)
+	mock.lockUnsaveAlbum.Unlock()
+	return mock.UnsaveAlbumFunc(userID, albumID)
+}
+
+// UnsaveAlbumCalls gets all the calls that were made to UnsaveAlbum.
+// Check the length with:
+//     len(mockedStorage.UnsaveAlbumCalls())
+func (mock *MockStorage) UnsaveAlbumCalls() []struct {
+	UserID  int64
+	AlbumID int64
+} {
+	var calls []struct {
+		UserID  int64
+		AlbumID int64
+	}
+	mock.lockUnsaveAlbum.RLock()
+	calls = mock.calls.UnsaveAlbum
+	mock.lockUnsaveAlbum.RUnlock()
 	return calls
 }
 
 // UserPlaylists calls UserPlaylistsFunc.
-func (mock *MockStorage) UserPlaylists(n int64) ([]*proto.PlaylistData, error) {
+func (mock *MockStorage) UserPlaylists(n int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
 	if mock.UserPlaylistsFunc == nil {
 		panic("MockStorage.UserPlaylistsFunc: method is nil but Storage.UserPlaylists was just called")
 	}
 	callInfo := struct {
-		N int64
+		N           int64
+		PageRequest *proto.PageRequest
 	}{
-		N: n,
+		N:           n,
+		PageRequest: pageRequest,
 	}
 	mock.lockUserPlaylists.Lock()
 	mock.calls.UserPlaylists = append(mock.calls.UserPlaylists, callInfo)
 	mock.lockUserPlaylists.Unlock()
-	return mock.UserPlaylistsFunc(n)
+	return mock.UserPlaylistsFunc(n, pageRequest)
 }
 
 // UserPlaylistsCalls gets all the calls that were made to UserPlaylists.
 // Check the length with:
 //     len(mockedStorage.UserPlaylistsCalls())
 func (mock *MockStorage) UserPlaylistsCalls() []struct {
-	N int64
+	N           int64
+	PageRequest *proto.PageRequest
 } {
 	var calls []struct {
-		N int64
+		N           int64
+		PageRequest *proto.PageRequest
 	}
 	mock.lockUserPlaylists.RLock()
 	calls = mock.calls.UserPlaylists
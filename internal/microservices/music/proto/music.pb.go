@@ -24,18 +24,17 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type RandomTracksOptions struct {
+type PageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount       int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	UserID       int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	IsAuthorized bool  `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Limit  int64  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 }
 
-func (x *RandomTracksOptions) Reset() {
-	*x = RandomTracksOptions{}
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -43,13 +42,13 @@ func (x *RandomTracksOptions) Reset() {
 	}
 }
 
-func (x *RandomTracksOptions) String() string {
+func (x *PageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandomTracksOptions) ProtoMessage() {}
+func (*PageRequest) ProtoMessage() {}
 
-func (x *RandomTracksOptions) ProtoReflect() protoreflect.Message {
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -61,42 +60,36 @@ func (x *RandomTracksOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandomTracksOptions.ProtoReflect.Descriptor instead.
-func (*RandomTracksOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *RandomTracksOptions) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *RandomTracksOptions) GetUserID() int64 {
+func (x *PageRequest) GetLimit() int64 {
 	if x != nil {
-		return x.UserID
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *RandomTracksOptions) GetIsAuthorized() bool {
+func (x *PageRequest) GetCursor() string {
 	if x != nil {
-		return x.IsAuthorized
+		return x.Cursor
 	}
-	return false
+	return ""
 }
 
-type RandomAlbumsOptions struct {
+type PageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	NextCursor string `protobuf:"bytes,1,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	TotalHint  int64  `protobuf:"varint,2,opt,name=TotalHint,proto3" json:"TotalHint,omitempty"`
 }
 
-func (x *RandomAlbumsOptions) Reset() {
-	*x = RandomAlbumsOptions{}
+func (x *PageResponse) Reset() {
+	*x = PageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -104,13 +97,13 @@ func (x *RandomAlbumsOptions) Reset() {
 	}
 }
 
-func (x *RandomAlbumsOptions) String() string {
+func (x *PageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandomAlbumsOptions) ProtoMessage() {}
+func (*PageResponse) ProtoMessage() {}
 
-func (x *RandomAlbumsOptions) ProtoReflect() protoreflect.Message {
+func (x *PageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -122,28 +115,36 @@ func (x *RandomAlbumsOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandomAlbumsOptions.ProtoReflect.Descriptor instead.
-func (*RandomAlbumsOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use PageResponse.ProtoReflect.Descriptor instead.
+func (*PageResponse) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RandomAlbumsOptions) GetAmount() int64 {
+func (x *PageResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Amount
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *PageResponse) GetTotalHint() int64 {
+	if x != nil {
+		return x.TotalHint
 	}
 	return 0
 }
 
-type RandomArtistsOptions struct {
+type Selection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Seed       int64 `protobuf:"varint,1,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Generation int64 `protobuf:"varint,2,opt,name=Generation,proto3" json:"Generation,omitempty"`
 }
 
-func (x *RandomArtistsOptions) Reset() {
-	*x = RandomArtistsOptions{}
+func (x *Selection) Reset() {
+	*x = Selection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -151,13 +152,13 @@ func (x *RandomArtistsOptions) Reset() {
 	}
 }
 
-func (x *RandomArtistsOptions) String() string {
+func (x *Selection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandomArtistsOptions) ProtoMessage() {}
+func (*Selection) ProtoMessage() {}
 
-func (x *RandomArtistsOptions) ProtoReflect() protoreflect.Message {
+func (x *Selection) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -169,28 +170,40 @@ func (x *RandomArtistsOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandomArtistsOptions.ProtoReflect.Descriptor instead.
-func (*RandomArtistsOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use Selection.ProtoReflect.Descriptor instead.
+func (*Selection) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RandomArtistsOptions) GetAmount() int64 {
+func (x *Selection) GetSeed() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Seed
 	}
 	return 0
 }
 
-type IncrementListenCountOptions struct {
+func (x *Selection) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+type RandomTracksOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Amount       int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	UserID       int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool  `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Seed         int64 `protobuf:"varint,4,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Page         int64 `protobuf:"varint,5,opt,name=Page,proto3" json:"Page,omitempty"`
+	Generation   int64 `protobuf:"varint,6,opt,name=Generation,proto3" json:"Generation,omitempty"`
 }
 
-func (x *IncrementListenCountOptions) Reset() {
-	*x = IncrementListenCountOptions{}
+func (x *RandomTracksOptions) Reset() {
+	*x = RandomTracksOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -198,13 +211,13 @@ func (x *IncrementListenCountOptions) Reset() {
 	}
 }
 
-func (x *IncrementListenCountOptions) String() string {
+func (x *RandomTracksOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementListenCountOptions) ProtoMessage() {}
+func (*RandomTracksOptions) ProtoMessage() {}
 
-func (x *IncrementListenCountOptions) ProtoReflect() protoreflect.Message {
+func (x *RandomTracksOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -216,30 +229,68 @@ func (x *IncrementListenCountOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementListenCountOptions.ProtoReflect.Descriptor instead.
-func (*IncrementListenCountOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandomTracksOptions.ProtoReflect.Descriptor instead.
+func (*RandomTracksOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IncrementListenCountOptions) GetID() int64 {
+func (x *RandomTracksOptions) GetAmount() int64 {
 	if x != nil {
-		return x.ID
+		return x.Amount
 	}
 	return 0
 }
 
-type ArtistProfileOptions struct {
+func (x *RandomTracksOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RandomTracksOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+func (x *RandomTracksOptions) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RandomTracksOptions) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RandomTracksOptions) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+type RandomAlbumsOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArtistID     int64 `protobuf:"varint,1,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
-	UserID       int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	IsAuthorized bool  `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Amount       int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Seed         int64 `protobuf:"varint,2,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Page         int64 `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	Generation   int64 `protobuf:"varint,4,opt,name=Generation,proto3" json:"Generation,omitempty"`
+	UserID       int64 `protobuf:"varint,5,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool  `protobuf:"varint,6,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
 }
 
-func (x *ArtistProfileOptions) Reset() {
-	*x = ArtistProfileOptions{}
+func (x *RandomAlbumsOptions) Reset() {
+	*x = RandomAlbumsOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,13 +298,13 @@ func (x *ArtistProfileOptions) Reset() {
 	}
 }
 
-func (x *ArtistProfileOptions) String() string {
+func (x *RandomAlbumsOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArtistProfileOptions) ProtoMessage() {}
+func (*RandomAlbumsOptions) ProtoMessage() {}
 
-func (x *ArtistProfileOptions) ProtoReflect() protoreflect.Message {
+func (x *RandomAlbumsOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -265,44 +316,68 @@ func (x *ArtistProfileOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArtistProfileOptions.ProtoReflect.Descriptor instead.
-func (*ArtistProfileOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandomAlbumsOptions.ProtoReflect.Descriptor instead.
+func (*RandomAlbumsOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ArtistProfileOptions) GetArtistID() int64 {
+func (x *RandomAlbumsOptions) GetAmount() int64 {
 	if x != nil {
-		return x.ArtistID
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ArtistProfileOptions) GetUserID() int64 {
+func (x *RandomAlbumsOptions) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RandomAlbumsOptions) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RandomAlbumsOptions) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *RandomAlbumsOptions) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *ArtistProfileOptions) GetIsAuthorized() bool {
+func (x *RandomAlbumsOptions) GetIsAuthorized() bool {
 	if x != nil {
 		return x.IsAuthorized
 	}
 	return false
 }
 
-type AlbumPageOptions struct {
+type RandomArtistsOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AlbumID      int64 `protobuf:"varint,1,opt,name=AlbumID,proto3" json:"AlbumID,omitempty"`
-	UserID       int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	IsAuthorized bool  `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Amount       int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Seed         int64 `protobuf:"varint,2,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Page         int64 `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	Generation   int64 `protobuf:"varint,4,opt,name=Generation,proto3" json:"Generation,omitempty"`
+	UserID       int64 `protobuf:"varint,5,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool  `protobuf:"varint,6,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
 }
 
-func (x *AlbumPageOptions) Reset() {
-	*x = AlbumPageOptions{}
+func (x *RandomArtistsOptions) Reset() {
+	*x = RandomArtistsOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,13 +385,13 @@ func (x *AlbumPageOptions) Reset() {
 	}
 }
 
-func (x *AlbumPageOptions) String() string {
+func (x *RandomArtistsOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlbumPageOptions) ProtoMessage() {}
+func (*RandomArtistsOptions) ProtoMessage() {}
 
-func (x *AlbumPageOptions) ProtoReflect() protoreflect.Message {
+func (x *RandomArtistsOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,44 +403,65 @@ func (x *AlbumPageOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlbumPageOptions.ProtoReflect.Descriptor instead.
-func (*AlbumPageOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandomArtistsOptions.ProtoReflect.Descriptor instead.
+func (*RandomArtistsOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AlbumPageOptions) GetAlbumID() int64 {
+func (x *RandomArtistsOptions) GetAmount() int64 {
 	if x != nil {
-		return x.AlbumID
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *AlbumPageOptions) GetUserID() int64 {
+func (x *RandomArtistsOptions) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RandomArtistsOptions) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RandomArtistsOptions) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *RandomArtistsOptions) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *AlbumPageOptions) GetIsAuthorized() bool {
+func (x *RandomArtistsOptions) GetIsAuthorized() bool {
 	if x != nil {
 		return x.IsAuthorized
 	}
 	return false
 }
 
-type FindOptions struct {
+type IncrementListenCountOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text         string `protobuf:"bytes,1,opt,name=Text,proto3" json:"Text,omitempty"`
-	UserID       int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	IsAuthorized bool   `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	ID     int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IP     string `protobuf:"bytes,3,opt,name=IP,proto3" json:"IP,omitempty"`
 }
 
-func (x *FindOptions) Reset() {
-	*x = FindOptions{}
+func (x *IncrementListenCountOptions) Reset() {
+	*x = IncrementListenCountOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,13 +469,13 @@ func (x *FindOptions) Reset() {
 	}
 }
 
-func (x *FindOptions) String() string {
+func (x *IncrementListenCountOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindOptions) ProtoMessage() {}
+func (*IncrementListenCountOptions) ProtoMessage() {}
 
-func (x *FindOptions) ProtoReflect() protoreflect.Message {
+func (x *IncrementListenCountOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,42 +487,44 @@ func (x *FindOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindOptions.ProtoReflect.Descriptor instead.
-func (*FindOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrementListenCountOptions.ProtoReflect.Descriptor instead.
+func (*IncrementListenCountOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *FindOptions) GetText() string {
+func (x *IncrementListenCountOptions) GetID() int64 {
 	if x != nil {
-		return x.Text
+		return x.ID
 	}
-	return ""
+	return 0
 }
 
-func (x *FindOptions) GetUserID() int64 {
+func (x *IncrementListenCountOptions) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *FindOptions) GetIsAuthorized() bool {
+func (x *IncrementListenCountOptions) GetIP() string {
 	if x != nil {
-		return x.IsAuthorized
+		return x.IP
 	}
-	return false
+	return ""
 }
 
-type UserPlaylistsOptions struct {
+type ArtistProfileOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ArtistID     int64 `protobuf:"varint,1,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	UserID       int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool  `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
 }
 
-func (x *UserPlaylistsOptions) Reset() {
-	*x = UserPlaylistsOptions{}
+func (x *ArtistProfileOptions) Reset() {
+	*x = ArtistProfileOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -434,13 +532,13 @@ func (x *UserPlaylistsOptions) Reset() {
 	}
 }
 
-func (x *UserPlaylistsOptions) String() string {
+func (x *ArtistProfileOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPlaylistsOptions) ProtoMessage() {}
+func (*ArtistProfileOptions) ProtoMessage() {}
 
-func (x *UserPlaylistsOptions) ProtoReflect() protoreflect.Message {
+func (x *ArtistProfileOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -452,29 +550,45 @@ func (x *UserPlaylistsOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPlaylistsOptions.ProtoReflect.Descriptor instead.
-func (*UserPlaylistsOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArtistProfileOptions.ProtoReflect.Descriptor instead.
+func (*ArtistProfileOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UserPlaylistsOptions) GetUserID() int64 {
+func (x *ArtistProfileOptions) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *ArtistProfileOptions) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-type PlaylistPageOptions struct {
+func (x *ArtistProfileOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+type AlbumPageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlaylistID int64 `protobuf:"varint,1,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
-	UserID     int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	AlbumID      int64        `protobuf:"varint,1,opt,name=AlbumID,proto3" json:"AlbumID,omitempty"`
+	UserID       int64        `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool         `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Page         *PageRequest `protobuf:"bytes,4,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func (x *PlaylistPageOptions) Reset() {
-	*x = PlaylistPageOptions{}
+func (x *AlbumPageOptions) Reset() {
+	*x = AlbumPageOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,13 +596,13 @@ func (x *PlaylistPageOptions) Reset() {
 	}
 }
 
-func (x *PlaylistPageOptions) String() string {
+func (x *AlbumPageOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaylistPageOptions) ProtoMessage() {}
+func (*AlbumPageOptions) ProtoMessage() {}
 
-func (x *PlaylistPageOptions) ProtoReflect() protoreflect.Message {
+func (x *AlbumPageOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -500,42 +614,60 @@ func (x *PlaylistPageOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaylistPageOptions.ProtoReflect.Descriptor instead.
-func (*PlaylistPageOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use AlbumPageOptions.ProtoReflect.Descriptor instead.
+func (*AlbumPageOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PlaylistPageOptions) GetPlaylistID() int64 {
+func (x *AlbumPageOptions) GetAlbumID() int64 {
 	if x != nil {
-		return x.PlaylistID
+		return x.AlbumID
 	}
 	return 0
 }
 
-func (x *PlaylistPageOptions) GetUserID() int64 {
+func (x *AlbumPageOptions) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-type Album struct {
+func (x *AlbumPageOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+func (x *AlbumPageOptions) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SearchFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Title          string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Year           int64  `protobuf:"varint,3,opt,name=Year,proto3" json:"Year,omitempty"`
-	Artist         string `protobuf:"bytes,4,opt,name=Artist,proto3" json:"Artist,omitempty"`
-	Artwork        string `protobuf:"bytes,5,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
-	TracksAmount   int64  `protobuf:"varint,6,opt,name=TracksAmount,proto3" json:"TracksAmount,omitempty"`
-	TracksDuration int64  `protobuf:"varint,7,opt,name=TracksDuration,proto3" json:"TracksDuration,omitempty"`
-	ArtworkColor   string `protobuf:"bytes,8,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
-}
-
-func (x *Album) Reset() {
-	*x = Album{}
+	GenreID      int64  `protobuf:"varint,1,opt,name=GenreID,proto3" json:"GenreID,omitempty"`
+	Genre        string `protobuf:"bytes,2,opt,name=Genre,proto3" json:"Genre,omitempty"`
+	ArtistID     int64  `protobuf:"varint,3,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	Artist       string `protobuf:"bytes,4,opt,name=Artist,proto3" json:"Artist,omitempty"`
+	Album        string `protobuf:"bytes,5,opt,name=Album,proto3" json:"Album,omitempty"`
+	YearFrom     int64  `protobuf:"varint,6,opt,name=YearFrom,proto3" json:"YearFrom,omitempty"`
+	YearTo       int64  `protobuf:"varint,7,opt,name=YearTo,proto3" json:"YearTo,omitempty"`
+	DurationFrom int64  `protobuf:"varint,8,opt,name=DurationFrom,proto3" json:"DurationFrom,omitempty"`
+	DurationTo   int64  `protobuf:"varint,9,opt,name=DurationTo,proto3" json:"DurationTo,omitempty"`
+	Explicit     *bool  `protobuf:"varint,10,opt,name=Explicit,proto3,oneof" json:"Explicit,omitempty"`
+	Lossless     *bool  `protobuf:"varint,11,opt,name=Lossless,proto3,oneof" json:"Lossless,omitempty"`
+	Lyrics       string `protobuf:"bytes,12,opt,name=Lyrics,proto3" json:"Lyrics,omitempty"`
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,13 +675,13 @@ func (x *Album) Reset() {
 	}
 }
 
-func (x *Album) String() string {
+func (x *SearchFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Album) ProtoMessage() {}
+func (*SearchFilter) ProtoMessage() {}
 
-func (x *Album) ProtoReflect() protoreflect.Message {
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -561,82 +693,109 @@ func (x *Album) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Album.ProtoReflect.Descriptor instead.
-func (*Album) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Album) GetID() int64 {
+func (x *SearchFilter) GetGenreID() int64 {
 	if x != nil {
-		return x.ID
+		return x.GenreID
 	}
 	return 0
 }
 
-func (x *Album) GetTitle() string {
+func (x *SearchFilter) GetGenre() string {
 	if x != nil {
-		return x.Title
+		return x.Genre
 	}
 	return ""
 }
 
-func (x *Album) GetYear() int64 {
+func (x *SearchFilter) GetArtistID() int64 {
 	if x != nil {
-		return x.Year
+		return x.ArtistID
 	}
 	return 0
 }
 
-func (x *Album) GetArtist() string {
+func (x *SearchFilter) GetArtist() string {
 	if x != nil {
 		return x.Artist
 	}
 	return ""
 }
 
-func (x *Album) GetArtwork() string {
+func (x *SearchFilter) GetAlbum() string {
 	if x != nil {
-		return x.Artwork
+		return x.Album
 	}
 	return ""
 }
 
-func (x *Album) GetTracksAmount() int64 {
+func (x *SearchFilter) GetYearFrom() int64 {
 	if x != nil {
-		return x.TracksAmount
+		return x.YearFrom
 	}
 	return 0
 }
 
-func (x *Album) GetTracksDuration() int64 {
+func (x *SearchFilter) GetYearTo() int64 {
 	if x != nil {
-		return x.TracksDuration
+		return x.YearTo
 	}
 	return 0
 }
 
-func (x *Album) GetArtworkColor() string {
+func (x *SearchFilter) GetDurationFrom() int64 {
 	if x != nil {
-		return x.ArtworkColor
+		return x.DurationFrom
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetDurationTo() int64 {
+	if x != nil {
+		return x.DurationTo
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetExplicit() bool {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return false
+}
+
+func (x *SearchFilter) GetLossless() bool {
+	if x != nil && x.Lossless != nil {
+		return *x.Lossless
+	}
+	return false
+}
+
+func (x *SearchFilter) GetLyrics() string {
+	if x != nil {
+		return x.Lyrics
 	}
 	return ""
 }
 
-type Artist struct {
+type FindOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID     int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name   string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Avatar string   `protobuf:"bytes,3,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
-	Video  string   `protobuf:"bytes,4,opt,name=Video,proto3" json:"Video,omitempty"`
-	Tracks []*Track `protobuf:"bytes,5,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
-	Albums []*Album `protobuf:"bytes,6,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Text         string        `protobuf:"bytes,1,opt,name=Text,proto3" json:"Text,omitempty"`
+	UserID       int64         `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool          `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Page         *PageRequest  `protobuf:"bytes,4,opt,name=Page,proto3" json:"Page,omitempty"`
+	Filter       *SearchFilter `protobuf:"bytes,5,opt,name=Filter,proto3" json:"Filter,omitempty"`
 }
 
-func (x *Artist) Reset() {
-	*x = Artist{}
+func (x *FindOptions) Reset() {
+	*x = FindOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -644,13 +803,13 @@ func (x *Artist) Reset() {
 	}
 }
 
-func (x *Artist) String() string {
+func (x *FindOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Artist) ProtoMessage() {}
+func (*FindOptions) ProtoMessage() {}
 
-func (x *Artist) ProtoReflect() protoreflect.Message {
+func (x *FindOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,74 +821,57 @@ func (x *Artist) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Artist.ProtoReflect.Descriptor instead.
-func (*Artist) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindOptions.ProtoReflect.Descriptor instead.
+func (*FindOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Artist) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Artist) GetName() string {
+func (x *FindOptions) GetText() string {
 	if x != nil {
-		return x.Name
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Artist) GetAvatar() string {
+func (x *FindOptions) GetUserID() int64 {
 	if x != nil {
-		return x.Avatar
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *Artist) GetVideo() string {
+func (x *FindOptions) GetIsAuthorized() bool {
 	if x != nil {
-		return x.Video
+		return x.IsAuthorized
 	}
-	return ""
+	return false
 }
 
-func (x *Artist) GetTracks() []*Track {
+func (x *FindOptions) GetPage() *PageRequest {
 	if x != nil {
-		return x.Tracks
+		return x.Page
 	}
 	return nil
 }
 
-func (x *Artist) GetAlbums() []*Album {
+func (x *FindOptions) GetFilter() *SearchFilter {
 	if x != nil {
-		return x.Albums
+		return x.Filter
 	}
 	return nil
 }
 
-type Track struct {
+type UserPlaylistsOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Title         string  `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Explicit      bool    `protobuf:"varint,3,opt,name=Explicit,proto3" json:"Explicit,omitempty"`
-	Genre         string  `protobuf:"bytes,4,opt,name=Genre,proto3" json:"Genre,omitempty"`
-	Number        int64   `protobuf:"varint,5,opt,name=Number,proto3" json:"Number,omitempty"`
-	File          string  `protobuf:"bytes,6,opt,name=File,proto3" json:"File,omitempty"`
-	ListenCount   int64   `protobuf:"varint,7,opt,name=ListenCount,proto3" json:"ListenCount,omitempty"`
-	Duration      int64   `protobuf:"varint,8,opt,name=Duration,proto3" json:"Duration,omitempty"`
-	Lossless      bool    `protobuf:"varint,9,opt,name=Lossless,proto3" json:"Lossless,omitempty"`
-	Album         *Album  `protobuf:"bytes,10,opt,name=Album,proto3" json:"Album,omitempty"`
-	Artist        *Artist `protobuf:"bytes,11,opt,name=Artist,proto3" json:"Artist,omitempty"`
-	IsInFavorites bool    `protobuf:"varint,12,opt,name=IsInFavorites,proto3" json:"IsInFavorites,omitempty"`
+	UserID int64        `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Page   *PageRequest `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func (x *Track) Reset() {
-	*x = Track{}
+func (x *UserPlaylistsOptions) Reset() {
+	*x = UserPlaylistsOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -737,13 +879,13 @@ func (x *Track) Reset() {
 	}
 }
 
-func (x *Track) String() string {
+func (x *UserPlaylistsOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Track) ProtoMessage() {}
+func (*UserPlaylistsOptions) ProtoMessage() {}
 
-func (x *Track) ProtoReflect() protoreflect.Message {
+func (x *UserPlaylistsOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -755,129 +897,122 @@ func (x *Track) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Track.ProtoReflect.Descriptor instead.
-func (*Track) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserPlaylistsOptions.ProtoReflect.Descriptor instead.
+func (*UserPlaylistsOptions) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Track) GetID() int64 {
+func (x *UserPlaylistsOptions) GetUserID() int64 {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *Track) GetTitle() string {
+func (x *UserPlaylistsOptions) GetPage() *PageRequest {
 	if x != nil {
-		return x.Title
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *Track) GetExplicit() bool {
-	if x != nil {
-		return x.Explicit
-	}
-	return false
+type PlaylistPageOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaylistID int64        `protobuf:"varint,1,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
+	UserID     int64        `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Page       *PageRequest `protobuf:"bytes,3,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func (x *Track) GetGenre() string {
-	if x != nil {
-		return x.Genre
+func (x *PlaylistPageOptions) Reset() {
+	*x = PlaylistPageOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Track) GetNumber() int64 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
+func (x *PlaylistPageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Track) GetFile() string {
-	if x != nil {
-		return x.File
+func (*PlaylistPageOptions) ProtoMessage() {}
+
+func (x *PlaylistPageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Track) GetListenCount() int64 {
-	if x != nil {
-		return x.ListenCount
-	}
-	return 0
+// Deprecated: Use PlaylistPageOptions.ProtoReflect.Descriptor instead.
+func (*PlaylistPageOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Track) GetDuration() int64 {
+func (x *PlaylistPageOptions) GetPlaylistID() int64 {
 	if x != nil {
-		return x.Duration
+		return x.PlaylistID
 	}
 	return 0
 }
 
-func (x *Track) GetLossless() bool {
+func (x *PlaylistPageOptions) GetUserID() int64 {
 	if x != nil {
-		return x.Lossless
+		return x.UserID
 	}
-	return false
+	return 0
 }
 
-func (x *Track) GetAlbum() *Album {
+func (x *PlaylistPageOptions) GetPage() *PageRequest {
 	if x != nil {
-		return x.Album
+		return x.Page
 	}
 	return nil
 }
 
-func (x *Track) GetArtist() *Artist {
-	if x != nil {
-		return x.Artist
-	}
-	return nil
+type Album struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title          string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Year           int64  `protobuf:"varint,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	Artist         string `protobuf:"bytes,4,opt,name=Artist,proto3" json:"Artist,omitempty"`
+	Artwork        string `protobuf:"bytes,5,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	TracksAmount   int64  `protobuf:"varint,6,opt,name=TracksAmount,proto3" json:"TracksAmount,omitempty"`
+	TracksDuration int64  `protobuf:"varint,7,opt,name=TracksDuration,proto3" json:"TracksDuration,omitempty"`
+	ArtworkColor   string `protobuf:"bytes,8,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+	IsSaved        bool   `protobuf:"varint,9,opt,name=IsSaved,proto3" json:"IsSaved,omitempty"`
+	ReleaseDate    string `protobuf:"bytes,10,opt,name=ReleaseDate,proto3" json:"ReleaseDate,omitempty"`
 }
 
-func (x *Track) GetIsInFavorites() bool {
-	if x != nil {
-		return x.IsInFavorites
+func (x *Album) Reset() {
+	*x = Album{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-type AlbumTrack struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID            int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Title         string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Explicit      bool   `protobuf:"varint,3,opt,name=Explicit,proto3" json:"Explicit,omitempty"`
-	Genre         string `protobuf:"bytes,4,opt,name=Genre,proto3" json:"Genre,omitempty"`
-	Number        int64  `protobuf:"varint,5,opt,name=Number,proto3" json:"Number,omitempty"`
-	File          string `protobuf:"bytes,6,opt,name=File,proto3" json:"File,omitempty"`
-	ListenCount   int64  `protobuf:"varint,7,opt,name=ListenCount,proto3" json:"ListenCount,omitempty"`
-	Duration      int64  `protobuf:"varint,8,opt,name=Duration,proto3" json:"Duration,omitempty"`
-	Lossless      bool   `protobuf:"varint,9,opt,name=Lossless,proto3" json:"Lossless,omitempty"`
-	IsInFavorites bool   `protobuf:"varint,10,opt,name=IsInFavorites,proto3" json:"IsInFavorites,omitempty"`
-}
-
-func (x *AlbumTrack) Reset() {
-	*x = AlbumTrack{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AlbumTrack) String() string {
+func (x *Album) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlbumTrack) ProtoMessage() {}
+func (*Album) ProtoMessage() {}
 
-func (x *AlbumTrack) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[12]
+func (x *Album) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,111 +1023,115 @@ func (x *AlbumTrack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlbumTrack.ProtoReflect.Descriptor instead.
-func (*AlbumTrack) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Album.ProtoReflect.Descriptor instead.
+func (*Album) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AlbumTrack) GetID() int64 {
+func (x *Album) GetID() int64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *AlbumTrack) GetTitle() string {
+func (x *Album) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *AlbumTrack) GetExplicit() bool {
+func (x *Album) GetYear() int64 {
 	if x != nil {
-		return x.Explicit
+		return x.Year
 	}
-	return false
+	return 0
 }
 
-func (x *AlbumTrack) GetGenre() string {
+func (x *Album) GetArtist() string {
 	if x != nil {
-		return x.Genre
+		return x.Artist
 	}
 	return ""
 }
 
-func (x *AlbumTrack) GetNumber() int64 {
+func (x *Album) GetArtwork() string {
 	if x != nil {
-		return x.Number
+		return x.Artwork
 	}
-	return 0
+	return ""
 }
 
-func (x *AlbumTrack) GetFile() string {
+func (x *Album) GetTracksAmount() int64 {
 	if x != nil {
-		return x.File
+		return x.TracksAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *AlbumTrack) GetListenCount() int64 {
+func (x *Album) GetTracksDuration() int64 {
 	if x != nil {
-		return x.ListenCount
+		return x.TracksDuration
 	}
 	return 0
 }
 
-func (x *AlbumTrack) GetDuration() int64 {
+func (x *Album) GetArtworkColor() string {
 	if x != nil {
-		return x.Duration
+		return x.ArtworkColor
 	}
-	return 0
+	return ""
 }
 
-func (x *AlbumTrack) GetLossless() bool {
+func (x *Album) GetIsSaved() bool {
 	if x != nil {
-		return x.Lossless
+		return x.IsSaved
 	}
 	return false
 }
 
-func (x *AlbumTrack) GetIsInFavorites() bool {
+func (x *Album) GetReleaseDate() string {
 	if x != nil {
-		return x.IsInFavorites
+		return x.ReleaseDate
 	}
-	return false
+	return ""
 }
 
-type PlaylistData struct {
+type Artist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlaylistID   int64  `protobuf:"varint,1,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
-	Title        string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Artwork      string `protobuf:"bytes,3,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
-	ArtworkColor string `protobuf:"bytes,4,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
-	IsPublic     bool   `protobuf:"varint,5,opt,name=IsPublic,proto3" json:"IsPublic,omitempty"`
-	IsOwn        bool   `protobuf:"varint,6,opt,name=IsOwn,proto3" json:"IsOwn,omitempty"`
+	ID             int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name           string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Avatar         string   `protobuf:"bytes,3,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	Video          string   `protobuf:"bytes,4,opt,name=Video,proto3" json:"Video,omitempty"`
+	Tracks         []*Track `protobuf:"bytes,5,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Albums         []*Album `protobuf:"bytes,6,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Bio            string   `protobuf:"bytes,7,opt,name=Bio,proto3" json:"Bio,omitempty"`
+	AvatarColor    string   `protobuf:"bytes,8,opt,name=AvatarColor,proto3" json:"AvatarColor,omitempty"`
+	IsFollowed     bool     `protobuf:"varint,9,opt,name=IsFollowed,proto3" json:"IsFollowed,omitempty"`
+	FollowersCount int64    `protobuf:"varint,10,opt,name=FollowersCount,proto3" json:"FollowersCount,omitempty"`
 }
 
-func (x *PlaylistData) Reset() {
-	*x = PlaylistData{}
+func (x *Artist) Reset() {
+	*x = Artist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[13]
+		mi := &file_music_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlaylistData) String() string {
+func (x *Artist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaylistData) ProtoMessage() {}
+func (*Artist) ProtoMessage() {}
 
-func (x *PlaylistData) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[13]
+func (x *Artist) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,86 +1142,120 @@ func (x *PlaylistData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaylistData.ProtoReflect.Descriptor instead.
-func (*PlaylistData) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Artist.ProtoReflect.Descriptor instead.
+func (*Artist) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PlaylistData) GetPlaylistID() int64 {
+func (x *Artist) GetID() int64 {
 	if x != nil {
-		return x.PlaylistID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *PlaylistData) GetTitle() string {
+func (x *Artist) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PlaylistData) GetArtwork() string {
+func (x *Artist) GetAvatar() string {
 	if x != nil {
-		return x.Artwork
+		return x.Avatar
 	}
 	return ""
 }
 
-func (x *PlaylistData) GetArtworkColor() string {
+func (x *Artist) GetVideo() string {
 	if x != nil {
-		return x.ArtworkColor
+		return x.Video
 	}
 	return ""
 }
 
-func (x *PlaylistData) GetIsPublic() bool {
+func (x *Artist) GetTracks() []*Track {
 	if x != nil {
-		return x.IsPublic
+		return x.Tracks
 	}
-	return false
+	return nil
 }
 
-func (x *PlaylistData) GetIsOwn() bool {
+func (x *Artist) GetAlbums() []*Album {
 	if x != nil {
-		return x.IsOwn
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *Artist) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Artist) GetAvatarColor() string {
+	if x != nil {
+		return x.AvatarColor
+	}
+	return ""
+}
+
+func (x *Artist) GetIsFollowed() bool {
+	if x != nil {
+		return x.IsFollowed
 	}
 	return false
 }
 
-type AlbumPageResponse struct {
+func (x *Artist) GetFollowersCount() int64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+type Track struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AlbumID        int64         `protobuf:"varint,1,opt,name=AlbumID,proto3" json:"AlbumID,omitempty"`
-	Title          string        `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Year           int64         `protobuf:"varint,3,opt,name=Year,proto3" json:"Year,omitempty"`
-	Artwork        string        `protobuf:"bytes,4,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
-	ArtworkColor   string        `protobuf:"bytes,5,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
-	TracksCount    int64         `protobuf:"varint,6,opt,name=TracksCount,proto3" json:"TracksCount,omitempty"`
-	TracksDuration int64         `protobuf:"varint,7,opt,name=TracksDuration,proto3" json:"TracksDuration,omitempty"`
-	Artist         *Artist       `protobuf:"bytes,8,opt,name=Artist,proto3" json:"Artist,omitempty"`
-	Tracks         []*AlbumTrack `protobuf:"bytes,9,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	ID            int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title         string  `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Explicit      bool    `protobuf:"varint,3,opt,name=Explicit,proto3" json:"Explicit,omitempty"`
+	Genre         string  `protobuf:"bytes,4,opt,name=Genre,proto3" json:"Genre,omitempty"`
+	Number        int64   `protobuf:"varint,5,opt,name=Number,proto3" json:"Number,omitempty"`
+	File          string  `protobuf:"bytes,6,opt,name=File,proto3" json:"File,omitempty"`
+	ListenCount   int64   `protobuf:"varint,7,opt,name=ListenCount,proto3" json:"ListenCount,omitempty"`
+	Duration      int64   `protobuf:"varint,8,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Lossless      bool    `protobuf:"varint,9,opt,name=Lossless,proto3" json:"Lossless,omitempty"`
+	Album         *Album  `protobuf:"bytes,10,opt,name=Album,proto3" json:"Album,omitempty"`
+	Artist        *Artist `protobuf:"bytes,11,opt,name=Artist,proto3" json:"Artist,omitempty"`
+	IsInFavorites bool    `protobuf:"varint,12,opt,name=IsInFavorites,proto3" json:"IsInFavorites,omitempty"`
+	AddedAt       int64   `protobuf:"varint,13,opt,name=AddedAt,proto3" json:"AddedAt,omitempty"`
+	HasLyrics     bool    `protobuf:"varint,14,opt,name=HasLyrics,proto3" json:"HasLyrics,omitempty"`
+	PlaylistIDs   []int64 `protobuf:"varint,15,rep,packed,name=PlaylistIDs,proto3" json:"PlaylistIDs,omitempty"`
 }
 
-func (x *AlbumPageResponse) Reset() {
-	*x = AlbumPageResponse{}
+func (x *Track) Reset() {
+	*x = Track{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[14]
+		mi := &file_music_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlbumPageResponse) String() string {
+func (x *Track) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlbumPageResponse) ProtoMessage() {}
+func (*Track) ProtoMessage() {}
 
-func (x *AlbumPageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[14]
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,131 +1266,136 @@ func (x *AlbumPageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlbumPageResponse.ProtoReflect.Descriptor instead.
-func (*AlbumPageResponse) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AlbumPageResponse) GetAlbumID() int64 {
+func (x *Track) GetID() int64 {
 	if x != nil {
-		return x.AlbumID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *AlbumPageResponse) GetTitle() string {
+func (x *Track) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *AlbumPageResponse) GetYear() int64 {
+func (x *Track) GetExplicit() bool {
 	if x != nil {
-		return x.Year
+		return x.Explicit
 	}
-	return 0
+	return false
 }
 
-func (x *AlbumPageResponse) GetArtwork() string {
+func (x *Track) GetGenre() string {
 	if x != nil {
-		return x.Artwork
+		return x.Genre
 	}
 	return ""
 }
 
-func (x *AlbumPageResponse) GetArtworkColor() string {
+func (x *Track) GetNumber() int64 {
 	if x != nil {
-		return x.ArtworkColor
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Track) GetFile() string {
+	if x != nil {
+		return x.File
 	}
 	return ""
 }
 
-func (x *AlbumPageResponse) GetTracksCount() int64 {
+func (x *Track) GetListenCount() int64 {
 	if x != nil {
-		return x.TracksCount
+		return x.ListenCount
 	}
 	return 0
 }
 
-func (x *AlbumPageResponse) GetTracksDuration() int64 {
+func (x *Track) GetDuration() int64 {
 	if x != nil {
-		return x.TracksDuration
+		return x.Duration
 	}
 	return 0
 }
 
-func (x *AlbumPageResponse) GetArtist() *Artist {
+func (x *Track) GetLossless() bool {
 	if x != nil {
-		return x.Artist
+		return x.Lossless
 	}
-	return nil
+	return false
 }
 
-func (x *AlbumPageResponse) GetTracks() []*AlbumTrack {
+func (x *Track) GetAlbum() *Album {
 	if x != nil {
-		return x.Tracks
+		return x.Album
 	}
 	return nil
 }
 
-type Tracks struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Track) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
 
-	Tracks []*Track `protobuf:"bytes,1,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+func (x *Track) GetIsInFavorites() bool {
+	if x != nil {
+		return x.IsInFavorites
+	}
+	return false
 }
 
-func (x *Tracks) Reset() {
-	*x = Tracks{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Track) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
 	}
+	return 0
 }
 
-func (x *Tracks) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Tracks) ProtoMessage() {}
-
-func (x *Tracks) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Track) GetHasLyrics() bool {
+	if x != nil {
+		return x.HasLyrics
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Tracks.ProtoReflect.Descriptor instead.
-func (*Tracks) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{15}
+	return false
 }
 
-func (x *Tracks) GetTracks() []*Track {
+func (x *Track) GetPlaylistIDs() []int64 {
 	if x != nil {
-		return x.Tracks
+		return x.PlaylistIDs
 	}
 	return nil
 }
 
-type Albums struct {
+type AlbumTrack struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Albums []*Album `protobuf:"bytes,1,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	ID            int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title         string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Explicit      bool   `protobuf:"varint,3,opt,name=Explicit,proto3" json:"Explicit,omitempty"`
+	Genre         string `protobuf:"bytes,4,opt,name=Genre,proto3" json:"Genre,omitempty"`
+	Number        int64  `protobuf:"varint,5,opt,name=Number,proto3" json:"Number,omitempty"`
+	File          string `protobuf:"bytes,6,opt,name=File,proto3" json:"File,omitempty"`
+	ListenCount   int64  `protobuf:"varint,7,opt,name=ListenCount,proto3" json:"ListenCount,omitempty"`
+	Duration      int64  `protobuf:"varint,8,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Lossless      bool   `protobuf:"varint,9,opt,name=Lossless,proto3" json:"Lossless,omitempty"`
+	IsInFavorites bool   `protobuf:"varint,10,opt,name=IsInFavorites,proto3" json:"IsInFavorites,omitempty"`
+	HasLyrics     bool   `protobuf:"varint,11,opt,name=HasLyrics,proto3" json:"HasLyrics,omitempty"`
 }
 
-func (x *Albums) Reset() {
-	*x = Albums{}
+func (x *AlbumTrack) Reset() {
+	*x = AlbumTrack{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_music_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1225,13 +1403,13 @@ func (x *Albums) Reset() {
 	}
 }
 
-func (x *Albums) String() string {
+func (x *AlbumTrack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Albums) ProtoMessage() {}
+func (*AlbumTrack) ProtoMessage() {}
 
-func (x *Albums) ProtoReflect() protoreflect.Message {
+func (x *AlbumTrack) ProtoReflect() protoreflect.Message {
 	mi := &file_music_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,206 +1421,118 @@ func (x *Albums) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Albums.ProtoReflect.Descriptor instead.
-func (*Albums) Descriptor() ([]byte, []int) {
+// Deprecated: Use AlbumTrack.ProtoReflect.Descriptor instead.
+func (*AlbumTrack) Descriptor() ([]byte, []int) {
 	return file_music_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Albums) GetAlbums() []*Album {
+func (x *AlbumTrack) GetID() int64 {
 	if x != nil {
-		return x.Albums
-	}
-	return nil
-}
-
-type Artists struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Artists []*Artist `protobuf:"bytes,1,rep,name=Artists,proto3" json:"Artists,omitempty"`
-}
-
-func (x *Artists) Reset() {
-	*x = Artists{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.ID
 	}
+	return 0
 }
 
-func (x *Artists) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Artists) ProtoMessage() {}
-
-func (x *Artists) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AlbumTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Artists.ProtoReflect.Descriptor instead.
-func (*Artists) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{17}
+	return ""
 }
 
-func (x *Artists) GetArtists() []*Artist {
+func (x *AlbumTrack) GetExplicit() bool {
 	if x != nil {
-		return x.Artists
+		return x.Explicit
 	}
-	return nil
-}
-
-type PlaylistsData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Playlists []*PlaylistData `protobuf:"bytes,1,rep,name=Playlists,proto3" json:"Playlists,omitempty"`
+	return false
 }
 
-func (x *PlaylistsData) Reset() {
-	*x = PlaylistsData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AlbumTrack) GetGenre() string {
+	if x != nil {
+		return x.Genre
 	}
+	return ""
 }
 
-func (x *PlaylistsData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PlaylistsData) ProtoMessage() {}
-
-func (x *PlaylistsData) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AlbumTrack) GetNumber() int64 {
+	if x != nil {
+		return x.Number
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PlaylistsData.ProtoReflect.Descriptor instead.
-func (*PlaylistsData) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{18}
+	return 0
 }
 
-func (x *PlaylistsData) GetPlaylists() []*PlaylistData {
+func (x *AlbumTrack) GetFile() string {
 	if x != nil {
-		return x.Playlists
+		return x.File
 	}
-	return nil
-}
-
-type FindResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tracks  []*Track  `protobuf:"bytes,1,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
-	Albums  []*Album  `protobuf:"bytes,2,rep,name=Albums,proto3" json:"Albums,omitempty"`
-	Artists []*Artist `protobuf:"bytes,3,rep,name=Artists,proto3" json:"Artists,omitempty"`
+	return ""
 }
 
-func (x *FindResponse) Reset() {
-	*x = FindResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AlbumTrack) GetListenCount() int64 {
+	if x != nil {
+		return x.ListenCount
 	}
+	return 0
 }
 
-func (x *FindResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FindResponse) ProtoMessage() {}
-
-func (x *FindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AlbumTrack) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FindResponse.ProtoReflect.Descriptor instead.
-func (*FindResponse) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{19}
+	return 0
 }
 
-func (x *FindResponse) GetTracks() []*Track {
+func (x *AlbumTrack) GetLossless() bool {
 	if x != nil {
-		return x.Tracks
+		return x.Lossless
 	}
-	return nil
+	return false
 }
 
-func (x *FindResponse) GetAlbums() []*Album {
+func (x *AlbumTrack) GetIsInFavorites() bool {
 	if x != nil {
-		return x.Albums
+		return x.IsInFavorites
 	}
-	return nil
+	return false
 }
 
-func (x *FindResponse) GetArtists() []*Artist {
+func (x *AlbumTrack) GetHasLyrics() bool {
 	if x != nil {
-		return x.Artists
+		return x.HasLyrics
 	}
-	return nil
+	return false
 }
 
-type PlaylistPageResponse struct {
+type PlaylistData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlaylistID   int64    `protobuf:"varint,1,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
-	Title        string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Artwork      string   `protobuf:"bytes,3,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
-	ArtworkColor string   `protobuf:"bytes,4,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
-	Tracks       []*Track `protobuf:"bytes,5,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
-	IsPublic     bool     `protobuf:"varint,6,opt,name=IsPublic,proto3" json:"IsPublic,omitempty"`
-	IsOwn        bool     `protobuf:"varint,7,opt,name=IsOwn,proto3" json:"IsOwn,omitempty"`
+	PlaylistID   int64  `protobuf:"varint,1,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
+	Title        string `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Artwork      string `protobuf:"bytes,3,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	ArtworkColor string `protobuf:"bytes,4,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+	IsPublic     bool   `protobuf:"varint,5,opt,name=IsPublic,proto3" json:"IsPublic,omitempty"`
+	IsOwn        bool   `protobuf:"varint,6,opt,name=IsOwn,proto3" json:"IsOwn,omitempty"`
 }
 
-func (x *PlaylistPageResponse) Reset() {
-	*x = PlaylistPageResponse{}
+func (x *PlaylistData) Reset() {
+	*x = PlaylistData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[20]
+		mi := &file_music_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlaylistPageResponse) String() string {
+func (x *PlaylistData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaylistPageResponse) ProtoMessage() {}
+func (*PlaylistData) ProtoMessage() {}
 
-func (x *PlaylistPageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[20]
+func (x *PlaylistData) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,83 +1543,89 @@ func (x *PlaylistPageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaylistPageResponse.ProtoReflect.Descriptor instead.
-func (*PlaylistPageResponse) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PlaylistData.ProtoReflect.Descriptor instead.
+func (*PlaylistData) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PlaylistPageResponse) GetPlaylistID() int64 {
+func (x *PlaylistData) GetPlaylistID() int64 {
 	if x != nil {
 		return x.PlaylistID
 	}
 	return 0
 }
 
-func (x *PlaylistPageResponse) GetTitle() string {
+func (x *PlaylistData) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *PlaylistPageResponse) GetArtwork() string {
+func (x *PlaylistData) GetArtwork() string {
 	if x != nil {
 		return x.Artwork
 	}
 	return ""
 }
 
-func (x *PlaylistPageResponse) GetArtworkColor() string {
+func (x *PlaylistData) GetArtworkColor() string {
 	if x != nil {
 		return x.ArtworkColor
 	}
 	return ""
 }
 
-func (x *PlaylistPageResponse) GetTracks() []*Track {
+func (x *PlaylistData) GetIsPublic() bool {
 	if x != nil {
-		return x.Tracks
-	}
-	return nil
-}
-
-func (x *PlaylistPageResponse) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
+		return x.IsPublic
 	}
 	return false
 }
 
-func (x *PlaylistPageResponse) GetIsOwn() bool {
+func (x *PlaylistData) GetIsOwn() bool {
 	if x != nil {
 		return x.IsOwn
 	}
 	return false
 }
 
-type IncrementListenCountEmpty struct {
+type AlbumPageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AlbumID        int64         `protobuf:"varint,1,opt,name=AlbumID,proto3" json:"AlbumID,omitempty"`
+	Title          string        `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Year           int64         `protobuf:"varint,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	Artwork        string        `protobuf:"bytes,4,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	ArtworkColor   string        `protobuf:"bytes,5,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+	TracksCount    int64         `protobuf:"varint,6,opt,name=TracksCount,proto3" json:"TracksCount,omitempty"`
+	TracksDuration int64         `protobuf:"varint,7,opt,name=TracksDuration,proto3" json:"TracksDuration,omitempty"`
+	Artist         *Artist       `protobuf:"bytes,8,opt,name=Artist,proto3" json:"Artist,omitempty"`
+	Tracks         []*AlbumTrack `protobuf:"bytes,9,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Page           *PageResponse `protobuf:"bytes,10,opt,name=Page,proto3" json:"Page,omitempty"`
+	IsSaved        bool          `protobuf:"varint,11,opt,name=IsSaved,proto3" json:"IsSaved,omitempty"`
+	ReleaseDate    string        `protobuf:"bytes,12,opt,name=ReleaseDate,proto3" json:"ReleaseDate,omitempty"`
 }
 
-func (x *IncrementListenCountEmpty) Reset() {
-	*x = IncrementListenCountEmpty{}
+func (x *AlbumPageResponse) Reset() {
+	*x = AlbumPageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[21]
+		mi := &file_music_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncrementListenCountEmpty) String() string {
+func (x *AlbumPageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementListenCountEmpty) ProtoMessage() {}
+func (*AlbumPageResponse) ProtoMessage() {}
 
-func (x *IncrementListenCountEmpty) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[21]
+func (x *AlbumPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,37 +1636,122 @@ func (x *IncrementListenCountEmpty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementListenCountEmpty.ProtoReflect.Descriptor instead.
-func (*IncrementListenCountEmpty) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AlbumPageResponse.ProtoReflect.Descriptor instead.
+func (*AlbumPageResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{18}
 }
 
-type AddTrackToFavoritesOptions struct {
+func (x *AlbumPageResponse) GetAlbumID() int64 {
+	if x != nil {
+		return x.AlbumID
+	}
+	return 0
+}
+
+func (x *AlbumPageResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AlbumPageResponse) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *AlbumPageResponse) GetArtwork() string {
+	if x != nil {
+		return x.Artwork
+	}
+	return ""
+}
+
+func (x *AlbumPageResponse) GetArtworkColor() string {
+	if x != nil {
+		return x.ArtworkColor
+	}
+	return ""
+}
+
+func (x *AlbumPageResponse) GetTracksCount() int64 {
+	if x != nil {
+		return x.TracksCount
+	}
+	return 0
+}
+
+func (x *AlbumPageResponse) GetTracksDuration() int64 {
+	if x != nil {
+		return x.TracksDuration
+	}
+	return 0
+}
+
+func (x *AlbumPageResponse) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+func (x *AlbumPageResponse) GetTracks() []*AlbumTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *AlbumPageResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *AlbumPageResponse) GetIsSaved() bool {
+	if x != nil {
+		return x.IsSaved
+	}
+	return false
+}
+
+func (x *AlbumPageResponse) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+type Tracks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID  int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	TrackID int64 `protobuf:"varint,2,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+	Tracks    []*Track      `protobuf:"bytes,1,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Page      *PageResponse `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Selection *Selection    `protobuf:"bytes,3,opt,name=Selection,proto3" json:"Selection,omitempty"`
 }
 
-func (x *AddTrackToFavoritesOptions) Reset() {
-	*x = AddTrackToFavoritesOptions{}
+func (x *Tracks) Reset() {
+	*x = Tracks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[22]
+		mi := &file_music_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTrackToFavoritesOptions) String() string {
+func (x *Tracks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTrackToFavoritesOptions) ProtoMessage() {}
+func (*Tracks) ProtoMessage() {}
 
-func (x *AddTrackToFavoritesOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[22]
+func (x *Tracks) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,51 +1762,59 @@ func (x *AddTrackToFavoritesOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTrackToFavoritesOptions.ProtoReflect.Descriptor instead.
-func (*AddTrackToFavoritesOptions) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Tracks.ProtoReflect.Descriptor instead.
+func (*Tracks) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AddTrackToFavoritesOptions) GetUserID() int64 {
+func (x *Tracks) GetTracks() []*Track {
 	if x != nil {
-		return x.UserID
+		return x.Tracks
 	}
-	return 0
+	return nil
 }
 
-func (x *AddTrackToFavoritesOptions) GetTrackID() int64 {
+func (x *Tracks) GetPage() *PageResponse {
 	if x != nil {
-		return x.TrackID
+		return x.Page
 	}
-	return 0
+	return nil
 }
 
-type DeleteTrackFromFavoritesOptions struct {
+func (x *Tracks) GetSelection() *Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+type Albums struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID  int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	TrackID int64 `protobuf:"varint,2,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+	Albums    []*Album      `protobuf:"bytes,1,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Page      *PageResponse `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Selection *Selection    `protobuf:"bytes,3,opt,name=Selection,proto3" json:"Selection,omitempty"`
 }
 
-func (x *DeleteTrackFromFavoritesOptions) Reset() {
-	*x = DeleteTrackFromFavoritesOptions{}
+func (x *Albums) Reset() {
+	*x = Albums{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[23]
+		mi := &file_music_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteTrackFromFavoritesOptions) String() string {
+func (x *Albums) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTrackFromFavoritesOptions) ProtoMessage() {}
+func (*Albums) ProtoMessage() {}
 
-func (x *DeleteTrackFromFavoritesOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[23]
+func (x *Albums) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,50 +1825,59 @@ func (x *DeleteTrackFromFavoritesOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTrackFromFavoritesOptions.ProtoReflect.Descriptor instead.
-func (*DeleteTrackFromFavoritesOptions) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Albums.ProtoReflect.Descriptor instead.
+func (*Albums) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteTrackFromFavoritesOptions) GetUserID() int64 {
+func (x *Albums) GetAlbums() []*Album {
 	if x != nil {
-		return x.UserID
+		return x.Albums
 	}
-	return 0
+	return nil
 }
 
-func (x *DeleteTrackFromFavoritesOptions) GetTrackID() int64 {
+func (x *Albums) GetPage() *PageResponse {
 	if x != nil {
-		return x.TrackID
+		return x.Page
 	}
-	return 0
+	return nil
 }
 
-type UserFavoritesOptions struct {
+func (x *Albums) GetSelection() *Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+type Artists struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Artists   []*Artist     `protobuf:"bytes,1,rep,name=Artists,proto3" json:"Artists,omitempty"`
+	Page      *PageResponse `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Selection *Selection    `protobuf:"bytes,3,opt,name=Selection,proto3" json:"Selection,omitempty"`
 }
 
-func (x *UserFavoritesOptions) Reset() {
-	*x = UserFavoritesOptions{}
+func (x *Artists) Reset() {
+	*x = Artists{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[24]
+		mi := &file_music_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserFavoritesOptions) String() string {
+func (x *Artists) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserFavoritesOptions) ProtoMessage() {}
+func (*Artists) ProtoMessage() {}
 
-func (x *UserFavoritesOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[24]
+func (x *Artists) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,41 +1888,58 @@ func (x *UserFavoritesOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserFavoritesOptions.ProtoReflect.Descriptor instead.
-func (*UserFavoritesOptions) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Artists.ProtoReflect.Descriptor instead.
+func (*Artists) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UserFavoritesOptions) GetUserID() int64 {
+func (x *Artists) GetArtists() []*Artist {
 	if x != nil {
-		return x.UserID
+		return x.Artists
 	}
-	return 0
+	return nil
 }
 
-type AddTrackToFavoritesResponse struct {
+func (x *Artists) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *Artists) GetSelection() *Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+type PlaylistsData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Playlists []*PlaylistData `protobuf:"bytes,1,rep,name=Playlists,proto3" json:"Playlists,omitempty"`
+	Page      *PageResponse   `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func (x *AddTrackToFavoritesResponse) Reset() {
-	*x = AddTrackToFavoritesResponse{}
+func (x *PlaylistsData) Reset() {
+	*x = PlaylistsData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[25]
+		mi := &file_music_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTrackToFavoritesResponse) String() string {
+func (x *PlaylistsData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTrackToFavoritesResponse) ProtoMessage() {}
+func (*PlaylistsData) ProtoMessage() {}
 
-func (x *AddTrackToFavoritesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[25]
+func (x *PlaylistsData) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,34 +1950,53 @@ func (x *AddTrackToFavoritesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTrackToFavoritesResponse.ProtoReflect.Descriptor instead.
-func (*AddTrackToFavoritesResponse) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PlaylistsData.ProtoReflect.Descriptor instead.
+func (*PlaylistsData) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{22}
 }
 
-type DeleteTrackFromFavoritesResponse struct {
+func (x *PlaylistsData) GetPlaylists() []*PlaylistData {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *PlaylistsData) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type FindResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tracks  []*Track      `protobuf:"bytes,1,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Albums  []*Album      `protobuf:"bytes,2,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Artists []*Artist     `protobuf:"bytes,3,rep,name=Artists,proto3" json:"Artists,omitempty"`
+	Page    *PageResponse `protobuf:"bytes,4,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func (x *DeleteTrackFromFavoritesResponse) Reset() {
-	*x = DeleteTrackFromFavoritesResponse{}
+func (x *FindResponse) Reset() {
+	*x = FindResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_music_proto_msgTypes[26]
+		mi := &file_music_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteTrackFromFavoritesResponse) String() string {
+func (x *FindResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTrackFromFavoritesResponse) ProtoMessage() {}
+func (*FindResponse) ProtoMessage() {}
 
-func (x *DeleteTrackFromFavoritesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_music_proto_msgTypes[26]
+func (x *FindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1773,1108 +2007,6928 @@ func (x *DeleteTrackFromFavoritesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTrackFromFavoritesResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTrackFromFavoritesResponse) Descriptor() ([]byte, []int) {
-	return file_music_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use FindResponse.ProtoReflect.Descriptor instead.
+func (*FindResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{23}
 }
 
-var File_music_proto protoreflect.FileDescriptor
-
-var file_music_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a,
-	0x13, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x52, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x49, 0x6e, 0x63, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x6e, 0x0a, 0x14, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x10, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x50,
-	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
-	0x22, 0x5d, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
-	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49,
-	0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22,
-	0x2e, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
-	0x4d, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
-	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79,
-	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xe3,
-	0x01, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65,
-	0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x73, 0x12, 0x1e, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x73, 0x22, 0xca, 0x02, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x47, 0x65,
-	0x6e, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x46,
-	0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x52, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x73, 0x49, 0x6e,
-	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x49, 0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0x90,
-	0x02, 0x0a, 0x0a, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x49,
-	0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x49, 0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x22, 0xa5, 0x02, 0x0a, 0x11, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65,
-	0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c,
-	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x41, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x22, 0x28, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x28, 0x0a, 0x06, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x73, 0x22, 0x2c, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12,
-	0x21, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x73, 0x22, 0x3c, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
-	0x22, 0x71, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x12, 0x1e, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73,
-	0x12, 0x21, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x41, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c,
-	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05,
-	0x49, 0x73, 0x4f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x4f,
-	0x77, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x4e, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x46, 0x61, 0x76,
-	0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x22,
-	0x53, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x72,
-	0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f,
-	0x72, 0x69, 0x74, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe8, 0x05, 0x0a, 0x05, 0x4d, 0x75, 0x73, 0x69,
-	0x63, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x73, 0x12, 0x14, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x73, 0x12, 0x14, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x08, 0x2e, 0x41, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x0e, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x15, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x14, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x49,
-	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1a, 0x2e, 0x49, 0x6e, 0x63,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x50, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x12, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
-	0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x15, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a,
-	0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x0c, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x0d, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x41, 0x64,
-	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1c, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x21, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x12, 0x15, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *FindResponse) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
 }
 
-var (
-	file_music_proto_rawDescOnce sync.Once
-	file_music_proto_rawDescData = file_music_proto_rawDesc
-)
+func (x *FindResponse) GetAlbums() []*Album {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
 
-func file_music_proto_rawDescGZIP() []byte {
-	file_music_proto_rawDescOnce.Do(func() {
-		file_music_proto_rawDescData = protoimpl.X.CompressGZIP(file_music_proto_rawDescData)
-	})
-	return file_music_proto_rawDescData
+func (x *FindResponse) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
 }
 
-var file_music_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_music_proto_goTypes = []interface{}{
-	(*RandomTracksOptions)(nil),              // 0: RandomTracksOptions
-	(*RandomAlbumsOptions)(nil),              // 1: RandomAlbumsOptions
-	(*RandomArtistsOptions)(nil),             // 2: RandomArtistsOptions
-	(*IncrementListenCountOptions)(nil),      // 3: IncrementListenCountOptions
-	(*ArtistProfileOptions)(nil),             // 4: ArtistProfileOptions
-	(*AlbumPageOptions)(nil),                 // 5: AlbumPageOptions
-	(*FindOptions)(nil),                      // 6: FindOptions
-	(*UserPlaylistsOptions)(nil),             // 7: UserPlaylistsOptions
-	(*PlaylistPageOptions)(nil),              // 8: PlaylistPageOptions
-	(*Album)(nil),                            // 9: Album
-	(*Artist)(nil),                           // 10: Artist
-	(*Track)(nil),                            // 11: Track
-	(*AlbumTrack)(nil),                       // 12: AlbumTrack
-	(*PlaylistData)(nil),                     // 13: PlaylistData
-	(*AlbumPageResponse)(nil),                // 14: AlbumPageResponse
-	(*Tracks)(nil),                           // 15: Tracks
-	(*Albums)(nil),                           // 16: Albums
-	(*Artists)(nil),                          // 17: Artists
-	(*PlaylistsData)(nil),                    // 18: PlaylistsData
-	(*FindResponse)(nil),                     // 19: FindResponse
-	(*PlaylistPageResponse)(nil),             // 20: PlaylistPageResponse
-	(*IncrementListenCountEmpty)(nil),        // 21: IncrementListenCountEmpty
-	(*AddTrackToFavoritesOptions)(nil),       // 22: AddTrackToFavoritesOptions
-	(*DeleteTrackFromFavoritesOptions)(nil),  // 23: DeleteTrackFromFavoritesOptions
-	(*UserFavoritesOptions)(nil),             // 24: UserFavoritesOptions
-	(*AddTrackToFavoritesResponse)(nil),      // 25: AddTrackToFavoritesResponse
-	(*DeleteTrackFromFavoritesResponse)(nil), // 26: DeleteTrackFromFavoritesResponse
+func (x *FindResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
 }
-var file_music_proto_depIdxs = []int32{
-	11, // 0: Artist.Tracks:type_name -> Track
-	9,  // 1: Artist.Albums:type_name -> Album
-	9,  // 2: Track.Album:type_name -> Album
-	10, // 3: Track.Artist:type_name -> Artist
-	10, // 4: AlbumPageResponse.Artist:type_name -> Artist
-	12, // 5: AlbumPageResponse.Tracks:type_name -> AlbumTrack
-	11, // 6: Tracks.Tracks:type_name -> Track
-	9,  // 7: Albums.Albums:type_name -> Album
-	10, // 8: Artists.Artists:type_name -> Artist
-	13, // 9: PlaylistsData.Playlists:type_name -> PlaylistData
-	11, // 10: FindResponse.Tracks:type_name -> Track
-	9,  // 11: FindResponse.Albums:type_name -> Album
-	10, // 12: FindResponse.Artists:type_name -> Artist
-	11, // 13: PlaylistPageResponse.Tracks:type_name -> Track
-	0,  // 14: Music.RandomTracks:input_type -> RandomTracksOptions
-	1,  // 15: Music.RandomAlbums:input_type -> RandomAlbumsOptions
-	2,  // 16: Music.RandomArtists:input_type -> RandomArtistsOptions
-	7,  // 17: Music.UserPlaylists:input_type -> UserPlaylistsOptions
-	4,  // 18: Music.ArtistProfile:input_type -> ArtistProfileOptions
-	3,  // 19: Music.IncrementListenCount:input_type -> IncrementListenCountOptions
-	5,  // 20: Music.AlbumPage:input_type -> AlbumPageOptions
-	8,  // 21: Music.PlaylistPage:input_type -> PlaylistPageOptions
-	6,  // 22: Music.Find:input_type -> FindOptions
-	22, // 23: Music.AddTrackToFavorites:input_type -> AddTrackToFavoritesOptions
-	23, // 24: Music.DeleteTrackFromFavorites:input_type -> DeleteTrackFromFavoritesOptions
-	24, // 25: Music.GetFavoriteTracks:input_type -> UserFavoritesOptions
-	15, // 26: Music.RandomTracks:output_type -> Tracks
-	16, // 27: Music.RandomAlbums:output_type -> Albums
-	17, // 28: Music.RandomArtists:output_type -> Artists
-	18, // 29: Music.UserPlaylists:output_type -> PlaylistsData
-	10, // 30: Music.ArtistProfile:output_type -> Artist
-	21, // 31: Music.IncrementListenCount:output_type -> IncrementListenCountEmpty
-	14, // 32: Music.AlbumPage:output_type -> AlbumPageResponse
-	20, // 33: Music.PlaylistPage:output_type -> PlaylistPageResponse
-	19, // 34: Music.Find:output_type -> FindResponse
-	25, // 35: Music.AddTrackToFavorites:output_type -> AddTrackToFavoritesResponse
-	26, // 36: Music.DeleteTrackFromFavorites:output_type -> DeleteTrackFromFavoritesResponse
-	15, // 37: Music.GetFavoriteTracks:output_type -> Tracks
-	26, // [26:38] is the sub-list for method output_type
-	14, // [14:26] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+type PlaylistPageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaylistID   int64         `protobuf:"varint,1,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
+	Title        string        `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Artwork      string        `protobuf:"bytes,3,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	ArtworkColor string        `protobuf:"bytes,4,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+	Tracks       []*Track      `protobuf:"bytes,5,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	IsPublic     bool          `protobuf:"varint,6,opt,name=IsPublic,proto3" json:"IsPublic,omitempty"`
+	IsOwn        bool          `protobuf:"varint,7,opt,name=IsOwn,proto3" json:"IsOwn,omitempty"`
+	Page         *PageResponse `protobuf:"bytes,8,opt,name=Page,proto3" json:"Page,omitempty"`
 }
 
-func init() { file_music_proto_init() }
-func file_music_proto_init() {
-	if File_music_proto != nil {
-		return
+func (x *PlaylistPageResponse) Reset() {
+	*x = PlaylistPageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_music_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandomTracksOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *PlaylistPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistPageResponse) ProtoMessage() {}
+
+func (x *PlaylistPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandomAlbumsOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistPageResponse.ProtoReflect.Descriptor instead.
+func (*PlaylistPageResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PlaylistPageResponse) GetPlaylistID() int64 {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return 0
+}
+
+func (x *PlaylistPageResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaylistPageResponse) GetArtwork() string {
+	if x != nil {
+		return x.Artwork
+	}
+	return ""
+}
+
+func (x *PlaylistPageResponse) GetArtworkColor() string {
+	if x != nil {
+		return x.ArtworkColor
+	}
+	return ""
+}
+
+func (x *PlaylistPageResponse) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *PlaylistPageResponse) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *PlaylistPageResponse) GetIsOwn() bool {
+	if x != nil {
+		return x.IsOwn
+	}
+	return false
+}
+
+func (x *PlaylistPageResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type IncrementListenCountEmpty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IncrementListenCountEmpty) Reset() {
+	*x = IncrementListenCountEmpty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrementListenCountEmpty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementListenCountEmpty) ProtoMessage() {}
+
+func (x *IncrementListenCountEmpty) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandomArtistsOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrementListenCountOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistProfileOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementListenCountEmpty.ProtoReflect.Descriptor instead.
+func (*IncrementListenCountEmpty) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{25}
+}
+
+type AddTrackToFavoritesOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	TrackID int64 `protobuf:"varint,2,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+}
+
+func (x *AddTrackToFavoritesOptions) Reset() {
+	*x = AddTrackToFavoritesOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTrackToFavoritesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrackToFavoritesOptions) ProtoMessage() {}
+
+func (x *AddTrackToFavoritesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumPageOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrackToFavoritesOptions.ProtoReflect.Descriptor instead.
+func (*AddTrackToFavoritesOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddTrackToFavoritesOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddTrackToFavoritesOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+type DeleteTrackFromFavoritesOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	TrackID int64 `protobuf:"varint,2,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+}
+
+func (x *DeleteTrackFromFavoritesOptions) Reset() {
+	*x = DeleteTrackFromFavoritesOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTrackFromFavoritesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrackFromFavoritesOptions) ProtoMessage() {}
+
+func (x *DeleteTrackFromFavoritesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrackFromFavoritesOptions.ProtoReflect.Descriptor instead.
+func (*DeleteTrackFromFavoritesOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTrackFromFavoritesOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteTrackFromFavoritesOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+type FavoritesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistID int64  `protobuf:"varint,1,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	GenreID  int64  `protobuf:"varint,2,opt,name=GenreID,proto3" json:"GenreID,omitempty"`
+	Explicit *bool  `protobuf:"varint,3,opt,name=Explicit,proto3,oneof" json:"Explicit,omitempty"`
+	Lossless *bool  `protobuf:"varint,4,opt,name=Lossless,proto3,oneof" json:"Lossless,omitempty"`
+	Text     string `protobuf:"bytes,5,opt,name=Text,proto3" json:"Text,omitempty"`
+}
+
+func (x *FavoritesFilter) Reset() {
+	*x = FavoritesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoritesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoritesFilter) ProtoMessage() {}
+
+func (x *FavoritesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPlaylistsOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoritesFilter.ProtoReflect.Descriptor instead.
+func (*FavoritesFilter) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FavoritesFilter) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *FavoritesFilter) GetGenreID() int64 {
+	if x != nil {
+		return x.GenreID
+	}
+	return 0
+}
+
+func (x *FavoritesFilter) GetExplicit() bool {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return false
+}
+
+func (x *FavoritesFilter) GetLossless() bool {
+	if x != nil && x.Lossless != nil {
+		return *x.Lossless
+	}
+	return false
+}
+
+func (x *FavoritesFilter) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UserFavoritesOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64            `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Page   *PageRequest     `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	SortBy string           `protobuf:"bytes,3,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+	Filter *FavoritesFilter `protobuf:"bytes,4,opt,name=Filter,proto3" json:"Filter,omitempty"`
+}
+
+func (x *UserFavoritesOptions) Reset() {
+	*x = UserFavoritesOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFavoritesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavoritesOptions) ProtoMessage() {}
+
+func (x *UserFavoritesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaylistPageOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Album); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Artist); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavoritesOptions.ProtoReflect.Descriptor instead.
+func (*UserFavoritesOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserFavoritesOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UserFavoritesOptions) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *UserFavoritesOptions) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *UserFavoritesOptions) GetFilter() *FavoritesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type AddTrackToFavoritesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddTrackToFavoritesResponse) Reset() {
+	*x = AddTrackToFavoritesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTrackToFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrackToFavoritesResponse) ProtoMessage() {}
+
+func (x *AddTrackToFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Track); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrackToFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*AddTrackToFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{30}
+}
+
+type ChartsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period       string `protobuf:"bytes,1,opt,name=Period,proto3" json:"Period,omitempty"`
+	Entity       string `protobuf:"bytes,2,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	GenreID      int64  `protobuf:"varint,3,opt,name=GenreID,proto3" json:"GenreID,omitempty"`
+	Amount       int64  `protobuf:"varint,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	UserID       int64  `protobuf:"varint,5,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool   `protobuf:"varint,6,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+}
+
+func (x *ChartsOptions) Reset() {
+	*x = ChartsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartsOptions) ProtoMessage() {}
+
+func (x *ChartsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumTrack); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartsOptions.ProtoReflect.Descriptor instead.
+func (*ChartsOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChartsOptions) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ChartsOptions) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ChartsOptions) GetGenreID() int64 {
+	if x != nil {
+		return x.GenreID
+	}
+	return 0
+}
+
+func (x *ChartsOptions) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChartsOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ChartsOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+type ChartTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position         int64  `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	PreviousPosition int64  `protobuf:"varint,2,opt,name=PreviousPosition,proto3" json:"PreviousPosition,omitempty"`
+	Plays            int64  `protobuf:"varint,3,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Track            *Track `protobuf:"bytes,4,opt,name=Track,proto3" json:"Track,omitempty"`
+}
+
+func (x *ChartTrack) Reset() {
+	*x = ChartTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartTrack) ProtoMessage() {}
+
+func (x *ChartTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaylistData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartTrack.ProtoReflect.Descriptor instead.
+func (*ChartTrack) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChartTrack) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetPreviousPosition() int64 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+type ChartAlbum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position         int64  `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	PreviousPosition int64  `protobuf:"varint,2,opt,name=PreviousPosition,proto3" json:"PreviousPosition,omitempty"`
+	Plays            int64  `protobuf:"varint,3,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Album            *Album `protobuf:"bytes,4,opt,name=Album,proto3" json:"Album,omitempty"`
+}
+
+func (x *ChartAlbum) Reset() {
+	*x = ChartAlbum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartAlbum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartAlbum) ProtoMessage() {}
+
+func (x *ChartAlbum) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumPageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartAlbum.ProtoReflect.Descriptor instead.
+func (*ChartAlbum) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChartAlbum) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChartAlbum) GetPreviousPosition() int64 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+func (x *ChartAlbum) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *ChartAlbum) GetAlbum() *Album {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+type ChartArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position         int64   `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	PreviousPosition int64   `protobuf:"varint,2,opt,name=PreviousPosition,proto3" json:"PreviousPosition,omitempty"`
+	Plays            int64   `protobuf:"varint,3,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Artist           *Artist `protobuf:"bytes,4,opt,name=Artist,proto3" json:"Artist,omitempty"`
+}
+
+func (x *ChartArtist) Reset() {
+	*x = ChartArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartArtist) ProtoMessage() {}
+
+func (x *ChartArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tracks); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartArtist.ProtoReflect.Descriptor instead.
+func (*ChartArtist) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChartArtist) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetPreviousPosition() int64 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type ChartsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period      string         `protobuf:"bytes,1,opt,name=Period,proto3" json:"Period,omitempty"`
+	PeriodStart string         `protobuf:"bytes,2,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"`
+	Tracks      []*ChartTrack  `protobuf:"bytes,3,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Albums      []*ChartAlbum  `protobuf:"bytes,4,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Artists     []*ChartArtist `protobuf:"bytes,5,rep,name=Artists,proto3" json:"Artists,omitempty"`
+}
+
+func (x *ChartsResponse) Reset() {
+	*x = ChartsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartsResponse) ProtoMessage() {}
+
+func (x *ChartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Albums); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartsResponse.ProtoReflect.Descriptor instead.
+func (*ChartsResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChartsResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ChartsResponse) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *ChartsResponse) GetTracks() []*ChartTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *ChartsResponse) GetAlbums() []*ChartAlbum {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *ChartsResponse) GetArtists() []*ChartArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+type Genre struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Artwork      string `protobuf:"bytes,3,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	ArtworkColor string `protobuf:"bytes,4,opt,name=ArtworkColor,proto3" json:"ArtworkColor,omitempty"`
+	TracksAmount int64  `protobuf:"varint,5,opt,name=TracksAmount,proto3" json:"TracksAmount,omitempty"`
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Artists); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Genre) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Genre) GetArtwork() string {
+	if x != nil {
+		return x.Artwork
+	}
+	return ""
+}
+
+func (x *Genre) GetArtworkColor() string {
+	if x != nil {
+		return x.ArtworkColor
+	}
+	return ""
+}
+
+func (x *Genre) GetTracksAmount() int64 {
+	if x != nil {
+		return x.TracksAmount
+	}
+	return 0
+}
+
+type Genres struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genres []*Genre `protobuf:"bytes,1,rep,name=Genres,proto3" json:"Genres,omitempty"`
+}
+
+func (x *Genres) Reset() {
+	*x = Genres{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Genres) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genres) ProtoMessage() {}
+
+func (x *Genres) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaylistsData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaylistPageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncrementListenCountEmpty); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_music_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTrackToFavoritesOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genres.ProtoReflect.Descriptor instead.
+func (*Genres) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Genres) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type ListGenresOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGenresOptions) Reset() {
+	*x = ListGenresOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGenresOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresOptions) ProtoMessage() {}
+
+func (x *ListGenresOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTrackFromFavoritesOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresOptions.ProtoReflect.Descriptor instead.
+func (*ListGenresOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{38}
+}
+
+type GenrePageOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GenreID      int64        `protobuf:"varint,1,opt,name=GenreID,proto3" json:"GenreID,omitempty"`
+	UserID       int64        `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool         `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Page         *PageRequest `protobuf:"bytes,6,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *GenrePageOptions) Reset() {
+	*x = GenrePageOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenrePageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenrePageOptions) ProtoMessage() {}
+
+func (x *GenrePageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserFavoritesOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenrePageOptions.ProtoReflect.Descriptor instead.
+func (*GenrePageOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GenrePageOptions) GetGenreID() int64 {
+	if x != nil {
+		return x.GenreID
+	}
+	return 0
+}
+
+func (x *GenrePageOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GenrePageOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+func (x *GenrePageOptions) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GenrePageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genre   *Genre        `protobuf:"bytes,1,opt,name=Genre,proto3" json:"Genre,omitempty"`
+	Tracks  []*Track      `protobuf:"bytes,2,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Albums  []*Album      `protobuf:"bytes,3,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Artists []*Artist     `protobuf:"bytes,4,rep,name=Artists,proto3" json:"Artists,omitempty"`
+	Page    *PageResponse `protobuf:"bytes,5,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *GenrePageResponse) Reset() {
+	*x = GenrePageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenrePageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenrePageResponse) ProtoMessage() {}
+
+func (x *GenrePageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTrackToFavoritesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenrePageResponse.ProtoReflect.Descriptor instead.
+func (*GenrePageResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GenrePageResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+func (x *GenrePageResponse) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *GenrePageResponse) GetAlbums() []*Album {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *GenrePageResponse) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *GenrePageResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ArtistTracksOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistID     int64        `protobuf:"varint,1,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	UserID       int64        `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool         `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	SortBy       string       `protobuf:"bytes,6,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+	Page         *PageRequest `protobuf:"bytes,7,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *ArtistTracksOptions) Reset() {
+	*x = ArtistTracksOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistTracksOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistTracksOptions) ProtoMessage() {}
+
+func (x *ArtistTracksOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_music_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTrackFromFavoritesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistTracksOptions.ProtoReflect.Descriptor instead.
+func (*ArtistTracksOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ArtistTracksOptions) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *ArtistTracksOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ArtistTracksOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+func (x *ArtistTracksOptions) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ArtistTracksOptions) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ArtistAlbumsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistID int64        `protobuf:"varint,1,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+	SortBy   string       `protobuf:"bytes,4,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+	Page     *PageRequest `protobuf:"bytes,5,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *ArtistAlbumsOptions) Reset() {
+	*x = ArtistAlbumsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistAlbumsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistAlbumsOptions) ProtoMessage() {}
+
+func (x *ArtistAlbumsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistAlbumsOptions.ProtoReflect.Descriptor instead.
+func (*ArtistAlbumsOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ArtistAlbumsOptions) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *ArtistAlbumsOptions) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ArtistAlbumsOptions) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type SuggestOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix       string `protobuf:"bytes,1,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	UserID       int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool   `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+	Limit        int64  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *SuggestOptions) Reset() {
+	*x = SuggestOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestOptions) ProtoMessage() {}
+
+func (x *SuggestOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestOptions.ProtoReflect.Descriptor instead.
+func (*SuggestOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SuggestOptions) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SuggestOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+func (x *SuggestOptions) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MatchRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=End,proto3" json:"End,omitempty"`
+}
+
+func (x *MatchRange) Reset() {
+	*x = MatchRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRange) ProtoMessage() {}
+
+func (x *MatchRange) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRange.ProtoReflect.Descriptor instead.
+func (*MatchRange) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MatchRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MatchRange) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string        `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	ID         int64         `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title      string        `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Subtitle   string        `protobuf:"bytes,4,opt,name=Subtitle,proto3" json:"Subtitle,omitempty"`
+	Artwork    string        `protobuf:"bytes,5,opt,name=Artwork,proto3" json:"Artwork,omitempty"`
+	Highlights []*MatchRange `protobuf:"bytes,6,rep,name=Highlights,proto3" json:"Highlights,omitempty"`
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Suggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Suggestion) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Suggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Suggestion) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *Suggestion) GetArtwork() string {
+	if x != nil {
+		return x.Artwork
+	}
+	return ""
+}
+
+func (x *Suggestion) GetHighlights() []*MatchRange {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type Suggestions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=Suggestions,proto3" json:"Suggestions,omitempty"`
+}
+
+func (x *Suggestions) Reset() {
+	*x = Suggestions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestions) ProtoMessage() {}
+
+func (x *Suggestions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestions.ProtoReflect.Descriptor instead.
+func (*Suggestions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Suggestions) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type RecentSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Query       string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	ResultType  string `protobuf:"bytes,3,opt,name=ResultType,proto3" json:"ResultType,omitempty"`
+	ResultID    int64  `protobuf:"varint,4,opt,name=ResultID,proto3" json:"ResultID,omitempty"`
+	ResultTitle string `protobuf:"bytes,5,opt,name=ResultTitle,proto3" json:"ResultTitle,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *RecentSearch) Reset() {
+	*x = RecentSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentSearch) ProtoMessage() {}
+
+func (x *RecentSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentSearch.ProtoReflect.Descriptor instead.
+func (*RecentSearch) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RecentSearch) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RecentSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RecentSearch) GetResultType() string {
+	if x != nil {
+		return x.ResultType
+	}
+	return ""
+}
+
+func (x *RecentSearch) GetResultID() int64 {
+	if x != nil {
+		return x.ResultID
+	}
+	return 0
+}
+
+func (x *RecentSearch) GetResultTitle() string {
+	if x != nil {
+		return x.ResultTitle
+	}
+	return ""
+}
+
+func (x *RecentSearch) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type RecentSearches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecentSearches []*RecentSearch `protobuf:"bytes,1,rep,name=RecentSearches,proto3" json:"RecentSearches,omitempty"`
+}
+
+func (x *RecentSearches) Reset() {
+	*x = RecentSearches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentSearches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentSearches) ProtoMessage() {}
+
+func (x *RecentSearches) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentSearches.ProtoReflect.Descriptor instead.
+func (*RecentSearches) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RecentSearches) GetRecentSearches() []*RecentSearch {
+	if x != nil {
+		return x.RecentSearches
+	}
+	return nil
+}
+
+type RecentSearchesOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Limit  int64 `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *RecentSearchesOptions) Reset() {
+	*x = RecentSearchesOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentSearchesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentSearchesOptions) ProtoMessage() {}
+
+func (x *RecentSearchesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentSearchesOptions.ProtoReflect.Descriptor instead.
+func (*RecentSearchesOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RecentSearchesOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RecentSearchesOptions) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RecordSearchResultOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Query      string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	ResultType string `protobuf:"bytes,3,opt,name=ResultType,proto3" json:"ResultType,omitempty"`
+	ResultID   int64  `protobuf:"varint,4,opt,name=ResultID,proto3" json:"ResultID,omitempty"`
+}
+
+func (x *RecordSearchResultOptions) Reset() {
+	*x = RecordSearchResultOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordSearchResultOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchResultOptions) ProtoMessage() {}
+
+func (x *RecordSearchResultOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchResultOptions.ProtoReflect.Descriptor instead.
+func (*RecordSearchResultOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RecordSearchResultOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RecordSearchResultOptions) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RecordSearchResultOptions) GetResultType() string {
+	if x != nil {
+		return x.ResultType
+	}
+	return ""
+}
+
+func (x *RecordSearchResultOptions) GetResultID() int64 {
+	if x != nil {
+		return x.ResultID
+	}
+	return 0
+}
+
+type RecordSearchResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecordSearchResultResponse) Reset() {
+	*x = RecordSearchResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordSearchResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchResultResponse) ProtoMessage() {}
+
+func (x *RecordSearchResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchResultResponse.ProtoReflect.Descriptor instead.
+func (*RecordSearchResultResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{51}
+}
+
+type DeleteRecentSearchOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ID     int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteRecentSearchOptions) Reset() {
+	*x = DeleteRecentSearchOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecentSearchOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecentSearchOptions) ProtoMessage() {}
+
+func (x *DeleteRecentSearchOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecentSearchOptions.ProtoReflect.Descriptor instead.
+func (*DeleteRecentSearchOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteRecentSearchOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DeleteRecentSearchOptions) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type DeleteRecentSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRecentSearchResponse) Reset() {
+	*x = DeleteRecentSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecentSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecentSearchResponse) ProtoMessage() {}
+
+func (x *DeleteRecentSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecentSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecentSearchResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{53}
+}
+
+type ClearRecentSearchesOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *ClearRecentSearchesOptions) Reset() {
+	*x = ClearRecentSearchesOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRecentSearchesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentSearchesOptions) ProtoMessage() {}
+
+func (x *ClearRecentSearchesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentSearchesOptions.ProtoReflect.Descriptor instead.
+func (*ClearRecentSearchesOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClearRecentSearchesOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ClearRecentSearchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRecentSearchesResponse) Reset() {
+	*x = ClearRecentSearchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRecentSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentSearchesResponse) ProtoMessage() {}
+
+func (x *ClearRecentSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ClearRecentSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{55}
+}
+
+type TrackFileOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackID int64 `protobuf:"varint,1,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+}
+
+func (x *TrackFileOptions) Reset() {
+	*x = TrackFileOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackFileOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackFileOptions) ProtoMessage() {}
+
+func (x *TrackFileOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackFileOptions.ProtoReflect.Descriptor instead.
+func (*TrackFileOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TrackFileOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+type TrackFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File     string `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	Lossless bool   `protobuf:"varint,2,opt,name=Lossless,proto3" json:"Lossless,omitempty"`
+}
+
+func (x *TrackFileResponse) Reset() {
+	*x = TrackFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackFileResponse) ProtoMessage() {}
+
+func (x *TrackFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackFileResponse.ProtoReflect.Descriptor instead.
+func (*TrackFileResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TrackFileResponse) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *TrackFileResponse) GetLossless() bool {
+	if x != nil {
+		return x.Lossless
+	}
+	return false
+}
+
+type DeleteTrackFromFavoritesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteTrackFromFavoritesResponse) Reset() {
+	*x = DeleteTrackFromFavoritesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTrackFromFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrackFromFavoritesResponse) ProtoMessage() {}
+
+func (x *DeleteTrackFromFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrackFromFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTrackFromFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{58}
+}
+
+type GetTrackOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackID      int64 `protobuf:"varint,1,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+	UserID       int64 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool  `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+}
+
+func (x *GetTrackOptions) Reset() {
+	*x = GetTrackOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrackOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrackOptions) ProtoMessage() {}
+
+func (x *GetTrackOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrackOptions.ProtoReflect.Descriptor instead.
+func (*GetTrackOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetTrackOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+func (x *GetTrackOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetTrackOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+type GetTracksOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs          []int64 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	UserID       int64   `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IsAuthorized bool    `protobuf:"varint,3,opt,name=IsAuthorized,proto3" json:"IsAuthorized,omitempty"`
+}
+
+func (x *GetTracksOptions) Reset() {
+	*x = GetTracksOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTracksOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTracksOptions) ProtoMessage() {}
+
+func (x *GetTracksOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTracksOptions.ProtoReflect.Descriptor instead.
+func (*GetTracksOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTracksOptions) GetIDs() []int64 {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+func (x *GetTracksOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetTracksOptions) GetIsAuthorized() bool {
+	if x != nil {
+		return x.IsAuthorized
+	}
+	return false
+}
+
+type SaveAlbumOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	AlbumID int64 `protobuf:"varint,2,opt,name=AlbumID,proto3" json:"AlbumID,omitempty"`
+}
+
+func (x *SaveAlbumOptions) Reset() {
+	*x = SaveAlbumOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveAlbumOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAlbumOptions) ProtoMessage() {}
+
+func (x *SaveAlbumOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAlbumOptions.ProtoReflect.Descriptor instead.
+func (*SaveAlbumOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SaveAlbumOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SaveAlbumOptions) GetAlbumID() int64 {
+	if x != nil {
+		return x.AlbumID
+	}
+	return 0
+}
+
+type FollowArtistOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	ArtistID int64 `protobuf:"varint,2,opt,name=ArtistID,proto3" json:"ArtistID,omitempty"`
+}
+
+func (x *FollowArtistOptions) Reset() {
+	*x = FollowArtistOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowArtistOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowArtistOptions) ProtoMessage() {}
+
+func (x *FollowArtistOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowArtistOptions.ProtoReflect.Descriptor instead.
+func (*FollowArtistOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FollowArtistOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *FollowArtistOptions) GetArtistID() int64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+type LibraryOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64        `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Page   *PageRequest `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *LibraryOptions) Reset() {
+	*x = LibraryOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryOptions) ProtoMessage() {}
+
+func (x *LibraryOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryOptions.ProtoReflect.Descriptor instead.
+func (*LibraryOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LibraryOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *LibraryOptions) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type LibraryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LibraryResponse) Reset() {
+	*x = LibraryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryResponse) ProtoMessage() {}
+
+func (x *LibraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryResponse.ProtoReflect.Descriptor instead.
+func (*LibraryResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{64}
+}
+
+type LyricsOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackID int64 `protobuf:"varint,1,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+}
+
+func (x *LyricsOptions) Reset() {
+	*x = LyricsOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LyricsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LyricsOptions) ProtoMessage() {}
+
+func (x *LyricsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LyricsOptions.ProtoReflect.Descriptor instead.
+func (*LyricsOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LyricsOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+type LyricsLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time int64  `protobuf:"varint,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+}
+
+func (x *LyricsLine) Reset() {
+	*x = LyricsLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LyricsLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LyricsLine) ProtoMessage() {}
+
+func (x *LyricsLine) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LyricsLine.ProtoReflect.Descriptor instead.
+func (*LyricsLine) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LyricsLine) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LyricsLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Lyrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackID int64         `protobuf:"varint,1,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+	Plain   string        `protobuf:"bytes,2,opt,name=Plain,proto3" json:"Plain,omitempty"`
+	Synced  bool          `protobuf:"varint,3,opt,name=Synced,proto3" json:"Synced,omitempty"`
+	Lines   []*LyricsLine `protobuf:"bytes,4,rep,name=Lines,proto3" json:"Lines,omitempty"`
+}
+
+func (x *Lyrics) Reset() {
+	*x = Lyrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lyrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lyrics) ProtoMessage() {}
+
+func (x *Lyrics) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lyrics.ProtoReflect.Descriptor instead.
+func (*Lyrics) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Lyrics) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+func (x *Lyrics) GetPlain() string {
+	if x != nil {
+		return x.Plain
+	}
+	return ""
+}
+
+func (x *Lyrics) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *Lyrics) GetLines() []*LyricsLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type RadioOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeedType string `protobuf:"bytes,1,opt,name=SeedType,proto3" json:"SeedType,omitempty"`
+	SeedID   int64  `protobuf:"varint,2,opt,name=SeedID,proto3" json:"SeedID,omitempty"`
+	Session  string `protobuf:"bytes,3,opt,name=Session,proto3" json:"Session,omitempty"`
+	UserID   int64  `protobuf:"varint,4,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Amount   int64  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *RadioOptions) Reset() {
+	*x = RadioOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RadioOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RadioOptions) ProtoMessage() {}
+
+func (x *RadioOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RadioOptions.ProtoReflect.Descriptor instead.
+func (*RadioOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RadioOptions) GetSeedType() string {
+	if x != nil {
+		return x.SeedType
+	}
+	return ""
+}
+
+func (x *RadioOptions) GetSeedID() int64 {
+	if x != nil {
+		return x.SeedID
+	}
+	return 0
+}
+
+func (x *RadioOptions) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *RadioOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RadioOptions) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RadioResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session string   `protobuf:"bytes,1,opt,name=Session,proto3" json:"Session,omitempty"`
+	Tracks  []*Track `protobuf:"bytes,2,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+}
+
+func (x *RadioResponse) Reset() {
+	*x = RadioResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RadioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RadioResponse) ProtoMessage() {}
+
+func (x *RadioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RadioResponse.ProtoReflect.Descriptor instead.
+func (*RadioResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RadioResponse) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *RadioResponse) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type RadioSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    int64   `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	SeedType  string  `protobuf:"bytes,2,opt,name=SeedType,proto3" json:"SeedType,omitempty"`
+	SeedID    int64   `protobuf:"varint,3,opt,name=SeedID,proto3" json:"SeedID,omitempty"`
+	ArtistIDs []int64 `protobuf:"varint,4,rep,packed,name=ArtistIDs,proto3" json:"ArtistIDs,omitempty"`
+	GenreIDs  []int64 `protobuf:"varint,5,rep,packed,name=GenreIDs,proto3" json:"GenreIDs,omitempty"`
+	Played    []int64 `protobuf:"varint,6,rep,packed,name=Played,proto3" json:"Played,omitempty"`
+}
+
+func (x *RadioSession) Reset() {
+	*x = RadioSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RadioSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RadioSession) ProtoMessage() {}
+
+func (x *RadioSession) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RadioSession.ProtoReflect.Descriptor instead.
+func (*RadioSession) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RadioSession) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RadioSession) GetSeedType() string {
+	if x != nil {
+		return x.SeedType
+	}
+	return ""
+}
+
+func (x *RadioSession) GetSeedID() int64 {
+	if x != nil {
+		return x.SeedID
+	}
+	return 0
+}
+
+func (x *RadioSession) GetArtistIDs() []int64 {
+	if x != nil {
+		return x.ArtistIDs
+	}
+	return nil
+}
+
+func (x *RadioSession) GetGenreIDs() []int64 {
+	if x != nil {
+		return x.GenreIDs
+	}
+	return nil
+}
+
+func (x *RadioSession) GetPlayed() []int64 {
+	if x != nil {
+		return x.Played
+	}
+	return nil
+}
+
+type DislikeTrackOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	TrackID int64 `protobuf:"varint,2,opt,name=TrackID,proto3" json:"TrackID,omitempty"`
+}
+
+func (x *DislikeTrackOptions) Reset() {
+	*x = DislikeTrackOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DislikeTrackOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikeTrackOptions) ProtoMessage() {}
+
+func (x *DislikeTrackOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikeTrackOptions.ProtoReflect.Descriptor instead.
+func (*DislikeTrackOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DislikeTrackOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DislikeTrackOptions) GetTrackID() int64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+type DislikeTrackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DislikeTrackResponse) Reset() {
+	*x = DislikeTrackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DislikeTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikeTrackResponse) ProtoMessage() {}
+
+func (x *DislikeTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikeTrackResponse.ProtoReflect.Descriptor instead.
+func (*DislikeTrackResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{72}
+}
+
+type ReleaseRadarOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *ReleaseRadarOptions) Reset() {
+	*x = ReleaseRadarOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseRadarOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRadarOptions) ProtoMessage() {}
+
+func (x *ReleaseRadarOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRadarOptions.ProtoReflect.Descriptor instead.
+func (*ReleaseRadarOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReleaseRadarOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ReleaseRadarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Albums     []*Album `protobuf:"bytes,1,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	PlaylistID int64    `protobuf:"varint,2,opt,name=PlaylistID,proto3" json:"PlaylistID,omitempty"`
+}
+
+func (x *ReleaseRadarResponse) Reset() {
+	*x = ReleaseRadarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseRadarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRadarResponse) ProtoMessage() {}
+
+func (x *ReleaseRadarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRadarResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseRadarResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReleaseRadarResponse) GetAlbums() []*Album {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *ReleaseRadarResponse) GetPlaylistID() int64 {
+	if x != nil {
+		return x.PlaylistID
+	}
+	return 0
+}
+
+type RecapOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Year   int64 `protobuf:"varint,2,opt,name=Year,proto3" json:"Year,omitempty"`
+}
+
+func (x *RecapOptions) Reset() {
+	*x = RecapOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapOptions) ProtoMessage() {}
+
+func (x *RecapOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapOptions.ProtoReflect.Descriptor instead.
+func (*RecapOptions) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RecapOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RecapOptions) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type RecapTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64  `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	Plays    int64  `protobuf:"varint,2,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Track    *Track `protobuf:"bytes,3,opt,name=Track,proto3" json:"Track,omitempty"`
+}
+
+func (x *RecapTrack) Reset() {
+	*x = RecapTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapTrack) ProtoMessage() {}
+
+func (x *RecapTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapTrack.ProtoReflect.Descriptor instead.
+func (*RecapTrack) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RecapTrack) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RecapTrack) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *RecapTrack) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+type RecapAlbum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64  `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	Plays    int64  `protobuf:"varint,2,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Album    *Album `protobuf:"bytes,3,opt,name=Album,proto3" json:"Album,omitempty"`
+}
+
+func (x *RecapAlbum) Reset() {
+	*x = RecapAlbum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapAlbum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapAlbum) ProtoMessage() {}
+
+func (x *RecapAlbum) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapAlbum.ProtoReflect.Descriptor instead.
+func (*RecapAlbum) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RecapAlbum) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RecapAlbum) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *RecapAlbum) GetAlbum() *Album {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+type RecapArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64   `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	Plays    int64   `protobuf:"varint,2,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Artist   *Artist `protobuf:"bytes,3,opt,name=Artist,proto3" json:"Artist,omitempty"`
+}
+
+func (x *RecapArtist) Reset() {
+	*x = RecapArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapArtist) ProtoMessage() {}
+
+func (x *RecapArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapArtist.ProtoReflect.Descriptor instead.
+func (*RecapArtist) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RecapArtist) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RecapArtist) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *RecapArtist) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+type RecapGenre struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64  `protobuf:"varint,1,opt,name=Position,proto3" json:"Position,omitempty"`
+	Plays    int64  `protobuf:"varint,2,opt,name=Plays,proto3" json:"Plays,omitempty"`
+	Genre    *Genre `protobuf:"bytes,3,opt,name=Genre,proto3" json:"Genre,omitempty"`
+}
+
+func (x *RecapGenre) Reset() {
+	*x = RecapGenre{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapGenre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapGenre) ProtoMessage() {}
+
+func (x *RecapGenre) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapGenre.ProtoReflect.Descriptor instead.
+func (*RecapGenre) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RecapGenre) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RecapGenre) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *RecapGenre) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+type RecapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year                 int64          `protobuf:"varint,1,opt,name=Year,proto3" json:"Year,omitempty"`
+	MinutesListened      int64          `protobuf:"varint,2,opt,name=MinutesListened,proto3" json:"MinutesListened,omitempty"`
+	Listens              int64          `protobuf:"varint,3,opt,name=Listens,proto3" json:"Listens,omitempty"`
+	LongestStreak        int64          `protobuf:"varint,4,opt,name=LongestStreak,proto3" json:"LongestStreak,omitempty"`
+	LongestStreakStart   string         `protobuf:"bytes,5,opt,name=LongestStreakStart,proto3" json:"LongestStreakStart,omitempty"`
+	MostPlayedDay        string         `protobuf:"bytes,6,opt,name=MostPlayedDay,proto3" json:"MostPlayedDay,omitempty"`
+	MostPlayedDayListens int64          `protobuf:"varint,7,opt,name=MostPlayedDayListens,proto3" json:"MostPlayedDayListens,omitempty"`
+	BuiltAt              int64          `protobuf:"varint,8,opt,name=BuiltAt,proto3" json:"BuiltAt,omitempty"`
+	Tracks               []*RecapTrack  `protobuf:"bytes,9,rep,name=Tracks,proto3" json:"Tracks,omitempty"`
+	Albums               []*RecapAlbum  `protobuf:"bytes,10,rep,name=Albums,proto3" json:"Albums,omitempty"`
+	Artists              []*RecapArtist `protobuf:"bytes,11,rep,name=Artists,proto3" json:"Artists,omitempty"`
+	Genres               []*RecapGenre  `protobuf:"bytes,12,rep,name=Genres,proto3" json:"Genres,omitempty"`
+}
+
+func (x *RecapResponse) Reset() {
+	*x = RecapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_music_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapResponse) ProtoMessage() {}
+
+func (x *RecapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_music_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapResponse.ProtoReflect.Descriptor instead.
+func (*RecapResponse) Descriptor() ([]byte, []int) {
+	return file_music_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RecapResponse) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *RecapResponse) GetMinutesListened() int64 {
+	if x != nil {
+		return x.MinutesListened
+	}
+	return 0
+}
+
+func (x *RecapResponse) GetListens() int64 {
+	if x != nil {
+		return x.Listens
+	}
+	return 0
+}
+
+func (x *RecapResponse) GetLongestStreak() int64 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *RecapResponse) GetLongestStreakStart() string {
+	if x != nil {
+		return x.LongestStreakStart
+	}
+	return ""
+}
+
+func (x *RecapResponse) GetMostPlayedDay() string {
+	if x != nil {
+		return x.MostPlayedDay
+	}
+	return ""
+}
+
+func (x *RecapResponse) GetMostPlayedDayListens() int64 {
+	if x != nil {
+		return x.MostPlayedDayListens
+	}
+	return 0
+}
+
+func (x *RecapResponse) GetBuiltAt() int64 {
+	if x != nil {
+		return x.BuiltAt
+	}
+	return 0
+}
+
+func (x *RecapResponse) GetTracks() []*RecapTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *RecapResponse) GetAlbums() []*RecapAlbum {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *RecapResponse) GetArtists() []*RecapArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *RecapResponse) GetGenres() []*RecapGenre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+var File_music_proto protoreflect.FileDescriptor
+
+var file_music_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a,
+	0x0b, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x0c, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x48, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x48, 0x69, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01,
+	0x0a, 0x13, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x65, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x55, 0x0a, 0x1b, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x22, 0x6e, 0x0a,
+	0x14, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x8a, 0x01,
+	0x0a, 0x10, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0xf4, 0x02, 0x0a, 0x0c, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x65,
+	0x6e, 0x72, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x59, 0x65, 0x61, 0x72, 0x46, 0x72, 0x6f,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x59, 0x65, 0x61, 0x72, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x59, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x59, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x12, 0x1f, 0x0a,
+	0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x45, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73,
+	0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x14, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a, 0x13,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x02,
+	0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x53, 0x61, 0x76, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x53, 0x61, 0x76, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0x96, 0x02, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1e, 0x0a, 0x06,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x06,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x42, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42, 0x69, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa4, 0x03, 0x0a, 0x05, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73,
+	0x12, 0x1c, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1f,
+	0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x49, 0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x49, 0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x48, 0x61, 0x73, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x22,
+	0xae, 0x02, 0x0a, 0x0a, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x49, 0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x49, 0x73, 0x49, 0x6e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x48, 0x61, 0x73, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x22, 0x84, 0x03, 0x0a, 0x11, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x41,
+	0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
+	0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x53, 0x61, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x53, 0x61, 0x76, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x75,
+	0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12,
+	0x1e, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12,
+	0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x07,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x52, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a,
+	0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x04,
+	0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0xff, 0x01, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x06,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x49, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x49, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x4f, 0x77,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x4f, 0x77, 0x6e, 0x12, 0x21,
+	0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4e,
+	0x0a, 0x1a, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x53,
+	0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f,
+	0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x49, 0x44, 0x22, 0xb7, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x12, 0x1f, 0x0a,
+	0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x08, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x65, 0x78, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x4c, 0x6f, 0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x22, 0x92, 0x01,
+	0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c,
+	0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x88, 0x01, 0x0a,
+	0x0a, 0x43, 0x68, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x52, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x26, 0x0a,
+	0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x0a,
+	0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x22,
+	0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x50, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x65, 0x6e,
+	0x72, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12,
+	0x20, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xb7, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x05, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x12, 0x1e, 0x0a, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x73, 0x12, 0x21, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x20, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x77, 0x0a,
+	0x13, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x7a, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x34, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2b, 0x0a, 0x0a, 0x48, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x0b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0e,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x22, 0x45,
+	0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x44, 0x22, 0x1c, 0x0a,
+	0x1a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
+	0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49,
+	0x44, 0x22, 0x43, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f,
+	0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4c, 0x6f,
+	0x73, 0x73, 0x6c, 0x65, 0x73, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x22, 0x49, 0x0a, 0x13, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x0e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x50, 0x61,
+	0x67, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x0d, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
+	0x22, 0x34, 0x0a, 0x0a, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x22, 0x73, 0x0a, 0x06, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c,
+	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x69, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0c,
+	0x52, 0x61, 0x64, 0x69, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x53, 0x65, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x65, 0x64,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x65, 0x65, 0x64, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x52, 0x61,
+	0x64, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x65, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x53, 0x65, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65,
+	0x65, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x65, 0x65, 0x64,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x08, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x16, 0x0a,
+	0x14, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x61, 0x64, 0x61, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x3a, 0x0a, 0x0c,
+	0x52, 0x65, 0x63, 0x61, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72, 0x22, 0x5c, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x5c, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x61, 0x70, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x05, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x22, 0x60, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x61, 0x70, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x61, 0x70, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x05, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x22, 0xc8, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x59, 0x65, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x12, 0x2e, 0x0a, 0x12, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x6f, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x44, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4d, 0x6f, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x44, 0x61, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x4d, 0x6f,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x44, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x4d, 0x6f, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x44, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x65, 0x63, 0x61, 0x70,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a,
+	0x06, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x52, 0x65, 0x63, 0x61, 0x70, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x52, 0x07, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x47, 0x65,
+	0x6e, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x65, 0x63,
+	0x61, 0x70, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x32,
+	0xf5, 0x11, 0x0a, 0x05, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x07, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x07, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0d, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x08, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0e, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x07, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x14,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x1a, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x09, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x12, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x15, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x0c, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0d, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0c, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x0c, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x22, 0x00, 0x12, 0x29,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x0c, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x08, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x0c, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0e, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x07,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0c, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1b, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x1a, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1b, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x1b, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1c,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x12,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1c, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x54, 0x6f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x21, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x15, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0f, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x2b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x12, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x07, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
+	0x47, 0x65, 0x6e, 0x72, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x12, 0x2e, 0x47,
+	0x65, 0x6e, 0x72, 0x65, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x14, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x73, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x0e, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x07, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x06, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x11, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x10, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x12, 0x11, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x10, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x10, 0x2e, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x2c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x73, 0x12, 0x0f, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x07, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x08, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x28, 0x0a, 0x05, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x12, 0x0d, 0x2e, 0x52, 0x61, 0x64, 0x69,
+	0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0e, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x44, 0x69,
+	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x44, 0x69, 0x73,
+	0x6c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x15, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x12,
+	0x14, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x15, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x12, 0x14,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61, 0x64, 0x61, 0x72, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x28, 0x0a,
+	0x05, 0x52, 0x65, 0x63, 0x61, 0x70, 0x12, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0e, 0x2e, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_music_proto_rawDescOnce sync.Once
+	file_music_proto_rawDescData = file_music_proto_rawDesc
+)
+
+func file_music_proto_rawDescGZIP() []byte {
+	file_music_proto_rawDescOnce.Do(func() {
+		file_music_proto_rawDescData = protoimpl.X.CompressGZIP(file_music_proto_rawDescData)
+	})
+	return file_music_proto_rawDescData
+}
+
+var file_music_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_music_proto_goTypes = []interface{}{
+	(*PageRequest)(nil),                      // 0: PageRequest
+	(*PageResponse)(nil),                     // 1: PageResponse
+	(*Selection)(nil),                        // 2: Selection
+	(*RandomTracksOptions)(nil),              // 3: RandomTracksOptions
+	(*RandomAlbumsOptions)(nil),              // 4: RandomAlbumsOptions
+	(*RandomArtistsOptions)(nil),             // 5: RandomArtistsOptions
+	(*IncrementListenCountOptions)(nil),      // 6: IncrementListenCountOptions
+	(*ArtistProfileOptions)(nil),             // 7: ArtistProfileOptions
+	(*AlbumPageOptions)(nil),                 // 8: AlbumPageOptions
+	(*SearchFilter)(nil),                     // 9: SearchFilter
+	(*FindOptions)(nil),                      // 10: FindOptions
+	(*UserPlaylistsOptions)(nil),             // 11: UserPlaylistsOptions
+	(*PlaylistPageOptions)(nil),              // 12: PlaylistPageOptions
+	(*Album)(nil),                            // 13: Album
+	(*Artist)(nil),                           // 14: Artist
+	(*Track)(nil),                            // 15: Track
+	(*AlbumTrack)(nil),                       // 16: AlbumTrack
+	(*PlaylistData)(nil),                     // 17: PlaylistData
+	(*AlbumPageResponse)(nil),                // 18: AlbumPageResponse
+	(*Tracks)(nil),                           // 19: Tracks
+	(*Albums)(nil),                           // 20: Albums
+	(*Artists)(nil),                          // 21: Artists
+	(*PlaylistsData)(nil),                    // 22: PlaylistsData
+	(*FindResponse)(nil),                     // 23: FindResponse
+	(*PlaylistPageResponse)(nil),             // 24: PlaylistPageResponse
+	(*IncrementListenCountEmpty)(nil),        // 25: IncrementListenCountEmpty
+	(*AddTrackToFavoritesOptions)(nil),       // 26: AddTrackToFavoritesOptions
+	(*DeleteTrackFromFavoritesOptions)(nil),  // 27: DeleteTrackFromFavoritesOptions
+	(*FavoritesFilter)(nil),                  // 28: FavoritesFilter
+	(*UserFavoritesOptions)(nil),             // 29: UserFavoritesOptions
+	(*AddTrackToFavoritesResponse)(nil),      // 30: AddTrackToFavoritesResponse
+	(*ChartsOptions)(nil),                    // 31: ChartsOptions
+	(*ChartTrack)(nil),                       // 32: ChartTrack
+	(*ChartAlbum)(nil),                       // 33: ChartAlbum
+	(*ChartArtist)(nil),                      // 34: ChartArtist
+	(*ChartsResponse)(nil),                   // 35: ChartsResponse
+	(*Genre)(nil),                            // 36: Genre
+	(*Genres)(nil),                           // 37: Genres
+	(*ListGenresOptions)(nil),                // 38: ListGenresOptions
+	(*GenrePageOptions)(nil),                 // 39: GenrePageOptions
+	(*GenrePageResponse)(nil),                // 40: GenrePageResponse
+	(*ArtistTracksOptions)(nil),              // 41: ArtistTracksOptions
+	(*ArtistAlbumsOptions)(nil),              // 42: ArtistAlbumsOptions
+	(*SuggestOptions)(nil),                   // 43: SuggestOptions
+	(*MatchRange)(nil),                       // 44: MatchRange
+	(*Suggestion)(nil),                       // 45: Suggestion
+	(*Suggestions)(nil),                      // 46: Suggestions
+	(*RecentSearch)(nil),                     // 47: RecentSearch
+	(*RecentSearches)(nil),                   // 48: RecentSearches
+	(*RecentSearchesOptions)(nil),            // 49: RecentSearchesOptions
+	(*RecordSearchResultOptions)(nil),        // 50: RecordSearchResultOptions
+	(*RecordSearchResultResponse)(nil),       // 51: RecordSearchResultResponse
+	(*DeleteRecentSearchOptions)(nil),        // 52: DeleteRecentSearchOptions
+	(*DeleteRecentSearchResponse)(nil),       // 53: DeleteRecentSearchResponse
+	(*ClearRecentSearchesOptions)(nil),       // 54: ClearRecentSearchesOptions
+	(*ClearRecentSearchesResponse)(nil),      // 55: ClearRecentSearchesResponse
+	(*TrackFileOptions)(nil),                 // 56: TrackFileOptions
+	(*TrackFileResponse)(nil),                // 57: TrackFileResponse
+	(*DeleteTrackFromFavoritesResponse)(nil), // 58: DeleteTrackFromFavoritesResponse
+	(*GetTrackOptions)(nil),                  // 59: GetTrackOptions
+	(*GetTracksOptions)(nil),                 // 60: GetTracksOptions
+	(*SaveAlbumOptions)(nil),                 // 61: SaveAlbumOptions
+	(*FollowArtistOptions)(nil),              // 62: FollowArtistOptions
+	(*LibraryOptions)(nil),                   // 63: LibraryOptions
+	(*LibraryResponse)(nil),                  // 64: LibraryResponse
+	(*LyricsOptions)(nil),                    // 65: LyricsOptions
+	(*LyricsLine)(nil),                       // 66: LyricsLine
+	(*Lyrics)(nil),                           // 67: Lyrics
+	(*RadioOptions)(nil),                     // 68: RadioOptions
+	(*RadioResponse)(nil),                    // 69: RadioResponse
+	(*RadioSession)(nil),                     // 70: RadioSession
+	(*DislikeTrackOptions)(nil),              // 71: DislikeTrackOptions
+	(*DislikeTrackResponse)(nil),             // 72: DislikeTrackResponse
+	(*ReleaseRadarOptions)(nil),              // 73: ReleaseRadarOptions
+	(*ReleaseRadarResponse)(nil),             // 74: ReleaseRadarResponse
+	(*RecapOptions)(nil),                     // 75: RecapOptions
+	(*RecapTrack)(nil),                       // 76: RecapTrack
+	(*RecapAlbum)(nil),                       // 77: RecapAlbum
+	(*RecapArtist)(nil),                      // 78: RecapArtist
+	(*RecapGenre)(nil),                       // 79: RecapGenre
+	(*RecapResponse)(nil),                    // 80: RecapResponse
+}
+var file_music_proto_depIdxs = []int32{
+	0,   // 0: AlbumPageOptions.Page:type_name -> PageRequest
+	0,   // 1: FindOptions.Page:type_name -> PageRequest
+	9,   // 2: FindOptions.Filter:type_name -> SearchFilter
+	0,   // 3: UserPlaylistsOptions.Page:type_name -> PageRequest
+	0,   // 4: PlaylistPageOptions.Page:type_name -> PageRequest
+	15,  // 5: Artist.Tracks:type_name -> Track
+	13,  // 6: Artist.Albums:type_name -> Album
+	13,  // 7: Track.Album:type_name -> Album
+	14,  // 8: Track.Artist:type_name -> Artist
+	14,  // 9: AlbumPageResponse.Artist:type_name -> Artist
+	16,  // 10: AlbumPageResponse.Tracks:type_name -> AlbumTrack
+	1,   // 11: AlbumPageResponse.Page:type_name -> PageResponse
+	15,  // 12: Tracks.Tracks:type_name -> Track
+	1,   // 13: Tracks.Page:type_name -> PageResponse
+	2,   // 14: Tracks.Selection:type_name -> Selection
+	13,  // 15: Albums.Albums:type_name -> Album
+	1,   // 16: Albums.Page:type_name -> PageResponse
+	2,   // 17: Albums.Selection:type_name -> Selection
+	14,  // 18: Artists.Artists:type_name -> Artist
+	1,   // 19: Artists.Page:type_name -> PageResponse
+	2,   // 20: Artists.Selection:type_name -> Selection
+	17,  // 21: PlaylistsData.Playlists:type_name -> PlaylistData
+	1,   // 22: PlaylistsData.Page:type_name -> PageResponse
+	15,  // 23: FindResponse.Tracks:type_name -> Track
+	13,  // 24: FindResponse.Albums:type_name -> Album
+	14,  // 25: FindResponse.Artists:type_name -> Artist
+	1,   // 26: FindResponse.Page:type_name -> PageResponse
+	15,  // 27: PlaylistPageResponse.Tracks:type_name -> Track
+	1,   // 28: PlaylistPageResponse.Page:type_name -> PageResponse
+	0,   // 29: UserFavoritesOptions.Page:type_name -> PageRequest
+	28,  // 30: UserFavoritesOptions.Filter:type_name -> FavoritesFilter
+	15,  // 31: ChartTrack.Track:type_name -> Track
+	13,  // 32: ChartAlbum.Album:type_name -> Album
+	14,  // 33: ChartArtist.Artist:type_name -> Artist
+	32,  // 34: ChartsResponse.Tracks:type_name -> ChartTrack
+	33,  // 35: ChartsResponse.Albums:type_name -> ChartAlbum
+	34,  // 36: ChartsResponse.Artists:type_name -> ChartArtist
+	36,  // 37: Genres.Genres:type_name -> Genre
+	0,   // 38: GenrePageOptions.Page:type_name -> PageRequest
+	36,  // 39: GenrePageResponse.Genre:type_name -> Genre
+	15,  // 40: GenrePageResponse.Tracks:type_name -> Track
+	13,  // 41: GenrePageResponse.Albums:type_name -> Album
+	14,  // 42: GenrePageResponse.Artists:type_name -> Artist
+	1,   // 43: GenrePageResponse.Page:type_name -> PageResponse
+	0,   // 44: ArtistTracksOptions.Page:type_name -> PageRequest
+	0,   // 45: ArtistAlbumsOptions.Page:type_name -> PageRequest
+	44,  // 46: Suggestion.Highlights:type_name -> MatchRange
+	45,  // 47: Suggestions.Suggestions:type_name -> Suggestion
+	47,  // 48: RecentSearches.RecentSearches:type_name -> RecentSearch
+	0,   // 49: LibraryOptions.Page:type_name -> PageRequest
+	66,  // 50: Lyrics.Lines:type_name -> LyricsLine
+	15,  // 51: RadioResponse.Tracks:type_name -> Track
+	13,  // 52: ReleaseRadarResponse.Albums:type_name -> Album
+	15,  // 53: RecapTrack.Track:type_name -> Track
+	13,  // 54: RecapAlbum.Album:type_name -> Album
+	14,  // 55: RecapArtist.Artist:type_name -> Artist
+	36,  // 56: RecapGenre.Genre:type_name -> Genre
+	76,  // 57: RecapResponse.Tracks:type_name -> RecapTrack
+	77,  // 58: RecapResponse.Albums:type_name -> RecapAlbum
+	78,  // 59: RecapResponse.Artists:type_name -> RecapArtist
+	79,  // 60: RecapResponse.Genres:type_name -> RecapGenre
+	3,   // 61: Music.RandomTracks:input_type -> RandomTracksOptions
+	4,   // 62: Music.RandomAlbums:input_type -> RandomAlbumsOptions
+	5,   // 63: Music.RandomArtists:input_type -> RandomArtistsOptions
+	11,  // 64: Music.UserPlaylists:input_type -> UserPlaylistsOptions
+	7,   // 65: Music.ArtistProfile:input_type -> ArtistProfileOptions
+	6,   // 66: Music.IncrementListenCount:input_type -> IncrementListenCountOptions
+	8,   // 67: Music.AlbumPage:input_type -> AlbumPageOptions
+	12,  // 68: Music.PlaylistPage:input_type -> PlaylistPageOptions
+	10,  // 69: Music.Find:input_type -> FindOptions
+	10,  // 70: Music.SearchTracks:input_type -> FindOptions
+	10,  // 71: Music.SearchAlbums:input_type -> FindOptions
+	10,  // 72: Music.SearchArtists:input_type -> FindOptions
+	10,  // 73: Music.SearchPlaylists:input_type -> FindOptions
+	43,  // 74: Music.Suggest:input_type -> SuggestOptions
+	49,  // 75: Music.GetRecentSearches:input_type -> RecentSearchesOptions
+	50,  // 76: Music.RecordSearchResult:input_type -> RecordSearchResultOptions
+	52,  // 77: Music.DeleteRecentSearch:input_type -> DeleteRecentSearchOptions
+	54,  // 78: Music.ClearRecentSearches:input_type -> ClearRecentSearchesOptions
+	56,  // 79: Music.TrackFile:input_type -> TrackFileOptions
+	26,  // 80: Music.AddTrackToFavorites:input_type -> AddTrackToFavoritesOptions
+	27,  // 81: Music.DeleteTrackFromFavorites:input_type -> DeleteTrackFromFavoritesOptions
+	29,  // 82: Music.GetFavoriteTracks:input_type -> UserFavoritesOptions
+	31,  // 83: Music.Charts:input_type -> ChartsOptions
+	38,  // 84: Music.ListGenres:input_type -> ListGenresOptions
+	39,  // 85: Music.GenrePage:input_type -> GenrePageOptions
+	41,  // 86: Music.ArtistTracks:input_type -> ArtistTracksOptions
+	42,  // 87: Music.ArtistAlbums:input_type -> ArtistAlbumsOptions
+	65,  // 88: Music.GetLyrics:input_type -> LyricsOptions
+	59,  // 89: Music.GetTrack:input_type -> GetTrackOptions
+	60,  // 90: Music.GetTracks:input_type -> GetTracksOptions
+	61,  // 91: Music.SaveAlbum:input_type -> SaveAlbumOptions
+	61,  // 92: Music.UnsaveAlbum:input_type -> SaveAlbumOptions
+	62,  // 93: Music.FollowArtist:input_type -> FollowArtistOptions
+	62,  // 94: Music.UnfollowArtist:input_type -> FollowArtistOptions
+	63,  // 95: Music.GetSavedAlbums:input_type -> LibraryOptions
+	63,  // 96: Music.GetFollowedArtists:input_type -> LibraryOptions
+	68,  // 97: Music.Radio:input_type -> RadioOptions
+	71,  // 98: Music.DislikeTrack:input_type -> DislikeTrackOptions
+	71,  // 99: Music.DeleteTrackDislike:input_type -> DislikeTrackOptions
+	73,  // 100: Music.ReleaseRadar:input_type -> ReleaseRadarOptions
+	75,  // 101: Music.Recap:input_type -> RecapOptions
+	19,  // 102: Music.RandomTracks:output_type -> Tracks
+	20,  // 103: Music.RandomAlbums:output_type -> Albums
+	21,  // 104: Music.RandomArtists:output_type -> Artists
+	22,  // 105: Music.UserPlaylists:output_type -> PlaylistsData
+	14,  // 106: Music.ArtistProfile:output_type -> Artist
+	25,  // 107: Music.IncrementListenCount:output_type -> IncrementListenCountEmpty
+	18,  // 108: Music.AlbumPage:output_type -> AlbumPageResponse
+	24,  // 109: Music.PlaylistPage:output_type -> PlaylistPageResponse
+	23,  // 110: Music.Find:output_type -> FindResponse
+	19,  // 111: Music.SearchTracks:output_type -> Tracks
+	20,  // 112: Music.SearchAlbums:output_type -> Albums
+	21,  // 113: Music.SearchArtists:output_type -> Artists
+	22,  // 114: Music.SearchPlaylists:output_type -> PlaylistsData
+	46,  // 115: Music.Suggest:output_type -> Suggestions
+	48,  // 116: Music.GetRecentSearches:output_type -> RecentSearches
+	51,  // 117: Music.RecordSearchResult:output_type -> RecordSearchResultResponse
+	53,  // 118: Music.DeleteRecentSearch:output_type -> DeleteRecentSearchResponse
+	55,  // 119: Music.ClearRecentSearches:output_type -> ClearRecentSearchesResponse
+	57,  // 120: Music.TrackFile:output_type -> TrackFileResponse
+	30,  // 121: Music.AddTrackToFavorites:output_type -> AddTrackToFavoritesResponse
+	58,  // 122: Music.DeleteTrackFromFavorites:output_type -> DeleteTrackFromFavoritesResponse
+	19,  // 123: Music.GetFavoriteTracks:output_type -> Tracks
+	35,  // 124: Music.Charts:output_type -> ChartsResponse
+	37,  // 125: Music.ListGenres:output_type -> Genres
+	40,  // 126: Music.GenrePage:output_type -> GenrePageResponse
+	19,  // 127: Music.ArtistTracks:output_type -> Tracks
+	20,  // 128: Music.ArtistAlbums:output_type -> Albums
+	67,  // 129: Music.GetLyrics:output_type -> Lyrics
+	15,  // 130: Music.GetTrack:output_type -> Track
+	19,  // 131: Music.GetTracks:output_type -> Tracks
+	64,  // 132: Music.SaveAlbum:output_type -> LibraryResponse
+	64,  // 133: Music.UnsaveAlbum:output_type -> LibraryResponse
+	64,  // 134: Music.FollowArtist:output_type -> LibraryResponse
+	64,  // 135: Music.UnfollowArtist:output_type -> LibraryResponse
+	20,  // 136: Music.GetSavedAlbums:output_type -> Albums
+	21,  // 137: Music.GetFollowedArtists:output_type -> Artists
+	69,  // 138: Music.Radio:output_type -> RadioResponse
+	72,  // 139: Music.DislikeTrack:output_type -> DislikeTrackResponse
+	72,  // 140: Music.DeleteTrackDislike:output_type -> DislikeTrackResponse
+	74,  // 141: Music.ReleaseRadar:output_type -> ReleaseRadarResponse
+	80,  // 142: Music.Recap:output_type -> RecapResponse
+	102, // [102:143] is the sub-list for method output_type
+	61,  // [61:102] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_music_proto_init() }
+func file_music_proto_init() {
+	if File_music_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_music_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Selection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomTracksOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomAlbumsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomArtistsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrementListenCountOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistProfileOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumPageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPlaylistsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistPageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Album); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Track); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tracks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Albums); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artists); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistPageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrementListenCountEmpty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTrackToFavoritesOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTrackFromFavoritesOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoritesFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFavoritesOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTrackToFavoritesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartAlbum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Genre); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Genres); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGenresOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenrePageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenrePageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistTracksOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistAlbumsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentSearches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentSearchesOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordSearchResultOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordSearchResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRecentSearchOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRecentSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRecentSearchesOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRecentSearchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackFileOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTrackFromFavoritesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrackOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTracksOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveAlbumOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowArtistOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LyricsOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LyricsLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lyrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RadioOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RadioResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RadioSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikeTrackOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DislikeTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRadarOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRadarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecapOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecapTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecapAlbum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecapArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecapGenre); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_music_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_music_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_music_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_music_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   81,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_music_proto_goTypes,
+		DependencyIndexes: file_music_proto_depIdxs,
+		MessageInfos:      file_music_proto_msgTypes,
+	}.Build()
+	File_music_proto = out.File
+	file_music_proto_rawDesc = nil
+	file_music_proto_goTypes = nil
+	file_music_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// MusicClient is the client API for Music service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MusicClient interface {
+	RandomTracks(ctx context.Context, in *RandomTracksOptions, opts ...grpc.CallOption) (*Tracks, error)
+	RandomAlbums(ctx context.Context, in *RandomAlbumsOptions, opts ...grpc.CallOption) (*Albums, error)
+	RandomArtists(ctx context.Context, in *RandomArtistsOptions, opts ...grpc.CallOption) (*Artists, error)
+	UserPlaylists(ctx context.Context, in *UserPlaylistsOptions, opts ...grpc.CallOption) (*PlaylistsData, error)
+	ArtistProfile(ctx context.Context, in *ArtistProfileOptions, opts ...grpc.CallOption) (*Artist, error)
+	IncrementListenCount(ctx context.Context, in *IncrementListenCountOptions, opts ...grpc.CallOption) (*IncrementListenCountEmpty, error)
+	AlbumPage(ctx context.Context, in *AlbumPageOptions, opts ...grpc.CallOption) (*AlbumPageResponse, error)
+	PlaylistPage(ctx context.Context, in *PlaylistPageOptions, opts ...grpc.CallOption) (*PlaylistPageResponse, error)
+	Find(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*FindResponse, error)
+	SearchTracks(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*Tracks, error)
+	SearchAlbums(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*Albums, error)
+	SearchArtists(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*Artists, error)
+	SearchPlaylists(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*PlaylistsData, error)
+	Suggest(ctx context.Context, in *SuggestOptions, opts ...grpc.CallOption) (*Suggestions, error)
+	GetRecentSearches(ctx context.Context, in *RecentSearchesOptions, opts ...grpc.CallOption) (*RecentSearches, error)
+	RecordSearchResult(ctx context.Context, in *RecordSearchResultOptions, opts ...grpc.CallOption) (*RecordSearchResultResponse, error)
+	DeleteRecentSearch(ctx context.Context, in *DeleteRecentSearchOptions, opts ...grpc.CallOption) (*DeleteRecentSearchResponse, error)
+	ClearRecentSearches(ctx context.Context, in *ClearRecentSearchesOptions, opts ...grpc.CallOption) (*ClearRecentSearchesResponse, error)
+	TrackFile(ctx context.Context, in *TrackFileOptions, opts ...grpc.CallOption) (*TrackFileResponse, error)
+	AddTrackToFavorites(ctx context.Context, in *AddTrackToFavoritesOptions, opts ...grpc.CallOption) (*AddTrackToFavoritesResponse, error)
+	DeleteTrackFromFavorites(ctx context.Context, in *DeleteTrackFromFavoritesOptions, opts ...grpc.CallOption) (*DeleteTrackFromFavoritesResponse, error)
+	GetFavoriteTracks(ctx context.Context, in *UserFavoritesOptions, opts ...grpc.CallOption) (*Tracks, error)
+	Charts(ctx context.Context, in *ChartsOptions, opts ...grpc.CallOption) (*ChartsResponse, error)
+	ListGenres(ctx context.Context, in *ListGenresOptions, opts ...grpc.CallOption) (*Genres, error)
+	GenrePage(ctx context.Context, in *GenrePageOptions, opts ...grpc.CallOption) (*GenrePageResponse, error)
+	ArtistTracks(ctx context.Context, in *ArtistTracksOptions, opts ...grpc.CallOption) (*Tracks, error)
+	ArtistAlbums(ctx context.Context, in *ArtistAlbumsOptions, opts ...grpc.CallOption) (*Albums, error)
+	GetLyrics(ctx context.Context, in *LyricsOptions, opts ...grpc.CallOption) (*Lyrics, error)
+	GetTrack(ctx context.Context, in *GetTrackOptions, opts ...grpc.CallOption) (*Track, error)
+	GetTracks(ctx context.Context, in *GetTracksOptions, opts ...grpc.CallOption) (*Tracks, error)
+	SaveAlbum(ctx context.Context, in *SaveAlbumOptions, opts ...grpc.CallOption) (*LibraryResponse, error)
+	UnsaveAlbum(ctx context.Context, in *SaveAlbumOptions, opts ...grpc.CallOption) (*LibraryResponse, error)
+	FollowArtist(ctx context.Context, in *FollowArtistOptions, opts ...grpc.CallOption) (*LibraryResponse, error)
+	UnfollowArtist(ctx context.Context, in *FollowArtistOptions, opts ...grpc.CallOption) (*LibraryResponse, error)
+	GetSavedAlbums(ctx context.Context, in *LibraryOptions, opts ...grpc.CallOption) (*Albums, error)
+	GetFollowedArtists(ctx context.Context, in *LibraryOptions, opts ...grpc.CallOption) (*Artists, error)
+	Radio(ctx context.Context, in *RadioOptions, opts ...grpc.CallOption) (*RadioResponse, error)
+	DislikeTrack(ctx context.Context, in *DislikeTrackOptions, opts ...grpc.CallOption) (*DislikeTrackResponse, error)
+	DeleteTrackDislike(ctx context.Context, in *DislikeTrackOptions, opts ...grpc.CallOption) (*DislikeTrackResponse, error)
+	ReleaseRadar(ctx context.Context, in *ReleaseRadarOptions, opts ...grpc.CallOption) (*ReleaseRadarResponse, error)
+	Recap(ctx context.Context, in *RecapOptions, opts ...grpc.CallOption) (*RecapResponse, error)
+}
+
+type musicClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMusicClient(cc grpc.ClientConnInterface) MusicClient {
+	return &musicClient{cc}
+}
+
+func (c *musicClient) RandomTracks(ctx context.Context, in *RandomTracksOptions, opts ...grpc.CallOption) (*Tracks, error) {
+	out := new(Tracks)
+	err := c.cc.Invoke(ctx, "/Music/RandomTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) RandomAlbums(ctx context.Context, in *RandomAlbumsOptions, opts ...grpc.CallOption) (*Albums, error) {
+	out := new(Albums)
+	err := c.cc.Invoke(ctx, "/Music/RandomAlbums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) RandomArtists(ctx context.Context, in *RandomArtistsOptions, opts ...grpc.CallOption) (*Artists, error) {
+	out := new(Artists)
+	err := c.cc.Invoke(ctx, "/Music/RandomArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) UserPlaylists(ctx context.Context, in *UserPlaylistsOptions, opts ...grpc.CallOption) (*PlaylistsData, error) {
+	out := new(PlaylistsData)
+	err := c.cc.Invoke(ctx, "/Music/UserPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) ArtistProfile(ctx context.Context, in *ArtistProfileOptions, opts ...grpc.CallOption) (*Artist, error) {
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, "/Music/ArtistProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) IncrementListenCount(ctx context.Context, in *IncrementListenCountOptions, opts ...grpc.CallOption) (*IncrementListenCountEmpty, error) {
+	out := new(IncrementListenCountEmpty)
+	err := c.cc.Invoke(ctx, "/Music/IncrementListenCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) AlbumPage(ctx context.Context, in *AlbumPageOptions, opts ...grpc.CallOption) (*AlbumPageResponse, error) {
+	out := new(AlbumPageResponse)
+	err := c.cc.Invoke(ctx, "/Music/AlbumPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) PlaylistPage(ctx context.Context, in *PlaylistPageOptions, opts ...grpc.CallOption) (*PlaylistPageResponse, error) {
+	out := new(PlaylistPageResponse)
+	err := c.cc.Invoke(ctx, "/Music/PlaylistPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) Find(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*FindResponse, error) {
+	out := new(FindResponse)
+	err := c.cc.Invoke(ctx, "/Music/Find", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) SearchTracks(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*Tracks, error) {
+	out := new(Tracks)
+	err := c.cc.Invoke(ctx, "/Music/SearchTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) SearchAlbums(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*Albums, error) {
+	out := new(Albums)
+	err := c.cc.Invoke(ctx, "/Music/SearchAlbums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) SearchArtists(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*Artists, error) {
+	out := new(Artists)
+	err := c.cc.Invoke(ctx, "/Music/SearchArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) SearchPlaylists(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*PlaylistsData, error) {
+	out := new(PlaylistsData)
+	err := c.cc.Invoke(ctx, "/Music/SearchPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) Suggest(ctx context.Context, in *SuggestOptions, opts ...grpc.CallOption) (*Suggestions, error) {
+	out := new(Suggestions)
+	err := c.cc.Invoke(ctx, "/Music/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetRecentSearches(ctx context.Context, in *RecentSearchesOptions, opts ...grpc.CallOption) (*RecentSearches, error) {
+	out := new(RecentSearches)
+	err := c.cc.Invoke(ctx, "/Music/GetRecentSearches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) RecordSearchResult(ctx context.Context, in *RecordSearchResultOptions, opts ...grpc.CallOption) (*RecordSearchResultResponse, error) {
+	out := new(RecordSearchResultResponse)
+	err := c.cc.Invoke(ctx, "/Music/RecordSearchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) DeleteRecentSearch(ctx context.Context, in *DeleteRecentSearchOptions, opts ...grpc.CallOption) (*DeleteRecentSearchResponse, error) {
+	out := new(DeleteRecentSearchResponse)
+	err := c.cc.Invoke(ctx, "/Music/DeleteRecentSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) ClearRecentSearches(ctx context.Context, in *ClearRecentSearchesOptions, opts ...grpc.CallOption) (*ClearRecentSearchesResponse, error) {
+	out := new(ClearRecentSearchesResponse)
+	err := c.cc.Invoke(ctx, "/Music/ClearRecentSearches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) TrackFile(ctx context.Context, in *TrackFileOptions, opts ...grpc.CallOption) (*TrackFileResponse, error) {
+	out := new(TrackFileResponse)
+	err := c.cc.Invoke(ctx, "/Music/TrackFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) AddTrackToFavorites(ctx context.Context, in *AddTrackToFavoritesOptions, opts ...grpc.CallOption) (*AddTrackToFavoritesResponse, error) {
+	out := new(AddTrackToFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/Music/AddTrackToFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) DeleteTrackFromFavorites(ctx context.Context, in *DeleteTrackFromFavoritesOptions, opts ...grpc.CallOption) (*DeleteTrackFromFavoritesResponse, error) {
+	out := new(DeleteTrackFromFavoritesResponse)
+	err := c.cc.Invoke(ctx, "/Music/DeleteTrackFromFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetFavoriteTracks(ctx context.Context, in *UserFavoritesOptions, opts ...grpc.CallOption) (*Tracks, error) {
+	out := new(Tracks)
+	err := c.cc.Invoke(ctx, "/Music/GetFavoriteTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) Charts(ctx context.Context, in *ChartsOptions, opts ...grpc.CallOption) (*ChartsResponse, error) {
+	out := new(ChartsResponse)
+	err := c.cc.Invoke(ctx, "/Music/Charts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) ListGenres(ctx context.Context, in *ListGenresOptions, opts ...grpc.CallOption) (*Genres, error) {
+	out := new(Genres)
+	err := c.cc.Invoke(ctx, "/Music/ListGenres", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GenrePage(ctx context.Context, in *GenrePageOptions, opts ...grpc.CallOption) (*GenrePageResponse, error) {
+	out := new(GenrePageResponse)
+	err := c.cc.Invoke(ctx, "/Music/GenrePage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) ArtistTracks(ctx context.Context, in *ArtistTracksOptions, opts ...grpc.CallOption) (*Tracks, error) {
+	out := new(Tracks)
+	err := c.cc.Invoke(ctx, "/Music/ArtistTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) ArtistAlbums(ctx context.Context, in *ArtistAlbumsOptions, opts ...grpc.CallOption) (*Albums, error) {
+	out := new(Albums)
+	err := c.cc.Invoke(ctx, "/Music/ArtistAlbums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetLyrics(ctx context.Context, in *LyricsOptions, opts ...grpc.CallOption) (*Lyrics, error) {
+	out := new(Lyrics)
+	err := c.cc.Invoke(ctx, "/Music/GetLyrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetTrack(ctx context.Context, in *GetTrackOptions, opts ...grpc.CallOption) (*Track, error) {
+	out := new(Track)
+	err := c.cc.Invoke(ctx, "/Music/GetTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetTracks(ctx context.Context, in *GetTracksOptions, opts ...grpc.CallOption) (*Tracks, error) {
+	out := new(Tracks)
+	err := c.cc.Invoke(ctx, "/Music/GetTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) SaveAlbum(ctx context.Context, in *SaveAlbumOptions, opts ...grpc.CallOption) (*LibraryResponse, error) {
+	out := new(LibraryResponse)
+	err := c.cc.Invoke(ctx, "/Music/SaveAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) UnsaveAlbum(ctx context.Context, in *SaveAlbumOptions, opts ...grpc.CallOption) (*LibraryResponse, error) {
+	out := new(LibraryResponse)
+	err := c.cc.Invoke(ctx, "/Music/UnsaveAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) FollowArtist(ctx context.Context, in *FollowArtistOptions, opts ...grpc.CallOption) (*LibraryResponse, error) {
+	out := new(LibraryResponse)
+	err := c.cc.Invoke(ctx, "/Music/FollowArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) UnfollowArtist(ctx context.Context, in *FollowArtistOptions, opts ...grpc.CallOption) (*LibraryResponse, error) {
+	out := new(LibraryResponse)
+	err := c.cc.Invoke(ctx, "/Music/UnfollowArtist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetSavedAlbums(ctx context.Context, in *LibraryOptions, opts ...grpc.CallOption) (*Albums, error) {
+	out := new(Albums)
+	err := c.cc.Invoke(ctx, "/Music/GetSavedAlbums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) GetFollowedArtists(ctx context.Context, in *LibraryOptions, opts ...grpc.CallOption) (*Artists, error) {
+	out := new(Artists)
+	err := c.cc.Invoke(ctx, "/Music/GetFollowedArtists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) Radio(ctx context.Context, in *RadioOptions, opts ...grpc.CallOption) (*RadioResponse, error) {
+	out := new(RadioResponse)
+	err := c.cc.Invoke(ctx, "/Music/Radio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) DislikeTrack(ctx context.Context, in *DislikeTrackOptions, opts ...grpc.CallOption) (*DislikeTrackResponse, error) {
+	out := new(DislikeTrackResponse)
+	err := c.cc.Invoke(ctx, "/Music/DislikeTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) DeleteTrackDislike(ctx context.Context, in *DislikeTrackOptions, opts ...grpc.CallOption) (*DislikeTrackResponse, error) {
+	out := new(DislikeTrackResponse)
+	err := c.cc.Invoke(ctx, "/Music/DeleteTrackDislike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) ReleaseRadar(ctx context.Context, in *ReleaseRadarOptions, opts ...grpc.CallOption) (*ReleaseRadarResponse, error) {
+	out := new(ReleaseRadarResponse)
+	err := c.cc.Invoke(ctx, "/Music/ReleaseRadar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *musicClient) Recap(ctx context.Context, in *RecapOptions, opts ...grpc.CallOption) (*RecapResponse, error) {
+	out := new(RecapResponse)
+	err := c.cc.Invoke(ctx, "/Music/Recap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MusicServer is the server API for Music service.
+type MusicServer interface {
+	RandomTracks(context.Context, *RandomTracksOptions) (*Tracks, error)
+	RandomAlbums(context.Context, *RandomAlbumsOptions) (*Albums, error)
+	RandomArtists(context.Context, *RandomArtistsOptions) (*Artists, error)
+	UserPlaylists(context.Context, *UserPlaylistsOptions) (*PlaylistsData, error)
+	ArtistProfile(context.Context, *ArtistProfileOptions) (*Artist, error)
+	IncrementListenCount(context.Context, *IncrementListenCountOptions) (*IncrementListenCountEmpty, error)
+	AlbumPage(context.Context, *AlbumPageOptions) (*AlbumPageResponse, error)
+	PlaylistPage(context.Context, *PlaylistPageOptions) (*PlaylistPageResponse, error)
+	Find(context.Context, *FindOptions) (*FindResponse, error)
+	SearchTracks(context.Context, *FindOptions) (*Tracks, error)
+	SearchAlbums(context.Context, *FindOptions) (*Albums, error)
+	SearchArtists(context.Context, *FindOptions) (*Artists, error)
+	SearchPlaylists(context.Context, *FindOptions) (*PlaylistsData, error)
+	Suggest(context.Context, *SuggestOptions) (*Suggestions, error)
+	GetRecentSearches(context.Context, *RecentSearchesOptions) (*RecentSearches, error)
+	RecordSearchResult(context.Context, *RecordSearchResultOptions) (*RecordSearchResultResponse, error)
+	DeleteRecentSearch(context.Context, *DeleteRecentSearchOptions) (*DeleteRecentSearchResponse, error)
+	ClearRecentSearches(context.Context, *ClearRecentSearchesOptions) (*ClearRecentSearchesResponse, error)
+	TrackFile(context.Context, *TrackFileOptions) (*TrackFileResponse, error)
+	AddTrackToFavorites(context.Context, *AddTrackToFavoritesOptions) (*AddTrackToFavoritesResponse, error)
+	DeleteTrackFromFavorites(context.Context, *DeleteTrackFromFavoritesOptions) (*DeleteTrackFromFavoritesResponse, error)
+	GetFavoriteTracks(context.Context, *UserFavoritesOptions) (*Tracks, error)
+	Charts(context.Context, *ChartsOptions) (*ChartsResponse, error)
+	ListGenres(context.Context, *ListGenresOptions) (*Genres, error)
+	GenrePage(context.Context, *GenrePageOptions) (*GenrePageResponse, error)
+	ArtistTracks(context.Context, *ArtistTracksOptions) (*Tracks, error)
+	ArtistAlbums(context.Context, *ArtistAlbumsOptions) (*Albums, error)
+	GetLyrics(context.Context, *LyricsOptions) (*Lyrics, error)
+	GetTrack(context.Context, *GetTrackOptions) (*Track, error)
+	GetTracks(context.Context, *GetTracksOptions) (*Tracks, error)
+	SaveAlbum(context.Context, *SaveAlbumOptions) (*LibraryResponse, error)
+	UnsaveAlbum(context.Context, *SaveAlbumOptions) (*LibraryResponse, error)
+	FollowArtist(context.Context, *FollowArtistOptions) (*LibraryResponse, error)
+	UnfollowArtist(context.Context, *FollowArtistOptions) (*LibraryResponse, error)
+	GetSavedAlbums(context.Context, *LibraryOptions) (*Albums, error)
+	GetFollowedArtists(context.Context, *LibraryOptions) (*Artists, error)
+	Radio(context.Context, *RadioOptions) (*RadioResponse, error)
+	DislikeTrack(context.Context, *DislikeTrackOptions) (*DislikeTrackResponse, error)
+	DeleteTrackDislike(context.Context, *DislikeTrackOptions) (*DislikeTrackResponse, error)
+	ReleaseRadar(context.Context, *ReleaseRadarOptions) (*ReleaseRadarResponse, error)
+	Recap(context.Context, *RecapOptions) (*RecapResponse, error)
+}
+
+// UnimplementedMusicServer can be embedded to have forward compatible implementations.
+type UnimplementedMusicServer struct {
+}
+
+func (*UnimplementedMusicServer) RandomTracks(context.Context, *RandomTracksOptions) (*Tracks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomTracks not implemented")
+}
+func (*UnimplementedMusicServer) RandomAlbums(context.Context, *RandomAlbumsOptions) (*Albums, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomAlbums not implemented")
+}
+func (*UnimplementedMusicServer) RandomArtists(context.Context, *RandomArtistsOptions) (*Artists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomArtists not implemented")
+}
+func (*UnimplementedMusicServer) UserPlaylists(context.Context, *UserPlaylistsOptions) (*PlaylistsData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPlaylists not implemented")
+}
+func (*UnimplementedMusicServer) ArtistProfile(context.Context, *ArtistProfileOptions) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArtistProfile not implemented")
+}
+func (*UnimplementedMusicServer) IncrementListenCount(context.Context, *IncrementListenCountOptions) (*IncrementListenCountEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementListenCount not implemented")
+}
+func (*UnimplementedMusicServer) AlbumPage(context.Context, *AlbumPageOptions) (*AlbumPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlbumPage not implemented")
+}
+func (*UnimplementedMusicServer) PlaylistPage(context.Context, *PlaylistPageOptions) (*PlaylistPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaylistPage not implemented")
+}
+func (*UnimplementedMusicServer) Find(context.Context, *FindOptions) (*FindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
+}
+func (*UnimplementedMusicServer) SearchTracks(context.Context, *FindOptions) (*Tracks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTracks not implemented")
+}
+func (*UnimplementedMusicServer) SearchAlbums(context.Context, *FindOptions) (*Albums, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAlbums not implemented")
+}
+func (*UnimplementedMusicServer) SearchArtists(context.Context, *FindOptions) (*Artists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArtists not implemented")
+}
+func (*UnimplementedMusicServer) SearchPlaylists(context.Context, *FindOptions) (*PlaylistsData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPlaylists not implemented")
+}
+func (*UnimplementedMusicServer) Suggest(context.Context, *SuggestOptions) (*Suggestions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (*UnimplementedMusicServer) GetRecentSearches(context.Context, *RecentSearchesOptions) (*RecentSearches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentSearches not implemented")
+}
+func (*UnimplementedMusicServer) RecordSearchResult(context.Context, *RecordSearchResultOptions) (*RecordSearchResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSearchResult not implemented")
+}
+func (*UnimplementedMusicServer) DeleteRecentSearch(context.Context, *DeleteRecentSearchOptions) (*DeleteRecentSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecentSearch not implemented")
+}
+func (*UnimplementedMusicServer) ClearRecentSearches(context.Context, *ClearRecentSearchesOptions) (*ClearRecentSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRecentSearches not implemented")
+}
+func (*UnimplementedMusicServer) TrackFile(context.Context, *TrackFileOptions) (*TrackFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrackFile not implemented")
+}
+func (*UnimplementedMusicServer) AddTrackToFavorites(context.Context, *AddTrackToFavoritesOptions) (*AddTrackToFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrackToFavorites not implemented")
+}
+func (*UnimplementedMusicServer) DeleteTrackFromFavorites(context.Context, *DeleteTrackFromFavoritesOptions) (*DeleteTrackFromFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrackFromFavorites not implemented")
+}
+func (*UnimplementedMusicServer) GetFavoriteTracks(context.Context, *UserFavoritesOptions) (*Tracks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteTracks not implemented")
+}
+func (*UnimplementedMusicServer) Charts(context.Context, *ChartsOptions) (*ChartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Charts not implemented")
+}
+func (*UnimplementedMusicServer) ListGenres(context.Context, *ListGenresOptions) (*Genres, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGenres not implemented")
+}
+func (*UnimplementedMusicServer) GenrePage(context.Context, *GenrePageOptions) (*GenrePageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenrePage not implemented")
+}
+func (*UnimplementedMusicServer) ArtistTracks(context.Context, *ArtistTracksOptions) (*Tracks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArtistTracks not implemented")
+}
+func (*UnimplementedMusicServer) ArtistAlbums(context.Context, *ArtistAlbumsOptions) (*Albums, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArtistAlbums not implemented")
+}
+func (*UnimplementedMusicServer) GetLyrics(context.Context, *LyricsOptions) (*Lyrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLyrics not implemented")
+}
+func (*UnimplementedMusicServer) GetTrack(context.Context, *GetTrackOptions) (*Track, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrack not implemented")
+}
+func (*UnimplementedMusicServer) GetTracks(context.Context, *GetTracksOptions) (*Tracks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTracks not implemented")
+}
+func (*UnimplementedMusicServer) SaveAlbum(context.Context, *SaveAlbumOptions) (*LibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAlbum not implemented")
+}
+func (*UnimplementedMusicServer) UnsaveAlbum(context.Context, *SaveAlbumOptions) (*LibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsaveAlbum not implemented")
+}
+func (*UnimplementedMusicServer) FollowArtist(context.Context, *FollowArtistOptions) (*LibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowArtist not implemented")
+}
+func (*UnimplementedMusicServer) UnfollowArtist(context.Context, *FollowArtistOptions) (*LibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowArtist not implemented")
+}
+func (*UnimplementedMusicServer) GetSavedAlbums(context.Context, *LibraryOptions) (*Albums, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedAlbums not implemented")
+}
+func (*UnimplementedMusicServer) GetFollowedArtists(context.Context, *LibraryOptions) (*Artists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowedArtists not implemented")
+}
+func (*UnimplementedMusicServer) Radio(context.Context, *RadioOptions) (*RadioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Radio not implemented")
+}
+func (*UnimplementedMusicServer) DislikeTrack(context.Context, *DislikeTrackOptions) (*DislikeTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DislikeTrack not implemented")
+}
+func (*UnimplementedMusicServer) DeleteTrackDislike(context.Context, *DislikeTrackOptions) (*DislikeTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrackDislike not implemented")
+}
+func (*UnimplementedMusicServer) ReleaseRadar(context.Context, *ReleaseRadarOptions) (*ReleaseRadarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRadar not implemented")
+}
+func (*UnimplementedMusicServer) Recap(context.Context, *RecapOptions) (*RecapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recap not implemented")
+}
+
+func RegisterMusicServer(s *grpc.Server, srv MusicServer) {
+	s.RegisterService(&_Music_serviceDesc, srv)
+}
+
+func _Music_RandomTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomTracksOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).RandomTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/RandomTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).RandomTracks(ctx, req.(*RandomTracksOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_RandomAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomAlbumsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).RandomAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/RandomAlbums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).RandomAlbums(ctx, req.(*RandomAlbumsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_RandomArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomArtistsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).RandomArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/RandomArtists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).RandomArtists(ctx, req.(*RandomArtistsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_UserPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPlaylistsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).UserPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/UserPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).UserPlaylists(ctx, req.(*UserPlaylistsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_ArtistProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtistProfileOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).ArtistProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/ArtistProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).ArtistProfile(ctx, req.(*ArtistProfileOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_IncrementListenCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementListenCountOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).IncrementListenCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/IncrementListenCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).IncrementListenCount(ctx, req.(*IncrementListenCountOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_AlbumPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumPageOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).AlbumPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/AlbumPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).AlbumPage(ctx, req.(*AlbumPageOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_PlaylistPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistPageOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).PlaylistPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/PlaylistPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).PlaylistPage(ctx, req.(*PlaylistPageOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_Find_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOptions)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_music_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   27,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_music_proto_goTypes,
-		DependencyIndexes: file_music_proto_depIdxs,
-		MessageInfos:      file_music_proto_msgTypes,
-	}.Build()
-	File_music_proto = out.File
-	file_music_proto_rawDesc = nil
-	file_music_proto_goTypes = nil
-	file_music_proto_depIdxs = nil
+	if interceptor == nil {
+		return srv.(MusicServer).Find(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/Find",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).Find(ctx, req.(*FindOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _Music_SearchTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).SearchTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/SearchTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).SearchTracks(ctx, req.(*FindOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _Music_SearchAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).SearchAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/SearchAlbums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).SearchAlbums(ctx, req.(*FindOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// MusicClient is the client API for Music service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MusicClient interface {
-	RandomTracks(ctx context.Context, in *RandomTracksOptions, opts ...grpc.CallOption) (*Tracks, error)
-	RandomAlbums(ctx context.Context, in *RandomAlbumsOptions, opts ...grpc.CallOption) (*Albums, error)
-	RandomArtists(ctx context.Context, in *RandomArtistsOptions, opts ...grpc.CallOption) (*Artists, error)
-	UserPlaylists(ctx context.Context, in *UserPlaylistsOptions, opts ...grpc.CallOption) (*PlaylistsData, error)
-	ArtistProfile(ctx context.Context, in *ArtistProfileOptions, opts ...grpc.CallOption) (*Artist, error)
-	IncrementListenCount(ctx context.Context, in *IncrementListenCountOptions, opts ...grpc.CallOption) (*IncrementListenCountEmpty, error)
-	AlbumPage(ctx context.Context, in *AlbumPageOptions, opts ...grpc.CallOption) (*AlbumPageResponse, error)
-	PlaylistPage(ctx context.Context, in *PlaylistPageOptions, opts ...grpc.CallOption) (*PlaylistPageResponse, error)
-	Find(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*FindResponse, error)
-	AddTrackToFavorites(ctx context.Context, in *AddTrackToFavoritesOptions, opts ...grpc.CallOption) (*AddTrackToFavoritesResponse, error)
-	DeleteTrackFromFavorites(ctx context.Context, in *DeleteTrackFromFavoritesOptions, opts ...grpc.CallOption) (*DeleteTrackFromFavoritesResponse, error)
-	GetFavoriteTracks(ctx context.Context, in *UserFavoritesOptions, opts ...grpc.CallOption) (*Tracks, error)
+func _Music_SearchArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).SearchArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/SearchArtists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).SearchArtists(ctx, req.(*FindOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type musicClient struct {
-	cc grpc.ClientConnInterface
+func _Music_SearchPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).SearchPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/SearchPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).SearchPlaylists(ctx, req.(*FindOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewMusicClient(cc grpc.ClientConnInterface) MusicClient {
-	return &musicClient{cc}
+func _Music_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).Suggest(ctx, req.(*SuggestOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) RandomTracks(ctx context.Context, in *RandomTracksOptions, opts ...grpc.CallOption) (*Tracks, error) {
-	out := new(Tracks)
-	err := c.cc.Invoke(ctx, "/Music/RandomTracks", in, out, opts...)
-	if err != nil {
+func _Music_GetRecentSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecentSearchesOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).GetRecentSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/GetRecentSearches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).GetRecentSearches(ctx, req.(*RecentSearchesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) RandomAlbums(ctx context.Context, in *RandomAlbumsOptions, opts ...grpc.CallOption) (*Albums, error) {
-	out := new(Albums)
-	err := c.cc.Invoke(ctx, "/Music/RandomAlbums", in, out, opts...)
-	if err != nil {
+func _Music_RecordSearchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSearchResultOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).RecordSearchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/RecordSearchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).RecordSearchResult(ctx, req.(*RecordSearchResultOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) RandomArtists(ctx context.Context, in *RandomArtistsOptions, opts ...grpc.CallOption) (*Artists, error) {
-	out := new(Artists)
-	err := c.cc.Invoke(ctx, "/Music/RandomArtists", in, out, opts...)
-	if err != nil {
+func _Music_DeleteRecentSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecentSearchOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).DeleteRecentSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/DeleteRecentSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).DeleteRecentSearch(ctx, req.(*DeleteRecentSearchOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) UserPlaylists(ctx context.Context, in *UserPlaylistsOptions, opts ...grpc.CallOption) (*PlaylistsData, error) {
-	out := new(PlaylistsData)
-	err := c.cc.Invoke(ctx, "/Music/UserPlaylists", in, out, opts...)
-	if err != nil {
+func _Music_ClearRecentSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRecentSearchesOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).ClearRecentSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/ClearRecentSearches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).ClearRecentSearches(ctx, req.(*ClearRecentSearchesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) ArtistProfile(ctx context.Context, in *ArtistProfileOptions, opts ...grpc.CallOption) (*Artist, error) {
-	out := new(Artist)
-	err := c.cc.Invoke(ctx, "/Music/ArtistProfile", in, out, opts...)
-	if err != nil {
+func _Music_TrackFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackFileOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).TrackFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/TrackFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).TrackFile(ctx, req.(*TrackFileOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) IncrementListenCount(ctx context.Context, in *IncrementListenCountOptions, opts ...grpc.CallOption) (*IncrementListenCountEmpty, error) {
-	out := new(IncrementListenCountEmpty)
-	err := c.cc.Invoke(ctx, "/Music/IncrementListenCount", in, out, opts...)
-	if err != nil {
+func _Music_AddTrackToFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTrackToFavoritesOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).AddTrackToFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/AddTrackToFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).AddTrackToFavorites(ctx, req.(*AddTrackToFavoritesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) AlbumPage(ctx context.Context, in *AlbumPageOptions, opts ...grpc.CallOption) (*AlbumPageResponse, error) {
-	out := new(AlbumPageResponse)
-	err := c.cc.Invoke(ctx, "/Music/AlbumPage", in, out, opts...)
-	if err != nil {
+func _Music_DeleteTrackFromFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrackFromFavoritesOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).DeleteTrackFromFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/DeleteTrackFromFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).DeleteTrackFromFavorites(ctx, req.(*DeleteTrackFromFavoritesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) PlaylistPage(ctx context.Context, in *PlaylistPageOptions, opts ...grpc.CallOption) (*PlaylistPageResponse, error) {
-	out := new(PlaylistPageResponse)
-	err := c.cc.Invoke(ctx, "/Music/PlaylistPage", in, out, opts...)
-	if err != nil {
+func _Music_GetFavoriteTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavoritesOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).GetFavoriteTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/GetFavoriteTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).GetFavoriteTracks(ctx, req.(*UserFavoritesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Music_Charts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChartsOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).Charts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/Charts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).Charts(ctx, req.(*ChartsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) Find(ctx context.Context, in *FindOptions, opts ...grpc.CallOption) (*FindResponse, error) {
-	out := new(FindResponse)
-	err := c.cc.Invoke(ctx, "/Music/Find", in, out, opts...)
-	if err != nil {
+func _Music_ListGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenresOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).ListGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/ListGenres",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).ListGenres(ctx, req.(*ListGenresOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) AddTrackToFavorites(ctx context.Context, in *AddTrackToFavoritesOptions, opts ...grpc.CallOption) (*AddTrackToFavoritesResponse, error) {
-	out := new(AddTrackToFavoritesResponse)
-	err := c.cc.Invoke(ctx, "/Music/AddTrackToFavorites", in, out, opts...)
-	if err != nil {
+func _Music_GenrePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenrePageOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).GenrePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/GenrePage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).GenrePage(ctx, req.(*GenrePageOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) DeleteTrackFromFavorites(ctx context.Context, in *DeleteTrackFromFavoritesOptions, opts ...grpc.CallOption) (*DeleteTrackFromFavoritesResponse, error) {
-	out := new(DeleteTrackFromFavoritesResponse)
-	err := c.cc.Invoke(ctx, "/Music/DeleteTrackFromFavorites", in, out, opts...)
-	if err != nil {
+func _Music_ArtistTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtistTracksOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MusicServer).ArtistTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/ArtistTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).ArtistTracks(ctx, req.(*ArtistTracksOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *musicClient) GetFavoriteTracks(ctx context.Context, in *UserFavoritesOptions, opts ...grpc.CallOption) (*Tracks, error) {
-	out := new(Tracks)
-	err := c.cc.Invoke(ctx, "/Music/GetFavoriteTracks", in, out, opts...)
-	if err != nil {
+func _Music_ArtistAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtistAlbumsOptions)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// MusicServer is the server API for Music service.
-type MusicServer interface {
-	RandomTracks(context.Context, *RandomTracksOptions) (*Tracks, error)
-	RandomAlbums(context.Context, *RandomAlbumsOptions) (*Albums, error)
-	RandomArtists(context.Context, *RandomArtistsOptions) (*Artists, error)
-	UserPlaylists(context.Context, *UserPlaylistsOptions) (*PlaylistsData, error)
-	ArtistProfile(context.Context, *ArtistProfileOptions) (*Artist, error)
-	IncrementListenCount(context.Context, *IncrementListenCountOptions) (*IncrementListenCountEmpty, error)
-	AlbumPage(context.Context, *AlbumPageOptions) (*AlbumPageResponse, error)
-	PlaylistPage(context.Context, *PlaylistPageOptions) (*PlaylistPageResponse, error)
-	Find(context.Context, *FindOptions) (*FindResponse, error)
-	AddTrackToFavorites(context.Context, *AddTrackToFavoritesOptions) (*AddTrackToFavoritesResponse, error)
-	DeleteTrackFromFavorites(context.Context, *DeleteTrackFromFavoritesOptions) (*DeleteTrackFromFavoritesResponse, error)
-	GetFavoriteTracks(context.Context, *UserFavoritesOptions) (*Tracks, error)
-}
-
-// UnimplementedMusicServer can be embedded to have forward compatible implementations.
-type UnimplementedMusicServer struct {
+	if interceptor == nil {
+		return srv.(MusicServer).ArtistAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/ArtistAlbums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).ArtistAlbums(ctx, req.(*ArtistAlbumsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedMusicServer) RandomTracks(context.Context, *RandomTracksOptions) (*Tracks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RandomTracks not implemented")
-}
-func (*UnimplementedMusicServer) RandomAlbums(context.Context, *RandomAlbumsOptions) (*Albums, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RandomAlbums not implemented")
-}
-func (*UnimplementedMusicServer) RandomArtists(context.Context, *RandomArtistsOptions) (*Artists, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RandomArtists not implemented")
-}
-func (*UnimplementedMusicServer) UserPlaylists(context.Context, *UserPlaylistsOptions) (*PlaylistsData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPlaylists not implemented")
-}
-func (*UnimplementedMusicServer) ArtistProfile(context.Context, *ArtistProfileOptions) (*Artist, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArtistProfile not implemented")
-}
-func (*UnimplementedMusicServer) IncrementListenCount(context.Context, *IncrementListenCountOptions) (*IncrementListenCountEmpty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncrementListenCount not implemented")
-}
-func (*UnimplementedMusicServer) AlbumPage(context.Context, *AlbumPageOptions) (*AlbumPageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AlbumPage not implemented")
-}
-func (*UnimplementedMusicServer) PlaylistPage(context.Context, *PlaylistPageOptions) (*PlaylistPageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaylistPage not implemented")
-}
-func (*UnimplementedMusicServer) Find(context.Context, *FindOptions) (*FindResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
-}
-func (*UnimplementedMusicServer) AddTrackToFavorites(context.Context, *AddTrackToFavoritesOptions) (*AddTrackToFavoritesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTrackToFavorites not implemented")
-}
-func (*UnimplementedMusicServer) DeleteTrackFromFavorites(context.Context, *DeleteTrackFromFavoritesOptions) (*DeleteTrackFromFavoritesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrackFromFavorites not implemented")
-}
-func (*UnimplementedMusicServer) GetFavoriteTracks(context.Context, *UserFavoritesOptions) (*Tracks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteTracks not implemented")
+func _Music_GetLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LyricsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).GetLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/GetLyrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).GetLyrics(ctx, req.(*LyricsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMusicServer(s *grpc.Server, srv MusicServer) {
-	s.RegisterService(&_Music_serviceDesc, srv)
+func _Music_GetTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrackOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MusicServer).GetTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Music/GetTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MusicServer).GetTrack(ctx, req.(*GetTrackOptions))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_RandomTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RandomTracksOptions)
+func _Music_GetTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTracksOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).RandomTracks(ctx, in)
+		return srv.(MusicServer).GetTracks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/RandomTracks",
+		FullMethod: "/Music/GetTracks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).RandomTracks(ctx, req.(*RandomTracksOptions))
+		return srv.(MusicServer).GetTracks(ctx, req.(*GetTracksOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_RandomAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RandomAlbumsOptions)
+func _Music_SaveAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAlbumOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).RandomAlbums(ctx, in)
+		return srv.(MusicServer).SaveAlbum(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/RandomAlbums",
+		FullMethod: "/Music/SaveAlbum",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).RandomAlbums(ctx, req.(*RandomAlbumsOptions))
+		return srv.(MusicServer).SaveAlbum(ctx, req.(*SaveAlbumOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_RandomArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RandomArtistsOptions)
+func _Music_UnsaveAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAlbumOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).RandomArtists(ctx, in)
+		return srv.(MusicServer).UnsaveAlbum(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/RandomArtists",
+		FullMethod: "/Music/UnsaveAlbum",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).RandomArtists(ctx, req.(*RandomArtistsOptions))
+		return srv.(MusicServer).UnsaveAlbum(ctx, req.(*SaveAlbumOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_UserPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPlaylistsOptions)
+func _Music_FollowArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowArtistOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).UserPlaylists(ctx, in)
+		return srv.(MusicServer).FollowArtist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/UserPlaylists",
+		FullMethod: "/Music/FollowArtist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).UserPlaylists(ctx, req.(*UserPlaylistsOptions))
+		return srv.(MusicServer).FollowArtist(ctx, req.(*FollowArtistOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_ArtistProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArtistProfileOptions)
+func _Music_UnfollowArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowArtistOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).ArtistProfile(ctx, in)
+		return srv.(MusicServer).UnfollowArtist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/ArtistProfile",
+		FullMethod: "/Music/UnfollowArtist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).ArtistProfile(ctx, req.(*ArtistProfileOptions))
+		return srv.(MusicServer).UnfollowArtist(ctx, req.(*FollowArtistOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_IncrementListenCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncrementListenCountOptions)
+func _Music_GetSavedAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).IncrementListenCount(ctx, in)
+		return srv.(MusicServer).GetSavedAlbums(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/IncrementListenCount",
+		FullMethod: "/Music/GetSavedAlbums",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).IncrementListenCount(ctx, req.(*IncrementListenCountOptions))
+		return srv.(MusicServer).GetSavedAlbums(ctx, req.(*LibraryOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_AlbumPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlbumPageOptions)
+func _Music_GetFollowedArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).AlbumPage(ctx, in)
+		return srv.(MusicServer).GetFollowedArtists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/AlbumPage",
+		FullMethod: "/Music/GetFollowedArtists",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).AlbumPage(ctx, req.(*AlbumPageOptions))
+		return srv.(MusicServer).GetFollowedArtists(ctx, req.(*LibraryOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_PlaylistPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlaylistPageOptions)
+func _Music_Radio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RadioOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).PlaylistPage(ctx, in)
+		return srv.(MusicServer).Radio(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/PlaylistPage",
+		FullMethod: "/Music/Radio",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).PlaylistPage(ctx, req.(*PlaylistPageOptions))
+		return srv.(MusicServer).Radio(ctx, req.(*RadioOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_Find_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindOptions)
+func _Music_DislikeTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DislikeTrackOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).Find(ctx, in)
+		return srv.(MusicServer).DislikeTrack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/Find",
+		FullMethod: "/Music/DislikeTrack",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).Find(ctx, req.(*FindOptions))
+		return srv.(MusicServer).DislikeTrack(ctx, req.(*DislikeTrackOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_AddTrackToFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTrackToFavoritesOptions)
+func _Music_DeleteTrackDislike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DislikeTrackOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).AddTrackToFavorites(ctx, in)
+		return srv.(MusicServer).DeleteTrackDislike(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/AddTrackToFavorites",
+		FullMethod: "/Music/DeleteTrackDislike",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).AddTrackToFavorites(ctx, req.(*AddTrackToFavoritesOptions))
+		return srv.(MusicServer).DeleteTrackDislike(ctx, req.(*DislikeTrackOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_DeleteTrackFromFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTrackFromFavoritesOptions)
+func _Music_ReleaseRadar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRadarOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).DeleteTrackFromFavorites(ctx, in)
+		return srv.(MusicServer).ReleaseRadar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/DeleteTrackFromFavorites",
+		FullMethod: "/Music/ReleaseRadar",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).DeleteTrackFromFavorites(ctx, req.(*DeleteTrackFromFavoritesOptions))
+		return srv.(MusicServer).ReleaseRadar(ctx, req.(*ReleaseRadarOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Music_GetFavoriteTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserFavoritesOptions)
+func _Music_Recap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecapOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MusicServer).GetFavoriteTracks(ctx, in)
+		return srv.(MusicServer).Recap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Music/GetFavoriteTracks",
+		FullMethod: "/Music/Recap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MusicServer).GetFavoriteTracks(ctx, req.(*UserFavoritesOptions))
+		return srv.(MusicServer).Recap(ctx, req.(*RecapOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2919,6 +8973,46 @@ var _Music_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Find",
 			Handler:    _Music_Find_Handler,
 		},
+		{
+			MethodName: "SearchTracks",
+			Handler:    _Music_SearchTracks_Handler,
+		},
+		{
+			MethodName: "SearchAlbums",
+			Handler:    _Music_SearchAlbums_Handler,
+		},
+		{
+			MethodName: "SearchArtists",
+			Handler:    _Music_SearchArtists_Handler,
+		},
+		{
+			MethodName: "SearchPlaylists",
+			Handler:    _Music_SearchPlaylists_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Music_Suggest_Handler,
+		},
+		{
+			MethodName: "GetRecentSearches",
+			Handler:    _Music_GetRecentSearches_Handler,
+		},
+		{
+			MethodName: "RecordSearchResult",
+			Handler:    _Music_RecordSearchResult_Handler,
+		},
+		{
+			MethodName: "DeleteRecentSearch",
+			Handler:    _Music_DeleteRecentSearch_Handler,
+		},
+		{
+			MethodName: "ClearRecentSearches",
+			Handler:    _Music_ClearRecentSearches_Handler,
+		},
+		{
+			MethodName: "TrackFile",
+			Handler:    _Music_TrackFile_Handler,
+		},
 		{
 			MethodName: "AddTrackToFavorites",
 			Handler:    _Music_AddTrackToFavorites_Handler,
@@ -2931,6 +9025,82 @@ var _Music_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFavoriteTracks",
 			Handler:    _Music_GetFavoriteTracks_Handler,
 		},
+		{
+			MethodName: "Charts",
+			Handler:    _Music_Charts_Handler,
+		},
+		{
+			MethodName: "ListGenres",
+			Handler:    _Music_ListGenres_Handler,
+		},
+		{
+			MethodName: "GenrePage",
+			Handler:    _Music_GenrePage_Handler,
+		},
+		{
+			MethodName: "ArtistTracks",
+			Handler:    _Music_ArtistTracks_Handler,
+		},
+		{
+			MethodName: "ArtistAlbums",
+			Handler:    _Music_ArtistAlbums_Handler,
+		},
+		{
+			MethodName: "GetLyrics",
+			Handler:    _Music_GetLyrics_Handler,
+		},
+		{
+			MethodName: "GetTrack",
+			Handler:    _Music_GetTrack_Handler,
+		},
+		{
+			MethodName: "GetTracks",
+			Handler:    _Music_GetTracks_Handler,
+		},
+		{
+			MethodName: "SaveAlbum",
+			Handler:    _Music_SaveAlbum_Handler,
+		},
+		{
+			MethodName: "UnsaveAlbum",
+			Handler:    _Music_UnsaveAlbum_Handler,
+		},
+		{
+			MethodName: "FollowArtist",
+			Handler:    _Music_FollowArtist_Handler,
+		},
+		{
+			MethodName: "UnfollowArtist",
+			Handler:    _Music_UnfollowArtist_Handler,
+		},
+		{
+			MethodName: "GetSavedAlbums",
+			Handler:    _Music_GetSavedAlbums_Handler,
+		},
+		{
+			MethodName: "GetFollowedArtists",
+			Handler:    _Music_GetFollowedArtists_Handler,
+		},
+		{
+			MethodName: "Radio",
+			Handler:    _Music_Radio_Handler,
+		},
+		{
+			MethodName: "DislikeTrack",
+			Handler:    _Music_DislikeTrack_Handler,
+		},
+		{
+			MethodName: "DeleteTrackDislike",
+			Handler:    _Music_DeleteTrackDislike_Handler,
+		},
+		{
+			MethodName: "ReleaseRadar",
+			Handler:    _Music_ReleaseRadar_Handler,
+		},
+		{
+			MethodName: "Recap",
+			Handler:    _Music_Recap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "music.proto",
@@ -2,31 +2,90 @@ package music
 
 import (
 	"2021_2_LostPointer/internal/microservices/music/proto"
+	"time"
 )
 
 //go:generate moq -out ./mock/music_repo_mock.go -pkg mock . Storage:MockStorage
 type Storage interface {
-	RandomTracks(int64, int64, bool) (*proto.Tracks, error)
-	RandomAlbums(int64) (*proto.Albums, error)
-	RandomArtists(int64) (*proto.Artists, error)
-	ArtistInfo(int64) (*proto.Artist, error)
+	RandomTracks(trackIDs []int64, userID int64, isAuthorized bool) (*proto.Tracks, error)
+	RandomAlbums(albumIDs []int64) (*proto.Albums, error)
+	RandomArtists(artistIDs []int64) (*proto.Artists, error)
+	RandomPoolGeneration(entity string) (int64, error)
+	RandomPoolSize(entity string, generation int64) (int64, error)
+	RandomPoolIDs(entity string, generation int64, positions []int64) ([]int64, error)
+	RebuildRandomPool(entity string) (int64, error)
+	ArtistInfo(int64, int64) (*proto.Artist, error)
 	ArtistTracks(int64, int64, bool, int64) ([]*proto.Track, error)
 	ArtistAlbums(int64, int64) ([]*proto.Album, error)
-	IncrementListenCount(int64) error
-	AlbumData(int64) (*proto.AlbumPageResponse, error)
-	AlbumTracks(int64, int64, bool) ([]*proto.AlbumTrack, error)
-	FindTracksByFullWord(string, int64, bool) ([]*proto.Track, error)
-	FindTracksByPartial(string, int64, bool) ([]*proto.Track, error)
-	FindArtists(string) ([]*proto.Artist, error)
-	FindAlbums(string) ([]*proto.Album, error)
-	UserPlaylists(int64) ([]*proto.PlaylistData, error)
+	IncrementListenCount(int64, int64) error
+	ReserveListen(userID int64, ip string, trackID int64, now time.Time) (string, error)
+	RecordListenRejection(userID int64, ip string, trackID int64, reason string) error
+	TrackFile(int64) (*proto.TrackFileResponse, error)
+	TracksByIDs([]int64, int64, bool) ([]*proto.Track, error)
+	Lyrics(int64) (*proto.Lyrics, error)
+	AlbumData(int64, int64) (*proto.AlbumPageResponse, error)
+	AlbumTracks(int64, int64, bool, *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error)
+	SearchTracks(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+	SearchArtists(string, *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error)
+	SearchAlbums(string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+	SearchPlaylists(string, int64, *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error)
+	Suggest(string, int64, int64) ([]*proto.Suggestion, error)
+	CachedSuggestions(string) ([]*proto.Suggestion, bool, error)
+	CacheSuggestions(string, []*proto.Suggestion, time.Duration) error
+	RecordSearch(int64, string, string, int64) error
+	RecentSearches(int64, int64) ([]*proto.RecentSearch, error)
+	DeleteRecentSearch(int64, int64) (bool, error)
+	ClearRecentSearches(int64) error
+	UserPlaylists(int64, *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error)
 	IsPlaylistOwner(int64, int64) (bool, error)
 	IsPlaylistPublic(int64) (bool, error)
-	PlaylistTracks(int64, int64) ([]*proto.Track, error)
-	PlaylistInfo(int64) (*proto.PlaylistData, error)
+	PlaylistTracks(int64, int64, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+	PlaylistInfo(int64, int64) (*proto.PlaylistData, error)
 	DoesPlaylistExist(int64) (bool, error)
 	AddTrackToFavorite(userID int64, trackID int64) error
 	DeleteTrackFromFavorites(userID int64, trackID int64) error
-	GetFavorites(userID int64) ([]*proto.Track, error)
+	GetFavorites(userID int64, sortBy string, filter *proto.FavoritesFilter, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
 	IsTrackInFavorites(userID int64, trackID int64) (bool, error)
+	IsAlbumSaved(userID int64, albumID int64) (bool, error)
+	SaveAlbum(userID int64, albumID int64) error
+	UnsaveAlbum(userID int64, albumID int64) error
+	SavedAlbums(userID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+	SavedAlbumIDs(userID int64, albumIDs []int64) ([]int64, error)
+	IsArtistFollowed(userID int64, artistID int64) (bool, error)
+	FollowArtist(userID int64, artistID int64) error
+	UnfollowArtist(userID int64, artistID int64) error
+	FollowedArtists(userID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error)
+	FollowedArtistIDs(userID int64, artistIDs []int64) ([]int64, error)
+	IsTrackDisliked(userID int64, trackID int64) (bool, error)
+	DislikeTrack(userID int64, trackID int64) error
+	DeleteTrackDislike(userID int64, trackID int64) error
+	RadioSeed(seedType string, seedID int64, userID int64) (*proto.RadioSession, error)
+	RadioTracks(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64, candidates []int64, amount int64) ([]*proto.Track, error)
+	RadioSession(key string) (*proto.RadioSession, bool, error)
+	SaveRadioSession(key string, session *proto.RadioSession, lifetime time.Duration) error
+	RebuildChart(period string, start time.Time, end time.Time, previousStart time.Time) error
+	ChartPeriodStart(period string) (time.Time, error)
+	ChartTracks(period string, periodStart time.Time, genreID int64, amount int64, userID int64, isAuthorized bool) ([]*proto.ChartTrack, error)
+	ChartAlbums(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartAlbum, error)
+	ChartArtists(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartArtist, error)
+	ListGenres() ([]*proto.Genre, error)
+	GenreInfo(genreID int64) (*proto.Genre, error)
+	GenreTracks(genreID int64, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+	GenreAlbums(genreID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+	GenreArtists(genreID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error)
+	ArtistTracksPage(artistID int64, userID int64, isAuthorized bool, sortBy string, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error)
+	ArtistAlbumsPage(artistID int64, sortBy string, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error)
+	ReleaseRadar(userID int64, now time.Time, amount int64) ([]*proto.Album, error)
+	ReleaseRadarTracks(userID int64, now time.Time, amount int64) ([]int64, error)
+	ReleaseRadarListeners(now time.Time, weekStart time.Time) ([]int64, error)
+	ReleaseRadarPlaylist(userID int64) (int64, error)
+	IsReleaseRadarPlaylist(playlistID int64, userID int64) (bool, error)
+	SaveReleaseRadarPlaylist(userID int64, weekStart time.Time, trackIDs []int64) error
+	RebuildRecaps(year int64, start time.Time, end time.Time) error
+	RecapBuiltAt(year int64) (time.Time, error)
+	Recap(userID int64, year int64) (*proto.RecapResponse, error)
+	RecapTopTracks(userID int64, year int64, amount int64) ([]*proto.RecapTrack, error)
+	RecapTopAlbums(userID int64, year int64, amount int64) ([]*proto.RecapAlbum, error)
+	RecapTopArtists(userID int64, year int64, amount int64) ([]*proto.RecapArtist, error)
+	RecapTopGenres(userID int64, year int64, amount int64) ([]*proto.RecapGenre, error)
 }
@@ -2,37 +2,146 @@ package repository
 
 import (
 	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/media"
 	"2021_2_LostPointer/internal/microservices/music/proto"
+	"2021_2_LostPointer/pkg/lrc"
+	"2021_2_LostPointer/pkg/pagination"
 	"2021_2_LostPointer/pkg/wrapper"
+	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
-	"os"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
+var (
+	popularTracksKeys  = []pagination.Key{{Column: "t.listen_count", Desc: true}, {Column: "t.id"}}
+	popularArtistsKeys = []pagination.Key{{Column: "SUM(t.listen_count)", Desc: true}, {Column: "art.id"}}
+	albumTracksKeys    = []pagination.Key{{Column: "COALESCE(t.number, 0)"}, {Column: "t.id"}}
+	albumsByYearKeys   = []pagination.Key{{Column: "alb.year", Desc: true}, {Column: "alb.id"}}
+	playlistsKeys      = []pagination.Key{{Column: "id"}}
+	playlistTracksKeys = []pagination.Key{{Column: "pt.id"}}
+	savedAlbumsKeys    = []pagination.Key{{Column: "sa.created_at", Desc: true, Type: pagination.Time}, {Column: "sa.album_id", Desc: true}}
+	followedArtistKeys = []pagination.Key{{Column: "af.created_at", Desc: true, Type: pagination.Time}, {Column: "af.artist_id", Desc: true}}
+)
+
+var artistTracksKeys = map[string][]pagination.Key{
+	constants.SortByPopularity: popularTracksKeys,
+	constants.SortByYear:       {{Column: "alb.year", Desc: true}, {Column: "t.album"}, {Column: "COALESCE(t.number, 0)"}, {Column: "t.id"}},
+	constants.SortByTitle:      {{Column: "t.title", Type: pagination.Text}, {Column: "t.id"}},
+}
+
+var artistAlbumsKeys = map[string][]pagination.Key{
+	constants.SortByPopularity: {{Column: "SUM(t.listen_count)", Desc: true}, {Column: "alb.id"}},
+	constants.SortByYear:       albumsByYearKeys,
+	constants.SortByTitle:      {{Column: "alb.title", Type: pagination.Text}, {Column: "alb.id"}},
+}
+
+// Релевантность поиска: полнотекстовый ранг по взвешенным полям (название, исполнитель, альбом)
+// плюс похожесть по триграммам, чтобы находить запросы с опечатками. $1 - запрос.
+// Кандидаты отбираются операторами @@ и <% по тем же выражениям, что и в GIN-индексах,
+// порог <% задан в базе настройкой pg_trgm.word_similarity_threshold.
+// Документы треков хранятся в track_search и обновляются триггерами на tracks, albums и artists.
+// Поиск треков допускает пустой запрос, если заданы фильтры
+const (
+	searchTrackScore = `ROUND((ts_rank(ts.document, plainto_tsquery('simple', $1)) +
+		word_similarity($1, t.title) + 0.6 * word_similarity($1, art.name) + 0.3 * word_similarity($1, alb.title))::numeric, 4)`
+	searchTrackMatch = `($1 = '' OR ts.document @@ plainto_tsquery('simple', $1) OR $1 <% ts.text)`
+
+	searchArtistScore = `ROUND((ts_rank(to_tsvector('simple', art.name), plainto_tsquery('simple', $1)) +
+		word_similarity($1, art.name))::numeric, 4)`
+	searchArtistMatch = `(to_tsvector('simple', art.name) @@ plainto_tsquery('simple', $1) OR lower($1) <% lower(art.name))`
+
+	searchAlbumVector = `setweight(to_tsvector('simple', alb.title), 'A') || setweight(to_tsvector('simple', art.name), 'B')`
+	searchAlbumScore  = `ROUND((ts_rank(` + searchAlbumVector + `, plainto_tsquery('simple', $1)) +
+		word_similarity($1, alb.title) + 0.6 * word_similarity($1, art.name))::numeric, 4)`
+	searchAlbumMatch = `(to_tsvector('simple', alb.title) @@ plainto_tsquery('simple', $1) OR
+		to_tsvector('simple', art.name) @@ plainto_tsquery('simple', $1) OR lower($1) <% lower(alb.title) OR lower($1) <% lower(art.name))`
+
+	searchPlaylistScore = `ROUND((ts_rank(to_tsvector('simple', title), plainto_tsquery('simple', $1)) +
+		word_similarity($1, title))::numeric, 4)`
+	searchPlaylistMatch = `(to_tsvector('simple', title) @@ plainto_tsquery('simple', $1) OR lower($1) <% lower(title))`
+)
+
+var (
+	searchTracksKeys    = []pagination.Key{{Column: searchTrackScore, Desc: true, Type: pagination.Float}, {Column: "t.id"}}
+	searchArtistsKeys   = []pagination.Key{{Column: searchArtistScore, Desc: true, Type: pagination.Float}, {Column: "art.id"}}
+	searchAlbumsKeys    = []pagination.Key{{Column: searchAlbumScore, Desc: true, Type: pagination.Float}, {Column: "alb.id"}}
+	searchPlaylistsKeys = []pagination.Key{{Column: searchPlaylistScore, Desc: true, Type: pagination.Float}, {Column: "id"}}
+)
+
+var favoritesKeys = map[string][]pagination.Key{
+	constants.SortByDateAdded:   {{Column: "l.created_at", Desc: true, Type: pagination.Time}, {Column: "l.id", Desc: true}},
+	constants.SortByTitle:       {{Column: "t.title", Type: pagination.Text}, {Column: "l.id"}},
+	constants.SortByArtist:      {{Column: "art.name", Type: pagination.Text}, {Column: "t.title", Type: pagination.Text}, {Column: "l.id"}},
+	constants.SortByDuration:    {{Column: "t.duration"}, {Column: "l.id"}},
+	constants.SortByListenCount: {{Column: "t.listen_count", Desc: true}, {Column: "l.id"}},
+}
+
+var chartEntityColumns = []struct {
+	entity string
+	column string
+}{
+	{constants.ChartEntityTracks, "p.track_id"},
+	{constants.ChartEntityAlbums, "t.album"},
+	{constants.ChartEntityArtists, "t.artist"},
+}
+
+var recapEntityColumns = []struct {
+	entity string
+	column string
+	amount int64
+}{
+	{constants.ChartEntityTracks, "l.track_id", constants.RecapTopTracksAmount},
+	{constants.ChartEntityAlbums, "t.album", constants.RecapTopAmount},
+	{constants.ChartEntityArtists, "t.artist", constants.RecapTopAmount},
+	{constants.RecapEntityGenres, "t.genre", constants.RecapTopAmount},
+}
+
+// Идентификаторы, из которых собираются случайные подборки. Альбомы без треков в подборки не попадают
+var randomPoolQueries = map[string]string{
+	constants.ChartEntityTracks:  `SELECT id FROM tracks`,
+	constants.ChartEntityAlbums:  `SELECT alb.id FROM albums alb WHERE EXISTS(SELECT 1 FROM tracks t WHERE t.album = alb.id)`,
+	constants.ChartEntityArtists: `SELECT id FROM artists`,
+}
+
 type MusicStorage struct {
-	db *sql.DB
+	db    *sql.DB
+	redis *redis.Client
+	links *media.Links
 }
 
-func NewMusicStorage(db *sql.DB) *MusicStorage {
-	return &MusicStorage{db: db}
+func NewMusicStorage(db *sql.DB, redis *redis.Client, links *media.Links) *MusicStorage {
+	return &MusicStorage{db: db, redis: redis, links: links}
 }
 
-func (storage *MusicStorage) RandomTracks(amount int64, userID int64, isAuthorized bool) (*proto.Tracks, error) {
+// Треки случайной подборки в порядке переданных идентификаторов
+func (storage *MusicStorage) RandomTracks(trackIDs []int64, userID int64, isAuthorized bool) (*proto.Tracks, error) {
 	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
 		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
 		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
 		wrapper.Wrapper([]string{"name"}, "g") + ", " +
 		`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`
+		ORDER BY selection.position`
 
-	rows, err := storage.db.Query(query, userID, amount)
+	rows, err := storage.db.Query(query, userID, pq.Array(trackIDs))
 	if err != nil {
 		return nil, err
 	}
@@ -43,20 +152,18 @@ func (storage *MusicStorage) RandomTracks(amount int64, userID int64, isAuthoriz
 		}
 	}()
 
-	tracks := make([]*proto.Track, 0, amount)
+	tracks := make([]*proto.Track, 0, len(trackIDs))
 	//nolint:dupl
 	for rows.Next() {
 		track := &proto.Track{}
 		track.Album = &proto.Album{}
 		track.Artist = &proto.Artist{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Album.ID, &track.Album.Title, &track.Album.Artwork, &track.Album.ArtworkColor, &track.Artist.ID,
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork, &track.Album.ArtworkColor, &track.Artist.ID,
 			&track.Artist.Name, &track.Genre, &track.IsInFavorites); err != nil {
 			return nil, err
 		}
-		if !isAuthorized {
-			track.File = ""
-		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
 		tracks = append(tracks, track)
 	}
 	err = rows.Err()
@@ -68,20 +175,21 @@ func (storage *MusicStorage) RandomTracks(amount int64, userID int64, isAuthoriz
 	return tracksList, nil
 }
 
-func (storage *MusicStorage) RandomAlbums(amount int64) (*proto.Albums, error) {
+// Альбомы случайной подборки в порядке переданных идентификаторов. Длительность считается только по ним
+func (storage *MusicStorage) RandomAlbums(albumIDs []int64) (*proto.Albums, error) {
 	query := `SELECT ` +
 		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 		wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 		`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
 		`
 
-	rows, err := storage.db.Query(query, amount)
+	rows, err := storage.db.Query(query, pq.Array(albumIDs))
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +200,7 @@ func (storage *MusicStorage) RandomAlbums(amount int64) (*proto.Albums, error) {
 		}
 	}()
 
-	albums := make([]*proto.Album, 0, amount)
+	albums := make([]*proto.Album, 0, len(albumIDs))
 	for rows.Next() {
 		album := &proto.Album{}
 		if err = rows.Scan(&album.ID, &album.Title, &album.Year, &album.Artwork, &album.TracksAmount, &album.ArtworkColor, &album.Artist,
@@ -110,16 +218,17 @@ func (storage *MusicStorage) RandomAlbums(amount int64) (*proto.Albums, error) {
 	return albumsList, nil
 }
 
-func (storage *MusicStorage) RandomArtists(amount int64) (*proto.Artists, error) {
+// Исполнители случайной подборки в порядке переданных идентификаторов
+func (storage *MusicStorage) RandomArtists(artistIDs []int64) (*proto.Artists, error) {
 	query := `
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
 	`
 
-	rows, err := storage.db.Query(query, amount)
+	rows, err := storage.db.Query(query, pq.Array(artistIDs))
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +240,7 @@ func (storage *MusicStorage) RandomArtists(amount int64) (*proto.Artists, error)
 		}
 	}()
 
-	artists := make([]*proto.Artist, 0, amount)
+	artists := make([]*proto.Artist, 0, len(artistIDs))
 	for rows.Next() {
 		artist := &proto.Artist{}
 		artist.Tracks = []*proto.Track{}
@@ -150,23 +259,151 @@ func (storage *MusicStorage) RandomArtists(amount int64) (*proto.Artists, error)
 	return artistsList, nil
 }
 
-func (storage *MusicStorage) ArtistInfo(artistID int64) (*proto.Artist, error) {
+// Пул хранится по поколениям: пересборка пишет новый хеш и переключает на него текущее поколение,
+// а прежний хеш доживает до истечения срока, чтобы начатые подборки листались без пересечений
+func randomPoolKey(entity string, generation int64) string {
+	return "random:" + entity + ":" + strconv.FormatInt(generation, 10)
+}
+
+func randomGenerationKey(entity string) string {
+	return "random:" + entity + ":generation"
+}
+
+func randomCounterKey(entity string) string {
+	return "random:" + entity + ":counter"
+}
+
+// Текущее поколение пула. 0, если пул ещё не собирался
+func (storage *MusicStorage) RandomPoolGeneration(entity string) (int64, error) {
+	generation, err := storage.redis.Get(context.Background(), randomGenerationKey(entity)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return generation, nil
+}
+
+// Размер поколения пула. 0, если поколение истекло
+func (storage *MusicStorage) RandomPoolSize(entity string, generation int64) (int64, error) {
+	return storage.redis.HLen(context.Background(), randomPoolKey(entity, generation)).Result()
+}
+
+// Идентификаторы по позициям в перемешанном поколении пула. Позиции, которых нет в поколении, пропускаются
+func (storage *MusicStorage) RandomPoolIDs(entity string, generation int64, positions []int64) ([]int64, error) {
+	if len(positions) == 0 {
+		return []int64{}, nil
+	}
+
+	fields := make([]string, 0, len(positions))
+	for _, position := range positions {
+		fields = append(fields, strconv.FormatInt(position, 10))
+	}
+	values, err := storage.redis.HMGet(context.Background(), randomPoolKey(entity, generation), fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		field, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var id int64
+		id, err = strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// Перемешивает идентификаторы в новое поколение пула (позиция в хеше -> идентификатор) и делает его текущим.
+// Каждое поколение живёт RandomPoolLifetime: если пересборка остановилась, истёкший пул соберётся на месте
+func (storage *MusicStorage) RebuildRandomPool(entity string) (int64, error) {
+	rows, err := storage.db.Query(randomPoolQueries[entity])
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	err = rows.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+	random.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
+
+	ctx := context.Background()
+	generation, err := storage.redis.Incr(ctx, randomCounterKey(entity)).Result()
+	if err != nil {
+		return 0, err
+	}
+	key := randomPoolKey(entity, generation)
+	_, err = storage.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for start := 0; start < len(ids); start += constants.RandomPoolWriteBatch {
+			end := start + constants.RandomPoolWriteBatch
+			if end > len(ids) {
+				end = len(ids)
+			}
+			values := make([]interface{}, 0, 2*(end-start))
+			for i := start; i < end; i++ {
+				values = append(values, i, ids[i])
+			}
+			pipe.HSet(ctx, key, values...)
+		}
+		pipe.Expire(ctx, key, constants.RandomPoolLifetime)
+		pipe.Set(ctx, randomGenerationKey(entity), generation, 0)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return generation, nil
+}
+
+func (storage *MusicStorage) ArtistInfo(artistID int64, userID int64) (*proto.Artist, error) {
 	query := `
-		SELECT id, name, avatar, video
+		SELECT id, name, avatar, video, COALESCE(bio, ''), COALESCE(avatar_color, ''),
+		(SELECT COUNT(*) FROM artist_follows af WHERE af.artist_id = artists.id) AS followers,
+		EXISTS(SELECT 1 FROM artist_follows af WHERE af.artist_id = artists.id AND af.user_id = $2) AS followed
 		FROM artists
 		WHERE id = $1
 	`
 	var video string
 	artist := &proto.Artist{}
-	err := storage.db.QueryRow(query, artistID).Scan(&artist.ID, &artist.Name, &artist.Avatar, &video)
+	err := storage.db.QueryRow(query, artistID, userID).Scan(&artist.ID, &artist.Name, &artist.Avatar, &video, &artist.Bio,
+		&artist.AvatarColor, &artist.FollowersCount, &artist.IsFollowed)
 	if err != nil {
 		return nil, err
 	}
 
-	artist.Avatar = os.Getenv("ARTISTS_ROOT_PREFIX") + artist.Avatar + constants.ImageExtension
+	artist.Avatar = storage.links.ArtistAvatar(artist.Avatar)
 
 	if len(video) > 1 {
-		artist.Video = os.Getenv("MOV_ROOT_PREFIX") + video + constants.VideoExtension
+		artist.Video = storage.links.ArtistVideo(video)
 	}
 
 	return artist, nil
@@ -175,7 +412,7 @@ func (storage *MusicStorage) ArtistInfo(artistID int64) (*proto.Artist, error) {
 func (storage *MusicStorage) ArtistTracks(artistID int64, userID int64, isAuthorized bool, amount int64) ([]*proto.Track, error) {
 	var err error
 	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
 		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
 		wrapper.Wrapper([]string{"name"}, "g") + ", " +
 		`
@@ -207,12 +444,10 @@ func (storage *MusicStorage) ArtistTracks(artistID int64, userID int64, isAuthor
 		track.Album = &proto.Album{}
 		track.Artist = &proto.Artist{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Album.ID, &track.Album.Title, &track.Album.Artwork, &track.Album.ArtworkColor, &track.Genre, &track.IsInFavorites); err != nil {
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork, &track.Album.ArtworkColor, &track.Genre, &track.IsInFavorites); err != nil {
 			return nil, err
 		}
-		if !isAuthorized {
-			track.File = ""
-		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
 		tracks = append(tracks, track)
 	}
 	err = rows.Err()
@@ -262,56 +497,130 @@ func (storage *MusicStorage) ArtistAlbums(artistID int64, amount int64) ([]*prot
 	return albums, nil
 }
 
-func (storage *MusicStorage) IncrementListenCount(trackID int64) error {
-	query := `UPDATE tracks SET listen_count = listen_count + 1 WHERE id=$1`
+// Прослушивание авторизованного пользователя дополнительно попадает в его историю.
+// Для несуществующего трека возвращает sql.ErrNoRows
+func (storage *MusicStorage) IncrementListenCount(trackID int64, userID int64) error {
+	query := `
+		WITH updated AS (UPDATE tracks SET listen_count = listen_count + 1 WHERE id=$1 RETURNING id),
+		listened AS (INSERT INTO listens(user_id, track_id) SELECT $2::integer, id FROM updated WHERE $2::integer > 0)
+		INSERT INTO track_plays_daily(track_id, day, plays)
+		SELECT id, current_date, 1 FROM updated
+		ON CONFLICT (track_id, day) DO UPDATE SET plays = track_plays_daily.plays + 1`
 
-	err := storage.db.QueryRow(query, trackID).Err()
+	result, err := storage.db.Exec(query, trackID, userID)
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
 
 	return nil
 }
 
-func (storage *MusicStorage) AlbumData(albumID int64) (*proto.AlbumPageResponse, error) {
-	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color", "track_count"}, "alb") + ", " +
-		wrapper.Wrapper([]string{"id", "name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
-		`
-		FROM albums alb
-		JOIN artists art ON alb.artist = art.id
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.id = $1
-		GROUP BY alb.id, art.id
-		`
+// Скользящее окно на sorted set: в ключе хранятся отметки времени засчитанных прослушиваний.
+// Прослушивание записывается сразу во все окна и только если ни одно из них не заполнено.
+// Возвращает номер первого заполненного окна, начиная с 1, или 0
+var listenLimitScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+for i, key in ipairs(KEYS) do
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+	if redis.call('ZCARD', key) >= tonumber(ARGV[i + 3]) then
+		return i
+	end
+end
+for _, key in ipairs(KEYS) do
+	redis.call('ZADD', key, now, ARGV[3])
+	redis.call('PEXPIRE', key, window)
+end
+return 0
+`)
+
+// Резервирует прослушивание в окнах пользователя и IP. Возвращает причину отказа или пустую строку
+func (storage *MusicStorage) ReserveListen(userID int64, ip string, trackID int64, now time.Time) (string, error) {
+	keys := make([]string, 0, 2)
+	reasons := make([]string, 0, 2)
+	args := []interface{}{now.UnixMilli(), constants.ListenLimitWindow.Milliseconds(), fmt.Sprintf("%d:%d", now.UnixNano(), trackID)}
+	if userID > 0 {
+		keys = append(keys, fmt.Sprintf("listens:user:%d", userID))
+		reasons = append(reasons, constants.ListenRejectUserLimit)
+		args = append(args, constants.ListenUserLimit)
+	}
+	if len(ip) != 0 {
+		keys = append(keys, "listens:ip:"+ip)
+		reasons = append(reasons, constants.ListenRejectIPLimit)
+		args = append(args, constants.ListenIPLimit)
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
 
-	album := &proto.AlbumPageResponse{}
-	album.Artist = &proto.Artist{}
-	err := storage.db.QueryRow(query, albumID).Scan(&album.AlbumID, &album.Title, &album.Year, &album.Artwork, &album.ArtworkColor,
-		&album.TracksCount, &album.Artist.ID, &album.Artist.Name, &album.TracksDuration)
+	exceeded, err := listenLimitScript.Run(context.Background(), storage.redis, keys, args...).Int()
+	if err != nil {
+		return "", err
+	}
+	if exceeded == 0 {
+		return "", nil
+	}
+
+	return reasons[exceeded-1], nil
+}
+
+func (storage *MusicStorage) RecordListenRejection(userID int64, ip string, trackID int64, reason string) error {
+	query := `INSERT INTO listen_rejections(user_id, ip, track_id, reason) VALUES (NULLIF($1, 0), $2, $3, $4)`
+
+	_, err := storage.db.Exec(query, userID, ip, trackID, reason)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Аудио доступно только авторизованным пользователям по подписанной ссылке на стриминг
+func (storage *MusicStorage) trackFile(trackID int64, userID int64, isAuthorized bool) string {
+	if !isAuthorized {
+		return ""
+	}
+	return storage.links.TrackStream(trackID, userID)
+}
+
+func (storage *MusicStorage) TrackFile(trackID int64) (*proto.TrackFileResponse, error) {
+	query := `SELECT file, lossless FROM tracks WHERE id = $1`
+
+	track := &proto.TrackFileResponse{}
+	err := storage.db.QueryRow(query, trackID).Scan(&track.File, &track.Lossless)
 	if err != nil {
 		return nil, err
 	}
-	return album, nil
+
+	return track, nil
 }
 
-func (storage *MusicStorage) AlbumTracks(albumID int64, userID int64, isAuthorized bool) ([]*proto.AlbumTrack, error) {
-	var err error
+// Треки по списку идентификаторов одним запросом, вместе с плейлистами пользователя, в которых они есть
+func (storage *MusicStorage) TracksByIDs(trackIDs []int64, userID int64, isAuthorized bool) ([]*proto.Track, error) {
 	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") + ", " +
 		wrapper.Wrapper([]string{"name"}, "g") + ", " +
 		`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite,
+		ARRAY(SELECT DISTINCT pt.playlist FROM playlist_tracks pt JOIN playlists p ON p.id = pt.playlist
+			WHERE pt.track = t.id AND p.user_id = $1 ORDER BY pt.playlist) AS playlists
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE alb.id = $2
-		ORDER BY t.number
-		`
+		WHERE t.id = ANY($2)`
 
-	rows, err := storage.db.Query(query, userID, albumID)
+	rows, err := storage.db.Query(query, userID, pq.Array(trackIDs))
 	if err != nil {
 		return nil, err
 	}
@@ -322,16 +631,20 @@ func (storage *MusicStorage) AlbumTracks(albumID int64, userID int64, isAuthoriz
 		}
 	}()
 
-	tracks := make([]*proto.AlbumTrack, 0)
+	tracks := make([]*proto.Track, 0, len(trackIDs))
 	for rows.Next() {
-		track := &proto.AlbumTrack{}
+		var playlistIDs pq.Int64Array
+		track := &proto.Track{}
+		track.Album = &proto.Album{}
+		track.Artist = &proto.Artist{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Genre, &track.IsInFavorites); err != nil {
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Year,
+			&track.Album.Artwork, &track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Artist.Avatar,
+			&track.Genre, &track.IsInFavorites, &playlistIDs); err != nil {
 			return nil, err
 		}
-		if !isAuthorized {
-			track.File = ""
-		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
+		track.PlaylistIDs = playlistIDs
 		tracks = append(tracks, track)
 	}
 	err = rows.Err()
@@ -342,30 +655,76 @@ func (storage *MusicStorage) AlbumTracks(albumID int64, userID int64, isAuthoriz
 	return tracks, nil
 }
 
-//nolint:dupl
-func (storage *MusicStorage) FindTracksByFullWord(text string, userID int64, isAuthorized bool) ([]*proto.Track, error) {
+func (storage *MusicStorage) Lyrics(trackID int64) (*proto.Lyrics, error) {
+	query := `SELECT plain, lines FROM lyrics WHERE track_id = $1`
+
+	var rawLines []byte
+	lyrics := &proto.Lyrics{TrackID: trackID}
+	err := storage.db.QueryRow(query, trackID).Scan(&lyrics.Plain, &rawLines)
+	if err != nil {
+		return nil, err
+	}
+	if rawLines == nil {
+		return lyrics, nil
+	}
+
+	var lines []lrc.Line
+	if err = json.Unmarshal(rawLines, &lines); err != nil {
+		return nil, err
+	}
+	lyrics.Synced = true
+	lyrics.Lines = make([]*proto.LyricsLine, 0, len(lines))
+	for _, line := range lines {
+		lyrics.Lines = append(lyrics.Lines, &proto.LyricsLine{Time: line.Time, Text: line.Text})
+	}
+
+	return lyrics, nil
+}
+
+func (storage *MusicStorage) AlbumData(albumID int64, userID int64) (*proto.AlbumPageResponse, error) {
 	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
-		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
-		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color", "track_count"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
+		`, EXISTS(SELECT 1 FROM saved_albums sa WHERE sa.album_id = alb.id AND sa.user_id = $2) AS saved,
+		to_char(alb.release_date, 'YYYY-MM-DD') AS release_date
+		FROM albums alb
+		JOIN artists art ON alb.artist = art.id
+		JOIN tracks t ON alb.id = t.album
+		WHERE alb.id = $1
+		GROUP BY alb.id, art.id
+		`
+
+	album := &proto.AlbumPageResponse{}
+	album.Artist = &proto.Artist{}
+	err := storage.db.QueryRow(query, albumID, userID).Scan(&album.AlbumID, &album.Title, &album.Year, &album.Artwork, &album.ArtworkColor,
+		&album.TracksCount, &album.Artist.ID, &album.Artist.Name, &album.TracksDuration, &album.IsSaved, &album.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+	return album, nil
+}
+
+func (storage *MusicStorage) AlbumTracks(albumID int64, userID int64, isAuthorized bool, pageRequest *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(albumTracksKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID, albumID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
 		wrapper.Wrapper([]string{"name"}, "g") + ", " +
 		`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-		    FROM search
-		    WHERE concatenation @@ plainto_tsquery($2)
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`
+		WHERE alb.id = $2 AND ` + page.Where + `
+		` + page.Order
 
-	rows, err := storage.db.Query(query, userID, text, constants.SearchTracksAmount)
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -374,53 +733,57 @@ func (storage *MusicStorage) FindTracksByFullWord(text string, userID int64, isA
 		}
 	}()
 
-	tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+	var total int64
+	tracks := make([]*proto.AlbumTrack, 0, page.Limit+1)
 	for rows.Next() {
-		track := &proto.Track{}
-		track.Album = &proto.Album{}
-		track.Artist = &proto.Artist{}
+		track := &proto.AlbumTrack{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Album.ID, &track.Album.Title, &track.Album.Artwork, &track.Album.ArtworkColor,
-			&track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites); err != nil {
-			return nil, err
-		}
-		if !isAuthorized {
-			track.File = ""
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Genre, &track.IsInFavorites, &total); err != nil {
+			return nil, nil, err
 		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
 		tracks = append(tracks, track)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return tracks, nil
+	hasMore := page.HasMore(len(tracks))
+	var last []interface{}
+	if hasMore {
+		tracks = tracks[:page.Limit]
+		last = []interface{}{tracks[len(tracks)-1].Number, tracks[len(tracks)-1].ID}
+	}
+
+	return tracks, pageResponse(page, total, last, hasMore), nil
 }
 
-//nolint:dupl
-func (storage *MusicStorage) FindTracksByPartial(text string, userID int64, isAuthorized bool) ([]*proto.Track, error) {
+func (storage *MusicStorage) SearchTracks(text string, filter *proto.SearchFilter, userID int64, isAuthorized bool, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	conditions, args := searchConditions(filter, []interface{}{text, userID})
+	page, err := pagination.NewPage(searchTracksKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), args...)
+	if err != nil {
+		return nil, nil, err
+	}
 	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
 		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
 		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
 		wrapper.Wrapper([]string{"name"}, "g") + ", " +
 		`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, ` + searchTrackScore + ` AS score, COUNT(*) OVER () AS total
 		FROM tracks t
+		JOIN track_search ts ON ts.track_id = t.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-		    SELECT track
-		    FROM test
-		    WHERE concat ILIKE $2
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $2
+		WHERE ` + searchTrackMatch + ` AND ` + conditions + page.Where + `
+		` + page.Order
 
-	rows, err := storage.db.Query(query, userID, "%"+text+"%", constants.SearchTracksAmount)
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -429,39 +792,53 @@ func (storage *MusicStorage) FindTracksByPartial(text string, userID int64, isAu
 		}
 	}()
 
-	tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+	var total int64
+	var score float64
+	scores := make([]float64, 0, page.Limit+1)
+	tracks := make([]*proto.Track, 0, page.Limit+1)
+	//nolint:dupl
 	for rows.Next() {
 		track := &proto.Track{}
 		track.Album = &proto.Album{}
 		track.Artist = &proto.Artist{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
-			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites); err != nil {
-			return nil, err
-		}
-		if !isAuthorized {
-			track.File = ""
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
+			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites, &score, &total); err != nil {
+			return nil, nil, err
 		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
 		tracks = append(tracks, track)
+		scores = append(scores, score)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return tracks, nil
+	hasMore := page.HasMore(len(tracks))
+	var last []interface{}
+	if hasMore {
+		tracks = tracks[:page.Limit]
+		last = []interface{}{scores[page.Limit-1], tracks[len(tracks)-1].ID}
+	}
+
+	return tracks, pageResponse(page, total, last, hasMore), nil
 }
 
-func (storage *MusicStorage) FindArtists(text string) ([]*proto.Artist, error) {
+func (storage *MusicStorage) SearchArtists(text string, pageRequest *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(searchArtistsKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), text)
+	if err != nil {
+		return nil, nil, err
+	}
 	query := `
-		SELECT id, name, avatar
-		FROM artists
-		WHERE name ILIKE $1
-		LIMIT $2
-	`
-	rows, err := storage.db.Query(query, "%"+text+"%", constants.SearchArtistsAmount)
+		SELECT art.id, art.name, art.avatar, ` + searchArtistScore + ` AS score, COUNT(*) OVER () AS total
+		FROM artists art
+		WHERE ` + searchArtistMatch + ` AND ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -470,40 +847,55 @@ func (storage *MusicStorage) FindArtists(text string) ([]*proto.Artist, error) {
 		}
 	}()
 
-	artists := make([]*proto.Artist, 0, constants.SearchArtistsAmount)
+	var total int64
+	var score float64
+	scores := make([]float64, 0, page.Limit+1)
+	artists := make([]*proto.Artist, 0, page.Limit+1)
 	for rows.Next() {
 		artist := &proto.Artist{}
 		artist.Tracks = []*proto.Track{}
 		artist.Albums = []*proto.Album{}
-		if err = rows.Scan(&artist.ID, &artist.Name, &artist.Avatar); err != nil {
-			return nil, err
+		if err = rows.Scan(&artist.ID, &artist.Name, &artist.Avatar, &score, &total); err != nil {
+			return nil, nil, err
 		}
 		artists = append(artists, artist)
+		scores = append(scores, score)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return artists, nil
+	hasMore := page.HasMore(len(artists))
+	var last []interface{}
+	if hasMore {
+		artists = artists[:page.Limit]
+		last = []interface{}{scores[page.Limit-1], artists[len(artists)-1].ID}
+	}
+
+	return artists, pageResponse(page, total, last, hasMore), nil
 }
 
-func (storage *MusicStorage) FindAlbums(text string) ([]*proto.Album, error) {
+func (storage *MusicStorage) SearchAlbums(text string, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(searchAlbumsKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), text)
+	if err != nil {
+		return nil, nil, err
+	}
 	query := `SELECT ` +
 		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 		wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
-		`
+		`, ` + searchAlbumScore + ` AS score, COUNT(*) OVER () AS total
 		FROM albums alb
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		WHERE alb.title ILIKE $1
+		WHERE ` + searchAlbumMatch + `
 		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY year DESC
-		LIMIT $2
-		`
-	rows, err := storage.db.Query(query, "%"+text+"%", constants.SearchAlbumsAmount)
+		HAVING ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -512,29 +904,47 @@ func (storage *MusicStorage) FindAlbums(text string) ([]*proto.Album, error) {
 		}
 	}()
 
-	albums := make([]*proto.Album, 0, constants.SearchAlbumsAmount)
+	var total int64
+	var score float64
+	scores := make([]float64, 0, page.Limit+1)
+	albums := make([]*proto.Album, 0, page.Limit+1)
 	for rows.Next() {
 		album := &proto.Album{}
 		if err = rows.Scan(&album.ID, &album.Title, &album.Year, &album.Artwork, &album.TracksAmount, &album.ArtworkColor, &album.Artist,
-			&album.TracksDuration); err != nil {
-			return nil, err
+			&album.TracksDuration, &score, &total); err != nil {
+			return nil, nil, err
 		}
 		albums = append(albums, album)
+		scores = append(scores, score)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return albums, nil
+	hasMore := page.HasMore(len(albums))
+	var last []interface{}
+	if hasMore {
+		albums = albums[:page.Limit]
+		last = []interface{}{scores[page.Limit-1], albums[len(albums)-1].ID}
+	}
+
+	return albums, pageResponse(page, total, last, hasMore), nil
 }
 
-func (storage *MusicStorage) IsPlaylistOwner(playlistID int64, userID int64) (bool, error) {
-	query := `SELECT * FROM playlists WHERE id=$1 AND user_id=$2`
+func (storage *MusicStorage) SearchPlaylists(text string, userID int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(searchPlaylistsKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), text, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT id, title, artwork, is_public, user_id=$2 AS is_own, ` + searchPlaylistScore + ` AS score, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$2 OR is_public=true) AND ` + searchPlaylistMatch + ` AND ` + page.Where + `
+		` + page.Order
 
-	rows, err := storage.db.Query(query, playlistID, userID)
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return true, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -543,22 +953,90 @@ func (storage *MusicStorage) IsPlaylistOwner(playlistID int64, userID int64) (bo
 		}
 	}()
 
-	if rows.Next() {
-		return true, nil
+	var total int64
+	var score float64
+	scores := make([]float64, 0, page.Limit+1)
+	playlists := make([]*proto.PlaylistData, 0, page.Limit+1)
+	for rows.Next() {
+		playlist := &proto.PlaylistData{}
+		if err = rows.Scan(&playlist.PlaylistID, &playlist.Title, &playlist.Artwork, &playlist.IsPublic, &playlist.IsOwn,
+			&score, &total); err != nil {
+			return nil, nil, err
+		}
+		playlist.Artwork = storage.links.PlaylistArtwork(playlist.Artwork, constants.PlaylistArtworkExtension100px, playlist.IsPublic, userID)
+		playlists = append(playlists, playlist)
+		scores = append(scores, score)
 	}
-	if err = rows.Err(); err != nil {
-		return false, err
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return false, nil
-}
+	hasMore := page.HasMore(len(playlists))
+	var last []interface{}
+	if hasMore {
+		playlists = playlists[:page.Limit]
+		last = []interface{}{scores[page.Limit-1], playlists[len(playlists)-1].PlaylistID}
+	}
 
-func (storage *MusicStorage) IsPlaylistPublic(playlistID int64) (bool, error) {
-	query := `SELECT * FROM playlists WHERE id=$1 AND is_public=true`
+	return playlists, pageResponse(page, total, last, hasMore), nil
+}
 
-	rows, err := storage.db.Query(query, playlistID)
+// Кроме популярности и совпадения с началом названия подсказки учитывают лайки пользователя
+// и его историю прослушиваний: понравившееся выше прослушанного
+func (storage *MusicStorage) Suggest(prefix string, userID int64, amount int64) ([]*proto.Suggestion, error) {
+	prefix = strings.ToLower(escapeLike(prefix))
+	query := `
+		SELECT type, id, title, subtitle, artwork
+		FROM (
+			(SELECT '` + constants.SuggestionTypeTrack + `' AS type, t.id, t.title, art.name AS subtitle, alb.artwork,
+				ln(1 + t.listen_count) + CASE WHEN lower(t.title) LIKE $1 THEN 2 ELSE 0 END +
+				CASE WHEN l.id IS NOT NULL THEN 5
+					WHEN EXISTS(SELECT 1 FROM listens ls WHERE ls.user_id = $3 AND ls.track_id = t.id) THEN 3 ELSE 0 END AS score
+			FROM tracks t
+			JOIN albums alb ON t.album = alb.id
+			JOIN artists art ON t.artist = art.id
+			LEFT JOIN likes l ON t.id = l.track_id AND l.user_id = $3
+			WHERE lower(t.title) LIKE $1 OR lower(t.title) LIKE $2
+			ORDER BY score DESC, t.id LIMIT $4)
+			UNION ALL
+			(SELECT '` + constants.SuggestionTypeArtist + `' AS type, art.id, art.name AS title, '' AS subtitle, art.avatar AS artwork,
+				ln(1 + COALESCE((SELECT SUM(t.listen_count) FROM tracks t WHERE t.artist = art.id), 0)) +
+				CASE WHEN lower(art.name) LIKE $1 THEN 2 ELSE 0 END +
+				CASE WHEN EXISTS(SELECT 1 FROM likes l JOIN tracks t ON t.id = l.track_id
+					WHERE l.user_id = $3 AND t.artist = art.id) THEN 3
+					WHEN EXISTS(SELECT 1 FROM listens ls JOIN tracks t ON t.id = ls.track_id
+					WHERE ls.user_id = $3 AND t.artist = art.id) THEN 2 ELSE 0 END AS score
+			FROM artists art
+			WHERE lower(art.name) LIKE $1 OR lower(art.name) LIKE $2
+			ORDER BY score DESC, art.id LIMIT $4)
+			UNION ALL
+			(SELECT '` + constants.SuggestionTypeAlbum + `' AS type, alb.id, alb.title, art.name AS subtitle, alb.artwork,
+				ln(1 + COALESCE((SELECT SUM(t.listen_count) FROM tracks t WHERE t.album = alb.id), 0)) +
+				CASE WHEN lower(alb.title) LIKE $1 THEN 2 ELSE 0 END +
+				CASE WHEN EXISTS(SELECT 1 FROM likes l JOIN tracks t ON t.id = l.track_id
+					WHERE l.user_id = $3 AND t.album = alb.id) THEN 3
+					WHEN EXISTS(SELECT 1 FROM listens ls JOIN tracks t ON t.id = ls.track_id
+					WHERE ls.user_id = $3 AND t.album = alb.id) THEN 2 ELSE 0 END AS score
+			FROM albums alb
+			JOIN artists art ON alb.artist = art.id
+			WHERE lower(alb.title) LIKE $1 OR lower(alb.title) LIKE $2
+			ORDER BY score DESC, alb.id LIMIT $4)
+			UNION ALL
+			(SELECT '` + constants.SuggestionTypePlaylist + `' AS type, p.id, p.title, '' AS subtitle, p.artwork,
+				ln(1 + (SELECT COUNT(*) FROM playlist_tracks pt WHERE pt.playlist = p.id)) +
+				CASE WHEN lower(p.title) LIKE $1 THEN 2 ELSE 0 END +
+				CASE WHEN p.user_id = $3 THEN 5 ELSE 0 END AS score
+			FROM playlists p
+			WHERE (p.user_id = $3 OR p.is_public = true) AND (lower(p.title) LIKE $1 OR lower(p.title) LIKE $2)
+			ORDER BY score DESC, p.id LIMIT $4)
+		) suggestions
+		ORDER BY score DESC, type, id
+		LIMIT $4`
+
+	rows, err := storage.db.Query(query, prefix+"%", "% "+prefix+"%", userID, amount)
 	if err != nil {
-		return true, err
+		return nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -567,38 +1045,228 @@ func (storage *MusicStorage) IsPlaylistPublic(playlistID int64) (bool, error) {
 		}
 	}()
 
-	if rows.Next() {
-		return true, nil
+	suggestions := make([]*proto.Suggestion, 0, amount)
+	for rows.Next() {
+		suggestion := &proto.Suggestion{}
+		if err = rows.Scan(&suggestion.Type, &suggestion.ID, &suggestion.Title, &suggestion.Subtitle, &suggestion.Artwork); err != nil {
+			return nil, err
+		}
+		if suggestion.Type == constants.SuggestionTypePlaylist {
+			// Приватные плейлисты попадают в подсказки только владельцу, поэтому его обложки подписываются всегда
+			suggestion.Artwork = storage.links.PlaylistArtwork(suggestion.Artwork, constants.PlaylistArtworkExtension100px, userID == -1, userID)
+		}
+		suggestions = append(suggestions, suggestion)
 	}
-	if err = rows.Err(); err != nil {
-		return false, err
+	err = rows.Err()
+	if err != nil {
+		return nil, err
 	}
 
-	return false, nil
+	return suggestions, nil
 }
 
-func (storage *MusicStorage) PlaylistTracks(playlistID int64, userID int64) ([]*proto.Track, error) {
-	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
-		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
-		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
-		wrapper.Wrapper([]string{"name"}, "g") + ", " +
-		`
-		l.id IS NOT NULL as favorite
+func (storage *MusicStorage) CachedSuggestions(key string) ([]*proto.Suggestion, bool, error) {
+	data, err := storage.redis.Get(context.Background(), key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	suggestions := &proto.Suggestions{}
+	if err = protobuf.Unmarshal(data, suggestions); err != nil {
+		return nil, false, err
+	}
+
+	return suggestions.Suggestions, true, nil
+}
+
+func (storage *MusicStorage) CacheSuggestions(key string, suggestions []*proto.Suggestion, lifetime time.Duration) error {
+	data, err := protobuf.Marshal(&proto.Suggestions{Suggestions: suggestions})
+	if err != nil {
+		return err
+	}
+
+	return storage.redis.Set(context.Background(), key, data, lifetime).Err()
+}
+
+// Запись в историю поиска, если пользователь её не отключил. Повторный запрос поднимается наверх,
+// а выбранный результат сохраняется до следующего выбора. История обрезается до RecentSearchesMaxAmount
+func (storage *MusicStorage) RecordSearch(userID int64, query string, resultType string, resultID int64) error {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`
+		INSERT INTO recent_searches(user_id, query, result_type, result_id)
+		SELECT id, $2, NULLIF($3, ''), NULLIF($4, 0) FROM users WHERE id = $1 AND save_search_history
+		ON CONFLICT (user_id, lower(query)) DO UPDATE SET created_at = now(),
+		result_type = COALESCE(EXCLUDED.result_type, recent_searches.result_type),
+		result_id = COALESCE(EXCLUDED.result_id, recent_searches.result_id)`,
+		userID, query, resultType, resultID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM recent_searches WHERE user_id = $1 AND id NOT IN (
+			SELECT id FROM recent_searches WHERE user_id = $1
+			ORDER BY created_at DESC, id DESC LIMIT $2
+		)`, userID, constants.RecentSearchesMaxAmount)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Чужой плейлист, ставший приватным после поиска, не раскрывает свое название
+func (storage *MusicStorage) RecentSearches(userID int64, amount int64) ([]*proto.RecentSearch, error) {
+	query := `
+		SELECT rs.id, rs.query, COALESCE(rs.result_type, ''), COALESCE(rs.result_id, 0),
+		COALESCE(t.title, art.name, alb.title, p.title, ''), rs.created_at
+		FROM recent_searches rs
+		LEFT JOIN tracks t ON rs.result_type = 'track' AND t.id = rs.result_id
+		LEFT JOIN artists art ON rs.result_type = 'artist' AND art.id = rs.result_id
+		LEFT JOIN albums alb ON rs.result_type = 'album' AND alb.id = rs.result_id
+		LEFT JOIN playlists p ON rs.result_type = 'playlist' AND p.id = rs.result_id AND (p.is_public OR p.user_id = rs.user_id)
+		WHERE rs.user_id = $1
+		ORDER BY rs.created_at DESC, rs.id DESC
+		LIMIT $2`
+
+	rows, err := storage.db.Query(query, userID, amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	searches := make([]*proto.RecentSearch, 0, amount)
+	for rows.Next() {
+		search := &proto.RecentSearch{}
+		var createdAt time.Time
+		if err = rows.Scan(&search.ID, &search.Query, &search.ResultType, &search.ResultID, &search.ResultTitle,
+			&createdAt); err != nil {
+			return nil, err
+		}
+		search.CreatedAt = createdAt.Unix()
+		searches = append(searches, search)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return searches, nil
+}
+
+func (storage *MusicStorage) DeleteRecentSearch(userID int64, searchID int64) (bool, error) {
+	query := `DELETE FROM recent_searches WHERE id = $1 AND user_id = $2`
+
+	result, err := storage.db.Exec(query, searchID, userID)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return deleted != 0, nil
+}
+
+func (storage *MusicStorage) ClearRecentSearches(userID int64) error {
+	query := `DELETE FROM recent_searches WHERE user_id = $1`
+
+	_, err := storage.db.Exec(query, userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (storage *MusicStorage) IsPlaylistOwner(playlistID int64, userID int64) (bool, error) {
+	query := `SELECT * FROM playlists WHERE id=$1 AND user_id=$2`
+
+	rows, err := storage.db.Query(query, playlistID, userID)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	if rows.Next() {
+		return true, nil
+	}
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (storage *MusicStorage) IsPlaylistPublic(playlistID int64) (bool, error) {
+	query := `SELECT * FROM playlists WHERE id=$1 AND is_public=true`
+
+	rows, err := storage.db.Query(query, playlistID)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	if rows.Next() {
+		return true, nil
+	}
+	if err = rows.Err(); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (storage *MusicStorage) PlaylistTracks(playlistID int64, userID int64, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(playlistTracksKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID, playlistID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite, pt.id, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
+		JOIN playlist_tracks pt ON pt.track = t.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-			FROM playlist_tracks
-			WHERE playlist=$2
-		)`
+		WHERE pt.playlist = $2 AND ` + page.Where + `
+		` + page.Order
 
-	rows, err := storage.db.Query(query, userID, playlistID)
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -607,28 +1275,39 @@ func (storage *MusicStorage) PlaylistTracks(playlistID int64, userID int64) ([]*
 		}
 	}()
 
-	tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+	var total, positionID int64
+	positions := make([]int64, 0, page.Limit+1)
+	tracks := make([]*proto.Track, 0, page.Limit+1)
 	//nolint:dupl
 	for rows.Next() {
 		track := &proto.Track{}
 		track.Album = &proto.Album{}
 		track.Artist = &proto.Artist{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
-			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites); err != nil {
-			return nil, err
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
+			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites, &positionID, &total); err != nil {
+			return nil, nil, err
 		}
+		track.File = storage.trackFile(track.ID, userID, userID > 0)
 		tracks = append(tracks, track)
+		positions = append(positions, positionID)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return tracks, nil
+	hasMore := page.HasMore(len(tracks))
+	var last []interface{}
+	if hasMore {
+		tracks = tracks[:page.Limit]
+		last = []interface{}{positions[page.Limit-1]}
+	}
+
+	return tracks, pageResponse(page, total, last, hasMore), nil
 }
 
-func (storage *MusicStorage) PlaylistInfo(playlistID int64) (*proto.PlaylistData, error) {
+func (storage *MusicStorage) PlaylistInfo(playlistID int64, userID int64) (*proto.PlaylistData, error) {
 	query := `SELECT id, title, artwork, artwork_color, is_public FROM playlists WHERE id=$1`
 
 	playlistInfo := &proto.PlaylistData{}
@@ -638,17 +1317,24 @@ func (storage *MusicStorage) PlaylistInfo(playlistID int64) (*proto.PlaylistData
 		return nil, err
 	}
 
-	playlistInfo.Artwork = os.Getenv("PLAYLIST_ROOT_PREFIX") + playlistInfo.Artwork + constants.PlaylistArtworkExtension384px
+	playlistInfo.Artwork = storage.links.PlaylistArtwork(playlistInfo.Artwork, constants.PlaylistArtworkExtension384px, playlistInfo.IsPublic, userID)
 
 	return playlistInfo, nil
 }
 
-func (storage *MusicStorage) UserPlaylists(userID int64) ([]*proto.PlaylistData, error) {
-	query := `SELECT id, title, artwork, is_public, user_id=$1 AS is_own FROM playlists WHERE user_id=$1 OR is_public=true`
+func (storage *MusicStorage) UserPlaylists(userID int64, pageRequest *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(playlistsKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT id, title, artwork, is_public, user_id=$1 AS is_own, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$1 OR is_public=true) AND ` + page.Where + `
+		` + page.Order
 
-	rows, err := storage.db.Query(query, userID)
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -657,21 +1343,29 @@ func (storage *MusicStorage) UserPlaylists(userID int64) ([]*proto.PlaylistData,
 		}
 	}()
 
-	playlists := make([]*proto.PlaylistData, 0)
+	var total int64
+	playlists := make([]*proto.PlaylistData, 0, page.Limit+1)
 	for rows.Next() {
 		playlist := &proto.PlaylistData{}
-		if err = rows.Scan(&playlist.PlaylistID, &playlist.Title, &playlist.Artwork, &playlist.IsPublic, &playlist.IsOwn); err != nil {
-			return nil, err
+		if err = rows.Scan(&playlist.PlaylistID, &playlist.Title, &playlist.Artwork, &playlist.IsPublic, &playlist.IsOwn, &total); err != nil {
+			return nil, nil, err
 		}
-		playlist.Artwork = os.Getenv("PLAYLIST_ROOT_PREFIX") + playlist.Artwork + constants.PlaylistArtworkExtension100px
+		playlist.Artwork = storage.links.PlaylistArtwork(playlist.Artwork, constants.PlaylistArtworkExtension100px, playlist.IsPublic, userID)
 		playlists = append(playlists, playlist)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(playlists))
+	var last []interface{}
+	if hasMore {
+		playlists = playlists[:page.Limit]
+		last = []interface{}{playlists[len(playlists)-1].PlaylistID}
 	}
 
-	return playlists, nil
+	return playlists, pageResponse(page, total, last, hasMore), nil
 }
 
 func (storage *MusicStorage) DoesPlaylistExist(playlistID int64) (bool, error) {
@@ -717,24 +1411,35 @@ func (storage *MusicStorage) DeleteTrackFromFavorites(userID int64, trackID int6
 	return nil
 }
 
-func (storage *MusicStorage) GetFavorites(userID int64) ([]*proto.Track, error) {
+func (storage *MusicStorage) GetFavorites(userID int64, sortBy string, filter *proto.FavoritesFilter, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	keys, ok := favoritesKeys[sortBy]
+	if !ok {
+		sortBy = constants.SortByDateAdded
+		keys = favoritesKeys[sortBy]
+	}
+	conditions, args := favoritesConditions(filter, userID)
+	page, err := pagination.NewPage(keys, pageRequest.GetCursor(), pageRequest.GetLimit(), args...)
+	if err != nil {
+		return nil, nil, err
+	}
 	query := `SELECT ` +
-		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
 		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
 		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
 		wrapper.Wrapper([]string{"name"}, "g") + ", " +
 		`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, l.id, l.created_at, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		JOIN likes l on t.id = l.track_id and l.user_id = $1
-        ORDER BY l.id`
+		WHERE ` + conditions + page.Where + `
+		` + page.Order
 
-	rows, err := storage.db.Query(query, userID)
+	rows, err := storage.db.Query(query, page.Args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = rows.Close()
@@ -743,25 +1448,41 @@ func (storage *MusicStorage) GetFavorites(userID int64) ([]*proto.Track, error)
 		}
 	}()
 
-	tracks := make([]*proto.Track, 0)
+	var total, likeID int64
+	var addedAt time.Time
+	likes := make([]int64, 0, page.Limit+1)
+	addedDates := make([]time.Time, 0, page.Limit+1)
+	tracks := make([]*proto.Track, 0, page.Limit+1)
 	//nolint:dupl
 	for rows.Next() {
 		track := &proto.Track{}
 		track.Album = &proto.Album{}
 		track.Artist = &proto.Artist{}
 		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
-			&track.Duration, &track.Lossless, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
-			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites); err != nil {
-			return nil, err
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
+			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites,
+			&likeID, &addedAt, &total); err != nil {
+			return nil, nil, err
 		}
+		track.File = storage.trackFile(track.ID, userID, true)
+		track.AddedAt = addedAt.Unix()
 		tracks = append(tracks, track)
+		likes = append(likes, likeID)
+		addedDates = append(addedDates, addedAt)
 	}
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return tracks, nil
+	hasMore := page.HasMore(len(tracks))
+	var last []interface{}
+	if hasMore {
+		tracks = tracks[:page.Limit]
+		last = favoriteKey(sortBy, tracks[len(tracks)-1], likes[page.Limit-1], addedDates[page.Limit-1])
+	}
+
+	return tracks, pageResponse(page, total, last, hasMore), nil
 }
 
 func (storage *MusicStorage) IsTrackInFavorites(userID int64, trackID int64) (bool, error) {
@@ -775,3 +1496,1582 @@ func (storage *MusicStorage) IsTrackInFavorites(userID int64, trackID int64) (bo
 
 	return isExist, nil
 }
+
+func (storage *MusicStorage) IsAlbumSaved(userID int64, albumID int64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM saved_albums WHERE user_id = $1 AND album_id = $2)`
+	var isExist bool
+
+	err := storage.db.QueryRow(query, userID, albumID).Scan(&isExist)
+	if err != nil {
+		return false, err
+	}
+
+	return isExist, nil
+}
+
+func (storage *MusicStorage) SaveAlbum(userID int64, albumID int64) error {
+	query := `INSERT INTO saved_albums(user_id, album_id) VALUES ($1, $2)`
+
+	_, err := storage.db.Exec(query, userID, albumID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (storage *MusicStorage) UnsaveAlbum(userID int64, albumID int64) error {
+	query := `DELETE FROM saved_albums WHERE user_id = $1 AND album_id = $2`
+
+	_, err := storage.db.Exec(query, userID, albumID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (storage *MusicStorage) SavedAlbums(userID int64, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(savedAlbumsKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") +
+		`, (SELECT COALESCE(SUM(t.duration), 0) FROM tracks t WHERE t.album = alb.id) AS tracksDuration,
+		sa.created_at, COUNT(*) OVER () AS total
+		FROM saved_albums sa
+		JOIN albums alb ON alb.id = sa.album_id
+		JOIN artists art ON art.id = alb.artist
+		WHERE sa.user_id = $1 AND ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	var total int64
+	var savedAt time.Time
+	savedDates := make([]time.Time, 0, page.Limit+1)
+	albums := make([]*proto.Album, 0, page.Limit+1)
+	for rows.Next() {
+		album := &proto.Album{IsSaved: true}
+		if err = rows.Scan(&album.ID, &album.Title, &album.Year, &album.Artwork, &album.TracksAmount, &album.ArtworkColor, &album.Artist,
+			&album.TracksDuration, &savedAt, &total); err != nil {
+			return nil, nil, err
+		}
+		albums = append(albums, album)
+		savedDates = append(savedDates, savedAt)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(albums))
+	var last []interface{}
+	if hasMore {
+		albums = albums[:page.Limit]
+		last = []interface{}{savedDates[page.Limit-1].Format(time.RFC3339Nano), albums[len(albums)-1].ID}
+	}
+
+	return albums, pageResponse(page, total, last, hasMore), nil
+}
+
+// Какие из albumIDs сохранены пользователем. Нужен спискам альбомов, которые выбираются без пользователя
+func (storage *MusicStorage) SavedAlbumIDs(userID int64, albumIDs []int64) ([]int64, error) {
+	query := `SELECT album_id FROM saved_albums WHERE user_id = $1 AND album_id = ANY($2::integer[])`
+
+	rows, err := storage.db.Query(query, userID, pq.Array(albumIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (storage *MusicStorage) IsArtistFollowed(userID int64, artistID int64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM artist_follows WHERE user_id = $1 AND artist_id = $2)`
+	var isExist bool
+
+	err := storage.db.QueryRow(query, userID, artistID).Scan(&isExist)
+	if err != nil {
+		return false, err
+	}
+
+	return isExist, nil
+}
+
+func (storage *MusicStorage) FollowArtist(userID int64, artistID int64) error {
+	query := `INSERT INTO artist_follows(user_id, artist_id) VALUES ($1, $2)`
+
+	_, err := storage.db.Exec(query, userID, artistID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (storage *MusicStorage) UnfollowArtist(userID int64, artistID int64) error {
+	query := `DELETE FROM artist_follows WHERE user_id = $1 AND artist_id = $2`
+
+	_, err := storage.db.Exec(query, userID, artistID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Какие из artistIDs в подписках пользователя. Нужен спискам исполнителей, которые выбираются без пользователя
+func (storage *MusicStorage) FollowedArtistIDs(userID int64, artistIDs []int64) ([]int64, error) {
+	query := `SELECT artist_id FROM artist_follows WHERE user_id = $1 AND artist_id = ANY($2::integer[])`
+
+	rows, err := storage.db.Query(query, userID, pq.Array(artistIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (storage *MusicStorage) FollowedArtists(userID int64, pageRequest *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(followedArtistKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") +
+		`, COALESCE(art.avatar_color, ''),
+		(SELECT COUNT(*) FROM artist_follows f WHERE f.artist_id = art.id) AS followers,
+		af.created_at, COUNT(*) OVER () AS total
+		FROM artist_follows af
+		JOIN artists art ON art.id = af.artist_id
+		WHERE af.user_id = $1 AND ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	var total int64
+	var followedAt time.Time
+	followDates := make([]time.Time, 0, page.Limit+1)
+	artists := make([]*proto.Artist, 0, page.Limit+1)
+	for rows.Next() {
+		artist := &proto.Artist{IsFollowed: true}
+		if err = rows.Scan(&artist.ID, &artist.Name, &artist.Avatar, &artist.AvatarColor, &artist.FollowersCount,
+			&followedAt, &total); err != nil {
+			return nil, nil, err
+		}
+		artists = append(artists, artist)
+		followDates = append(followDates, followedAt)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(artists))
+	var last []interface{}
+	if hasMore {
+		artists = artists[:page.Limit]
+		last = []interface{}{followDates[page.Limit-1].Format(time.RFC3339Nano), artists[len(artists)-1].ID}
+	}
+
+	return artists, pageResponse(page, total, last, hasMore), nil
+}
+
+func (storage *MusicStorage) IsTrackDisliked(userID int64, trackID int64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM dislikes WHERE user_id = $1 AND track_id = $2)`
+	var isExist bool
+
+	err := storage.db.QueryRow(query, userID, trackID).Scan(&isExist)
+	if err != nil {
+		return false, err
+	}
+
+	return isExist, nil
+}
+
+// Дизлайк снимает лайк с трека
+func (storage *MusicStorage) DislikeTrack(userID int64, trackID int64) error {
+	query := `
+		WITH unliked AS (DELETE FROM likes WHERE user_id = $1 AND track_id = $2)
+		INSERT INTO dislikes(user_id, track_id) VALUES ($1, $2)`
+
+	_, err := storage.db.Exec(query, userID, trackID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (storage *MusicStorage) DeleteTrackDislike(userID int64, trackID int64) error {
+	query := `DELETE FROM dislikes WHERE user_id = $1 AND track_id = $2`
+
+	_, err := storage.db.Exec(query, userID, trackID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Артисты и жанры, вокруг которых строится радио. Плейлист годится, только если он публичный или принадлежит пользователю
+func (storage *MusicStorage) RadioSeed(seedType string, seedID int64, userID int64) (*proto.RadioSession, error) {
+	var query string
+	args := []interface{}{seedID}
+	switch seedType {
+	case constants.RadioSeedTrack:
+		query = `SELECT ARRAY[artist], array_remove(ARRAY[genre], NULL) FROM tracks WHERE id = $1`
+	case constants.RadioSeedArtist:
+		query = `SELECT ARRAY[id], array_remove(ARRAY(SELECT DISTINCT genre FROM tracks WHERE artist = $1), NULL) FROM artists WHERE id = $1`
+	case constants.RadioSeedAlbum:
+		query = `
+			SELECT ARRAY(SELECT DISTINCT artist FROM tracks WHERE album = $1),
+			array_remove(ARRAY(SELECT DISTINCT genre FROM tracks WHERE album = $1), NULL)
+			FROM albums WHERE id = $1`
+	case constants.RadioSeedPlaylist:
+		query = `
+			SELECT ARRAY(SELECT DISTINCT t.artist FROM playlist_tracks pt JOIN tracks t ON t.id = pt.track WHERE pt.playlist = $1),
+			array_remove(ARRAY(SELECT DISTINCT t.genre FROM playlist_tracks pt JOIN tracks t ON t.id = pt.track WHERE pt.playlist = $1), NULL)
+			FROM playlists WHERE id = $1 AND (is_public OR user_id = $2)`
+		args = append(args, userID)
+	default:
+		return nil, sql.ErrNoRows
+	}
+
+	var artistIDs, genreIDs pq.Int64Array
+	err := storage.db.QueryRow(query, args...).Scan(&artistIDs, &genreIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RadioSession{ArtistIDs: artistIDs, GenreIDs: genreIDs}, nil
+}
+
+// Кандидаты для радио без дизлайков и уже сыгранных треков, треки артистов из зерна попадаются чаще.
+// pool сужает выборку до знакомых пользователю (лайки и прослушивания) или новых для него треков.
+// candidates - случайная выборка из пула подборок: треки берутся только из нее и в ее порядке,
+// чтобы не сортировать весь каталог, когда выборка не ограничена зерном
+func (storage *MusicStorage) RadioTracks(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64,
+	candidates []int64, amount int64) ([]*proto.Track, error) {
+	if exclude == nil {
+		exclude = []int64{}
+	}
+	args := []interface{}{userID, pq.Array(exclude)}
+	var conditions strings.Builder
+	order := "RANDOM() DESC"
+	if len(artistIDs) > 0 || len(genreIDs) > 0 {
+		args = append(args, pq.Array(artistIDs), pq.Array(genreIDs))
+		conditions.WriteString(" AND (t.artist = ANY($3) OR t.genre = ANY($4))")
+		order = "RANDOM() * CASE WHEN t.artist = ANY($3) THEN 2 ELSE 1 END DESC"
+	}
+	if candidates != nil {
+		args = append(args, pq.Array(candidates))
+		conditions.WriteString(fmt.Sprintf(" AND t.id = ANY($%d::integer[])", len(args)))
+		order = fmt.Sprintf("array_position($%d::integer[], t.id)", len(args))
+	}
+	switch pool {
+	case constants.RadioPoolFamiliar:
+		conditions.WriteString(" AND (l.id IS NOT NULL OR EXISTS(SELECT 1 FROM listens ls WHERE ls.user_id = $1 AND ls.track_id = t.id))")
+	case constants.RadioPoolFresh:
+		conditions.WriteString(" AND l.id IS NULL AND NOT EXISTS(SELECT 1 FROM listens ls WHERE ls.user_id = $1 AND ls.track_id = t.id)")
+	}
+	args = append(args, amount)
+
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE NOT t.id = ANY($2) AND NOT EXISTS(SELECT 1 FROM dislikes d WHERE d.user_id = $1 AND d.track_id = t.id)` +
+		conditions.String() + `
+		ORDER BY ` + order + fmt.Sprintf(" LIMIT $%d", len(args))
+
+	rows, err := storage.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	tracks := make([]*proto.Track, 0, amount)
+	//nolint:dupl
+	for rows.Next() {
+		track := &proto.Track{}
+		track.Album = &proto.Album{}
+		track.Artist = &proto.Artist{}
+		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork, &track.Album.ArtworkColor, &track.Artist.ID,
+			&track.Artist.Name, &track.Genre, &track.IsInFavorites); err != nil {
+			return nil, err
+		}
+		track.File = storage.trackFile(track.ID, userID, true)
+		tracks = append(tracks, track)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+func (storage *MusicStorage) RadioSession(key string) (*proto.RadioSession, bool, error) {
+	data, err := storage.redis.Get(context.Background(), key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	session := &proto.RadioSession{}
+	if err = protobuf.Unmarshal(data, session); err != nil {
+		return nil, false, err
+	}
+
+	return session, true, nil
+}
+
+func (storage *MusicStorage) SaveRadioSession(key string, session *proto.RadioSession, lifetime time.Duration) error {
+	data, err := protobuf.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	return storage.redis.Set(context.Background(), key, data, lifetime).Err()
+}
+
+func (storage *MusicStorage) RebuildChart(period string, start time.Time, end time.Time, previousStart time.Time) error {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	periodStart := start.Format(constants.ChartDateLayout)
+	periodEnd := end.Format(constants.ChartDateLayout)
+
+	_, err = tx.Exec(`DELETE FROM charts WHERE period = $1 AND period_start = $2`, period, periodStart)
+	if err != nil {
+		return err
+	}
+
+	for _, entity := range chartEntityColumns {
+		query := `
+		INSERT INTO charts(period, entity, genre, period_start, position, entity_id, plays)
+		SELECT $1, $2, ranked.genre, $3, ranked.position, ranked.entity_id, ranked.plays
+		FROM (
+			SELECT g.genre, ` + entity.column + ` AS entity_id, SUM(p.plays) AS plays,
+			ROW_NUMBER() OVER (PARTITION BY g.genre ORDER BY SUM(p.plays) DESC, ` + entity.column + `) AS position
+			FROM track_plays_daily p
+			JOIN tracks t ON t.id = p.track_id
+			CROSS JOIN LATERAL (VALUES (0), (t.genre)) AS g(genre)
+			WHERE p.day >= $3 AND p.day < $4 AND g.genre IS NOT NULL
+			GROUP BY g.genre, ` + entity.column + `
+		) ranked
+		WHERE ranked.position <= $5`
+
+		_, err = tx.Exec(query, period, entity.entity, periodStart, periodEnd, constants.ChartPositionsAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+		UPDATE charts c SET previous_position = prev.position
+		FROM charts prev
+		WHERE c.period = $1 AND c.period_start = $2
+		AND prev.period = c.period AND prev.period_start = $3
+		AND prev.entity = c.entity AND prev.genre = c.genre AND prev.entity_id = c.entity_id`
+
+	_, err = tx.Exec(query, period, periodStart, previousStart.Format(constants.ChartDateLayout))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (storage *MusicStorage) ChartPeriodStart(period string) (time.Time, error) {
+	query := `SELECT period_start FROM charts WHERE period = $1 ORDER BY period_start DESC LIMIT 1`
+
+	var periodStart time.Time
+	err := storage.db.QueryRow(query, period).Scan(&periodStart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return periodStart, nil
+}
+
+func (storage *MusicStorage) ChartTracks(period string, periodStart time.Time, genreID int64, amount int64, userID int64, isAuthorized bool) ([]*proto.ChartTrack, error) {
+	query := `SELECT c.position, COALESCE(c.previous_position, 0), c.plays, ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite
+		FROM charts c
+		JOIN tracks t ON t.id = c.entity_id
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE c.period = $2 AND c.entity = $3 AND c.genre = $4 AND c.period_start = $5
+		ORDER BY c.position LIMIT $6`
+
+	rows, err := storage.db.Query(query, userID, period, constants.ChartEntityTracks, genreID,
+		periodStart.Format(constants.ChartDateLayout), amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	tracks := make([]*proto.ChartTrack, 0, amount)
+	for rows.Next() {
+		track := &proto.ChartTrack{}
+		track.Track = &proto.Track{}
+		track.Track.Album = &proto.Album{}
+		track.Track.Artist = &proto.Artist{}
+		if err = rows.Scan(&track.Position, &track.PreviousPosition, &track.Plays, &track.Track.ID, &track.Track.Title,
+			&track.Track.Explicit, &track.Track.Number, &track.Track.File, &track.Track.ListenCount, &track.Track.Duration,
+			&track.Track.Lossless, &track.Track.HasLyrics, &track.Track.Album.ID, &track.Track.Album.Title, &track.Track.Album.Artwork,
+			&track.Track.Album.ArtworkColor, &track.Track.Artist.ID, &track.Track.Artist.Name, &track.Track.Genre,
+			&track.Track.IsInFavorites); err != nil {
+			return nil, err
+		}
+		track.Track.File = storage.trackFile(track.Track.ID, userID, isAuthorized)
+		tracks = append(tracks, track)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+func (storage *MusicStorage) ChartAlbums(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartAlbum, error) {
+	query := `SELECT c.position, COALESCE(c.previous_position, 0), c.plays, ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") +
+		`
+		FROM charts c
+		JOIN albums alb ON alb.id = c.entity_id
+		JOIN artists art ON art.id = alb.artist
+		WHERE c.period = $1 AND c.entity = $2 AND c.genre = $3 AND c.period_start = $4
+		ORDER BY c.position LIMIT $5`
+
+	rows, err := storage.db.Query(query, period, constants.ChartEntityAlbums, genreID,
+		periodStart.Format(constants.ChartDateLayout), amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	albums := make([]*proto.ChartAlbum, 0, amount)
+	for rows.Next() {
+		album := &proto.ChartAlbum{}
+		album.Album = &proto.Album{}
+		if err = rows.Scan(&album.Position, &album.PreviousPosition, &album.Plays, &album.Album.ID, &album.Album.Title,
+			&album.Album.Year, &album.Album.Artwork, &album.Album.TracksAmount, &album.Album.ArtworkColor,
+			&album.Album.Artist); err != nil {
+			return nil, err
+		}
+		albums = append(albums, album)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return albums, nil
+}
+
+func (storage *MusicStorage) ChartArtists(period string, periodStart time.Time, genreID int64, amount int64) ([]*proto.ChartArtist, error) {
+	query := `SELECT c.position, COALESCE(c.previous_position, 0), c.plays, ` +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") +
+		`
+		FROM charts c
+		JOIN artists art ON art.id = c.entity_id
+		WHERE c.period = $1 AND c.entity = $2 AND c.genre = $3 AND c.period_start = $4
+		ORDER BY c.position LIMIT $5`
+
+	rows, err := storage.db.Query(query, period, constants.ChartEntityArtists, genreID,
+		periodStart.Format(constants.ChartDateLayout), amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	artists := make([]*proto.ChartArtist, 0, amount)
+	for rows.Next() {
+		artist := &proto.ChartArtist{}
+		artist.Artist = &proto.Artist{}
+		if err = rows.Scan(&artist.Position, &artist.PreviousPosition, &artist.Plays, &artist.Artist.ID,
+			&artist.Artist.Name, &artist.Artist.Avatar); err != nil {
+			return nil, err
+		}
+		artists = append(artists, artist)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return artists, nil
+}
+
+func (storage *MusicStorage) ListGenres() ([]*proto.Genre, error) {
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "name", "artwork", "artwork_color"}, "g") + ", COUNT(t.id) AS tracksAmount" +
+		`
+		FROM genres g
+		JOIN tracks t ON t.genre = g.id
+		GROUP BY g.id
+		ORDER BY tracksAmount DESC, g.name`
+
+	rows, err := storage.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	genres := make([]*proto.Genre, 0)
+	for rows.Next() {
+		genre := &proto.Genre{}
+		if err = rows.Scan(&genre.ID, &genre.Name, &genre.Artwork, &genre.ArtworkColor, &genre.TracksAmount); err != nil {
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (storage *MusicStorage) GenreInfo(genreID int64) (*proto.Genre, error) {
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "name", "artwork", "artwork_color"}, "g") + ", COUNT(t.id) AS tracksAmount" +
+		`
+		FROM genres g
+		LEFT JOIN tracks t ON t.genre = g.id
+		WHERE g.id = $1
+		GROUP BY g.id`
+
+	genre := &proto.Genre{}
+	err := storage.db.QueryRow(query, genreID).Scan(&genre.ID, &genre.Name, &genre.Artwork, &genre.ArtworkColor, &genre.TracksAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return genre, nil
+}
+
+func (storage *MusicStorage) GenreTracks(genreID int64, userID int64, isAuthorized bool, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(popularTracksKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID, genreID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE t.genre = $2 AND ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	var total int64
+	tracks := make([]*proto.Track, 0, page.Limit+1)
+	//nolint:dupl
+	for rows.Next() {
+		track := &proto.Track{}
+		track.Album = &proto.Album{}
+		track.Artist = &proto.Artist{}
+		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Artwork,
+			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites, &total); err != nil {
+			return nil, nil, err
+		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
+		tracks = append(tracks, track)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(tracks))
+	var last []interface{}
+	if hasMore {
+		tracks = tracks[:page.Limit]
+		last = []interface{}{tracks[len(tracks)-1].ListenCount, tracks[len(tracks)-1].ID}
+	}
+
+	return tracks, pageResponse(page, total, last, hasMore), nil
+}
+
+func (storage *MusicStorage) GenreAlbums(genreID int64, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(artistAlbumsKeys[constants.SortByPopularity], pageRequest.GetCursor(), pageRequest.GetLimit(), genreID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
+		`, SUM(t.listen_count) AS listenCount, COUNT(*) OVER () AS total
+		FROM albums alb
+		JOIN artists art ON art.id = alb.artist
+		JOIN tracks t ON alb.id = t.album
+		WHERE t.genre = $1
+		GROUP BY alb.id, art.name
+		HAVING ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	listenCounts := make([]int64, 0, page.Limit+1)
+	var total, listenCount int64
+	albums := make([]*proto.Album, 0, page.Limit+1)
+	for rows.Next() {
+		album := &proto.Album{}
+		if err = rows.Scan(&album.ID, &album.Title, &album.Year, &album.Artwork, &album.TracksAmount, &album.ArtworkColor, &album.Artist,
+			&album.TracksDuration, &listenCount, &total); err != nil {
+			return nil, nil, err
+		}
+		albums = append(albums, album)
+		listenCounts = append(listenCounts, listenCount)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(albums))
+	var last []interface{}
+	if hasMore {
+		albums = albums[:page.Limit]
+		last = []interface{}{listenCounts[page.Limit-1], albums[len(albums)-1].ID}
+	}
+
+	return albums, pageResponse(page, total, last, hasMore), nil
+}
+
+func (storage *MusicStorage) GenreArtists(genreID int64, pageRequest *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+	page, err := pagination.NewPage(popularArtistsKeys, pageRequest.GetCursor(), pageRequest.GetLimit(), genreID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") +
+		`, SUM(t.listen_count) AS listenCount, COUNT(*) OVER () AS total
+		FROM artists art
+		JOIN tracks t ON t.artist = art.id
+		WHERE t.genre = $1
+		GROUP BY art.id
+		HAVING ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	var total, listenCount int64
+	listenCounts := make([]int64, 0, page.Limit+1)
+	artists := make([]*proto.Artist, 0, page.Limit+1)
+	for rows.Next() {
+		artist := &proto.Artist{}
+		if err = rows.Scan(&artist.ID, &artist.Name, &artist.Avatar, &listenCount, &total); err != nil {
+			return nil, nil, err
+		}
+		artists = append(artists, artist)
+		listenCounts = append(listenCounts, listenCount)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(artists))
+	var last []interface{}
+	if hasMore {
+		artists = artists[:page.Limit]
+		last = []interface{}{listenCounts[page.Limit-1], artists[len(artists)-1].ID}
+	}
+
+	return artists, pageResponse(page, total, last, hasMore), nil
+}
+
+func (storage *MusicStorage) ArtistTracksPage(artistID int64, userID int64, isAuthorized bool, sortBy string, pageRequest *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+	keys, ok := artistTracksKeys[sortBy]
+	if !ok {
+		sortBy = constants.SortByPopularity
+		keys = artistTracksKeys[sortBy]
+	}
+	page, err := pagination.NewPage(keys, pageRequest.GetCursor(), pageRequest.GetLimit(), userID, artistID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE t.artist = $2 AND ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	var total int64
+	tracks := make([]*proto.Track, 0, page.Limit+1)
+	for rows.Next() {
+		track := &proto.Track{}
+		track.Album = &proto.Album{}
+		track.Artist = &proto.Artist{}
+		if err = rows.Scan(&track.ID, &track.Title, &track.Explicit, &track.Number, &track.File, &track.ListenCount,
+			&track.Duration, &track.Lossless, &track.HasLyrics, &track.Album.ID, &track.Album.Title, &track.Album.Year, &track.Album.Artwork,
+			&track.Album.ArtworkColor, &track.Artist.ID, &track.Artist.Name, &track.Genre, &track.IsInFavorites, &total); err != nil {
+			return nil, nil, err
+		}
+		track.File = storage.trackFile(track.ID, userID, isAuthorized)
+		tracks = append(tracks, track)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(tracks))
+	var last []interface{}
+	if hasMore {
+		tracks = tracks[:page.Limit]
+		last = artistTrackKey(sortBy, tracks[len(tracks)-1])
+	}
+
+	return tracks, pageResponse(page, total, last, hasMore), nil
+}
+
+func (storage *MusicStorage) ArtistAlbumsPage(artistID int64, sortBy string, pageRequest *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+	keys, ok := artistAlbumsKeys[sortBy]
+	if !ok {
+		sortBy = constants.SortByPopularity
+		keys = artistAlbumsKeys[sortBy]
+	}
+	page, err := pagination.NewPage(keys, pageRequest.GetCursor(), pageRequest.GetLimit(), artistID)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
+		`, SUM(t.listen_count) AS listenCount, COUNT(*) OVER () AS total
+		FROM albums alb
+		JOIN artists art ON art.id = alb.artist
+		JOIN tracks t ON alb.id = t.album
+		WHERE alb.artist = $1
+		GROUP BY alb.id, art.name
+		HAVING ` + page.Where + `
+		` + page.Order
+
+	rows, err := storage.db.Query(query, page.Args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	listenCounts := make([]int64, 0, page.Limit+1)
+	var total, listenCount int64
+	albums := make([]*proto.Album, 0, page.Limit+1)
+	for rows.Next() {
+		album := &proto.Album{}
+		if err = rows.Scan(&album.ID, &album.Title, &album.Year, &album.Artwork, &album.TracksAmount, &album.ArtworkColor, &album.Artist,
+			&album.TracksDuration, &listenCount, &total); err != nil {
+			return nil, nil, err
+		}
+		albums = append(albums, album)
+		listenCounts = append(listenCounts, listenCount)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := page.HasMore(len(albums))
+	var last []interface{}
+	if hasMore {
+		albums = albums[:page.Limit]
+		last = artistAlbumKey(sortBy, albums[len(albums)-1], listenCounts[page.Limit-1])
+	}
+
+	return albums, pageResponse(page, total, last, hasMore), nil
+}
+
+func artistTrackKey(sortBy string, track *proto.Track) []interface{} {
+	switch sortBy {
+	case constants.SortByYear:
+		return []interface{}{track.Album.Year, track.Album.ID, track.Number, track.ID}
+	case constants.SortByTitle:
+		return []interface{}{track.Title, track.ID}
+	default:
+		return []interface{}{track.ListenCount, track.ID}
+	}
+}
+
+func artistAlbumKey(sortBy string, album *proto.Album, listenCount int64) []interface{} {
+	switch sortBy {
+	case constants.SortByYear:
+		return []interface{}{album.Year, album.ID}
+	case constants.SortByTitle:
+		return []interface{}{album.Title, album.ID}
+	default:
+		return []interface{}{listenCount, album.ID}
+	}
+}
+
+func favoriteKey(sortBy string, track *proto.Track, likeID int64, addedAt time.Time) []interface{} {
+	switch sortBy {
+	case constants.SortByTitle:
+		return []interface{}{track.Title, likeID}
+	case constants.SortByArtist:
+		return []interface{}{track.Artist.Name, track.Title, likeID}
+	case constants.SortByDuration:
+		return []interface{}{track.Duration, likeID}
+	case constants.SortByListenCount:
+		return []interface{}{track.ListenCount, likeID}
+	default:
+		return []interface{}{addedAt.Format(time.RFC3339Nano), likeID}
+	}
+}
+
+// Условия фильтрации избранного, аргументы пагинации идут после аргументов фильтра
+func favoritesConditions(filter *proto.FavoritesFilter, userID int64) (string, []interface{}) {
+	args := []interface{}{userID}
+	var conditions string
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions += fmt.Sprintf(condition, len(args)) + " AND "
+	}
+
+	if filter.GetArtistID() != 0 {
+		addCondition("t.artist = $%d", filter.GetArtistID())
+	}
+	if filter.GetGenreID() != 0 {
+		addCondition("t.genre = $%d", filter.GetGenreID())
+	}
+	if filter != nil && filter.Explicit != nil {
+		addCondition("t.explicit = $%d", filter.GetExplicit())
+	}
+	if filter != nil && filter.Lossless != nil {
+		addCondition("t.lossless = $%d", filter.GetLossless())
+	}
+	if len(filter.GetText()) != 0 {
+		addCondition("concat_ws(' ', t.title, art.name, alb.title) ILIKE $%d", "%"+escapeLike(filter.GetText())+"%")
+	}
+
+	return conditions, args
+}
+
+// Условия фильтров поиска треков, аргументы фильтров дописываются после аргументов запроса
+func searchConditions(filter *proto.SearchFilter, args []interface{}) (string, []interface{}) {
+	var conditions string
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions += fmt.Sprintf(condition, len(args)) + " AND "
+	}
+
+	if filter.GetGenreID() != 0 {
+		addCondition("t.genre = $%d", filter.GetGenreID())
+	}
+	if len(filter.GetGenre()) != 0 {
+		addCondition("lower(g.name) = lower($%d)", filter.GetGenre())
+	}
+	if filter.GetArtistID() != 0 {
+		addCondition("t.artist = $%d", filter.GetArtistID())
+	}
+	if len(filter.GetArtist()) != 0 {
+		addCondition("lower(art.name) = lower($%d)", filter.GetArtist())
+	}
+	if len(filter.GetAlbum()) != 0 {
+		addCondition("lower(alb.title) = lower($%d)", filter.GetAlbum())
+	}
+	if filter.GetYearFrom() != 0 {
+		addCondition("alb.year >= $%d", filter.GetYearFrom())
+	}
+	if filter.GetYearTo() != 0 {
+		addCondition("alb.year <= $%d", filter.GetYearTo())
+	}
+	if filter.GetDurationFrom() != 0 {
+		addCondition("t.duration >= $%d", filter.GetDurationFrom())
+	}
+	if filter.GetDurationTo() != 0 {
+		addCondition("t.duration <= $%d", filter.GetDurationTo())
+	}
+	if filter != nil && filter.Explicit != nil {
+		addCondition("t.explicit = $%d", filter.GetExplicit())
+	}
+	if filter != nil && filter.Lossless != nil {
+		addCondition("t.lossless = $%d", filter.GetLossless())
+	}
+	if len(filter.GetLyrics()) != 0 {
+		addCondition(`EXISTS(SELECT 1 FROM lyrics ly WHERE ly.track_id = t.id AND
+			to_tsvector('simple', ly.plain) @@ plainto_tsquery('simple', $%d))`, filter.GetLyrics())
+	}
+
+	return conditions, args
+}
+
+// Исполнители, на которых подписан пользователь ($1) или которых он часто слушал начиная с $2
+const releaseRadarArtistsQuery = `
+		SELECT artist_id FROM artist_follows WHERE user_id = $1
+		UNION
+		SELECT t.artist FROM listens l
+		JOIN tracks t ON t.id = l.track_id
+		WHERE l.user_id = $1 AND l.listened_at >= $2
+		GROUP BY t.artist
+		HAVING COUNT(*) >= $3`
+
+// Альбомы исполнителей пользователя, вышедшие за последние constants.ReleaseRadarPeriod. Будущие релизы не показываются
+func (storage *MusicStorage) ReleaseRadar(userID int64, now time.Time, amount int64) ([]*proto.Album, error) {
+	query := `WITH radar_artists AS (` + releaseRadarArtistsQuery + `)
+		SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") +
+		`, to_char(alb.release_date, 'YYYY-MM-DD') AS release_date
+		FROM albums alb
+		JOIN radar_artists ra ON ra.artist_id = alb.artist
+		JOIN artists art ON art.id = alb.artist
+		WHERE alb.release_date >= $4 AND alb.release_date <= $5
+		ORDER BY alb.release_date DESC, alb.id DESC LIMIT $6`
+
+	rows, err := storage.db.Query(query, userID, now.Add(-constants.ReleaseRadarListensPeriod), constants.ReleaseRadarMinListens,
+		now.Add(-constants.ReleaseRadarPeriod).Format(constants.ChartDateLayout), now.Format(constants.ChartDateLayout), amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	albums := make([]*proto.Album, 0, amount)
+	for rows.Next() {
+		album := &proto.Album{}
+		if err = rows.Scan(&album.ID, &album.Title, &album.Year, &album.Artwork, &album.TracksAmount, &album.ArtworkColor,
+			&album.Artist, &album.ReleaseDate); err != nil {
+			return nil, err
+		}
+		albums = append(albums, album)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return albums, nil
+}
+
+// Треки из новинок в порядке альбомов, без дизлайкнутых пользователем
+func (storage *MusicStorage) ReleaseRadarTracks(userID int64, now time.Time, amount int64) ([]int64, error) {
+	query := `WITH radar_artists AS (` + releaseRadarArtistsQuery + `)
+		SELECT t.id
+		FROM tracks t
+		JOIN albums alb ON alb.id = t.album
+		JOIN radar_artists ra ON ra.artist_id = alb.artist
+		WHERE alb.release_date >= $4 AND alb.release_date <= $5
+		AND NOT EXISTS(SELECT 1 FROM dislikes d WHERE d.user_id = $1 AND d.track_id = t.id)
+		ORDER BY alb.release_date DESC, alb.id DESC, COALESCE(t.number, 0), t.id LIMIT $6`
+
+	rows, err := storage.db.Query(query, userID, now.Add(-constants.ReleaseRadarListensPeriod), constants.ReleaseRadarMinListens,
+		now.Add(-constants.ReleaseRadarPeriod).Format(constants.ChartDateLayout), now.Format(constants.ChartDateLayout), amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	trackIDs := make([]int64, 0, amount)
+	for rows.Next() {
+		var trackID int64
+		if err = rows.Scan(&trackID); err != nil {
+			return nil, err
+		}
+		trackIDs = append(trackIDs, trackID)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return trackIDs, nil
+}
+
+// Пользователи с подписками или недавними прослушиваниями, чей плейлист новинок ещё не собран на неделе weekStart
+func (storage *MusicStorage) ReleaseRadarListeners(now time.Time, weekStart time.Time) ([]int64, error) {
+	query := `
+		SELECT user_id FROM artist_follows
+		UNION
+		SELECT user_id FROM listens WHERE listened_at >= $1
+		EXCEPT
+		SELECT user_id FROM release_radar_playlists WHERE week_start >= $2`
+
+	rows, err := storage.db.Query(query, now.Add(-constants.ReleaseRadarListensPeriod), weekStart.Format(constants.ChartDateLayout))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	userIDs := make([]int64, 0)
+	for rows.Next() {
+		var userID int64
+		if err = rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// Возвращает 0, если плейлист новинок для пользователя ещё не собирался или новинок у него не было
+func (storage *MusicStorage) ReleaseRadarPlaylist(userID int64) (int64, error) {
+	query := `SELECT COALESCE(playlist_id, 0) FROM release_radar_playlists WHERE user_id = $1`
+
+	var playlistID int64
+	err := storage.db.QueryRow(query, userID).Scan(&playlistID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return playlistID, nil
+}
+
+func (storage *MusicStorage) IsReleaseRadarPlaylist(playlistID int64, userID int64) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM release_radar_playlists WHERE playlist_id = $1 AND user_id = $2)`
+
+	var isReleaseRadar bool
+	err := storage.db.QueryRow(query, playlistID, userID).Scan(&isReleaseRadar)
+	if err != nil {
+		return false, err
+	}
+
+	return isReleaseRadar, nil
+}
+
+// Плейлист новинок принадлежит системному пользователю и хранится как обычный плейлист.
+// При пересборке треки заменяются, а идентификатор плейлиста остаётся прежним. Неделя без новинок
+// тоже отмечается собранной, но плейлист для неё не создаётся
+func (storage *MusicStorage) SaveReleaseRadarPlaylist(userID int64, weekStart time.Time, trackIDs []int64) error {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var playlistID sql.NullInt64
+	err = tx.QueryRow(`SELECT playlist_id FROM release_radar_playlists WHERE user_id = $1 FOR UPDATE`, userID).Scan(&playlistID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if !playlistID.Valid && len(trackIDs) > 0 {
+		err = tx.QueryRow(`INSERT INTO playlists(title, user_id) VALUES ($1, $2) RETURNING id`,
+			constants.ReleaseRadarPlaylistTitle, constants.SystemUserID).Scan(&playlistID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO release_radar_playlists(user_id, playlist_id, week_start) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET playlist_id = EXCLUDED.playlist_id, week_start = EXCLUDED.week_start`,
+		userID, playlistID, weekStart.Format(constants.ChartDateLayout))
+	if err != nil {
+		return err
+	}
+	if !playlistID.Valid {
+		return tx.Commit()
+	}
+
+	_, err = tx.Exec(`DELETE FROM playlist_tracks WHERE playlist = $1`, playlistID.Int64)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+		INSERT INTO playlist_tracks(playlist, track)
+		SELECT $1, tracks.id FROM unnest($2::integer[]) WITH ORDINALITY AS tracks(id, position)
+		ORDER BY tracks.position`, playlistID.Int64, pq.Array(trackIDs))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Пересчитывает итоги года year по прослушиваниям из [start, end) для всех пользователей сразу.
+// Серия - самая длинная последовательность дней подряд, в которые пользователь что-то слушал
+func (storage *MusicStorage) RebuildRecaps(year int64, start time.Time, end time.Time) error {
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM listening_recaps WHERE year = $1`, year)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM listening_recap_tops WHERE year = $1`, year)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		WITH plays AS (
+			SELECT l.user_id, t.duration, l.listened_at::date AS day
+			FROM listens l
+			JOIN tracks t ON t.id = l.track_id
+			WHERE l.listened_at >= $2 AND l.listened_at < $3
+		), days AS (
+			SELECT user_id, day, COUNT(*) AS listens FROM plays GROUP BY user_id, day
+		), streaks AS (
+			SELECT user_id, MIN(day) AS start, COUNT(*) AS length
+			FROM (
+				SELECT user_id, day, day - (ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY day))::integer AS streak
+				FROM days
+			) numbered
+			GROUP BY user_id, streak
+		)
+		INSERT INTO listening_recaps(user_id, year, minutes_listened, listens, longest_streak, longest_streak_start,
+			most_played_day, most_played_day_listens)
+		SELECT totals.user_id, $1, totals.seconds / 60, totals.listens, streak.length, streak.start, top_day.day, top_day.listens
+		FROM (SELECT user_id, SUM(duration) AS seconds, COUNT(*) AS listens FROM plays GROUP BY user_id) totals
+		CROSS JOIN LATERAL (
+			SELECT s.start, s.length FROM streaks s WHERE s.user_id = totals.user_id ORDER BY s.length DESC, s.start LIMIT 1
+		) streak
+		CROSS JOIN LATERAL (
+			SELECT d.day, d.listens FROM days d WHERE d.user_id = totals.user_id ORDER BY d.listens DESC, d.day LIMIT 1
+		) top_day`
+
+	_, err = tx.Exec(query, year, start, end)
+	if err != nil {
+		return err
+	}
+
+	for _, entity := range recapEntityColumns {
+		query = `
+		INSERT INTO listening_recap_tops(user_id, year, entity, position, entity_id, plays)
+		SELECT ranked.user_id, $1, $2, ranked.position, ranked.entity_id, ranked.plays
+		FROM (
+			SELECT l.user_id, ` + entity.column + ` AS entity_id, COUNT(*) AS plays,
+			ROW_NUMBER() OVER (PARTITION BY l.user_id ORDER BY COUNT(*) DESC, ` + entity.column + `) AS position
+			FROM listens l
+			JOIN tracks t ON t.id = l.track_id
+			WHERE l.listened_at >= $3 AND l.listened_at < $4 AND ` + entity.column + ` IS NOT NULL
+			GROUP BY l.user_id, ` + entity.column + `
+		) ranked
+		WHERE ranked.position <= $5`
+
+		_, err = tx.Exec(query, year, entity.entity, start, end, entity.amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Время последнего пересчёта итогов года. Нулевое время, если итоги ещё не считались
+func (storage *MusicStorage) RecapBuiltAt(year int64) (time.Time, error) {
+	query := `SELECT COALESCE(MAX(built_at), 'epoch') FROM listening_recaps WHERE year = $1`
+
+	var builtAt time.Time
+	err := storage.db.QueryRow(query, year).Scan(&builtAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if builtAt.Unix() == 0 {
+		return time.Time{}, nil
+	}
+
+	return builtAt, nil
+}
+
+func (storage *MusicStorage) Recap(userID int64, year int64) (*proto.RecapResponse, error) {
+	query := `
+		SELECT minutes_listened, listens, longest_streak, to_char(longest_streak_start, 'YYYY-MM-DD'),
+		to_char(most_played_day, 'YYYY-MM-DD'), most_played_day_listens, built_at
+		FROM listening_recaps
+		WHERE user_id = $1 AND year = $2`
+
+	recap := &proto.RecapResponse{Year: year}
+	var builtAt time.Time
+	err := storage.db.QueryRow(query, userID, year).Scan(&recap.MinutesListened, &recap.Listens, &recap.LongestStreak,
+		&recap.LongestStreakStart, &recap.MostPlayedDay, &recap.MostPlayedDayListens, &builtAt)
+	if err != nil {
+		return nil, err
+	}
+	recap.BuiltAt = builtAt.Unix()
+
+	return recap, nil
+}
+
+func (storage *MusicStorage) RecapTopTracks(userID int64, year int64, amount int64) ([]*proto.RecapTrack, error) {
+	query := `SELECT r.position, r.plays, ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit"}, "t") + ", COALESCE(t.number, 0), " +
+		wrapper.Wrapper([]string{"file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") +
+		`, COALESCE(g.name, ''),
+		l.id IS NOT NULL as favorite
+		FROM listening_recap_tops r
+		JOIN tracks t ON t.id = r.entity_id
+		LEFT JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE r.user_id = $1 AND r.year = $2 AND r.entity = $3
+		ORDER BY r.position LIMIT $4`
+
+	rows, err := storage.db.Query(query, userID, year, constants.ChartEntityTracks, amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	tracks := make([]*proto.RecapTrack, 0, amount)
+	for rows.Next() {
+		track := &proto.RecapTrack{}
+		track.Track = &proto.Track{}
+		track.Track.Album = &proto.Album{}
+		track.Track.Artist = &proto.Artist{}
+		if err = rows.Scan(&track.Position, &track.Plays, &track.Track.ID, &track.Track.Title,
+			&track.Track.Explicit, &track.Track.Number, &track.Track.File, &track.Track.ListenCount, &track.Track.Duration,
+			&track.Track.Lossless, &track.Track.HasLyrics, &track.Track.Album.ID, &track.Track.Album.Title, &track.Track.Album.Artwork,
+			&track.Track.Album.ArtworkColor, &track.Track.Artist.ID, &track.Track.Artist.Name, &track.Track.Genre,
+			&track.Track.IsInFavorites); err != nil {
+			return nil, err
+		}
+		track.Track.File = storage.trackFile(track.Track.ID, userID, true)
+		tracks = append(tracks, track)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+func (storage *MusicStorage) RecapTopAlbums(userID int64, year int64, amount int64) ([]*proto.RecapAlbum, error) {
+	query := `SELECT r.position, r.plays, ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") +
+		`
+		FROM listening_recap_tops r
+		JOIN albums alb ON alb.id = r.entity_id
+		JOIN artists art ON art.id = alb.artist
+		WHERE r.user_id = $1 AND r.year = $2 AND r.entity = $3
+		ORDER BY r.position LIMIT $4`
+
+	rows, err := storage.db.Query(query, userID, year, constants.ChartEntityAlbums, amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	albums := make([]*proto.RecapAlbum, 0, amount)
+	for rows.Next() {
+		album := &proto.RecapAlbum{}
+		album.Album = &proto.Album{}
+		if err = rows.Scan(&album.Position, &album.Plays, &album.Album.ID, &album.Album.Title, &album.Album.Year,
+			&album.Album.Artwork, &album.Album.TracksAmount, &album.Album.ArtworkColor, &album.Album.Artist); err != nil {
+			return nil, err
+		}
+		albums = append(albums, album)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return albums, nil
+}
+
+func (storage *MusicStorage) RecapTopArtists(userID int64, year int64, amount int64) ([]*proto.RecapArtist, error) {
+	query := `SELECT r.position, r.plays, ` +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") +
+		`
+		FROM listening_recap_tops r
+		JOIN artists art ON art.id = r.entity_id
+		WHERE r.user_id = $1 AND r.year = $2 AND r.entity = $3
+		ORDER BY r.position LIMIT $4`
+
+	rows, err := storage.db.Query(query, userID, year, constants.ChartEntityArtists, amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	artists := make([]*proto.RecapArtist, 0, amount)
+	for rows.Next() {
+		artist := &proto.RecapArtist{}
+		artist.Artist = &proto.Artist{}
+		if err = rows.Scan(&artist.Position, &artist.Plays, &artist.Artist.ID, &artist.Artist.Name,
+			&artist.Artist.Avatar); err != nil {
+			return nil, err
+		}
+		artists = append(artists, artist)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return artists, nil
+}
+
+func (storage *MusicStorage) RecapTopGenres(userID int64, year int64, amount int64) ([]*proto.RecapGenre, error) {
+	query := `SELECT r.position, r.plays, ` +
+		wrapper.Wrapper([]string{"id", "name", "artwork", "artwork_color"}, "g") +
+		`
+		FROM listening_recap_tops r
+		JOIN genres g ON g.id = r.entity_id
+		WHERE r.user_id = $1 AND r.year = $2 AND r.entity = $3
+		ORDER BY r.position LIMIT $4`
+
+	rows, err := storage.db.Query(query, userID, year, constants.RecapEntityGenres, amount)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = rows.Close()
+		if err != nil {
+			log.Fatal("Error occurred during closing rows")
+		}
+	}()
+
+	genres := make([]*proto.RecapGenre, 0, amount)
+	for rows.Next() {
+		genre := &proto.RecapGenre{}
+		genre.Genre = &proto.Genre{}
+		if err = rows.Scan(&genre.Position, &genre.Plays, &genre.Genre.ID, &genre.Genre.Name, &genre.Genre.Artwork,
+			&genre.Genre.ArtworkColor); err != nil {
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+// Полностью перестраивает поисковый индекс треков и возвращает количество проиндексированных треков
+func (storage *MusicStorage) RebuildSearchIndex() (int64, error) {
+	var indexed int64
+	err := storage.db.QueryRow(`SELECT rebuild_track_search()`).Scan(&indexed)
+	if err != nil {
+		return 0, err
+	}
+
+	return indexed, nil
+}
+
+// Экранирует спецсимволы LIKE, чтобы ввод пользователя искался буквально
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+func pageResponse(page *pagination.Page, total int64, last []interface{}, hasMore bool) *proto.PageResponse {
+	nextCursor, totalHint := page.Next(total, last, hasMore)
+	return &proto.PageResponse{NextCursor: nextCursor, TotalHint: totalHint}
+}
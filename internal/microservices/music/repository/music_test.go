@@ -2,29 +2,50 @@ package repository
 
 import (
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v8"
 	"github.com/jinzhu/copier"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
+	protobuf "google.golang.org/protobuf/proto"
 
 	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/media"
 	"2021_2_LostPointer/internal/microservices/music/proto"
+	"2021_2_LostPointer/pkg/pagination"
 	"2021_2_LostPointer/pkg/wrapper"
-	_ "database/sql"
+	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"log"
-	"os"
 	"regexp"
 	"testing"
+	"time"
 )
 
+var testLinks = newTestLinks()
+
+func newTestLinks() *media.Links {
+	signer, _ := media.NewURLSigner("test:secret", time.Hour)
+	return media.NewLinks(signer, "/static/artists/", "/static/mov/", "/static/playlists/")
+}
+
 //nolint:cyclop
+func selectionIDs(amount int64) []int64 {
+	ids := make([]int64, 0, amount)
+	for id := int64(1); id <= amount; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func TestMusicStorage_RandomTracks(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	const userID = 1
 
@@ -34,7 +55,7 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 		Explicit:    true,
 		Genre:       "testGenre",
 		Number:      2,
-		File:        "testFile",
+		File:        testLinks.TrackStream(1, userID),
 		ListenCount: 3,
 		Duration:    4,
 		Lossless:    true,
@@ -67,23 +88,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			amount:       4,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
 				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(4)).WillReturnRows(rows)
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Tracks {
 				var amount = 4
@@ -100,23 +122,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			amount:       10,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
 				for i := 0; i < 10; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(10)).WillReturnRows(rows)
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(10))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Tracks {
 				var amount = 10
@@ -133,23 +156,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			amount:       100,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
 				for i := 0; i < 100; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(100)).WillReturnRows(rows)
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(100))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Tracks {
 				var amount = 100
@@ -166,23 +190,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			amount:       1,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
 				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(1)).WillReturnError(errors.New("error"))
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(1))).WillReturnError(errors.New("error"))
 			},
 			expected: func() *proto.Tracks {
 				var tracks = new(proto.Tracks)
@@ -198,23 +223,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			isAuthorized: true,
 			mock: func() {
 				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg"})
 				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(1)).WillReturnRows(rows)
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(1))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Tracks {
 				var tracks = new(proto.Tracks)
@@ -228,23 +254,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			name:   "get 4 random tracks unauthorized",
 			amount: 4,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
 				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(4)).WillReturnRows(rows)
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Tracks {
 				var amount = 4
@@ -261,23 +288,24 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 			amount:       4,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}).RowError(0, errors.New("error"))
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}).RowError(0, errors.New("error"))
 				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
 		l.id IS NOT NULL as favorite
-		FROM tracks t
+		FROM unnest($2::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN tracks t ON t.id = selection.id
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		ORDER BY RANDOM() DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(4)).WillReturnRows(rows)
+		ORDER BY selection.position`)).WithArgs(driver.Value(userID), pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Tracks {
 				var amount = 4
@@ -296,7 +324,7 @@ func TestMusicStorage_RandomTracks(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.RandomTracks(currentTest.amount, userID, currentTest.isAuthorized)
+			result, err := repository.RandomTracks(selectionIDs(currentTest.amount), userID, currentTest.isAuthorized)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -314,7 +342,7 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	album := &proto.Album{
 		ID:             1,
@@ -346,13 +374,13 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 					wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 					`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
-		`)).WithArgs(driver.Value(4)).WillReturnRows(rows)
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
+		`)).WithArgs(pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Albums {
 				var amount = 4
@@ -376,13 +404,13 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 					wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 					`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
-		`)).WithArgs(driver.Value(10)).WillReturnRows(rows)
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
+		`)).WithArgs(pq.Array(selectionIDs(10))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Albums {
 				var amount = 10
@@ -406,13 +434,13 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 					wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 					`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
-		`)).WithArgs(driver.Value(100)).WillReturnRows(rows)
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
+		`)).WithArgs(pq.Array(selectionIDs(100))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Albums {
 				var amount = 100
@@ -436,13 +464,13 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 					wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 					`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
-		`)).WithArgs(driver.Value(1)).WillReturnError(errors.New("error"))
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
+		`)).WithArgs(pq.Array(selectionIDs(1))).WillReturnError(errors.New("error"))
 			},
 			expected: func() *proto.Albums {
 				var albums = new(proto.Albums)
@@ -465,13 +493,13 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 					wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 					`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
-		`)).WithArgs(driver.Value(1)).WillReturnRows(rows)
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
+		`)).WithArgs(pq.Array(selectionIDs(1))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Albums {
 				var albums = new(proto.Albums)
@@ -493,13 +521,13 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
 					wrapper.Wrapper([]string{"name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
 					`
-		FROM albums alb
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN albums alb ON alb.id = selection.id
 		JOIN artists art ON art.id = alb.artist
 		JOIN tracks t ON alb.id = t.album
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY RANDOM()
-		LIMIT $1
-		`)).WithArgs(driver.Value(4)).WillReturnRows(rows)
+		GROUP BY selection.position, alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		ORDER BY selection.position
+		`)).WithArgs(pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Albums {
 				var amount = 4
@@ -518,7 +546,7 @@ func TestMusicStorage_RandomAlbums(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.RandomAlbums(currentTest.amount)
+			result, err := repository.RandomAlbums(selectionIDs(currentTest.amount))
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -536,7 +564,7 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	tracks := make([]*proto.Track, 0)
 	albums := make([]*proto.Album, 0)
@@ -567,11 +595,11 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
-	`)).WithArgs(driver.Value(4)).WillReturnRows(rows)
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
+	`)).WithArgs(pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Artists {
 				var amount = 4
@@ -593,11 +621,11 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
-	`)).WithArgs(driver.Value(10)).WillReturnRows(rows)
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
+	`)).WithArgs(pq.Array(selectionIDs(10))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Artists {
 				var amount = 10
@@ -619,11 +647,11 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
-	`)).WithArgs(driver.Value(100)).WillReturnRows(rows)
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
+	`)).WithArgs(pq.Array(selectionIDs(100))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Artists {
 				var amount = 100
@@ -645,11 +673,11 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
-	`)).WithArgs(driver.Value(1)).WillReturnError(errors.New("error"))
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
+	`)).WithArgs(pq.Array(selectionIDs(1))).WillReturnError(errors.New("error"))
 			},
 			expected: func() *proto.Artists {
 				var artists = new(proto.Artists)
@@ -670,11 +698,11 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
-	`)).WithArgs(driver.Value(1)).WillReturnRows(rows)
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
+	`)).WithArgs(pq.Array(selectionIDs(1))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Artists {
 				var artists = new(proto.Artists)
@@ -694,11 +722,11 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`
 		SELECT
-		id, name, avatar
-		FROM artists
-		ORDER BY RANDOM()
-		LIMIT $1
-	`)).WithArgs(driver.Value(4)).WillReturnRows(rows)
+		art.id, art.name, art.avatar
+		FROM unnest($1::integer[]) WITH ORDINALITY AS selection(id, position)
+		JOIN artists art ON art.id = selection.id
+		ORDER BY selection.position
+	`)).WithArgs(pq.Array(selectionIDs(4))).WillReturnRows(rows)
 			},
 			expected: func() *proto.Artists {
 				var amount = 4
@@ -717,7 +745,7 @@ func TestMusicStorage_RandomArtists(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.RandomArtists(currentTest.amount)
+			result, err := repository.RandomArtists(selectionIDs(currentTest.amount))
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -734,27 +762,39 @@ func TestMusicStorage_ArtistInfo(t *testing.T) {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	artist := &proto.Artist{
-		ID:     1,
-		Name:   "testName",
-		Avatar: "testAvatar",
-		Video:  "testVideo",
+		ID:             1,
+		Name:           "testName",
+		Avatar:         "testAvatar",
+		Video:          "testVideo",
+		Bio:            "testBio",
+		AvatarColor:    "testAvatarColor",
+		IsFollowed:     true,
+		FollowersCount: 7,
 	}
 	expectedArtistWithVideo := &proto.Artist{
-		ID:     1,
-		Name:   "testName",
-		Avatar: os.Getenv("ARTISTS_ROOT_PREFIX") + "testAvatar" + constants.ImageExtension,
-		Video:  os.Getenv("MOV_ROOT_PREFIX") + "testVideo" + constants.VideoExtension,
+		ID:             1,
+		Name:           "testName",
+		Avatar:         "/static/artists/testAvatar.webp",
+		Video:          "/static/mov/testVideo.mp4",
+		Bio:            "testBio",
+		AvatarColor:    "testAvatarColor",
+		IsFollowed:     true,
+		FollowersCount: 7,
 	}
 	expectedArtistWithoutVideo := &proto.Artist{
-		ID:     1,
-		Name:   "testName",
-		Avatar: os.Getenv("ARTISTS_ROOT_PREFIX") + "testAvatar" + constants.ImageExtension,
-		Video:  "",
+		ID:             1,
+		Name:           "testName",
+		Avatar:         "/static/artists/testAvatar.webp",
+		Video:          "",
+		Bio:            "testBio",
+		AvatarColor:    "testAvatarColor",
+		IsFollowed:     true,
+		FollowersCount: 7,
 	}
-	var artistID int64 = 1
+	var artistID, userID int64 = 1, 2
 
 	tests := []struct {
 		name          string
@@ -765,13 +805,15 @@ func TestMusicStorage_ArtistInfo(t *testing.T) {
 		{
 			name: "get artist info with video",
 			mock: func() {
-				row := mock.NewRows([]string{"id", "name", "avatar", "video"})
-				row.AddRow(artist.ID, artist.Name, artist.Avatar, artist.Video)
+				row := mock.NewRows([]string{"id", "name", "avatar", "video", "bio", "avatar_color", "followers", "followed"})
+				row.AddRow(artist.ID, artist.Name, artist.Avatar, artist.Video, artist.Bio, artist.AvatarColor, artist.FollowersCount, artist.IsFollowed)
 				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar, video
+		SELECT id, name, avatar, video, COALESCE(bio, ''), COALESCE(avatar_color, ''),
+		(SELECT COUNT(*) FROM artist_follows af WHERE af.artist_id = artists.id) AS followers,
+		EXISTS(SELECT 1 FROM artist_follows af WHERE af.artist_id = artists.id AND af.user_id = $2) AS followed
 		FROM artists
 		WHERE id = $1
-	`)).WillReturnRows(row)
+	`)).WithArgs(driver.Value(artistID), driver.Value(userID)).WillReturnRows(row)
 			},
 			expected: expectedArtistWithVideo,
 		},
@@ -779,26 +821,30 @@ func TestMusicStorage_ArtistInfo(t *testing.T) {
 			name: "get artist info without video",
 			mock: func() {
 				video := ""
-				row := mock.NewRows([]string{"id", "name", "avatar", "video"})
-				row.AddRow(artist.ID, artist.Name, artist.Avatar, video)
+				row := mock.NewRows([]string{"id", "name", "avatar", "video", "bio", "avatar_color", "followers", "followed"})
+				row.AddRow(artist.ID, artist.Name, artist.Avatar, video, artist.Bio, artist.AvatarColor, artist.FollowersCount, artist.IsFollowed)
 				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar, video
+		SELECT id, name, avatar, video, COALESCE(bio, ''), COALESCE(avatar_color, ''),
+		(SELECT COUNT(*) FROM artist_follows af WHERE af.artist_id = artists.id) AS followers,
+		EXISTS(SELECT 1 FROM artist_follows af WHERE af.artist_id = artists.id AND af.user_id = $2) AS followed
 		FROM artists
 		WHERE id = $1
-	`)).WillReturnRows(row)
+	`)).WithArgs(driver.Value(artistID), driver.Value(userID)).WillReturnRows(row)
 			},
 			expected: expectedArtistWithoutVideo,
 		},
 		{
 			name: "query returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"id", "name", "avatar", "video"})
-				row.AddRow(artist.ID, artist.Name, artist.Avatar, artist.Video)
+				row := mock.NewRows([]string{"id", "name", "avatar", "video", "bio", "avatar_color", "followers", "followed"})
+				row.AddRow(artist.ID, artist.Name, artist.Avatar, artist.Video, artist.Bio, artist.AvatarColor, artist.FollowersCount, artist.IsFollowed)
 				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar, video
+		SELECT id, name, avatar, video, COALESCE(bio, ''), COALESCE(avatar_color, ''),
+		(SELECT COUNT(*) FROM artist_follows af WHERE af.artist_id = artists.id) AS followers,
+		EXISTS(SELECT 1 FROM artist_follows af WHERE af.artist_id = artists.id AND af.user_id = $2) AS followed
 		FROM artists
 		WHERE id = $1
-	`)).WillReturnError(errors.New("error"))
+	`)).WithArgs(driver.Value(artistID), driver.Value(userID)).WillReturnError(errors.New("error"))
 			},
 			expected:      expectedArtistWithoutVideo,
 			expectedError: true,
@@ -809,7 +855,7 @@ func TestMusicStorage_ArtistInfo(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.ArtistInfo(artistID)
+			result, err := repository.ArtistInfo(artistID, userID)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -827,7 +873,7 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	const userID = 1
 
@@ -837,7 +883,7 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 		Explicit:    true,
 		Genre:       "testGenre",
 		Number:      2,
-		File:        "testFile",
+		File:        testLinks.TrackStream(1, userID),
 		ListenCount: 3,
 		Duration:    4,
 		Lossless:    true,
@@ -870,12 +916,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			amount:       4,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
 				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -903,12 +949,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			amount:       10,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
 				for i := 0; i < 10; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -936,12 +982,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			amount:       100,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
 				for i := 0; i < 100; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -969,12 +1015,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			amount:       1,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
 				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -1001,12 +1047,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			isAuthorized: true,
 			mock: func() {
 				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites", "newArg"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites", "newArg"})
 				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites, newArg)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites, newArg)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -1031,12 +1077,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			name:   "get 4 random tracks unauthorized",
 			amount: 4,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"})
 				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -1064,12 +1110,12 @@ func TestMusicStorage_ArtistTracks(t *testing.T) {
 			amount:       4,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"}).RowError(1, errors.New("error"))
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "g.name", "favorites"}).RowError(1, errors.New("error"))
 				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Genre, track.IsInFavorites)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
@@ -1117,7 +1163,7 @@ func TestMusicStorage_ArtistAlbums(t *testing.T) {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	album := &proto.Album{
 		ID:             1,
@@ -1320,28 +1366,37 @@ func TestMusicStorage_IncrementListenCount(t *testing.T) {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	var trackID int64 = 1
+	var trackID, userID int64 = 1, 2
+	query := `WITH updated AS (UPDATE tracks SET listen_count = listen_count + 1 WHERE id=$1 RETURNING id)`
 
 	tests := []struct {
 		name          string
 		mock          func()
-		expectedError bool
+		expectedError error
 	}{
 		{
 			name: "increment listen count",
 			mock: func() {
-				row := mock.NewRows([]string{})
-				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE tracks SET listen_count = listen_count + 1 WHERE id=$1`)).WithArgs(driver.Value(trackID)).WillReturnRows(row)
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID), driver.Value(userID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "track does not exist",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID), driver.Value(userID)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
+			expectedError: sql.ErrNoRows,
 		},
 		{
 			name: "query returns error",
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE tracks SET listen_count = listen_count + 1 WHERE id=$1`)).WithArgs(driver.Value(trackID)).WillReturnError(errors.New("error"))
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID), driver.Value(userID)).WillReturnError(errors.New("error"))
 			},
-			expectedError: true,
+			expectedError: errors.New("error"),
 		},
 	}
 
@@ -1349,81 +1404,68 @@ func TestMusicStorage_IncrementListenCount(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			err := repository.IncrementListenCount(trackID)
-			if currentTest.expectedError {
-				assert.Error(t, err)
+			err := repository.IncrementListenCount(trackID, userID)
+			if currentTest.expectedError != nil {
+				assert.Equal(t, currentTest.expectedError, err)
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_AlbumData(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-		return
-	}
-	repository := NewMusicStorage(db)
-
-	album := &proto.AlbumPageResponse{
-		AlbumID:      1,
-		Title:        "testTitle",
-		Year:         2,
-		Artwork:      "testArtwork",
-		ArtworkColor: "testArtWOrkColor",
-		TracksCount:  3,
-		Artist: &proto.Artist{
-			ID:   4,
-			Name: "testArtistName",
-		},
-		TracksDuration: 5,
-	}
+func TestMusicStorage_ReserveListen(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
 
-	var albumID int64 = 1
+	now := time.Date(2021, time.December, 6, 12, 0, 0, 0, time.UTC)
+	member := fmt.Sprintf("%d:%d", now.UnixNano(), 3)
+	window := constants.ListenLimitWindow.Milliseconds()
 
 	tests := []struct {
 		name          string
 		mock          func()
-		expected      *proto.AlbumPageResponse
+		userID        int64
+		ip            string
+		expected      string
 		expectedError bool
 	}{
 		{
-			name: "get album data",
+			name: "listen is reserved",
 			mock: func() {
-				row := mock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "artwork_color", "a.track_count", "art.id", "art.name", "tracksDuration"})
-				row.AddRow(album.AlbumID, album.Title, album.Year, album.Artwork, album.ArtworkColor, album.TracksCount, album.Artist.ID, album.Artist.Name, album.TracksDuration)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ` +
-					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color", "track_count"}, "alb") + ", " +
-					wrapper.Wrapper([]string{"id", "name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
-					`
-		FROM albums alb
-		JOIN artists art ON alb.artist = art.id
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.id = $1
-		GROUP BY alb.id, art.id
-		`)).WithArgs(driver.Value(albumID)).WillReturnRows(row)
+				mock.ExpectEvalSha(listenLimitScript.Hash(), []string{"listens:user:1", "listens:ip:10.0.0.1"},
+					now.UnixMilli(), window, member, constants.ListenUserLimit, constants.ListenIPLimit).SetVal(int64(0))
 			},
-			expected: album,
+			userID: 1,
+			ip:     "10.0.0.1",
 		},
 		{
-			name: "query returns error",
+			name: "ip limit is exceeded",
 			mock: func() {
-				row := mock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "artwork_color", "a.track_count", "art.id", "art.name", "tracksDuration"})
-				row.AddRow(album.AlbumID, album.Title, album.Year, album.Artwork, album.ArtworkColor, album.TracksCount, album.Artist.ID, album.Artist.Name, album.TracksDuration)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ` +
-					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color", "track_count"}, "alb") + ", " +
-					wrapper.Wrapper([]string{"id", "name"}, "art") + ", SUM(t.duration) AS tracksDuration" +
-					`
-		FROM albums alb
-		JOIN artists art ON alb.artist = art.id
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.id = $1
-		GROUP BY alb.id, art.id
-		`)).WithArgs(driver.Value(albumID)).WillReturnError(errors.New("error"))
+				mock.ExpectEvalSha(listenLimitScript.Hash(), []string{"listens:user:1", "listens:ip:10.0.0.1"},
+					now.UnixMilli(), window, member, constants.ListenUserLimit, constants.ListenIPLimit).SetVal(int64(2))
 			},
-			expected:      album,
+			userID:   1,
+			ip:       "10.0.0.1",
+			expected: constants.ListenRejectIPLimit,
+		},
+		{
+			name: "anonymous listener is limited by ip",
+			mock: func() {
+				mock.ExpectEvalSha(listenLimitScript.Hash(), []string{"listens:ip:10.0.0.1"},
+					now.UnixMilli(), window, member, constants.ListenIPLimit).SetVal(int64(1))
+			},
+			ip:       "10.0.0.1",
+			expected: constants.ListenRejectIPLimit,
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectEvalSha(listenLimitScript.Hash(), []string{"listens:user:1"},
+					now.UnixMilli(), window, member, constants.ListenUserLimit).SetErr(errors.New("error"))
+			},
+			userID:        1,
 			expectedError: true,
 		},
 	}
@@ -1432,209 +1474,68 @@ func TestMusicStorage_AlbumData(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.AlbumData(albumID)
+			result, err := repository.ReserveListen(currentTest.userID, currentTest.ip, 3, now)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
-				assert.Equal(t, currentTest.expected, result)
 				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_AlbumTracks(t *testing.T) {
+func TestMusicStorage_RecordListenRejection(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	const userID = 1
+	query := `INSERT INTO listen_rejections(user_id, ip, track_id, reason) VALUES (NULLIF($1, 0), $2, $3, $4)`
 
-	track := &proto.AlbumTrack{
-		ID:            1,
-		Title:         "testTrackTitle",
-		Explicit:      true,
-		Genre:         "testGenre",
-		Number:        2,
-		File:          "testFile",
-		ListenCount:   3,
-		Duration:      4,
-		Lossless:      true,
-		IsInFavorites: true,
+	mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(1, "10.0.0.1", 3, constants.ListenRejectIPLimit).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repository.RecordListenRejection(1, "10.0.0.1", 3, constants.ListenRejectIPLimit))
+
+	mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+	assert.Error(t, repository.RecordListenRejection(1, "10.0.0.1", 3, constants.ListenRejectIPLimit))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMusicStorage_TrackFile(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
 	}
-	trackWithoutFile := new(proto.AlbumTrack)
-	_ = copier.Copy(trackWithoutFile, track)
-	trackWithoutFile.File = ""
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	var albumID int64 = 1
+	var trackID int64 = 1
+	query := `SELECT file, lossless FROM tracks WHERE id = $1`
 
 	tests := []struct {
 		name          string
-		amount        int64
-		isAuthorized  bool
 		mock          func()
-		expected      []*proto.AlbumTrack
+		expected      *proto.TrackFileResponse
 		expectedError bool
 	}{
 		{
-			name:         "get tracks by album",
-			amount:       4,
-			isAuthorized: true,
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "g.name", "favorite"})
-				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE alb.id = $2
-		ORDER BY t.number
-		`)).WithArgs(driver.Value(userID), driver.Value(albumID)).WillReturnRows(rows)
-			},
-			expected: func() []*proto.AlbumTrack {
-				var amount = 4
-				tracks := make([]*proto.AlbumTrack, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
-		},
-		{
-			name:         "query returns error",
-			amount:       1,
-			isAuthorized: true,
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "g.name", "favorite"})
-				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE alb.id = $2
-		ORDER BY t.number
-		`)).WithArgs(driver.Value(userID), driver.Value(albumID)).WillReturnError(errors.New("error"))
-			},
-			expected: func() []*proto.AlbumTrack {
-				tracks := make([]*proto.AlbumTrack, 0, 1)
-				tracks = append(tracks, track)
-				return tracks
-			}(),
-			expectedError: true,
-		},
-		{
-			name:         "scan returns error",
-			amount:       1,
-			isAuthorized: true,
-			mock: func() {
-				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "g.name", "favorite", "newArg"})
-				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Genre, track.IsInFavorites, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE alb.id = $2
-		ORDER BY t.number
-		`)).WithArgs(driver.Value(userID), driver.Value(albumID)).WillReturnRows(rows)
-			},
-			expected: func() []*proto.AlbumTrack {
-				tracks := make([]*proto.AlbumTrack, 0, 1)
-				tracks = append(tracks, track)
-				return tracks
-			}(),
-			expectedError: true,
-		},
-		{
-			name:   "get tracks by album unauthorized",
-			amount: 4,
+			name: "get track file",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "g.name", "favorite"})
-				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE alb.id = $2
-		ORDER BY t.number
-		`)).WithArgs(driver.Value(userID), driver.Value(albumID)).WillReturnRows(rows)
+				row := mock.NewRows([]string{"file", "lossless"})
+				row.AddRow("testFile", true)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID)).WillReturnRows(row)
 			},
-			expected: func() []*proto.AlbumTrack {
-				var amount = 4
-				tracks := make([]*proto.AlbumTrack, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, trackWithoutFile)
-				}
-				return tracks
-			}(),
+			expected: &proto.TrackFileResponse{File: "testFile", Lossless: true},
 		},
 		{
-			name:         "rows.Err() returns error",
-			amount:       4,
-			isAuthorized: true,
+			name: "track doesn't exist",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "g.name", "favorite"}).RowError(1, errors.New("error"))
-				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE alb.id = $2
-		ORDER BY t.number
-		`)).WithArgs(driver.Value(userID), driver.Value(albumID)).WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID)).WillReturnError(sql.ErrNoRows)
 			},
-			expected: func() []*proto.AlbumTrack {
-				var amount = 4
-				tracks := make([]*proto.AlbumTrack, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
 			expectedError: true,
 		},
 	}
@@ -1643,7 +1544,7 @@ func TestMusicStorage_AlbumTracks(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.AlbumTracks(albumID, userID, currentTest.isAuthorized)
+			result, err := repository.TrackFile(trackID)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -1654,16 +1555,16 @@ func TestMusicStorage_AlbumTracks(t *testing.T) {
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_FindTracksByFullWord(t *testing.T) {
+func TestMusicStorage_TracksByIDs(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	const userID = 1
+	trackIDs := []int64{1, 2}
 
 	track := &proto.Track{
 		ID:          1,
@@ -1671,65 +1572,318 @@ func TestMusicStorage_FindTracksByFullWord(t *testing.T) {
 		Explicit:    true,
 		Genre:       "testGenre",
 		Number:      2,
-		File:        "testFile",
+		File:        testLinks.TrackStream(1, userID),
 		ListenCount: 3,
 		Duration:    4,
 		Lossless:    true,
+		HasLyrics:   true,
 		Album: &proto.Album{
 			ID:           5,
 			Title:        "testAlbumTitle",
+			Year:         2021,
 			Artwork:      "testAlbumArtwork",
-			ArtworkColor: "testArtWorkColor",
+			ArtworkColor: "testArtWOrkColor",
 		},
 		Artist: &proto.Artist{
-			ID:   6,
-			Name: "testArtistName",
+			ID:     6,
+			Name:   "testArtistName",
+			Avatar: "testArtistAvatar",
 		},
 		IsInFavorites: true,
+		PlaylistIDs:   []int64{3, 7},
 	}
-	trackWithoutFile := new(proto.Track)
-	_ = copier.Copy(trackWithoutFile, track)
-	trackWithoutFile.File = ""
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite,
+		ARRAY(SELECT DISTINCT pt.playlist FROM playlist_tracks pt JOIN playlists p ON p.id = pt.playlist
+			WHERE pt.track = t.id AND p.user_id = $1 ORDER BY pt.playlist) AS playlists
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE t.id = ANY($2)`
+	columns := []string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics",
+		"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "art.avatar", "g.name", "favorite", "playlists"}
 
-	const text = "testText"
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Track
+		expectedError bool
+	}{
+		{
+			name: "tracks found with one query",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount,
+					track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Year, track.Album.Artwork,
+					track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Artist.Avatar, track.Genre, track.IsInFavorites, "{3,7}")
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value("{1,2}")).WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value("{1,2}")).
+					WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id"}).AddRow(track.ID)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value("{1,2}")).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.TracksByIDs(trackIDs, userID, true)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_Lyrics(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	var trackID int64 = 1
+	query := `SELECT plain, lines FROM lyrics WHERE track_id = $1`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.Lyrics
+		expectedError bool
+	}{
+		{
+			name: "synced lyrics",
+			mock: func() {
+				row := mock.NewRows([]string{"plain", "lines"})
+				row.AddRow("First\nSecond", []byte(`[{"time":1500,"text":"First"},{"time":4000,"text":"Second"}]`))
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID)).WillReturnRows(row)
+			},
+			expected: &proto.Lyrics{
+				TrackID: trackID,
+				Plain:   "First\nSecond",
+				Synced:  true,
+				Lines:   []*proto.LyricsLine{{Time: 1500, Text: "First"}, {Time: 4000, Text: "Second"}},
+			},
+		},
+		{
+			name: "plain lyrics",
+			mock: func() {
+				row := mock.NewRows([]string{"plain", "lines"})
+				row.AddRow("First\nSecond", nil)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID)).WillReturnRows(row)
+			},
+			expected: &proto.Lyrics{TrackID: trackID, Plain: "First\nSecond"},
+		},
+		{
+			name: "broken lines",
+			mock: func() {
+				row := mock.NewRows([]string{"plain", "lines"})
+				row.AddRow("First", []byte(`{`))
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID)).WillReturnRows(row)
+			},
+			expectedError: true,
+		},
+		{
+			name: "track has no lyrics",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(trackID)).WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.Lyrics(trackID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_AlbumData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	album := &proto.AlbumPageResponse{
+		AlbumID:      1,
+		Title:        "testTitle",
+		Year:         2,
+		Artwork:      "testArtwork",
+		ArtworkColor: "testArtWOrkColor",
+		TracksCount:  3,
+		Artist: &proto.Artist{
+			ID:   4,
+			Name: "testArtistName",
+		},
+		TracksDuration: 5,
+		IsSaved:        true,
+		ReleaseDate:    "2021-03-15",
+	}
+
+	var albumID, userID int64 = 1, 2
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.AlbumPageResponse
+		expectedError bool
+	}{
+		{
+			name: "get album data",
+			mock: func() {
+				row := mock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "artwork_color", "a.track_count", "art.id", "art.name", "tracksDuration", "saved", "release_date"})
+				row.AddRow(album.AlbumID, album.Title, album.Year, album.Artwork, album.ArtworkColor, album.TracksCount, album.Artist.ID, album.Artist.Name, album.TracksDuration, album.IsSaved, album.ReleaseDate)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color", "track_count"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", SUM(t.duration) AS tracksDuration"+
+					`, EXISTS(SELECT 1 FROM saved_albums sa WHERE sa.album_id = alb.id AND sa.user_id = $2) AS saved,
+		to_char(alb.release_date, 'YYYY-MM-DD') AS release_date
+		FROM albums alb
+		JOIN artists art ON alb.artist = art.id
+		JOIN tracks t ON alb.id = t.album
+		WHERE alb.id = $1
+		GROUP BY alb.id, art.id
+		`)).WithArgs(driver.Value(albumID), driver.Value(userID)).WillReturnRows(row)
+			},
+			expected: album,
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				row := mock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "artwork_color", "a.track_count", "art.id", "art.name", "tracksDuration", "saved", "release_date"})
+				row.AddRow(album.AlbumID, album.Title, album.Year, album.Artwork, album.ArtworkColor, album.TracksCount, album.Artist.ID, album.Artist.Name, album.TracksDuration, album.IsSaved, album.ReleaseDate)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "artwork_color", "track_count"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", SUM(t.duration) AS tracksDuration"+
+					`, EXISTS(SELECT 1 FROM saved_albums sa WHERE sa.album_id = alb.id AND sa.user_id = $2) AS saved,
+		to_char(alb.release_date, 'YYYY-MM-DD') AS release_date
+		FROM albums alb
+		JOIN artists art ON alb.artist = art.id
+		JOIN tracks t ON alb.id = t.album
+		WHERE alb.id = $1
+		GROUP BY alb.id, art.id
+		`)).WithArgs(driver.Value(albumID), driver.Value(userID)).WillReturnError(errors.New("error"))
+			},
+			expected:      album,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.AlbumData(albumID, userID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.Equal(t, currentTest.expected, result)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+//nolint:cyclop
+func TestMusicStorage_AlbumTracks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+	page := &proto.PageRequest{Limit: 10}
+
+	const userID = 1
+
+	track := &proto.AlbumTrack{
+		ID:            1,
+		Title:         "testTrackTitle",
+		Explicit:      true,
+		Genre:         "testGenre",
+		Number:        2,
+		File:          testLinks.TrackStream(1, userID),
+		ListenCount:   3,
+		Duration:      4,
+		Lossless:      true,
+		IsInFavorites: true,
+	}
+	trackWithoutFile := new(proto.AlbumTrack)
+	_ = copier.Copy(trackWithoutFile, track)
+	trackWithoutFile.File = ""
+
+	var albumID int64 = 1
 
 	tests := []struct {
 		name          string
+		amount        int64
 		isAuthorized  bool
 		mock          func()
-		expected      []*proto.Track
+		expected      []*proto.AlbumTrack
 		expectedError bool
 	}{
 		{
-			name:         "find constants.SearchTracksAmount tracks with text",
+			name:         "get tracks by album",
+			amount:       4,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "g.name", "favorite", "total"})
+				for i := 0; i < 4; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Genre, track.IsInFavorites, 10)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-		    FROM search
-		    WHERE concatenation @@ plainto_tsquery($2)
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(text), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+		WHERE alb.id = $2 AND TRUE
+		ORDER BY COALESCE(t.number, 0), t.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(albumID), driver.Value(page.Limit+1)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
+			expected: func() []*proto.AlbumTrack {
+				var amount = 4
+				tracks := make([]*proto.AlbumTrack, 0, amount)
+				for i := 0; i < amount; i++ {
 					tracks = append(tracks, track)
 				}
 				return tracks
@@ -1737,106 +1891,88 @@ func TestMusicStorage_FindTracksByFullWord(t *testing.T) {
 		},
 		{
 			name:         "query returns error",
+			amount:       1,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "g.name", "favorite", "total"})
+				for i := 0; i < 1; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Genre, track.IsInFavorites, 10)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-		    FROM search
-		    WHERE concatenation @@ plainto_tsquery($2)
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(text), driver.Value(constants.SearchTracksAmount)).WillReturnError(errors.New("error"))
+		WHERE alb.id = $2 AND TRUE
+		ORDER BY COALESCE(t.number, 0), t.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(albumID), driver.Value(page.Limit+1)).WillReturnError(errors.New("error"))
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
+			expected: func() []*proto.AlbumTrack {
+				tracks := make([]*proto.AlbumTrack, 0, 1)
+				tracks = append(tracks, track)
 				return tracks
 			}(),
 			expectedError: true,
 		},
 		{
 			name:         "scan returns error",
+			amount:       1,
 			isAuthorized: true,
 			mock: func() {
 				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg)
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "g.name", "favorite", "newArg", "total"})
+				for i := 0; i < 1; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Genre, track.IsInFavorites, newArg, 10)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-		    FROM search
-		    WHERE concatenation @@ plainto_tsquery($2)
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(text), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+		WHERE alb.id = $2 AND TRUE
+		ORDER BY COALESCE(t.number, 0), t.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(albumID), driver.Value(page.Limit+1)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
+			expected: func() []*proto.AlbumTrack {
+				tracks := make([]*proto.AlbumTrack, 0, 1)
+				tracks = append(tracks, track)
 				return tracks
 			}(),
 			expectedError: true,
 		},
 		{
-			name: "find tracks unauthorized",
+			name:   "get tracks by album unauthorized",
+			amount: 4,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "g.name", "favorite", "total"})
+				for i := 0; i < 4; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Genre, track.IsInFavorites, 10)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-		    FROM search
-		    WHERE concatenation @@ plainto_tsquery($2)
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(text), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+		WHERE alb.id = $2 AND TRUE
+		ORDER BY COALESCE(t.number, 0), t.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(albumID), driver.Value(page.Limit+1)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
+			expected: func() []*proto.AlbumTrack {
+				var amount = 4
+				tracks := make([]*proto.AlbumTrack, 0, amount)
+				for i := 0; i < amount; i++ {
 					tracks = append(tracks, trackWithoutFile)
 				}
 				return tracks
@@ -1844,34 +1980,30 @@ func TestMusicStorage_FindTracksByFullWord(t *testing.T) {
 		},
 		{
 			name:         "rows.Err() returns error",
+			amount:       4,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}).RowError(1, errors.New("error"))
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "g.name", "favorite", "total"}).RowError(1, errors.New("error"))
+				for i := 0; i < 4; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Genre, track.IsInFavorites, 10)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
 					wrapper.Wrapper([]string{"name"}, "g")+", "+
 					`
-		l.id IS NOT NULL as favorite
+		l.id IS NOT NULL as favorite, COUNT(*) OVER () AS total
 		FROM tracks t
 		JOIN genres g ON t.genre = g.id
 		JOIN albums alb ON t.album = alb.id
 		JOIN artists art ON t.artist = art.id
 		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-		    FROM search
-		    WHERE concatenation @@ plainto_tsquery($2)
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(text), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+		WHERE alb.id = $2 AND TRUE
+		ORDER BY COALESCE(t.number, 0), t.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(albumID), driver.Value(page.Limit+1)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
+			expected: func() []*proto.AlbumTrack {
+				var amount = 4
+				tracks := make([]*proto.AlbumTrack, 0, amount)
+				for i := 0; i < amount; i++ {
 					tracks = append(tracks, track)
 				}
 				return tracks
@@ -1884,7 +2016,7 @@ func TestMusicStorage_FindTracksByFullWord(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.FindTracksByFullWord(text, userID, currentTest.isAuthorized)
+			result, _, err := repository.AlbumTracks(albumID, userID, currentTest.isAuthorized, page)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -1895,228 +2027,3140 @@ func TestMusicStorage_FindTracksByFullWord(t *testing.T) {
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_FindTracksByPartial(t *testing.T) {
+func TestMusicStorage_SearchTracks(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
-
-	const userID = 1
+	repository := NewMusicStorage(db, nil, testLinks)
 
+	const (
+		text   = "testText"
+		userID = 1
+	)
+	page := &proto.PageRequest{Limit: 2}
 	track := &proto.Track{
 		ID:          1,
 		Title:       "testTrackTitle",
 		Explicit:    true,
 		Genre:       "testGenre",
 		Number:      2,
-		File:        "testFile",
+		File:        testLinks.TrackStream(1, userID),
 		ListenCount: 3,
 		Duration:    4,
 		Lossless:    true,
 		Album: &proto.Album{
-			ID:      5,
-			Title:   "testAlbumTitle",
-			Artwork: "testAlbumArtwork",
+			ID:           5,
+			Title:        "testAlbumTitle",
+			Artwork:      "testAlbumArtwork",
+			ArtworkColor: "testArtworkColor",
 		},
 		Artist: &proto.Artist{
 			ID:   6,
 			Name: "testArtistName",
 		},
-		IsInFavorites: true,
 	}
-	trackWithoutFile := new(proto.Track)
-	_ = copier.Copy(trackWithoutFile, track)
-	trackWithoutFile.File = ""
-
-	const text = "testText"
-	textArgument := "%" + text + "%"
+	columns := []string{"t.id", "t.title", "t.explicit", "t.number", "t.file", "t.listen_count", "t.duration", "t.lossless", "t.has_lyrics",
+		"alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "score", "total"}
+	addRows := func(rows *sqlmock.Rows, amount int) {
+		for i := 0; i < amount; i++ {
+			rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration,
+				track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor,
+				track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 0.75, 3)
+		}
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t") + ", " +
+		wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
+		wrapper.Wrapper([]string{"name"}, "g") + ", " +
+		`
+		l.id IS NOT NULL as favorite, ` + searchTrackScore + ` AS score, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN track_search ts ON ts.track_id = t.id
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $2
+		WHERE ` + searchTrackMatch + ` AND TRUE
+		ORDER BY ` + searchTrackScore + ` DESC, t.id LIMIT $3`
+	lossless := true
+	filter := &proto.SearchFilter{Artist: "testArtistName", YearFrom: 2010, YearTo: 2015, Lossless: &lossless}
+	filteredQuery := `WHERE ` + searchTrackMatch + ` AND lower(art.name) = lower($3) AND alb.year >= $4 AND alb.year <= $5 AND
+		t.lossless = $6 AND TRUE
+		ORDER BY ` + searchTrackScore + ` DESC, t.id LIMIT $7`
 
 	tests := []struct {
 		name          string
+		filter        *proto.SearchFilter
 		isAuthorized  bool
 		mock          func()
 		expected      []*proto.Track
+		expectedPage  *proto.PageResponse
 		expectedError bool
 	}{
 		{
-			name:         "find constants.SearchTracksAmount tracks with text",
+			name:         "ranked tracks, has more",
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-		    SELECT track
-		    FROM test
-		    WHERE concat ILIKE $2
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(textArgument), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 3)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(driver.Value(text), driver.Value(userID),
+						driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track, track},
+			expectedPage: &proto.PageResponse{
+				NextCursor: pagination.Encode(&pagination.Cursor{Values: []interface{}{0.75, track.ID}, Total: 3}),
+				TotalHint:  3,
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
 		},
 		{
-			name:         "query returns error",
+			name:         "filtered tracks",
+			filter:       filter,
 			isAuthorized: true,
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-		    SELECT track
-		    FROM test
-		    WHERE concat ILIKE $2
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(textArgument), driver.Value(constants.SearchTracksAmount)).WillReturnError(errors.New("error"))
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(filteredQuery)).
+					WithArgs(driver.Value(text), driver.Value(userID),
+						driver.Value(filter.Artist), driver.Value(filter.YearFrom), driver.Value(filter.YearTo),
+						driver.Value(lossless), driver.Value(page.Limit+1)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
-			expectedError: true,
+			expected:     []*proto.Track{track},
+			expectedPage: &proto.PageResponse{TotalHint: 3},
 		},
 		{
-			name:         "scan returns error",
+			name:         "tracks filtered by lyrics",
+			filter:       &proto.SearchFilter{Lyrics: "neon lights"},
 			isAuthorized: true,
 			mock: func() {
-				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-		    SELECT track
-		    FROM test
-		    WHERE concat ILIKE $2
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(textArgument), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE `+searchTrackMatch+` AND EXISTS(SELECT 1 FROM lyrics ly WHERE ly.track_id = t.id AND
+			to_tsvector('simple', ly.plain) @@ plainto_tsquery('simple', $3)) AND TRUE`)).
+					WithArgs(driver.Value(text), driver.Value(userID),
+						driver.Value("neon lights"), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected:     []*proto.Track{track},
+			expectedPage: &proto.PageResponse{TotalHint: 3},
+		},
+		{
+			name: "unauthorized user gets no files",
+			mock: func() {
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
 			},
 			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
+				unauthorizedTrack := &proto.Track{}
+				_ = copier.Copy(unauthorizedTrack, track)
+				unauthorizedTrack.File = ""
+				return []*proto.Track{unauthorizedTrack}
 			}(),
+			expectedPage: &proto.PageResponse{TotalHint: 3},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
 			expectedError: true,
 		},
 		{
-			name: "find tracks unauthorized",
+			name: "scan returns error",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-		    SELECT track
-		    FROM test
-		    WHERE concat ILIKE $2
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(textArgument), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"t.id"}).AddRow(1)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, trackWithoutFile)
-				}
-				return tracks
-			}(),
+			expectedError: true,
 		},
 		{
-			name:         "rows.Err() returns error",
-			isAuthorized: true,
+			name: "rows.Err() returns error",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}).RowError(1, errors.New("error"))
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				rows := sqlmock.NewRows(columns).RowError(0, errors.New("error"))
+				addRows(rows, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, resultPage, err := repository.SearchTracks(text, currentTest.filter, userID, currentTest.isAuthorized, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+				assert.Equal(t, currentTest.expectedPage, resultPage)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_SearchArtists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const text = "testText"
+	page := &proto.PageRequest{Limit: 4}
+	artist := &proto.Artist{
+		ID:     1,
+		Name:   "testName",
+		Avatar: "testAvatar",
+		Tracks: []*proto.Track{},
+		Albums: []*proto.Album{},
+	}
+	columns := []string{"art.id", "art.name", "art.avatar", "score", "total"}
+	cursor := pagination.Encode(&pagination.Cursor{Values: []interface{}{0.5, int64(3)}, Total: 10})
+
+	tests := []struct {
+		name          string
+		page          *proto.PageRequest
+		mock          func()
+		expected      []*proto.Artist
+		expectedPage  *proto.PageResponse
+		expectedError bool
+	}{
+		{
+			name: "first page",
+			page: page,
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(artist.ID, artist.Name, artist.Avatar, 1.2, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT art.id, art.name, art.avatar, `+searchArtistScore+` AS score, COUNT(*) OVER () AS total
+		FROM artists art
+		WHERE `+searchArtistMatch+` AND TRUE
+		ORDER BY `+searchArtistScore+` DESC, art.id LIMIT $2`)).
+					WithArgs(driver.Value(text), driver.Value(page.Limit+1)).
+					WillReturnRows(rows)
+			},
+			expected:     []*proto.Artist{artist},
+			expectedPage: &proto.PageResponse{TotalHint: 1},
+		},
+		{
+			name: "next page by cursor",
+			page: &proto.PageRequest{Limit: 4, Cursor: cursor},
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(artist.ID, artist.Name, artist.Avatar, 0.4, 7)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE `+searchArtistMatch+` AND (`+searchArtistScore+` < $2 OR (`+
+					searchArtistScore+` = $2 AND art.id > $3))`)).
+					WithArgs(driver.Value(text), driver.Value(0.5),
+						driver.Value(3), driver.Value(page.Limit+1)).
+					WillReturnRows(rows)
+			},
+			expected:     []*proto.Artist{artist},
+			expectedPage: &proto.PageResponse{TotalHint: 10},
+		},
+		{
+			name: "query returns error",
+			page: page,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM artists art`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			page: page,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"art.id"}).AddRow(1)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM artists art`)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+		{
+			name:          "invalid cursor",
+			page:          &proto.PageRequest{Limit: 4, Cursor: "qwe"},
+			mock:          func() {},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, resultPage, err := repository.SearchArtists(text, currentTest.page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+				assert.Equal(t, currentTest.expectedPage, resultPage)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_SearchAlbums(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const text = "testText"
+	page := &proto.PageRequest{Limit: 3}
+	album := &proto.Album{
+		ID:             1,
+		Title:          "testTitle",
+		Year:           2021,
+		Artist:         "testArtist",
+		Artwork:        "testArtwork",
+		ArtworkColor:   "testArtworkColor",
+		TracksAmount:   5,
+		TracksDuration: 300,
+	}
+	columns := []string{"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.track_count", "alb.artwork_color", "art.name",
+		"tracksDuration", "score", "total"}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Album
+		expectedError bool
+	}{
+		{
+			name: "ranked albums",
+			mock: func() {
+				rows := sqlmock.NewRows(columns)
+				for i := 0; i < 2; i++ {
+					rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor,
+						album.Artist, album.TracksDuration, 0.9, 2)
 				}
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-		    SELECT track
-		    FROM test
-		    WHERE concat ILIKE $2
-		)
-		ORDER BY t.listen_count DESC LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(textArgument), driver.Value(constants.SearchTracksAmount)).WillReturnRows(rows)
+					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"name"}, "art")+", SUM(t.duration) AS tracksDuration"+
+					`, `+searchAlbumScore+` AS score, COUNT(*) OVER () AS total
+		FROM albums alb
+		JOIN artists art ON art.id = alb.artist
+		JOIN tracks t ON alb.id = t.album
+		WHERE `+searchAlbumMatch+`
+		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
+		HAVING TRUE
+		ORDER BY `+searchAlbumScore+` DESC, alb.id LIMIT $2`)).
+					WithArgs(driver.Value(text), driver.Value(page.Limit+1)).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.Album{album, album},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM albums alb`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "rows.Err() returns error",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).RowError(0, errors.New("error"))
+				rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor,
+					album.Artist, album.TracksDuration, 0.9, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM albums alb`)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, _, err := repository.SearchAlbums(text, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_SearchPlaylists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		text   = "testText"
+		userID = 1
+	)
+	page := &proto.PageRequest{Limit: 5}
+	playlist := &proto.PlaylistData{
+		PlaylistID: 1,
+		Title:      "testTitle",
+		Artwork:    "/static/playlists/testArtwork_100px.webp",
+		IsPublic:   true,
+	}
+	columns := []string{"id", "title", "artwork", "is_public", "is_own", "score", "total"}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.PlaylistData
+		expectedError bool
+	}{
+		{
+			name: "public and own playlists",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(playlist.PlaylistID, playlist.Title, "testArtwork", playlist.IsPublic,
+					playlist.IsOwn, 0.6, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$2 AS is_own, `+
+					searchPlaylistScore+` AS score, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$2 OR is_public=true) AND `+searchPlaylistMatch+` AND TRUE
+		ORDER BY `+searchPlaylistScore+` DESC, id LIMIT $3`)).
+					WithArgs(driver.Value(text), driver.Value(userID), driver.Value(page.Limit+1)).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.PlaylistData{playlist},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM playlists`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM playlists`)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, _, err := repository.SearchPlaylists(text, userID, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_Suggest(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID = 1
+		amount = 8
+	)
+	columns := []string{"type", "id", "title", "subtitle", "artwork"}
+
+	tests := []struct {
+		name          string
+		prefix        string
+		mock          func()
+		expected      []*proto.Suggestion
+		expectedError bool
+	}{
+		{
+			name:   "mixed suggestions",
+			prefix: "La",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(constants.SuggestionTypeTrack, 1, "Lahaine", "testArtist", "testArtwork").
+					AddRow(constants.SuggestionTypePlaylist, 2, "La playlist", "", "testPlaylistArtwork")
+				mock.ExpectQuery(regexp.QuoteMeta(`) suggestions
+		ORDER BY score DESC, type, id
+		LIMIT $4`)).WithArgs(driver.Value("la%"), driver.Value("% la%"), driver.Value(userID), driver.Value(amount)).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.Suggestion{
+				{Type: constants.SuggestionTypeTrack, ID: 1, Title: "Lahaine", Subtitle: "testArtist", Artwork: "testArtwork"},
+				{
+					Type:    constants.SuggestionTypePlaylist,
+					ID:      2,
+					Title:   "La playlist",
+					Artwork: testLinks.PlaylistArtwork("testPlaylistArtwork", constants.PlaylistArtworkExtension100px, false, userID),
+				},
+			},
+		},
+		{
+			name:   "listened track ranks above unrelated match",
+			prefix: "La",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(constants.SuggestionTypeTrack, 3, "La vie", "listenedArtist", "testArtwork").
+					AddRow(constants.SuggestionTypeTrack, 1, "Lahaine", "testArtist", "testArtwork")
+				mock.ExpectQuery(regexp.QuoteMeta(`CASE WHEN l.id IS NOT NULL THEN 5
+					WHEN EXISTS(SELECT 1 FROM listens ls WHERE ls.user_id = $3 AND ls.track_id = t.id) THEN 3 ELSE 0 END AS score`)).
+					WithArgs(driver.Value("la%"), driver.Value("% la%"), driver.Value(userID), driver.Value(amount)).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.Suggestion{
+				{Type: constants.SuggestionTypeTrack, ID: 3, Title: "La vie", Subtitle: "listenedArtist", Artwork: "testArtwork"},
+				{Type: constants.SuggestionTypeTrack, ID: 1, Title: "Lahaine", Subtitle: "testArtist", Artwork: "testArtwork"},
+			},
+		},
+		{
+			name:   "like wildcards are escaped",
+			prefix: "100%_",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`) suggestions`)).
+					WithArgs(driver.Value(`100\%\_%`), driver.Value(`% 100\%\_%`), driver.Value(userID), driver.Value(amount)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expected: []*proto.Suggestion{},
+		},
+		{
+			name:   "query returns error",
+			prefix: "La",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`) suggestions`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name:   "scan returns error",
+			prefix: "La",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"type"}).AddRow(constants.SuggestionTypeTrack)
+				mock.ExpectQuery(regexp.QuoteMeta(`) suggestions`)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.Suggest(currentTest.prefix, userID, amount)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_CachedSuggestions(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
+
+	const key = "suggestions:1:8:la"
+	suggestions := []*proto.Suggestion{{Type: constants.SuggestionTypeArtist, ID: 1, Title: "Lahaine"}}
+	data, _ := protobuf.Marshal(&proto.Suggestions{Suggestions: suggestions})
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Suggestion
+		expectedFound bool
+		expectedError bool
+	}{
+		{
+			name: "cache hit",
+			mock: func() {
+				mock.ExpectGet(key).SetVal(string(data))
+			},
+			expected:      suggestions,
+			expectedFound: true,
+		},
+		{
+			name: "cache miss",
+			mock: func() {
+				mock.ExpectGet(key).RedisNil()
+			},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectGet(key).SetErr(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "broken cache entry",
+			mock: func() {
+				mock.ExpectGet(key).SetVal("qwe")
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, found, err := repository.CachedSuggestions(key)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedFound, found)
+				assert.Equal(t, len(currentTest.expected), len(result))
+				for i := range currentTest.expected {
+					assert.True(t, protobuf.Equal(currentTest.expected[i], result[i]))
+				}
+			}
+		})
+	}
+}
+
+func TestMusicStorage_CacheSuggestions(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
+
+	const key = "suggestions:-1:8:la"
+	suggestions := []*proto.Suggestion{{Type: constants.SuggestionTypeAlbum, ID: 1, Title: "Lahaine"}}
+	data, _ := protobuf.Marshal(&proto.Suggestions{Suggestions: suggestions})
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "stored",
+			mock: func() {
+				mock.ExpectSet(key, data, constants.SuggestionsCacheLifetime).SetVal("OK")
+			},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectSet(key, data, constants.SuggestionsCacheLifetime).SetErr(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.CacheSuggestions(key, suggestions, constants.SuggestionsCacheLifetime)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_RecordSearch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID   = 1
+		query    = "lahaine"
+		resultID = 2
+	)
+	insertQuery := `INSERT INTO recent_searches(user_id, query, result_type, result_id)
+		SELECT id, $2, NULLIF($3, ''), NULLIF($4, 0) FROM users WHERE id = $1 AND save_search_history`
+	trimQuery := `DELETE FROM recent_searches WHERE user_id = $1 AND id NOT IN (`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "record search",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(insertQuery)).
+					WithArgs(driver.Value(userID), driver.Value(query), driver.Value(constants.SuggestionTypeTrack), driver.Value(resultID)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(trimQuery)).
+					WithArgs(driver.Value(userID), driver.Value(constants.RecentSearchesMaxAmount)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "insert returns error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(insertQuery)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+		{
+			name: "trim returns error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(insertQuery)).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(trimQuery)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+		{
+			name: "begin returns error",
+			mock: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.RecordSearch(userID, query, constants.SuggestionTypeTrack, resultID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMusicStorage_RecentSearches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID = 1
+		amount = 10
+	)
+	createdAt := time.Date(2021, time.December, 6, 12, 0, 0, 0, time.UTC)
+	search := &proto.RecentSearch{
+		ID:          1,
+		Query:       "lahaine",
+		ResultType:  constants.SuggestionTypeTrack,
+		ResultID:    2,
+		ResultTitle: "Lahaine",
+		CreatedAt:   createdAt.Unix(),
+	}
+	columns := []string{"rs.id", "rs.query", "result_type", "result_id", "result_title", "rs.created_at"}
+	query := `LEFT JOIN playlists p ON rs.result_type = 'playlist' AND p.id = rs.result_id AND (p.is_public OR p.user_id = rs.user_id)`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.RecentSearch
+		expectedError bool
+	}{
+		{
+			name: "get recent searches",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(search.ID, search.Query, search.ResultType, search.ResultID,
+					search.ResultTitle, createdAt)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value(amount)).WillReturnRows(rows)
+			},
+			expected: []*proto.RecentSearch{search},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"rs.id"}).AddRow(1)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+		{
+			name: "rows.Err() returns error",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(search.ID, search.Query, search.ResultType, search.ResultID,
+					search.ResultTitle, createdAt).RowError(0, errors.New("error"))
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.RecentSearches(userID, amount)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_DeleteRecentSearch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID   = 1
+		searchID = 2
+	)
+	query := `DELETE FROM recent_searches WHERE id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "recent search deleted",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(searchID), driver.Value(userID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expected: true,
+		},
+		{
+			name: "recent search not found",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(searchID), driver.Value(userID)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "exec returns error",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "rows affected returns error",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnResult(sqlmock.NewErrorResult(errors.New("error")))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.DeleteRecentSearch(userID, searchID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ClearRecentSearches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const userID = 1
+	query := `DELETE FROM recent_searches WHERE user_id = $1`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "recent searches cleared",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+		},
+		{
+			name: "exec returns error",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.ClearRecentSearches(userID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_IsPlaylistOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	var (
+		playlistID int64
+		userID     int64
+	)
+
+	tests := []struct {
+		name          string
+		isOwner       bool
+		mock          func()
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:    "user is owner",
+			isOwner: true,
+			mock: func() {
+				rows := mock.NewRows([]string{"isOwner"})
+				rows.AddRow("true")
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND user_id=$2`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+			},
+			expected: true,
+		},
+		{
+			name:    "user is not owner",
+			isOwner: true,
+			mock: func() {
+				rows := mock.NewRows([]string{"isOwner"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND user_id=$2`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+			},
+		},
+		{
+			name:    "query returns error",
+			isOwner: true,
+			mock: func() {
+				rows := mock.NewRows([]string{"isOwner"})
+				rows.AddRow("true")
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND user_id=$2`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.IsPlaylistOwner(playlistID, userID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+//nolint:cyclop
+func TestMusicStorage_PlaylistTracks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+	page := &proto.PageRequest{Limit: 10}
+
+	const userID = 1
+
+	track := &proto.Track{
+		ID:          1,
+		Title:       "testTrackTitle",
+		Explicit:    true,
+		Genre:       "testGenre",
+		Number:      2,
+		File:        testLinks.TrackStream(1, userID),
+		ListenCount: 3,
+		Duration:    4,
+		Lossless:    true,
+		Album: &proto.Album{
+			ID:           5,
+			Title:        "testAlbumTitle",
+			Artwork:      "testArtWork",
+			ArtworkColor: "testArtWorkColor",
+		},
+		Artist: &proto.Artist{
+			ID:   6,
+			Name: "testArtistName",
+		},
+		IsInFavorites: true,
+	}
+	trackWithoutFile := new(proto.Track)
+	_ = copier.Copy(trackWithoutFile, track)
+	trackWithoutFile.File = ""
+
+	var playlistID int64 = 1
+
+	tests := []struct {
+		name          string
+		isAuthorized  bool
+		mock          func()
+		expected      []*proto.Track
+		expectedError bool
+	}{
+		{
+			name:         "get playlist tracks",
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "pt.id", "total"})
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, pt.id, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN playlist_tracks pt ON pt.track = t.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE pt.playlist = $2 AND TRUE
+		ORDER BY pt.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(playlistID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+		},
+		{
+			name:         "query returns error",
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "pt.id", "total"})
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, pt.id, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN playlist_tracks pt ON pt.track = t.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE pt.playlist = $2 AND TRUE
+		ORDER BY pt.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(playlistID), driver.Value(page.Limit+1)).WillReturnError(errors.New("error"))
+			},
+			expected: func() []*proto.Track {
+				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+			expectedError: true,
+		},
+		{
+			name:         "scan returns error",
+			isAuthorized: true,
+			mock: func() {
+				var newArg = 1
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg", "pt.id", "total"})
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg, 1, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, pt.id, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN playlist_tracks pt ON pt.track = t.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE pt.playlist = $2 AND TRUE
+		ORDER BY pt.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(playlistID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+			expectedError: true,
+		},
+		{
+			name: "find tracks unauthorized",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "pt.id", "total"})
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, pt.id, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN playlist_tracks pt ON pt.track = t.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE pt.playlist = $2 AND TRUE
+		ORDER BY pt.id LIMIT $3`)).WithArgs(driver.Value(-1), driver.Value(playlistID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					tracks = append(tracks, trackWithoutFile)
+				}
+				return tracks
+			}(),
+		},
+		{
+			name:         "rows.Err() returns error",
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "pt.id", "total"}).RowError(1, errors.New("error"))
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, pt.id, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN playlist_tracks pt ON pt.track = t.id
+		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE pt.playlist = $2 AND TRUE
+		ORDER BY pt.id LIMIT $3`)).WithArgs(driver.Value(userID), driver.Value(playlistID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
+				for i := 0; i < constants.SearchTracksAmount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			var requestUserID int64 = userID
+			if !currentTest.isAuthorized {
+				requestUserID = -1
+			}
+			result, _, err := repository.PlaylistTracks(playlistID, requestUserID, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_PlaylistInfo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	playlist := &proto.PlaylistData{
+		PlaylistID:   1,
+		Title:        "testTitle",
+		Artwork:      "testArtWork",
+		ArtworkColor: "testArtWorkColor",
+		IsPublic:     true,
+	}
+
+	expectedPlaylist := &proto.PlaylistData{
+		PlaylistID:   1,
+		Title:        "testTitle",
+		Artwork:      "/static/playlists/testArtWork_384px.webp",
+		ArtworkColor: "testArtWorkColor",
+		IsPublic:     true,
+	}
+
+	var playlistID int64 = 1
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.PlaylistData
+		expectedError bool
+	}{
+		{
+			name: "get playlist info",
+			mock: func() {
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "artworkColor", "isPublic"})
+				row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.ArtworkColor, playlist.IsPublic)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, artwork_color, is_public FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+			expected: expectedPlaylist,
+		},
+		{
+			name: "private playlist artwork is signed",
+			mock: func() {
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "artworkColor", "isPublic"})
+				row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.ArtworkColor, false)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, artwork_color, is_public FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+			expected: &proto.PlaylistData{
+				PlaylistID:   1,
+				Title:        "testTitle",
+				Artwork:      testLinks.PlaylistArtwork("testArtWork", constants.PlaylistArtworkExtension384px, false, 1),
+				ArtworkColor: "testArtWorkColor",
+			},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "artworkColor", "isPublic"})
+				row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.ArtworkColor, playlist.IsPublic)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, artwork_color, is_public FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnError(errors.New("error"))
+			},
+			expected:      expectedPlaylist,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.PlaylistInfo(playlistID, 1)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+//nolint:cyclop
+func TestMusicStorage_UserPlaylists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+	page := &proto.PageRequest{Limit: 10}
+
+	playlist := &proto.PlaylistData{
+		PlaylistID: 1,
+		Title:      "testTitle",
+		Artwork:    "testArtWork",
+		IsPublic:   true,
+		IsOwn:      true,
+	}
+
+	expectedPlaylist := &proto.PlaylistData{
+		PlaylistID: 1,
+		Title:      "testTitle",
+		Artwork:    "/static/playlists/testArtWork_100px.webp",
+		IsPublic:   true,
+		IsOwn:      true,
+	}
+
+	var (
+		userID          int64 = 1
+		playlistsAmount       = 4
+	)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.PlaylistData
+		expectedError bool
+	}{
+		{
+			name: "get playlist info",
+			mock: func() {
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own", "total"})
+				for i := 0; i < playlistsAmount; i++ {
+					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$1 OR is_public=true) AND TRUE
+		ORDER BY id LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnRows(row)
+			},
+			expected: func() []*proto.PlaylistData {
+				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
+				for i := 0; i < playlistsAmount; i++ {
+					playlists = append(playlists, expectedPlaylist)
+				}
+				return playlists
+			}(),
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own", "total"})
+				for i := 0; i < playlistsAmount; i++ {
+					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$1 OR is_public=true) AND TRUE
+		ORDER BY id LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnError(errors.New("error"))
+			},
+			expected: func() []*proto.PlaylistData {
+				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
+				for i := 0; i < playlistsAmount; i++ {
+					playlists = append(playlists, expectedPlaylist)
+				}
+				return playlists
+			}(),
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			mock: func() {
+				newArg := 1
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own", "newArg", "total"})
+				for i := 0; i < playlistsAmount; i++ {
+					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn, newArg, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$1 OR is_public=true) AND TRUE
+		ORDER BY id LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnRows(row)
+			},
+			expected: func() []*proto.PlaylistData {
+				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
+				for i := 0; i < playlistsAmount; i++ {
+					playlists = append(playlists, expectedPlaylist)
+				}
+				return playlists
+			}(),
+			expectedError: true,
+		},
+		{
+			name: "rows.Err() returns error",
+			mock: func() {
+				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own", "total"}).RowError(1, errors.New("error"))
+				for i := 0; i < playlistsAmount; i++ {
+					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own, COUNT(*) OVER () AS total
+		FROM playlists
+		WHERE (user_id=$1 OR is_public=true) AND TRUE
+		ORDER BY id LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnRows(row)
+			},
+			expected: func() []*proto.PlaylistData {
+				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
+				for i := 0; i < playlistsAmount; i++ {
+					playlists = append(playlists, expectedPlaylist)
+				}
+				return playlists
+			}(),
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, _, err := repository.UserPlaylists(userID, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_DoesPlaylistExist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const playlistID int64 = 1
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "playlist exist",
+			mock: func() {
+				row := mock.NewRows([]string{"playlist_exist"})
+				row.AddRow("true")
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+			expected: true,
+		},
+		{
+			name: "playlist does not exist",
+			mock: func() {
+				row := mock.NewRows([]string{"playlist_exist"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnError(errors.New("error"))
+			},
+			expected:      false,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.DoesPlaylistExist(playlistID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_AddTrackToFavorite(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID = iota
+		trackID
+	)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "successful adding track to favorites",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO likes(user_id, track_id) VALUES ($1, $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnRows()
+			},
+		},
+		{
+			name: "failed adding track to favorites",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO likes(user_id, track_id) VALUES ($1, $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.AddTrackToFavorite(userID, trackID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_DeleteTrackFromFavorites(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID = iota
+		trackID
+	)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "successful deleting track from favorites",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM likes WHERE user_id = $1 and track_id = $2`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnResult(driver.ResultNoRows)
+			},
+		},
+		{
+			name: "failed deleting track from favorites",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM likes WHERE user_id = $1 and track_id = $2`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.DeleteTrackFromFavorites(userID, trackID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_IsTrackInFavorites(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID = iota
+		trackID
+	)
+
+	tests := []struct {
+		name              string
+		mock              func()
+		expectedExistence bool
+		expectedError     bool
+	}{
+		{
+			name: "track in favorites",
+			mock: func() {
+				row := mock.NewRows([]string{"exist"})
+				row.AddRow(true)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM likes WHERE user_id = $1 AND track_id = $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnRows(row)
+			},
+			expectedExistence: true,
+		},
+		{
+			name: "track not in favorites",
+			mock: func() {
+				row := mock.NewRows([]string{"exist"})
+				row.AddRow(false)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM likes WHERE user_id = $1 AND track_id = $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnRows(row)
+			},
+		},
+		{
+			name: "query error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM likes WHERE user_id = $1 AND track_id = $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			isExist, err := repository.IsTrackInFavorites(userID, trackID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedExistence, isExist)
+			}
+		})
+	}
+}
+
+//nolint:cyclop
+func TestMusicStorage_GetFavorites(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+	page := &proto.PageRequest{Limit: 10}
+
+	const userID = 1
+	addedAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	track := &proto.Track{
+		ID:          1,
+		Title:       "testTrackTitle",
+		Explicit:    true,
+		Genre:       "testGenre",
+		Number:      2,
+		File:        testLinks.TrackStream(1, userID),
+		ListenCount: 3,
+		Duration:    4,
+		Lossless:    true,
+		Album: &proto.Album{
+			ID:           5,
+			Title:        "testAlbumTitle",
+			Artwork:      "testAlbumArtwork",
+			ArtworkColor: "testArtWOrkColor",
+		},
+		Artist: &proto.Artist{
+			ID:   6,
+			Name: "testArtistName",
+		},
+		IsInFavorites: true,
+		AddedAt:       addedAt.Unix(),
+	}
+	explicit := true
+
+	tests := []struct {
+		name          string
+		amount        int64
+		isAuthorized  bool
+		sortBy        string
+		filter        *proto.FavoritesFilter
+		mock          func()
+		expected      []*proto.Track
+		expectedError bool
+	}{
+		{
+			name:         "successful getting favorites",
+			amount:       4,
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "l.id", "l.created_at", "total"})
+				for i := 0; i < 4; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, addedAt, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, l.id, l.created_at, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE TRUE
+		ORDER BY l.created_at DESC, l.id DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				var amount = 4
+				tracks := make([]*proto.Track, 0, amount)
+				for i := 0; i < amount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+		},
+		{
+			name:         "filtered favorites sorted by artist",
+			amount:       2,
+			isAuthorized: true,
+			sortBy:       constants.SortByArtist,
+			filter:       &proto.FavoritesFilter{ArtistID: 6, Explicit: &explicit, Text: "test"},
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "l.id", "l.created_at", "total"})
+				for i := 0; i < 2; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, addedAt, 2)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE t.artist = $2 AND t.explicit = $3 AND concat_ws(' ', t.title, art.name, alb.title) ILIKE $4 AND TRUE
+		ORDER BY art.name, t.title, l.id LIMIT $5`)).WithArgs(driver.Value(userID), driver.Value(6), driver.Value(true),
+					driver.Value("%test%"), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track, track},
+		},
+		{
+			name:         "like wildcards in text filter are escaped",
+			amount:       1,
+			isAuthorized: true,
+			filter:       &proto.FavoritesFilter{Text: "test_1%"},
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "l.id", "l.created_at", "total"})
+				rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, addedAt, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE concat_ws(' ', t.title, art.name, alb.title) ILIKE $2 AND TRUE`)).
+					WithArgs(driver.Value(userID), driver.Value(`%test\_1\%%`), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track},
+		},
+		{
+			name:         "query returns error",
+			amount:       1,
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "l.id", "l.created_at", "total"})
+				for i := 0; i < 1; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, addedAt, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, l.id, l.created_at, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE TRUE
+		ORDER BY l.created_at DESC, l.id DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnError(errors.New("error"))
+			},
+			expected: func() []*proto.Track {
+				var amount = 1
+				tracks := make([]*proto.Track, 0, amount)
+				for i := 0; i < amount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+			expectedError: true,
+		},
+		{
+			name:         "scan returns error",
+			amount:       1,
+			isAuthorized: true,
+			mock: func() {
+				var newArg = 1
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg", "l.id", "l.created_at", "total"})
+				for i := 0; i < 1; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg, 1, addedAt, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, l.id, l.created_at, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE TRUE
+		ORDER BY l.created_at DESC, l.id DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				var amount = 1
+				tracks := make([]*proto.Track, 0, amount)
+				for i := 0; i < amount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+			expectedError: true,
+		},
+		{
+			name:         "rows.Err() returns error",
+			amount:       4,
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "l.id", "l.created_at", "total"}).RowError(0, errors.New("error"))
+				for i := 0; i < 4; i++ {
+					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 1, addedAt, 10)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
+					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics"}, "t")+", "+
+					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
+					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
+					wrapper.Wrapper([]string{"name"}, "g")+", "+
+					`
+		l.id IS NOT NULL as favorite, l.id, l.created_at, COUNT(*) OVER () AS total
+		FROM tracks t
+		JOIN genres g ON t.genre = g.id
+		JOIN albums alb ON t.album = alb.id
+		JOIN artists art ON t.artist = art.id
+		JOIN likes l on t.id = l.track_id and l.user_id = $1
+		WHERE TRUE
+		ORDER BY l.created_at DESC, l.id DESC LIMIT $2`)).WithArgs(driver.Value(userID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: func() []*proto.Track {
+				var amount = 4
+				tracks := make([]*proto.Track, 0, amount)
+				for i := 0; i < amount; i++ {
+					tracks = append(tracks, track)
+				}
+				return tracks
+			}(),
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, _, err := repository.GetFavorites(userID, currentTest.sortBy, currentTest.filter, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_SaveAlbum(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID  = 1
+		albumID = 2
+	)
+	query := `INSERT INTO saved_albums(user_id, album_id) VALUES ($1, $2)`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "album saved",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value(albumID)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value(albumID)).
+					WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.SaveAlbum(userID, albumID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_SavedAlbums(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const userID = 1
+	savedAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	album := &proto.Album{
+		ID:             2,
+		Title:          "testAlbumTitle",
+		Year:           2021,
+		Artwork:        "testAlbumArtwork",
+		TracksAmount:   3,
+		ArtworkColor:   "testArtworkColor",
+		Artist:         "testArtistName",
+		TracksDuration: 400,
+		IsSaved:        true,
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb") + ", " +
+		wrapper.Wrapper([]string{"name"}, "art") +
+		`, (SELECT COALESCE(SUM(t.duration), 0) FROM tracks t WHERE t.album = alb.id) AS tracksDuration,
+		sa.created_at, COUNT(*) OVER () AS total
+		FROM saved_albums sa
+		JOIN albums alb ON alb.id = sa.album_id
+		JOIN artists art ON art.id = alb.artist
+		WHERE sa.user_id = $1 AND `
+	columns := []string{"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.track_count", "alb.artwork_color", "art.name",
+		"tracksDuration", "created_at", "total"}
+
+	tests := []struct {
+		name          string
+		page          *proto.PageRequest
+		mock          func()
+		expected      []*proto.Album
+		expectedPage  *proto.PageResponse
+		expectedError bool
+	}{
+		{
+			name: "last page of saved albums",
+			page: &proto.PageRequest{Limit: 2},
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount,
+					album.ArtworkColor, album.Artist, album.TracksDuration, savedAt, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(query+`TRUE
+		ORDER BY sa.created_at DESC, sa.album_id DESC LIMIT $2`)).
+					WithArgs(driver.Value(userID), driver.Value(3)).WillReturnRows(rows)
+			},
+			expected:     []*proto.Album{album},
+			expectedPage: &proto.PageResponse{TotalHint: 1},
+		},
+		{
+			name: "first page has more albums",
+			page: &proto.PageRequest{Limit: 1},
+			mock: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount,
+						album.ArtworkColor, album.Artist, album.TracksDuration, savedAt, 2).
+					AddRow(5, album.Title, album.Year, album.Artwork, album.TracksAmount,
+						album.ArtworkColor, album.Artist, album.TracksDuration, savedAt.Add(-time.Hour), 2)
+				mock.ExpectQuery(regexp.QuoteMeta(query+`TRUE
+		ORDER BY sa.created_at DESC, sa.album_id DESC LIMIT $2`)).
+					WithArgs(driver.Value(userID), driver.Value(2)).WillReturnRows(rows)
+			},
+			expected: []*proto.Album{album},
+			expectedPage: &proto.PageResponse{
+				NextCursor: pagination.Encode(&pagination.Cursor{Values: []interface{}{savedAt.Format(time.RFC3339Nano), album.ID}, Total: 2}),
+				TotalHint:  2,
+			},
+		},
+		{
+			name:          "invalid cursor",
+			page:          &proto.PageRequest{Limit: 1, Cursor: "invalid"},
+			mock:          func() {},
+			expectedError: true,
+		},
+		{
+			name: "cursor with tampered date",
+			page: &proto.PageRequest{
+				Limit:  1,
+				Cursor: pagination.Encode(&pagination.Cursor{Values: []interface{}{"yesterday", album.ID}, Total: 2}),
+			},
+			mock:          func() {},
+			expectedError: true,
+		},
+		{
+			name: "query returns error",
+			page: &proto.PageRequest{Limit: 1},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, page, err := repository.SavedAlbums(userID, currentTest.page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+				assert.Equal(t, currentTest.expectedPage, page)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_FollowedArtists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const userID = 1
+	followedAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	artist := &proto.Artist{
+		ID:             2,
+		Name:           "testArtistName",
+		Avatar:         "testArtistAvatar",
+		AvatarColor:    "testAvatarColor",
+		FollowersCount: 10,
+		IsFollowed:     true,
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "name", "avatar"}, "art") +
+		`, COALESCE(art.avatar_color, ''),
+		(SELECT COUNT(*) FROM artist_follows f WHERE f.artist_id = art.id) AS followers,
+		af.created_at, COUNT(*) OVER () AS total
+		FROM artist_follows af
+		JOIN artists art ON art.id = af.artist_id
+		WHERE af.user_id = $1 AND TRUE
+		ORDER BY af.created_at DESC, af.artist_id DESC LIMIT $2`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Artist
+		expectedError bool
+	}{
+		{
+			name: "followed artists",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"art.id", "art.name", "art.avatar", "avatar_color", "followers", "created_at", "total"}).
+					AddRow(artist.ID, artist.Name, artist.Avatar, artist.AvatarColor, artist.FollowersCount, followedAt, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value(3)).WillReturnRows(rows)
+			},
+			expected: []*proto.Artist{artist},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID), driver.Value(3)).
+					WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, _, err := repository.FollowedArtists(userID, &proto.PageRequest{Limit: 2})
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_SavedAlbumIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	query := `SELECT album_id FROM saved_albums WHERE user_id = $1 AND album_id = ANY($2::integer[])`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []int64
+		expectedError bool
+	}{
+		{
+			name: "ids found",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"album_id"}).AddRow(3)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1, "{2,3}").WillReturnRows(rows)
+			},
+			expected: []int64{3},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1, "{2,3}").WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.SavedAlbumIDs(1, []int64{2, 3})
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMusicStorage_FollowedArtistIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	query := `SELECT artist_id FROM artist_follows WHERE user_id = $1 AND artist_id = ANY($2::integer[])`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []int64
+		expectedError bool
+	}{
+		{
+			name: "ids found",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"artist_id"}).AddRow(4)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1, "{4,5}").WillReturnRows(rows)
+			},
+			expected: []int64{4},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1, "{4,5}").WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.FollowedArtistIDs(1, []int64{4, 5})
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMusicStorage_IsPlaylistPublic(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const playlistID = 1
+
+	tests := []struct {
+		name              string
+		mock              func()
+		expectedPublicity bool
+		expectedError     bool
+	}{
+		{
+			name: "playlist is public",
+			mock: func() {
+				row := mock.NewRows([]string{"public"})
+				row.AddRow(true)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+			expectedPublicity: true,
+		},
+		{
+			name: "playlist is not public",
+			mock: func() {
+				row := mock.NewRows([]string{"public"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+		},
+		{
+			name: "query error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "row.Err() returns error",
+			mock: func() {
+				row := mock.NewRows([]string{"public"}).RowError(0, errors.New("error"))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			isPublic, err := repository.IsPlaylistPublic(playlistID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedPublicity, isPublic)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_RebuildChart(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	start := time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	previousStart := start.AddDate(0, 0, -7)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "rebuild chart",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM charts WHERE period = $1 AND period_start = $2`)).
+					WithArgs(constants.ChartPeriodWeekly, "2021-12-06").WillReturnResult(sqlmock.NewResult(0, 0))
+				for _, entity := range []string{constants.ChartEntityTracks, constants.ChartEntityAlbums, constants.ChartEntityArtists} {
+					mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO charts`)).
+						WithArgs(constants.ChartPeriodWeekly, entity, "2021-12-06", "2021-12-13", constants.ChartPositionsAmount).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE charts c SET previous_position = prev.position`)).
+					WithArgs(constants.ChartPeriodWeekly, "2021-12-06", "2021-11-29").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "insert returns error",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM charts WHERE period = $1 AND period_start = $2`)).
+					WithArgs(constants.ChartPeriodWeekly, "2021-12-06").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO charts`)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+		{
+			name: "begin returns error",
+			mock: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.RebuildChart(constants.ChartPeriodWeekly, start, end, previousStart)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMusicStorage_ChartPeriodStart(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	periodStart := time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      time.Time
+		expectedError bool
+	}{
+		{
+			name: "chart exists",
+			mock: func() {
+				row := mock.NewRows([]string{"period_start"})
+				row.AddRow(periodStart)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT period_start FROM charts WHERE period = $1 ORDER BY period_start DESC LIMIT 1`)).
+					WithArgs(constants.ChartPeriodMonthly).WillReturnRows(row)
+			},
+			expected: periodStart,
+		},
+		{
+			name: "chart is not built yet",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT period_start FROM charts WHERE period = $1 ORDER BY period_start DESC LIMIT 1`)).
+					WithArgs(constants.ChartPeriodMonthly).WillReturnRows(mock.NewRows([]string{"period_start"}))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.ChartPeriodStart(constants.ChartPeriodMonthly)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ChartTracks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		userID  = 1
+		genreID = 2
+		amount  = 10
+	)
+	periodStart := time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)
+
+	chartTrack := &proto.ChartTrack{
+		Position:         1,
+		PreviousPosition: 3,
+		Plays:            100,
+		Track: &proto.Track{
+			ID:          1,
+			Title:       "testTrackTitle",
+			Explicit:    true,
+			Genre:       "testGenre",
+			Number:      2,
+			File:        testLinks.TrackStream(1, userID),
+			ListenCount: 3,
+			Duration:    4,
+			Lossless:    true,
+			Album: &proto.Album{
+				ID:           5,
+				Title:        "testAlbumTitle",
+				Artwork:      "testArtwork",
+				ArtworkColor: "testArtworkColor",
+			},
+			Artist: &proto.Artist{
+				ID:   6,
+				Name: "testArtistName",
+			},
+			IsInFavorites: true,
+		},
+	}
+	trackWithoutFile := new(proto.Track)
+	_ = copier.Copy(trackWithoutFile, chartTrack.Track)
+	trackWithoutFile.File = ""
+	chartTrackWithoutFile := &proto.ChartTrack{
+		Position:         chartTrack.Position,
+		PreviousPosition: chartTrack.PreviousPosition,
+		Plays:            chartTrack.Plays,
+		Track:            trackWithoutFile,
+	}
+
+	columns := []string{"position", "previous_position", "plays", "t.id", "t.title", "t.explicit", "t.number", "t.file",
+		"t.listen_count", "t.duration", "t.lossless", "t.has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id",
+		"art.name", "g.name", "favorite"}
+	addRow := func(rows *sqlmock.Rows) {
+		rows.AddRow(chartTrack.Position, chartTrack.PreviousPosition, chartTrack.Plays, chartTrack.Track.ID,
+			chartTrack.Track.Title, chartTrack.Track.Explicit, chartTrack.Track.Number, "testFile",
+			chartTrack.Track.ListenCount, chartTrack.Track.Duration, chartTrack.Track.Lossless, chartTrack.Track.HasLyrics, chartTrack.Track.Album.ID,
+			chartTrack.Track.Album.Title, chartTrack.Track.Album.Artwork, chartTrack.Track.Album.ArtworkColor,
+			chartTrack.Track.Artist.ID, chartTrack.Track.Artist.Name, chartTrack.Track.Genre, chartTrack.Track.IsInFavorites)
+	}
+
+	tests := []struct {
+		name          string
+		isAuthorized  bool
+		mock          func()
+		expected      []*proto.ChartTrack
+		expectedError bool
+	}{
+		{
+			name:         "authorized user gets chart tracks",
+			isAuthorized: true,
+			mock: func() {
+				rows := sqlmock.NewRows(columns)
+				addRow(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).
+					WithArgs(userID, constants.ChartPeriodWeekly, constants.ChartEntityTracks, genreID, "2021-12-06", amount).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.ChartTrack{chartTrack},
+		},
+		{
+			name: "unauthorized user gets chart tracks without files",
+			mock: func() {
+				rows := sqlmock.NewRows(columns)
+				addRow(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).
+					WithArgs(userID, constants.ChartPeriodWeekly, constants.ChartEntityTracks, genreID, "2021-12-06", amount).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.ChartTrack{chartTrackWithoutFile},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"position"})
+				rows.AddRow(1)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.ChartTracks(constants.ChartPeriodWeekly, periodStart, genreID, amount, userID, currentTest.isAuthorized)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ChartAlbums(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const amount = 10
+	periodStart := time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)
+
+	chartAlbum := &proto.ChartAlbum{
+		Position: 1,
+		Plays:    100,
+		Album: &proto.Album{
+			ID:           1,
+			Title:        "testTitle",
+			Year:         2021,
+			Artwork:      "testArtwork",
+			TracksAmount: 10,
+			ArtworkColor: "testArtworkColor",
+			Artist:       "testArtist",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.ChartAlbum
+		expectedError bool
+	}{
+		{
+			name: "get chart albums",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"position", "previous_position", "plays", "alb.id", "alb.title", "alb.year",
+					"alb.artwork", "alb.track_count", "alb.artwork_color", "art.name"})
+				rows.AddRow(chartAlbum.Position, chartAlbum.PreviousPosition, chartAlbum.Plays, chartAlbum.Album.ID,
+					chartAlbum.Album.Title, chartAlbum.Album.Year, chartAlbum.Album.Artwork, chartAlbum.Album.TracksAmount,
+					chartAlbum.Album.ArtworkColor, chartAlbum.Album.Artist)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).
+					WithArgs(constants.ChartPeriodWeekly, constants.ChartEntityAlbums, 0, "2021-12-06", amount).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.ChartAlbum{chartAlbum},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.ChartAlbums(constants.ChartPeriodWeekly, periodStart, 0, amount)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ChartArtists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const amount = 10
+	periodStart := time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)
+
+	chartArtist := &proto.ChartArtist{
+		Position:         2,
+		PreviousPosition: 1,
+		Plays:            50,
+		Artist: &proto.Artist{
+			ID:     1,
+			Name:   "testName",
+			Avatar: "testAvatar",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.ChartArtist
+		expectedError bool
+	}{
+		{
+			name: "get chart artists",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"position", "previous_position", "plays", "art.id", "art.name", "art.avatar"})
+				rows.AddRow(chartArtist.Position, chartArtist.PreviousPosition, chartArtist.Plays, chartArtist.Artist.ID,
+					chartArtist.Artist.Name, chartArtist.Artist.Avatar)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).
+					WithArgs(constants.ChartPeriodWeekly, constants.ChartEntityArtists, 0, "2021-12-06", amount).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.ChartArtist{chartArtist},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM charts c`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.ChartArtists(constants.ChartPeriodWeekly, periodStart, 0, amount)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ListGenres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	genre := &proto.Genre{
+		ID:           1,
+		Name:         "testName",
+		Artwork:      "testArtwork",
+		ArtworkColor: "testArtworkColor",
+		TracksAmount: 10,
+	}
+	query := `SELECT ` +
+		wrapper.Wrapper([]string{"id", "name", "artwork", "artwork_color"}, "g") + ", COUNT(t.id) AS tracksAmount" +
+		`
+		FROM genres g
+		JOIN tracks t ON t.genre = g.id
+		GROUP BY g.id
+		ORDER BY tracksAmount DESC, g.name`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Genre
+		expectedError bool
+	}{
+		{
+			name: "get genres",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"g.id", "g.name", "g.artwork", "g.artwork_color", "tracksAmount"})
+				rows.AddRow(genre.ID, genre.Name, genre.Artwork, genre.ArtworkColor, genre.TracksAmount)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+			},
+			expected: []*proto.Genre{genre},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+		{
+			name: "scan returns error",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"g.id"})
+				rows.AddRow(genre.ID)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.ListGenres()
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_GenreInfo(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const genreID = 1
+	genre := &proto.Genre{
+		ID:           genreID,
+		Name:         "testName",
+		Artwork:      "testArtwork",
+		ArtworkColor: "testArtworkColor",
+		TracksAmount: 10,
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.Genre
+		expectedError bool
+	}{
+		{
+			name: "get genre",
+			mock: func() {
+				row := sqlmock.NewRows([]string{"g.id", "g.name", "g.artwork", "g.artwork_color", "tracksAmount"})
+				row.AddRow(genre.ID, genre.Name, genre.Artwork, genre.ArtworkColor, genre.TracksAmount)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE g.id = $1`)).WithArgs(driver.Value(genreID)).WillReturnRows(row)
+			},
+			expected: genre,
+		},
+		{
+			name: "genre does not exist",
+			mock: func() {
+				row := sqlmock.NewRows([]string{"g.id", "g.name", "g.artwork", "g.artwork_color", "tracksAmount"})
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE g.id = $1`)).WithArgs(driver.Value(genreID)).WillReturnRows(row)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.GenreInfo(genreID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_GenreTracks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		genreID = 1
+		userID  = 2
+		total   = 30
+	)
+	track := &proto.Track{
+		ID:          1,
+		Title:       "testTrackTitle",
+		Explicit:    true,
+		Genre:       "testGenre",
+		Number:      2,
+		File:        testLinks.TrackStream(1, userID),
+		ListenCount: 3,
+		Duration:    4,
+		Lossless:    true,
+		Album: &proto.Album{
+			ID:           5,
+			Title:        "testAlbumTitle",
+			Artwork:      "testArtwork",
+			ArtworkColor: "testArtworkColor",
+		},
+		Artist: &proto.Artist{
+			ID:   6,
+			Name: "testArtistName",
+		},
+	}
+	trackWithoutFile := new(proto.Track)
+	_ = copier.Copy(trackWithoutFile, track)
+	trackWithoutFile.File = ""
+
+	columns := []string{"t.id", "t.title", "t.explicit", "t.number", "t.file", "t.listen_count", "t.duration", "t.lossless", "t.has_lyrics",
+		"alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "total"}
+	addRows := func(rows *sqlmock.Rows, amount int) {
+		for i := 0; i < amount; i++ {
+			rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration,
+				track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor,
+				track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, total)
+		}
+	}
+	cursor := pagination.Encode(&pagination.Cursor{Values: []interface{}{int64(10), int64(7)}, Total: total})
+
+	tests := []struct {
+		name          string
+		isAuthorized  bool
+		page          *proto.PageRequest
+		mock          func()
+		expected      []*proto.Track
+		expectedPage  *proto.PageResponse
+		expectedError bool
+	}{
+		{
+			name:         "authorized user gets first page of genre tracks",
+			isAuthorized: true,
+			page:         &proto.PageRequest{Limit: 2},
+			mock: func() {
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 3)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.genre = $2 AND TRUE
+		ORDER BY t.listen_count DESC, t.id LIMIT $3`)).
+					WithArgs(driver.Value(userID), driver.Value(genreID), driver.Value(3)).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track, track},
+			expectedPage: &proto.PageResponse{
+				NextCursor: pagination.Encode(&pagination.Cursor{Values: []interface{}{track.ListenCount, track.ID}, Total: total}),
+				TotalHint:  total,
+			},
+		},
+		{
+			name: "unauthorized user gets last page of genre tracks without files",
+			page: &proto.PageRequest{Limit: 2, Cursor: cursor},
+			mock: func() {
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.genre = $2 AND (t.listen_count < $3 OR (t.listen_count = $3 AND t.id > $4))
+		ORDER BY t.listen_count DESC, t.id LIMIT $5`)).
+					WithArgs(driver.Value(userID), driver.Value(genreID), driver.Value(10), driver.Value(7), driver.Value(3)).
+					WillReturnRows(rows)
+			},
+			expected:     []*proto.Track{trackWithoutFile},
+			expectedPage: &proto.PageResponse{TotalHint: total},
+		},
+		{
+			name:          "invalid cursor",
+			page:          &proto.PageRequest{Limit: 2, Cursor: "qwe"},
+			mock:          func() {},
+			expectedError: true,
+		},
+		{
+			name: "query returns error",
+			page: &proto.PageRequest{Limit: 2},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.genre = $2`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, page, err := repository.GenreTracks(genreID, userID, currentTest.isAuthorized, currentTest.page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+				assert.Equal(t, currentTest.expectedPage, page)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_GenreAlbums(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		genreID     = 1
+		listenCount = 100
+	)
+	page := &proto.PageRequest{Limit: 1}
+	album := &proto.Album{
+		ID:             1,
+		Title:          "testTitle",
+		Year:           2021,
+		Artist:         "testArtist",
+		Artwork:        "testArtwork",
+		TracksAmount:   10,
+		TracksDuration: 3600,
+		ArtworkColor:   "testArtworkColor",
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Album
+		expectedPage  *proto.PageResponse
+		expectedError bool
+	}{
+		{
+			name: "get genre albums",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.track_count",
+					"alb.artwork_color", "art.name", "tracksDuration", "listenCount", "total"})
+				for i := 0; i < 2; i++ {
+					rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor,
+						album.Artist, album.TracksDuration, listenCount, 5)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.genre = $1
+		GROUP BY alb.id, art.name
+		HAVING TRUE
+		ORDER BY SUM(t.listen_count) DESC, alb.id LIMIT $2`)).
+					WithArgs(driver.Value(genreID), driver.Value(2)).WillReturnRows(rows)
+			},
+			expected: []*proto.Album{album},
+			expectedPage: &proto.PageResponse{
+				NextCursor: pagination.Encode(&pagination.Cursor{Values: []interface{}{int64(listenCount), album.ID}, Total: 5}),
+				TotalHint:  5,
+			},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.genre = $1`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, resultPage, err := repository.GenreAlbums(genreID, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+				assert.Equal(t, currentTest.expectedPage, resultPage)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_GenreArtists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const genreID = 1
+	page := &proto.PageRequest{Limit: 8}
+	artist := &proto.Artist{
+		ID:     1,
+		Name:   "testName",
+		Avatar: "testAvatar",
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Artist
+		expectedError bool
+	}{
+		{
+			name: "get genre artists",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"art.id", "art.name", "art.avatar", "listenCount", "total"})
+				rows.AddRow(artist.ID, artist.Name, artist.Avatar, 100, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(`HAVING TRUE
+		ORDER BY SUM(t.listen_count) DESC, art.id LIMIT $2`)).
+					WithArgs(driver.Value(genreID), driver.Value(page.Limit+1)).WillReturnRows(rows)
+			},
+			expected: []*proto.Artist{artist},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE t.genre = $1`)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, _, err := repository.GenreArtists(genreID, page)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ArtistTracksPage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const (
+		artistID = 1
+		userID   = 2
+	)
+	track := &proto.Track{
+		ID:          1,
+		Title:       "testTrackTitle",
+		Genre:       "testGenre",
+		Number:      2,
+		File:        testLinks.TrackStream(1, userID),
+		ListenCount: 3,
+		Duration:    4,
+		Album: &proto.Album{
+			ID:           5,
+			Title:        "testAlbumTitle",
+			Year:         2021,
+			Artwork:      "testArtwork",
+			ArtworkColor: "testArtworkColor",
+		},
+		Artist: &proto.Artist{
+			ID:   artistID,
+			Name: "testArtistName",
+		},
+	}
+	columns := []string{"t.id", "t.title", "t.explicit", "t.number", "t.file", "t.listen_count", "t.duration", "t.lossless", "t.has_lyrics",
+		"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "total"}
+	addRows := func(rows *sqlmock.Rows, amount int) {
+		for i := 0; i < amount; i++ {
+			rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount, track.Duration,
+				track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Year, track.Album.Artwork, track.Album.ArtworkColor,
+				track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, 2)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		sortBy        string
+		order         string
+		mock          func(order string)
+		expected      []*proto.Track
+		expectedPage  *proto.PageResponse
+		expectedError bool
+	}{
+		{
+			name:   "sort by popularity",
+			sortBy: constants.SortByPopularity,
+			order:  "ORDER BY t.listen_count DESC, t.id LIMIT $3",
+			mock: func(order string) {
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(order)).
+					WithArgs(driver.Value(userID), driver.Value(artistID), driver.Value(2)).
+					WillReturnRows(rows)
+			},
+			expected:     []*proto.Track{track},
+			expectedPage: &proto.PageResponse{TotalHint: 2},
+		},
+		{
+			name:   "sort by year",
+			sortBy: constants.SortByYear,
+			order:  "ORDER BY alb.year DESC, t.album, COALESCE(t.number, 0), t.id LIMIT $3",
+			mock: func(order string) {
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 2)
+				mock.ExpectQuery(regexp.QuoteMeta(order)).WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track},
+			expectedPage: &proto.PageResponse{
+				NextCursor: pagination.Encode(&pagination.Cursor{
+					Values: []interface{}{track.Album.Year, track.Album.ID, track.Number, track.ID},
+					Total:  2,
+				}),
+				TotalHint: 2,
+			},
+		},
+		{
+			name:   "unknown sort falls back to popularity",
+			sortBy: "qwe",
+			order:  "ORDER BY t.listen_count DESC, t.id LIMIT $3",
+			mock: func(order string) {
+				rows := sqlmock.NewRows(columns)
+				addRows(rows, 2)
+				mock.ExpectQuery(regexp.QuoteMeta(order)).WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track},
+			expectedPage: &proto.PageResponse{
+				NextCursor: pagination.Encode(&pagination.Cursor{Values: []interface{}{track.ListenCount, track.ID}, Total: 2}),
+				TotalHint:  2,
+			},
+		},
+		{
+			name:   "query returns error",
+			sortBy: constants.SortByTitle,
+			order:  "ORDER BY t.title, t.id LIMIT $3",
+			mock: func(order string) {
+				mock.ExpectQuery(regexp.QuoteMeta(order)).WillReturnError(errors.New("error"))
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
 			expectedError: true,
 		},
 	}
@@ -2124,138 +5168,82 @@ func TestMusicStorage_FindTracksByPartial(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			currentTest.mock()
-			result, err := repository.FindTracksByPartial(text, userID, currentTest.isAuthorized)
+			currentTest.mock(currentTest.order)
+			result, page, err := repository.ArtistTracksPage(artistID, userID, true, currentTest.sortBy, &proto.PageRequest{Limit: 1})
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, result)
+				assert.Equal(t, currentTest.expectedPage, page)
 			}
 		})
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_FindArtists(t *testing.T) {
+func TestMusicStorage_ArtistAlbumsPage(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
-
-	tracks := make([]*proto.Track, 0)
-	albums := make([]*proto.Album, 0)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	artist := &proto.Artist{
-		ID:     1,
-		Name:   "testName",
-		Avatar: "testAvatar",
-		Video:  "",
-		Tracks: tracks,
-		Albums: albums,
+	const artistID = 1
+	page := &proto.PageRequest{Limit: 20}
+	album := &proto.Album{
+		ID:             1,
+		Title:          "testTitle",
+		Year:           2021,
+		Artist:         "testArtist",
+		Artwork:        "testArtwork",
+		TracksAmount:   10,
+		TracksDuration: 3600,
+		ArtworkColor:   "testArtworkColor",
 	}
-
-	text := "testText"
-	textArgument := "%" + text + "%"
+	columns := []string{"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.track_count", "alb.artwork_color", "art.name",
+		"tracksDuration", "listenCount", "total"}
 
 	tests := []struct {
 		name          string
-		mock          func()
-		expected      []*proto.Artist
+		sortBy        string
+		order         string
+		mock          func(order string)
+		expected      []*proto.Album
 		expectedError bool
 	}{
 		{
-			name: "find artists",
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"artists.id", "artists.name", "artists.avatar"})
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					rows.AddRow(artist.ID, artist.Name, artist.Avatar)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar
-		FROM artists
-		WHERE name ILIKE $1
-		LIMIT $2
-	`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchArtistsAmount)).WillReturnRows(rows)
-			},
-			expected: func() []*proto.Artist {
-				artists := make([]*proto.Artist, 0, constants.SearchArtistsAmount)
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					artists = append(artists, artist)
-				}
-				return artists
-			}(),
-		},
-		{
-			name: "query returns error",
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"artists.id", "artists.name", "artists.avatar"})
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					rows.AddRow(artist.ID, artist.Name, artist.Avatar)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar
-		FROM artists
-		WHERE name ILIKE $1
-		LIMIT $2
-	`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchArtistsAmount)).WillReturnError(errors.New("error"))
+			name:   "sort by year",
+			sortBy: constants.SortByYear,
+			order:  "HAVING TRUE\n\t\tORDER BY alb.year DESC, alb.id LIMIT $2",
+			mock: func(order string) {
+				rows := sqlmock.NewRows(columns)
+				rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor,
+					album.Artist, album.TracksDuration, 100, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(order)).
+					WithArgs(driver.Value(artistID), driver.Value(page.Limit+1)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Artist {
-				artists := make([]*proto.Artist, 0, constants.SearchArtistsAmount)
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					artists = append(artists, artist)
-				}
-				return artists
-			}(),
-			expectedError: true,
+			expected: []*proto.Album{album},
 		},
 		{
-			name: "scan returns error",
-			mock: func() {
-				var newArg = 1
-				rows := sqlmock.NewRows([]string{"artists.id", "artists.name", "artists.avatar", "newArg"})
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					rows.AddRow(artist.ID, artist.Name, artist.Avatar, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar
-		FROM artists
-		WHERE name ILIKE $1
-		LIMIT $2
-	`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchArtistsAmount)).WillReturnRows(rows)
+			name:   "sort by title",
+			sortBy: constants.SortByTitle,
+			order:  "ORDER BY alb.title, alb.id LIMIT $2",
+			mock: func(order string) {
+				rows := sqlmock.NewRows(columns)
+				rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor,
+					album.Artist, album.TracksDuration, 100, 1)
+				mock.ExpectQuery(regexp.QuoteMeta(order)).WillReturnRows(rows)
 			},
-			expected: func() []*proto.Artist {
-				artists := make([]*proto.Artist, 0, constants.SearchArtistsAmount)
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					artists = append(artists, artist)
-				}
-				return artists
-			}(),
-			expectedError: true,
+			expected: []*proto.Album{album},
 		},
 		{
-			name: "rows.Err() returns error",
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"artists.id", "artists.name", "artists.avatar"}).RowError(1, errors.New("error"))
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					rows.AddRow(artist.ID, artist.Name, artist.Avatar)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, name, avatar
-		FROM artists
-		WHERE name ILIKE $1
-		LIMIT $2
-	`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchArtistsAmount)).WillReturnRows(rows)
+			name:   "query returns error",
+			sortBy: constants.SortByPopularity,
+			order:  "ORDER BY SUM(t.listen_count) DESC, alb.id LIMIT $2",
+			mock: func(order string) {
+				mock.ExpectQuery(regexp.QuoteMeta(order)).WillReturnError(errors.New("error"))
 			},
-			expected: func() []*proto.Artist {
-				artists := make([]*proto.Artist, 0, constants.SearchArtistsAmount)
-				for i := 0; i < constants.SearchArtistsAmount; i++ {
-					artists = append(artists, artist)
-				}
-				return artists
-			}(),
 			expectedError: true,
 		},
 	}
@@ -2263,8 +5251,8 @@ func TestMusicStorage_FindArtists(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			currentTest.mock()
-			result, err := repository.FindArtists(text)
+			currentTest.mock(currentTest.order)
+			result, _, err := repository.ArtistAlbumsPage(artistID, currentTest.sortBy, page)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
@@ -2275,149 +5263,34 @@ func TestMusicStorage_FindArtists(t *testing.T) {
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_FindAlbums(t *testing.T) {
+func TestMusicStorage_RebuildSearchIndex(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
-
-	album := &proto.Album{
-		ID:             1,
-		Title:          "testTitle",
-		Year:           2,
-		Artist:         "testArtist",
-		Artwork:        "testArtwork",
-		TracksAmount:   3,
-		TracksDuration: 4,
-		ArtworkColor:   "testArtWorkColor",
-	}
-
-	text := "testText"
-	textArgument := "%" + text + "%"
+	repository := NewMusicStorage(db, nil, testLinks)
 
 	tests := []struct {
 		name          string
 		mock          func()
-		expected      []*proto.Album
+		expected      int64
 		expectedError bool
 	}{
 		{
-			name: "find albums",
+			name: "index rebuilt",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "a.track_count", "artwork_color", "art.name", "tracksDuration"})
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor, album.Artist, album.TracksDuration)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"name"}, "art")+", SUM(t.duration) AS tracksDuration"+
-					`
-		FROM albums alb
-		JOIN artists art ON art.id = alb.artist
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.title ILIKE $1
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY year DESC
-		LIMIT $2
-		`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchAlbumsAmount)).WillReturnRows(rows)
+				row := mock.NewRows([]string{"rebuild_track_search"})
+				row.AddRow(1267)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT rebuild_track_search()`)).WillReturnRows(row)
 			},
-			expected: func() []*proto.Album {
-				albums := make([]*proto.Album, 0, constants.SearchAlbumsAmount)
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					albums = append(albums, album)
-				}
-				return albums
-			}(),
+			expected: 1267,
 		},
 		{
 			name: "query returns error",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "a.track_count", "artwork_color", "art.name", "tracksDuration"})
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor, album.Artist, album.TracksDuration)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"name"}, "art")+", SUM(t.duration) AS tracksDuration"+
-					`
-		FROM albums alb
-		JOIN artists art ON art.id = alb.artist
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.title ILIKE $1
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY year DESC
-		LIMIT $2
-		`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchAlbumsAmount)).WillReturnError(errors.New("error"))
-			},
-			expected: func() []*proto.Album {
-				albums := make([]*proto.Album, 0, constants.SearchAlbumsAmount)
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					albums = append(albums, album)
-				}
-				return albums
-			}(),
-			expectedError: true,
-		},
-		{
-			name: "scan returns error",
-			mock: func() {
-				var newArg = 1
-				rows := sqlmock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "a.track_count", "artwork_color", "art.name", "tracksDuration", "newArg"})
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor, album.Artist, album.TracksDuration, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"name"}, "art")+", SUM(t.duration) AS tracksDuration"+
-					`
-		FROM albums alb
-		JOIN artists art ON art.id = alb.artist
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.title ILIKE $1
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY year DESC
-		LIMIT $2
-		`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchAlbumsAmount)).WillReturnRows(rows)
-			},
-			expected: func() []*proto.Album {
-				albums := make([]*proto.Album, 0, constants.SearchAlbumsAmount)
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					albums = append(albums, album)
-				}
-				return albums
-			}(),
-			expectedError: true,
-		},
-		{
-			name: "rows returns error",
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"a.id", "a.title", "a.year", "a.artwork", "a.track_count", "artwork_color", "art.name", "tracksDuration"}).RowError(1, errors.New("error"))
-				for i := 0; i < 4; i++ {
-					rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor, album.Artist, album.TracksDuration)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "year", "artwork", "track_count", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"name"}, "art")+", SUM(t.duration) AS tracksDuration"+
-					`
-		FROM albums alb
-		JOIN artists art ON art.id = alb.artist
-		JOIN tracks t ON alb.id = t.album
-		WHERE alb.title ILIKE $1
-		GROUP BY alb.id, alb.title, alb.year, art.name, alb.artwork, alb.track_count
-		ORDER BY year DESC
-		LIMIT $2
-		`)).WithArgs(driver.Value(textArgument), driver.Value(constants.SearchAlbumsAmount)).WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT rebuild_track_search()`)).WillReturnError(errors.New("error"))
 			},
-			expected: func() []*proto.Album {
-				albums := make([]*proto.Album, 0, constants.SearchAlbumsAmount)
-				for i := 0; i < constants.SearchAlbumsAmount; i++ {
-					albums = append(albums, album)
-				}
-				return albums
-			}(),
 			expectedError: true,
 		},
 	}
@@ -2426,299 +5299,302 @@ func TestMusicStorage_FindAlbums(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.FindAlbums(text)
+			result, err := repository.RebuildSearchIndex()
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_IsPlaylistOwner(t *testing.T) {
+func TestMusicStorage_RadioSeed(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	var (
-		playlistID int64
-		userID     int64
+	const (
+		seedID = 3
+		userID = 1
 	)
+	trackQuery := `SELECT ARRAY[artist], array_remove(ARRAY[genre], NULL) FROM tracks WHERE id = $1`
+	playlistQuery := `FROM playlists WHERE id = $1 AND (is_public OR user_id = $2)`
 
 	tests := []struct {
 		name          string
-		isOwner       bool
+		seedType      string
 		mock          func()
-		expected      bool
+		expected      *proto.RadioSession
 		expectedError bool
 	}{
 		{
-			name:    "user is owner",
-			isOwner: true,
+			name:     "track seed",
+			seedType: constants.RadioSeedTrack,
 			mock: func() {
-				rows := mock.NewRows([]string{"isOwner"})
-				rows.AddRow("true")
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND user_id=$2`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"artists", "genres"}).AddRow("{2}", "{5}")
+				mock.ExpectQuery(regexp.QuoteMeta(trackQuery)).WithArgs(driver.Value(seedID)).WillReturnRows(rows)
 			},
-			expected: true,
+			expected: &proto.RadioSession{ArtistIDs: []int64{2}, GenreIDs: []int64{5}},
 		},
 		{
-			name:    "user is not owner",
-			isOwner: true,
+			name:     "track seed without genre",
+			seedType: constants.RadioSeedTrack,
 			mock: func() {
-				rows := mock.NewRows([]string{"isOwner"})
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND user_id=$2`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"artists", "genres"}).AddRow("{2}", "{}")
+				mock.ExpectQuery(regexp.QuoteMeta(trackQuery)).WithArgs(driver.Value(seedID)).WillReturnRows(rows)
 			},
+			expected: &proto.RadioSession{ArtistIDs: []int64{2}, GenreIDs: []int64{}},
 		},
 		{
-			name:    "query returns error",
-			isOwner: true,
+			name:     "playlist seed is checked against user",
+			seedType: constants.RadioSeedPlaylist,
 			mock: func() {
-				rows := mock.NewRows([]string{"isOwner"})
-				rows.AddRow("true")
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND user_id=$2`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnError(errors.New("error"))
+				rows := sqlmock.NewRows([]string{"artists", "genres"}).AddRow("{2,4}", "{5}")
+				mock.ExpectQuery(regexp.QuoteMeta(playlistQuery)).WithArgs(driver.Value(seedID), driver.Value(userID)).WillReturnRows(rows)
+			},
+			expected: &proto.RadioSession{ArtistIDs: []int64{2, 4}, GenreIDs: []int64{5}},
+		},
+		{
+			name:     "seed not found",
+			seedType: constants.RadioSeedTrack,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(trackQuery)).WithArgs(driver.Value(seedID)).WillReturnError(sql.ErrNoRows)
 			},
 			expectedError: true,
 		},
+		{
+			name:          "unknown seed type",
+			seedType:      "genre",
+			mock:          func() {},
+			expectedError: true,
+		},
 	}
 
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.IsPlaylistOwner(playlistID, userID)
+			result, err := repository.RadioSeed(currentTest.seedType, seedID, userID)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, currentTest.expected, result)
+				assert.True(t, protobuf.Equal(currentTest.expected, result))
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_PlaylistTracks(t *testing.T) {
+func TestMusicStorage_RadioTracks(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
-
-	const userID = 1
+	repository := NewMusicStorage(db, nil, testLinks)
 
+	const (
+		userID = 1
+		amount = 3
+	)
 	track := &proto.Track{
 		ID:          1,
 		Title:       "testTrackTitle",
-		Explicit:    true,
 		Genre:       "testGenre",
-		Number:      2,
-		File:        "testFile",
+		File:        testLinks.TrackStream(1, userID),
 		ListenCount: 3,
 		Duration:    4,
-		Lossless:    true,
-		Album: &proto.Album{
-			ID:           5,
-			Title:        "testAlbumTitle",
-			Artwork:      "testArtWork",
-			ArtworkColor: "testArtWorkColor",
+		Album:       &proto.Album{ID: 5, Title: "testAlbumTitle", Artwork: "testAlbumArtwork", ArtworkColor: "testArtworkColor"},
+		Artist:      &proto.Artist{ID: 6, Name: "testArtistName"},
+	}
+	columns := []string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "has_lyrics",
+		"alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}
+	familiarQuery := `WHERE NOT t.id = ANY($2) AND NOT EXISTS(SELECT 1 FROM dislikes d WHERE d.user_id = $1 AND d.track_id = t.id)` +
+		` AND (t.artist = ANY($3) OR t.genre = ANY($4))` +
+		` AND (l.id IS NOT NULL OR EXISTS(SELECT 1 FROM listens ls WHERE ls.user_id = $1 AND ls.track_id = t.id))
+		ORDER BY RANDOM() * CASE WHEN t.artist = ANY($3) THEN 2 ELSE 1 END DESC LIMIT $5`
+	fillerQuery := `WHERE NOT t.id = ANY($2) AND NOT EXISTS(SELECT 1 FROM dislikes d WHERE d.user_id = $1 AND d.track_id = t.id)` +
+		` AND t.id = ANY($3::integer[])
+		ORDER BY array_position($3::integer[], t.id) LIMIT $4`
+
+	tests := []struct {
+		name          string
+		artistIDs     []int64
+		genreIDs      []int64
+		pool          string
+		exclude       []int64
+		candidates    []int64
+		mock          func()
+		expected      []*proto.Track
+		expectedError bool
+	}{
+		{
+			name:      "familiar tracks of seed artists",
+			artistIDs: []int64{6},
+			genreIDs:  []int64{2},
+			pool:      constants.RadioPoolFamiliar,
+			exclude:   []int64{7, 8},
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow(track.ID, track.Title, track.Explicit, track.Number, "testFile", track.ListenCount,
+					track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title, track.Album.Artwork,
+					track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				mock.ExpectQuery(regexp.QuoteMeta(familiarQuery)).
+					WithArgs(driver.Value(userID), driver.Value("{7,8}"), driver.Value("{6}"), driver.Value("{2}"), driver.Value(amount)).
+					WillReturnRows(rows)
+			},
+			expected: []*proto.Track{track},
 		},
-		Artist: &proto.Artist{
-			ID:   6,
-			Name: "testArtistName",
+		{
+			name:       "filler from random candidates",
+			candidates: []int64{9, 4, 1},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(fillerQuery)).
+					WithArgs(driver.Value(userID), driver.Value("{}"), driver.Value("{9,4,1}"), driver.Value(amount)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expected: []*proto.Track{},
 		},
-		IsInFavorites: true,
+		{
+			name:       "query returns error",
+			candidates: []int64{9, 4, 1},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(fillerQuery)).
+					WithArgs(driver.Value(userID), driver.Value("{}"), driver.Value("{9,4,1}"), driver.Value(amount)).
+					WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.RadioTracks(userID, currentTest.artistIDs, currentTest.genreIDs, currentTest.pool,
+				currentTest.exclude, currentTest.candidates, amount)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(currentTest.expected), len(result))
+				for i := range currentTest.expected {
+					assert.True(t, protobuf.Equal(currentTest.expected[i], result[i]))
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
 	}
-	trackWithoutFile := new(proto.Track)
-	_ = copier.Copy(trackWithoutFile, track)
-	trackWithoutFile.File = ""
+}
 
-	var playlistID int64 = 1
+func TestMusicStorage_RadioSession(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
+
+	const key = "radio:token"
+	session := &proto.RadioSession{UserID: 1, SeedType: constants.RadioSeedArtist, SeedID: 2, ArtistIDs: []int64{2}, Played: []int64{4, 5}}
+	data, _ := protobuf.Marshal(session)
 
 	tests := []struct {
 		name          string
-		isAuthorized  bool
 		mock          func()
-		expected      []*proto.Track
+		expected      *proto.RadioSession
+		expectedFound bool
 		expectedError bool
 	}{
 		{
-			name:         "get playlist tracks",
-			isAuthorized: true,
+			name: "session found",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-			FROM playlist_tracks
-			WHERE playlist=$2
-		)`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+				mock.ExpectGet(key).SetVal(string(data))
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
+			expected:      session,
+			expectedFound: true,
 		},
 		{
-			name:         "query returns error",
-			isAuthorized: true,
+			name: "session expired",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-			FROM playlist_tracks
-			WHERE playlist=$2
-		)`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnError(errors.New("error"))
+				mock.ExpectGet(key).RedisNil()
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
-			expectedError: true,
 		},
 		{
-			name:         "scan returns error",
-			isAuthorized: true,
+			name: "redis returns error",
 			mock: func() {
-				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-			FROM playlist_tracks
-			WHERE playlist=$2
-		)`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+				mock.ExpectGet(key).SetErr(errors.New("error"))
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
 			expectedError: true,
 		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, found, err := repository.RadioSession(key)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedFound, found)
+				if currentTest.expectedFound {
+					assert.True(t, protobuf.Equal(currentTest.expected, result))
+				}
+			}
+		})
+	}
+}
+
+func TestMusicStorage_ReleaseRadar(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewMusicStorage(db, nil, testLinks)
+
+	const amount = 10
+	now := time.Date(2021, time.December, 9, 15, 30, 0, 0, time.UTC)
+	album := &proto.Album{
+		ID:           1,
+		Title:        "testTitle",
+		Year:         2021,
+		Artwork:      "testArtwork",
+		TracksAmount: 10,
+		ArtworkColor: "testArtworkColor",
+		Artist:       "testArtist",
+		ReleaseDate:  "2021-12-03",
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Album
+		expectedError bool
+	}{
 		{
-			name: "find tracks unauthorized",
+			name: "get recent releases",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, "", track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-			FROM playlist_tracks
-			WHERE playlist=$2
-		)`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+				rows := sqlmock.NewRows([]string{"alb.id", "alb.title", "alb.year", "alb.artwork", "alb.track_count",
+					"alb.artwork_color", "art.name", "release_date"})
+				rows.AddRow(album.ID, album.Title, album.Year, album.Artwork, album.TracksAmount, album.ArtworkColor,
+					album.Artist, album.ReleaseDate)
+				mock.ExpectQuery(regexp.QuoteMeta(`WHERE alb.release_date >= $4 AND alb.release_date <= $5`)).
+					WithArgs(1, now.Add(-constants.ReleaseRadarListensPeriod), constants.ReleaseRadarMinListens,
+						"2021-11-11", "2021-12-09", amount).
+					WillReturnRows(rows)
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, trackWithoutFile)
-				}
-				return tracks
-			}(),
+			expected: []*proto.Album{album},
 		},
 		{
-			name:         "rows.Err() returns error",
-			isAuthorized: true,
+			name: "query returns error",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}).RowError(1, errors.New("error"))
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT `+
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t")+", "+
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb")+", "+
-					wrapper.Wrapper([]string{"id", "name"}, "art")+", "+
-					wrapper.Wrapper([]string{"name"}, "g")+", "+
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		LEFT JOIN likes l on t.id = l.track_id and l.user_id = $1
-		WHERE t.id IN (
-			SELECT track
-			FROM playlist_tracks
-			WHERE playlist=$2
-		)`)).WithArgs(driver.Value(userID), driver.Value(playlistID)).WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM albums alb`)).WillReturnError(errors.New("error"))
 			},
-			expected: func() []*proto.Track {
-				tracks := make([]*proto.Track, 0, constants.SearchTracksAmount)
-				for i := 0; i < constants.SearchTracksAmount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
 			expectedError: true,
 		},
 	}
@@ -2727,66 +5603,53 @@ func TestMusicStorage_PlaylistTracks(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.PlaylistTracks(playlistID, userID)
+			result, err := repository.ReleaseRadar(1, now, amount)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_PlaylistInfo(t *testing.T) {
+func TestMusicStorage_ReleaseRadarPlaylist(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
-
-	playlist := &proto.PlaylistData{
-		PlaylistID:   1,
-		Title:        "testTitle",
-		Artwork:      "testArtWork",
-		ArtworkColor: "testArtWorkColor",
-		IsPublic:     true,
-	}
-
-	expectedPlaylist := &proto.PlaylistData{
-		PlaylistID:   1,
-		Title:        "testTitle",
-		Artwork:      os.Getenv("PLAYLIST_ROOT_PREFIX") + "testArtWork" + constants.PlaylistArtworkExtension384px,
-		ArtworkColor: "testArtWorkColor",
-		IsPublic:     true,
-	}
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	var playlistID int64 = 1
+	query := `SELECT COALESCE(playlist_id, 0) FROM release_radar_playlists WHERE user_id = $1`
 
 	tests := []struct {
 		name          string
 		mock          func()
-		expected      *proto.PlaylistData
+		expected      int64
 		expectedError bool
 	}{
 		{
-			name: "get playlist info",
+			name: "playlist found",
 			mock: func() {
-				row := mock.NewRows([]string{"playlistID", "title", "artwork", "artworkColor", "isPublic"})
-				row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.ArtworkColor, playlist.IsPublic)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, artwork_color, is_public FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"playlist_id"}).AddRow(5))
+			},
+			expected: 5,
+		},
+		{
+			name: "playlist was not built yet",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1).WillReturnError(sql.ErrNoRows)
 			},
-			expected: expectedPlaylist,
 		},
 		{
 			name: "query returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"playlistID", "title", "artwork", "artworkColor", "isPublic"})
-				row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.ArtworkColor, playlist.IsPublic)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, artwork_color, is_public FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnError(errors.New("error"))
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1).WillReturnError(errors.New("error"))
 			},
-			expected:      expectedPlaylist,
 			expectedError: true,
 		},
 	}
@@ -2795,123 +5658,108 @@ func TestMusicStorage_PlaylistInfo(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.PlaylistInfo(playlistID)
+			result, err := repository.ReleaseRadarPlaylist(1)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_UserPlaylists(t *testing.T) {
+func TestMusicStorage_SaveReleaseRadarPlaylist(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
-
-	playlist := &proto.PlaylistData{
-		PlaylistID: 1,
-		Title:      "testTitle",
-		Artwork:    "testArtWork",
-		IsPublic:   true,
-		IsOwn:      true,
-	}
-
-	expectedPlaylist := &proto.PlaylistData{
-		PlaylistID: 1,
-		Title:      "testTitle",
-		Artwork:    os.Getenv("PLAYLIST_ROOT_PREFIX") + "testArtWork" + constants.PlaylistArtworkExtension100px,
-		IsPublic:   true,
-		IsOwn:      true,
-	}
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	var (
-		userID          int64 = 1
-		playlistsAmount       = 4
-	)
+	weekStart := time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)
+	trackIDs := []int64{10, 11}
+	selectQuery := `SELECT playlist_id FROM release_radar_playlists WHERE user_id = $1 FOR UPDATE`
+	insertTracksQuery := `INSERT INTO playlist_tracks(playlist, track)`
+	upsertQuery := `INSERT INTO release_radar_playlists(user_id, playlist_id, week_start) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET playlist_id = EXCLUDED.playlist_id, week_start = EXCLUDED.week_start`
 
 	tests := []struct {
 		name          string
+		trackIDs      []int64
 		mock          func()
-		expected      []*proto.PlaylistData
 		expectedError bool
 	}{
 		{
-			name: "get playlist info",
+			name:     "first playlist is created for system user",
+			trackIDs: trackIDs,
 			mock: func() {
-				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own"})
-				for i := 0; i < playlistsAmount; i++ {
-					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own FROM playlists WHERE user_id=$1 OR is_public=true`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO playlists(title, user_id) VALUES ($1, $2) RETURNING id`)).
+					WithArgs(constants.ReleaseRadarPlaylistTitle, constants.SystemUserID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(upsertQuery)).
+					WithArgs(1, 5, "2021-12-06").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM playlist_tracks WHERE playlist = $1`)).
+					WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(insertTracksQuery)).
+					WithArgs(5, pq.Array(trackIDs)).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
 			},
-			expected: func() []*proto.PlaylistData {
-				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
-				for i := 0; i < playlistsAmount; i++ {
-					playlists = append(playlists, expectedPlaylist)
-				}
-				return playlists
-			}(),
 		},
 		{
-			name: "query returns error",
+			name:     "existing playlist is refilled",
+			trackIDs: trackIDs,
 			mock: func() {
-				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own"})
-				for i := 0; i < playlistsAmount; i++ {
-					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own FROM playlists WHERE user_id=$1 OR is_public=true`)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"playlist_id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(upsertQuery)).
+					WithArgs(1, 5, "2021-12-06").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM playlist_tracks WHERE playlist = $1`)).
+					WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(regexp.QuoteMeta(insertTracksQuery)).
+					WithArgs(5, pq.Array(trackIDs)).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
 			},
-			expected: func() []*proto.PlaylistData {
-				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
-				for i := 0; i < playlistsAmount; i++ {
-					playlists = append(playlists, expectedPlaylist)
-				}
-				return playlists
-			}(),
-			expectedError: true,
 		},
 		{
-			name: "scan returns error",
+			name:     "week without new tracks is recorded without playlist",
+			trackIDs: []int64{},
 			mock: func() {
-				newArg := 1
-				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own", "newArg"})
-				for i := 0; i < playlistsAmount; i++ {
-					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own FROM playlists WHERE user_id=$1 OR is_public=true`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				mock.ExpectExec(regexp.QuoteMeta(upsertQuery)).
+					WithArgs(1, nil, "2021-12-06").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
 			},
-			expected: func() []*proto.PlaylistData {
-				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
-				for i := 0; i < playlistsAmount; i++ {
-					playlists = append(playlists, expectedPlaylist)
-				}
-				return playlists
-			}(),
-			expectedError: true,
 		},
 		{
-			name: "rows.Err() returns error",
+			name:     "existing playlist is emptied",
+			trackIDs: []int64{},
 			mock: func() {
-				row := mock.NewRows([]string{"playlistID", "title", "artwork", "isPublic", "is_own"}).RowError(1, errors.New("error"))
-				for i := 0; i < playlistsAmount; i++ {
-					row.AddRow(playlist.PlaylistID, playlist.Title, playlist.Artwork, playlist.IsPublic, playlist.IsOwn)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, title, artwork, is_public, user_id=$1 AS is_own FROM playlists WHERE user_id=$1 OR is_public=true`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"playlist_id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(upsertQuery)).
+					WithArgs(1, 5, "2021-12-06").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM playlist_tracks WHERE playlist = $1`)).
+					WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(regexp.QuoteMeta(insertTracksQuery)).
+					WithArgs(5, pq.Array([]int64{})).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "query returns error",
+			trackIDs: trackIDs,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).WithArgs(1).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
 			},
-			expected: func() []*proto.PlaylistData {
-				playlists := make([]*proto.PlaylistData, 0, playlistsAmount)
-				for i := 0; i < playlistsAmount; i++ {
-					playlists = append(playlists, expectedPlaylist)
-				}
-				return playlists
-			}(),
 			expectedError: true,
 		},
 	}
@@ -2920,55 +5768,72 @@ func TestMusicStorage_UserPlaylists(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.UserPlaylists(userID)
+			err := repository.SaveReleaseRadarPlaylist(1, weekStart, currentTest.trackIDs)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_DoesPlaylistExist(t *testing.T) {
+func TestMusicStorage_RebuildRecaps(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	const playlistID int64 = 1
+	start := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
 
 	tests := []struct {
 		name          string
 		mock          func()
-		expected      bool
 		expectedError bool
 	}{
 		{
-			name: "playlist exist",
+			name: "rebuild recaps",
 			mock: func() {
-				row := mock.NewRows([]string{"playlist_exist"})
-				row.AddRow("true")
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM listening_recaps WHERE year = $1`)).
+					WithArgs(2021).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM listening_recap_tops WHERE year = $1`)).
+					WithArgs(2021).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO listening_recaps`)).
+					WithArgs(2021, start, end).WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO listening_recap_tops`)).
+					WithArgs(2021, constants.ChartEntityTracks, start, end, constants.RecapTopTracksAmount).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				for _, entity := range []string{constants.ChartEntityAlbums, constants.ChartEntityArtists, constants.RecapEntityGenres} {
+					mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO listening_recap_tops`)).
+						WithArgs(2021, entity, start, end, constants.RecapTopAmount).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+				mock.ExpectCommit()
 			},
-			expected: true,
 		},
 		{
-			name: "playlist does not exist",
+			name: "insert returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"playlist_exist"})
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM listening_recaps WHERE year = $1`)).
+					WithArgs(2021).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM listening_recap_tops WHERE year = $1`)).
+					WithArgs(2021).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO listening_recaps`)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
 			},
+			expectedError: true,
 		},
 		{
-			name: "query returns error",
+			name: "begin returns error",
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1`)).WithArgs(driver.Value(playlistID)).WillReturnError(errors.New("error"))
+				mock.ExpectBegin().WillReturnError(errors.New("error"))
 			},
-			expected:      false,
 			expectedError: true,
 		},
 	}
@@ -2977,45 +5842,58 @@ func TestMusicStorage_DoesPlaylistExist(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.DoesPlaylistExist(playlistID)
+			err := repository.RebuildRecaps(2021, start, end)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_AddTrackToFavorite(t *testing.T) {
+func TestMusicStorage_Recap(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	const (
-		userID = iota
-		trackID
-	)
+	builtAt := time.Date(2021, time.December, 31, 12, 0, 0, 0, time.UTC)
+	recap := &proto.RecapResponse{
+		Year:                 2021,
+		MinutesListened:      1500,
+		Listens:              400,
+		LongestStreak:        12,
+		LongestStreakStart:   "2021-03-01",
+		MostPlayedDay:        "2021-03-05",
+		MostPlayedDayListens: 40,
+		BuiltAt:              builtAt.Unix(),
+	}
 
 	tests := []struct {
 		name          string
 		mock          func()
+		expected      *proto.RecapResponse
 		expectedError bool
 	}{
 		{
-			name: "successful adding track to favorites",
+			name: "recap found",
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO likes(user_id, track_id) VALUES ($1, $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnRows()
+				rows := sqlmock.NewRows([]string{"minutes_listened", "listens", "longest_streak", "longest_streak_start",
+					"most_played_day", "most_played_day_listens", "built_at"})
+				rows.AddRow(recap.MinutesListened, recap.Listens, recap.LongestStreak, recap.LongestStreakStart,
+					recap.MostPlayedDay, recap.MostPlayedDayListens, builtAt)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listening_recaps`)).WithArgs(1, 2021).WillReturnRows(rows)
 			},
+			expected: recap,
 		},
 		{
-			name: "failed adding track to favorites",
+			name: "recap was not built",
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO likes(user_id, track_id) VALUES ($1, $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnError(errors.New("error"))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listening_recaps`)).WithArgs(1, 2021).WillReturnError(sql.ErrNoRows)
 			},
 			expectedError: true,
 		},
@@ -3025,44 +5903,66 @@ func TestMusicStorage_AddTrackToFavorite(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			err := repository.AddTrackToFavorite(userID, trackID)
+			result, err := repository.Recap(1, 2021)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_DeleteTrackFromFavorites(t *testing.T) {
+func TestMusicStorage_RecapTopTracks(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	const (
-		userID = iota
-		trackID
-	)
+	recapTrack := &proto.RecapTrack{
+		Position: 1,
+		Plays:    100,
+		Track: &proto.Track{
+			ID:          1,
+			Title:       "testTitle",
+			Genre:       "testGenre",
+			File:        testLinks.TrackStream(1, 1),
+			ListenCount: 3,
+			Duration:    4,
+			Album:       &proto.Album{ID: 5, Title: "testAlbumTitle", Artwork: "testAlbumArtwork", ArtworkColor: "testArtworkColor"},
+			Artist:      &proto.Artist{ID: 6, Name: "testArtistName"},
+		},
+	}
+	columns := []string{"position", "plays", "t.id", "t.title", "t.explicit", "number", "t.file", "t.listen_count", "t.duration",
+		"t.lossless", "t.has_lyrics", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}
 
 	tests := []struct {
 		name          string
 		mock          func()
+		expected      []*proto.RecapTrack
 		expectedError bool
 	}{
 		{
-			name: "successful deleting track from favorites",
+			name: "tracks without number are listed with zero",
 			mock: func() {
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM likes WHERE user_id = $1 and track_id = $2`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnResult(driver.ResultNoRows)
+				track := recapTrack.Track
+				rows := sqlmock.NewRows(columns).AddRow(recapTrack.Position, recapTrack.Plays, track.ID, track.Title, track.Explicit,
+					0, "testFile", track.ListenCount, track.Duration, track.Lossless, track.HasLyrics, track.Album.ID, track.Album.Title,
+					track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
+				mock.ExpectQuery(regexp.QuoteMeta(`COALESCE(t.number, 0)`)).
+					WithArgs(1, 2021, constants.ChartEntityTracks, constants.RecapTopTracksAmount).
+					WillReturnRows(rows)
 			},
+			expected: []*proto.RecapTrack{recapTrack},
 		},
 		{
-			name: "failed deleting track from favorites",
+			name: "query returns error",
 			mock: func() {
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM likes WHERE user_id = $1 and track_id = $2`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnError(errors.New("error"))
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listening_recap_tops r`)).WillReturnError(errors.New("error"))
 			},
 			expectedError: true,
 		},
@@ -3072,57 +5972,118 @@ func TestMusicStorage_DeleteTrackFromFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			err := repository.DeleteTrackFromFavorites(userID, trackID)
+			result, err := repository.RecapTopTracks(1, 2021, constants.RecapTopTracksAmount)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, len(currentTest.expected), len(result))
+				for i := range currentTest.expected {
+					assert.True(t, protobuf.Equal(currentTest.expected[i], result[i]))
+				}
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_IsTrackInFavorites(t *testing.T) {
+func TestMusicStorage_RecapTopAlbums(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	repository := NewMusicStorage(db, nil, testLinks)
 
-	const (
-		userID = iota
-		trackID
-	)
+	recapAlbum := &proto.RecapAlbum{
+		Position: 1,
+		Plays:    100,
+		Album: &proto.Album{
+			ID:           1,
+			Title:        "testTitle",
+			Year:         2021,
+			Artwork:      "testArtwork",
+			TracksAmount: 10,
+			ArtworkColor: "testArtworkColor",
+			Artist:       "testArtist",
+		},
+	}
 
 	tests := []struct {
-		name              string
-		mock              func()
-		expectedExistence bool
-		expectedError     bool
+		name          string
+		mock          func()
+		expected      []*proto.RecapAlbum
+		expectedError bool
 	}{
 		{
-			name: "track in favorites",
+			name: "get recap albums",
 			mock: func() {
-				row := mock.NewRows([]string{"exist"})
-				row.AddRow(true)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM likes WHERE user_id = $1 AND track_id = $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnRows(row)
+				rows := sqlmock.NewRows([]string{"position", "plays", "alb.id", "alb.title", "alb.year",
+					"alb.artwork", "alb.track_count", "alb.artwork_color", "art.name"})
+				rows.AddRow(recapAlbum.Position, recapAlbum.Plays, recapAlbum.Album.ID, recapAlbum.Album.Title,
+					recapAlbum.Album.Year, recapAlbum.Album.Artwork, recapAlbum.Album.TracksAmount,
+					recapAlbum.Album.ArtworkColor, recapAlbum.Album.Artist)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listening_recap_tops r`)).
+					WithArgs(1, 2021, constants.ChartEntityAlbums, constants.RecapTopAmount).
+					WillReturnRows(rows)
 			},
-			expectedExistence: true,
+			expected: []*proto.RecapAlbum{recapAlbum},
 		},
 		{
-			name: "track not in favorites",
+			name: "query returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"exist"})
-				row.AddRow(false)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM likes WHERE user_id = $1 AND track_id = $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnRows(row)
+				mock.ExpectQuery(regexp.QuoteMeta(`FROM listening_recap_tops r`)).WillReturnError(errors.New("error"))
 			},
+			expectedError: true,
 		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.RecapTopAlbums(1, 2021, constants.RecapTopAmount)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+		})
+	}
+}
+
+func TestMusicStorage_RandomPoolIDs(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		positions     []int64
+		expected      []int64
+		expectedError bool
+	}{
 		{
-			name: "query error",
+			name: "ids by positions",
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM likes WHERE user_id = $1 AND track_id = $2)`)).WithArgs(driver.Value(userID), driver.Value(trackID)).WillReturnError(errors.New("error"))
+				mock.ExpectHMGet("random:tracks:2", "3", "0", "7").SetVal([]interface{}{"12", "5", nil})
 			},
+			positions: []int64{3, 0, 7},
+			expected:  []int64{12, 5},
+		},
+		{
+			name:      "no positions",
+			mock:      func() {},
+			positions: []int64{},
+			expected:  []int64{},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectHMGet("random:tracks:2", "1").SetErr(errors.New("error"))
+			},
+			positions:     []int64{1},
 			expectedError: true,
 		},
 	}
@@ -3131,189 +6092,59 @@ func TestMusicStorage_IsTrackInFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			isExist, err := repository.IsTrackInFavorites(userID, trackID)
+			result, err := repository.RandomPoolIDs(constants.ChartEntityTracks, 2, currentTest.positions)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, currentTest.expectedExistence, isExist)
+				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-//nolint:cyclop
-func TestMusicStorage_GetFavorites(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-		return
-	}
-	repository := NewMusicStorage(db)
+func TestMusicStorage_RandomPoolSize(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
 
-	const userID = 1
+	mock.ExpectHLen("random:albums:3").SetVal(42)
 
-	track := &proto.Track{
-		ID:          1,
-		Title:       "testTrackTitle",
-		Explicit:    true,
-		Genre:       "testGenre",
-		Number:      2,
-		File:        "testFile",
-		ListenCount: 3,
-		Duration:    4,
-		Lossless:    true,
-		Album: &proto.Album{
-			ID:           5,
-			Title:        "testAlbumTitle",
-			Artwork:      "testAlbumArtwork",
-			ArtworkColor: "testArtWOrkColor",
-		},
-		Artist: &proto.Artist{
-			ID:   6,
-			Name: "testArtistName",
-		},
-		IsInFavorites: true,
-	}
+	size, err := repository.RandomPoolSize(constants.ChartEntityAlbums, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), size)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMusicStorage_RandomPoolGeneration(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewMusicStorage(nil, redisDB, testLinks)
 
 	tests := []struct {
 		name          string
-		amount        int64
-		isAuthorized  bool
 		mock          func()
-		expected      []*proto.Track
+		expected      int64
 		expectedError bool
 	}{
 		{
-			name:         "successful getting favorites",
-			amount:       4,
-			isAuthorized: true,
-			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ` +
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
-					wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
-					wrapper.Wrapper([]string{"name"}, "g") + ", " +
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		JOIN likes l on t.id = l.track_id and l.user_id = $1
-        ORDER BY l.id`)).WithArgs(driver.Value(userID)).WillReturnRows(rows)
-			},
-			expected: func() []*proto.Track {
-				var amount = 4
-				tracks := make([]*proto.Track, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
-		},
-		{
-			name:         "query returns error",
-			amount:       1,
-			isAuthorized: true,
+			name: "current generation",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"})
-				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ` +
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
-					wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
-					wrapper.Wrapper([]string{"name"}, "g") + ", " +
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		JOIN likes l on t.id = l.track_id and l.user_id = $1
-        ORDER BY l.id`)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
+				mock.ExpectGet("random:tracks:generation").SetVal("4")
 			},
-			expected: func() []*proto.Track {
-				var amount = 1
-				tracks := make([]*proto.Track, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
-			expectedError: true,
+			expected: 4,
 		},
 		{
-			name:         "scan returns error",
-			amount:       1,
-			isAuthorized: true,
+			name: "pool was never built",
 			mock: func() {
-				var newArg = 1
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite", "newArg"})
-				for i := 0; i < 1; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites, newArg)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ` +
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
-					wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
-					wrapper.Wrapper([]string{"name"}, "g") + ", " +
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		JOIN likes l on t.id = l.track_id and l.user_id = $1
-        ORDER BY l.id`)).WithArgs(driver.Value(userID)).WillReturnRows(rows)
+				mock.ExpectGet("random:tracks:generation").RedisNil()
 			},
-			expected: func() []*proto.Track {
-				var amount = 1
-				tracks := make([]*proto.Track, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
-			expectedError: true,
+			expected: 0,
 		},
 		{
-			name:         "rows.Err() returns error",
-			amount:       4,
-			isAuthorized: true,
+			name: "redis returns error",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"tracks.id", "tracks.title", "explicit", "number", "file", "listen_count", "duration", "lossless", "alb.id", "alb.title", "alb.artwork", "alb.artwork_color", "art.id", "art.name", "g.name", "favorite"}).RowError(0, errors.New("error"))
-				for i := 0; i < 4; i++ {
-					rows.AddRow(track.ID, track.Title, track.Explicit, track.Number, track.File, track.ListenCount, track.Duration, track.Lossless, track.Album.ID, track.Album.Title, track.Album.Artwork, track.Album.ArtworkColor, track.Artist.ID, track.Artist.Name, track.Genre, track.IsInFavorites)
-				}
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT ` +
-					wrapper.Wrapper([]string{"id", "title", "explicit", "number", "file", "listen_count", "duration", "lossless"}, "t") + ", " +
-					wrapper.Wrapper([]string{"id", "title", "artwork", "artwork_color"}, "alb") + ", " +
-					wrapper.Wrapper([]string{"id", "name"}, "art") + ", " +
-					wrapper.Wrapper([]string{"name"}, "g") + ", " +
-					`
-		l.id IS NOT NULL as favorite
-		FROM tracks t
-		JOIN genres g ON t.genre = g.id
-		JOIN albums alb ON t.album = alb.id
-		JOIN artists art ON t.artist = art.id
-		JOIN likes l on t.id = l.track_id and l.user_id = $1
-        ORDER BY l.id`)).WithArgs(driver.Value(userID)).WillReturnRows(rows)
+				mock.ExpectGet("random:tracks:generation").SetErr(errors.New("error"))
 			},
-			expected: func() []*proto.Track {
-				var amount = 4
-				tracks := make([]*proto.Track, 0, amount)
-				for i := 0; i < amount; i++ {
-					tracks = append(tracks, track)
-				}
-				return tracks
-			}(),
 			expectedError: true,
 		},
 	}
@@ -3322,62 +6153,74 @@ func TestMusicStorage_GetFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			result, err := repository.GetFavorites(userID)
+			result, err := repository.RandomPoolGeneration(constants.ChartEntityTracks)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, result)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
 
-func TestMusicStorage_IsPlaylistPublic(t *testing.T) {
+func TestMusicStorage_RebuildRandomPool(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 		return
 	}
-	repository := NewMusicStorage(db)
+	redisDB, redisMock := redismock.NewClientMock()
+	repository := NewMusicStorage(db, redisDB, testLinks)
 
-	const playlistID = 1
+	query := `SELECT id FROM artists`
 
 	tests := []struct {
-		name              string
-		mock              func()
-		expectedPublicity bool
-		expectedError     bool
+		name          string
+		mock          func()
+		expected      int64
+		expectedError bool
 	}{
 		{
-			name: "playlist is public",
+			name: "new generation becomes current",
 			mock: func() {
-				row := mock.NewRows([]string{"public"})
-				row.AddRow(true)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+				redisMock.ExpectIncr("random:artists:counter").SetVal(3)
+				redisMock.ExpectTxPipeline()
+				redisMock.ExpectHSet("random:artists:3", 0, int64(5)).SetVal(1)
+				redisMock.ExpectExpire("random:artists:3", constants.RandomPoolLifetime).SetVal(true)
+				redisMock.ExpectSet("random:artists:generation", int64(3), 0).SetVal("OK")
+				redisMock.ExpectTxPipelineExec()
 			},
-			expectedPublicity: true,
+			expected: 3,
 		},
 		{
-			name: "playlist is not public",
+			name: "empty generation",
 			mock: func() {
-				row := mock.NewRows([]string{"public"})
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				redisMock.ExpectIncr("random:artists:counter").SetVal(4)
+				redisMock.ExpectTxPipeline()
+				redisMock.ExpectExpire("random:artists:4", constants.RandomPoolLifetime).SetVal(false)
+				redisMock.ExpectSet("random:artists:generation", int64(4), 0).SetVal("OK")
+				redisMock.ExpectTxPipelineExec()
 			},
+			expected: 4,
 		},
 		{
-			name: "query error",
+			name: "query returns error",
 			mock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnError(errors.New("error"))
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(errors.New("error"))
 			},
 			expectedError: true,
 		},
 		{
-			name: "row.Err() returns error",
+			name: "counter returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"public"}).RowError(0, errors.New("error"))
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM playlists WHERE id=$1 AND is_public=true`)).WithArgs(driver.Value(playlistID)).WillReturnRows(row)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+				redisMock.ExpectIncr("random:artists:counter").SetErr(errors.New("error"))
 			},
+			expectedError: true,
 		},
 	}
 
@@ -3385,13 +6228,15 @@ func TestMusicStorage_IsPlaylistPublic(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			currentTest.mock()
-			isPublic, err := repository.IsPlaylistPublic(playlistID)
+			generation, err := repository.RebuildRandomPool(constants.ChartEntityArtists)
 			if currentTest.expectedError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, currentTest.expectedPublicity, isPublic)
+				assert.Equal(t, currentTest.expected, generation)
 			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+			assert.NoError(t, redisMock.ExpectationsWereMet())
 		})
 	}
 }
@@ -2,14 +2,29 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
 	"strings"
+	"time"
+	"unicode"
 
+	uuid "github.com/satori/go.uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"2021_2_LostPointer/internal/constants"
 	"2021_2_LostPointer/internal/microservices/music"
 	"2021_2_LostPointer/internal/microservices/music/proto"
+	"2021_2_LostPointer/pkg/pagination"
+	"2021_2_LostPointer/pkg/shuffle"
+)
+
+const (
+	tracksPart  = "tracks"
+	albumsPart  = "albums"
+	artistsPart = "artists"
 )
 
 type MusicService struct {
@@ -21,34 +36,128 @@ func NewMusicService(storage music.Storage) *MusicService {
 }
 
 func (service *MusicService) RandomTracks(ctx context.Context, metadata *proto.RandomTracksOptions) (*proto.Tracks, error) {
-	tracks, err := service.storage.RandomTracks(metadata.Amount, metadata.UserID, metadata.IsAuthorized)
+	if metadata.Page < 0 {
+		return &proto.Tracks{}, status.Error(codes.InvalidArgument, constants.SelectionPageInvalidMessage)
+	}
+	trackIDs, selection, err := service.randomSelection(constants.ChartEntityTracks, metadata.Seed, metadata.Generation,
+		metadata.Page, metadata.Amount)
+	if err != nil {
+		return &proto.Tracks{}, status.Error(codes.Internal, err.Error())
+	}
+
+	tracks, err := service.storage.RandomTracks(trackIDs, metadata.UserID, metadata.IsAuthorized)
 	if err != nil {
 		return &proto.Tracks{}, status.Error(codes.Internal, err.Error())
 	}
+	tracks.Selection = selection
 
 	return tracks, nil
 }
 
 func (service *MusicService) RandomAlbums(ctx context.Context, metadata *proto.RandomAlbumsOptions) (*proto.Albums, error) {
-	albums, err := service.storage.RandomAlbums(metadata.Amount)
+	if metadata.Page < 0 {
+		return &proto.Albums{}, status.Error(codes.InvalidArgument, constants.SelectionPageInvalidMessage)
+	}
+	albumIDs, selection, err := service.randomSelection(constants.ChartEntityAlbums, metadata.Seed, metadata.Generation,
+		metadata.Page, metadata.Amount)
 	if err != nil {
 		return &proto.Albums{}, status.Error(codes.Internal, err.Error())
 	}
 
+	albums, err := service.storage.RandomAlbums(albumIDs)
+	if err != nil {
+		return &proto.Albums{}, status.Error(codes.Internal, err.Error())
+	}
+	if metadata.IsAuthorized {
+		if err = service.markSavedAlbums(metadata.UserID, albums.Albums); err != nil {
+			return &proto.Albums{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+	albums.Selection = selection
+
 	return albums, nil
 }
 
 func (service *MusicService) RandomArtists(ctx context.Context, metadata *proto.RandomArtistsOptions) (*proto.Artists, error) {
-	artists, err := service.storage.RandomArtists(metadata.Amount)
+	if metadata.Page < 0 {
+		return &proto.Artists{}, status.Error(codes.InvalidArgument, constants.SelectionPageInvalidMessage)
+	}
+	artistIDs, selection, err := service.randomSelection(constants.ChartEntityArtists, metadata.Seed, metadata.Generation,
+		metadata.Page, metadata.Amount)
+	if err != nil {
+		return &proto.Artists{}, status.Error(codes.Internal, err.Error())
+	}
+
+	artists, err := service.storage.RandomArtists(artistIDs)
 	if err != nil {
 		return &proto.Artists{}, status.Error(codes.Internal, err.Error())
 	}
+	if metadata.IsAuthorized {
+		if err = service.markFollowedArtists(metadata.UserID, artists.Artists); err != nil {
+			return &proto.Artists{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+	artists.Selection = selection
 
 	return artists, nil
 }
 
+// Страница случайной подборки из перемешанного пула. С одинаковыми seed и поколением пула страницы
+// не пересекаются, поэтому клиент листает подборку с seed и поколением из первого ответа. Без seed
+// каждый запрос возвращает новую выборку. Истёкшее поколение заменяется текущим, пустой пул собирается на месте
+func (service *MusicService) randomSelection(entity string, seed int64, generation int64, page int64, amount int64) ([]int64, *proto.Selection, error) {
+	var size int64
+	var err error
+	if generation != 0 {
+		if size, err = service.storage.RandomPoolSize(entity, generation); err != nil {
+			return nil, nil, err
+		}
+	}
+	if size == 0 {
+		if generation, err = service.storage.RandomPoolGeneration(entity); err != nil {
+			return nil, nil, err
+		}
+		if generation != 0 {
+			if size, err = service.storage.RandomPoolSize(entity, generation); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if size == 0 {
+		if generation, err = service.storage.RebuildRandomPool(entity); err != nil {
+			return nil, nil, err
+		}
+		if size, err = service.storage.RandomPoolSize(entity, generation); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	positions := shuffle.New(size, seed).Positions(page*amount, amount)
+
+	ids, err := service.storage.RandomPoolIDs(entity, generation, positions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ids, &proto.Selection{Seed: seed, Generation: generation}, nil
+}
+
+// Пересобирает пулы случайных подборок, чтобы в них попадали новые треки, альбомы и исполнители
+func (service *MusicService) RebuildRandomPools() error {
+	for _, entity := range []string{constants.ChartEntityTracks, constants.ChartEntityAlbums, constants.ChartEntityArtists} {
+		if _, err := service.storage.RebuildRandomPool(entity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (service *MusicService) ArtistProfile(ctx context.Context, metadata *proto.ArtistProfileOptions) (*proto.Artist, error) {
-	artistData, err := service.storage.ArtistInfo(metadata.ArtistID)
+	artistData, err := service.storage.ArtistInfo(metadata.ArtistID, metadata.UserID)
 	if err != nil {
 		return &proto.Artist{}, status.Error(codes.Internal, err.Error())
 	}
@@ -66,8 +175,32 @@ func (service *MusicService) ArtistProfile(ctx context.Context, metadata *proto.
 	return artistData, nil
 }
 
+// Отклонённые прослушивания не меняют listen_count, но сохраняются для отчёта модераторам.
+// Прослушивание несуществующего трека не расходует лимиты
 func (service *MusicService) IncrementListenCount(ctx context.Context, metadata *proto.IncrementListenCountOptions) (*proto.IncrementListenCountEmpty, error) {
-	err := service.storage.IncrementListenCount(metadata.ID)
+	_, err := service.storage.TrackFile(metadata.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.IncrementListenCountEmpty{}, status.Error(codes.NotFound, constants.TrackNotFound)
+	}
+	if err != nil {
+		return &proto.IncrementListenCountEmpty{}, status.Error(codes.Internal, err.Error())
+	}
+
+	reason, err := service.storage.ReserveListen(metadata.UserID, metadata.IP, metadata.ID, time.Now())
+	if err != nil {
+		return &proto.IncrementListenCountEmpty{}, status.Error(codes.Internal, err.Error())
+	}
+	if len(reason) != 0 {
+		if err = service.storage.RecordListenRejection(metadata.UserID, metadata.IP, metadata.ID, reason); err != nil {
+			return &proto.IncrementListenCountEmpty{}, status.Error(codes.Internal, err.Error())
+		}
+		return &proto.IncrementListenCountEmpty{}, status.Error(codes.ResourceExhausted, constants.ListenLimitExceededMessage)
+	}
+
+	err = service.storage.IncrementListenCount(metadata.ID, metadata.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.IncrementListenCountEmpty{}, status.Error(codes.NotFound, constants.TrackNotFound)
+	}
 	if err != nil {
 		return &proto.IncrementListenCountEmpty{}, status.Error(codes.Internal, err.Error())
 	}
@@ -75,70 +208,370 @@ func (service *MusicService) IncrementListenCount(ctx context.Context, metadata
 	return &proto.IncrementListenCountEmpty{}, nil
 }
 
+func (service *MusicService) TrackFile(ctx context.Context, data *proto.TrackFileOptions) (*proto.TrackFileResponse, error) {
+	track, err := service.storage.TrackFile(data.TrackID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.TrackFileResponse{}, status.Error(codes.NotFound, constants.TrackNotFound)
+	}
+	if err != nil {
+		return &proto.TrackFileResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return track, nil
+}
+
+func (service *MusicService) GetLyrics(ctx context.Context, data *proto.LyricsOptions) (*proto.Lyrics, error) {
+	lyrics, err := service.storage.Lyrics(data.TrackID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Lyrics{}, status.Error(codes.NotFound, constants.LyricsNotFoundMessage)
+	}
+	if err != nil {
+		return &proto.Lyrics{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return lyrics, nil
+}
+
+func (service *MusicService) GetTrack(ctx context.Context, data *proto.GetTrackOptions) (*proto.Track, error) {
+	tracks, err := service.storage.TracksByIDs([]int64{data.TrackID}, data.UserID, data.IsAuthorized)
+	if err != nil {
+		return &proto.Track{}, status.Error(codes.Internal, err.Error())
+	}
+	if len(tracks) == 0 {
+		return &proto.Track{}, status.Error(codes.NotFound, constants.TrackNotFound)
+	}
+
+	return tracks[0], nil
+}
+
+// Треки возвращаются в порядке запроса, несуществующие пропускаются
+func (service *MusicService) GetTracks(ctx context.Context, data *proto.GetTracksOptions) (*proto.Tracks, error) {
+	if len(data.IDs) == 0 {
+		return &proto.Tracks{}, status.Error(codes.InvalidArgument, constants.TrackIDsRequiredMessage)
+	}
+	if len(data.IDs) > constants.TracksBatchMaxAmount {
+		return &proto.Tracks{}, status.Error(codes.InvalidArgument, constants.TrackIDsTooManyMessage)
+	}
+
+	trackIDs := make([]int64, 0, len(data.IDs))
+	requested := make(map[int64]bool, len(data.IDs))
+	for _, trackID := range data.IDs {
+		if !requested[trackID] {
+			requested[trackID] = true
+			trackIDs = append(trackIDs, trackID)
+		}
+	}
+
+	found, err := service.storage.TracksByIDs(trackIDs, data.UserID, data.IsAuthorized)
+	if err != nil {
+		return &proto.Tracks{}, status.Error(codes.Internal, err.Error())
+	}
+
+	byID := make(map[int64]*proto.Track, len(found))
+	for _, track := range found {
+		byID[track.ID] = track
+	}
+	tracks := make([]*proto.Track, 0, len(found))
+	for _, trackID := range trackIDs {
+		if track, ok := byID[trackID]; ok {
+			tracks = append(tracks, track)
+		}
+	}
+
+	return &proto.Tracks{Tracks: tracks}, nil
+}
+
 func (service *MusicService) AlbumPage(ctx context.Context, metadata *proto.AlbumPageOptions) (*proto.AlbumPageResponse, error) {
-	album, err := service.storage.AlbumData(metadata.AlbumID)
+	album, err := service.storage.AlbumData(metadata.AlbumID, metadata.UserID)
 	if err != nil {
 		return &proto.AlbumPageResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	album.Tracks, err = service.storage.AlbumTracks(metadata.AlbumID, metadata.UserID, metadata.IsAuthorized)
+	album.Tracks, album.Page, err = service.storage.AlbumTracks(metadata.AlbumID, metadata.UserID, metadata.IsAuthorized,
+		listRequest(metadata.Page))
 	if err != nil {
-		return &proto.AlbumPageResponse{}, status.Error(codes.Internal, err.Error())
+		return &proto.AlbumPageResponse{}, pageError(err)
 	}
 
 	return album, nil
 }
 
 func (service *MusicService) Find(ctx context.Context, data *proto.FindOptions) (*proto.FindResponse, error) {
-	data.Text = strings.TrimSpace(data.Text)
-	if len(data.Text) == 0 {
+	text, filter, err := searchQuery(data)
+	if err != nil {
+		return &proto.FindResponse{}, err
+	}
+	if len(text) == 0 && isEmptyFilter(filter) {
 		return &proto.FindResponse{}, nil
 	}
-	tracks, err := service.storage.FindTracksByFullWord(data.Text, data.UserID, data.IsAuthorized)
+	parts, err := pagination.DecodeParts(data.Page.GetCursor())
 	if err != nil {
-		return &proto.FindResponse{}, status.Error(codes.Internal, err.Error())
+		return &proto.FindResponse{}, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage)
+	}
+	if parts == nil {
+		parts = make(map[string]pagination.Part)
+	}
+
+	result := &proto.FindResponse{
+		Tracks:  []*proto.Track{},
+		Albums:  []*proto.Album{},
+		Artists: []*proto.Artist{},
+	}
+	var page *proto.PageResponse
+
+	if part := parts[tracksPart]; !part.Done {
+		result.Tracks, page, err = service.storage.SearchTracks(text, filter, data.UserID, data.IsAuthorized,
+			&proto.PageRequest{Limit: pageAmount(data.Page, constants.SearchTracksAmount), Cursor: part.Cursor})
+		if err != nil {
+			return &proto.FindResponse{}, pageError(err)
+		}
+		parts[tracksPart] = nextPart(page)
+	}
+
+	// Фильтры относятся только к трекам, без текста исполнители и альбомы не ищутся
+	if len(text) == 0 {
+		parts[artistsPart] = pagination.Part{Done: true}
+		parts[albumsPart] = pagination.Part{Done: true}
+	}
+
+	if part := parts[artistsPart]; !part.Done {
+		result.Artists, page, err = service.storage.SearchArtists(text,
+			&proto.PageRequest{Limit: pageAmount(data.Page, constants.SearchArtistsAmount), Cursor: part.Cursor})
+		if err != nil {
+			return &proto.FindResponse{}, pageError(err)
+		}
+		parts[artistsPart] = nextPart(page)
 	}
 
-	var FindTracksByPartial []*proto.Track
-	if len(tracks) < constants.SearchTracksAmount {
-		FindTracksByPartial, err = service.storage.FindTracksByPartial(data.Text, data.UserID, data.IsAuthorized)
+	if part := parts[albumsPart]; !part.Done {
+		result.Albums, page, err = service.storage.SearchAlbums(text,
+			&proto.PageRequest{Limit: pageAmount(data.Page, constants.SearchAlbumsAmount), Cursor: part.Cursor})
 		if err != nil {
+			return &proto.FindResponse{}, pageError(err)
+		}
+		parts[albumsPart] = nextPart(page)
+	}
+
+	if data.IsAuthorized {
+		if err = service.markFollowedArtists(data.UserID, result.Artists); err != nil {
+			return &proto.FindResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		if err = service.markSavedAlbums(data.UserID, result.Albums); err != nil {
 			return &proto.FindResponse{}, status.Error(codes.Internal, err.Error())
 		}
 	}
+	result.Page = partsResponse(parts)
 
-	for _, track := range FindTracksByPartial {
-		if !contains(tracks, track.ID) && len(tracks) < constants.SearchTracksAmount {
-			tracks = append(tracks, track)
+	// Запоминаем только первую страницу выдачи и только непустой запрос, ошибки истории не ломают поиск
+	if query := strings.TrimSpace(data.Text); data.IsAuthorized && len(query) != 0 && len(data.Page.GetCursor()) == 0 {
+		_ = service.storage.RecordSearch(data.UserID, query, "", 0)
+	}
+
+	return result, nil
+}
+
+func (service *MusicService) SearchTracks(ctx context.Context, data *proto.FindOptions) (*proto.Tracks, error) {
+	text, filter, err := searchQuery(data)
+	if err != nil {
+		return &proto.Tracks{}, err
+	}
+	if len(text) == 0 && isEmptyFilter(filter) {
+		return &proto.Tracks{Tracks: []*proto.Track{}}, nil
+	}
+
+	tracks, page, err := service.storage.SearchTracks(text, filter, data.UserID, data.IsAuthorized,
+		pageRequest(data.Page, constants.SearchPageAmount))
+	if err != nil {
+		return &proto.Tracks{}, pageError(err)
+	}
+
+	return &proto.Tracks{Tracks: tracks, Page: page}, nil
+}
+
+func (service *MusicService) SearchAlbums(ctx context.Context, data *proto.FindOptions) (*proto.Albums, error) {
+	text, _, err := searchQuery(data)
+	if err != nil {
+		return &proto.Albums{}, err
+	}
+	if len(text) == 0 {
+		return &proto.Albums{Albums: []*proto.Album{}}, nil
+	}
+
+	albums, page, err := service.storage.SearchAlbums(text, pageRequest(data.Page, constants.SearchPageAmount))
+	if err != nil {
+		return &proto.Albums{}, pageError(err)
+	}
+	if data.IsAuthorized {
+		if err = service.markSavedAlbums(data.UserID, albums); err != nil {
+			return &proto.Albums{}, status.Error(codes.Internal, err.Error())
 		}
 	}
 
-	artists, err := service.storage.FindArtists(data.Text)
+	return &proto.Albums{Albums: albums, Page: page}, nil
+}
+
+func (service *MusicService) SearchArtists(ctx context.Context, data *proto.FindOptions) (*proto.Artists, error) {
+	text, _, err := searchQuery(data)
 	if err != nil {
-		return &proto.FindResponse{}, status.Error(codes.Internal, err.Error())
+		return &proto.Artists{}, err
+	}
+	if len(text) == 0 {
+		return &proto.Artists{Artists: []*proto.Artist{}}, nil
 	}
 
-	albums, err := service.storage.FindAlbums(data.Text)
+	artists, page, err := service.storage.SearchArtists(text, pageRequest(data.Page, constants.SearchPageAmount))
 	if err != nil {
-		return &proto.FindResponse{}, status.Error(codes.Internal, err.Error())
+		return &proto.Artists{}, pageError(err)
+	}
+	if data.IsAuthorized {
+		if err = service.markFollowedArtists(data.UserID, artists); err != nil {
+			return &proto.Artists{}, status.Error(codes.Internal, err.Error())
+		}
 	}
 
-	result := &proto.FindResponse{
-		Tracks:  tracks,
-		Albums:  albums,
-		Artists: artists,
+	return &proto.Artists{Artists: artists, Page: page}, nil
+}
+
+func (service *MusicService) SearchPlaylists(ctx context.Context, data *proto.FindOptions) (*proto.PlaylistsData, error) {
+	text, _, err := searchQuery(data)
+	if err != nil {
+		return &proto.PlaylistsData{}, err
+	}
+	if len(text) == 0 {
+		return &proto.PlaylistsData{Playlists: []*proto.PlaylistData{}}, nil
 	}
 
-	return result, nil
+	playlists, page, err := service.storage.SearchPlaylists(text, data.UserID, pageRequest(data.Page, constants.SearchPageAmount))
+	if err != nil {
+		return &proto.PlaylistsData{}, pageError(err)
+	}
+
+	return &proto.PlaylistsData{Playlists: playlists, Page: page}, nil
+}
+
+func (service *MusicService) Suggest(ctx context.Context, data *proto.SuggestOptions) (*proto.Suggestions, error) {
+	prefix := strings.TrimSpace(data.Prefix)
+	if len(prefix) == 0 {
+		return &proto.Suggestions{Suggestions: []*proto.Suggestion{}}, nil
+	}
+	amount := data.Limit
+	if amount <= 0 {
+		amount = constants.SuggestDefaultAmount
+	}
+	if amount > constants.SuggestMaxAmount {
+		amount = constants.SuggestMaxAmount
+	}
+
+	userID, lifetime := int64(-1), constants.SuggestionsCacheLifetime
+	if data.IsAuthorized {
+		userID, lifetime = data.UserID, constants.PersonalSuggestionsCacheLifetime
+	}
+	key := fmt.Sprintf("suggestions:%d:%d:%s", userID, amount, strings.ToLower(prefix))
+
+	// Кэш только ускоряет ответ, поэтому его ошибки не ломают подсказки
+	suggestions, found, err := service.storage.CachedSuggestions(key)
+	if err != nil || !found {
+		suggestions, err = service.storage.Suggest(prefix, userID, amount)
+		if err != nil {
+			return &proto.Suggestions{}, status.Error(codes.Internal, err.Error())
+		}
+		_ = service.storage.CacheSuggestions(key, suggestions, lifetime)
+	}
+
+	for _, suggestion := range suggestions {
+		suggestion.Highlights = matchRanges(suggestion.Title, prefix)
+	}
+
+	return &proto.Suggestions{Suggestions: suggestions}, nil
+}
+
+func (service *MusicService) GetRecentSearches(ctx context.Context, data *proto.RecentSearchesOptions) (*proto.RecentSearches, error) {
+	amount := data.Limit
+	if amount <= 0 {
+		amount = constants.RecentSearchesDefaultAmount
+	}
+	if amount > constants.RecentSearchesMaxAmount {
+		amount = constants.RecentSearchesMaxAmount
+	}
+
+	searches, err := service.storage.RecentSearches(data.UserID, amount)
+	if err != nil {
+		return &proto.RecentSearches{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.RecentSearches{RecentSearches: searches}, nil
+}
+
+func (service *MusicService) RecordSearchResult(ctx context.Context, data *proto.RecordSearchResultOptions) (*proto.RecordSearchResultResponse, error) {
+	query := strings.TrimSpace(data.Query)
+	if len(query) == 0 {
+		return &proto.RecordSearchResultResponse{}, status.Error(codes.InvalidArgument, constants.SearchQueryEmptyMessage)
+	}
+	switch data.ResultType {
+	case constants.SuggestionTypeTrack, constants.SuggestionTypeArtist, constants.SuggestionTypeAlbum, constants.SuggestionTypePlaylist:
+	default:
+		return &proto.RecordSearchResultResponse{}, status.Error(codes.InvalidArgument, constants.SearchResultTypeInvalidMessage)
+	}
+	// В историю попадают только плейлисты, которые пользователь может найти
+	if data.ResultType == constants.SuggestionTypePlaylist {
+		isVisible, err := service.isPlaylistVisible(data.ResultID, data.UserID)
+		if err != nil {
+			return &proto.RecordSearchResultResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		if !isVisible {
+			return &proto.RecordSearchResultResponse{}, status.Error(codes.NotFound, constants.PlaylistNotFoundMessage)
+		}
+	}
+
+	err := service.storage.RecordSearch(data.UserID, query, data.ResultType, data.ResultID)
+	if err != nil {
+		return &proto.RecordSearchResultResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.RecordSearchResultResponse{}, nil
+}
+
+func (service *MusicService) isPlaylistVisible(playlistID int64, userID int64) (bool, error) {
+	doesExist, err := service.storage.DoesPlaylistExist(playlistID)
+	if err != nil || !doesExist {
+		return false, err
+	}
+	isOwner, err := service.storage.IsPlaylistOwner(playlistID, userID)
+	if err != nil || isOwner {
+		return isOwner, err
+	}
+
+	return service.storage.IsPlaylistPublic(playlistID)
+}
+
+func (service *MusicService) DeleteRecentSearch(ctx context.Context, data *proto.DeleteRecentSearchOptions) (*proto.DeleteRecentSearchResponse, error) {
+	deleted, err := service.storage.DeleteRecentSearch(data.UserID, data.ID)
+	if err != nil {
+		return &proto.DeleteRecentSearchResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if !deleted {
+		return &proto.DeleteRecentSearchResponse{}, status.Error(codes.NotFound, constants.RecentSearchNotFoundMessage)
+	}
+
+	return &proto.DeleteRecentSearchResponse{}, nil
+}
+
+func (service *MusicService) ClearRecentSearches(ctx context.Context, data *proto.ClearRecentSearchesOptions) (*proto.ClearRecentSearchesResponse, error) {
+	err := service.storage.ClearRecentSearches(data.UserID)
+	if err != nil {
+		return &proto.ClearRecentSearchesResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ClearRecentSearchesResponse{}, nil
 }
 
 func (service *MusicService) UserPlaylists(ctx context.Context, data *proto.UserPlaylistsOptions) (*proto.PlaylistsData, error) {
-	playlists, err := service.storage.UserPlaylists(data.UserID)
+	playlists, page, err := service.storage.UserPlaylists(data.UserID, listRequest(data.Page))
 	if err != nil {
-		return &proto.PlaylistsData{}, status.Error(codes.Internal, err.Error())
+		return &proto.PlaylistsData{}, pageError(err)
 	}
 
-	return &proto.PlaylistsData{Playlists: playlists}, nil
+	return &proto.PlaylistsData{Playlists: playlists, Page: page}, nil
 }
 
 func (service *MusicService) PlaylistPage(ctx context.Context, data *proto.PlaylistPageOptions) (*proto.PlaylistPageResponse, error) {
@@ -159,17 +592,25 @@ func (service *MusicService) PlaylistPage(ctx context.Context, data *proto.Playl
 		return &proto.PlaylistPageResponse{}, status.Error(codes.Internal, err.Error())
 	}
 	if !isOwner && !isPublic {
-		return &proto.PlaylistPageResponse{}, status.Error(codes.PermissionDenied, constants.NotPlaylistOwnerMessage)
+		// Плейлист новинок принадлежит системному пользователю, но виден тому, для кого собран
+		var isReleaseRadar bool
+		isReleaseRadar, err = service.storage.IsReleaseRadarPlaylist(data.PlaylistID, data.UserID)
+		if err != nil {
+			return &proto.PlaylistPageResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		if !isReleaseRadar {
+			return &proto.PlaylistPageResponse{}, status.Error(codes.PermissionDenied, constants.NotPlaylistOwnerMessage)
+		}
 	}
 
-	playlistInfo, err := service.storage.PlaylistInfo(data.PlaylistID)
+	playlistInfo, err := service.storage.PlaylistInfo(data.PlaylistID, data.UserID)
 	if err != nil {
 		return &proto.PlaylistPageResponse{}, status.Error(codes.Internal, err.Error())
 	}
 
-	playlistTracks, err := service.storage.PlaylistTracks(data.PlaylistID, data.UserID)
+	playlistTracks, page, err := service.storage.PlaylistTracks(data.PlaylistID, data.UserID, listRequest(data.Page))
 	if err != nil {
-		return &proto.PlaylistPageResponse{}, status.Error(codes.Internal, err.Error())
+		return &proto.PlaylistPageResponse{}, pageError(err)
 	}
 
 	playlistData := &proto.PlaylistPageResponse{
@@ -180,6 +621,7 @@ func (service *MusicService) PlaylistPage(ctx context.Context, data *proto.Playl
 		Tracks:       playlistTracks,
 		IsPublic:     playlistInfo.IsPublic,
 		IsOwn:        isOwner,
+		Page:         page,
 	}
 
 	return playlistData, nil
@@ -220,22 +662,729 @@ func (service *MusicService) DeleteTrackFromFavorites(ctx context.Context, data
 }
 
 func (service *MusicService) GetFavoriteTracks(ctx context.Context, data *proto.UserFavoritesOptions) (*proto.Tracks, error) {
+	sortBy, err := favoritesSort(data.SortBy)
+	if err != nil {
+		return &proto.Tracks{}, err
+	}
+
 	tracks := new(proto.Tracks)
+	tracks.Tracks, tracks.Page, err = service.storage.GetFavorites(data.UserID, sortBy, data.Filter, listRequest(data.Page))
+	if err != nil {
+		return &proto.Tracks{}, pageError(err)
+	}
+
+	return tracks, nil
+}
+
+func (service *MusicService) SaveAlbum(ctx context.Context, data *proto.SaveAlbumOptions) (*proto.LibraryResponse, error) {
+	isExist, err := service.storage.IsAlbumSaved(data.UserID, data.AlbumID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if isExist {
+		return &proto.LibraryResponse{}, status.Error(codes.PermissionDenied, constants.AlbumAlreadySavedMessage)
+	}
+
+	err = service.storage.SaveAlbum(data.UserID, data.AlbumID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.NotFound, constants.AlbumNotFoundMessage)
+	}
+
+	return &proto.LibraryResponse{}, nil
+}
+
+func (service *MusicService) UnsaveAlbum(ctx context.Context, data *proto.SaveAlbumOptions) (*proto.LibraryResponse, error) {
+	isExist, err := service.storage.IsAlbumSaved(data.UserID, data.AlbumID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if !isExist {
+		return &proto.LibraryResponse{}, status.Error(codes.PermissionDenied, constants.AlbumNotSavedMessage)
+	}
+
+	err = service.storage.UnsaveAlbum(data.UserID, data.AlbumID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.LibraryResponse{}, nil
+}
+
+func (service *MusicService) GetSavedAlbums(ctx context.Context, data *proto.LibraryOptions) (*proto.Albums, error) {
 	var err error
+	albums := new(proto.Albums)
+	albums.Albums, albums.Page, err = service.storage.SavedAlbums(data.UserID, pageRequest(data.Page, constants.PageDefaultAmount))
+	if err != nil {
+		return &proto.Albums{}, pageError(err)
+	}
 
-	tracks.Tracks, err = service.storage.GetFavorites(data.UserID)
+	return albums, nil
+}
+
+func (service *MusicService) FollowArtist(ctx context.Context, data *proto.FollowArtistOptions) (*proto.LibraryResponse, error) {
+	isExist, err := service.storage.IsArtistFollowed(data.UserID, data.ArtistID)
 	if err != nil {
-		return &proto.Tracks{}, status.Error(codes.Internal, err.Error())
+		return &proto.LibraryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if isExist {
+		return &proto.LibraryResponse{}, status.Error(codes.PermissionDenied, constants.ArtistAlreadyFollowedMessage)
 	}
 
-	return tracks, nil
+	err = service.storage.FollowArtist(data.UserID, data.ArtistID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.NotFound, constants.ArtistNotFoundMessage)
+	}
+
+	return &proto.LibraryResponse{}, nil
 }
 
-func contains(tracks []*proto.Track, trackID int64) bool {
-	for _, currentTrack := range tracks {
-		if currentTrack.ID == trackID {
-			return true
-		}
+func (service *MusicService) UnfollowArtist(ctx context.Context, data *proto.FollowArtistOptions) (*proto.LibraryResponse, error) {
+	isExist, err := service.storage.IsArtistFollowed(data.UserID, data.ArtistID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if !isExist {
+		return &proto.LibraryResponse{}, status.Error(codes.PermissionDenied, constants.ArtistNotFollowedMessage)
+	}
+
+	err = service.storage.UnfollowArtist(data.UserID, data.ArtistID)
+	if err != nil {
+		return &proto.LibraryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.LibraryResponse{}, nil
+}
+
+func (service *MusicService) GetFollowedArtists(ctx context.Context, data *proto.LibraryOptions) (*proto.Artists, error) {
+	var err error
+	artists := new(proto.Artists)
+	artists.Artists, artists.Page, err = service.storage.FollowedArtists(data.UserID, pageRequest(data.Page, constants.PageDefaultAmount))
+	if err != nil {
+		return &proto.Artists{}, pageError(err)
+	}
+
+	return artists, nil
+}
+
+// Списки альбомов выбираются без пользователя, поэтому сохраненные альбомы отмечаются одним запросом после выборки
+func (service *MusicService) markSavedAlbums(userID int64, albums []*proto.Album) error {
+	if len(albums) == 0 {
+		return nil
+	}
+	albumIDs := make([]int64, 0, len(albums))
+	for _, album := range albums {
+		albumIDs = append(albumIDs, album.ID)
+	}
+	savedIDs, err := service.storage.SavedAlbumIDs(userID, albumIDs)
+	if err != nil {
+		return err
+	}
+
+	saved := make(map[int64]bool, len(savedIDs))
+	for _, albumID := range savedIDs {
+		saved[albumID] = true
+	}
+	for _, album := range albums {
+		album.IsSaved = saved[album.ID]
+	}
+
+	return nil
+}
+
+func (service *MusicService) markFollowedArtists(userID int64, artists []*proto.Artist) error {
+	if len(artists) == 0 {
+		return nil
+	}
+	artistIDs := make([]int64, 0, len(artists))
+	for _, artist := range artists {
+		artistIDs = append(artistIDs, artist.ID)
+	}
+	followedIDs, err := service.storage.FollowedArtistIDs(userID, artistIDs)
+	if err != nil {
+		return err
+	}
+
+	followed := make(map[int64]bool, len(followedIDs))
+	for _, artistID := range followedIDs {
+		followed[artistID] = true
+	}
+	for _, artist := range artists {
+		artist.IsFollowed = followed[artist.ID]
+	}
+
+	return nil
+}
+
+func (service *MusicService) DislikeTrack(ctx context.Context, data *proto.DislikeTrackOptions) (*proto.DislikeTrackResponse, error) {
+	isExist, err := service.storage.IsTrackDisliked(data.UserID, data.TrackID)
+	if err != nil {
+		return &proto.DislikeTrackResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if isExist {
+		return &proto.DislikeTrackResponse{}, status.Error(codes.PermissionDenied, constants.TrackAlreadyDislikedMessage)
+	}
+
+	err = service.storage.DislikeTrack(data.UserID, data.TrackID)
+	if err != nil {
+		return &proto.DislikeTrackResponse{}, status.Error(codes.NotFound, constants.TrackNotFound)
+	}
+
+	return &proto.DislikeTrackResponse{}, nil
+}
+
+func (service *MusicService) DeleteTrackDislike(ctx context.Context, data *proto.DislikeTrackOptions) (*proto.DislikeTrackResponse, error) {
+	isExist, err := service.storage.IsTrackDisliked(data.UserID, data.TrackID)
+	if err != nil {
+		return &proto.DislikeTrackResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if !isExist {
+		return &proto.DislikeTrackResponse{}, status.Error(codes.PermissionDenied, constants.TrackNotDislikedMessage)
+	}
+
+	err = service.storage.DeleteTrackDislike(data.UserID, data.TrackID)
+	if err != nil {
+		return &proto.DislikeTrackResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.DislikeTrackResponse{}, nil
+}
+
+// Радио отдаёт треки порциями. Состояние сессии лежит в Redis, поэтому для продолжения шлюзу достаточно токена
+func (service *MusicService) Radio(ctx context.Context, data *proto.RadioOptions) (*proto.RadioResponse, error) {
+	amount := data.Amount
+	if amount <= 0 {
+		amount = constants.RadioDefaultAmount
+	}
+	if amount > constants.RadioMaxAmount {
+		amount = constants.RadioMaxAmount
+	}
+
+	token := data.Session
+	var session *proto.RadioSession
+	if len(token) != 0 {
+		var found bool
+		var err error
+		session, found, err = service.storage.RadioSession(radioSessionKey(token))
+		if err != nil {
+			return &proto.RadioResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		if !found || session.UserID != data.UserID {
+			return &proto.RadioResponse{}, status.Error(codes.NotFound, constants.RadioSessionNotFoundMessage)
+		}
+	} else {
+		if !isRadioSeedType(data.SeedType) {
+			return &proto.RadioResponse{}, status.Error(codes.InvalidArgument, constants.RadioSeedTypeInvalidMessage)
+		}
+		var err error
+		session, err = service.storage.RadioSeed(data.SeedType, data.SeedID, data.UserID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return &proto.RadioResponse{}, status.Error(codes.NotFound, constants.RadioSeedNotFoundMessage)
+		}
+		if err != nil {
+			return &proto.RadioResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		session.UserID, session.SeedType, session.SeedID = data.UserID, data.SeedType, data.SeedID
+		token = uuid.NewV4().String()
+	}
+
+	tracks, err := service.radioTracks(session, amount)
+	if err != nil {
+		return &proto.RadioResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, track := range tracks {
+		session.Played = append(session.Played, track.ID)
+	}
+	if len(session.Played) > constants.RadioHistoryMaxAmount {
+		session.Played = session.Played[len(session.Played)-constants.RadioHistoryMaxAmount:]
+	}
+	err = service.storage.SaveRadioSession(radioSessionKey(token), session, constants.RadioSessionLifetime)
+	if err != nil {
+		return &proto.RadioResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.RadioResponse{Session: token, Tracks: tracks}, nil
+}
+
+// Порция радио: часть знакомых пользователю треков вперемешку с новыми. Если треков по зерну не хватает,
+// порция добирается из случайной выборки каталога. Без артистов и жанров в зерне вся порция
+// собирается из такой выборки, чтобы не сортировать весь каталог
+func (service *MusicService) radioTracks(session *proto.RadioSession, amount int64) ([]*proto.Track, error) {
+	exclude := append([]int64{}, session.Played...)
+
+	var candidates []int64
+	if len(session.ArtistIDs) == 0 && len(session.GenreIDs) == 0 {
+		var err error
+		candidates, _, err = service.randomSelection(constants.ChartEntityTracks, 0, 0, 0, amount*constants.RadioCandidatesFactor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var familiar []*proto.Track
+	if familiarAmount := amount * constants.RadioFamiliarPercent / 100; familiarAmount > 0 {
+		var err error
+		familiar, err = service.storage.RadioTracks(session.UserID, session.ArtistIDs, session.GenreIDs,
+			constants.RadioPoolFamiliar, exclude, candidates, familiarAmount)
+		if err != nil {
+			return nil, err
+		}
+		exclude = appendTrackIDs(exclude, familiar)
+	}
+
+	fresh, err := service.storage.RadioTracks(session.UserID, session.ArtistIDs, session.GenreIDs,
+		constants.RadioPoolFresh, exclude, candidates, amount-int64(len(familiar)))
+	if err != nil {
+		return nil, err
+	}
+	exclude = appendTrackIDs(exclude, fresh)
+
+	tracks := mixTracks(familiar, fresh)
+	if rest := amount - int64(len(tracks)); rest > 0 {
+		fillerCandidates, _, err := service.randomSelection(constants.ChartEntityTracks, 0, 0, 0, rest*constants.RadioCandidatesFactor)
+		if err != nil {
+			return nil, err
+		}
+		filler, err := service.storage.RadioTracks(session.UserID, nil, nil, "", exclude, fillerCandidates, rest)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, filler...)
+	}
+
+	return tracks, nil
+}
+
+func (service *MusicService) Charts(ctx context.Context, data *proto.ChartsOptions) (*proto.ChartsResponse, error) {
+	if !isChartPeriod(data.Period) {
+		return &proto.ChartsResponse{}, status.Error(codes.InvalidArgument, constants.ChartPeriodInvalidMessage)
+	}
+	if len(data.Entity) != 0 && data.Entity != constants.ChartEntityTracks &&
+		data.Entity != constants.ChartEntityAlbums && data.Entity != constants.ChartEntityArtists {
+		return &proto.ChartsResponse{}, status.Error(codes.InvalidArgument, constants.ChartEntityInvalidMessage)
+	}
+
+	periodStart, err := service.storage.ChartPeriodStart(data.Period)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.ChartsResponse{}, status.Error(codes.NotFound, constants.ChartNotReadyMessage)
+	}
+	if err != nil {
+		return &proto.ChartsResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	amount := data.Amount
+	if amount <= 0 || amount > constants.ChartPositionsAmount {
+		amount = constants.ChartPositionsAmount
+	}
+
+	charts := &proto.ChartsResponse{
+		Period:      data.Period,
+		PeriodStart: periodStart.Format(constants.ChartDateLayout),
+	}
+	if len(data.Entity) == 0 || data.Entity == constants.ChartEntityTracks {
+		charts.Tracks, err = service.storage.ChartTracks(data.Period, periodStart, data.GenreID, amount, data.UserID, data.IsAuthorized)
+		if err != nil {
+			return &proto.ChartsResponse{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if len(data.Entity) == 0 || data.Entity == constants.ChartEntityAlbums {
+		charts.Albums, err = service.storage.ChartAlbums(data.Period, periodStart, data.GenreID, amount)
+		if err != nil {
+			return &proto.ChartsResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		if data.IsAuthorized {
+			albums := make([]*proto.Album, 0, len(charts.Albums))
+			for _, position := range charts.Albums {
+				albums = append(albums, position.Album)
+			}
+			if err = service.markSavedAlbums(data.UserID, albums); err != nil {
+				return &proto.ChartsResponse{}, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+	if len(data.Entity) == 0 || data.Entity == constants.ChartEntityArtists {
+		charts.Artists, err = service.storage.ChartArtists(data.Period, periodStart, data.GenreID, amount)
+		if err != nil {
+			return &proto.ChartsResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		if data.IsAuthorized {
+			artists := make([]*proto.Artist, 0, len(charts.Artists))
+			for _, position := range charts.Artists {
+				artists = append(artists, position.Artist)
+			}
+			if err = service.markFollowedArtists(data.UserID, artists); err != nil {
+				return &proto.ChartsResponse{}, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	return charts, nil
+}
+
+func (service *MusicService) RebuildCharts(now time.Time) error {
+	for _, period := range []string{constants.ChartPeriodDaily, constants.ChartPeriodWeekly, constants.ChartPeriodMonthly} {
+		start, end, previousStart := chartPeriodBounds(period, now)
+		_, _, beforePreviousStart := chartPeriodBounds(period, previousStart)
+
+		if err := service.storage.RebuildChart(period, previousStart, start, beforePreviousStart); err != nil {
+			return err
+		}
+		if err := service.storage.RebuildChart(period, start, end, previousStart); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (service *MusicService) ReleaseRadar(ctx context.Context, data *proto.ReleaseRadarOptions) (*proto.ReleaseRadarResponse, error) {
+	albums, err := service.storage.ReleaseRadar(data.UserID, time.Now(), constants.ReleaseRadarAlbumsAmount)
+	if err != nil {
+		return &proto.ReleaseRadarResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	playlistID, err := service.storage.ReleaseRadarPlaylist(data.UserID)
+	if err != nil {
+		return &proto.ReleaseRadarResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ReleaseRadarResponse{Albums: albums, PlaylistID: playlistID}, nil
+}
+
+// Собирает плейлисты новинок, которые ещё не собраны на текущей неделе. Если новинок нет,
+// прежний плейлист очищается, а неделя всё равно отмечается собранной, чтобы пользователь
+// не перебирался на каждом запуске. Ошибка у одного пользователя не останавливает сборку для остальных
+func (service *MusicService) RebuildReleaseRadarPlaylists(now time.Time) error {
+	weekStart, _, _ := chartPeriodBounds(constants.ChartPeriodWeekly, now)
+	userIDs, err := service.storage.ReleaseRadarListeners(now, weekStart)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	var trackIDs []int64
+	for _, userID := range userIDs {
+		trackIDs, err = service.storage.ReleaseRadarTracks(userID, now, constants.ReleaseRadarTracksAmount)
+		if err == nil {
+			err = service.storage.SaveReleaseRadarPlaylist(userID, weekStart, trackIDs)
+		}
+		if err != nil {
+			failed++
+			log.Printf("CANNOT REBUILD RELEASE RADAR PLAYLIST FOR USER %d: %s", userID, err.Error())
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("release radar playlists failed for %d of %d users", failed, len(userIDs))
+	}
+
+	return nil
+}
+
+func (service *MusicService) Recap(ctx context.Context, data *proto.RecapOptions) (*proto.RecapResponse, error) {
+	if data.Year < 1 || data.Year > int64(time.Now().Year()) {
+		return &proto.RecapResponse{}, status.Error(codes.InvalidArgument, constants.RecapYearInvalidMessage)
+	}
+
+	recap, err := service.storage.Recap(data.UserID, data.Year)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.RecapResponse{}, status.Error(codes.NotFound, constants.RecapNotFoundMessage)
+	}
+	if err != nil {
+		return &proto.RecapResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	recap.Tracks, err = service.storage.RecapTopTracks(data.UserID, data.Year, constants.RecapTopTracksAmount)
+	if err != nil {
+		return &proto.RecapResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	recap.Albums, err = service.storage.RecapTopAlbums(data.UserID, data.Year, constants.RecapTopAmount)
+	if err != nil {
+		return &proto.RecapResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	recap.Artists, err = service.storage.RecapTopArtists(data.UserID, data.Year, constants.RecapTopAmount)
+	if err != nil {
+		return &proto.RecapResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	recap.Genres, err = service.storage.RecapTopGenres(data.UserID, data.Year, constants.RecapTopAmount)
+	if err != nil {
+		return &proto.RecapResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return recap, nil
+}
+
+// Пересчитывает итоги текущего года. Итоги прошлого года пересчитываются один раз после его окончания,
+// чтобы в них попали прослушивания последних дней года
+func (service *MusicService) RebuildRecaps(now time.Time) error {
+	yearStart := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+
+	previousYear := int64(now.Year() - 1)
+	builtAt, err := service.storage.RecapBuiltAt(previousYear)
+	if err != nil {
+		return err
+	}
+	if builtAt.Before(yearStart) {
+		if err = service.storage.RebuildRecaps(previousYear, yearStart.AddDate(-1, 0, 0), yearStart); err != nil {
+			return err
+		}
+	}
+
+	return service.storage.RebuildRecaps(int64(now.Year()), yearStart, yearStart.AddDate(1, 0, 0))
+}
+
+func (service *MusicService) ListGenres(ctx context.Context, data *proto.ListGenresOptions) (*proto.Genres, error) {
+	genres, err := service.storage.ListGenres()
+	if err != nil {
+		return &proto.Genres{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.Genres{Genres: genres}, nil
+}
+
+func (service *MusicService) GenrePage(ctx context.Context, data *proto.GenrePageOptions) (*proto.GenrePageResponse, error) {
+	genre, err := service.storage.GenreInfo(data.GenreID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.GenrePageResponse{}, status.Error(codes.NotFound, constants.GenreNotFoundMessage)
+	}
+	if err != nil {
+		return &proto.GenrePageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	parts, err := pagination.DecodeParts(data.Page.GetCursor())
+	if err != nil {
+		return &proto.GenrePageResponse{}, status.Error(codes.InvalidArgument, constants.CursorInvalidMessage)
+	}
+	if parts == nil {
+		parts = make(map[string]pagination.Part)
+	}
+
+	result := &proto.GenrePageResponse{
+		Genre:   genre,
+		Tracks:  []*proto.Track{},
+		Albums:  []*proto.Album{},
+		Artists: []*proto.Artist{},
+	}
+	var page *proto.PageResponse
+
+	if part := parts[tracksPart]; !part.Done {
+		result.Tracks, page, err = service.storage.GenreTracks(data.GenreID, data.UserID, data.IsAuthorized,
+			&proto.PageRequest{Limit: pageAmount(data.Page, constants.GenreTracksSelectionAmount), Cursor: part.Cursor})
+		if err != nil {
+			return &proto.GenrePageResponse{}, pageError(err)
+		}
+		parts[tracksPart] = nextPart(page)
+	}
+	if part := parts[albumsPart]; !part.Done {
+		result.Albums, page, err = service.storage.GenreAlbums(data.GenreID,
+			&proto.PageRequest{Limit: pageAmount(data.Page, constants.GenreAlbumsSelectionAmount), Cursor: part.Cursor})
+		if err != nil {
+			return &proto.GenrePageResponse{}, pageError(err)
+		}
+		parts[albumsPart] = nextPart(page)
+	}
+	if part := parts[artistsPart]; !part.Done {
+		result.Artists, page, err = service.storage.GenreArtists(data.GenreID,
+			&proto.PageRequest{Limit: pageAmount(data.Page, constants.GenreArtistsSelectionAmount), Cursor: part.Cursor})
+		if err != nil {
+			return &proto.GenrePageResponse{}, pageError(err)
+		}
+		parts[artistsPart] = nextPart(page)
+	}
+
+	result.Page = partsResponse(parts)
+
+	return result, nil
+}
+
+func (service *MusicService) ArtistTracks(ctx context.Context, data *proto.ArtistTracksOptions) (*proto.Tracks, error) {
+	sortBy, err := discographySort(data.SortBy)
+	if err != nil {
+		return &proto.Tracks{}, err
+	}
+
+	tracks, page, err := service.storage.ArtistTracksPage(data.ArtistID, data.UserID, data.IsAuthorized, sortBy,
+		pageRequest(data.Page, constants.ArtistDiscographyPageAmount))
+	if err != nil {
+		return &proto.Tracks{}, pageError(err)
+	}
+
+	return &proto.Tracks{Tracks: tracks, Page: page}, nil
+}
+
+func (service *MusicService) ArtistAlbums(ctx context.Context, data *proto.ArtistAlbumsOptions) (*proto.Albums, error) {
+	sortBy, err := discographySort(data.SortBy)
+	if err != nil {
+		return &proto.Albums{}, err
+	}
+
+	albums, page, err := service.storage.ArtistAlbumsPage(data.ArtistID, sortBy, pageRequest(data.Page, constants.ArtistDiscographyPageAmount))
+	if err != nil {
+		return &proto.Albums{}, pageError(err)
+	}
+
+	return &proto.Albums{Albums: albums, Page: page}, nil
+}
+
+func discographySort(sortBy string) (string, error) {
+	if len(sortBy) == 0 {
+		sortBy = constants.SortByPopularity
+	}
+	if sortBy != constants.SortByPopularity && sortBy != constants.SortByYear && sortBy != constants.SortByTitle {
+		return "", status.Error(codes.InvalidArgument, constants.SortInvalidMessage)
+	}
+
+	return sortBy, nil
+}
+
+func favoritesSort(sortBy string) (string, error) {
+	if len(sortBy) == 0 {
+		return constants.SortByDateAdded, nil
+	}
+	switch sortBy {
+	case constants.SortByDateAdded, constants.SortByTitle, constants.SortByArtist, constants.SortByDuration, constants.SortByListenCount:
+		return sortBy, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, constants.FavoritesSortInvalidMessage)
+	}
+}
+
+// Позиции (в символах) совпадений префикса с началом слов названия
+func matchRanges(title string, prefix string) []*proto.MatchRange {
+	titleRunes, prefixRunes := []rune(title), []rune(prefix)
+	ranges := make([]*proto.MatchRange, 0)
+	for i := 0; i+len(prefixRunes) <= len(titleRunes); i++ {
+		if i != 0 && !unicode.IsSpace(titleRunes[i-1]) {
+			continue
+		}
+		matched := true
+		for j, current := range prefixRunes {
+			if unicode.ToLower(titleRunes[i+j]) != unicode.ToLower(current) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			ranges = append(ranges, &proto.MatchRange{Start: int64(i), End: int64(i + len(prefixRunes))})
+			i += len(prefixRunes) - 1
+		}
+	}
+
+	return ranges
+}
+
+// Разбирает текст поиска, фильтры из запроса дополняют и переопределяют переданные в FindOptions
+func searchQuery(data *proto.FindOptions) (string, *proto.SearchFilter, error) {
+	filter := data.Filter
+	if filter == nil {
+		filter = &proto.SearchFilter{}
+	}
+	text, err := parseQuery(strings.TrimSpace(data.Text), filter)
+	if err != nil {
+		return "", nil, status.Error(codes.InvalidArgument, constants.SearchQueryInvalidMessage+": "+err.Error())
+	}
+
+	return text, filter, nil
+}
+
+func isEmptyFilter(filter *proto.SearchFilter) bool {
+	return filter.GenreID == 0 && len(filter.Genre) == 0 && filter.ArtistID == 0 && len(filter.Artist) == 0 &&
+		len(filter.Album) == 0 && filter.YearFrom == 0 && filter.YearTo == 0 && filter.DurationFrom == 0 &&
+		filter.DurationTo == 0 && filter.Explicit == nil && filter.Lossless == nil
+}
+
+func pageAmount(page *proto.PageRequest, defaultAmount int64) int64 {
+	amount := page.GetLimit()
+	if amount <= 0 {
+		amount = defaultAmount
+	}
+	if amount > constants.PageMaxAmount {
+		amount = constants.PageMaxAmount
+	}
+
+	return amount
+}
+
+func pageRequest(page *proto.PageRequest, defaultAmount int64) *proto.PageRequest {
+	return &proto.PageRequest{Limit: pageAmount(page, defaultAmount), Cursor: page.GetCursor()}
+}
+
+// Альбом, плейлист, избранное и плейлисты пользователя раньше отдавались целиком,
+// поэтому без limit и cursor они по-прежнему возвращаются одной страницей
+func listRequest(page *proto.PageRequest) *proto.PageRequest {
+	if page.GetLimit() <= 0 && len(page.GetCursor()) == 0 {
+		return &proto.PageRequest{}
+	}
+
+	return pageRequest(page, constants.PageDefaultAmount)
+}
+
+func pageError(err error) error {
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return status.Error(codes.InvalidArgument, constants.CursorInvalidMessage)
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func nextPart(page *proto.PageResponse) pagination.Part {
+	return pagination.Part{Cursor: page.NextCursor, Total: page.TotalHint, Done: len(page.NextCursor) == 0}
+}
+
+func partsResponse(parts map[string]pagination.Part) *proto.PageResponse {
+	var totalHint int64
+	for _, part := range parts {
+		totalHint += part.Total
+	}
+
+	return &proto.PageResponse{NextCursor: pagination.EncodeParts(parts), TotalHint: totalHint}
+}
+
+func isRadioSeedType(seedType string) bool {
+	return seedType == constants.RadioSeedTrack || seedType == constants.RadioSeedArtist ||
+		seedType == constants.RadioSeedAlbum || seedType == constants.RadioSeedPlaylist
+}
+
+func radioSessionKey(token string) string {
+	return fmt.Sprintf("radio:%s", token)
+}
+
+func appendTrackIDs(trackIDs []int64, tracks []*proto.Track) []int64 {
+	for _, track := range tracks {
+		trackIDs = append(trackIDs, track.ID)
+	}
+	return trackIDs
+}
+
+// Знакомые треки распределяются по порции равномерно
+func mixTracks(familiar []*proto.Track, fresh []*proto.Track) []*proto.Track {
+	total := len(familiar) + len(fresh)
+	tracks := make([]*proto.Track, 0, total)
+	placed := 0
+	for i := 0; i < total; i++ {
+		if placed < len(familiar) && (i+1)*len(familiar) >= (placed+1)*total {
+			tracks = append(tracks, familiar[placed])
+			placed++
+		} else {
+			tracks = append(tracks, fresh[i-placed])
+		}
+	}
+	return tracks
+}
+
+func isChartPeriod(period string) bool {
+	return period == constants.ChartPeriodDaily || period == constants.ChartPeriodWeekly || period == constants.ChartPeriodMonthly
+}
+
+func chartPeriodBounds(period string, now time.Time) (start time.Time, end time.Time, previousStart time.Time) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch period {
+	case constants.ChartPeriodWeekly:
+		start = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7), start.AddDate(0, 0, -7)
+	case constants.ChartPeriodMonthly:
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0), start.AddDate(0, -1, 0)
+	default:
+		return day, day.AddDate(0, 0, 1), day.AddDate(0, 0, -1)
 	}
-	return false
 }
@@ -3,8 +3,10 @@ package usecase
 import (
 	"2021_2_LostPointer/internal/constants"
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -12,6 +14,7 @@ import (
 
 	"2021_2_LostPointer/internal/microservices/music/mock"
 	"2021_2_LostPointer/internal/microservices/music/proto"
+	"2021_2_LostPointer/pkg/pagination"
 )
 
 func TestMusicService_RandomTracks(t *testing.T) {
@@ -26,7 +29,16 @@ func TestMusicService_RandomTracks(t *testing.T) {
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				RandomTracksFunc: func(int64, int64, bool) (*proto.Tracks, error) {
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomTracksFunc: func([]int64, int64, bool) (*proto.Tracks, error) {
 					return &proto.Tracks{
 						Tracks: []*proto.Track{},
 					}, nil
@@ -34,16 +46,27 @@ func TestMusicService_RandomTracks(t *testing.T) {
 			},
 			input: &proto.RandomTracksOptions{
 				Amount:       1,
+				Seed:         42,
 				IsAuthorized: true,
 			},
 			expected: &proto.Tracks{
-				Tracks: []*proto.Track{},
+				Tracks:    []*proto.Track{},
+				Selection: &proto.Selection{Seed: 42, Generation: 1},
 			},
 		},
 		{
 			name: "Error 500. mock.RandomTracks returned error",
 			storageMock: &mock.MockStorage{
-				RandomTracksFunc: func(int64, int64, bool) (*proto.Tracks, error) {
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomTracksFunc: func([]int64, int64, bool) (*proto.Tracks, error) {
 					return nil, errors.New("error")
 				},
 			},
@@ -54,6 +77,83 @@ func TestMusicService_RandomTracks(t *testing.T) {
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
+		{
+			name: "Empty pool is rebuilt",
+			storageMock: func() *mock.MockStorage {
+				var size int64
+				return &mock.MockStorage{
+					RandomPoolGenerationFunc: func(string) (int64, error) {
+						return 0, nil
+					},
+					RandomPoolSizeFunc: func(entity string, generation int64) (int64, error) {
+						assert.Equal(t, int64(2), generation)
+						return size, nil
+					},
+					RebuildRandomPoolFunc: func(string) (int64, error) {
+						size = 10
+						return 2, nil
+					},
+					RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+						return positions, nil
+					},
+					RandomTracksFunc: func(trackIDs []int64, userID int64, isAuthorized bool) (*proto.Tracks, error) {
+						return &proto.Tracks{Tracks: []*proto.Track{}}, nil
+					},
+				}
+			}(),
+			input: &proto.RandomTracksOptions{Amount: 1, Seed: 42},
+			expected: &proto.Tracks{
+				Tracks:    []*proto.Track{},
+				Selection: &proto.Selection{Seed: 42, Generation: 2},
+			},
+		},
+		{
+			name: "Expired generation falls back to the current one",
+			storageMock: &mock.MockStorage{
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 3, nil
+				},
+				RandomPoolSizeFunc: func(entity string, generation int64) (int64, error) {
+					if generation == 2 {
+						return 0, nil
+					}
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					assert.Equal(t, int64(3), generation)
+					return positions, nil
+				},
+				RandomTracksFunc: func([]int64, int64, bool) (*proto.Tracks, error) {
+					return &proto.Tracks{Tracks: []*proto.Track{}}, nil
+				},
+			},
+			input: &proto.RandomTracksOptions{Amount: 1, Seed: 42, Generation: 2},
+			expected: &proto.Tracks{
+				Tracks:    []*proto.Track{},
+				Selection: &proto.Selection{Seed: 42, Generation: 3},
+			},
+		},
+		{
+			name: "Error 500. mock.RandomPoolSize returned error",
+			storageMock: &mock.MockStorage{
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 0, errors.New("error")
+				},
+			},
+			input:       &proto.RandomTracksOptions{Amount: 1},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name:        "Error 400. Negative page",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.RandomTracksOptions{Amount: 1, Page: -1},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.SelectionPageInvalidMessage),
+		},
 	}
 
 	for _, test := range tests {
@@ -73,6 +173,38 @@ func TestMusicService_RandomTracks(t *testing.T) {
 	}
 }
 
+func TestMusicService_RandomTracksSeededPages(t *testing.T) {
+	var positions [][]int64
+	storageMock := &mock.MockStorage{
+		RandomPoolGenerationFunc: func(string) (int64, error) {
+			return 1, nil
+		},
+		RandomPoolSizeFunc: func(string, int64) (int64, error) {
+			return 25, nil
+		},
+		RandomPoolIDsFunc: func(entity string, generation int64, pagePositions []int64) ([]int64, error) {
+			positions = append(positions, pagePositions)
+			return pagePositions, nil
+		},
+		RandomTracksFunc: func([]int64, int64, bool) (*proto.Tracks, error) {
+			return &proto.Tracks{}, nil
+		},
+	}
+	service := NewMusicService(storageMock)
+
+	for _, page := range []int64{0, 1, 0} {
+		_, err := service.RandomTracks(context.Background(), &proto.RandomTracksOptions{Amount: 10, Seed: 42, Page: page})
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, positions[0], 10)
+	assert.Len(t, positions[1], 10)
+	assert.Equal(t, positions[0], positions[2])
+	for _, position := range positions[1] {
+		assert.NotContains(t, positions[0], position)
+	}
+}
+
 func TestMusicService_RandomAlbums(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -85,21 +217,66 @@ func TestMusicService_RandomAlbums(t *testing.T) {
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				RandomAlbumsFunc: func(int64) (*proto.Albums, error) {
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomAlbumsFunc: func([]int64) (*proto.Albums, error) {
 					return &proto.Albums{
 						Albums: []*proto.Album{},
 					}, nil
 				},
 			},
-			input: &proto.RandomAlbumsOptions{Amount: 5},
+			input: &proto.RandomAlbumsOptions{Amount: 5, Seed: 42},
+			expected: &proto.Albums{
+				Albums:    []*proto.Album{},
+				Selection: &proto.Selection{Seed: 42, Generation: 1},
+			},
+		},
+		{
+			name: "Success. Saved albums are marked",
+			storageMock: &mock.MockStorage{
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomAlbumsFunc: func([]int64) (*proto.Albums, error) {
+					return &proto.Albums{Albums: []*proto.Album{{ID: 1}, {ID: 2}}}, nil
+				},
+				SavedAlbumIDsFunc: func(userID int64, albumIDs []int64) ([]int64, error) {
+					assert.Equal(t, int64(3), userID)
+					return []int64{1}, nil
+				},
+			},
+			input: &proto.RandomAlbumsOptions{Amount: 2, Seed: 42, UserID: 3, IsAuthorized: true},
 			expected: &proto.Albums{
-				Albums: []*proto.Album{},
+				Albums:    []*proto.Album{{ID: 1, IsSaved: true}, {ID: 2}},
+				Selection: &proto.Selection{Seed: 42, Generation: 1},
 			},
 		},
 		{
 			name: "Error 500. mock.RandomAlbums returned error",
 			storageMock: &mock.MockStorage{
-				RandomAlbumsFunc: func(int64) (*proto.Albums, error) {
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomAlbumsFunc: func([]int64) (*proto.Albums, error) {
 					return nil, errors.New("error")
 				},
 			},
@@ -138,21 +315,66 @@ func TestMusicService_RandomArtists(t *testing.T) {
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				RandomArtistsFunc: func(int64) (*proto.Artists, error) {
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomArtistsFunc: func([]int64) (*proto.Artists, error) {
 					return &proto.Artists{
 						Artists: []*proto.Artist{},
 					}, nil
 				},
 			},
-			input: &proto.RandomArtistsOptions{Amount: 5},
+			input: &proto.RandomArtistsOptions{Amount: 5, Seed: 42},
 			expected: &proto.Artists{
-				Artists: []*proto.Artist{},
+				Artists:   []*proto.Artist{},
+				Selection: &proto.Selection{Seed: 42, Generation: 1},
+			},
+		},
+		{
+			name: "Success. Followed artists are marked",
+			storageMock: &mock.MockStorage{
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomArtistsFunc: func([]int64) (*proto.Artists, error) {
+					return &proto.Artists{Artists: []*proto.Artist{{ID: 1}, {ID: 2}}}, nil
+				},
+				FollowedArtistIDsFunc: func(userID int64, artistIDs []int64) ([]int64, error) {
+					assert.Equal(t, int64(3), userID)
+					return []int64{2}, nil
+				},
+			},
+			input: &proto.RandomArtistsOptions{Amount: 2, Seed: 42, UserID: 3, IsAuthorized: true},
+			expected: &proto.Artists{
+				Artists:   []*proto.Artist{{ID: 1}, {ID: 2, IsFollowed: true}},
+				Selection: &proto.Selection{Seed: 42, Generation: 1},
 			},
 		},
 		{
 			name: "Error 500. mock.RandomArtists returned error",
 			storageMock: &mock.MockStorage{
-				RandomArtistsFunc: func(int64) (*proto.Artists, error) {
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					return positions, nil
+				},
+				RandomArtistsFunc: func([]int64) (*proto.Artists, error) {
 					return nil, errors.New("error")
 				},
 			},
@@ -191,7 +413,7 @@ func TestMusicService_ArtistProfile(t *testing.T) {
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				ArtistInfoFunc: func(int64) (*proto.Artist, error) {
+				ArtistInfoFunc: func(int64, int64) (*proto.Artist, error) {
 					return &proto.Artist{}, nil
 				},
 				ArtistTracksFunc: func(int64, int64, bool, int64) ([]*proto.Track, error) {
@@ -210,7 +432,7 @@ func TestMusicService_ArtistProfile(t *testing.T) {
 		{
 			name: "Error 500. mock.ArtistInfo returned error",
 			storageMock: &mock.MockStorage{
-				ArtistInfoFunc: func(int64) (*proto.Artist, error) {
+				ArtistInfoFunc: func(int64, int64) (*proto.Artist, error) {
 					return nil, errors.New("error")
 				},
 			},
@@ -224,7 +446,7 @@ func TestMusicService_ArtistProfile(t *testing.T) {
 		{
 			name: "Error 500. mock.ArtistTracks returned error",
 			storageMock: &mock.MockStorage{
-				ArtistInfoFunc: func(int64) (*proto.Artist, error) {
+				ArtistInfoFunc: func(int64, int64) (*proto.Artist, error) {
 					return &proto.Artist{}, nil
 				},
 				ArtistTracksFunc: func(int64, int64, bool, int64) ([]*proto.Track, error) {
@@ -241,7 +463,7 @@ func TestMusicService_ArtistProfile(t *testing.T) {
 		{
 			name: "Error 500. mock.ArtistAlbums returned error",
 			storageMock: &mock.MockStorage{
-				ArtistInfoFunc: func(int64) (*proto.Artist, error) {
+				ArtistInfoFunc: func(int64, int64) (*proto.Artist, error) {
 					return &proto.Artist{}, nil
 				},
 				ArtistTracksFunc: func(int64, int64, bool, int64) ([]*proto.Track, error) {
@@ -278,28 +500,104 @@ func TestMusicService_ArtistProfile(t *testing.T) {
 }
 
 func TestMusicService_IncrementListenCount(t *testing.T) {
+	allowListen := func(int64, string, int64, time.Time) (string, error) {
+		return "", nil
+	}
+	recordRejection := func(int64, string, int64, string) error {
+		return nil
+	}
+	trackFile := func(int64) (*proto.TrackFileResponse, error) {
+		return &proto.TrackFileResponse{File: "testFile"}, nil
+	}
+
 	tests := []struct {
-		name        string
-		storageMock *mock.MockStorage
-		input       *proto.IncrementListenCountOptions
-		expected    *proto.IncrementListenCountEmpty
-		expectedErr bool
-		err         error
+		name             string
+		storageMock      *mock.MockStorage
+		input            *proto.IncrementListenCountOptions
+		expected         *proto.IncrementListenCountEmpty
+		expectedErr      bool
+		err              error
+		expectedRejected string
 	}{
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				IncrementListenCountFunc: func(int64) error {
+				TrackFileFunc:     trackFile,
+				ReserveListenFunc: allowListen,
+				IncrementListenCountFunc: func(int64, int64) error {
 					return nil
 				},
 			},
-			input:    &proto.IncrementListenCountOptions{ID: 1},
+			input:    &proto.IncrementListenCountOptions{ID: 1, UserID: 1, IP: "10.0.0.1"},
 			expected: &proto.IncrementListenCountEmpty{},
 		},
+		{
+			name: "Error 429. User limit is exceeded",
+			storageMock: &mock.MockStorage{
+				TrackFileFunc: trackFile,
+				ReserveListenFunc: func(int64, string, int64, time.Time) (string, error) {
+					return constants.ListenRejectUserLimit, nil
+				},
+				RecordListenRejectionFunc: recordRejection,
+			},
+			input:            &proto.IncrementListenCountOptions{ID: 1, UserID: 1, IP: "10.0.0.1"},
+			expectedErr:      true,
+			err:              status.Error(codes.ResourceExhausted, constants.ListenLimitExceededMessage),
+			expectedRejected: constants.ListenRejectUserLimit,
+		},
+		{
+			name: "Error 404. Track does not exist and limits are not charged",
+			storageMock: &mock.MockStorage{
+				TrackFileFunc: func(int64) (*proto.TrackFileResponse, error) {
+					return nil, sql.ErrNoRows
+				},
+			},
+			input:       &proto.IncrementListenCountOptions{ID: 1, UserID: 1, IP: "10.0.0.1"},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.TrackNotFound),
+		},
+		{
+			name: "Error 404. Track was deleted after the limits were checked",
+			storageMock: &mock.MockStorage{
+				TrackFileFunc:     trackFile,
+				ReserveListenFunc: allowListen,
+				IncrementListenCountFunc: func(int64, int64) error {
+					return sql.ErrNoRows
+				},
+			},
+			input:       &proto.IncrementListenCountOptions{ID: 1, UserID: 1, IP: "10.0.0.1"},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.TrackNotFound),
+		},
+		{
+			name: "Error 500. mock.TrackFile returned error",
+			storageMock: &mock.MockStorage{
+				TrackFileFunc: func(int64) (*proto.TrackFileResponse, error) {
+					return nil, errors.New("error")
+				},
+			},
+			input:       &proto.IncrementListenCountOptions{ID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 500. mock.ReserveListen returned error",
+			storageMock: &mock.MockStorage{
+				TrackFileFunc: trackFile,
+				ReserveListenFunc: func(int64, string, int64, time.Time) (string, error) {
+					return "", errors.New("error")
+				},
+			},
+			input:       &proto.IncrementListenCountOptions{ID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
 		{
 			name: "Error 500. mock.IncrementListenCount returned error",
 			storageMock: &mock.MockStorage{
-				IncrementListenCountFunc: func(int64) error {
+				TrackFileFunc:     trackFile,
+				ReserveListenFunc: allowListen,
+				IncrementListenCountFunc: func(int64, int64) error {
 					return errors.New("error")
 				},
 			},
@@ -322,63 +620,54 @@ func TestMusicService_IncrementListenCount(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, res)
 			}
+			if len(currentTest.expectedRejected) != 0 {
+				calls := currentTest.storageMock.RecordListenRejectionCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, currentTest.expectedRejected, calls[0].Reason)
+				assert.Equal(t, "10.0.0.1", calls[0].Ip)
+			}
 		})
 	}
 }
 
-func TestMusicService_AlbumPage(t *testing.T) {
+func TestMusicService_TrackFile(t *testing.T) {
 	tests := []struct {
 		name        string
 		storageMock *mock.MockStorage
-		input       *proto.AlbumPageOptions
-		expected    *proto.AlbumPageResponse
+		input       *proto.TrackFileOptions
+		expected    *proto.TrackFileResponse
 		expectedErr bool
 		err         error
 	}{
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				AlbumDataFunc: func(int64) (*proto.AlbumPageResponse, error) {
-					return &proto.AlbumPageResponse{}, nil
+				TrackFileFunc: func(int64) (*proto.TrackFileResponse, error) {
+					return &proto.TrackFileResponse{File: "testFile", Lossless: true}, nil
 				},
-				AlbumTracksFunc: func(int64, int64, bool) ([]*proto.AlbumTrack, error) {
-					return nil, nil
-				},
-			},
-			input: &proto.AlbumPageOptions{
-				AlbumID:      1,
-				IsAuthorized: true,
 			},
-			expected: &proto.AlbumPageResponse{},
+			input:    &proto.TrackFileOptions{TrackID: 1},
+			expected: &proto.TrackFileResponse{File: "testFile", Lossless: true},
 		},
 		{
-			name: "Error 500. mock.AlbumData returned error",
+			name: "Error 404. Track doesn't exist",
 			storageMock: &mock.MockStorage{
-				AlbumDataFunc: func(int64) (*proto.AlbumPageResponse, error) {
-					return nil, errors.New("error")
+				TrackFileFunc: func(int64) (*proto.TrackFileResponse, error) {
+					return nil, sql.ErrNoRows
 				},
 			},
-			input: &proto.AlbumPageOptions{
-				AlbumID:      1,
-				IsAuthorized: true,
-			},
+			input:       &proto.TrackFileOptions{TrackID: 1},
 			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
+			err:         status.Error(codes.NotFound, constants.TrackNotFound),
 		},
 		{
-			name: "Error 500. mock.AlbumTracks returned error",
+			name: "Error 500. mock.TrackFile returned error",
 			storageMock: &mock.MockStorage{
-				AlbumDataFunc: func(int64) (*proto.AlbumPageResponse, error) {
-					return &proto.AlbumPageResponse{}, nil
-				},
-				AlbumTracksFunc: func(int64, int64, bool) ([]*proto.AlbumTrack, error) {
+				TrackFileFunc: func(int64) (*proto.TrackFileResponse, error) {
 					return nil, errors.New("error")
 				},
 			},
-			input: &proto.AlbumPageOptions{
-				AlbumID:      1,
-				IsAuthorized: true,
-			},
+			input:       &proto.TrackFileOptions{TrackID: 1},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
@@ -389,7 +678,7 @@ func TestMusicService_AlbumPage(t *testing.T) {
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
 
-			res, err := storage.AlbumPage(context.Background(), currentTest.input)
+			res, err := storage.TrackFile(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
@@ -401,36 +690,51 @@ func TestMusicService_AlbumPage(t *testing.T) {
 	}
 }
 
-func TestMusicService_UserPlaylists(t *testing.T) {
+func TestMusicService_GetLyrics(t *testing.T) {
+	lyrics := &proto.Lyrics{
+		TrackID: 1,
+		Plain:   "First",
+		Synced:  true,
+		Lines:   []*proto.LyricsLine{{Time: 1500, Text: "First"}},
+	}
+
 	tests := []struct {
 		name        string
 		storageMock *mock.MockStorage
-		input       *proto.UserPlaylistsOptions
-		expected    *proto.PlaylistsData
+		input       *proto.LyricsOptions
+		expected    *proto.Lyrics
 		expectedErr bool
 		err         error
 	}{
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				UserPlaylistsFunc: func(int64) ([]*proto.PlaylistData, error) {
-					return []*proto.PlaylistData{}, nil
-
+				LyricsFunc: func(int64) (*proto.Lyrics, error) {
+					return lyrics, nil
 				},
 			},
-			input: &proto.UserPlaylistsOptions{UserID: 1},
-			expected: &proto.PlaylistsData{
-				Playlists: []*proto.PlaylistData{},
+			input:    &proto.LyricsOptions{TrackID: 1},
+			expected: lyrics,
+		},
+		{
+			name: "Error 404. Track has no lyrics",
+			storageMock: &mock.MockStorage{
+				LyricsFunc: func(int64) (*proto.Lyrics, error) {
+					return nil, sql.ErrNoRows
+				},
 			},
+			input:       &proto.LyricsOptions{TrackID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.LyricsNotFoundMessage),
 		},
 		{
-			name: "Error 500. mock.UserPlaylists returned error",
+			name: "Error 500. mock.Lyrics returned error",
 			storageMock: &mock.MockStorage{
-				UserPlaylistsFunc: func(int64) ([]*proto.PlaylistData, error) {
+				LyricsFunc: func(int64) (*proto.Lyrics, error) {
 					return nil, errors.New("error")
 				},
 			},
-			input:       &proto.UserPlaylistsOptions{UserID: 1},
+			input:       &proto.LyricsOptions{TrackID: 1},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
@@ -441,7 +745,7 @@ func TestMusicService_UserPlaylists(t *testing.T) {
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
 
-			res, err := storage.UserPlaylists(context.Background(), currentTest.input)
+			res, err := storage.GetLyrics(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
@@ -453,206 +757,186 @@ func TestMusicService_UserPlaylists(t *testing.T) {
 	}
 }
 
-func TestMusicService_Find(t *testing.T) {
+func TestMusicService_GetTrack(t *testing.T) {
+	track := &proto.Track{ID: 1, Title: "Title", PlaylistIDs: []int64{2}}
+
 	tests := []struct {
 		name        string
 		storageMock *mock.MockStorage
-		input       *proto.FindOptions
-		expected    *proto.FindResponse
+		input       *proto.GetTrackOptions
+		expected    *proto.Track
 		expectedErr bool
 		err         error
 	}{
 		{
-			name: "Success, contain returned true",
-			storageMock: &mock.MockStorage{
-				FindTracksByFullWordFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{
-						{
-							ID:          0,
-							Title:       "",
-							Explicit:    false,
-							Genre:       "",
-							Number:      0,
-							File:        "",
-							ListenCount: 0,
-							Duration:    0,
-							Lossless:    false,
-							Album:       nil,
-							Artist:      nil,
-						},
-					}, nil
-				},
-				FindTracksByPartialFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{
-						{
-							ID:          0,
-							Title:       "",
-							Explicit:    false,
-							Genre:       "",
-							Number:      0,
-							File:        "",
-							ListenCount: 0,
-							Duration:    0,
-							Lossless:    false,
-							Album:       nil,
-							Artist:      nil,
-						},
-					}, nil
-				},
-				FindArtistsFunc: func(string) ([]*proto.Artist, error) {
-					return []*proto.Artist{}, nil
-				},
-				FindAlbumsFunc: func(string) ([]*proto.Album, error) {
-					return []*proto.Album{}, nil
-				},
-			},
-			input: &proto.FindOptions{
-				Text:         "lahaine",
-				IsAuthorized: true,
-			},
-			expected: &proto.FindResponse{
-				Tracks: []*proto.Track{
-					{
-						ID:          0,
-						Title:       "",
-						Explicit:    false,
-						Genre:       "",
-						Number:      0,
-						File:        "",
-						ListenCount: 0,
-						Duration:    0,
-						Lossless:    false,
-						Album:       nil,
-						Artist:      nil,
-					},
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				TracksByIDsFunc: func([]int64, int64, bool) ([]*proto.Track, error) {
+					return []*proto.Track{track}, nil
 				},
-				Albums:  []*proto.Album{},
-				Artists: []*proto.Artist{},
 			},
+			input:    &proto.GetTrackOptions{TrackID: 1, UserID: 1, IsAuthorized: true},
+			expected: track,
 		},
 		{
-			name: "Success, contain returned false",
+			name: "Error 404. Track not found",
 			storageMock: &mock.MockStorage{
-				FindTracksByFullWordFunc: func(string, int64, bool) ([]*proto.Track, error) {
+				TracksByIDsFunc: func([]int64, int64, bool) ([]*proto.Track, error) {
 					return []*proto.Track{}, nil
 				},
-				FindTracksByPartialFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{
-						{
-							ID:          0,
-							Title:       "",
-							Explicit:    false,
-							Genre:       "",
-							Number:      0,
-							File:        "",
-							ListenCount: 0,
-							Duration:    0,
-							Lossless:    false,
-							Album:       nil,
-							Artist:      nil,
-						},
-					}, nil
-				},
-				FindArtistsFunc: func(string) ([]*proto.Artist, error) {
-					return []*proto.Artist{}, nil
-				},
-				FindAlbumsFunc: func(string) ([]*proto.Album, error) {
-					return []*proto.Album{}, nil
-				},
-			},
-			input: &proto.FindOptions{
-				Text:         "lahaine",
-				IsAuthorized: true,
-			},
-			expected: &proto.FindResponse{
-				Tracks: []*proto.Track{
-					{
-						ID:          0,
-						Title:       "",
-						Explicit:    false,
-						Genre:       "",
-						Number:      0,
-						File:        "",
-						ListenCount: 0,
-						Duration:    0,
-						Lossless:    false,
-						Album:       nil,
-						Artist:      nil,
-					},
-				},
-				Albums:  []*proto.Album{},
-				Artists: []*proto.Artist{},
 			},
+			input:       &proto.GetTrackOptions{TrackID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.TrackNotFound),
 		},
 		{
-			name: "Error 500. mock.FindTracksByFullWord returned error",
+			name: "Error 500. mock.TracksByIDs returned error",
 			storageMock: &mock.MockStorage{
-				FindTracksByFullWordFunc: func(string, int64, bool) ([]*proto.Track, error) {
+				TracksByIDsFunc: func([]int64, int64, bool) ([]*proto.Track, error) {
 					return nil, errors.New("error")
 				},
 			},
-			input: &proto.FindOptions{
-				Text:         "lahaine",
-				IsAuthorized: true,
-			},
+			input:       &proto.GetTrackOptions{TrackID: 1},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.GetTrack(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_GetTracks(t *testing.T) {
+	first := &proto.Track{ID: 1, Title: "First"}
+	second := &proto.Track{ID: 2, Title: "Second"}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.GetTracksOptions
+		expected    *proto.Tracks
+		expectedErr bool
+		err         error
+	}{
 		{
-			name: "Error 500. mock.FindTracksByPartial returned error",
+			name: "Success. Tracks keep requested order, duplicates and missing tracks are skipped",
 			storageMock: &mock.MockStorage{
-				FindTracksByFullWordFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
+				TracksByIDsFunc: func(trackIDs []int64, userID int64, isAuthorized bool) ([]*proto.Track, error) {
+					assert.Equal(t, []int64{2, 3, 1}, trackIDs)
+					return []*proto.Track{first, second}, nil
 				},
-				FindTracksByPartialFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return nil, errors.New("error")
-				},
-			},
-			input: &proto.FindOptions{
-				Text:         "lahaine",
-				IsAuthorized: true,
 			},
+			input:    &proto.GetTracksOptions{IDs: []int64{2, 3, 2, 1}, UserID: 1, IsAuthorized: true},
+			expected: &proto.Tracks{Tracks: []*proto.Track{second, first}},
+		},
+		{
+			name:        "Error 400. No track ids",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.GetTracksOptions{},
 			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
+			err:         status.Error(codes.InvalidArgument, constants.TrackIDsRequiredMessage),
+		},
+		{
+			name:        "Error 400. Too many track ids",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.GetTracksOptions{IDs: make([]int64, constants.TracksBatchMaxAmount+1)},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.TrackIDsTooManyMessage),
 		},
 		{
-			name: "Error 500. mock.FindArtists returned error",
+			name: "Error 500. mock.TracksByIDs returned error",
 			storageMock: &mock.MockStorage{
-				FindTracksByFullWordFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
-				},
-				FindTracksByPartialFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
-				},
-				FindArtistsFunc: func(string) ([]*proto.Artist, error) {
+				TracksByIDsFunc: func([]int64, int64, bool) ([]*proto.Track, error) {
 					return nil, errors.New("error")
 				},
 			},
-			input: &proto.FindOptions{
-				Text:         "lahaine",
-				IsAuthorized: true,
-			},
+			input:       &proto.GetTracksOptions{IDs: []int64{1}},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.GetTracks(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_AlbumPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.AlbumPageOptions
+		expected    *proto.AlbumPageResponse
+		expectedErr bool
+		err         error
+	}{
 		{
-			name: "Error 500. mock.FindAlbums returned error",
+			name: "Success",
 			storageMock: &mock.MockStorage{
-				FindTracksByFullWordFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
-				},
-				FindTracksByPartialFunc: func(string, int64, bool) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
+				AlbumDataFunc: func(int64, int64) (*proto.AlbumPageResponse, error) {
+					return &proto.AlbumPageResponse{}, nil
 				},
-				FindArtistsFunc: func(string) ([]*proto.Artist, error) {
-					return []*proto.Artist{}, nil
+				AlbumTracksFunc: func(int64, int64, bool, *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error) {
+					return nil, nil, nil
 				},
-				FindAlbumsFunc: func(string) ([]*proto.Album, error) {
+			},
+			input: &proto.AlbumPageOptions{
+				AlbumID:      1,
+				IsAuthorized: true,
+			},
+			expected: &proto.AlbumPageResponse{},
+		},
+		{
+			name: "Error 500. mock.AlbumData returned error",
+			storageMock: &mock.MockStorage{
+				AlbumDataFunc: func(int64, int64) (*proto.AlbumPageResponse, error) {
 					return nil, errors.New("error")
 				},
 			},
-			input: &proto.FindOptions{
-				Text:         "lahaine",
+			input: &proto.AlbumPageOptions{
+				AlbumID:      1,
+				IsAuthorized: true,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 500. mock.AlbumTracks returned error",
+			storageMock: &mock.MockStorage{
+				AlbumDataFunc: func(int64, int64) (*proto.AlbumPageResponse, error) {
+					return &proto.AlbumPageResponse{}, nil
+				},
+				AlbumTracksFunc: func(int64, int64, bool, *proto.PageRequest) ([]*proto.AlbumTrack, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+			},
+			input: &proto.AlbumPageOptions{
+				AlbumID:      1,
 				IsAuthorized: true,
 			},
 			expectedErr: true,
@@ -665,7 +949,7 @@ func TestMusicService_Find(t *testing.T) {
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
 
-			res, err := storage.Find(context.Background(), currentTest.input)
+			res, err := storage.AlbumPage(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
@@ -677,186 +961,2416 @@ func TestMusicService_Find(t *testing.T) {
 	}
 }
 
-func TestMusicService_PlaylistPage(t *testing.T) {
+func TestMusicService_UserPlaylists(t *testing.T) {
 	tests := []struct {
 		name        string
 		storageMock *mock.MockStorage
-		input       *proto.PlaylistPageOptions
-		expected    *proto.PlaylistPageResponse
+		input       *proto.UserPlaylistsOptions
+		expected    *proto.PlaylistsData
 		expectedErr bool
 		err         error
 	}{
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return true, nil
-				},
-				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
-					return true, nil
-				},
-				PlaylistInfoFunc: func(int64) (*proto.PlaylistData, error) {
-					return &proto.PlaylistData{}, nil
-				},
-				PlaylistTracksFunc: func(int64, int64) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
-				},
-				IsPlaylistPublicFunc: func(int64) (bool, error) {
-					return true, nil
+				UserPlaylistsFunc: func(userID int64, page *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+					assert.Equal(t, &proto.PageRequest{}, page)
+					return []*proto.PlaylistData{}, nil, nil
+
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
-			},
-			expected: &proto.PlaylistPageResponse{
-				Tracks: []*proto.Track{},
-				IsOwn:  true,
+			input: &proto.UserPlaylistsOptions{UserID: 1},
+			expected: &proto.PlaylistsData{
+				Playlists: []*proto.PlaylistData{},
 			},
 		},
 		{
-			name: "Error 500. mock.DoesPlaylistExist returned error",
+			name: "Success. Cursor without limit uses default page size",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return false, errors.New("error")
+				UserPlaylistsFunc: func(userID int64, page *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+					assert.Equal(t, &proto.PageRequest{Limit: constants.PageDefaultAmount, Cursor: "next"}, page)
+					return []*proto.PlaylistData{}, nil, nil
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
+			input: &proto.UserPlaylistsOptions{UserID: 1, Page: &proto.PageRequest{Cursor: "next"}},
+			expected: &proto.PlaylistsData{
+				Playlists: []*proto.PlaylistData{},
 			},
-			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
 		},
 		{
-			name: "Error 404. mock.DoesPlaylistExist returned false, playlist does not exist",
+			name: "Error 500. mock.UserPlaylists returned error",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return false, nil
+				UserPlaylistsFunc: func(int64, *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
-			},
+			input:       &proto.UserPlaylistsOptions{UserID: 1},
 			expectedErr: true,
-			err:         status.Error(codes.NotFound, constants.PlaylistNotFoundMessage),
+			err:         status.Error(codes.Internal, "error"),
 		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.UserPlaylists(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_Find(t *testing.T) {
+	tracks := []*proto.Track{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+	albums := []*proto.Album{{ID: 1}}
+	tracksCursor := pagination.EncodeParts(map[string]pagination.Part{
+		tracksPart:  {Cursor: "tracks", Total: 10},
+		artistsPart: {Total: 1, Done: true},
+		albumsPart:  {Total: 1, Done: true},
+	})
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.FindOptions
+		expected    *proto.FindResponse
+		expectedErr bool
+		err         error
+	}{
 		{
-			name: "Error 500. mock.IsPlaylistOwner returned error",
+			name: "Success. First page of every entity",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return true, nil
+				SearchTracksFunc: func(text string, filter *proto.SearchFilter, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.SearchTracksAmount), page.Limit)
+					return tracks, &proto.PageResponse{NextCursor: "tracks", TotalHint: 10}, nil
 				},
-				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
-					return false, errors.New("error")
+				SearchArtistsFunc: func(text string, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.SearchArtistsAmount), page.Limit)
+					return []*proto.Artist{{ID: 1}}, &proto.PageResponse{TotalHint: 1}, nil
+				},
+				SearchAlbumsFunc: func(text string, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.SearchAlbumsAmount), page.Limit)
+					return []*proto.Album{{ID: 1}}, &proto.PageResponse{TotalHint: 1}, nil
+				},
+				FollowedArtistIDsFunc: func(userID int64, artistIDs []int64) ([]int64, error) {
+					assert.Equal(t, []int64{1}, artistIDs)
+					return []int64{1}, nil
+				},
+				SavedAlbumIDsFunc: func(userID int64, albumIDs []int64) ([]int64, error) {
+					assert.Equal(t, []int64{1}, albumIDs)
+					return []int64{}, nil
+				},
+				RecordSearchFunc: func(userID int64, query string, resultType string, resultID int64) error {
+					assert.Equal(t, int64(1), userID)
+					assert.Equal(t, "lahaine", query)
+					return nil
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
+			input: &proto.FindOptions{
+				Text:         " lahaine ",
+				UserID:       1,
+				IsAuthorized: true,
+			},
+			expected: &proto.FindResponse{
+				Tracks:  tracks,
+				Albums:  albums,
+				Artists: []*proto.Artist{{ID: 1, IsFollowed: true}},
+				Page:    &proto.PageResponse{NextCursor: tracksCursor, TotalHint: 12},
 			},
-			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
 		},
 		{
-			name: "Error 403. mock.IsPlaylistOwner returned false, user is not playlist owner",
+			name: "Success. Next page skips finished entities",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return true, nil
+				SearchTracksFunc: func(text string, filter *proto.SearchFilter, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					assert.Equal(t, "tracks", page.Cursor)
+					assert.Equal(t, int64(2), page.Limit)
+					return tracks[:2], &proto.PageResponse{TotalHint: 10}, nil
 				},
-				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
-					return false, nil
+			},
+			input: &proto.FindOptions{
+				Text: "lahaine",
+				Page: &proto.PageRequest{Limit: 2, Cursor: tracksCursor},
+			},
+			expected: &proto.FindResponse{
+				Tracks:  tracks[:2],
+				Albums:  []*proto.Album{},
+				Artists: []*proto.Artist{},
+				Page:    &proto.PageResponse{TotalHint: 12},
+			},
+		},
+		{
+			name:        "Success. Empty text",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{Text: "   "},
+			expected:    &proto.FindResponse{},
+		},
+		{
+			name: "Success. Recent search error is ignored",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return []*proto.Track{}, &proto.PageResponse{}, nil
 				},
-				IsPlaylistPublicFunc: func(int64) (bool, error) {
-					return false, nil
+				SearchArtistsFunc: func(string, *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					return []*proto.Artist{}, &proto.PageResponse{}, nil
 				},
-				PlaylistInfoFunc: func(int64) (*proto.PlaylistData, error) {
-					return &proto.PlaylistData{}, nil
+				SearchAlbumsFunc: func(string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return []*proto.Album{}, &proto.PageResponse{}, nil
 				},
-				PlaylistTracksFunc: func(int64, int64) ([]*proto.Track, error) {
-					return []*proto.Track{}, nil
+				RecordSearchFunc: func(int64, string, string, int64) error {
+					return errors.New("error")
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
+			input: &proto.FindOptions{
+				Text:         "lahaine",
+				IsAuthorized: true,
+			},
+			expected: &proto.FindResponse{
+				Tracks:  []*proto.Track{},
+				Albums:  []*proto.Album{},
+				Artists: []*proto.Artist{},
+				Page:    &proto.PageResponse{},
 			},
-			expectedErr: true,
-			err:         status.Error(codes.PermissionDenied, constants.NotPlaylistOwnerMessage),
 		},
 		{
-			name: "Error 500. mock.PlaylistInfo returned error",
+			name: "Success. Only filters search tracks",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return true, nil
+				SearchTracksFunc: func(text string, filter *proto.SearchFilter, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					assert.Equal(t, "", text)
+					assert.Equal(t, &proto.SearchFilter{YearFrom: 2010, YearTo: 2015}, filter)
+					return tracks, &proto.PageResponse{TotalHint: 4}, nil
 				},
-				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
-					return true, nil
+			},
+			input: &proto.FindOptions{Text: "year:2010..2015"},
+			expected: &proto.FindResponse{
+				Tracks:  tracks,
+				Albums:  []*proto.Album{},
+				Artists: []*proto.Artist{},
+				Page:    &proto.PageResponse{TotalHint: 4},
+			},
+		},
+		{
+			name: "Success. Search without text is not recorded",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return tracks, &proto.PageResponse{TotalHint: 4}, nil
 				},
-				PlaylistInfoFunc: func(int64) (*proto.PlaylistData, error) {
-					return nil, errors.New("error")
+			},
+			input: &proto.FindOptions{
+				Text:         " ",
+				Filter:       &proto.SearchFilter{GenreID: 2},
+				UserID:       1,
+				IsAuthorized: true,
+			},
+			expected: &proto.FindResponse{
+				Tracks:  tracks,
+				Albums:  []*proto.Album{},
+				Artists: []*proto.Artist{},
+				Page:    &proto.PageResponse{TotalHint: 4},
+			},
+		},
+		{
+			name:        "Error 400. Invalid query",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{Text: "lahaine year:abc"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.SearchQueryInvalidMessage+": invalid value for year at position 14"),
+		},
+		{
+			name:        "Error 400. Invalid cursor",
+			storageMock: &mock.MockStorage{},
+			input: &proto.FindOptions{
+				Text: "lahaine",
+				Page: &proto.PageRequest{Cursor: "!!!"},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.CursorInvalidMessage),
+		},
+		{
+			name: "Error 400. mock.SearchTracks returned invalid cursor",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, pagination.ErrInvalidCursor
 				},
-				IsPlaylistPublicFunc: func(int64) (bool, error) {
-					return true, nil
+			},
+			input: &proto.FindOptions{
+				Text:         "lahaine",
+				IsAuthorized: true,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.CursorInvalidMessage),
+		},
+		{
+			name: "Error 500. mock.SearchTracks returned error",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
+			input: &proto.FindOptions{
+				Text:         "lahaine",
+				IsAuthorized: true,
 			},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
 		{
-			name: "Error 500. mock.PlaylistTracks returned error",
+			name: "Error 500. mock.SearchArtists returned error",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return true, nil
-				},
-				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
-					return true, nil
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return tracks, &proto.PageResponse{}, nil
 				},
-				PlaylistInfoFunc: func(int64) (*proto.PlaylistData, error) {
-					return &proto.PlaylistData{}, nil
+				SearchArtistsFunc: func(string, *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
 				},
-				PlaylistTracksFunc: func(int64, int64) ([]*proto.Track, error) {
-					return nil, errors.New("error")
+			},
+			input: &proto.FindOptions{
+				Text:         "lahaine",
+				IsAuthorized: true,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 500. mock.SearchAlbums returned error",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return tracks, &proto.PageResponse{}, nil
+				},
+				SearchArtistsFunc: func(string, *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					return []*proto.Artist{}, &proto.PageResponse{}, nil
+				},
+				SearchAlbumsFunc: func(string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+			},
+			input: &proto.FindOptions{
+				Text:         "lahaine",
+				IsAuthorized: true,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.Find(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_SearchTracks(t *testing.T) {
+	tracks := []*proto.Track{{ID: 1}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.FindOptions
+		expected    *proto.Tracks
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(text string, filter *proto.SearchFilter, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					assert.Equal(t, "lahaine", text)
+					assert.Equal(t, int64(constants.SearchPageAmount), page.Limit)
+					return tracks, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input:    &proto.FindOptions{Text: "lahaine "},
+			expected: &proto.Tracks{Tracks: tracks, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name:        "Success. Empty text",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{},
+			expected:    &proto.Tracks{Tracks: []*proto.Track{}},
+		},
+		{
+			name: "Success. Query filters are merged with options filter",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(text string, filter *proto.SearchFilter, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					lossless := true
+					assert.Equal(t, "la haine", text)
+					assert.Equal(t, &proto.SearchFilter{GenreID: 2, Artist: "Face Bar", DurationTo: 210, Lossless: &lossless}, filter)
+					return tracks, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input: &proto.FindOptions{
+				Text:   `artist:"Face Bar" la duration:..3:30 haine lossless:true`,
+				Filter: &proto.SearchFilter{GenreID: 2},
+			},
+			expected: &proto.Tracks{Tracks: tracks, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name:        "Error 400. Invalid query",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{Text: `artist:"Face`},
+			expected:    &proto.Tracks{},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.SearchQueryInvalidMessage+": unterminated quote at position 8"),
+		},
+		{
+			name: "Error 400. Invalid cursor",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, pagination.ErrInvalidCursor
+				},
+			},
+			input:       &proto.FindOptions{Text: "lahaine"},
+			expected:    &proto.Tracks{},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.CursorInvalidMessage),
+		},
+		{
+			name: "Error 500. mock.SearchTracks returned error",
+			storageMock: &mock.MockStorage{
+				SearchTracksFunc: func(string, *proto.SearchFilter, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+			},
+			input:       &proto.FindOptions{Text: "lahaine"},
+			expected:    &proto.Tracks{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.SearchTracks(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_SearchAlbums(t *testing.T) {
+	albums := []*proto.Album{{ID: 1}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.FindOptions
+		expected    *proto.Albums
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				SearchAlbumsFunc: func(text string, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					assert.Equal(t, int64(5), page.Limit)
+					return albums, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input:    &proto.FindOptions{Text: "lahaine", Page: &proto.PageRequest{Limit: 5}},
+			expected: &proto.Albums{Albums: albums, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name: "Success. Saved albums are marked",
+			storageMock: &mock.MockStorage{
+				SearchAlbumsFunc: func(string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return []*proto.Album{{ID: 1}, {ID: 2}}, &proto.PageResponse{TotalHint: 2}, nil
+				},
+				SavedAlbumIDsFunc: func(userID int64, albumIDs []int64) ([]int64, error) {
+					assert.Equal(t, int64(3), userID)
+					assert.Equal(t, []int64{1, 2}, albumIDs)
+					return []int64{2}, nil
+				},
+			},
+			input: &proto.FindOptions{Text: "lahaine", UserID: 3, IsAuthorized: true},
+			expected: &proto.Albums{
+				Albums: []*proto.Album{{ID: 1}, {ID: 2, IsSaved: true}},
+				Page:   &proto.PageResponse{TotalHint: 2},
+			},
+		},
+		{
+			name: "Error 500. mock.SavedAlbumIDs returned error",
+			storageMock: &mock.MockStorage{
+				SearchAlbumsFunc: func(string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return []*proto.Album{{ID: 1}}, &proto.PageResponse{TotalHint: 1}, nil
+				},
+				SavedAlbumIDsFunc: func(int64, []int64) ([]int64, error) {
+					return nil, errors.New("error")
+				},
+			},
+			input:       &proto.FindOptions{Text: "lahaine", UserID: 3, IsAuthorized: true},
+			expected:    &proto.Albums{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name:        "Success. Empty text",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{},
+			expected:    &proto.Albums{Albums: []*proto.Album{}},
+		},
+		{
+			name: "Error 500. mock.SearchAlbums returned error",
+			storageMock: &mock.MockStorage{
+				SearchAlbumsFunc: func(string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+			},
+			input:       &proto.FindOptions{Text: "lahaine"},
+			expected:    &proto.Albums{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.SearchAlbums(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_SearchArtists(t *testing.T) {
+	artists := []*proto.Artist{{ID: 1}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.FindOptions
+		expected    *proto.Artists
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				SearchArtistsFunc: func(text string, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.PageMaxAmount), page.Limit)
+					return artists, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input:    &proto.FindOptions{Text: "lahaine", Page: &proto.PageRequest{Limit: 1000}},
+			expected: &proto.Artists{Artists: artists, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name: "Success. Followed artists are marked",
+			storageMock: &mock.MockStorage{
+				SearchArtistsFunc: func(string, *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					return []*proto.Artist{{ID: 1}, {ID: 2}}, &proto.PageResponse{TotalHint: 2}, nil
+				},
+				FollowedArtistIDsFunc: func(userID int64, artistIDs []int64) ([]int64, error) {
+					assert.Equal(t, int64(3), userID)
+					assert.Equal(t, []int64{1, 2}, artistIDs)
+					return []int64{1}, nil
+				},
+			},
+			input: &proto.FindOptions{Text: "lahaine", UserID: 3, IsAuthorized: true},
+			expected: &proto.Artists{
+				Artists: []*proto.Artist{{ID: 1, IsFollowed: true}, {ID: 2}},
+				Page:    &proto.PageResponse{TotalHint: 2},
+			},
+		},
+		{
+			name:        "Success. Empty text",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{},
+			expected:    &proto.Artists{Artists: []*proto.Artist{}},
+		},
+		{
+			name: "Error 500. mock.SearchArtists returned error",
+			storageMock: &mock.MockStorage{
+				SearchArtistsFunc: func(string, *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+			},
+			input:       &proto.FindOptions{Text: "lahaine"},
+			expected:    &proto.Artists{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.SearchArtists(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_SearchPlaylists(t *testing.T) {
+	playlists := []*proto.PlaylistData{{PlaylistID: 1}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.FindOptions
+		expected    *proto.PlaylistsData
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				SearchPlaylistsFunc: func(text string, userID int64, page *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+					assert.Equal(t, int64(1), userID)
+					return playlists, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input:    &proto.FindOptions{Text: "lahaine", UserID: 1},
+			expected: &proto.PlaylistsData{Playlists: playlists, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name:        "Success. Empty text",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.FindOptions{},
+			expected:    &proto.PlaylistsData{Playlists: []*proto.PlaylistData{}},
+		},
+		{
+			name: "Error 500. mock.SearchPlaylists returned error",
+			storageMock: &mock.MockStorage{
+				SearchPlaylistsFunc: func(string, int64, *proto.PageRequest) ([]*proto.PlaylistData, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+			},
+			input:       &proto.FindOptions{Text: "lahaine"},
+			expected:    &proto.PlaylistsData{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.SearchPlaylists(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_Suggest(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.SuggestOptions
+		expected    *proto.Suggestions
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success. Cache miss for authorized user",
+			storageMock: &mock.MockStorage{
+				CachedSuggestionsFunc: func(key string) ([]*proto.Suggestion, bool, error) {
+					assert.Equal(t, "suggestions:1:8:la", key)
+					return nil, false, nil
+				},
+				SuggestFunc: func(prefix string, userID int64, amount int64) ([]*proto.Suggestion, error) {
+					assert.Equal(t, "La", prefix)
+					assert.Equal(t, int64(1), userID)
+					assert.Equal(t, int64(constants.SuggestDefaultAmount), amount)
+					return []*proto.Suggestion{{Type: constants.SuggestionTypeTrack, ID: 1, Title: "Lahaine la lave"}}, nil
+				},
+				CacheSuggestionsFunc: func(key string, suggestions []*proto.Suggestion, lifetime time.Duration) error {
+					assert.Equal(t, constants.PersonalSuggestionsCacheLifetime, lifetime)
+					return nil
+				},
+			},
+			input: &proto.SuggestOptions{Prefix: " La ", UserID: 1, IsAuthorized: true},
+			expected: &proto.Suggestions{Suggestions: []*proto.Suggestion{{
+				Type:       constants.SuggestionTypeTrack,
+				ID:         1,
+				Title:      "Lahaine la lave",
+				Highlights: []*proto.MatchRange{{Start: 0, End: 2}, {Start: 8, End: 10}, {Start: 11, End: 13}},
+			}}},
+		},
+		{
+			name: "Success. Cache hit for anonymous user",
+			storageMock: &mock.MockStorage{
+				CachedSuggestionsFunc: func(key string) ([]*proto.Suggestion, bool, error) {
+					assert.Equal(t, "suggestions:-1:20:ла", key)
+					return []*proto.Suggestion{{Type: constants.SuggestionTypeArtist, ID: 2, Title: "Лана"}}, true, nil
+				},
+			},
+			input: &proto.SuggestOptions{Prefix: "Ла", UserID: -1, Limit: 100},
+			expected: &proto.Suggestions{Suggestions: []*proto.Suggestion{{
+				Type:       constants.SuggestionTypeArtist,
+				ID:         2,
+				Title:      "Лана",
+				Highlights: []*proto.MatchRange{{Start: 0, End: 2}},
+			}}},
+		},
+		{
+			name: "Success. Cache errors do not break suggestions",
+			storageMock: &mock.MockStorage{
+				CachedSuggestionsFunc: func(string) ([]*proto.Suggestion, bool, error) {
+					return nil, false, errors.New("error")
+				},
+				SuggestFunc: func(string, int64, int64) ([]*proto.Suggestion, error) {
+					return []*proto.Suggestion{}, nil
+				},
+				CacheSuggestionsFunc: func(string, []*proto.Suggestion, time.Duration) error {
+					return errors.New("error")
+				},
+			},
+			input:    &proto.SuggestOptions{Prefix: "la"},
+			expected: &proto.Suggestions{Suggestions: []*proto.Suggestion{}},
+		},
+		{
+			name:        "Success. Empty prefix",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.SuggestOptions{Prefix: "  "},
+			expected:    &proto.Suggestions{Suggestions: []*proto.Suggestion{}},
+		},
+		{
+			name: "Error 500. mock.Suggest returned error",
+			storageMock: &mock.MockStorage{
+				CachedSuggestionsFunc: func(string) ([]*proto.Suggestion, bool, error) {
+					return nil, false, nil
+				},
+				SuggestFunc: func(string, int64, int64) ([]*proto.Suggestion, error) {
+					return nil, errors.New("error")
+				},
+			},
+			input:       &proto.SuggestOptions{Prefix: "la"},
+			expected:    &proto.Suggestions{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.Suggest(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_GetRecentSearches(t *testing.T) {
+	searches := []*proto.RecentSearch{{ID: 1, Query: "lahaine", ResultType: constants.SuggestionTypeTrack, ResultID: 2}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.RecentSearchesOptions
+		expected    *proto.RecentSearches
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success. Default amount",
+			storageMock: &mock.MockStorage{
+				RecentSearchesFunc: func(userID int64, amount int64) ([]*proto.RecentSearch, error) {
+					assert.Equal(t, int64(1), userID)
+					assert.Equal(t, int64(constants.RecentSearchesDefaultAmount), amount)
+					return searches, nil
+				},
+			},
+			input:    &proto.RecentSearchesOptions{UserID: 1},
+			expected: &proto.RecentSearches{RecentSearches: searches},
+		},
+		{
+			name: "Success. Amount is clamped",
+			storageMock: &mock.MockStorage{
+				RecentSearchesFunc: func(userID int64, amount int64) ([]*proto.RecentSearch, error) {
+					assert.Equal(t, int64(constants.RecentSearchesMaxAmount), amount)
+					return searches, nil
+				},
+			},
+			input:    &proto.RecentSearchesOptions{UserID: 1, Limit: 1000},
+			expected: &proto.RecentSearches{RecentSearches: searches},
+		},
+		{
+			name: "Error 500. mock.RecentSearches returned error",
+			storageMock: &mock.MockStorage{
+				RecentSearchesFunc: func(int64, int64) ([]*proto.RecentSearch, error) {
+					return nil, errors.New("error")
+				},
+			},
+			input:       &proto.RecentSearchesOptions{UserID: 1},
+			expected:    &proto.RecentSearches{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.GetRecentSearches(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_RecordSearchResult(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.RecordSearchResultOptions
+		expected    *proto.RecordSearchResultResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				RecordSearchFunc: func(userID int64, query string, resultType string, resultID int64) error {
+					assert.Equal(t, "lahaine", query)
+					assert.Equal(t, constants.SuggestionTypeAlbum, resultType)
+					assert.Equal(t, int64(2), resultID)
+					return nil
+				},
+			},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      " lahaine",
+				ResultType: constants.SuggestionTypeAlbum,
+				ResultID:   2,
+			},
+			expected: &proto.RecordSearchResultResponse{},
+		},
+		{
+			name:        "Error 400. Empty query",
+			storageMock: &mock.MockStorage{},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "  ",
+				ResultType: constants.SuggestionTypeAlbum,
+				ResultID:   2,
+			},
+			expected:    &proto.RecordSearchResultResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.SearchQueryEmptyMessage),
+		},
+		{
+			name:        "Error 400. Invalid result type",
+			storageMock: &mock.MockStorage{},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "lahaine",
+				ResultType: "genre",
+				ResultID:   2,
+			},
+			expected:    &proto.RecordSearchResultResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.SearchResultTypeInvalidMessage),
+		},
+		{
+			name: "Success. Own private playlist",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				RecordSearchFunc: func(int64, string, string, int64) error {
+					return nil
+				},
+			},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "mix",
+				ResultType: constants.SuggestionTypePlaylist,
+				ResultID:   3,
+			},
+			expected: &proto.RecordSearchResultResponse{},
+		},
+		{
+			name: "Error 404. Private playlist of another user",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "mix",
+				ResultType: constants.SuggestionTypePlaylist,
+				ResultID:   3,
+			},
+			expected:    &proto.RecordSearchResultResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.PlaylistNotFoundMessage),
+		},
+		{
+			name: "Error 404. Playlist doesn't exist",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "mix",
+				ResultType: constants.SuggestionTypePlaylist,
+				ResultID:   3,
+			},
+			expected:    &proto.RecordSearchResultResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.PlaylistNotFoundMessage),
+		},
+		{
+			name: "Error 500. mock.DoesPlaylistExist returned error",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return false, errors.New("error")
+				},
+			},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "mix",
+				ResultType: constants.SuggestionTypePlaylist,
+				ResultID:   3,
+			},
+			expected:    &proto.RecordSearchResultResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 500. mock.RecordSearch returned error",
+			storageMock: &mock.MockStorage{
+				RecordSearchFunc: func(int64, string, string, int64) error {
+					return errors.New("error")
+				},
+			},
+			input: &proto.RecordSearchResultOptions{
+				UserID:     1,
+				Query:      "lahaine",
+				ResultType: constants.SuggestionTypeTrack,
+				ResultID:   2,
+			},
+			expected:    &proto.RecordSearchResultResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.RecordSearchResult(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_DeleteRecentSearch(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.DeleteRecentSearchOptions
+		expected    *proto.DeleteRecentSearchResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				DeleteRecentSearchFunc: func(userID int64, searchID int64) (bool, error) {
+					assert.Equal(t, int64(1), userID)
+					assert.Equal(t, int64(3), searchID)
+					return true, nil
+				},
+			},
+			input:    &proto.DeleteRecentSearchOptions{UserID: 1, ID: 3},
+			expected: &proto.DeleteRecentSearchResponse{},
+		},
+		{
+			name: "Error 404. Recent search not found",
+			storageMock: &mock.MockStorage{
+				DeleteRecentSearchFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.DeleteRecentSearchOptions{UserID: 1, ID: 3},
+			expected:    &proto.DeleteRecentSearchResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.RecentSearchNotFoundMessage),
+		},
+		{
+			name: "Error 500. mock.DeleteRecentSearch returned error",
+			storageMock: &mock.MockStorage{
+				DeleteRecentSearchFunc: func(int64, int64) (bool, error) {
+					return false, errors.New("error")
+				},
+			},
+			input:       &proto.DeleteRecentSearchOptions{UserID: 1, ID: 3},
+			expected:    &proto.DeleteRecentSearchResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.DeleteRecentSearch(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_ClearRecentSearches(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.ClearRecentSearchesOptions
+		expected    *proto.ClearRecentSearchesResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				ClearRecentSearchesFunc: func(int64) error {
+					return nil
+				},
+			},
+			input:    &proto.ClearRecentSearchesOptions{UserID: 1},
+			expected: &proto.ClearRecentSearchesResponse{},
+		},
+		{
+			name: "Error 500. mock.ClearRecentSearches returned error",
+			storageMock: &mock.MockStorage{
+				ClearRecentSearchesFunc: func(int64) error {
+					return errors.New("error")
+				},
+			},
+			input:       &proto.ClearRecentSearchesOptions{UserID: 1},
+			expected:    &proto.ClearRecentSearchesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.ClearRecentSearches(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, currentTest.expected, res)
+		})
+	}
+}
+
+func TestMusicService_PlaylistPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.PlaylistPageOptions
+		expected    *proto.PlaylistPageResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				PlaylistInfoFunc: func(int64, int64) (*proto.PlaylistData, error) {
+					return &proto.PlaylistData{}, nil
+				},
+				PlaylistTracksFunc: func(int64, int64, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return []*proto.Track{}, nil, nil
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expected: &proto.PlaylistPageResponse{
+				Tracks: []*proto.Track{},
+				IsOwn:  true,
+			},
+		},
+		{
+			name: "Error 500. mock.DoesPlaylistExist returned error",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return false, errors.New("error")
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 404. mock.DoesPlaylistExist returned false, playlist does not exist",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.PlaylistNotFoundMessage),
+		},
+		{
+			name: "Error 500. mock.IsPlaylistOwner returned error",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return false, errors.New("error")
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 403. mock.IsPlaylistOwner returned false, user is not playlist owner",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+				IsReleaseRadarPlaylistFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				PlaylistInfoFunc: func(int64, int64) (*proto.PlaylistData, error) {
+					return &proto.PlaylistData{}, nil
+				},
+				PlaylistTracksFunc: func(int64, int64, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return []*proto.Track{}, nil, nil
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.NotPlaylistOwnerMessage),
+		},
+		{
+			name: "Success. Release radar playlist is visible to its user",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return false, nil
+				},
+				IsReleaseRadarPlaylistFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				PlaylistInfoFunc: func(int64, int64) (*proto.PlaylistData, error) {
+					return &proto.PlaylistData{PlaylistID: 1, Title: constants.ReleaseRadarPlaylistTitle}, nil
+				},
+				PlaylistTracksFunc: func(int64, int64, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return []*proto.Track{}, nil, nil
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expected: &proto.PlaylistPageResponse{
+				PlaylistID: 1,
+				Title:      constants.ReleaseRadarPlaylistTitle,
+				Tracks:     []*proto.Track{},
+			},
+		},
+		{
+			name: "Error 500. mock.PlaylistInfo returned error",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				PlaylistInfoFunc: func(int64, int64) (*proto.PlaylistData, error) {
+					return nil, errors.New("error")
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 500. mock.PlaylistTracks returned error",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				PlaylistInfoFunc: func(int64, int64) (*proto.PlaylistData, error) {
+					return &proto.PlaylistData{}, nil
+				},
+				PlaylistTracksFunc: func(int64, int64, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Error 500. mock.IsPlaylistPublic returned error",
+			storageMock: &mock.MockStorage{
+				DoesPlaylistExistFunc: func(int64) (bool, error) {
+					return true, nil
+				},
+				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				PlaylistInfoFunc: func(int64, int64) (*proto.PlaylistData, error) {
+					return &proto.PlaylistData{}, nil
+				},
+				PlaylistTracksFunc: func(int64, int64, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
+				},
+				IsPlaylistPublicFunc: func(int64) (bool, error) {
+					return true, errors.New("error")
+				},
+			},
+			input: &proto.PlaylistPageOptions{
+				PlaylistID: 1,
+				UserID:     1,
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.PlaylistPage(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_AddTrackToFavorites(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.AddTrackToFavoritesOptions
+		expected    *proto.AddTrackToFavoritesResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				AddTrackToFavoriteFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input: &proto.AddTrackToFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected: &proto.AddTrackToFavoritesResponse{},
+		},
+		{
+			name: "Fail. Track already in favorites",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				AddTrackToFavoriteFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input: &proto.AddTrackToFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected:    &proto.AddTrackToFavoritesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.TrackAlreadyInFavorites),
+		},
+		{
+			name: "Fail. IsTrackInFavorites returns error",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return true, errors.New("error")
+				},
+				AddTrackToFavoriteFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input: &proto.AddTrackToFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected:    &proto.AddTrackToFavoritesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Fail. AddTrackToFavorites returns error",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				AddTrackToFavoriteFunc: func(int64, int64) error {
+					return errors.New("error")
+				},
+			},
+			input: &proto.AddTrackToFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected:    &proto.AddTrackToFavoritesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.TrackNotFound),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.AddTrackToFavorites(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_DeleteTrackFromFavorites(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.DeleteTrackFromFavoritesOptions
+		expected    *proto.DeleteTrackFromFavoritesResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input: &proto.DeleteTrackFromFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected: &proto.DeleteTrackFromFavoritesResponse{},
+		},
+		{
+			name: "Fail. Track not in favorites",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input: &proto.DeleteTrackFromFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected:    &proto.DeleteTrackFromFavoritesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.TrackNotInFavorites),
+		},
+		{
+			name: "Fail. IsTrackInFavorites returns error",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return true, errors.New("error")
+				},
+				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input: &proto.DeleteTrackFromFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected:    &proto.DeleteTrackFromFavoritesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Fail. DeleteTrackFromFavorites returns error",
+			storageMock: &mock.MockStorage{
+				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
+					return errors.New("error")
+				},
+			},
+			input: &proto.DeleteTrackFromFavoritesOptions{
+				UserID:  1,
+				TrackID: 1,
+			},
+			expected:    &proto.DeleteTrackFromFavoritesResponse{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+			res, err := storage.DeleteTrackFromFavorites(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_GetFavoriteTracks(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.UserFavoritesOptions
+		expected    *proto.Tracks
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				GetFavoritesFunc: func(_ int64, sortBy string, _ *proto.FavoritesFilter, _ *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					if sortBy != constants.SortByDateAdded {
+						return nil, nil, errors.New("unexpected sort")
+					}
+					tracks := make([]*proto.Track, 0)
+					return tracks, nil, nil
+				},
+			},
+			input: &proto.UserFavoritesOptions{
+				UserID: 1,
+			},
+			expected: &proto.Tracks{Tracks: []*proto.Track{}},
+		},
+		{
+			name: "Success. Sorted by duration",
+			storageMock: &mock.MockStorage{
+				GetFavoritesFunc: func(_ int64, sortBy string, filter *proto.FavoritesFilter, _ *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return []*proto.Track{{ID: 1, Duration: 100}}, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input: &proto.UserFavoritesOptions{
+				UserID: 1,
+				SortBy: constants.SortByDuration,
+				Filter: &proto.FavoritesFilter{GenreID: 2},
+			},
+			expected: &proto.Tracks{Tracks: []*proto.Track{{ID: 1, Duration: 100}}, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name:        "Fail. Invalid sort",
+			storageMock: &mock.MockStorage{},
+			input: &proto.UserFavoritesOptions{
+				UserID: 1,
+				SortBy: "popularity",
+			},
+			expected:    &proto.Tracks{},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.FavoritesSortInvalidMessage),
+		},
+		{
+			name: "Fail. GetFavorites returns error",
+			storageMock: &mock.MockStorage{
+				GetFavoritesFunc: func(int64, string, *proto.FavoritesFilter, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return []*proto.Track{}, nil, errors.New("error")
+				},
+			},
+			input: &proto.UserFavoritesOptions{
+				UserID: 1,
+			},
+			expected:    &proto.Tracks{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+			res, err := storage.GetFavoriteTracks(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_SaveAlbum(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.SaveAlbumOptions
+		expected    *proto.LibraryResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsAlbumSavedFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				SaveAlbumFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input:    &proto.SaveAlbumOptions{UserID: 1, AlbumID: 2},
+			expected: &proto.LibraryResponse{},
+		},
+		{
+			name: "Fail. Album already saved",
+			storageMock: &mock.MockStorage{
+				IsAlbumSavedFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+			},
+			input:       &proto.SaveAlbumOptions{UserID: 1, AlbumID: 2},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.AlbumAlreadySavedMessage),
+		},
+		{
+			name: "Fail. IsAlbumSaved returns error",
+			storageMock: &mock.MockStorage{
+				IsAlbumSavedFunc: func(int64, int64) (bool, error) {
+					return false, errors.New("error")
+				},
+			},
+			input:       &proto.SaveAlbumOptions{UserID: 1, AlbumID: 2},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Fail. SaveAlbum returns error",
+			storageMock: &mock.MockStorage{
+				IsAlbumSavedFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+				SaveAlbumFunc: func(int64, int64) error {
+					return errors.New("error")
+				},
+			},
+			input:       &proto.SaveAlbumOptions{UserID: 1, AlbumID: 2},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.AlbumNotFoundMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.SaveAlbum(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_UnfollowArtist(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.FollowArtistOptions
+		expected    *proto.LibraryResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				IsArtistFollowedFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				UnfollowArtistFunc: func(int64, int64) error {
+					return nil
+				},
+			},
+			input:    &proto.FollowArtistOptions{UserID: 1, ArtistID: 2},
+			expected: &proto.LibraryResponse{},
+		},
+		{
+			name: "Fail. Artist is not followed",
+			storageMock: &mock.MockStorage{
+				IsArtistFollowedFunc: func(int64, int64) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.FollowArtistOptions{UserID: 1, ArtistID: 2},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.ArtistNotFollowedMessage),
+		},
+		{
+			name: "Fail. UnfollowArtist returns error",
+			storageMock: &mock.MockStorage{
+				IsArtistFollowedFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
+				UnfollowArtistFunc: func(int64, int64) error {
+					return errors.New("error")
+				},
+			},
+			input:       &proto.FollowArtistOptions{UserID: 1, ArtistID: 2},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.UnfollowArtist(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_GetSavedAlbums(t *testing.T) {
+	albums := []*proto.Album{{ID: 1, Title: "Title", IsSaved: true}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.LibraryOptions
+		expected    *proto.Albums
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				SavedAlbumsFunc: func(userID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.PageDefaultAmount), page.Limit)
+					return albums, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input:    &proto.LibraryOptions{UserID: 1},
+			expected: &proto.Albums{Albums: albums, Page: &proto.PageResponse{TotalHint: 1}},
+		},
+		{
+			name: "Error 400. Invalid cursor",
+			storageMock: &mock.MockStorage{
+				SavedAlbumsFunc: func(int64, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return nil, nil, pagination.ErrInvalidCursor
+				},
+			},
+			input:       &proto.LibraryOptions{UserID: 1, Page: &proto.PageRequest{Cursor: "invalid"}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.CursorInvalidMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+
+			res, err := storage.GetSavedAlbums(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_Charts(t *testing.T) {
+	periodStart := time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)
+	chartTracks := []*proto.ChartTrack{{Position: 1, Plays: 10, Track: &proto.Track{ID: 1}}}
+	chartAlbums := []*proto.ChartAlbum{{Position: 1, Plays: 10, Album: &proto.Album{ID: 1}}}
+	chartArtists := []*proto.ChartArtist{{Position: 1, Plays: 10, Artist: &proto.Artist{ID: 1}}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.ChartsOptions
+		expected    *proto.ChartsResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success. All entities",
+			storageMock: &mock.MockStorage{
+				ChartPeriodStartFunc: func(string) (time.Time, error) {
+					return periodStart, nil
 				},
-				IsPlaylistPublicFunc: func(int64) (bool, error) {
-					return true, nil
+				ChartTracksFunc: func(string, time.Time, int64, int64, int64, bool) ([]*proto.ChartTrack, error) {
+					return chartTracks, nil
+				},
+				ChartAlbumsFunc: func(string, time.Time, int64, int64) ([]*proto.ChartAlbum, error) {
+					return chartAlbums, nil
+				},
+				ChartArtistsFunc: func(string, time.Time, int64, int64) ([]*proto.ChartArtist, error) {
+					return chartArtists, nil
+				},
+			},
+			input: &proto.ChartsOptions{Period: constants.ChartPeriodWeekly, Amount: 10},
+			expected: &proto.ChartsResponse{
+				Period:      constants.ChartPeriodWeekly,
+				PeriodStart: "2021-12-06",
+				Tracks:      chartTracks,
+				Albums:      chartAlbums,
+				Artists:     chartArtists,
+			},
+		},
+		{
+			name: "Success. Only albums",
+			storageMock: &mock.MockStorage{
+				ChartPeriodStartFunc: func(string) (time.Time, error) {
+					return periodStart, nil
+				},
+				ChartAlbumsFunc: func(string, time.Time, int64, int64) ([]*proto.ChartAlbum, error) {
+					return chartAlbums, nil
+				},
+			},
+			input: &proto.ChartsOptions{Period: constants.ChartPeriodWeekly, Entity: constants.ChartEntityAlbums},
+			expected: &proto.ChartsResponse{
+				Period:      constants.ChartPeriodWeekly,
+				PeriodStart: "2021-12-06",
+				Albums:      chartAlbums,
+			},
+		},
+		{
+			name: "Success. Library flags for authorized user",
+			storageMock: &mock.MockStorage{
+				ChartPeriodStartFunc: func(string) (time.Time, error) {
+					return periodStart, nil
+				},
+				ChartAlbumsFunc: func(string, time.Time, int64, int64) ([]*proto.ChartAlbum, error) {
+					return []*proto.ChartAlbum{{Position: 1, Plays: 10, Album: &proto.Album{ID: 1}}}, nil
+				},
+				ChartArtistsFunc: func(string, time.Time, int64, int64) ([]*proto.ChartArtist, error) {
+					return []*proto.ChartArtist{{Position: 1, Plays: 10, Artist: &proto.Artist{ID: 2}}}, nil
+				},
+				SavedAlbumIDsFunc: func(userID int64, albumIDs []int64) ([]int64, error) {
+					assert.Equal(t, []int64{1}, albumIDs)
+					return []int64{1}, nil
+				},
+				FollowedArtistIDsFunc: func(userID int64, artistIDs []int64) ([]int64, error) {
+					assert.Equal(t, []int64{2}, artistIDs)
+					return []int64{2}, nil
+				},
+				ChartTracksFunc: func(string, time.Time, int64, int64, int64, bool) ([]*proto.ChartTrack, error) {
+					return chartTracks, nil
+				},
+			},
+			input: &proto.ChartsOptions{Period: constants.ChartPeriodWeekly, UserID: 3, IsAuthorized: true},
+			expected: &proto.ChartsResponse{
+				Period:      constants.ChartPeriodWeekly,
+				PeriodStart: "2021-12-06",
+				Tracks:      chartTracks,
+				Albums:      []*proto.ChartAlbum{{Position: 1, Plays: 10, Album: &proto.Album{ID: 1, IsSaved: true}}},
+				Artists:     []*proto.ChartArtist{{Position: 1, Plays: 10, Artist: &proto.Artist{ID: 2, IsFollowed: true}}},
+			},
+		},
+		{
+			name:        "Fail. Invalid period",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.ChartsOptions{Period: "yearly"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.ChartPeriodInvalidMessage),
+		},
+		{
+			name:        "Fail. Invalid entity",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.ChartsOptions{Period: constants.ChartPeriodDaily, Entity: "genres"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.ChartEntityInvalidMessage),
+		},
+		{
+			name: "Fail. Chart is not ready",
+			storageMock: &mock.MockStorage{
+				ChartPeriodStartFunc: func(string) (time.Time, error) {
+					return time.Time{}, sql.ErrNoRows
+				},
+			},
+			input:       &proto.ChartsOptions{Period: constants.ChartPeriodDaily},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.ChartNotReadyMessage),
+		},
+		{
+			name: "Fail. ChartTracks returns error",
+			storageMock: &mock.MockStorage{
+				ChartPeriodStartFunc: func(string) (time.Time, error) {
+					return periodStart, nil
+				},
+				ChartTracksFunc: func(string, time.Time, int64, int64, int64, bool) ([]*proto.ChartTrack, error) {
+					return nil, errors.New("error")
+				},
+			},
+			input:       &proto.ChartsOptions{Period: constants.ChartPeriodDaily},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+			res, err := storage.Charts(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_RebuildCharts(t *testing.T) {
+	now := time.Date(2021, time.December, 9, 15, 30, 0, 0, time.UTC)
+
+	type rebuild struct {
+		period        string
+		start         string
+		end           string
+		previousStart string
+	}
+	var rebuilds []rebuild
+	storageMock := &mock.MockStorage{
+		RebuildChartFunc: func(period string, start time.Time, end time.Time, previousStart time.Time) error {
+			rebuilds = append(rebuilds, rebuild{period, start.Format(constants.ChartDateLayout),
+				end.Format(constants.ChartDateLayout), previousStart.Format(constants.ChartDateLayout)})
+			return nil
+		},
+	}
+
+	err := NewMusicService(storageMock).RebuildCharts(now)
+	assert.NoError(t, err)
+	assert.Equal(t, []rebuild{
+		{constants.ChartPeriodDaily, "2021-12-08", "2021-12-09", "2021-12-07"},
+		{constants.ChartPeriodDaily, "2021-12-09", "2021-12-10", "2021-12-08"},
+		{constants.ChartPeriodWeekly, "2021-11-29", "2021-12-06", "2021-11-22"},
+		{constants.ChartPeriodWeekly, "2021-12-06", "2021-12-13", "2021-11-29"},
+		{constants.ChartPeriodMonthly, "2021-11-01", "2021-12-01", "2021-10-01"},
+		{constants.ChartPeriodMonthly, "2021-12-01", "2022-01-01", "2021-11-01"},
+	}, rebuilds)
+
+	failingMock := &mock.MockStorage{
+		RebuildChartFunc: func(string, time.Time, time.Time, time.Time) error {
+			return errors.New("error")
+		},
+	}
+	err = NewMusicService(failingMock).RebuildCharts(now)
+	assert.Error(t, err)
+}
+
+func TestMusicService_ReleaseRadar(t *testing.T) {
+	albums := []*proto.Album{{ID: 1, Title: "New", Artist: "Artist", ReleaseDate: "2021-12-03"}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		expected    *proto.ReleaseRadarResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				ReleaseRadarFunc: func(userID int64, now time.Time, amount int64) ([]*proto.Album, error) {
+					return albums, nil
+				},
+				ReleaseRadarPlaylistFunc: func(int64) (int64, error) {
+					return 7, nil
+				},
+			},
+			expected: &proto.ReleaseRadarResponse{Albums: albums, PlaylistID: 7},
+		},
+		{
+			name: "Fail. ReleaseRadar returns error",
+			storageMock: &mock.MockStorage{
+				ReleaseRadarFunc: func(int64, time.Time, int64) ([]*proto.Album, error) {
+					return nil, errors.New("error")
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Fail. ReleaseRadarPlaylist returns error",
+			storageMock: &mock.MockStorage{
+				ReleaseRadarFunc: func(int64, time.Time, int64) ([]*proto.Album, error) {
+					return albums, nil
+				},
+				ReleaseRadarPlaylistFunc: func(int64) (int64, error) {
+					return 0, errors.New("error")
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			res, err := NewMusicService(currentTest.storageMock).ReleaseRadar(context.Background(),
+				&proto.ReleaseRadarOptions{UserID: 1})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, currentTest.err, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+				assert.Equal(t, int64(constants.ReleaseRadarAlbumsAmount), currentTest.storageMock.ReleaseRadarCalls()[0].Amount)
+			}
+		})
+	}
+}
+
+func TestMusicService_RebuildReleaseRadarPlaylists(t *testing.T) {
+	now := time.Date(2021, time.December, 9, 15, 30, 0, 0, time.UTC)
+
+	type saved struct {
+		userID    int64
+		weekStart string
+		trackIDs  []int64
+	}
+	var saves []saved
+	storageMock := &mock.MockStorage{
+		ReleaseRadarListenersFunc: func(time.Time, time.Time) ([]int64, error) {
+			return []int64{1, 2}, nil
+		},
+		ReleaseRadarTracksFunc: func(userID int64, now time.Time, amount int64) ([]int64, error) {
+			if userID == 1 {
+				return []int64{10, 11}, nil
+			}
+			return []int64{}, nil
+		},
+		SaveReleaseRadarPlaylistFunc: func(userID int64, weekStart time.Time, trackIDs []int64) error {
+			saves = append(saves, saved{userID, weekStart.Format(constants.ChartDateLayout), trackIDs})
+			return nil
+		},
+	}
+
+	err := NewMusicService(storageMock).RebuildReleaseRadarPlaylists(now)
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-12-06", storageMock.ReleaseRadarListenersCalls()[0].WeekStart.Format(constants.ChartDateLayout))
+	// Неделя без новинок тоже сохраняется, чтобы пользователь не перебирался на следующем запуске
+	assert.Equal(t, []saved{
+		{1, "2021-12-06", []int64{10, 11}},
+		{2, "2021-12-06", []int64{}},
+	}, saves)
+
+	failingMock := &mock.MockStorage{
+		ReleaseRadarListenersFunc: func(time.Time, time.Time) ([]int64, error) {
+			return []int64{1, 2, 3}, nil
+		},
+		ReleaseRadarTracksFunc: func(userID int64, now time.Time, amount int64) ([]int64, error) {
+			if userID == 2 {
+				return nil, errors.New("error")
+			}
+			return []int64{10}, nil
+		},
+		SaveReleaseRadarPlaylistFunc: func(userID int64, weekStart time.Time, trackIDs []int64) error {
+			if userID == 1 {
+				return errors.New("error")
+			}
+			return nil
+		},
+	}
+	err = NewMusicService(failingMock).RebuildReleaseRadarPlaylists(now)
+	assert.Error(t, err)
+	// Ошибки первых двух пользователей не мешают собрать плейлист третьему
+	saveCalls := failingMock.SaveReleaseRadarPlaylistCalls()
+	assert.Equal(t, 2, len(saveCalls))
+	assert.Equal(t, int64(3), saveCalls[1].UserID)
+}
+
+func TestMusicService_Recap(t *testing.T) {
+	tracks := []*proto.RecapTrack{{Position: 1, Plays: 20, Track: &proto.Track{ID: 1}}}
+	albums := []*proto.RecapAlbum{{Position: 1, Plays: 30, Album: &proto.Album{ID: 1}}}
+	artists := []*proto.RecapArtist{{Position: 1, Plays: 40, Artist: &proto.Artist{ID: 1}}}
+	genres := []*proto.RecapGenre{{Position: 1, Plays: 50, Genre: &proto.Genre{ID: 1}}}
+	successMock := func() *mock.MockStorage {
+		return &mock.MockStorage{
+			RecapFunc: func(userID int64, year int64) (*proto.RecapResponse, error) {
+				return &proto.RecapResponse{Year: year, MinutesListened: 100}, nil
+			},
+			RecapTopTracksFunc: func(int64, int64, int64) ([]*proto.RecapTrack, error) {
+				return tracks, nil
+			},
+			RecapTopAlbumsFunc: func(int64, int64, int64) ([]*proto.RecapAlbum, error) {
+				return albums, nil
+			},
+			RecapTopArtistsFunc: func(int64, int64, int64) ([]*proto.RecapArtist, error) {
+				return artists, nil
+			},
+			RecapTopGenresFunc: func(int64, int64, int64) ([]*proto.RecapGenre, error) {
+				return genres, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.RecapOptions
+		expected    *proto.RecapResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name:        "Success",
+			storageMock: successMock(),
+			input:       &proto.RecapOptions{UserID: 1, Year: 2021},
+			expected: &proto.RecapResponse{
+				Year:            2021,
+				MinutesListened: 100,
+				Tracks:          tracks,
+				Albums:          albums,
+				Artists:         artists,
+				Genres:          genres,
+			},
+		},
+		{
+			name:        "Fail. Year is in the future",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.RecapOptions{UserID: 1, Year: int64(time.Now().Year() + 1)},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.RecapYearInvalidMessage),
+		},
+		{
+			name: "Fail. Recap was not built",
+			storageMock: &mock.MockStorage{
+				RecapFunc: func(int64, int64) (*proto.RecapResponse, error) {
+					return nil, sql.ErrNoRows
+				},
+			},
+			input:       &proto.RecapOptions{UserID: 1, Year: 2021},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.RecapNotFoundMessage),
+		},
+		{
+			name: "Fail. RecapTopTracks returns error",
+			storageMock: &mock.MockStorage{
+				RecapFunc: func(int64, int64) (*proto.RecapResponse, error) {
+					return &proto.RecapResponse{}, nil
+				},
+				RecapTopTracksFunc: func(int64, int64, int64) ([]*proto.RecapTrack, error) {
+					return nil, errors.New("error")
+				},
+			},
+			input:       &proto.RecapOptions{UserID: 1, Year: 2021},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+			res, err := storage.Recap(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_RebuildRecaps(t *testing.T) {
+	now := time.Date(2022, time.January, 2, 3, 0, 0, 0, time.UTC)
+
+	type rebuilt struct {
+		year  int64
+		start string
+		end   string
+	}
+	var rebuilds []rebuilt
+	storageMock := &mock.MockStorage{
+		RecapBuiltAtFunc: func(year int64) (time.Time, error) {
+			return time.Date(2021, time.December, 31, 3, 0, 0, 0, time.UTC), nil
+		},
+		RebuildRecapsFunc: func(year int64, start time.Time, end time.Time) error {
+			rebuilds = append(rebuilds, rebuilt{year, start.Format(constants.ChartDateLayout), end.Format(constants.ChartDateLayout)})
+			return nil
+		},
+	}
+
+	err := NewMusicService(storageMock).RebuildRecaps(now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2021), storageMock.RecapBuiltAtCalls()[0].Year)
+	// Итоги прошлого года досчитываются один раз после его окончания
+	assert.Equal(t, []rebuilt{
+		{2021, "2021-01-01", "2022-01-01"},
+		{2022, "2022-01-01", "2023-01-01"},
+	}, rebuilds)
+
+	rebuilds = nil
+	storageMock.RecapBuiltAtFunc = func(int64) (time.Time, error) {
+		return time.Date(2022, time.January, 1, 3, 0, 0, 0, time.UTC), nil
+	}
+	err = NewMusicService(storageMock).RebuildRecaps(now)
+	assert.NoError(t, err)
+	assert.Equal(t, []rebuilt{{2022, "2022-01-01", "2023-01-01"}}, rebuilds)
+
+	failingMock := &mock.MockStorage{
+		RecapBuiltAtFunc: func(int64) (time.Time, error) {
+			return time.Time{}, errors.New("error")
+		},
+	}
+	err = NewMusicService(failingMock).RebuildRecaps(now)
+	assert.Error(t, err)
+}
+
+func TestMusicService_ListGenres(t *testing.T) {
+	genres := []*proto.Genre{{ID: 1, Name: "rock", TracksAmount: 10}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		expected    *proto.Genres
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				ListGenresFunc: func() ([]*proto.Genre, error) {
+					return genres, nil
+				},
+			},
+			expected: &proto.Genres{Genres: genres},
+		},
+		{
+			name: "Fail. ListGenres returns error",
+			storageMock: &mock.MockStorage{
+				ListGenresFunc: func() ([]*proto.Genre, error) {
+					return nil, errors.New("error")
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+			res, err := storage.ListGenres(context.Background(), &proto.ListGenresOptions{})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_GenrePage(t *testing.T) {
+	genre := &proto.Genre{ID: 1, Name: "rock", TracksAmount: 10}
+	tracks := []*proto.Track{{ID: 1}}
+	albums := []*proto.Album{{ID: 2}}
+	artists := []*proto.Artist{{ID: 3}}
+
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.GenrePageOptions
+		expected    *proto.GenrePageResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success. Default amounts",
+			storageMock: &mock.MockStorage{
+				GenreInfoFunc: func(int64) (*proto.Genre, error) {
+					return genre, nil
+				},
+				GenreTracksFunc: func(genreID int64, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.GenreTracksSelectionAmount), page.Limit)
+					assert.Equal(t, "", page.Cursor)
+					return tracks, &proto.PageResponse{NextCursor: "tracks", TotalHint: 30}, nil
+				},
+				GenreAlbumsFunc: func(genreID int64, page *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.GenreAlbumsSelectionAmount), page.Limit)
+					return albums, &proto.PageResponse{TotalHint: 1}, nil
+				},
+				GenreArtistsFunc: func(genreID int64, page *proto.PageRequest) ([]*proto.Artist, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.GenreArtistsSelectionAmount), page.Limit)
+					return artists, &proto.PageResponse{TotalHint: 1}, nil
+				},
+			},
+			input: &proto.GenrePageOptions{GenreID: 1},
+			expected: &proto.GenrePageResponse{
+				Genre:   genre,
+				Tracks:  tracks,
+				Albums:  albums,
+				Artists: artists,
+				Page: &proto.PageResponse{
+					NextCursor: pagination.EncodeParts(map[string]pagination.Part{
+						tracksPart:  {Cursor: "tracks", Total: 30},
+						albumsPart:  {Total: 1, Done: true},
+						artistsPart: {Total: 1, Done: true},
+					}),
+					TotalHint: 32,
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
+		},
+		{
+			name: "Success. Next page skips finished lists, amount is limited",
+			storageMock: &mock.MockStorage{
+				GenreInfoFunc: func(int64) (*proto.Genre, error) {
+					return genre, nil
+				},
+				GenreTracksFunc: func(genreID int64, userID int64, isAuthorized bool, page *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					assert.Equal(t, int64(constants.PageMaxAmount), page.Limit)
+					assert.Equal(t, "tracks", page.Cursor)
+					return tracks, &proto.PageResponse{TotalHint: 30}, nil
+				},
+			},
+			input: &proto.GenrePageOptions{GenreID: 1, Page: &proto.PageRequest{
+				Limit: 1000,
+				Cursor: pagination.EncodeParts(map[string]pagination.Part{
+					tracksPart:  {Cursor: "tracks", Total: 30},
+					albumsPart:  {Total: 1, Done: true},
+					artistsPart: {Total: 1, Done: true},
+				}),
+			}},
+			expected: &proto.GenrePageResponse{
+				Genre:   genre,
+				Tracks:  tracks,
+				Albums:  []*proto.Album{},
+				Artists: []*proto.Artist{},
+				Page:    &proto.PageResponse{TotalHint: 32},
+			},
+		},
+		{
+			name: "Fail. Genre not found",
+			storageMock: &mock.MockStorage{
+				GenreInfoFunc: func(int64) (*proto.Genre, error) {
+					return nil, sql.ErrNoRows
+				},
 			},
+			input:       &proto.GenrePageOptions{GenreID: 1},
 			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
+			err:         status.Error(codes.NotFound, constants.GenreNotFoundMessage),
 		},
 		{
-			name: "Error 500. mock.IsPlaylistPublic returned error",
+			name: "Fail. Invalid cursor",
 			storageMock: &mock.MockStorage{
-				DoesPlaylistExistFunc: func(int64) (bool, error) {
-					return true, nil
+				GenreInfoFunc: func(int64) (*proto.Genre, error) {
+					return genre, nil
 				},
-				IsPlaylistOwnerFunc: func(int64, int64) (bool, error) {
-					return true, nil
-				},
-				PlaylistInfoFunc: func(int64) (*proto.PlaylistData, error) {
-					return &proto.PlaylistData{}, nil
+			},
+			input:       &proto.GenrePageOptions{GenreID: 1, Page: &proto.PageRequest{Cursor: "qwe"}},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.CursorInvalidMessage),
+		},
+		{
+			name: "Fail. GenreAlbums returns error",
+			storageMock: &mock.MockStorage{
+				GenreInfoFunc: func(int64) (*proto.Genre, error) {
+					return genre, nil
 				},
-				PlaylistTracksFunc: func(int64, int64) ([]*proto.Track, error) {
-					return nil, errors.New("error")
+				GenreTracksFunc: func(int64, int64, bool, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return tracks, &proto.PageResponse{}, nil
 				},
-				IsPlaylistPublicFunc: func(int64) (bool, error) {
-					return true, errors.New("error")
+				GenreAlbumsFunc: func(int64, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
 				},
 			},
-			input: &proto.PlaylistPageOptions{
-				PlaylistID: 1,
-				UserID:     1,
-			},
+			input:       &proto.GenrePageOptions{GenreID: 1},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
@@ -866,8 +3380,7 @@ func TestMusicService_PlaylistPage(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
-
-			res, err := storage.PlaylistPage(context.Background(), currentTest.input)
+			res, err := storage.GenrePage(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
@@ -879,84 +3392,76 @@ func TestMusicService_PlaylistPage(t *testing.T) {
 	}
 }
 
-func TestMusicService_AddTrackToFavorites(t *testing.T) {
+func TestMusicService_ArtistTracks(t *testing.T) {
+	tracks := []*proto.Track{{ID: 1}, {ID: 2}}
+	page := &proto.PageResponse{NextCursor: "cursor", TotalHint: 40}
+
 	tests := []struct {
-		name        string
-		storageMock *mock.MockStorage
-		input       *proto.AddTrackToFavoritesOptions
-		expected    *proto.AddTrackToFavoritesResponse
-		expectedErr bool
-		err         error
+		name           string
+		storageMock    *mock.MockStorage
+		input          *proto.ArtistTracksOptions
+		expected       *proto.Tracks
+		expectedSortBy string
+		expectedPage   *proto.PageRequest
+		expectedErr    bool
+		err            error
 	}{
 		{
-			name: "Success",
+			name: "Success. Default options",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return false, nil
-				},
-				AddTrackToFavoriteFunc: func(int64, int64) error {
-					return nil
+				ArtistTracksPageFunc: func(int64, int64, bool, string, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return tracks, page, nil
 				},
 			},
-			input: &proto.AddTrackToFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
-			},
-			expected: &proto.AddTrackToFavoritesResponse{},
+			input:          &proto.ArtistTracksOptions{ArtistID: 1},
+			expected:       &proto.Tracks{Tracks: tracks, Page: page},
+			expectedSortBy: constants.SortByPopularity,
+			expectedPage:   &proto.PageRequest{Limit: constants.ArtistDiscographyPageAmount},
 		},
 		{
-			name: "Fail. Track already in favorites",
+			name: "Success. Sort by title, amount is limited",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return true, nil
-				},
-				AddTrackToFavoriteFunc: func(int64, int64) error {
-					return nil
+				ArtistTracksPageFunc: func(int64, int64, bool, string, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return tracks, page, nil
 				},
 			},
-			input: &proto.AddTrackToFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
+			input: &proto.ArtistTracksOptions{
+				ArtistID: 1,
+				SortBy:   constants.SortByTitle,
+				Page:     &proto.PageRequest{Limit: 1000, Cursor: "cursor"},
 			},
-			expected:    &proto.AddTrackToFavoritesResponse{},
+			expected:       &proto.Tracks{Tracks: tracks, Page: page},
+			expectedSortBy: constants.SortByTitle,
+			expectedPage:   &proto.PageRequest{Limit: constants.PageMaxAmount, Cursor: "cursor"},
+		},
+		{
+			name:        "Fail. Invalid sort",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.ArtistTracksOptions{ArtistID: 1, SortBy: "qwe"},
 			expectedErr: true,
-			err:         status.Error(codes.PermissionDenied, constants.TrackAlreadyInFavorites),
+			err:         status.Error(codes.InvalidArgument, constants.SortInvalidMessage),
 		},
 		{
-			name: "Fail. IsTrackInFavorites returns error",
+			name: "Fail. Invalid cursor",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return true, errors.New("error")
-				},
-				AddTrackToFavoriteFunc: func(int64, int64) error {
-					return nil
+				ArtistTracksPageFunc: func(int64, int64, bool, string, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, pagination.ErrInvalidCursor
 				},
 			},
-			input: &proto.AddTrackToFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
-			},
-			expected:    &proto.AddTrackToFavoritesResponse{},
+			input:       &proto.ArtistTracksOptions{ArtistID: 1, Page: &proto.PageRequest{Cursor: "qwe"}},
 			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
+			err:         status.Error(codes.InvalidArgument, constants.CursorInvalidMessage),
 		},
 		{
-			name: "Fail. AddTrackToFavorites returns error",
+			name: "Fail. ArtistTracksPage returns error",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return false, nil
-				},
-				AddTrackToFavoriteFunc: func(int64, int64) error {
-					return errors.New("error")
+				ArtistTracksPageFunc: func(int64, int64, bool, string, *proto.PageRequest) ([]*proto.Track, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
 				},
 			},
-			input: &proto.AddTrackToFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
-			},
-			expected:    &proto.AddTrackToFavoritesResponse{},
+			input:       &proto.ArtistTracksOptions{ArtistID: 1},
 			expectedErr: true,
-			err:         status.Error(codes.NotFound, constants.TrackNotFound),
+			err:         status.Error(codes.Internal, "error"),
 		},
 	}
 
@@ -964,97 +3469,125 @@ func TestMusicService_AddTrackToFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
-
-			res, err := storage.AddTrackToFavorites(context.Background(), currentTest.input)
+			res, err := storage.ArtistTracks(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, currentTest.expected, res)
+				calls := currentTest.storageMock.ArtistTracksPageCalls()
+				assert.Equal(t, currentTest.expectedSortBy, calls[0].SortBy)
+				assert.Equal(t, currentTest.expectedPage, calls[0].Page)
 			}
 		})
 	}
 }
 
-func TestMusicService_DeleteTrackFromFavorites(t *testing.T) {
+func TestMusicService_ArtistAlbums(t *testing.T) {
+	albums := []*proto.Album{{ID: 1}}
+	page := &proto.PageResponse{TotalHint: 1}
+
 	tests := []struct {
 		name        string
 		storageMock *mock.MockStorage
-		input       *proto.DeleteTrackFromFavoritesOptions
-		expected    *proto.DeleteTrackFromFavoritesResponse
+		input       *proto.ArtistAlbumsOptions
+		expected    *proto.Albums
 		expectedErr bool
 		err         error
 	}{
 		{
 			name: "Success",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return true, nil
+				ArtistAlbumsPageFunc: func(int64, string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return albums, page, nil
 				},
-				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
-					return nil
-				},
-			},
-			input: &proto.DeleteTrackFromFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
 			},
-			expected: &proto.DeleteTrackFromFavoritesResponse{},
+			input:    &proto.ArtistAlbumsOptions{ArtistID: 1, SortBy: constants.SortByYear},
+			expected: &proto.Albums{Albums: albums, Page: page},
 		},
 		{
-			name: "Fail. Track not in favorites",
+			name:        "Fail. Invalid sort",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.ArtistAlbumsOptions{ArtistID: 1, SortBy: "qwe"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.SortInvalidMessage),
+		},
+		{
+			name: "Fail. ArtistAlbumsPage returns error",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return false, nil
-				},
-				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
-					return nil
+				ArtistAlbumsPageFunc: func(int64, string, *proto.PageRequest) ([]*proto.Album, *proto.PageResponse, error) {
+					return nil, nil, errors.New("error")
 				},
 			},
-			input: &proto.DeleteTrackFromFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
-			},
-			expected:    &proto.DeleteTrackFromFavoritesResponse{},
+			input:       &proto.ArtistAlbumsOptions{ArtistID: 1},
 			expectedErr: true,
-			err:         status.Error(codes.PermissionDenied, constants.TrackNotInFavorites),
+			err:         status.Error(codes.Internal, "error"),
 		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewMusicService(currentTest.storageMock)
+			res, err := storage.ArtistAlbums(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestMusicService_DislikeTrack(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.DislikeTrackOptions
+		expected    *proto.DislikeTrackResponse
+		expectedErr bool
+		err         error
+	}{
 		{
-			name: "Fail. IsTrackInFavorites returns error",
+			name: "Success",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return true, errors.New("error")
+				IsTrackDislikedFunc: func(int64, int64) (bool, error) {
+					return false, nil
 				},
-				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
+				DislikeTrackFunc: func(int64, int64) error {
 					return nil
 				},
 			},
-			input: &proto.DeleteTrackFromFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
+			input:    &proto.DislikeTrackOptions{UserID: 1, TrackID: 2},
+			expected: &proto.DislikeTrackResponse{},
+		},
+		{
+			name: "Fail. Track already disliked",
+			storageMock: &mock.MockStorage{
+				IsTrackDislikedFunc: func(int64, int64) (bool, error) {
+					return true, nil
+				},
 			},
-			expected:    &proto.DeleteTrackFromFavoritesResponse{},
+			input:       &proto.DislikeTrackOptions{UserID: 1, TrackID: 2},
 			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
+			err:         status.Error(codes.PermissionDenied, constants.TrackAlreadyDislikedMessage),
 		},
 		{
-			name: "Fail. DeleteTrackFromFavorites returns error",
+			name: "Fail. DislikeTrack returns error",
 			storageMock: &mock.MockStorage{
-				IsTrackInFavoritesFunc: func(int64, int64) (bool, error) {
-					return true, nil
+				IsTrackDislikedFunc: func(int64, int64) (bool, error) {
+					return false, nil
 				},
-				DeleteTrackFromFavoritesFunc: func(int64, int64) error {
+				DislikeTrackFunc: func(int64, int64) error {
 					return errors.New("error")
 				},
 			},
-			input: &proto.DeleteTrackFromFavoritesOptions{
-				UserID:  1,
-				TrackID: 1,
-			},
-			expected:    &proto.DeleteTrackFromFavoritesResponse{},
+			input:       &proto.DislikeTrackOptions{UserID: 1, TrackID: 2},
 			expectedErr: true,
-			err:         status.Error(codes.Internal, "error"),
+			err:         status.Error(codes.NotFound, constants.TrackNotFound),
 		},
 	}
 
@@ -1062,7 +3595,8 @@ func TestMusicService_DeleteTrackFromFavorites(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
-			res, err := storage.DeleteTrackFromFavorites(context.Background(), currentTest.input)
+
+			res, err := storage.DislikeTrack(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
@@ -1074,39 +3608,192 @@ func TestMusicService_DeleteTrackFromFavorites(t *testing.T) {
 	}
 }
 
-func TestMusicService_GetFavoriteTracks(t *testing.T) {
+func radioTestTracks(trackIDs ...int64) []*proto.Track {
+	tracks := make([]*proto.Track, 0, len(trackIDs))
+	for _, trackID := range trackIDs {
+		tracks = append(tracks, &proto.Track{ID: trackID})
+	}
+	return tracks
+}
+
+func TestMusicService_Radio(t *testing.T) {
+	longHistory := make([]int64, constants.RadioHistoryMaxAmount)
+	for i := range longHistory {
+		longHistory[i] = int64(i + 100)
+	}
+
 	tests := []struct {
-		name        string
-		storageMock *mock.MockStorage
-		input       *proto.UserFavoritesOptions
-		expected    *proto.Tracks
-		expectedErr bool
-		err         error
+		name           string
+		storageMock    *mock.MockStorage
+		input          *proto.RadioOptions
+		expectedTracks []int64
+		expectedPlayed []int64
+		expectedErr    bool
+		err            error
 	}{
 		{
-			name: "Success",
+			name: "Success. New session mixes familiar and fresh tracks",
 			storageMock: &mock.MockStorage{
-				GetFavoritesFunc: func(int64) ([]*proto.Track, error) {
-					tracks := make([]*proto.Track, 0)
-					return tracks, nil
+				RadioSeedFunc: func(seedType string, seedID int64, userID int64) (*proto.RadioSession, error) {
+					return &proto.RadioSession{ArtistIDs: []int64{2}, GenreIDs: []int64{3}}, nil
+				},
+				RadioTracksFunc: func(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64,
+					candidates []int64, amount int64) ([]*proto.Track, error) {
+					assert.Nil(t, candidates)
+					if pool == constants.RadioPoolFamiliar {
+						return radioTestTracks(1, 2, 3), nil
+					}
+					return radioTestTracks(11, 12, 13, 14, 15, 16, 17), nil
+				},
+				SaveRadioSessionFunc: func(string, *proto.RadioSession, time.Duration) error {
+					return nil
 				},
 			},
-			input: &proto.UserFavoritesOptions{
-				UserID: 1,
+			input:          &proto.RadioOptions{SeedType: constants.RadioSeedArtist, SeedID: 2, UserID: 1},
+			expectedTracks: []int64{11, 12, 13, 1, 14, 15, 2, 16, 17, 3},
+			expectedPlayed: []int64{11, 12, 13, 1, 14, 15, 2, 16, 17, 3},
+		},
+		{
+			name: "Success. Continuation skips played tracks and fills from catalog",
+			storageMock: &mock.MockStorage{
+				RadioSessionFunc: func(string) (*proto.RadioSession, bool, error) {
+					return &proto.RadioSession{UserID: 1, ArtistIDs: []int64{2}, Played: []int64{1, 2}}, true, nil
+				},
+				RadioTracksFunc: func(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64,
+					candidates []int64, amount int64) ([]*proto.Track, error) {
+					if len(artistIDs) != 0 {
+						assert.Equal(t, []int64{1, 2}, exclude)
+						return radioTestTracks(5), nil
+					}
+					assert.Equal(t, []int64{1, 2, 5}, exclude)
+					assert.Equal(t, []int64{9, 8, 7}, candidates)
+					return radioTestTracks(6), nil
+				},
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					assert.Equal(t, constants.ChartEntityTracks, entity)
+					return []int64{9, 8, 7}, nil
+				},
+				SaveRadioSessionFunc: func(string, *proto.RadioSession, time.Duration) error {
+					return nil
+				},
 			},
-			expected: &proto.Tracks{Tracks: []*proto.Track{}},
+			input:          &proto.RadioOptions{Session: "token", UserID: 1, Amount: 2},
+			expectedTracks: []int64{5, 6},
+			expectedPlayed: []int64{1, 2, 5, 6},
 		},
 		{
-			name: "Fail. GetFavorites returns error",
+			name: "Success. History is trimmed",
 			storageMock: &mock.MockStorage{
-				GetFavoritesFunc: func(int64) ([]*proto.Track, error) {
-					return []*proto.Track{}, errors.New("error")
+				RadioSessionFunc: func(string) (*proto.RadioSession, bool, error) {
+					return &proto.RadioSession{UserID: 1, Played: append([]int64{}, longHistory...)}, true, nil
+				},
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					assert.Equal(t, constants.ChartEntityTracks, entity)
+					return []int64{9, 8, 7}, nil
+				},
+				RadioTracksFunc: func(userID int64, artistIDs []int64, genreIDs []int64, pool string, exclude []int64,
+					candidates []int64, amount int64) ([]*proto.Track, error) {
+					assert.Equal(t, []int64{9, 8, 7}, candidates)
+					return radioTestTracks(7), nil
+				},
+				SaveRadioSessionFunc: func(string, *proto.RadioSession, time.Duration) error {
+					return nil
 				},
 			},
-			input: &proto.UserFavoritesOptions{
-				UserID: 1,
+			input:          &proto.RadioOptions{Session: "token", UserID: 1, Amount: 1},
+			expectedTracks: []int64{7},
+			expectedPlayed: append(append([]int64{}, longHistory[1:]...), 7),
+		},
+		{
+			name: "Fail. Random pool returns error",
+			storageMock: &mock.MockStorage{
+				RadioSeedFunc: func(string, int64, int64) (*proto.RadioSession, error) {
+					return &proto.RadioSession{}, nil
+				},
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 0, errors.New("error")
+				},
 			},
-			expected:    &proto.Tracks{},
+			input:       &proto.RadioOptions{SeedType: constants.RadioSeedTrack, SeedID: 2, UserID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+		{
+			name: "Fail. Session belongs to another user",
+			storageMock: &mock.MockStorage{
+				RadioSessionFunc: func(string) (*proto.RadioSession, bool, error) {
+					return &proto.RadioSession{UserID: 2}, true, nil
+				},
+			},
+			input:       &proto.RadioOptions{Session: "token", UserID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.RadioSessionNotFoundMessage),
+		},
+		{
+			name: "Fail. Session expired",
+			storageMock: &mock.MockStorage{
+				RadioSessionFunc: func(string) (*proto.RadioSession, bool, error) {
+					return nil, false, nil
+				},
+			},
+			input:       &proto.RadioOptions{Session: "token", UserID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.RadioSessionNotFoundMessage),
+		},
+		{
+			name:        "Fail. Invalid seed type",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.RadioOptions{SeedType: "genre", SeedID: 2, UserID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.RadioSeedTypeInvalidMessage),
+		},
+		{
+			name: "Fail. Seed not found",
+			storageMock: &mock.MockStorage{
+				RadioSeedFunc: func(string, int64, int64) (*proto.RadioSession, error) {
+					return nil, sql.ErrNoRows
+				},
+			},
+			input:       &proto.RadioOptions{SeedType: constants.RadioSeedPlaylist, SeedID: 2, UserID: 1},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.RadioSeedNotFoundMessage),
+		},
+		{
+			name: "Fail. SaveRadioSession returns error",
+			storageMock: &mock.MockStorage{
+				RadioSeedFunc: func(string, int64, int64) (*proto.RadioSession, error) {
+					return &proto.RadioSession{}, nil
+				},
+				RadioTracksFunc: func(int64, []int64, []int64, string, []int64, []int64, int64) ([]*proto.Track, error) {
+					return radioTestTracks(1), nil
+				},
+				RandomPoolGenerationFunc: func(string) (int64, error) {
+					return 1, nil
+				},
+				RandomPoolSizeFunc: func(string, int64) (int64, error) {
+					return 10, nil
+				},
+				RandomPoolIDsFunc: func(entity string, generation int64, positions []int64) ([]int64, error) {
+					assert.Equal(t, constants.ChartEntityTracks, entity)
+					return []int64{9, 8, 7}, nil
+				},
+				SaveRadioSessionFunc: func(string, *proto.RadioSession, time.Duration) error {
+					return errors.New("error")
+				},
+			},
+			input:       &proto.RadioOptions{SeedType: constants.RadioSeedTrack, SeedID: 2, UserID: 1},
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
@@ -1116,14 +3803,44 @@ func TestMusicService_GetFavoriteTracks(t *testing.T) {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
 			storage := NewMusicService(currentTest.storageMock)
-			res, err := storage.GetFavoriteTracks(context.Background(), currentTest.input)
+
+			res, err := storage.Radio(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
 				assert.Equal(t, err, currentTest.err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, currentTest.expected, res)
+				assert.NotEmpty(t, res.Session)
+				trackIDs := make([]int64, 0, len(res.Tracks))
+				for _, track := range res.Tracks {
+					trackIDs = append(trackIDs, track.ID)
+				}
+				assert.Equal(t, currentTest.expectedTracks, trackIDs)
+
+				calls := currentTest.storageMock.SaveRadioSessionCalls()
+				assert.Equal(t, 1, len(calls))
+				assert.Equal(t, "radio:"+res.Session, calls[0].Key)
+				assert.Equal(t, currentTest.expectedPlayed, calls[0].Session.Played)
 			}
 		})
 	}
 }
+
+func TestMusicService_RebuildRandomPools(t *testing.T) {
+	storageMock := &mock.MockStorage{
+		RebuildRandomPoolFunc: func(string) (int64, error) {
+			return 1, nil
+		},
+	}
+	service := NewMusicService(storageMock)
+
+	assert.NoError(t, service.RebuildRandomPools())
+	calls := storageMock.RebuildRandomPoolCalls()
+	assert.Len(t, calls, 3)
+	assert.Equal(t, constants.ChartEntityTracks, calls[0].Entity)
+
+	storageMock.RebuildRandomPoolFunc = func(string) (int64, error) {
+		return 0, errors.New("error")
+	}
+	assert.Error(t, service.RebuildRandomPools())
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"2021_2_LostPointer/internal/microservices/music/proto"
+)
+
+const (
+	unterminatedQuoteMessage = "unterminated quote"
+	emptyValueMessage        = "empty value for "
+	invalidValueMessage      = "invalid value for "
+	invalidRangeMessage      = "range start is greater than end"
+	rangeSeparator           = ".."
+)
+
+var errInvalidRange = errors.New(invalidRangeMessage)
+
+// Ошибка разбора поискового запроса, позиция считается в символах начиная с единицы
+type queryError struct {
+	position int
+	message  string
+}
+
+func (err *queryError) Error() string {
+	return fmt.Sprintf("%s at position %d", err.message, err.position)
+}
+
+// Поддерживаемые фильтры запроса вида key:value или key:"value with spaces"
+var queryFilters = map[string]func(*proto.SearchFilter, string) error{
+	"artist": func(filter *proto.SearchFilter, value string) error {
+		filter.Artist = value
+		return nil
+	},
+	"album": func(filter *proto.SearchFilter, value string) error {
+		filter.Album = value
+		return nil
+	},
+	"genre": func(filter *proto.SearchFilter, value string) error {
+		filter.Genre = value
+		return nil
+	},
+	"year": func(filter *proto.SearchFilter, value string) error {
+		var err error
+		filter.YearFrom, filter.YearTo, err = parseRange(value, parseNumber)
+		return err
+	},
+	"duration": func(filter *proto.SearchFilter, value string) error {
+		var err error
+		filter.DurationFrom, filter.DurationTo, err = parseRange(value, parseSeconds)
+		return err
+	},
+	"explicit": func(filter *proto.SearchFilter, value string) error {
+		explicit, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		filter.Explicit = &explicit
+		return nil
+	},
+	"lossless": func(filter *proto.SearchFilter, value string) error {
+		lossless, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		filter.Lossless = &lossless
+		return nil
+	},
+	"lyrics": func(filter *proto.SearchFilter, value string) error {
+		filter.Lyrics = value
+		return nil
+	},
+}
+
+// Разбирает запрос: фильтры записываются в filter, остальные слова возвращаются как текст поиска
+func parseQuery(query string, filter *proto.SearchFilter) (string, error) {
+	runes := []rune(query)
+	words := make([]string, 0)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+		if runes[i] == '"' {
+			phrase, next, err := readQuoted(runes, i)
+			if err != nil {
+				return "", err
+			}
+			words = append(words, phrase)
+			i = next
+			continue
+		}
+
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != ':' && runes[i] != '"' {
+			i++
+		}
+		key := strings.ToLower(string(runes[start:i]))
+		apply, isFilter := queryFilters[key]
+		if !isFilter || i == len(runes) || runes[i] != ':' {
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			words = append(words, string(runes[start:i]))
+			continue
+		}
+
+		i++
+		valueStart := i
+		var value string
+		if i < len(runes) && runes[i] == '"' {
+			var err error
+			if value, i, err = readQuoted(runes, i); err != nil {
+				return "", err
+			}
+		} else {
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			value = string(runes[valueStart:i])
+		}
+
+		if len(strings.TrimSpace(value)) == 0 {
+			return "", &queryError{position: valueStart + 1, message: emptyValueMessage + key}
+		}
+		if err := apply(filter, value); err != nil {
+			message := invalidValueMessage + key
+			if errors.Is(err, errInvalidRange) {
+				message = invalidRangeMessage
+			}
+			return "", &queryError{position: valueStart + 1, message: message}
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// Читает строку в кавычках, начиная с открывающей, и возвращает индекс после закрывающей
+func readQuoted(runes []rune, start int) (string, int, error) {
+	for i := start + 1; i < len(runes); i++ {
+		if runes[i] == '"' {
+			return string(runes[start+1 : i]), i + 1, nil
+		}
+	}
+	return "", 0, &queryError{position: start + 1, message: unterminatedQuoteMessage}
+}
+
+// Разбирает значение или диапазон from..to, любая из границ диапазона может быть опущена
+func parseRange(value string, parse func(string) (int64, error)) (int64, int64, error) {
+	bounds := strings.SplitN(value, rangeSeparator, 2)
+	if len(bounds) == 1 {
+		number, err := parse(value)
+		return number, number, err
+	}
+	if len(bounds[0]) == 0 && len(bounds[1]) == 0 {
+		return 0, 0, strconv.ErrSyntax
+	}
+
+	var from, to int64
+	var err error
+	if len(bounds[0]) != 0 {
+		if from, err = parse(bounds[0]); err != nil {
+			return 0, 0, err
+		}
+	}
+	if len(bounds[1]) != 0 {
+		if to, err = parse(bounds[1]); err != nil {
+			return 0, 0, err
+		}
+	}
+	if to != 0 && from > to {
+		return 0, 0, errInvalidRange
+	}
+
+	return from, to, nil
+}
+
+func parseNumber(value string) (int64, error) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if number <= 0 {
+		return 0, strconv.ErrRange
+	}
+	return number, nil
+}
+
+// Длительность в секундах или в формате минуты:секунды
+func parseSeconds(value string) (int64, error) {
+	parts := strings.SplitN(value, ":", 2)
+	if len(parts) == 1 {
+		return parseNumber(value)
+	}
+	minutes, seconds := parts[0], parts[1]
+	minutesNumber, err := strconv.ParseInt(minutes, 10, 64)
+	if err != nil || minutesNumber < 0 {
+		return 0, strconv.ErrSyntax
+	}
+	secondsNumber, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil || len(seconds) != 2 || secondsNumber < 0 || secondsNumber >= 60 {
+		return 0, strconv.ErrSyntax
+	}
+	if minutesNumber*60+secondsNumber == 0 {
+		return 0, strconv.ErrRange
+	}
+	return minutesNumber*60 + secondsNumber, nil
+}
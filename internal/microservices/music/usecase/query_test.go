@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"2021_2_LostPointer/internal/microservices/music/proto"
+)
+
+func TestParseQuery(t *testing.T) {
+	explicit := false
+	lossless := true
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedText   string
+		expectedFilter *proto.SearchFilter
+		expectedErr    string
+	}{
+		{
+			name:           "Only text",
+			query:          "la  haine",
+			expectedText:   "la haine",
+			expectedFilter: &proto.SearchFilter{},
+		},
+		{
+			name:         "All filters",
+			query:        `Artist:"Face Bar" album:Ghetto genre:rap year:2010..2015 duration:90..4:05 explicit:false lossless:1 lyrics:"neon lights" haine`,
+			expectedText: "haine",
+			expectedFilter: &proto.SearchFilter{
+				Artist:       "Face Bar",
+				Album:        "Ghetto",
+				Genre:        "rap",
+				YearFrom:     2010,
+				YearTo:       2015,
+				DurationFrom: 90,
+				DurationTo:   245,
+				Explicit:     &explicit,
+				Lossless:     &lossless,
+				Lyrics:       "neon lights",
+			},
+		},
+		{
+			name:           "Single year and open ranges",
+			query:          "year:2012 duration:120..",
+			expectedFilter: &proto.SearchFilter{YearFrom: 2012, YearTo: 2012, DurationFrom: 120},
+		},
+		{
+			name:           "Quoted phrase and unknown key are text",
+			query:          `"la haine" feat:someone`,
+			expectedText:   "la haine feat:someone",
+			expectedFilter: &proto.SearchFilter{},
+		},
+		{
+			name:           "Key without colon is text",
+			query:          "artist year",
+			expectedText:   "artist year",
+			expectedFilter: &proto.SearchFilter{},
+		},
+		{
+			name:        "Unterminated quote in value",
+			query:       `artist:"Face Bar`,
+			expectedErr: "unterminated quote at position 8",
+		},
+		{
+			name:        "Unterminated quote in text",
+			query:       `привет "мир`,
+			expectedErr: "unterminated quote at position 8",
+		},
+		{
+			name:        "Empty value",
+			query:       "la year: 2010",
+			expectedErr: "empty value for year at position 9",
+		},
+		{
+			name:        "Invalid number",
+			query:       "year:20x0",
+			expectedErr: "invalid value for year at position 6",
+		},
+		{
+			name:        "Invalid range",
+			query:       "year:2015..2010",
+			expectedErr: "range start is greater than end at position 6",
+		},
+		{
+			name:        "Empty range",
+			query:       "year:..",
+			expectedErr: "invalid value for year at position 6",
+		},
+		{
+			name:        "Invalid duration",
+			query:       "duration:3:75",
+			expectedErr: "invalid value for duration at position 10",
+		},
+		{
+			name:        "Invalid bool",
+			query:       "lossless:maybe",
+			expectedErr: "invalid value for lossless at position 10",
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			filter := &proto.SearchFilter{}
+			text, err := parseQuery(currentTest.query, filter)
+			if len(currentTest.expectedErr) != 0 {
+				assert.EqualError(t, err, currentTest.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedText, text)
+				assert.Equal(t, currentTest.expectedFilter, filter)
+			}
+		})
+	}
+}
@@ -0,0 +1,788 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proto/player.pb.go
+
+// Package mock_proto is a generated GoMock package.
+package mock
+
+import (
+	proto "2021_2_LostPointer/internal/microservices/player/proto"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+)
+
+// MockPlayerClient is a mock of PlayerClient interface.
+type MockPlayerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayerClientMockRecorder
+}
+
+// MockPlayerClientMockRecorder is the mock recorder for MockPlayerClient.
+type MockPlayerClientMockRecorder struct {
+	mock *MockPlayerClient
+}
+
+// NewMockPlayerClient creates a new mock instance.
+func NewMockPlayerClient(ctrl *gomock.Controller) *MockPlayerClient {
+	mock := &MockPlayerClient{ctrl: ctrl}
+	mock.recorder = &MockPlayerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayerClient) EXPECT() *MockPlayerClientMockRecorder {
+	return m.recorder
+}
+
+// GetDevices mocks base method.
+func (m *MockPlayerClient) GetDevices(ctx context.Context, in *proto.GetDevicesOptions, opts ...grpc.CallOption) (*proto.Devices, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDevices", varargs...)
+	ret0, _ := ret[0].(*proto.Devices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockPlayerClientMockRecorder) GetDevices(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockPlayerClient)(nil).GetDevices), varargs...)
+}
+
+// GetQueue mocks base method.
+func (m *MockPlayerClient) GetQueue(ctx context.Context, in *proto.GetQueueOptions, opts ...grpc.CallOption) (*proto.Queue, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetQueue", varargs...)
+	ret0, _ := ret[0].(*proto.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockPlayerClientMockRecorder) GetQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockPlayerClient)(nil).GetQueue), varargs...)
+}
+
+// RegisterDevice mocks base method.
+func (m *MockPlayerClient) RegisterDevice(ctx context.Context, in *proto.RegisterDeviceOptions, opts ...grpc.CallOption) (*proto.Device, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterDevice", varargs...)
+	ret0, _ := ret[0].(*proto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDevice indicates an expected call of RegisterDevice.
+func (mr *MockPlayerClientMockRecorder) RegisterDevice(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDevice", reflect.TypeOf((*MockPlayerClient)(nil).RegisterDevice), varargs...)
+}
+
+// SendCommand mocks base method.
+func (m *MockPlayerClient) SendCommand(ctx context.Context, in *proto.SendCommandOptions, opts ...grpc.CallOption) (*proto.SendCommandResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendCommand", varargs...)
+	ret0, _ := ret[0].(*proto.SendCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCommand indicates an expected call of SendCommand.
+func (mr *MockPlayerClientMockRecorder) SendCommand(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCommand", reflect.TypeOf((*MockPlayerClient)(nil).SendCommand), varargs...)
+}
+
+// UpdateQueue mocks base method.
+func (m *MockPlayerClient) UpdateQueue(ctx context.Context, in *proto.UpdateQueueOptions, opts ...grpc.CallOption) (*proto.Queue, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateQueue", varargs...)
+	ret0, _ := ret[0].(*proto.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQueue indicates an expected call of UpdateQueue.
+func (mr *MockPlayerClientMockRecorder) UpdateQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueue", reflect.TypeOf((*MockPlayerClient)(nil).UpdateQueue), varargs...)
+}
+
+// WatchCommands mocks base method.
+func (m *MockPlayerClient) WatchCommands(ctx context.Context, in *proto.WatchCommandsOptions, opts ...grpc.CallOption) (proto.Player_WatchCommandsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchCommands", varargs...)
+	ret0, _ := ret[0].(proto.Player_WatchCommandsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchCommands indicates an expected call of WatchCommands.
+func (mr *MockPlayerClientMockRecorder) WatchCommands(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchCommands", reflect.TypeOf((*MockPlayerClient)(nil).WatchCommands), varargs...)
+}
+
+// WatchQueue mocks base method.
+func (m *MockPlayerClient) WatchQueue(ctx context.Context, in *proto.GetQueueOptions, opts ...grpc.CallOption) (proto.Player_WatchQueueClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchQueue", varargs...)
+	ret0, _ := ret[0].(proto.Player_WatchQueueClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchQueue indicates an expected call of WatchQueue.
+func (mr *MockPlayerClientMockRecorder) WatchQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchQueue", reflect.TypeOf((*MockPlayerClient)(nil).WatchQueue), varargs...)
+}
+
+// MockPlayer_WatchQueueClient is a mock of Player_WatchQueueClient interface.
+type MockPlayer_WatchQueueClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayer_WatchQueueClientMockRecorder
+}
+
+// MockPlayer_WatchQueueClientMockRecorder is the mock recorder for MockPlayer_WatchQueueClient.
+type MockPlayer_WatchQueueClientMockRecorder struct {
+	mock *MockPlayer_WatchQueueClient
+}
+
+// NewMockPlayer_WatchQueueClient creates a new mock instance.
+func NewMockPlayer_WatchQueueClient(ctrl *gomock.Controller) *MockPlayer_WatchQueueClient {
+	mock := &MockPlayer_WatchQueueClient{ctrl: ctrl}
+	mock.recorder = &MockPlayer_WatchQueueClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayer_WatchQueueClient) EXPECT() *MockPlayer_WatchQueueClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockPlayer_WatchQueueClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockPlayer_WatchQueueClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockPlayer_WatchQueueClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockPlayer_WatchQueueClient) Recv() (*proto.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPlayer_WatchQueueClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPlayer_WatchQueueClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPlayer_WatchQueueClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPlayer_WatchQueueClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPlayer_WatchQueueClient)(nil).Trailer))
+}
+
+// MockPlayer_WatchCommandsClient is a mock of Player_WatchCommandsClient interface.
+type MockPlayer_WatchCommandsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayer_WatchCommandsClientMockRecorder
+}
+
+// MockPlayer_WatchCommandsClientMockRecorder is the mock recorder for MockPlayer_WatchCommandsClient.
+type MockPlayer_WatchCommandsClientMockRecorder struct {
+	mock *MockPlayer_WatchCommandsClient
+}
+
+// NewMockPlayer_WatchCommandsClient creates a new mock instance.
+func NewMockPlayer_WatchCommandsClient(ctrl *gomock.Controller) *MockPlayer_WatchCommandsClient {
+	mock := &MockPlayer_WatchCommandsClient{ctrl: ctrl}
+	mock.recorder = &MockPlayer_WatchCommandsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayer_WatchCommandsClient) EXPECT() *MockPlayer_WatchCommandsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockPlayer_WatchCommandsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockPlayer_WatchCommandsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockPlayer_WatchCommandsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockPlayer_WatchCommandsClient) Recv() (*proto.DeviceCommand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.DeviceCommand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPlayer_WatchCommandsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPlayer_WatchCommandsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockPlayer_WatchCommandsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockPlayer_WatchCommandsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockPlayer_WatchCommandsClient)(nil).Trailer))
+}
+
+// MockPlayerServer is a mock of PlayerServer interface.
+type MockPlayerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayerServerMockRecorder
+}
+
+// MockPlayerServerMockRecorder is the mock recorder for MockPlayerServer.
+type MockPlayerServerMockRecorder struct {
+	mock *MockPlayerServer
+}
+
+// NewMockPlayerServer creates a new mock instance.
+func NewMockPlayerServer(ctrl *gomock.Controller) *MockPlayerServer {
+	mock := &MockPlayerServer{ctrl: ctrl}
+	mock.recorder = &MockPlayerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayerServer) EXPECT() *MockPlayerServerMockRecorder {
+	return m.recorder
+}
+
+// GetDevices mocks base method.
+func (m *MockPlayerServer) GetDevices(arg0 context.Context, arg1 *proto.GetDevicesOptions) (*proto.Devices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Devices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockPlayerServerMockRecorder) GetDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockPlayerServer)(nil).GetDevices), arg0, arg1)
+}
+
+// GetQueue mocks base method.
+func (m *MockPlayerServer) GetQueue(arg0 context.Context, arg1 *proto.GetQueueOptions) (*proto.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockPlayerServerMockRecorder) GetQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockPlayerServer)(nil).GetQueue), arg0, arg1)
+}
+
+// RegisterDevice mocks base method.
+func (m *MockPlayerServer) RegisterDevice(arg0 context.Context, arg1 *proto.RegisterDeviceOptions) (*proto.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDevice", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDevice indicates an expected call of RegisterDevice.
+func (mr *MockPlayerServerMockRecorder) RegisterDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDevice", reflect.TypeOf((*MockPlayerServer)(nil).RegisterDevice), arg0, arg1)
+}
+
+// SendCommand mocks base method.
+func (m *MockPlayerServer) SendCommand(arg0 context.Context, arg1 *proto.SendCommandOptions) (*proto.SendCommandResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCommand", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SendCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCommand indicates an expected call of SendCommand.
+func (mr *MockPlayerServerMockRecorder) SendCommand(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCommand", reflect.TypeOf((*MockPlayerServer)(nil).SendCommand), arg0, arg1)
+}
+
+// UpdateQueue mocks base method.
+func (m *MockPlayerServer) UpdateQueue(arg0 context.Context, arg1 *proto.UpdateQueueOptions) (*proto.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQueue", arg0, arg1)
+	ret0, _ := ret[0].(*proto.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQueue indicates an expected call of UpdateQueue.
+func (mr *MockPlayerServerMockRecorder) UpdateQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueue", reflect.TypeOf((*MockPlayerServer)(nil).UpdateQueue), arg0, arg1)
+}
+
+// WatchCommands mocks base method.
+func (m *MockPlayerServer) WatchCommands(arg0 *proto.WatchCommandsOptions, arg1 proto.Player_WatchCommandsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchCommands", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchCommands indicates an expected call of WatchCommands.
+func (mr *MockPlayerServerMockRecorder) WatchCommands(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchCommands", reflect.TypeOf((*MockPlayerServer)(nil).WatchCommands), arg0, arg1)
+}
+
+// WatchQueue mocks base method.
+func (m *MockPlayerServer) WatchQueue(arg0 *proto.GetQueueOptions, arg1 proto.Player_WatchQueueServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchQueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchQueue indicates an expected call of WatchQueue.
+func (mr *MockPlayerServerMockRecorder) WatchQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchQueue", reflect.TypeOf((*MockPlayerServer)(nil).WatchQueue), arg0, arg1)
+}
+
+// MockPlayer_WatchQueueServer is a mock of Player_WatchQueueServer interface.
+type MockPlayer_WatchQueueServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayer_WatchQueueServerMockRecorder
+}
+
+// MockPlayer_WatchQueueServerMockRecorder is the mock recorder for MockPlayer_WatchQueueServer.
+type MockPlayer_WatchQueueServerMockRecorder struct {
+	mock *MockPlayer_WatchQueueServer
+}
+
+// NewMockPlayer_WatchQueueServer creates a new mock instance.
+func NewMockPlayer_WatchQueueServer(ctrl *gomock.Controller) *MockPlayer_WatchQueueServer {
+	mock := &MockPlayer_WatchQueueServer{ctrl: ctrl}
+	mock.recorder = &MockPlayer_WatchQueueServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayer_WatchQueueServer) EXPECT() *MockPlayer_WatchQueueServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPlayer_WatchQueueServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPlayer_WatchQueueServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockPlayer_WatchQueueServer) Send(arg0 *proto.Queue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockPlayer_WatchQueueServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPlayer_WatchQueueServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockPlayer_WatchQueueServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockPlayer_WatchQueueServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPlayer_WatchQueueServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPlayer_WatchQueueServer)(nil).SetTrailer), arg0)
+}
+
+// MockPlayer_WatchCommandsServer is a mock of Player_WatchCommandsServer interface.
+type MockPlayer_WatchCommandsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayer_WatchCommandsServerMockRecorder
+}
+
+// MockPlayer_WatchCommandsServerMockRecorder is the mock recorder for MockPlayer_WatchCommandsServer.
+type MockPlayer_WatchCommandsServerMockRecorder struct {
+	mock *MockPlayer_WatchCommandsServer
+}
+
+// NewMockPlayer_WatchCommandsServer creates a new mock instance.
+func NewMockPlayer_WatchCommandsServer(ctrl *gomock.Controller) *MockPlayer_WatchCommandsServer {
+	mock := &MockPlayer_WatchCommandsServer{ctrl: ctrl}
+	mock.recorder = &MockPlayer_WatchCommandsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayer_WatchCommandsServer) EXPECT() *MockPlayer_WatchCommandsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockPlayer_WatchCommandsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockPlayer_WatchCommandsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockPlayer_WatchCommandsServer) Send(arg0 *proto.DeviceCommand) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockPlayer_WatchCommandsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockPlayer_WatchCommandsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockPlayer_WatchCommandsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockPlayer_WatchCommandsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockPlayer_WatchCommandsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockPlayer_WatchCommandsServer)(nil).SetTrailer), arg0)
+}
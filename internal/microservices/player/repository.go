@@ -0,0 +1,24 @@
+package player
+
+import (
+	"context"
+	"time"
+
+	"2021_2_LostPointer/internal/microservices/player/proto"
+)
+
+//go:generate moq -out ./mock/player_repo_mock.go -pkg mock . Storage:MockStorage
+type Storage interface {
+	Queue(userID int64) (*proto.Queue, error)
+	SaveQueue(userID int64, queue *proto.Queue) (*proto.Queue, bool, error)
+	PublishQueue(userID int64, queue *proto.Queue) error
+	SubscribeQueue(ctx context.Context, userID int64) (<-chan *proto.Queue, error)
+	Device(deviceID string) (*proto.Device, bool, error)
+	UserDevices(userID int64) ([]*proto.Device, error)
+	SaveDevice(device *proto.Device, lifetime time.Duration) error
+	IsDeviceOnline(deviceID string) (bool, error)
+	SetDevicePresence(deviceID string, lifetime time.Duration) error
+	DeleteDevicePresence(deviceID string) error
+	PublishCommand(deviceID string, command *proto.DeviceCommand) error
+	SubscribeCommands(ctx context.Context, deviceID string) (<-chan *proto.DeviceCommand, error)
+}
@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/microservices/player/proto"
+)
+
+type PlayerStorage struct {
+	db    *sql.DB
+	redis *redis.Client
+}
+
+func NewPlayerStorage(db *sql.DB, redis *redis.Client) *PlayerStorage {
+	return &PlayerStorage{db: db, redis: redis}
+}
+
+func queueChannel(userID int64) string {
+	return fmt.Sprintf("queue:%d", userID)
+}
+
+// Пользователь без сохраненной очереди получает пустую очередь нулевой версии
+func (storage *PlayerStorage) Queue(userID int64) (*proto.Queue, error) {
+	query := `
+		SELECT track_ids, current_index, position_ms, shuffle, repeat_mode, context_type, context_id,
+		version, device_id, updated_at
+		FROM play_queues WHERE user_id = $1`
+
+	var trackIDs pq.Int64Array
+	var updatedAt time.Time
+	queue := &proto.Queue{}
+	err := storage.db.QueryRow(query, userID).Scan(&trackIDs, &queue.CurrentIndex, &queue.PositionMs, &queue.Shuffle,
+		&queue.RepeatMode, &queue.ContextType, &queue.ContextID, &queue.Version, &queue.DeviceID, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.Queue{TrackIDs: []int64{}, RepeatMode: constants.RepeatModeOff}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	queue.TrackIDs = trackIDs
+	queue.UpdatedAt = updatedAt.Unix()
+
+	return queue, nil
+}
+
+// Очередь сохраняется, только если ее версия в базе совпадает с версией, от которой клиент делал изменения.
+// Иначе возвращается false и очередь не меняется. Новая очередь создается только от нулевой версии
+func (storage *PlayerStorage) SaveQueue(userID int64, queue *proto.Queue) (*proto.Queue, bool, error) {
+	args := []interface{}{userID, pq.Array(queue.TrackIDs), queue.CurrentIndex, queue.PositionMs, queue.Shuffle,
+		queue.RepeatMode, queue.ContextType, queue.ContextID, queue.DeviceID}
+	query := `
+		INSERT INTO play_queues(user_id, track_ids, current_index, position_ms, shuffle, repeat_mode, context_type,
+		context_id, device_id, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, 1)
+		ON CONFLICT (user_id) DO NOTHING
+		RETURNING version, updated_at`
+	if queue.Version != 0 {
+		query = `
+			UPDATE play_queues SET track_ids = $2, current_index = $3, position_ms = $4, shuffle = $5,
+			repeat_mode = $6, context_type = $7, context_id = $8, device_id = $9, version = version + 1,
+			updated_at = now()
+			WHERE user_id = $1 AND version = $10
+			RETURNING version, updated_at`
+		args = append(args, queue.Version)
+	}
+
+	var updatedAt time.Time
+	saved := protobuf.Clone(queue).(*proto.Queue)
+	err := storage.db.QueryRow(query, args...).Scan(&saved.Version, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	saved.UpdatedAt = updatedAt.Unix()
+
+	return saved, true, nil
+}
+
+func (storage *PlayerStorage) PublishQueue(userID int64, queue *proto.Queue) error {
+	data, err := protobuf.Marshal(queue)
+	if err != nil {
+		return err
+	}
+
+	return storage.redis.Publish(context.Background(), queueChannel(userID), data).Err()
+}
+
+// Подписка на изменения очереди через Redis pub/sub, поэтому изменения доходят до всех экземпляров сервиса.
+// Канал закрывается после отмены ctx
+//
+//nolint:dupl
+func (storage *PlayerStorage) SubscribeQueue(ctx context.Context, userID int64) (<-chan *proto.Queue, error) {
+	subscription := storage.redis.Subscribe(ctx, queueChannel(userID))
+	if _, err := subscription.Receive(ctx); err != nil {
+		_ = subscription.Close()
+		return nil, err
+	}
+
+	queues := make(chan *proto.Queue)
+	go func() {
+		defer close(queues)
+		defer func() {
+			_ = subscription.Close()
+		}()
+
+		messages := subscription.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				queue := &proto.Queue{}
+				if err := protobuf.Unmarshal([]byte(message.Payload), queue); err != nil {
+					continue
+				}
+				select {
+				case queues <- queue:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return queues, nil
+}
+
+func deviceKey(deviceID string) string {
+	return fmt.Sprintf("device:%s", deviceID)
+}
+
+func userDevicesKey(userID int64) string {
+	return fmt.Sprintf("devices:%d", userID)
+}
+
+func presenceKey(deviceID string) string {
+	return fmt.Sprintf("presence:%s", deviceID)
+}
+
+func commandsChannel(deviceID string) string {
+	return fmt.Sprintf("commands:%s", deviceID)
+}
+
+func (storage *PlayerStorage) Device(deviceID string) (*proto.Device, bool, error) {
+	data, err := storage.redis.Get(context.Background(), deviceKey(deviceID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	device := &proto.Device{}
+	if err = protobuf.Unmarshal(data, device); err != nil {
+		return nil, false, err
+	}
+
+	return device, true, nil
+}
+
+// Устройства хранятся вместе с сессией и истекают вместе с ней, поэтому из множества устройств пользователя
+// удаляются идентификаторы, записи по которым уже истекли
+func (storage *PlayerStorage) UserDevices(userID int64) ([]*proto.Device, error) {
+	ctx := context.Background()
+	deviceIDs, err := storage.redis.SMembers(ctx, userDevicesKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(deviceIDs) == 0 {
+		return []*proto.Device{}, nil
+	}
+
+	keys := make([]string, 0, len(deviceIDs)*2)
+	for _, deviceID := range deviceIDs {
+		keys = append(keys, deviceKey(deviceID))
+	}
+	for _, deviceID := range deviceIDs {
+		keys = append(keys, presenceKey(deviceID))
+	}
+	values, err := storage.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*proto.Device, 0, len(deviceIDs))
+	expired := make([]interface{}, 0)
+	for i, deviceID := range deviceIDs {
+		data, ok := values[i].(string)
+		if !ok {
+			expired = append(expired, deviceID)
+			continue
+		}
+		device := &proto.Device{}
+		if err = protobuf.Unmarshal([]byte(data), device); err != nil {
+			return nil, err
+		}
+		device.Online = values[len(deviceIDs)+i] != nil
+		devices = append(devices, device)
+	}
+	if len(expired) != 0 {
+		if err = storage.redis.SRem(ctx, userDevicesKey(userID), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return devices, nil
+}
+
+func (storage *PlayerStorage) SaveDevice(device *proto.Device, lifetime time.Duration) error {
+	data, err := protobuf.Marshal(device)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	_, err = storage.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, deviceKey(device.ID), data, lifetime)
+		pipe.SAdd(ctx, userDevicesKey(device.UserID), device.ID)
+		pipe.Expire(ctx, userDevicesKey(device.UserID), lifetime)
+		return nil
+	})
+
+	return err
+}
+
+func (storage *PlayerStorage) IsDeviceOnline(deviceID string) (bool, error) {
+	count, err := storage.redis.Exists(context.Background(), presenceKey(deviceID)).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return count != 0, nil
+}
+
+// Устройство считается онлайн, пока открыт его канал команд: присутствие продлевается, пока канал жив,
+// и истекает само, если экземпляр сервиса упал, не успев его удалить
+func (storage *PlayerStorage) SetDevicePresence(deviceID string, lifetime time.Duration) error {
+	return storage.redis.Set(context.Background(), presenceKey(deviceID), 1, lifetime).Err()
+}
+
+func (storage *PlayerStorage) DeleteDevicePresence(deviceID string) error {
+	return storage.redis.Del(context.Background(), presenceKey(deviceID)).Err()
+}
+
+func (storage *PlayerStorage) PublishCommand(deviceID string, command *proto.DeviceCommand) error {
+	data, err := protobuf.Marshal(command)
+	if err != nil {
+		return err
+	}
+
+	return storage.redis.Publish(context.Background(), commandsChannel(deviceID), data).Err()
+}
+
+//nolint:dupl
+func (storage *PlayerStorage) SubscribeCommands(ctx context.Context, deviceID string) (<-chan *proto.DeviceCommand, error) {
+	subscription := storage.redis.Subscribe(ctx, commandsChannel(deviceID))
+	if _, err := subscription.Receive(ctx); err != nil {
+		_ = subscription.Close()
+		return nil, err
+	}
+
+	commands := make(chan *proto.DeviceCommand)
+	go func() {
+		defer close(commands)
+		defer func() {
+			_ = subscription.Close()
+		}()
+
+		messages := subscription.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				command := &proto.DeviceCommand{}
+				if err := protobuf.Unmarshal([]byte(message.Payload), command); err != nil {
+					continue
+				}
+				select {
+				case commands <- command:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return commands, nil
+}
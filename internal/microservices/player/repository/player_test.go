@@ -0,0 +1,357 @@
+package repository
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redismock/v8"
+	"github.com/stretchr/testify/assert"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/microservices/player/proto"
+)
+
+func TestPlayerStorage_Queue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewPlayerStorage(db, nil)
+
+	const userID = 1
+	updatedAt := time.Unix(1600000000, 0)
+	query := `FROM play_queues WHERE user_id = $1`
+	columns := []string{"track_ids", "current_index", "position_ms", "shuffle", "repeat_mode", "context_type", "context_id",
+		"version", "device_id", "updated_at"}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.Queue
+		expectedError bool
+	}{
+		{
+			name: "queue found",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).AddRow("{3,1,2}", 1, 15000, true, constants.RepeatModeAll,
+					constants.QueueContextAlbum, 4, 7, "phone", updatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).WillReturnRows(rows)
+			},
+			expected: &proto.Queue{TrackIDs: []int64{3, 1, 2}, CurrentIndex: 1, PositionMs: 15000, Shuffle: true,
+				RepeatMode: constants.RepeatModeAll, ContextType: constants.QueueContextAlbum, ContextID: 4, Version: 7,
+				DeviceID: "phone", UpdatedAt: updatedAt.Unix()},
+		},
+		{
+			name: "user has no queue yet",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).WillReturnError(sql.ErrNoRows)
+			},
+			expected: &proto.Queue{TrackIDs: []int64{}, RepeatMode: constants.RepeatModeOff},
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.Queue(userID)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, protobuf.Equal(currentTest.expected, result))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlayerStorage_SaveQueue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewPlayerStorage(db, nil)
+
+	const userID = 1
+	updatedAt := time.Unix(1600000000, 0)
+	queue := &proto.Queue{TrackIDs: []int64{1, 2}, CurrentIndex: 1, PositionMs: 500, RepeatMode: constants.RepeatModeOff,
+		ContextType: constants.QueueContextPlaylist, ContextID: 3, Version: 4, DeviceID: "laptop"}
+	newQueue := protobuf.Clone(queue).(*proto.Queue)
+	newQueue.Version = 0
+	query := `WHERE user_id = $1 AND version = $10
+			RETURNING version, updated_at`
+	insertQuery := `ON CONFLICT (user_id) DO NOTHING
+		RETURNING version, updated_at`
+	args := []driver.Value{userID, "{1,2}", queue.CurrentIndex, queue.PositionMs, queue.Shuffle, queue.RepeatMode,
+		queue.ContextType, queue.ContextID, queue.DeviceID}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		input         *proto.Queue
+		expected      *proto.Queue
+		expectedSaved bool
+		expectedError bool
+	}{
+		{
+			name: "queue saved with next version",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(5, updatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(append(args, queue.Version)...).WillReturnRows(rows)
+			},
+			input: queue,
+			expected: &proto.Queue{TrackIDs: []int64{1, 2}, CurrentIndex: 1, PositionMs: 500, RepeatMode: constants.RepeatModeOff,
+				ContextType: constants.QueueContextPlaylist, ContextID: 3, Version: 5, DeviceID: "laptop", UpdatedAt: updatedAt.Unix()},
+			expectedSaved: true,
+		},
+		{
+			name: "queue was changed by another device",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(append(args, queue.Version)...).WillReturnError(sql.ErrNoRows)
+			},
+			input: queue,
+		},
+		{
+			name: "first queue created",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(1, updatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(insertQuery)).WithArgs(args...).WillReturnRows(rows)
+			},
+			input: newQueue,
+			expected: &proto.Queue{TrackIDs: []int64{1, 2}, CurrentIndex: 1, PositionMs: 500, RepeatMode: constants.RepeatModeOff,
+				ContextType: constants.QueueContextPlaylist, ContextID: 3, Version: 1, DeviceID: "laptop", UpdatedAt: updatedAt.Unix()},
+			expectedSaved: true,
+		},
+		{
+			name: "queue was created by another device",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(insertQuery)).WithArgs(args...).WillReturnError(sql.ErrNoRows)
+			},
+			input: newQueue,
+		},
+		{
+			name: "query returns error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(append(args, queue.Version)...).WillReturnError(errors.New("error"))
+			},
+			input:         queue,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			version := currentTest.input.Version
+			currentTest.mock()
+			result, saved, err := repository.SaveQueue(userID, currentTest.input)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedSaved, saved)
+				if currentTest.expectedSaved {
+					assert.True(t, protobuf.Equal(currentTest.expected, result))
+				}
+			}
+			assert.Equal(t, version, currentTest.input.Version)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlayerStorage_PublishQueue(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewPlayerStorage(nil, redisDB)
+
+	queue := &proto.Queue{TrackIDs: []int64{1}, Version: 2}
+	data, _ := protobuf.Marshal(queue)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name: "queue published",
+			mock: func() {
+				mock.ExpectPublish("queue:1", data).SetVal(1)
+			},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectPublish("queue:1", data).SetErr(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.PublishQueue(1, queue)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlayerStorage_Device(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewPlayerStorage(nil, redisDB)
+
+	device := &proto.Device{ID: "phone", UserID: 1, Name: "Phone", Type: constants.DeviceTypeMobile, Session: "cookie"}
+	data, _ := protobuf.Marshal(device)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.Device
+		expectedFound bool
+		expectedError bool
+	}{
+		{
+			name: "device found",
+			mock: func() {
+				mock.ExpectGet("device:phone").SetVal(string(data))
+			},
+			expected:      device,
+			expectedFound: true,
+		},
+		{
+			name: "device expired",
+			mock: func() {
+				mock.ExpectGet("device:phone").RedisNil()
+			},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectGet("device:phone").SetErr(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, isFound, err := repository.Device("phone")
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expectedFound, isFound)
+				if currentTest.expectedFound {
+					assert.True(t, protobuf.Equal(currentTest.expected, result))
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlayerStorage_UserDevices(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewPlayerStorage(nil, redisDB)
+
+	phone := &proto.Device{ID: "phone", UserID: 1, Name: "Phone", Type: constants.DeviceTypeMobile}
+	phoneData, _ := protobuf.Marshal(phone)
+	laptop := &proto.Device{ID: "laptop", UserID: 1, Name: "Laptop", Type: constants.DeviceTypeDesktop}
+	laptopData, _ := protobuf.Marshal(laptop)
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      []*proto.Device
+		expectedError bool
+	}{
+		{
+			name: "expired devices are removed",
+			mock: func() {
+				mock.ExpectSMembers("devices:1").SetVal([]string{"phone", "tv", "laptop"})
+				mock.ExpectMGet("device:phone", "device:tv", "device:laptop", "presence:phone", "presence:tv", "presence:laptop").
+					SetVal([]interface{}{string(phoneData), nil, string(laptopData), "1", nil, nil})
+				mock.ExpectSRem("devices:1", "tv").SetVal(1)
+			},
+			expected: []*proto.Device{
+				{ID: "phone", UserID: 1, Name: "Phone", Type: constants.DeviceTypeMobile, Online: true},
+				{ID: "laptop", UserID: 1, Name: "Laptop", Type: constants.DeviceTypeDesktop},
+			},
+		},
+		{
+			name: "user has no devices",
+			mock: func() {
+				mock.ExpectSMembers("devices:1").SetVal([]string{})
+			},
+			expected: []*proto.Device{},
+		},
+		{
+			name: "redis returns error",
+			mock: func() {
+				mock.ExpectSMembers("devices:1").SetVal([]string{"phone"})
+				mock.ExpectMGet("device:phone", "presence:phone").SetErr(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.UserDevices(1)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, len(currentTest.expected))
+				for i := range currentTest.expected {
+					assert.True(t, protobuf.Equal(currentTest.expected[i], result[i]))
+				}
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlayerStorage_SaveDevice(t *testing.T) {
+	redisDB, mock := redismock.NewClientMock()
+	repository := NewPlayerStorage(nil, redisDB)
+
+	device := &proto.Device{ID: "phone", UserID: 1, Name: "Phone", Type: constants.DeviceTypeMobile, Session: "cookie"}
+	data, _ := protobuf.Marshal(device)
+
+	mock.ExpectTxPipeline()
+	mock.ExpectSet("device:phone", data, time.Hour).SetVal("OK")
+	mock.ExpectSAdd("devices:1", "phone").SetVal(1)
+	mock.ExpectExpire("devices:1", time.Hour).SetVal(true)
+	mock.ExpectTxPipelineExec()
+
+	assert.NoError(t, repository.SaveDevice(device, time.Hour))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
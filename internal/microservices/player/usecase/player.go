@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
+	"2021_2_LostPointer/internal/microservices/player"
+	"2021_2_LostPointer/internal/microservices/player/proto"
+	"2021_2_LostPointer/pkg/validation"
+)
+
+type PlayerService struct {
+	storage   player.Storage
+	publisher events.Publisher
+}
+
+func NewPlayerService(storage player.Storage, publisher events.Publisher) *PlayerService {
+	return &PlayerService{storage: storage, publisher: publisher}
+}
+
+func (service *PlayerService) GetQueue(ctx context.Context, data *proto.GetQueueOptions) (*proto.Queue, error) {
+	queue, err := service.storage.Queue(data.UserID)
+	if err != nil {
+		return &proto.Queue{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return queue, nil
+}
+
+// Очередь заменяется целиком. Version - версия, от которой клиент делал изменения: если очередь уже поменяли
+// с другого устройства, возвращается Aborted и клиент должен перечитать очередь
+func (service *PlayerService) UpdateQueue(ctx context.Context, data *proto.UpdateQueueOptions) (*proto.Queue, error) {
+	queue := data.GetQueue()
+	if queue == nil {
+		queue = &proto.Queue{}
+	}
+	if err := validateQueue(queue); err != nil {
+		return &proto.Queue{}, err
+	}
+	if len(queue.RepeatMode) == 0 {
+		queue.RepeatMode = constants.RepeatModeOff
+	}
+	if len(queue.ContextType) == 0 {
+		queue.ContextID = 0
+	}
+	if queue.TrackIDs == nil {
+		queue.TrackIDs = []int64{}
+	}
+
+	saved, ok, err := service.storage.SaveQueue(data.UserID, queue)
+	if err != nil {
+		return &proto.Queue{}, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return &proto.Queue{}, status.Error(codes.Aborted, constants.QueueVersionConflictMessage)
+	}
+
+	_ = service.storage.PublishQueue(data.UserID, saved)
+	if len(saved.TrackIDs) != 0 {
+		_ = service.publisher.Publish(data.UserID, constants.EventTypeNowPlaying, &events.NowPlaying{
+			TrackID:    saved.TrackIDs[saved.CurrentIndex],
+			DeviceID:   saved.DeviceID,
+			PositionMs: saved.PositionMs,
+		})
+	}
+
+	return saved, nil
+}
+
+// Первым отправляется текущее состояние очереди, затем ее изменения. Подписка оформляется до чтения состояния,
+// поэтому изменение между ними не теряется, а устаревшие версии отбрасываются
+func (service *PlayerService) WatchQueue(data *proto.GetQueueOptions, stream proto.Player_WatchQueueServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	queues, err := service.storage.SubscribeQueue(ctx, data.UserID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	queue, err := service.storage.Queue(data.UserID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err = stream.Send(queue); err != nil {
+		return err
+	}
+
+	version := queue.Version
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case changed, ok := <-queues:
+			if !ok {
+				return nil
+			}
+			if changed.Version <= version {
+				continue
+			}
+			version = changed.Version
+			if err = stream.Send(changed); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func validateQueue(queue *proto.Queue) error {
+	if len(queue.TrackIDs) > constants.QueueMaxAmount {
+		return status.Error(codes.InvalidArgument, constants.QueueTooLongMessage)
+	}
+	if queue.CurrentIndex < 0 || (len(queue.TrackIDs) == 0 && queue.CurrentIndex != 0) ||
+		(len(queue.TrackIDs) != 0 && queue.CurrentIndex >= int64(len(queue.TrackIDs))) {
+		return status.Error(codes.InvalidArgument, constants.QueueIndexInvalidMessage)
+	}
+	if queue.PositionMs < 0 {
+		return status.Error(codes.InvalidArgument, constants.QueuePositionInvalidMessage)
+	}
+	switch queue.RepeatMode {
+	case "", constants.RepeatModeOff, constants.RepeatModeAll, constants.RepeatModeOne:
+	default:
+		return status.Error(codes.InvalidArgument, constants.QueueRepeatModeInvalidMessage)
+	}
+	switch queue.ContextType {
+	case "", constants.QueueContextAlbum, constants.QueueContextArtist, constants.QueueContextPlaylist,
+		constants.QueueContextGenre, constants.QueueContextRadio, constants.QueueContextFavorites:
+	default:
+		return status.Error(codes.InvalidArgument, constants.QueueContextInvalidMessage)
+	}
+
+	return nil
+}
+
+// Устройство привязывается к сессии, в которой зарегистрировано, и живет столько же, сколько сессия
+func (service *PlayerService) RegisterDevice(ctx context.Context, data *proto.RegisterDeviceOptions) (*proto.Device, error) {
+	name := strings.TrimSpace(data.Name)
+	isNameValid, message, err := validation.ValidateDeviceName(name)
+	if err != nil {
+		return &proto.Device{}, status.Error(codes.Internal, err.Error())
+	}
+	if !isNameValid {
+		return &proto.Device{}, status.Error(codes.InvalidArgument, message)
+	}
+	if !isDeviceType(data.Type) {
+		return &proto.Device{}, status.Error(codes.InvalidArgument, constants.DeviceTypeInvalidMessage)
+	}
+
+	device := &proto.Device{
+		ID:           uuid.NewV4().String(),
+		UserID:       data.UserID,
+		Name:         name,
+		Type:         data.Type,
+		Session:      data.Session,
+		RegisteredAt: time.Now().Unix(),
+	}
+	if err = service.storage.SaveDevice(device, constants.CookieLifetime); err != nil {
+		return &proto.Device{}, status.Error(codes.Internal, err.Error())
+	}
+	device.Session = ""
+
+	return device, nil
+}
+
+func (service *PlayerService) GetDevices(ctx context.Context, data *proto.GetDevicesOptions) (*proto.Devices, error) {
+	devices, err := service.storage.UserDevices(data.UserID)
+	if err != nil {
+		return &proto.Devices{}, status.Error(codes.Internal, err.Error())
+	}
+	for _, device := range devices {
+		device.Session = ""
+	}
+	sort.SliceStable(devices, func(i, j int) bool {
+		return devices[i].RegisteredAt < devices[j].RegisteredAt
+	})
+
+	return &proto.Devices{Devices: devices}, nil
+}
+
+// Команду можно отправить только на свое устройство, которое сейчас онлайн. При передаче воспроизведения
+// остальные устройства пользователя ставятся на паузу
+func (service *PlayerService) SendCommand(ctx context.Context, data *proto.SendCommandOptions) (*proto.SendCommandResponse, error) {
+	command := data.GetCommand()
+	if command == nil || !isDeviceCommand(command.Type) {
+		return &proto.SendCommandResponse{}, status.Error(codes.InvalidArgument, constants.DeviceCommandInvalidMessage)
+	}
+	if command.PositionMs < 0 {
+		return &proto.SendCommandResponse{}, status.Error(codes.InvalidArgument, constants.QueuePositionInvalidMessage)
+	}
+
+	if len(command.SourceDeviceID) != 0 {
+		if err := service.checkDeviceOwner(command.SourceDeviceID, data.UserID); err != nil {
+			return &proto.SendCommandResponse{}, err
+		}
+	}
+	if err := service.checkDeviceOwner(command.TargetDeviceID, data.UserID); err != nil {
+		return &proto.SendCommandResponse{}, err
+	}
+
+	isOnline, err := service.storage.IsDeviceOnline(command.TargetDeviceID)
+	if err != nil {
+		return &proto.SendCommandResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	if !isOnline {
+		return &proto.SendCommandResponse{}, status.Error(codes.NotFound, constants.DeviceOfflineMessage)
+	}
+
+	command.IssuedAt = time.Now().Unix()
+	if err = service.storage.PublishCommand(command.TargetDeviceID, command); err != nil {
+		return &proto.SendCommandResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	if command.Type == constants.DeviceCommandTransfer {
+		devices, err := service.storage.UserDevices(data.UserID)
+		if err != nil {
+			return &proto.SendCommandResponse{}, status.Error(codes.Internal, err.Error())
+		}
+		for _, device := range devices {
+			if !device.Online || device.ID == command.TargetDeviceID {
+				continue
+			}
+			err = service.storage.PublishCommand(device.ID, &proto.DeviceCommand{
+				Type:           constants.DeviceCommandPause,
+				SourceDeviceID: command.SourceDeviceID,
+				TargetDeviceID: device.ID,
+				IssuedAt:       command.IssuedAt,
+			})
+			if err != nil {
+				return &proto.SendCommandResponse{}, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	return &proto.SendCommandResponse{}, nil
+}
+
+// Канал команд устройства. Первым отправляется команда connected, после нее устройство считается онлайн,
+// пока канал открыт
+func (service *PlayerService) WatchCommands(data *proto.WatchCommandsOptions, stream proto.Player_WatchCommandsServer) error {
+	device, isFound, err := service.storage.Device(data.DeviceID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !isFound {
+		return status.Error(codes.NotFound, constants.DeviceNotFoundMessage)
+	}
+	if device.UserID != data.UserID {
+		return status.Error(codes.PermissionDenied, constants.DeviceNotOwnedMessage)
+	}
+	if device.Session != data.Session {
+		return status.Error(codes.PermissionDenied, constants.DeviceSessionMismatchMessage)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	commands, err := service.storage.SubscribeCommands(ctx, device.ID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err = service.storage.SetDevicePresence(device.ID, constants.DevicePresenceLifetime); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer func() {
+		_ = service.storage.DeleteDevicePresence(device.ID)
+	}()
+
+	err = stream.Send(&proto.DeviceCommand{
+		Type:           constants.DeviceCommandConnected,
+		TargetDeviceID: device.ID,
+		IssuedAt:       time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	presence := time.NewTicker(constants.DevicePresenceRefreshInterval)
+	defer presence.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-presence.C:
+			if err = service.storage.SetDevicePresence(device.ID, constants.DevicePresenceLifetime); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		case command, ok := <-commands:
+			if !ok {
+				return nil
+			}
+			if err = stream.Send(command); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (service *PlayerService) checkDeviceOwner(deviceID string, userID int64) error {
+	device, isFound, err := service.storage.Device(deviceID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !isFound {
+		return status.Error(codes.NotFound, constants.DeviceNotFoundMessage)
+	}
+	if device.UserID != userID {
+		return status.Error(codes.PermissionDenied, constants.DeviceNotOwnedMessage)
+	}
+
+	return nil
+}
+
+func isDeviceType(deviceType string) bool {
+	switch deviceType {
+	case constants.DeviceTypeWeb, constants.DeviceTypeDesktop, constants.DeviceTypeMobile, constants.DeviceTypeSpeaker:
+		return true
+	}
+
+	return false
+}
+
+func isDeviceCommand(commandType string) bool {
+	switch commandType {
+	case constants.DeviceCommandPlay, constants.DeviceCommandPause, constants.DeviceCommandNext,
+		constants.DeviceCommandPrevious, constants.DeviceCommandSeek, constants.DeviceCommandTransfer:
+		return true
+	}
+
+	return false
+}
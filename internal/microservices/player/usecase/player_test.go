@@ -0,0 +1,548 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
+	"2021_2_LostPointer/internal/microservices/player/mock"
+	"2021_2_LostPointer/internal/microservices/player/proto"
+)
+
+func TestPlayerService_GetQueue(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		expected    *proto.Queue
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				QueueFunc: func(int64) (*proto.Queue, error) {
+					return &proto.Queue{TrackIDs: []int64{1, 2}, RepeatMode: constants.RepeatModeOff, Version: 3}, nil
+				},
+			},
+			expected: &proto.Queue{TrackIDs: []int64{1, 2}, RepeatMode: constants.RepeatModeOff, Version: 3},
+		},
+		{
+			name: "Error 500. Storage error",
+			storageMock: &mock.MockStorage{
+				QueueFunc: func(int64) (*proto.Queue, error) {
+					return nil, errors.New("error")
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewPlayerService(currentTest.storageMock, events.NewMemoryBroker())
+
+			res, err := service.GetQueue(context.Background(), &proto.GetQueueOptions{UserID: 1})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+		})
+	}
+}
+
+func TestPlayerService_UpdateQueue(t *testing.T) {
+	saveQueue := func(userID int64, queue *proto.Queue) (*proto.Queue, bool, error) {
+		return &proto.Queue{TrackIDs: queue.TrackIDs, CurrentIndex: queue.CurrentIndex, RepeatMode: queue.RepeatMode,
+			ContextType: queue.ContextType, ContextID: queue.ContextID, Version: queue.Version + 1}, true, nil
+	}
+	publishQueue := func(int64, *proto.Queue) error {
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		storageMock   *mock.MockStorage
+		input         *proto.Queue
+		expected      *proto.Queue
+		expectedErr   bool
+		err           error
+		expectPublish bool
+	}{
+		{
+			name: "Success. Defaults are set",
+			storageMock: &mock.MockStorage{
+				SaveQueueFunc:    saveQueue,
+				PublishQueueFunc: publishQueue,
+			},
+			input:         &proto.Queue{ContextID: 5, Version: 2},
+			expected:      &proto.Queue{TrackIDs: []int64{}, RepeatMode: constants.RepeatModeOff, Version: 3},
+			expectPublish: true,
+		},
+		{
+			name: "Success. Publish error is ignored",
+			storageMock: &mock.MockStorage{
+				SaveQueueFunc: saveQueue,
+				PublishQueueFunc: func(int64, *proto.Queue) error {
+					return errors.New("error")
+				},
+			},
+			input: &proto.Queue{TrackIDs: []int64{4, 5}, CurrentIndex: 1, RepeatMode: constants.RepeatModeOne,
+				ContextType: constants.QueueContextAlbum, ContextID: 2},
+			expected: &proto.Queue{TrackIDs: []int64{4, 5}, CurrentIndex: 1, RepeatMode: constants.RepeatModeOne,
+				ContextType: constants.QueueContextAlbum, ContextID: 2, Version: 1},
+			expectPublish: true,
+		},
+		{
+			name:        "Error 400. Queue is too long",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.Queue{TrackIDs: make([]int64, constants.QueueMaxAmount+1)},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.QueueTooLongMessage),
+		},
+		{
+			name:        "Error 400. Index is out of queue",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.Queue{TrackIDs: []int64{1, 2}, CurrentIndex: 2},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.QueueIndexInvalidMessage),
+		},
+		{
+			name:        "Error 400. Position is negative",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.Queue{TrackIDs: []int64{1}, PositionMs: -1},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.QueuePositionInvalidMessage),
+		},
+		{
+			name:        "Error 400. Repeat mode is not valid",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.Queue{RepeatMode: "twice"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.QueueRepeatModeInvalidMessage),
+		},
+		{
+			name:        "Error 400. Context is not valid",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.Queue{ContextType: "podcast"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.QueueContextInvalidMessage),
+		},
+		{
+			name: "Error 409. Queue was changed by another device",
+			storageMock: &mock.MockStorage{
+				SaveQueueFunc: func(int64, *proto.Queue) (*proto.Queue, bool, error) {
+					return nil, false, nil
+				},
+			},
+			input:       &proto.Queue{Version: 1},
+			expectedErr: true,
+			err:         status.Error(codes.Aborted, constants.QueueVersionConflictMessage),
+		},
+		{
+			name: "Error 500. Storage error",
+			storageMock: &mock.MockStorage{
+				SaveQueueFunc: func(int64, *proto.Queue) (*proto.Queue, bool, error) {
+					return nil, false, errors.New("error")
+				},
+			},
+			input:       &proto.Queue{},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewPlayerService(currentTest.storageMock, events.NewMemoryBroker())
+
+			res, err := service.UpdateQueue(context.Background(), &proto.UpdateQueueOptions{UserID: 1, Queue: currentTest.input})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+			}
+			if currentTest.expectPublish {
+				assert.Len(t, currentTest.storageMock.PublishQueueCalls(), 1)
+			}
+		})
+	}
+}
+
+func TestPlayerService_UpdateQueueNowPlaying(t *testing.T) {
+	storageMock := &mock.MockStorage{
+		SaveQueueFunc: func(userID int64, queue *proto.Queue) (*proto.Queue, bool, error) {
+			return &proto.Queue{TrackIDs: queue.TrackIDs, CurrentIndex: queue.CurrentIndex, PositionMs: queue.PositionMs,
+				DeviceID: queue.DeviceID, Version: queue.Version + 1}, true, nil
+		},
+		PublishQueueFunc: func(int64, *proto.Queue) error {
+			return nil
+		},
+	}
+	broker := events.NewMemoryBroker()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	userEvents, err := broker.Subscribe(ctx, 1, 0)
+	assert.NoError(t, err)
+
+	service := NewPlayerService(storageMock, broker)
+	_, err = service.UpdateQueue(context.Background(), &proto.UpdateQueueOptions{
+		UserID: 1,
+		Queue:  &proto.Queue{TrackIDs: []int64{4, 5}, CurrentIndex: 1, PositionMs: 300, DeviceID: "phone"},
+	})
+	assert.NoError(t, err)
+
+	select {
+	case event := <-userEvents:
+		assert.Equal(t, constants.EventTypeNowPlaying, event.Type)
+		assert.Equal(t, "{\"track_id\":5,\"device_id\":\"phone\",\"position_ms\":300}", string(event.Data))
+	case <-time.After(time.Second):
+		t.Fatal("event was not published")
+	}
+}
+
+func TestPlayerService_WatchQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queues := make(chan *proto.Queue, 3)
+	queues <- &proto.Queue{Version: 2}
+	queues <- &proto.Queue{Version: 4}
+	queues <- &proto.Queue{Version: 3}
+	close(queues)
+
+	storageMock := &mock.MockStorage{
+		SubscribeQueueFunc: func(context.Context, int64) (<-chan *proto.Queue, error) {
+			return queues, nil
+		},
+		QueueFunc: func(int64) (*proto.Queue, error) {
+			return &proto.Queue{Version: 2}, nil
+		},
+	}
+
+	stream := mock.NewMockPlayer_WatchQueueServer(ctrl)
+	stream.EXPECT().Context().Return(context.Background())
+	gomock.InOrder(
+		stream.EXPECT().Send(&proto.Queue{Version: 2}).Return(nil),
+		stream.EXPECT().Send(&proto.Queue{Version: 4}).Return(nil),
+	)
+
+	service := NewPlayerService(storageMock, events.NewMemoryBroker())
+	err := service.WatchQueue(&proto.GetQueueOptions{UserID: 1}, stream)
+	assert.NoError(t, err)
+}
+
+func TestPlayerService_RegisterDevice(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		input       *proto.RegisterDeviceOptions
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				SaveDeviceFunc: func(device *proto.Device, lifetime time.Duration) error {
+					if device.Name != "Phone" || device.Session != "cookie" || device.UserID != 1 ||
+						lifetime != constants.CookieLifetime {
+						return errors.New("unexpected device")
+					}
+					return nil
+				},
+			},
+			input: &proto.RegisterDeviceOptions{UserID: 1, Session: "cookie", Name: " Phone ", Type: constants.DeviceTypeMobile},
+		},
+		{
+			name:        "Error 400. Name is not valid",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.RegisterDeviceOptions{UserID: 1, Session: "cookie", Type: constants.DeviceTypeMobile},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.DeviceNameInvalidLengthMessage),
+		},
+		{
+			name:        "Error 400. Type is not valid",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.RegisterDeviceOptions{UserID: 1, Session: "cookie", Name: "Phone", Type: "fridge"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.DeviceTypeInvalidMessage),
+		},
+		{
+			name: "Error 500. Storage error",
+			storageMock: &mock.MockStorage{
+				SaveDeviceFunc: func(*proto.Device, time.Duration) error {
+					return errors.New("error")
+				},
+			},
+			input:       &proto.RegisterDeviceOptions{UserID: 1, Session: "cookie", Name: "Phone", Type: constants.DeviceTypeMobile},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewPlayerService(currentTest.storageMock, events.NewMemoryBroker())
+
+			res, err := service.RegisterDevice(context.Background(), currentTest.input)
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, res.ID)
+				assert.Equal(t, "Phone", res.Name)
+				assert.Empty(t, res.Session)
+			}
+		})
+	}
+}
+
+func TestPlayerService_GetDevices(t *testing.T) {
+	storageMock := &mock.MockStorage{
+		UserDevicesFunc: func(int64) ([]*proto.Device, error) {
+			return []*proto.Device{
+				{ID: "laptop", Session: "cookie", RegisteredAt: 20},
+				{ID: "phone", Session: "cookie", RegisteredAt: 10, Online: true},
+			}, nil
+		},
+	}
+	service := NewPlayerService(storageMock, events.NewMemoryBroker())
+
+	res, err := service.GetDevices(context.Background(), &proto.GetDevicesOptions{UserID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, &proto.Devices{Devices: []*proto.Device{
+		{ID: "phone", RegisteredAt: 10, Online: true},
+		{ID: "laptop", RegisteredAt: 20},
+	}}, res)
+}
+
+func TestPlayerService_SendCommand(t *testing.T) {
+	devices := map[string]*proto.Device{
+		"phone":  {ID: "phone", UserID: 1},
+		"laptop": {ID: "laptop", UserID: 1},
+		"tv":     {ID: "tv", UserID: 1},
+		"other":  {ID: "other", UserID: 2},
+	}
+	device := func(deviceID string) (*proto.Device, bool, error) {
+		found, ok := devices[deviceID]
+		return found, ok, nil
+	}
+	online := func(string) (bool, error) {
+		return true, nil
+	}
+	publish := func(string, *proto.DeviceCommand) error {
+		return nil
+	}
+
+	tests := []struct {
+		name              string
+		storageMock       *mock.MockStorage
+		input             *proto.DeviceCommand
+		expectedPublished []string
+		expectedErr       bool
+		err               error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				DeviceFunc:         device,
+				IsDeviceOnlineFunc: online,
+				PublishCommandFunc: publish,
+			},
+			input:             &proto.DeviceCommand{Type: constants.DeviceCommandSeek, SourceDeviceID: "phone", TargetDeviceID: "laptop", PositionMs: 1000},
+			expectedPublished: []string{"laptop"},
+		},
+		{
+			name: "Success. Other devices are paused on transfer",
+			storageMock: &mock.MockStorage{
+				DeviceFunc:         device,
+				IsDeviceOnlineFunc: online,
+				PublishCommandFunc: publish,
+				UserDevicesFunc: func(int64) ([]*proto.Device, error) {
+					return []*proto.Device{
+						{ID: "phone", Online: true},
+						{ID: "laptop", Online: true},
+						{ID: "tv"},
+					}, nil
+				},
+			},
+			input:             &proto.DeviceCommand{Type: constants.DeviceCommandTransfer, SourceDeviceID: "phone", TargetDeviceID: "laptop"},
+			expectedPublished: []string{"laptop", "phone"},
+		},
+		{
+			name:        "Error 400. Command is not valid",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.DeviceCommand{Type: "rewind", TargetDeviceID: "laptop"},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.DeviceCommandInvalidMessage),
+		},
+		{
+			name:        "Error 400. Position is negative",
+			storageMock: &mock.MockStorage{},
+			input:       &proto.DeviceCommand{Type: constants.DeviceCommandSeek, TargetDeviceID: "laptop", PositionMs: -1},
+			expectedErr: true,
+			err:         status.Error(codes.InvalidArgument, constants.QueuePositionInvalidMessage),
+		},
+		{
+			name: "Error 403. Target device belongs to another user",
+			storageMock: &mock.MockStorage{
+				DeviceFunc: device,
+			},
+			input:       &proto.DeviceCommand{Type: constants.DeviceCommandPause, SourceDeviceID: "phone", TargetDeviceID: "other"},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.DeviceNotOwnedMessage),
+		},
+		{
+			name: "Error 403. Source device belongs to another user",
+			storageMock: &mock.MockStorage{
+				DeviceFunc: device,
+			},
+			input:       &proto.DeviceCommand{Type: constants.DeviceCommandPause, SourceDeviceID: "other", TargetDeviceID: "phone"},
+			expectedErr: true,
+			err:         status.Error(codes.PermissionDenied, constants.DeviceNotOwnedMessage),
+		},
+		{
+			name: "Error 404. Target device not found",
+			storageMock: &mock.MockStorage{
+				DeviceFunc: device,
+			},
+			input:       &proto.DeviceCommand{Type: constants.DeviceCommandPlay, TargetDeviceID: "speaker"},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.DeviceNotFoundMessage),
+		},
+		{
+			name: "Error 404. Target device is offline",
+			storageMock: &mock.MockStorage{
+				DeviceFunc: device,
+				IsDeviceOnlineFunc: func(string) (bool, error) {
+					return false, nil
+				},
+			},
+			input:       &proto.DeviceCommand{Type: constants.DeviceCommandPlay, TargetDeviceID: "tv"},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.DeviceOfflineMessage),
+		},
+		{
+			name: "Error 500. Storage error",
+			storageMock: &mock.MockStorage{
+				DeviceFunc:         device,
+				IsDeviceOnlineFunc: online,
+				PublishCommandFunc: func(string, *proto.DeviceCommand) error {
+					return errors.New("error")
+				},
+			},
+			input:       &proto.DeviceCommand{Type: constants.DeviceCommandNext, TargetDeviceID: "tv"},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewPlayerService(currentTest.storageMock, events.NewMemoryBroker())
+
+			_, err := service.SendCommand(context.Background(), &proto.SendCommandOptions{UserID: 1, Command: currentTest.input})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				published := make([]string, 0)
+				for _, call := range currentTest.storageMock.PublishCommandCalls() {
+					published = append(published, call.DeviceID)
+				}
+				assert.Equal(t, currentTest.expectedPublished, published)
+			}
+		})
+	}
+}
+
+func TestPlayerService_WatchCommands(t *testing.T) {
+	device := func(string) (*proto.Device, bool, error) {
+		return &proto.Device{ID: "phone", UserID: 1, Session: "cookie"}, true, nil
+	}
+
+	tests := []struct {
+		name  string
+		input *proto.WatchCommandsOptions
+		err   error
+	}{
+		{
+			name:  "Error 403. Device belongs to another user",
+			input: &proto.WatchCommandsOptions{UserID: 2, Session: "cookie", DeviceID: "phone"},
+			err:   status.Error(codes.PermissionDenied, constants.DeviceNotOwnedMessage),
+		},
+		{
+			name:  "Error 403. Device was registered in another session",
+			input: &proto.WatchCommandsOptions{UserID: 1, Session: "stolen", DeviceID: "phone"},
+			err:   status.Error(codes.PermissionDenied, constants.DeviceSessionMismatchMessage),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			service := NewPlayerService(&mock.MockStorage{DeviceFunc: device}, events.NewMemoryBroker())
+
+			err := service.WatchCommands(currentTest.input, nil)
+			assert.Equal(t, currentTest.err, err)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		commands := make(chan *proto.DeviceCommand, 1)
+		commands <- &proto.DeviceCommand{Type: constants.DeviceCommandPause, SourceDeviceID: "laptop", TargetDeviceID: "phone"}
+		close(commands)
+
+		storageMock := &mock.MockStorage{
+			DeviceFunc: device,
+			SubscribeCommandsFunc: func(context.Context, string) (<-chan *proto.DeviceCommand, error) {
+				return commands, nil
+			},
+			SetDevicePresenceFunc: func(string, time.Duration) error {
+				return nil
+			},
+			DeleteDevicePresenceFunc: func(string) error {
+				return nil
+			},
+		}
+
+		stream := mock.NewMockPlayer_WatchCommandsServer(ctrl)
+		stream.EXPECT().Context().Return(context.Background())
+		gomock.InOrder(
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(command *proto.DeviceCommand) error {
+				assert.Equal(t, constants.DeviceCommandConnected, command.Type)
+				return nil
+			}),
+			stream.EXPECT().Send(&proto.DeviceCommand{Type: constants.DeviceCommandPause, SourceDeviceID: "laptop", TargetDeviceID: "phone"}).
+				Return(nil),
+		)
+
+		service := NewPlayerService(storageMock, events.NewMemoryBroker())
+		err := service.WatchCommands(&proto.WatchCommandsOptions{UserID: 1, Session: "cookie", DeviceID: "phone"}, stream)
+		assert.NoError(t, err)
+		assert.Len(t, storageMock.SetDevicePresenceCalls(), 1)
+		assert.Len(t, storageMock.DeleteDevicePresenceCalls(), 1)
+	})
+}
@@ -76,6 +76,26 @@ func (mr *MockPlaylistsClientMockRecorder) CreatePlaylist(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockPlaylistsClient)(nil).CreatePlaylist), varargs...)
 }
 
+// CreateRecapPlaylist mocks base method.
+func (m *MockPlaylistsClient) CreateRecapPlaylist(ctx context.Context, in *proto.RecapPlaylistOptions, opts ...grpc.CallOption) (*proto.CreatePlaylistResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRecapPlaylist", varargs...)
+	ret0, _ := ret[0].(*proto.CreatePlaylistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecapPlaylist indicates an expected call of CreateRecapPlaylist.
+func (mr *MockPlaylistsClientMockRecorder) CreateRecapPlaylist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecapPlaylist", reflect.TypeOf((*MockPlaylistsClient)(nil).CreateRecapPlaylist), varargs...)
+}
+
 // DeletePlaylist mocks base method.
 func (m *MockPlaylistsClient) DeletePlaylist(ctx context.Context, in *proto.DeletePlaylistOptions, opts ...grpc.CallOption) (*proto.DeletePlaylistResponse, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +229,21 @@ func (mr *MockPlaylistsServerMockRecorder) CreatePlaylist(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockPlaylistsServer)(nil).CreatePlaylist), arg0, arg1)
 }
 
+// CreateRecapPlaylist mocks base method.
+func (m *MockPlaylistsServer) CreateRecapPlaylist(arg0 context.Context, arg1 *proto.RecapPlaylistOptions) (*proto.CreatePlaylistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecapPlaylist", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreatePlaylistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecapPlaylist indicates an expected call of CreateRecapPlaylist.
+func (mr *MockPlaylistsServerMockRecorder) CreateRecapPlaylist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecapPlaylist", reflect.TypeOf((*MockPlaylistsServer)(nil).CreateRecapPlaylist), arg0, arg1)
+}
+
 // DeletePlaylist mocks base method.
 func (m *MockPlaylistsServer) DeletePlaylist(arg0 context.Context, arg1 *proto.DeletePlaylistOptions) (*proto.DeletePlaylistResponse, error) {
 	m.ctrl.T.Helper()
@@ -25,6 +25,9 @@ var _ playlists.Storage = &MockStorage{}
 // 			CreatePlaylistFunc: func(n int64, s1 string, s2 string, s3 string, b bool) (*proto.CreatePlaylistResponse, error) {
 // 				panic("mock out the CreatePlaylist method")
 // 			},
+// 			CreateRecapPlaylistFunc: func(n1 int64, n2 int64, s1 string, s2 string, s3 string) (*proto.CreatePlaylistResponse, error) {
+// 				panic("mock out the CreateRecapPlaylist method")
+// 			},
 // 			DeletePlaylistFunc: func(n int64) error {
 // 				panic("mock out the DeletePlaylist method")
 // 			},
@@ -68,6 +71,9 @@ type MockStorage struct {
 	// CreatePlaylistFunc mocks the CreatePlaylist method.
 	CreatePlaylistFunc func(n int64, s1 string, s2 string, s3 string, b bool) (*proto.CreatePlaylistResponse, error)
 
+	// CreateRecapPlaylistFunc mocks the CreateRecapPlaylist method.
+	CreateRecapPlaylistFunc func(n1 int64, n2 int64, s1 string, s2 string, s3 string) (*proto.CreatePlaylistResponse, error)
+
 	// DeletePlaylistFunc mocks the DeletePlaylist method.
 	DeletePlaylistFunc func(n int64) error
 
@@ -120,6 +126,19 @@ type MockStorage struct {
 			// B is the b argument value.
 			B bool
 		}
+		// CreateRecapPlaylist holds details about calls to the CreateRecapPlaylist method.
+		CreateRecapPlaylist []struct {
+			// N1 is the n1 argument value.
+			N1 int64
+			// N2 is the n2 argument value.
+			N2 int64
+			// S1 is the s1 argument value.
+			S1 string
+			// S2 is the s2 argument value.
+			S2 string
+			// S3 is the s3 argument value.
+			S3 string
+		}
 		// DeletePlaylist holds details about calls to the DeletePlaylist method.
 		DeletePlaylist []struct {
 			// N is the n argument value.
@@ -187,6 +206,7 @@ type MockStorage struct {
 	}
 	lockAddTrack               sync.RWMutex
 	lockCreatePlaylist         sync.RWMutex
+	lockCreateRecapPlaylist    sync.RWMutex
 	lockDeletePlaylist         sync.RWMutex
 	lockDeletePlaylistArtwork  sync.RWMutex
 	lockDeleteTrack            sync.RWMutex
@@ -281,6 +301,53 @@ func (mock *MockStorage) CreatePlaylistCalls() []struct {
 	return calls
 }
 
+// CreateRecapPlaylist calls CreateRecapPlaylistFunc.
+func (mock *MockStorage) CreateRecapPlaylist(n1 int64, n2 int64, s1 string, s2 string, s3 string) (*proto.CreatePlaylistResponse, error) {
+	if mock.CreateRecapPlaylistFunc == nil {
+		panic("MockStorage.CreateRecapPlaylistFunc: method is nil but Storage.CreateRecapPlaylist was just called")
+	}
+	callInfo := struct {
+		N1 int64
+		N2 int64
+		S1 string
+		S2 string
+		S3 string
+	}{
+		N1: n1,
+		N2: n2,
+		S1: s1,
+		S2: s2,
+		S3: s3,
+	}
+	mock.lockCreateRecapPlaylist.Lock()
+	mock.calls.CreateRecapPlaylist = append(mock.calls.CreateRecapPlaylist, callInfo)
+	mock.lockCreateRecapPlaylist.Unlock()
+	return mock.CreateRecapPlaylistFunc(n1, n2, s1, s2, s3)
+}
+
+// CreateRecapPlaylistCalls gets all the calls that were made to CreateRecapPlaylist.
+// Check the length with:
+//     len(mockedStorage.CreateRecapPlaylistCalls())
+func (mock *MockStorage) CreateRecapPlaylistCalls() []struct {
+	N1 int64
+	N2 int64
+	S1 string
+	S2 string
+	S3 string
+} {
+	var calls []struct {
+		N1 int64
+		N2 int64
+		S1 string
+		S2 string
+		S3 string
+	}
+	mock.lockCreateRecapPlaylist.RLock()
+	calls = mock.calls.CreateRecapPlaylist
+	mock.lockCreateRecapPlaylist.RUnlock()
+	return calls
+}
+
 // DeletePlaylist calls DeletePlaylistFunc.
 func (mock *MockStorage) DeletePlaylist(n int64) error {
 	if mock.DeletePlaylistFunc == nil {
@@ -426,6 +426,61 @@ func (x *DeletePlaylistArtworkOptions) GetUserID() int64 {
 	return 0
 }
 
+type RecapPlaylistOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Year   int64 `protobuf:"varint,2,opt,name=Year,proto3" json:"Year,omitempty"`
+}
+
+func (x *RecapPlaylistOptions) Reset() {
+	*x = RecapPlaylistOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_playlists_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecapPlaylistOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecapPlaylistOptions) ProtoMessage() {}
+
+func (x *RecapPlaylistOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_playlists_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecapPlaylistOptions.ProtoReflect.Descriptor instead.
+func (*RecapPlaylistOptions) Descriptor() ([]byte, []int) {
+	return file_playlists_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecapPlaylistOptions) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RecapPlaylistOptions) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
 type CreatePlaylistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -437,7 +492,7 @@ type CreatePlaylistResponse struct {
 func (x *CreatePlaylistResponse) Reset() {
 	*x = CreatePlaylistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlists_proto_msgTypes[6]
+		mi := &file_playlists_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +505,7 @@ func (x *CreatePlaylistResponse) String() string {
 func (*CreatePlaylistResponse) ProtoMessage() {}
 
 func (x *CreatePlaylistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlists_proto_msgTypes[6]
+	mi := &file_playlists_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +518,7 @@ func (x *CreatePlaylistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePlaylistResponse.ProtoReflect.Descriptor instead.
 func (*CreatePlaylistResponse) Descriptor() ([]byte, []int) {
-	return file_playlists_proto_rawDescGZIP(), []int{6}
+	return file_playlists_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreatePlaylistResponse) GetPlaylistID() int64 {
@@ -485,7 +540,7 @@ type UpdatePlaylistResponse struct {
 func (x *UpdatePlaylistResponse) Reset() {
 	*x = UpdatePlaylistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlists_proto_msgTypes[7]
+		mi := &file_playlists_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -498,7 +553,7 @@ func (x *UpdatePlaylistResponse) String() string {
 func (*UpdatePlaylistResponse) ProtoMessage() {}
 
 func (x *UpdatePlaylistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlists_proto_msgTypes[7]
+	mi := &file_playlists_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +566,7 @@ func (x *UpdatePlaylistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePlaylistResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePlaylistResponse) Descriptor() ([]byte, []int) {
-	return file_playlists_proto_rawDescGZIP(), []int{7}
+	return file_playlists_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdatePlaylistResponse) GetOldArtworkFilename() string {
@@ -539,7 +594,7 @@ type DeletePlaylistResponse struct {
 func (x *DeletePlaylistResponse) Reset() {
 	*x = DeletePlaylistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlists_proto_msgTypes[8]
+		mi := &file_playlists_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -552,7 +607,7 @@ func (x *DeletePlaylistResponse) String() string {
 func (*DeletePlaylistResponse) ProtoMessage() {}
 
 func (x *DeletePlaylistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlists_proto_msgTypes[8]
+	mi := &file_playlists_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +620,7 @@ func (x *DeletePlaylistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePlaylistResponse.ProtoReflect.Descriptor instead.
 func (*DeletePlaylistResponse) Descriptor() ([]byte, []int) {
-	return file_playlists_proto_rawDescGZIP(), []int{8}
+	return file_playlists_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeletePlaylistResponse) GetOldArtworkFilename() string {
@@ -584,7 +639,7 @@ type AddTrackResponse struct {
 func (x *AddTrackResponse) Reset() {
 	*x = AddTrackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlists_proto_msgTypes[9]
+		mi := &file_playlists_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +652,7 @@ func (x *AddTrackResponse) String() string {
 func (*AddTrackResponse) ProtoMessage() {}
 
 func (x *AddTrackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlists_proto_msgTypes[9]
+	mi := &file_playlists_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +665,7 @@ func (x *AddTrackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTrackResponse.ProtoReflect.Descriptor instead.
 func (*AddTrackResponse) Descriptor() ([]byte, []int) {
-	return file_playlists_proto_rawDescGZIP(), []int{9}
+	return file_playlists_proto_rawDescGZIP(), []int{10}
 }
 
 type DeleteTrackResponse struct {
@@ -622,7 +677,7 @@ type DeleteTrackResponse struct {
 func (x *DeleteTrackResponse) Reset() {
 	*x = DeleteTrackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlists_proto_msgTypes[10]
+		mi := &file_playlists_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -635,7 +690,7 @@ func (x *DeleteTrackResponse) String() string {
 func (*DeleteTrackResponse) ProtoMessage() {}
 
 func (x *DeleteTrackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlists_proto_msgTypes[10]
+	mi := &file_playlists_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +703,7 @@ func (x *DeleteTrackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTrackResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTrackResponse) Descriptor() ([]byte, []int) {
-	return file_playlists_proto_rawDescGZIP(), []int{10}
+	return file_playlists_proto_rawDescGZIP(), []int{11}
 }
 
 type DeletePlaylistArtworkResponse struct {
@@ -662,7 +717,7 @@ type DeletePlaylistArtworkResponse struct {
 func (x *DeletePlaylistArtworkResponse) Reset() {
 	*x = DeletePlaylistArtworkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_playlists_proto_msgTypes[11]
+		mi := &file_playlists_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -675,7 +730,7 @@ func (x *DeletePlaylistArtworkResponse) String() string {
 func (*DeletePlaylistArtworkResponse) ProtoMessage() {}
 
 func (x *DeletePlaylistArtworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_playlists_proto_msgTypes[11]
+	mi := &file_playlists_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +743,7 @@ func (x *DeletePlaylistArtworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePlaylistArtworkResponse.ProtoReflect.Descriptor instead.
 func (*DeletePlaylistArtworkResponse) Descriptor() ([]byte, []int) {
-	return file_playlists_proto_rawDescGZIP(), []int{11}
+	return file_playlists_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeletePlaylistArtworkResponse) GetOldArtworkFilename() string {
@@ -747,59 +802,68 @@ var file_playlists_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
 	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x16, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x6c, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2e, 0x0a, 0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x6c, 0x64,
-	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61,
-	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x42, 0x0a, 0x14, 0x52,
+	0x65, 0x63, 0x61, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x59,
+	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72, 0x22,
+	0x38, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x6c, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f,
+	0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x1d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
 	0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x12, 0x0a,
-	0x10, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4f, 0x6c, 0x64,
-	0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xa3, 0x03, 0x0a, 0x09, 0x50, 0x6c,
-	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xec, 0x03,
+	0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x17, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x17, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x41, 0x64,
+	0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x1d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x1e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x61, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x63,
+	0x61, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x17, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
-	0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x17, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x11, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x14, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1d,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41,
-	0x72, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1e, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x1f, 0x5a, 0x1d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -814,7 +878,7 @@ func file_playlists_proto_rawDescGZIP() []byte {
 	return file_playlists_proto_rawDescData
 }
 
-var file_playlists_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_playlists_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_playlists_proto_goTypes = []interface{}{
 	(*CreatePlaylistOptions)(nil),         // 0: CreatePlaylistOptions
 	(*UpdatePlaylistOptions)(nil),         // 1: UpdatePlaylistOptions
@@ -822,12 +886,13 @@ var file_playlists_proto_goTypes = []interface{}{
 	(*AddTrackOptions)(nil),               // 3: AddTrackOptions
 	(*DeleteTrackOptions)(nil),            // 4: DeleteTrackOptions
 	(*DeletePlaylistArtworkOptions)(nil),  // 5: DeletePlaylistArtworkOptions
-	(*CreatePlaylistResponse)(nil),        // 6: CreatePlaylistResponse
-	(*UpdatePlaylistResponse)(nil),        // 7: UpdatePlaylistResponse
-	(*DeletePlaylistResponse)(nil),        // 8: DeletePlaylistResponse
-	(*AddTrackResponse)(nil),              // 9: AddTrackResponse
-	(*DeleteTrackResponse)(nil),           // 10: DeleteTrackResponse
-	(*DeletePlaylistArtworkResponse)(nil), // 11: DeletePlaylistArtworkResponse
+	(*RecapPlaylistOptions)(nil),          // 6: RecapPlaylistOptions
+	(*CreatePlaylistResponse)(nil),        // 7: CreatePlaylistResponse
+	(*UpdatePlaylistResponse)(nil),        // 8: UpdatePlaylistResponse
+	(*DeletePlaylistResponse)(nil),        // 9: DeletePlaylistResponse
+	(*AddTrackResponse)(nil),              // 10: AddTrackResponse
+	(*DeleteTrackResponse)(nil),           // 11: DeleteTrackResponse
+	(*DeletePlaylistArtworkResponse)(nil), // 12: DeletePlaylistArtworkResponse
 }
 var file_playlists_proto_depIdxs = []int32{
 	0,  // 0: Playlists.CreatePlaylist:input_type -> CreatePlaylistOptions
@@ -836,14 +901,16 @@ var file_playlists_proto_depIdxs = []int32{
 	3,  // 3: Playlists.AddTrack:input_type -> AddTrackOptions
 	4,  // 4: Playlists.DeleteTrack:input_type -> DeleteTrackOptions
 	5,  // 5: Playlists.DeletePlaylistArtwork:input_type -> DeletePlaylistArtworkOptions
-	6,  // 6: Playlists.CreatePlaylist:output_type -> CreatePlaylistResponse
-	7,  // 7: Playlists.UpdatePlaylist:output_type -> UpdatePlaylistResponse
-	8,  // 8: Playlists.DeletePlaylist:output_type -> DeletePlaylistResponse
-	9,  // 9: Playlists.AddTrack:output_type -> AddTrackResponse
-	10, // 10: Playlists.DeleteTrack:output_type -> DeleteTrackResponse
-	11, // 11: Playlists.DeletePlaylistArtwork:output_type -> DeletePlaylistArtworkResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	6,  // 6: Playlists.CreateRecapPlaylist:input_type -> RecapPlaylistOptions
+	7,  // 7: Playlists.CreatePlaylist:output_type -> CreatePlaylistResponse
+	8,  // 8: Playlists.UpdatePlaylist:output_type -> UpdatePlaylistResponse
+	9,  // 9: Playlists.DeletePlaylist:output_type -> DeletePlaylistResponse
+	10, // 10: Playlists.AddTrack:output_type -> AddTrackResponse
+	11, // 11: Playlists.DeleteTrack:output_type -> DeleteTrackResponse
+	12, // 12: Playlists.DeletePlaylistArtwork:output_type -> DeletePlaylistArtworkResponse
+	7,  // 13: Playlists.CreateRecapPlaylist:output_type -> CreatePlaylistResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -928,7 +995,7 @@ func file_playlists_proto_init() {
 			}
 		}
 		file_playlists_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePlaylistResponse); i {
+			switch v := v.(*RecapPlaylistOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -940,7 +1007,7 @@ func file_playlists_proto_init() {
 			}
 		}
 		file_playlists_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePlaylistResponse); i {
+			switch v := v.(*CreatePlaylistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -952,7 +1019,7 @@ func file_playlists_proto_init() {
 			}
 		}
 		file_playlists_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePlaylistResponse); i {
+			switch v := v.(*UpdatePlaylistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -964,7 +1031,7 @@ func file_playlists_proto_init() {
 			}
 		}
 		file_playlists_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTrackResponse); i {
+			switch v := v.(*DeletePlaylistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -976,7 +1043,7 @@ func file_playlists_proto_init() {
 			}
 		}
 		file_playlists_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTrackResponse); i {
+			switch v := v.(*AddTrackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -988,6 +1055,18 @@ func file_playlists_proto_init() {
 			}
 		}
 		file_playlists_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_playlists_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePlaylistArtworkResponse); i {
 			case 0:
 				return &v.state
@@ -1006,7 +1085,7 @@ func file_playlists_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_playlists_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1038,6 +1117,7 @@ type PlaylistsClient interface {
 	AddTrack(ctx context.Context, in *AddTrackOptions, opts ...grpc.CallOption) (*AddTrackResponse, error)
 	DeleteTrack(ctx context.Context, in *DeleteTrackOptions, opts ...grpc.CallOption) (*DeleteTrackResponse, error)
 	DeletePlaylistArtwork(ctx context.Context, in *DeletePlaylistArtworkOptions, opts ...grpc.CallOption) (*DeletePlaylistArtworkResponse, error)
+	CreateRecapPlaylist(ctx context.Context, in *RecapPlaylistOptions, opts ...grpc.CallOption) (*CreatePlaylistResponse, error)
 }
 
 type playlistsClient struct {
@@ -1102,6 +1182,15 @@ func (c *playlistsClient) DeletePlaylistArtwork(ctx context.Context, in *DeleteP
 	return out, nil
 }
 
+func (c *playlistsClient) CreateRecapPlaylist(ctx context.Context, in *RecapPlaylistOptions, opts ...grpc.CallOption) (*CreatePlaylistResponse, error) {
+	out := new(CreatePlaylistResponse)
+	err := c.cc.Invoke(ctx, "/Playlists/CreateRecapPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlaylistsServer is the server API for Playlists service.
 type PlaylistsServer interface {
 	CreatePlaylist(context.Context, *CreatePlaylistOptions) (*CreatePlaylistResponse, error)
@@ -1110,6 +1199,7 @@ type PlaylistsServer interface {
 	AddTrack(context.Context, *AddTrackOptions) (*AddTrackResponse, error)
 	DeleteTrack(context.Context, *DeleteTrackOptions) (*DeleteTrackResponse, error)
 	DeletePlaylistArtwork(context.Context, *DeletePlaylistArtworkOptions) (*DeletePlaylistArtworkResponse, error)
+	CreateRecapPlaylist(context.Context, *RecapPlaylistOptions) (*CreatePlaylistResponse, error)
 }
 
 // UnimplementedPlaylistsServer can be embedded to have forward compatible implementations.
@@ -1134,6 +1224,9 @@ func (*UnimplementedPlaylistsServer) DeleteTrack(context.Context, *DeleteTrackOp
 func (*UnimplementedPlaylistsServer) DeletePlaylistArtwork(context.Context, *DeletePlaylistArtworkOptions) (*DeletePlaylistArtworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylistArtwork not implemented")
 }
+func (*UnimplementedPlaylistsServer) CreateRecapPlaylist(context.Context, *RecapPlaylistOptions) (*CreatePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecapPlaylist not implemented")
+}
 
 func RegisterPlaylistsServer(s *grpc.Server, srv PlaylistsServer) {
 	s.RegisterService(&_Playlists_serviceDesc, srv)
@@ -1247,6 +1340,24 @@ func _Playlists_DeletePlaylistArtwork_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Playlists_CreateRecapPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecapPlaylistOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistsServer).CreateRecapPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Playlists/CreateRecapPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistsServer).CreateRecapPlaylist(ctx, req.(*RecapPlaylistOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Playlists_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Playlists",
 	HandlerType: (*PlaylistsServer)(nil),
@@ -1275,6 +1386,10 @@ var _Playlists_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePlaylistArtwork",
 			Handler:    _Playlists_DeletePlaylistArtwork_Handler,
 		},
+		{
+			MethodName: "CreateRecapPlaylist",
+			Handler:    _Playlists_CreateRecapPlaylist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "playlists.proto",
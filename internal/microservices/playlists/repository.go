@@ -16,4 +16,5 @@ type Storage interface {
 	UpdatePlaylistArtwork(int64, string, string) error
 	DeletePlaylistArtwork(int64) error
 	UpdatePlaylistAccess(int64, bool) error
+	CreateRecapPlaylist(int64, int64, string, string, string) (*proto.CreatePlaylistResponse, error)
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"2021_2_LostPointer/internal/constants"
 	"database/sql"
+	"errors"
 	"log"
 
 	"github.com/kennygrant/sanitize"
@@ -31,6 +32,70 @@ func (storage *PlaylistsStorage) CreatePlaylist(userID int64, title string, artw
 	return &proto.CreatePlaylistResponse{PlaylistID: id}, nil
 }
 
+// Создаёт приватный плейлист из топа треков пользователя за год. Повторный вызов возвращает уже созданный плейлист,
+// пока пользователь его не удалил. Если итогов года нет, возвращает sql.ErrNoRows
+func (storage *PlaylistsStorage) CreateRecapPlaylist(userID int64, year int64, title string, artwork string, artworkColor string) (*proto.CreatePlaylistResponse, error) {
+	var id int64
+	err := storage.db.QueryRow(`SELECT playlist_id FROM recap_playlists WHERE user_id = $1 AND year = $2`, userID, year).Scan(&id)
+	if err == nil {
+		return &proto.CreatePlaylistResponse{PlaylistID: id}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	tx, err := storage.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = tx.QueryRow(`INSERT INTO playlists(title, user_id, artwork, artwork_color, is_public) VALUES ($1, $2, $3, $4, false) RETURNING id`,
+		title, userID, artwork, artworkColor).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO playlist_tracks(playlist, track)
+		SELECT $1, entity_id FROM listening_recap_tops
+		WHERE user_id = $2 AND year = $3 AND entity = $4
+		ORDER BY position`
+
+	result, err := tx.Exec(query, id, userID, year, constants.ChartEntityTracks)
+	if err != nil {
+		return nil, err
+	}
+	added, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if added == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	// Параллельный запрос мог успеть создать плейлист: тогда свой откатывается и возвращается его
+	var playlistID int64
+	err = tx.QueryRow(`
+		INSERT INTO recap_playlists(user_id, year, playlist_id) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, year) DO UPDATE SET playlist_id = recap_playlists.playlist_id
+		RETURNING playlist_id`, userID, year, id).Scan(&playlistID)
+	if err != nil {
+		return nil, err
+	}
+	if playlistID != id {
+		return &proto.CreatePlaylistResponse{PlaylistID: playlistID}, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &proto.CreatePlaylistResponse{PlaylistID: id}, nil
+}
+
 func (storage *PlaylistsStorage) GetOldPlaylistSettings(playlistID int64) (string, error) {
 	query := `SELECT artwork FROM playlists WHERE id=$1`
 
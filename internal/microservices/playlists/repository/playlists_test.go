@@ -6,6 +6,7 @@ import (
 
 	"2021_2_LostPointer/internal/constants"
 	"2021_2_LostPointer/internal/microservices/playlists/proto"
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"log"
@@ -76,6 +77,114 @@ func TestPlaylistsStorage_CreatePlaylist(t *testing.T) {
 	}
 }
 
+func TestPlaylistsStorage_CreateRecapPlaylist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewPlaylistsStorage(db)
+
+	const (
+		title        = "My top tracks 2021"
+		userID       = 1
+		year         = 2021
+		artWork      = "testArtWork"
+		artWorkColor = "testArtWorkColor"
+	)
+	insertPlaylistQuery := `INSERT INTO playlists(title, user_id, artwork, artwork_color, is_public) VALUES ($1, $2, $3, $4, false) RETURNING id`
+	insertTracksQuery := `INSERT INTO playlist_tracks(playlist, track)`
+	existingQuery := `SELECT playlist_id FROM recap_playlists WHERE user_id = $1 AND year = $2`
+	linkQuery := `INSERT INTO recap_playlists(user_id, year, playlist_id) VALUES ($1, $2, $3)`
+
+	tests := []struct {
+		name          string
+		mock          func()
+		expected      *proto.CreatePlaylistResponse
+		expectedError bool
+	}{
+		{
+			name: "create recap playlist success",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existingQuery)).WithArgs(userID, year).WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insertPlaylistQuery)).
+					WithArgs(title, userID, artWork, artWorkColor).
+					WillReturnRows(mock.NewRows([]string{"id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(insertTracksQuery)).
+					WithArgs(5, userID, year, constants.ChartEntityTracks).WillReturnResult(sqlmock.NewResult(0, 100))
+				mock.ExpectQuery(regexp.QuoteMeta(linkQuery)).
+					WithArgs(userID, year, 5).WillReturnRows(mock.NewRows([]string{"playlist_id"}).AddRow(5))
+				mock.ExpectCommit()
+			},
+			expected: &proto.CreatePlaylistResponse{PlaylistID: 5},
+		},
+		{
+			name: "recap playlist already exists",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existingQuery)).
+					WithArgs(userID, year).WillReturnRows(mock.NewRows([]string{"playlist_id"}).AddRow(3))
+			},
+			expected: &proto.CreatePlaylistResponse{PlaylistID: 3},
+		},
+		{
+			name: "recap playlist created concurrently",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existingQuery)).WithArgs(userID, year).WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insertPlaylistQuery)).
+					WithArgs(title, userID, artWork, artWorkColor).
+					WillReturnRows(mock.NewRows([]string{"id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(insertTracksQuery)).
+					WithArgs(5, userID, year, constants.ChartEntityTracks).WillReturnResult(sqlmock.NewResult(0, 100))
+				mock.ExpectQuery(regexp.QuoteMeta(linkQuery)).
+					WithArgs(userID, year, 5).WillReturnRows(mock.NewRows([]string{"playlist_id"}).AddRow(3))
+				mock.ExpectRollback()
+			},
+			expected: &proto.CreatePlaylistResponse{PlaylistID: 3},
+		},
+		{
+			name: "recap has no tracks",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existingQuery)).WithArgs(userID, year).WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insertPlaylistQuery)).
+					WithArgs(title, userID, artWork, artWorkColor).
+					WillReturnRows(mock.NewRows([]string{"id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta(insertTracksQuery)).
+					WithArgs(5, userID, year, constants.ChartEntityTracks).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+		{
+			name: "create playlist fail",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(existingQuery)).WithArgs(userID, year).WillReturnError(sql.ErrNoRows)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(insertPlaylistQuery)).WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			result, err := repository.CreateRecapPlaylist(userID, year, title, artWork, artWorkColor)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, result)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestPlaylistsStorage_GetOldPlaylistSettings(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
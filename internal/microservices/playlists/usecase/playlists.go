@@ -2,22 +2,27 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
 	"2021_2_LostPointer/internal/microservices/playlists"
 	"2021_2_LostPointer/internal/microservices/playlists/proto"
 	"2021_2_LostPointer/pkg/validation"
 )
 
 type PlaylistsService struct {
-	storage playlists.Storage
+	storage   playlists.Storage
+	publisher events.Publisher
 }
 
-func NewPlaylistsService(storage playlists.Storage) *PlaylistsService {
-	return &PlaylistsService{storage: storage}
+func NewPlaylistsService(storage playlists.Storage, publisher events.Publisher) *PlaylistsService {
+	return &PlaylistsService{storage: storage, publisher: publisher}
 }
 
 func (service *PlaylistsService) CreatePlaylist(ctx context.Context, data *proto.CreatePlaylistOptions) (*proto.CreatePlaylistResponse, error) {
@@ -41,6 +46,19 @@ func (service *PlaylistsService) CreatePlaylist(ctx context.Context, data *proto
 	return response, nil
 }
 
+func (service *PlaylistsService) CreateRecapPlaylist(ctx context.Context, data *proto.RecapPlaylistOptions) (*proto.CreatePlaylistResponse, error) {
+	response, err := service.storage.CreateRecapPlaylist(data.UserID, data.Year, fmt.Sprintf(constants.RecapPlaylistTitle, data.Year),
+		constants.PlaylistArtworkDefaultFilename, constants.PlaylistArtworkDefaultColor)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &proto.CreatePlaylistResponse{}, status.Error(codes.NotFound, constants.RecapNotFoundMessage)
+	}
+	if err != nil {
+		return &proto.CreatePlaylistResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
 //nolint:cyclop
 func (service *PlaylistsService) UpdatePlaylist(ctx context.Context, data *proto.UpdatePlaylistOptions) (*proto.UpdatePlaylistResponse, error) {
 	doesExist, err := service.storage.DoesPlaylistExist(data.PlaylistID)
@@ -93,6 +111,7 @@ func (service *PlaylistsService) UpdatePlaylist(ctx context.Context, data *proto
 			return &proto.UpdatePlaylistResponse{}, status.Error(codes.Internal, err.Error())
 		}
 	}
+	service.notifyPlaylistChanged(data.UserID, data.PlaylistID, constants.PlaylistActionUpdated)
 
 	return response, nil
 }
@@ -123,6 +142,7 @@ func (service *PlaylistsService) DeletePlaylist(ctx context.Context, data *proto
 	if err != nil {
 		return &proto.DeletePlaylistResponse{}, status.Error(codes.Internal, err.Error())
 	}
+	service.notifyPlaylistChanged(data.UserID, data.PlaylistID, constants.PlaylistActionDeleted)
 
 	return &proto.DeletePlaylistResponse{OldArtworkFilename: oldArtwork}, nil
 }
@@ -156,6 +176,7 @@ func (service *PlaylistsService) AddTrack(ctx context.Context, data *proto.AddTr
 	if err != nil {
 		return &proto.AddTrackResponse{}, status.Error(codes.Internal, err.Error())
 	}
+	service.notifyPlaylistChanged(data.UserID, data.PlaylistID, constants.PlaylistActionTrackAdded)
 
 	return &proto.AddTrackResponse{}, nil
 }
@@ -181,6 +202,7 @@ func (service *PlaylistsService) DeleteTrack(ctx context.Context, data *proto.De
 	if err != nil {
 		return &proto.DeleteTrackResponse{}, status.Error(codes.Internal, err.Error())
 	}
+	service.notifyPlaylistChanged(data.UserID, data.PlaylistID, constants.PlaylistActionTrackRemoved)
 
 	return &proto.DeleteTrackResponse{}, nil
 }
@@ -211,6 +233,14 @@ func (service *PlaylistsService) DeletePlaylistArtwork(ctx context.Context, data
 	if err != nil {
 		return &proto.DeletePlaylistArtworkResponse{}, status.Error(codes.Internal, err.Error())
 	}
+	service.notifyPlaylistChanged(data.UserID, data.PlaylistID, constants.PlaylistActionUpdated)
 
 	return &proto.DeletePlaylistArtworkResponse{OldArtworkFilename: oldArtwork}, nil
 }
+
+func (service *PlaylistsService) notifyPlaylistChanged(userID int64, playlistID int64, action string) {
+	_ = service.publisher.Publish(userID, constants.EventTypePlaylistChanged, &events.PlaylistChanged{
+		PlaylistID: playlistID,
+		Action:     action,
+	})
+}
@@ -2,14 +2,17 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"2021_2_LostPointer/internal/constants"
+	"2021_2_LostPointer/internal/events"
 	"2021_2_LostPointer/internal/microservices/playlists/mock"
 	"2021_2_LostPointer/internal/microservices/playlists/proto"
 )
@@ -76,7 +79,7 @@ func TestPlaylistsService_CreatePlaylist(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			storage := NewPlaylistsService(currentTest.storageMock)
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
 
 			res, err := storage.CreatePlaylist(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
@@ -90,6 +93,64 @@ func TestPlaylistsService_CreatePlaylist(t *testing.T) {
 	}
 }
 
+func TestPlaylistsService_CreateRecapPlaylist(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageMock *mock.MockStorage
+		expected    *proto.CreatePlaylistResponse
+		expectedErr bool
+		err         error
+	}{
+		{
+			name: "Success",
+			storageMock: &mock.MockStorage{
+				CreateRecapPlaylistFunc: func(int64, int64, string, string, string) (*proto.CreatePlaylistResponse, error) {
+					return &proto.CreatePlaylistResponse{PlaylistID: 5}, nil
+				},
+			},
+			expected: &proto.CreatePlaylistResponse{PlaylistID: 5},
+		},
+		{
+			name: "Error 404. Recap was not built",
+			storageMock: &mock.MockStorage{
+				CreateRecapPlaylistFunc: func(int64, int64, string, string, string) (*proto.CreatePlaylistResponse, error) {
+					return nil, sql.ErrNoRows
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.NotFound, constants.RecapNotFoundMessage),
+		},
+		{
+			name: "Error 500. CreateRecapPlaylist returns error",
+			storageMock: &mock.MockStorage{
+				CreateRecapPlaylistFunc: func(int64, int64, string, string, string) (*proto.CreatePlaylistResponse, error) {
+					return nil, errors.New("error")
+				},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
+
+			res, err := storage.CreateRecapPlaylist(context.Background(), &proto.RecapPlaylistOptions{UserID: 1, Year: 2021})
+			if currentTest.expectedErr {
+				assert.Error(t, err)
+				assert.Equal(t, err, currentTest.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, res)
+				call := currentTest.storageMock.CreateRecapPlaylistCalls()[0]
+				assert.Equal(t, "My top tracks 2021", call.S1)
+			}
+		})
+	}
+}
+
 func TestPlaylistsService_DeletePlaylist(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -231,7 +292,7 @@ func TestPlaylistsService_DeletePlaylist(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			storage := NewPlaylistsService(currentTest.storageMock)
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
 			res, err := storage.DeletePlaylist(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
 				assert.Error(t, err)
@@ -505,7 +566,7 @@ func TestPlaylistsService_UpdatePlaylist(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			storage := NewPlaylistsService(currentTest.storageMock)
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
 
 			res, err := storage.UpdatePlaylist(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
@@ -656,7 +717,7 @@ func TestPlaylistsService_AddTrack(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			storage := NewPlaylistsService(currentTest.storageMock)
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
 
 			res, err := storage.AddTrack(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
@@ -767,7 +828,7 @@ func TestPlaylistsService_DeleteTrack(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			storage := NewPlaylistsService(currentTest.storageMock)
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
 
 			res, err := storage.DeleteTrack(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
@@ -901,7 +962,7 @@ func TestPlaylistsService_DeletePlaylistArtwork(t *testing.T) {
 	for _, test := range tests {
 		currentTest := test
 		t.Run(currentTest.name, func(t *testing.T) {
-			storage := NewPlaylistsService(currentTest.storageMock)
+			storage := NewPlaylistsService(currentTest.storageMock, events.NewMemoryBroker())
 
 			res, err := storage.DeletePlaylistArtwork(context.Background(), currentTest.input)
 			if currentTest.expectedErr {
@@ -914,3 +975,37 @@ func TestPlaylistsService_DeletePlaylistArtwork(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaylistsService_PlaylistChangedEvent(t *testing.T) {
+	storageMock := &mock.MockStorage{
+		DoesPlaylistExistFunc: func(int64) (bool, error) {
+			return true, nil
+		},
+		IsOwnerFunc: func(int64, int64) (bool, error) {
+			return true, nil
+		},
+		IsAddedFunc: func(int64, int64) (bool, error) {
+			return false, nil
+		},
+		AddTrackFunc: func(int64, int64) error {
+			return nil
+		},
+	}
+	broker := events.NewMemoryBroker()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	userEvents, err := broker.Subscribe(ctx, 1, 0)
+	assert.NoError(t, err)
+
+	service := NewPlaylistsService(storageMock, broker)
+	_, err = service.AddTrack(context.Background(), &proto.AddTrackOptions{UserID: 1, PlaylistID: 2, TrackID: 3})
+	assert.NoError(t, err)
+
+	select {
+	case event := <-userEvents:
+		assert.Equal(t, constants.EventTypePlaylistChanged, event.Type)
+		assert.Equal(t, "{\"playlist_id\":2,\"action\":\"track_added\"}", string(event.Data))
+	case <-time.After(time.Second):
+		t.Fatal("event was not published")
+	}
+}
@@ -43,6 +43,9 @@ var _ profile.UserSettingsStorage = &MockUserSettingsStorage{}
 // 			UpdatePasswordFunc: func(n int64, s string) error {
 // 				panic("mock out the UpdatePassword method")
 // 			},
+// 			UpdateSaveSearchHistoryFunc: func(n int64, b bool) error {
+// 				panic("mock out the UpdateSaveSearchHistory method")
+// 			},
 // 		}
 //
 // 		// use mockedUserSettingsStorage in code that requires profile.UserSettingsStorage
@@ -74,6 +77,9 @@ type MockUserSettingsStorage struct {
 	// UpdatePasswordFunc mocks the UpdatePassword method.
 	UpdatePasswordFunc func(n int64, s string) error
 
+	// UpdateSaveSearchHistoryFunc mocks the UpdateSaveSearchHistory method.
+	UpdateSaveSearchHistoryFunc func(n int64, b bool) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CheckPasswordByUserID holds details about calls to the CheckPasswordByUserID method.
@@ -126,15 +132,23 @@ type MockUserSettingsStorage struct {
 			// S is the s argument value.
 			S string
 		}
+		// UpdateSaveSearchHistory holds details about calls to the UpdateSaveSearchHistory method.
+		UpdateSaveSearchHistory []struct {
+			// N is the n argument value.
+			N int64
+			// B is the b argument value.
+			B bool
+		}
 	}
-	lockCheckPasswordByUserID sync.RWMutex
-	lockGetSettings           sync.RWMutex
-	lockIsEmailUnique         sync.RWMutex
-	lockIsNicknameUnique      sync.RWMutex
-	lockUpdateAvatar          sync.RWMutex
-	lockUpdateEmail           sync.RWMutex
-	lockUpdateNickname        sync.RWMutex
-	lockUpdatePassword        sync.RWMutex
+	lockCheckPasswordByUserID   sync.RWMutex
+	lockGetSettings             sync.RWMutex
+	lockIsEmailUnique           sync.RWMutex
+	lockIsNicknameUnique        sync.RWMutex
+	lockUpdateAvatar            sync.RWMutex
+	lockUpdateEmail             sync.RWMutex
+	lockUpdateNickname          sync.RWMutex
+	lockUpdatePassword          sync.RWMutex
+	lockUpdateSaveSearchHistory sync.RWMutex
 }
 
 // CheckPasswordByUserID calls CheckPasswordByUserIDFunc.
@@ -404,3 +418,38 @@ func (mock *MockUserSettingsStorage) UpdatePasswordCalls() []struct {
 	mock.lockUpdatePassword.RUnlock()
 	return calls
 }
+
+// UpdateSaveSearchHistory calls UpdateSaveSearchHistoryFunc.
+func (mock *MockUserSettingsStorage) UpdateSaveSearchHistory(n int64, b bool) error {
+	if mock.UpdateSaveSearchHistoryFunc == nil {
+		panic("MockUserSettingsStorage.UpdateSaveSearchHistoryFunc: method is nil but UserSettingsStorage.UpdateSaveSearchHistory was just called")
+	}
+	callInfo := struct {
+		N int64
+		B bool
+	}{
+		N: n,
+		B: b,
+	}
+	mock.lockUpdateSaveSearchHistory.Lock()
+	mock.calls.UpdateSaveSearchHistory = append(mock.calls.UpdateSaveSearchHistory, callInfo)
+	mock.lockUpdateSaveSearchHistory.Unlock()
+	return mock.UpdateSaveSearchHistoryFunc(n, b)
+}
+
+// UpdateSaveSearchHistoryCalls gets all the calls that were made to UpdateSaveSearchHistory.
+// Check the length with:
+//     len(mockedUserSettingsStorage.UpdateSaveSearchHistoryCalls())
+func (mock *MockUserSettingsStorage) UpdateSaveSearchHistoryCalls() []struct {
+	N int64
+	B bool
+} {
+	var calls []struct {
+		N int64
+		B bool
+	}
+	mock.lockUpdateSaveSearchHistory.RLock()
+	calls = mock.calls.UpdateSaveSearchHistory
+	mock.lockUpdateSaveSearchHistory.RUnlock()
+	return calls
+}
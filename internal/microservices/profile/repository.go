@@ -11,6 +11,7 @@ type UserSettingsStorage interface {
 	UpdateNickname(int64, string) error
 	UpdatePassword(int64, string) error
 	UpdateAvatar(int64, string) error
+	UpdateSaveSearchHistory(int64, bool) error
 	IsEmailUnique(string) (bool, error)
 	IsNicknameUnique(string) (bool, error)
 	CheckPasswordByUserID(int64, string) (bool, error)
@@ -24,7 +24,7 @@ func NewUserSettingsStorage(db *sql.DB) *UserSettingsStorage {
 }
 
 func (storage *UserSettingsStorage) GetSettings(userID int64) (*proto.UserSettings, error) {
-	query := `SELECT email, avatar, nickname FROM users WHERE id=$1`
+	query := `SELECT email, avatar, nickname, save_search_history FROM users WHERE id=$1`
 
 	rows, err := storage.db.Query(query, userID)
 	if err != nil {
@@ -48,7 +48,7 @@ func (storage *UserSettingsStorage) GetSettings(userID int64) (*proto.UserSettin
 
 	var avatar string
 	settings := &proto.UserSettings{}
-	if err = rows.Scan(&settings.Email, &avatar, &settings.Nickname); err != nil {
+	if err = rows.Scan(&settings.Email, &avatar, &settings.Nickname, &settings.SaveSearchHistory); err != nil {
 		return nil, err
 	}
 	settings.BigAvatar = os.Getenv("USERS_ROOT_PREFIX") + avatar + constants.UserAvatarExtension500px
@@ -89,6 +89,16 @@ func (storage *UserSettingsStorage) UpdateAvatar(userID int64, fileName string)
 	return nil
 }
 
+func (storage *UserSettingsStorage) UpdateSaveSearchHistory(userID int64, saveSearchHistory bool) error {
+	query := `UPDATE users SET save_search_history=$1 WHERE id=$2`
+
+	err := storage.db.QueryRow(query, saveSearchHistory, userID).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (storage *UserSettingsStorage) UpdatePassword(userID int64, password string) error {
 	query := `UPDATE users SET password=$1, salt=$2 WHERE id=$3`
 
@@ -33,10 +33,11 @@ func TestUserSettingsStorage_GetSettings(t *testing.T) {
 		Nickname: "testNickname",
 	}
 	expectedSettings := &proto.UserSettings{
-		Email:       "testEmail",
-		Nickname:    "testNickname",
-		SmallAvatar: os.Getenv("USERS_ROOT_PREFIX") + avatar + constants.UserAvatarExtension150px,
-		BigAvatar:   os.Getenv("USERS_ROOT_PREFIX") + avatar + constants.UserAvatarExtension500px,
+		Email:             "testEmail",
+		Nickname:          "testNickname",
+		SmallAvatar:       os.Getenv("USERS_ROOT_PREFIX") + avatar + constants.UserAvatarExtension150px,
+		BigAvatar:         os.Getenv("USERS_ROOT_PREFIX") + avatar + constants.UserAvatarExtension500px,
+		SaveSearchHistory: true,
 	}
 
 	tests := []struct {
@@ -48,26 +49,26 @@ func TestUserSettingsStorage_GetSettings(t *testing.T) {
 		{
 			name: "get settings success",
 			mock: func() {
-				row := mock.NewRows([]string{"email", "avatar", "name"})
-				row.AddRow(settings.Email, avatar, settings.Nickname)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				row := mock.NewRows([]string{"email", "avatar", "name", "save_search_history"})
+				row.AddRow(settings.Email, avatar, settings.Nickname, true)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname, save_search_history FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
 			},
 			expected: expectedSettings,
 		},
 		{
 			name: "can not get settings",
 			mock: func() {
-				row := mock.NewRows([]string{"email", "avatar", "name"})
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				row := mock.NewRows([]string{"email", "avatar", "name", "save_search_history"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname, save_search_history FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
 			},
 			expectedError: true,
 		},
 		{
 			name: "query returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"email", "avatar", "name"})
-				row.AddRow(settings.Email, avatar, settings.Nickname)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
+				row := mock.NewRows([]string{"email", "avatar", "name", "save_search_history"})
+				row.AddRow(settings.Email, avatar, settings.Nickname, true)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname, save_search_history FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnError(errors.New("error"))
 			},
 			expectedError: true,
 		},
@@ -75,18 +76,18 @@ func TestUserSettingsStorage_GetSettings(t *testing.T) {
 			name: "scan returns error",
 			mock: func() {
 				const newArg = 1
-				row := mock.NewRows([]string{"email", "avatar", "name", "newArg"})
-				row.AddRow(settings.Email, avatar, settings.Nickname, newArg)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				row := mock.NewRows([]string{"email", "avatar", "name", "save_search_history", "newArg"})
+				row.AddRow(settings.Email, avatar, settings.Nickname, true, newArg)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname, save_search_history FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
 			},
 			expectedError: true,
 		},
 		{
 			name: "row.Err() returns error",
 			mock: func() {
-				row := mock.NewRows([]string{"email", "avatar", "name"}).RowError(0, errors.New("error"))
-				row.AddRow(settings.Email, avatar, settings.Nickname)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
+				row := mock.NewRows([]string{"email", "avatar", "name", "save_search_history"}).RowError(0, errors.New("error"))
+				row.AddRow(settings.Email, avatar, settings.Nickname, true)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT email, avatar, nickname, save_search_history FROM users WHERE id=$1`)).WithArgs(driver.Value(userID)).WillReturnRows(row)
 			},
 			expectedError: true,
 		},
@@ -267,6 +268,54 @@ func TestUserSettingsStorage_UpdateAvatar(t *testing.T) {
 	}
 }
 
+func TestUserSettingsStorage_UpdateSaveSearchHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		return
+	}
+	repository := NewUserSettingsStorage(db)
+
+	const userID = 1
+
+	tests := []struct {
+		name          string
+		value         bool
+		mock          func()
+		expectedError bool
+	}{
+		{
+			name:  "update save search history success",
+			value: true,
+			mock: func() {
+				row := mock.NewRows([]string{"success"}).AddRow(1)
+				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE users SET save_search_history=$1 WHERE id=$2`)).WithArgs(driver.Value(true), driver.Value(userID)).WillReturnRows(row)
+			},
+		},
+		{
+			name:  "query returns error",
+			value: true,
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE users SET save_search_history=$1 WHERE id=$2`)).WithArgs(driver.Value(true), driver.Value(userID)).WillReturnError(errors.New("error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			currentTest.mock()
+			err := repository.UpdateSaveSearchHistory(userID, currentTest.value)
+			if currentTest.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestUserSettingsStorage_UpdatePassword(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -123,5 +123,12 @@ func (service *ProfileService) UpdateSettings(ctx context.Context, settings *pro
 		}
 	}
 
+	if settings.SaveSearchHistory != nil && settings.GetSaveSearchHistory() != settings.OldSettings.SaveSearchHistory {
+		err := service.storage.UpdateSaveSearchHistory(settings.UserID, settings.GetSaveSearchHistory())
+		if err != nil {
+			return &proto.EmptyProfile{}, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return &proto.EmptyProfile{}, nil
 }
@@ -76,6 +76,8 @@ func TestProfileService_GetSettings(t *testing.T) {
 }
 
 func TestProfileService_UpdateSettings(t *testing.T) {
+	falseValue := false
+
 	tests := []struct {
 		name        string
 		storageMock *mock.MockUserSettingsStorage
@@ -397,6 +399,45 @@ func TestProfileService_UpdateSettings(t *testing.T) {
 			expectedErr: true,
 			err:         status.Error(codes.Internal, "error"),
 		},
+
+		//---------SEARCH HISTORY---------
+		{
+			name: "Successfully disabled search history",
+			storageMock: &mock.MockUserSettingsStorage{
+				UpdateSaveSearchHistoryFunc: func(userID int64, saveSearchHistory bool) error {
+					assert.False(t, saveSearchHistory)
+					return nil
+				},
+			},
+			input: &proto.UpdateSettingsOptions{
+				SaveSearchHistory: &falseValue,
+				OldSettings:       &proto.UserSettings{SaveSearchHistory: true},
+			},
+			expected: &proto.EmptyProfile{},
+		},
+		{
+			name:        "Search history setting is not changed",
+			storageMock: &mock.MockUserSettingsStorage{},
+			input: &proto.UpdateSettingsOptions{
+				SaveSearchHistory: &falseValue,
+				OldSettings:       &proto.UserSettings{},
+			},
+			expected: &proto.EmptyProfile{},
+		},
+		{
+			name: "Error 500. mock.UpdateSaveSearchHistory returned error",
+			storageMock: &mock.MockUserSettingsStorage{
+				UpdateSaveSearchHistoryFunc: func(int64, bool) error {
+					return errors.New("error")
+				},
+			},
+			input: &proto.UpdateSettingsOptions{
+				SaveSearchHistory: &falseValue,
+				OldSettings:       &proto.UserSettings{SaveSearchHistory: true},
+			},
+			expectedErr: true,
+			err:         status.Error(codes.Internal, "error"),
+		},
 	}
 
 	for _, test := range tests {
@@ -17,6 +17,8 @@ type (
 		TracksCount    int64  `json:"tracks_count,omitempty"`
 		TracksDuration int64  `json:"tracks_duration,omitempty"`
 		ArtworkColor   string `json:"artwork_color,omitempty"`
+		IsSaved        bool   `json:"is_saved,omitempty"`
+		ReleaseDate    string `json:"release_date,omitempty"`
 	}
 
 	AlbumPage struct {
@@ -29,6 +31,13 @@ type (
 		ArtworkColor   string       `json:"artwork_color,omitempty"`
 		Artist         Artist       `json:"artist"`
 		Tracks         []TrackAlbum `json:"tracks,omitempty"`
+		IsSaved        bool         `json:"is_saved,omitempty"`
+		ReleaseDate    string       `json:"release_date,omitempty"`
+	}
+
+	ReleaseRadar struct {
+		Albums     Albums `json:"albums"`
+		PlaylistID int64  `json:"playlist_id,omitempty"`
 	}
 )
 
@@ -52,7 +61,9 @@ func (a *AlbumPage) BindProto(album *proto.AlbumPageResponse) {
 			ID:   album.Artist.ID,
 			Name: album.Artist.Name,
 		},
-		Tracks: tracks,
+		Tracks:      tracks,
+		IsSaved:     album.IsSaved,
+		ReleaseDate: album.ReleaseDate,
 	}
 
 	*a = *bindedAlbum
@@ -68,7 +79,23 @@ func (a *Album) BindProto(album *proto.Album) {
 		TracksCount:    album.TracksAmount,
 		TracksDuration: album.TracksDuration,
 		ArtworkColor:   album.ArtworkColor,
+		IsSaved:        album.IsSaved,
+		ReleaseDate:    album.ReleaseDate,
 	}
 
 	*a = *bindedAlbum
 }
+
+func (r *ReleaseRadar) BindProto(releaseRadar *proto.ReleaseRadarResponse) {
+	albums := make(Albums, 0, len(releaseRadar.Albums))
+	for _, current := range releaseRadar.Albums {
+		var album Album
+		album.BindProto(current)
+		albums = append(albums, album)
+	}
+
+	*r = ReleaseRadar{
+		Albums:     albums,
+		PlaylistID: releaseRadar.PlaylistID,
+	}
+}
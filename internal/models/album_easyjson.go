@@ -17,7 +17,80 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD775dbc7Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *Albums) {
+func easyjsonD775dbc7Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *ReleaseRadar) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "albums":
+			(out.Albums).UnmarshalEasyJSON(in)
+		case "playlist_id":
+			out.PlaylistID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD775dbc7Encode20212LostPointerInternalModels(out *jwriter.Writer, in ReleaseRadar) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix[1:])
+		(in.Albums).MarshalEasyJSON(out)
+	}
+	if in.PlaylistID != 0 {
+		const prefix string = ",\"playlist_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PlaylistID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReleaseRadar) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD775dbc7Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReleaseRadar) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD775dbc7Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReleaseRadar) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD775dbc7Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReleaseRadar) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD775dbc7Decode20212LostPointerInternalModels(l, v)
+}
+func easyjsonD775dbc7Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *Albums) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -45,7 +118,7 @@ func easyjsonD775dbc7Decode20212LostPointerInternalModels(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD775dbc7Encode20212LostPointerInternalModels(out *jwriter.Writer, in Albums) {
+func easyjsonD775dbc7Encode20212LostPointerInternalModels1(out *jwriter.Writer, in Albums) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -63,27 +136,27 @@ func easyjsonD775dbc7Encode20212LostPointerInternalModels(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v Albums) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD775dbc7Encode20212LostPointerInternalModels(&w, v)
+	easyjsonD775dbc7Encode20212LostPointerInternalModels1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Albums) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD775dbc7Encode20212LostPointerInternalModels(w, v)
+	easyjsonD775dbc7Encode20212LostPointerInternalModels1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Albums) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD775dbc7Decode20212LostPointerInternalModels(&r, v)
+	easyjsonD775dbc7Decode20212LostPointerInternalModels1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Albums) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD775dbc7Decode20212LostPointerInternalModels(l, v)
+	easyjsonD775dbc7Decode20212LostPointerInternalModels1(l, v)
 }
-func easyjsonD775dbc7Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *AlbumPage) {
+func easyjsonD775dbc7Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out *AlbumPage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -141,6 +214,10 @@ func easyjsonD775dbc7Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out
 				}
 				in.Delim(']')
 			}
+		case "is_saved":
+			out.IsSaved = bool(in.Bool())
+		case "release_date":
+			out.ReleaseDate = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -151,7 +228,7 @@ func easyjsonD775dbc7Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD775dbc7Encode20212LostPointerInternalModels1(out *jwriter.Writer, in AlbumPage) {
+func easyjsonD775dbc7Encode20212LostPointerInternalModels2(out *jwriter.Writer, in AlbumPage) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -245,33 +322,43 @@ func easyjsonD775dbc7Encode20212LostPointerInternalModels1(out *jwriter.Writer,
 			out.RawByte(']')
 		}
 	}
+	if in.IsSaved {
+		const prefix string = ",\"is_saved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSaved))
+	}
+	if in.ReleaseDate != "" {
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.String(string(in.ReleaseDate))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AlbumPage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD775dbc7Encode20212LostPointerInternalModels1(&w, v)
+	easyjsonD775dbc7Encode20212LostPointerInternalModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlbumPage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD775dbc7Encode20212LostPointerInternalModels1(w, v)
+	easyjsonD775dbc7Encode20212LostPointerInternalModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlbumPage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD775dbc7Decode20212LostPointerInternalModels1(&r, v)
+	easyjsonD775dbc7Decode20212LostPointerInternalModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlbumPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD775dbc7Decode20212LostPointerInternalModels1(l, v)
+	easyjsonD775dbc7Decode20212LostPointerInternalModels2(l, v)
 }
-func easyjsonD775dbc7Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out *Album) {
+func easyjsonD775dbc7Decode20212LostPointerInternalModels3(in *jlexer.Lexer, out *Album) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -306,6 +393,10 @@ func easyjsonD775dbc7Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out
 			out.TracksDuration = int64(in.Int64())
 		case "artwork_color":
 			out.ArtworkColor = string(in.String())
+		case "is_saved":
+			out.IsSaved = bool(in.Bool())
+		case "release_date":
+			out.ReleaseDate = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -316,7 +407,7 @@ func easyjsonD775dbc7Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD775dbc7Encode20212LostPointerInternalModels2(out *jwriter.Writer, in Album) {
+func easyjsonD775dbc7Encode20212LostPointerInternalModels3(out *jwriter.Writer, in Album) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -396,29 +487,49 @@ func easyjsonD775dbc7Encode20212LostPointerInternalModels2(out *jwriter.Writer,
 		}
 		out.String(string(in.ArtworkColor))
 	}
+	if in.IsSaved {
+		const prefix string = ",\"is_saved\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.IsSaved))
+	}
+	if in.ReleaseDate != "" {
+		const prefix string = ",\"release_date\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ReleaseDate))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Album) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD775dbc7Encode20212LostPointerInternalModels2(&w, v)
+	easyjsonD775dbc7Encode20212LostPointerInternalModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Album) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD775dbc7Encode20212LostPointerInternalModels2(w, v)
+	easyjsonD775dbc7Encode20212LostPointerInternalModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Album) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD775dbc7Decode20212LostPointerInternalModels2(&r, v)
+	easyjsonD775dbc7Decode20212LostPointerInternalModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Album) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD775dbc7Decode20212LostPointerInternalModels2(l, v)
+	easyjsonD775dbc7Decode20212LostPointerInternalModels3(l, v)
 }
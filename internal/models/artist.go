@@ -7,12 +7,16 @@ type (
 	Artists []Artist
 
 	Artist struct {
-		ID     int64   `json:"id,omitempty"`
-		Name   string  `json:"name"`
-		Avatar string  `json:"avatar,omitempty"`
-		Video  string  `json:"video,omitempty"`
-		Tracks []Track `json:"tracks,omitempty"`
-		Albums []Album `json:"albums,omitempty"`
+		ID          int64   `json:"id,omitempty"`
+		Name        string  `json:"name"`
+		Avatar      string  `json:"avatar,omitempty"`
+		AvatarColor string  `json:"avatar_color,omitempty"`
+		Video       string  `json:"video,omitempty"`
+		Bio         string  `json:"bio,omitempty"`
+		Tracks      []Track `json:"tracks,omitempty"`
+		Albums      []Album `json:"albums,omitempty"`
+		IsFollowed  bool    `json:"is_followed,omitempty"`
+		Followers   int64   `json:"followers_count,omitempty"`
 	}
 )
 
@@ -32,12 +36,16 @@ func (a *Artist) BindProto(artist *proto.Artist) {
 	}
 
 	bindedArtists := &Artist{
-		ID:     artist.ID,
-		Name:   artist.Name,
-		Avatar: artist.Avatar,
-		Video:  artist.Video,
-		Tracks: tracks,
-		Albums: albums,
+		ID:          artist.ID,
+		Name:        artist.Name,
+		Avatar:      artist.Avatar,
+		AvatarColor: artist.AvatarColor,
+		Video:       artist.Video,
+		Bio:         artist.Bio,
+		Tracks:      tracks,
+		Albums:      albums,
+		IsFollowed:  artist.IsFollowed,
+		Followers:   artist.FollowersCount,
 	}
 
 	*a = *bindedArtists
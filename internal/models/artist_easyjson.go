@@ -108,8 +108,12 @@ func easyjson75485a89Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out
 			out.Name = string(in.String())
 		case "avatar":
 			out.Avatar = string(in.String())
+		case "avatar_color":
+			out.AvatarColor = string(in.String())
 		case "video":
 			out.Video = string(in.String())
+		case "bio":
+			out.Bio = string(in.String())
 		case "tracks":
 			if in.IsNull() {
 				in.Skip()
@@ -156,6 +160,10 @@ func easyjson75485a89Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out
 				}
 				in.Delim(']')
 			}
+		case "is_followed":
+			out.IsFollowed = bool(in.Bool())
+		case "followers_count":
+			out.Followers = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -191,11 +199,21 @@ func easyjson75485a89Encode20212LostPointerInternalModels1(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.String(string(in.Avatar))
 	}
+	if in.AvatarColor != "" {
+		const prefix string = ",\"avatar_color\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarColor))
+	}
 	if in.Video != "" {
 		const prefix string = ",\"video\":"
 		out.RawString(prefix)
 		out.String(string(in.Video))
 	}
+	if in.Bio != "" {
+		const prefix string = ",\"bio\":"
+		out.RawString(prefix)
+		out.String(string(in.Bio))
+	}
 	if len(in.Tracks) != 0 {
 		const prefix string = ",\"tracks\":"
 		out.RawString(prefix)
@@ -224,6 +242,16 @@ func easyjson75485a89Encode20212LostPointerInternalModels1(out *jwriter.Writer,
 			out.RawByte(']')
 		}
 	}
+	if in.IsFollowed {
+		const prefix string = ",\"is_followed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFollowed))
+	}
+	if in.Followers != 0 {
+		const prefix string = ",\"followers_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Followers))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,48 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/catalog/proto"
+
+//easyjson:json
+type CatalogEntryID struct {
+	ID int64 `json:"id,omitempty"`
+}
+
+func (c *CatalogEntryID) BindProto(entry *proto.CreateResponse) {
+	binded := &CatalogEntryID{
+		ID: entry.ID,
+	}
+
+	*c = *binded
+}
+
+//easyjson:json
+type (
+	SuspiciousAccount struct {
+		UserID         int64  `json:"user_id"`
+		Nickname       string `json:"nickname"`
+		Email          string `json:"email"`
+		Rejections     int64  `json:"rejections"`
+		IPs            int64  `json:"ips"`
+		Listens        int64  `json:"listens"`
+		LastRejectedAt int64  `json:"last_rejected_at"`
+	}
+
+	SuspiciousAccounts []SuspiciousAccount
+)
+
+func (s *SuspiciousAccounts) BindProto(response *proto.SuspiciousAccountsResponse) {
+	accounts := make(SuspiciousAccounts, 0, len(response.Accounts))
+	for _, account := range response.Accounts {
+		accounts = append(accounts, SuspiciousAccount{
+			UserID:         account.UserID,
+			Nickname:       account.Nickname,
+			Email:          account.Email,
+			Rejections:     account.Rejections,
+			IPs:            account.IPs,
+			Listens:        account.Listens,
+			LastRejectedAt: account.LastRejectedAt,
+		})
+	}
+
+	*s = accounts
+}
@@ -0,0 +1,260 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson40cc99a3Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *SuspiciousAccounts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(SuspiciousAccounts, 0, 0)
+			} else {
+				*out = SuspiciousAccounts{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 SuspiciousAccount
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3Encode20212LostPointerInternalModels(out *jwriter.Writer, in SuspiciousAccounts) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuspiciousAccounts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuspiciousAccounts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuspiciousAccounts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuspiciousAccounts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3Decode20212LostPointerInternalModels(l, v)
+}
+func easyjson40cc99a3Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *SuspiciousAccount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int64(in.Int64())
+		case "nickname":
+			out.Nickname = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "rejections":
+			out.Rejections = int64(in.Int64())
+		case "ips":
+			out.IPs = int64(in.Int64())
+		case "listens":
+			out.Listens = int64(in.Int64())
+		case "last_rejected_at":
+			out.LastRejectedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3Encode20212LostPointerInternalModels1(out *jwriter.Writer, in SuspiciousAccount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nickname))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"rejections\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Rejections))
+	}
+	{
+		const prefix string = ",\"ips\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IPs))
+	}
+	{
+		const prefix string = ",\"listens\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Listens))
+	}
+	{
+		const prefix string = ",\"last_rejected_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LastRejectedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuspiciousAccount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3Encode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuspiciousAccount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3Encode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuspiciousAccount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3Decode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuspiciousAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3Decode20212LostPointerInternalModels1(l, v)
+}
+func easyjson40cc99a3Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out *CatalogEntryID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3Encode20212LostPointerInternalModels2(out *jwriter.Writer, in CatalogEntryID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CatalogEntryID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3Encode20212LostPointerInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CatalogEntryID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3Encode20212LostPointerInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CatalogEntryID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3Decode20212LostPointerInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CatalogEntryID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3Decode20212LostPointerInternalModels2(l, v)
+}
@@ -0,0 +1,99 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type (
+	ChartTrack struct {
+		Position         int64 `json:"position"`
+		PreviousPosition int64 `json:"previous_position,omitempty"`
+		Movement         int64 `json:"movement"`
+		IsNew            bool  `json:"is_new,omitempty"`
+		Plays            int64 `json:"plays"`
+		Track            Track `json:"track"`
+	}
+
+	ChartAlbum struct {
+		Position         int64 `json:"position"`
+		PreviousPosition int64 `json:"previous_position,omitempty"`
+		Movement         int64 `json:"movement"`
+		IsNew            bool  `json:"is_new,omitempty"`
+		Plays            int64 `json:"plays"`
+		Album            Album `json:"album"`
+	}
+
+	ChartArtist struct {
+		Position         int64  `json:"position"`
+		PreviousPosition int64  `json:"previous_position,omitempty"`
+		Movement         int64  `json:"movement"`
+		IsNew            bool   `json:"is_new,omitempty"`
+		Plays            int64  `json:"plays"`
+		Artist           Artist `json:"artist"`
+	}
+
+	Charts struct {
+		Period      string        `json:"period"`
+		PeriodStart string        `json:"period_start"`
+		Tracks      []ChartTrack  `json:"tracks,omitempty"`
+		Albums      []ChartAlbum  `json:"albums,omitempty"`
+		Artists     []ChartArtist `json:"artists,omitempty"`
+	}
+)
+
+func (c *Charts) BindProto(charts *proto.ChartsResponse) {
+	tracks := make([]ChartTrack, 0)
+	for _, t := range charts.Tracks {
+		track := ChartTrack{
+			Position:         t.Position,
+			PreviousPosition: t.PreviousPosition,
+			Movement:         chartMovement(t.Position, t.PreviousPosition),
+			IsNew:            t.PreviousPosition == 0,
+			Plays:            t.Plays,
+		}
+		track.Track.BindProto(t.Track)
+		tracks = append(tracks, track)
+	}
+
+	albums := make([]ChartAlbum, 0)
+	for _, alb := range charts.Albums {
+		album := ChartAlbum{
+			Position:         alb.Position,
+			PreviousPosition: alb.PreviousPosition,
+			Movement:         chartMovement(alb.Position, alb.PreviousPosition),
+			IsNew:            alb.PreviousPosition == 0,
+			Plays:            alb.Plays,
+		}
+		album.Album.BindProto(alb.Album)
+		albums = append(albums, album)
+	}
+
+	artists := make([]ChartArtist, 0)
+	for _, art := range charts.Artists {
+		artist := ChartArtist{
+			Position:         art.Position,
+			PreviousPosition: art.PreviousPosition,
+			Movement:         chartMovement(art.Position, art.PreviousPosition),
+			IsNew:            art.PreviousPosition == 0,
+			Plays:            art.Plays,
+		}
+		artist.Artist.BindProto(art.Artist)
+		artists = append(artists, artist)
+	}
+
+	bindedCharts := &Charts{
+		Period:      charts.Period,
+		PeriodStart: charts.PeriodStart,
+		Tracks:      tracks,
+		Albums:      albums,
+		Artists:     artists,
+	}
+
+	*c = *bindedCharts
+}
+
+func chartMovement(position int64, previousPosition int64) int64 {
+	if previousPosition == 0 {
+		return 0
+	}
+	return previousPosition - position
+}
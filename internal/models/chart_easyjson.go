@@ -0,0 +1,506 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson71c7223aDecode20212LostPointerInternalModels(in *jlexer.Lexer, out *Charts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "period":
+			out.Period = string(in.String())
+		case "period_start":
+			out.PeriodStart = string(in.String())
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]ChartTrack, 0, 0)
+					} else {
+						out.Tracks = []ChartTrack{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ChartTrack
+					(v1).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "albums":
+			if in.IsNull() {
+				in.Skip()
+				out.Albums = nil
+			} else {
+				in.Delim('[')
+				if out.Albums == nil {
+					if !in.IsDelim(']') {
+						out.Albums = make([]ChartAlbum, 0, 0)
+					} else {
+						out.Albums = []ChartAlbum{}
+					}
+				} else {
+					out.Albums = (out.Albums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 ChartAlbum
+					(v2).UnmarshalEasyJSON(in)
+					out.Albums = append(out.Albums, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]ChartArtist, 0, 0)
+					} else {
+						out.Artists = []ChartArtist{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 ChartArtist
+					(v3).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson71c7223aEncode20212LostPointerInternalModels(out *jwriter.Writer, in Charts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"period\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Period))
+	}
+	{
+		const prefix string = ",\"period_start\":"
+		out.RawString(prefix)
+		out.String(string(in.PeriodStart))
+	}
+	if len(in.Tracks) != 0 {
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.Tracks {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Albums) != 0 {
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Albums {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				(v7).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Artists {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Charts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson71c7223aEncode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Charts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson71c7223aEncode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Charts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson71c7223aDecode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Charts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson71c7223aDecode20212LostPointerInternalModels(l, v)
+}
+func easyjson71c7223aDecode20212LostPointerInternalModels1(in *jlexer.Lexer, out *ChartTrack) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "previous_position":
+			out.PreviousPosition = int64(in.Int64())
+		case "movement":
+			out.Movement = int64(in.Int64())
+		case "is_new":
+			out.IsNew = bool(in.Bool())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "track":
+			(out.Track).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson71c7223aEncode20212LostPointerInternalModels1(out *jwriter.Writer, in ChartTrack) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	if in.PreviousPosition != 0 {
+		const prefix string = ",\"previous_position\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PreviousPosition))
+	}
+	{
+		const prefix string = ",\"movement\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Movement))
+	}
+	if in.IsNew {
+		const prefix string = ",\"is_new\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsNew))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"track\":"
+		out.RawString(prefix)
+		(in.Track).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChartTrack) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson71c7223aEncode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChartTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson71c7223aEncode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChartTrack) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson71c7223aDecode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChartTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson71c7223aDecode20212LostPointerInternalModels1(l, v)
+}
+func easyjson71c7223aDecode20212LostPointerInternalModels2(in *jlexer.Lexer, out *ChartArtist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "previous_position":
+			out.PreviousPosition = int64(in.Int64())
+		case "movement":
+			out.Movement = int64(in.Int64())
+		case "is_new":
+			out.IsNew = bool(in.Bool())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "artist":
+			(out.Artist).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson71c7223aEncode20212LostPointerInternalModels2(out *jwriter.Writer, in ChartArtist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	if in.PreviousPosition != 0 {
+		const prefix string = ",\"previous_position\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PreviousPosition))
+	}
+	{
+		const prefix string = ",\"movement\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Movement))
+	}
+	if in.IsNew {
+		const prefix string = ",\"is_new\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsNew))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		(in.Artist).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChartArtist) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson71c7223aEncode20212LostPointerInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChartArtist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson71c7223aEncode20212LostPointerInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChartArtist) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson71c7223aDecode20212LostPointerInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChartArtist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson71c7223aDecode20212LostPointerInternalModels2(l, v)
+}
+func easyjson71c7223aDecode20212LostPointerInternalModels3(in *jlexer.Lexer, out *ChartAlbum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "previous_position":
+			out.PreviousPosition = int64(in.Int64())
+		case "movement":
+			out.Movement = int64(in.Int64())
+		case "is_new":
+			out.IsNew = bool(in.Bool())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "album":
+			(out.Album).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson71c7223aEncode20212LostPointerInternalModels3(out *jwriter.Writer, in ChartAlbum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	if in.PreviousPosition != 0 {
+		const prefix string = ",\"previous_position\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PreviousPosition))
+	}
+	{
+		const prefix string = ",\"movement\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Movement))
+	}
+	if in.IsNew {
+		const prefix string = ",\"is_new\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsNew))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		(in.Album).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChartAlbum) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson71c7223aEncode20212LostPointerInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChartAlbum) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson71c7223aEncode20212LostPointerInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChartAlbum) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson71c7223aDecode20212LostPointerInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChartAlbum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson71c7223aDecode20212LostPointerInternalModels3(l, v)
+}
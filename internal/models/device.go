@@ -0,0 +1,60 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/player/proto"
+
+type (
+	Device struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Type         string `json:"type"`
+		Online       bool   `json:"online"`
+		RegisteredAt int64  `json:"registered_at,omitempty"`
+	}
+
+	UserDevices struct {
+		Devices []Device `json:"devices"`
+	}
+
+	DeviceCommand struct {
+		Type           string `json:"type"`
+		SourceDeviceID string `json:"source_device_id,omitempty"`
+		TargetDeviceID string `json:"target_device_id"`
+		PositionMs     int64  `json:"position_ms,omitempty"`
+		IssuedAt       int64  `json:"issued_at,omitempty"`
+	}
+)
+
+func (d *Device) BindProto(device *proto.Device) {
+	bindedDevice := &Device{
+		ID:           device.ID,
+		Name:         device.Name,
+		Type:         device.Type,
+		Online:       device.Online,
+		RegisteredAt: device.RegisteredAt,
+	}
+
+	*d = *bindedDevice
+}
+
+func (d *UserDevices) BindProto(devices *proto.Devices) {
+	bindedDevices := make([]Device, 0, len(devices.Devices))
+	for _, current := range devices.Devices {
+		var device Device
+		device.BindProto(current)
+		bindedDevices = append(bindedDevices, device)
+	}
+
+	*d = UserDevices{Devices: bindedDevices}
+}
+
+func (c *DeviceCommand) BindProto(command *proto.DeviceCommand) {
+	bindedCommand := &DeviceCommand{
+		Type:           command.Type,
+		SourceDeviceID: command.SourceDeviceID,
+		TargetDeviceID: command.TargetDeviceID,
+		PositionMs:     command.PositionMs,
+		IssuedAt:       command.IssuedAt,
+	}
+
+	*c = *bindedCommand
+}
@@ -0,0 +1,305 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3073ac56Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *UserDevices) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "devices":
+			if in.IsNull() {
+				in.Skip()
+				out.Devices = nil
+			} else {
+				in.Delim('[')
+				if out.Devices == nil {
+					if !in.IsDelim(']') {
+						out.Devices = make([]Device, 0, 1)
+					} else {
+						out.Devices = []Device{}
+					}
+				} else {
+					out.Devices = (out.Devices)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Device
+					(v1).UnmarshalEasyJSON(in)
+					out.Devices = append(out.Devices, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3073ac56Encode20212LostPointerInternalModels(out *jwriter.Writer, in UserDevices) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"devices\":"
+		out.RawString(prefix[1:])
+		if in.Devices == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Devices {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserDevices) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3073ac56Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserDevices) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3073ac56Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserDevices) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3073ac56Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserDevices) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3073ac56Decode20212LostPointerInternalModels(l, v)
+}
+func easyjson3073ac56Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *DeviceCommand) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "source_device_id":
+			out.SourceDeviceID = string(in.String())
+		case "target_device_id":
+			out.TargetDeviceID = string(in.String())
+		case "position_ms":
+			out.PositionMs = int64(in.Int64())
+		case "issued_at":
+			out.IssuedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3073ac56Encode20212LostPointerInternalModels1(out *jwriter.Writer, in DeviceCommand) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.SourceDeviceID != "" {
+		const prefix string = ",\"source_device_id\":"
+		out.RawString(prefix)
+		out.String(string(in.SourceDeviceID))
+	}
+	{
+		const prefix string = ",\"target_device_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetDeviceID))
+	}
+	if in.PositionMs != 0 {
+		const prefix string = ",\"position_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PositionMs))
+	}
+	if in.IssuedAt != 0 {
+		const prefix string = ",\"issued_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.IssuedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeviceCommand) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3073ac56Encode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeviceCommand) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3073ac56Encode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeviceCommand) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3073ac56Decode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeviceCommand) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3073ac56Decode20212LostPointerInternalModels1(l, v)
+}
+func easyjson3073ac56Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out *Device) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "online":
+			out.Online = bool(in.Bool())
+		case "registered_at":
+			out.RegisteredAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3073ac56Encode20212LostPointerInternalModels2(out *jwriter.Writer, in Device) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"online\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Online))
+	}
+	if in.RegisteredAt != 0 {
+		const prefix string = ",\"registered_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RegisteredAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Device) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3073ac56Encode20212LostPointerInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Device) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3073ac56Encode20212LostPointerInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Device) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3073ac56Decode20212LostPointerInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3073ac56Decode20212LostPointerInternalModels2(l, v)
+}
@@ -0,0 +1,70 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type (
+	Genres []Genre
+
+	Genre struct {
+		ID           int64  `json:"id,omitempty"`
+		Name         string `json:"name"`
+		Artwork      string `json:"artwork,omitempty"`
+		ArtworkColor string `json:"artwork_color,omitempty"`
+		TracksCount  int64  `json:"tracks_count,omitempty"`
+	}
+
+	GenrePage struct {
+		Genre   Genre    `json:"genre"`
+		Tracks  []Track  `json:"tracks,omitempty"`
+		Albums  []Album  `json:"albums,omitempty"`
+		Artists []Artist `json:"artists,omitempty"`
+	}
+)
+
+func (g *Genre) BindProto(genre *proto.Genre) {
+	bindedGenre := &Genre{
+		ID:           genre.ID,
+		Name:         genre.Name,
+		Artwork:      genre.Artwork,
+		ArtworkColor: genre.ArtworkColor,
+		TracksCount:  genre.TracksAmount,
+	}
+
+	*g = *bindedGenre
+}
+
+func (g *GenrePage) BindProto(page *proto.GenrePageResponse) {
+	tracks := make([]Track, 0)
+	for _, t := range page.Tracks {
+		var track Track
+		track.BindProto(t)
+		tracks = append(tracks, track)
+	}
+
+	albums := make([]Album, 0)
+	for _, alb := range page.Albums {
+		var album Album
+		album.BindProto(alb)
+		albums = append(albums, album)
+	}
+
+	artists := make([]Artist, 0)
+	for _, art := range page.Artists {
+		var artist Artist
+		artist.BindProto(art)
+		artists = append(artists, artist)
+	}
+
+	var genre Genre
+	genre.BindProto(page.Genre)
+
+	bindedPage := &GenrePage{
+		Genre:   genre,
+		Tracks:  tracks,
+		Albums:  albums,
+		Artists: artists,
+	}
+
+	*g = *bindedPage
+}
@@ -0,0 +1,362 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson52fdb84bDecode20212LostPointerInternalModels(in *jlexer.Lexer, out *Genres) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Genres, 0, 1)
+			} else {
+				*out = Genres{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 Genre
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52fdb84bEncode20212LostPointerInternalModels(out *jwriter.Writer, in Genres) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Genres) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52fdb84bEncode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Genres) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52fdb84bEncode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Genres) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52fdb84bDecode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Genres) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52fdb84bDecode20212LostPointerInternalModels(l, v)
+}
+func easyjson52fdb84bDecode20212LostPointerInternalModels1(in *jlexer.Lexer, out *GenrePage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "genre":
+			(out.Genre).UnmarshalEasyJSON(in)
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]Track, 0, 0)
+					} else {
+						out.Tracks = []Track{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 Track
+					(v4).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "albums":
+			if in.IsNull() {
+				in.Skip()
+				out.Albums = nil
+			} else {
+				in.Delim('[')
+				if out.Albums == nil {
+					if !in.IsDelim(']') {
+						out.Albums = make([]Album, 0, 0)
+					} else {
+						out.Albums = []Album{}
+					}
+				} else {
+					out.Albums = (out.Albums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 Album
+					(v5).UnmarshalEasyJSON(in)
+					out.Albums = append(out.Albums, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]Artist, 0, 0)
+					} else {
+						out.Artists = []Artist{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 Artist
+					(v6).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52fdb84bEncode20212LostPointerInternalModels1(out *jwriter.Writer, in GenrePage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix[1:])
+		(in.Genre).MarshalEasyJSON(out)
+	}
+	if len(in.Tracks) != 0 {
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v7, v8 := range in.Tracks {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Albums) != 0 {
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.Albums {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Artists {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GenrePage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52fdb84bEncode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GenrePage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52fdb84bEncode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GenrePage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52fdb84bDecode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GenrePage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52fdb84bDecode20212LostPointerInternalModels1(l, v)
+}
+func easyjson52fdb84bDecode20212LostPointerInternalModels2(in *jlexer.Lexer, out *Genre) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "name":
+			out.Name = string(in.String())
+		case "artwork":
+			out.Artwork = string(in.String())
+		case "artwork_color":
+			out.ArtworkColor = string(in.String())
+		case "tracks_count":
+			out.TracksCount = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson52fdb84bEncode20212LostPointerInternalModels2(out *jwriter.Writer, in Genre) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Artwork != "" {
+		const prefix string = ",\"artwork\":"
+		out.RawString(prefix)
+		out.String(string(in.Artwork))
+	}
+	if in.ArtworkColor != "" {
+		const prefix string = ",\"artwork_color\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtworkColor))
+	}
+	if in.TracksCount != 0 {
+		const prefix string = ",\"tracks_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TracksCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Genre) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson52fdb84bEncode20212LostPointerInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Genre) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson52fdb84bEncode20212LostPointerInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Genre) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson52fdb84bDecode20212LostPointerInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Genre) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson52fdb84bDecode20212LostPointerInternalModels2(l, v)
+}
@@ -0,0 +1,34 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type (
+	LyricsLine struct {
+		Time int64  `json:"time"`
+		Text string `json:"text"`
+	}
+
+	Lyrics struct {
+		TrackID int64        `json:"track_id"`
+		Plain   string       `json:"plain"`
+		Synced  bool         `json:"synced"`
+		Lines   []LyricsLine `json:"lines,omitempty"`
+	}
+)
+
+func (l *Lyrics) BindProto(lyrics *proto.Lyrics) {
+	lines := make([]LyricsLine, 0, len(lyrics.Lines))
+	for _, line := range lyrics.Lines {
+		lines = append(lines, LyricsLine{Time: line.Time, Text: line.Text})
+	}
+
+	bindedLyrics := &Lyrics{
+		TrackID: lyrics.TrackID,
+		Plain:   lyrics.Plain,
+		Synced:  lyrics.Synced,
+		Lines:   lines,
+	}
+
+	*l = *bindedLyrics
+}
@@ -0,0 +1,209 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6549bd74Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *LyricsLine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time":
+			out.Time = int64(in.Int64())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6549bd74Encode20212LostPointerInternalModels(out *jwriter.Writer, in LyricsLine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LyricsLine) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6549bd74Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LyricsLine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6549bd74Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LyricsLine) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6549bd74Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LyricsLine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6549bd74Decode20212LostPointerInternalModels(l, v)
+}
+func easyjson6549bd74Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *Lyrics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackID = int64(in.Int64())
+		case "plain":
+			out.Plain = string(in.String())
+		case "synced":
+			out.Synced = bool(in.Bool())
+		case "lines":
+			if in.IsNull() {
+				in.Skip()
+				out.Lines = nil
+			} else {
+				in.Delim('[')
+				if out.Lines == nil {
+					if !in.IsDelim(']') {
+						out.Lines = make([]LyricsLine, 0, 2)
+					} else {
+						out.Lines = []LyricsLine{}
+					}
+				} else {
+					out.Lines = (out.Lines)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 LyricsLine
+					(v1).UnmarshalEasyJSON(in)
+					out.Lines = append(out.Lines, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6549bd74Encode20212LostPointerInternalModels1(out *jwriter.Writer, in Lyrics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TrackID))
+	}
+	{
+		const prefix string = ",\"plain\":"
+		out.RawString(prefix)
+		out.String(string(in.Plain))
+	}
+	{
+		const prefix string = ",\"synced\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Synced))
+	}
+	if len(in.Lines) != 0 {
+		const prefix string = ",\"lines\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Lines {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Lyrics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6549bd74Encode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Lyrics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6549bd74Encode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Lyrics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6549bd74Decode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Lyrics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6549bd74Decode20212LostPointerInternalModels1(l, v)
+}
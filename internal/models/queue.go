@@ -0,0 +1,39 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/player/proto"
+
+//easyjson:json
+type Queue struct {
+	TrackIDs     []int64 `json:"track_ids"`
+	CurrentIndex int64   `json:"current_index"`
+	PositionMs   int64   `json:"position_ms"`
+	Shuffle      bool    `json:"shuffle"`
+	RepeatMode   string  `json:"repeat_mode"`
+	ContextType  string  `json:"context_type,omitempty"`
+	ContextID    int64   `json:"context_id,omitempty"`
+	Version      int64   `json:"version"`
+	DeviceID     string  `json:"device_id,omitempty"`
+	UpdatedAt    int64   `json:"updated_at,omitempty"`
+}
+
+func (q *Queue) BindProto(queue *proto.Queue) {
+	trackIDs := queue.TrackIDs
+	if trackIDs == nil {
+		trackIDs = []int64{}
+	}
+
+	bindedQueue := &Queue{
+		TrackIDs:     trackIDs,
+		CurrentIndex: queue.CurrentIndex,
+		PositionMs:   queue.PositionMs,
+		Shuffle:      queue.Shuffle,
+		RepeatMode:   queue.RepeatMode,
+		ContextType:  queue.ContextType,
+		ContextID:    queue.ContextID,
+		Version:      queue.Version,
+		DeviceID:     queue.DeviceID,
+		UpdatedAt:    queue.UpdatedAt,
+	}
+
+	*q = *bindedQueue
+}
@@ -0,0 +1,180 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson5e1ce037Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *Queue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TrackIDs == nil {
+					if !in.IsDelim(']') {
+						out.TrackIDs = make([]int64, 0, 8)
+					} else {
+						out.TrackIDs = []int64{}
+					}
+				} else {
+					out.TrackIDs = (out.TrackIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int64
+					v1 = int64(in.Int64())
+					out.TrackIDs = append(out.TrackIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "current_index":
+			out.CurrentIndex = int64(in.Int64())
+		case "position_ms":
+			out.PositionMs = int64(in.Int64())
+		case "shuffle":
+			out.Shuffle = bool(in.Bool())
+		case "repeat_mode":
+			out.RepeatMode = string(in.String())
+		case "context_type":
+			out.ContextType = string(in.String())
+		case "context_id":
+			out.ContextID = int64(in.Int64())
+		case "version":
+			out.Version = int64(in.Int64())
+		case "device_id":
+			out.DeviceID = string(in.String())
+		case "updated_at":
+			out.UpdatedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5e1ce037Encode20212LostPointerInternalModels(out *jwriter.Writer, in Queue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_ids\":"
+		out.RawString(prefix[1:])
+		if in.TrackIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.TrackIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"current_index\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CurrentIndex))
+	}
+	{
+		const prefix string = ",\"position_ms\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PositionMs))
+	}
+	{
+		const prefix string = ",\"shuffle\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shuffle))
+	}
+	{
+		const prefix string = ",\"repeat_mode\":"
+		out.RawString(prefix)
+		out.String(string(in.RepeatMode))
+	}
+	if in.ContextType != "" {
+		const prefix string = ",\"context_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ContextType))
+	}
+	if in.ContextID != 0 {
+		const prefix string = ",\"context_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ContextID))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Version))
+	}
+	if in.DeviceID != "" {
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix)
+		out.String(string(in.DeviceID))
+	}
+	if in.UpdatedAt != 0 {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Queue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5e1ce037Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Queue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5e1ce037Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Queue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5e1ce037Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Queue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5e1ce037Decode20212LostPointerInternalModels(l, v)
+}
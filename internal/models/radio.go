@@ -0,0 +1,25 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type Radio struct {
+	Session string  `json:"session"`
+	Tracks  []Track `json:"tracks"`
+}
+
+func (r *Radio) BindProto(radio *proto.RadioResponse) {
+	tracks := make([]Track, 0, len(radio.Tracks))
+	for _, current := range radio.Tracks {
+		var track Track
+		track.BindProto(current)
+		tracks = append(tracks, track)
+	}
+
+	bindedRadio := &Radio{
+		Session: radio.Session,
+		Tracks:  tracks,
+	}
+
+	*r = *bindedRadio
+}
@@ -0,0 +1,90 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type (
+	RecapTrack struct {
+		Position int64 `json:"position"`
+		Plays    int64 `json:"plays"`
+		Track    Track `json:"track"`
+	}
+
+	RecapAlbum struct {
+		Position int64 `json:"position"`
+		Plays    int64 `json:"plays"`
+		Album    Album `json:"album"`
+	}
+
+	RecapArtist struct {
+		Position int64  `json:"position"`
+		Plays    int64  `json:"plays"`
+		Artist   Artist `json:"artist"`
+	}
+
+	RecapGenre struct {
+		Position int64 `json:"position"`
+		Plays    int64 `json:"plays"`
+		Genre    Genre `json:"genre"`
+	}
+
+	Recap struct {
+		Year                 int64         `json:"year"`
+		MinutesListened      int64         `json:"minutes_listened"`
+		Listens              int64         `json:"listens"`
+		LongestStreak        int64         `json:"longest_streak"`
+		LongestStreakStart   string        `json:"longest_streak_start"`
+		MostPlayedDay        string        `json:"most_played_day"`
+		MostPlayedDayListens int64         `json:"most_played_day_listens"`
+		BuiltAt              int64         `json:"built_at"`
+		Tracks               []RecapTrack  `json:"tracks"`
+		Albums               []RecapAlbum  `json:"albums"`
+		Artists              []RecapArtist `json:"artists"`
+		Genres               []RecapGenre  `json:"genres"`
+	}
+)
+
+func (r *Recap) BindProto(recap *proto.RecapResponse) {
+	tracks := make([]RecapTrack, 0, len(recap.Tracks))
+	for _, t := range recap.Tracks {
+		track := RecapTrack{Position: t.Position, Plays: t.Plays}
+		track.Track.BindProto(t.Track)
+		tracks = append(tracks, track)
+	}
+
+	albums := make([]RecapAlbum, 0, len(recap.Albums))
+	for _, alb := range recap.Albums {
+		album := RecapAlbum{Position: alb.Position, Plays: alb.Plays}
+		album.Album.BindProto(alb.Album)
+		albums = append(albums, album)
+	}
+
+	artists := make([]RecapArtist, 0, len(recap.Artists))
+	for _, art := range recap.Artists {
+		artist := RecapArtist{Position: art.Position, Plays: art.Plays}
+		artist.Artist.BindProto(art.Artist)
+		artists = append(artists, artist)
+	}
+
+	genres := make([]RecapGenre, 0, len(recap.Genres))
+	for _, g := range recap.Genres {
+		genre := RecapGenre{Position: g.Position, Plays: g.Plays}
+		genre.Genre.BindProto(g.Genre)
+		genres = append(genres, genre)
+	}
+
+	*r = Recap{
+		Year:                 recap.Year,
+		MinutesListened:      recap.MinutesListened,
+		Listens:              recap.Listens,
+		LongestStreak:        recap.LongestStreak,
+		LongestStreakStart:   recap.LongestStreakStart,
+		MostPlayedDay:        recap.MostPlayedDay,
+		MostPlayedDayListens: recap.MostPlayedDayListens,
+		BuiltAt:              recap.BuiltAt,
+		Tracks:               tracks,
+		Albums:               albums,
+		Artists:              artists,
+		Genres:               genres,
+	}
+}
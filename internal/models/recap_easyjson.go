@@ -0,0 +1,610 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1b37eecfDecode20212LostPointerInternalModels(in *jlexer.Lexer, out *RecapTrack) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "track":
+			(out.Track).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1b37eecfEncode20212LostPointerInternalModels(out *jwriter.Writer, in RecapTrack) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"track\":"
+		out.RawString(prefix)
+		(in.Track).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecapTrack) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1b37eecfEncode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecapTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1b37eecfEncode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecapTrack) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1b37eecfDecode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecapTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1b37eecfDecode20212LostPointerInternalModels(l, v)
+}
+func easyjson1b37eecfDecode20212LostPointerInternalModels1(in *jlexer.Lexer, out *RecapGenre) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "genre":
+			(out.Genre).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1b37eecfEncode20212LostPointerInternalModels1(out *jwriter.Writer, in RecapGenre) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		(in.Genre).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecapGenre) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1b37eecfEncode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecapGenre) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1b37eecfEncode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecapGenre) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1b37eecfDecode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecapGenre) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1b37eecfDecode20212LostPointerInternalModels1(l, v)
+}
+func easyjson1b37eecfDecode20212LostPointerInternalModels2(in *jlexer.Lexer, out *RecapArtist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "artist":
+			(out.Artist).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1b37eecfEncode20212LostPointerInternalModels2(out *jwriter.Writer, in RecapArtist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		(in.Artist).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecapArtist) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1b37eecfEncode20212LostPointerInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecapArtist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1b37eecfEncode20212LostPointerInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecapArtist) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1b37eecfDecode20212LostPointerInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecapArtist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1b37eecfDecode20212LostPointerInternalModels2(l, v)
+}
+func easyjson1b37eecfDecode20212LostPointerInternalModels3(in *jlexer.Lexer, out *RecapAlbum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int64(in.Int64())
+		case "plays":
+			out.Plays = int64(in.Int64())
+		case "album":
+			(out.Album).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1b37eecfEncode20212LostPointerInternalModels3(out *jwriter.Writer, in RecapAlbum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Position))
+	}
+	{
+		const prefix string = ",\"plays\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Plays))
+	}
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		(in.Album).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecapAlbum) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1b37eecfEncode20212LostPointerInternalModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecapAlbum) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1b37eecfEncode20212LostPointerInternalModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecapAlbum) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1b37eecfDecode20212LostPointerInternalModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecapAlbum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1b37eecfDecode20212LostPointerInternalModels3(l, v)
+}
+func easyjson1b37eecfDecode20212LostPointerInternalModels4(in *jlexer.Lexer, out *Recap) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "year":
+			out.Year = int64(in.Int64())
+		case "minutes_listened":
+			out.MinutesListened = int64(in.Int64())
+		case "listens":
+			out.Listens = int64(in.Int64())
+		case "longest_streak":
+			out.LongestStreak = int64(in.Int64())
+		case "longest_streak_start":
+			out.LongestStreakStart = string(in.String())
+		case "most_played_day":
+			out.MostPlayedDay = string(in.String())
+		case "most_played_day_listens":
+			out.MostPlayedDayListens = int64(in.Int64())
+		case "built_at":
+			out.BuiltAt = int64(in.Int64())
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]RecapTrack, 0, 0)
+					} else {
+						out.Tracks = []RecapTrack{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 RecapTrack
+					(v1).UnmarshalEasyJSON(in)
+					out.Tracks = append(out.Tracks, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "albums":
+			if in.IsNull() {
+				in.Skip()
+				out.Albums = nil
+			} else {
+				in.Delim('[')
+				if out.Albums == nil {
+					if !in.IsDelim(']') {
+						out.Albums = make([]RecapAlbum, 0, 0)
+					} else {
+						out.Albums = []RecapAlbum{}
+					}
+				} else {
+					out.Albums = (out.Albums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 RecapAlbum
+					(v2).UnmarshalEasyJSON(in)
+					out.Albums = append(out.Albums, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]RecapArtist, 0, 0)
+					} else {
+						out.Artists = []RecapArtist{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 RecapArtist
+					(v3).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]RecapGenre, 0, 0)
+					} else {
+						out.Genres = []RecapGenre{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 RecapGenre
+					(v4).UnmarshalEasyJSON(in)
+					out.Genres = append(out.Genres, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1b37eecfEncode20212LostPointerInternalModels4(out *jwriter.Writer, in Recap) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"year\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Year))
+	}
+	{
+		const prefix string = ",\"minutes_listened\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MinutesListened))
+	}
+	{
+		const prefix string = ",\"listens\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Listens))
+	}
+	{
+		const prefix string = ",\"longest_streak\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LongestStreak))
+	}
+	{
+		const prefix string = ",\"longest_streak_start\":"
+		out.RawString(prefix)
+		out.String(string(in.LongestStreakStart))
+	}
+	{
+		const prefix string = ",\"most_played_day\":"
+		out.RawString(prefix)
+		out.String(string(in.MostPlayedDay))
+	}
+	{
+		const prefix string = ",\"most_played_day_listens\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MostPlayedDayListens))
+	}
+	{
+		const prefix string = ",\"built_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.BuiltAt))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Tracks {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		if in.Albums == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Albums {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Artists {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Genres {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Recap) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1b37eecfEncode20212LostPointerInternalModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Recap) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1b37eecfEncode20212LostPointerInternalModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Recap) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1b37eecfDecode20212LostPointerInternalModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Recap) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1b37eecfDecode20212LostPointerInternalModels4(l, v)
+}
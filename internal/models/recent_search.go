@@ -0,0 +1,30 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type (
+	RecentSearches []RecentSearch
+
+	RecentSearch struct {
+		ID          int64  `json:"id"`
+		Query       string `json:"query"`
+		ResultType  string `json:"result_type,omitempty"`
+		ResultID    int64  `json:"result_id,omitempty"`
+		ResultTitle string `json:"result_title,omitempty"`
+		CreatedAt   int64  `json:"created_at"`
+	}
+)
+
+func (r *RecentSearch) BindProto(search *proto.RecentSearch) {
+	bindedSearch := &RecentSearch{
+		ID:          search.ID,
+		Query:       search.Query,
+		ResultType:  search.ResultType,
+		ResultID:    search.ResultID,
+		ResultTitle: search.ResultTitle,
+		CreatedAt:   search.CreatedAt,
+	}
+
+	*r = *bindedSearch
+}
@@ -0,0 +1,186 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3d19e036Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *RecentSearches) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(RecentSearches, 0, 0)
+			} else {
+				*out = RecentSearches{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 RecentSearch
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3d19e036Encode20212LostPointerInternalModels(out *jwriter.Writer, in RecentSearches) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecentSearches) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3d19e036Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecentSearches) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3d19e036Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecentSearches) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3d19e036Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecentSearches) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3d19e036Decode20212LostPointerInternalModels(l, v)
+}
+func easyjson3d19e036Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *RecentSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "query":
+			out.Query = string(in.String())
+		case "result_type":
+			out.ResultType = string(in.String())
+		case "result_id":
+			out.ResultID = int64(in.Int64())
+		case "result_title":
+			out.ResultTitle = string(in.String())
+		case "created_at":
+			out.CreatedAt = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3d19e036Encode20212LostPointerInternalModels1(out *jwriter.Writer, in RecentSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	if in.ResultType != "" {
+		const prefix string = ",\"result_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultType))
+	}
+	if in.ResultID != 0 {
+		const prefix string = ",\"result_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ResultID))
+	}
+	if in.ResultTitle != "" {
+		const prefix string = ",\"result_title\":"
+		out.RawString(prefix)
+		out.String(string(in.ResultTitle))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecentSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3d19e036Encode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecentSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3d19e036Encode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecentSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3d19e036Decode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecentSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3d19e036Decode20212LostPointerInternalModels1(l, v)
+}
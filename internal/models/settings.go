@@ -3,18 +3,20 @@ package models
 import "2021_2_LostPointer/internal/microservices/profile/proto"
 
 type UserSettings struct {
-	Email       string `json:"email,omitempty"`
-	Nickname    string `json:"nickname,omitempty"`
-	SmallAvatar string `json:"small_avatar,omitempty"`
-	BigAvatar   string `json:"big_avatar,omitempty"`
+	Email             string `json:"email,omitempty"`
+	Nickname          string `json:"nickname,omitempty"`
+	SmallAvatar       string `json:"small_avatar,omitempty"`
+	BigAvatar         string `json:"big_avatar,omitempty"`
+	SaveSearchHistory bool   `json:"save_search_history"`
 }
 
 func (u *UserSettings) BindProto(proto *proto.UserSettings) {
 	bindedData := UserSettings{
-		Email:       proto.Email,
-		Nickname:    proto.Nickname,
-		SmallAvatar: proto.SmallAvatar,
-		BigAvatar:   proto.BigAvatar,
+		Email:             proto.Email,
+		Nickname:          proto.Nickname,
+		SmallAvatar:       proto.SmallAvatar,
+		BigAvatar:         proto.BigAvatar,
+		SaveSearchHistory: proto.SaveSearchHistory,
 	}
 
 	*u = bindedData
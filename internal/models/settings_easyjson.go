@@ -44,6 +44,8 @@ func easyjsonB229cf53Decode20212LostPointerInternalModels(in *jlexer.Lexer, out
 			out.SmallAvatar = string(in.String())
 		case "big_avatar":
 			out.BigAvatar = string(in.String())
+		case "save_search_history":
+			out.SaveSearchHistory = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -94,6 +96,16 @@ func easyjsonB229cf53Encode20212LostPointerInternalModels(out *jwriter.Writer, i
 		}
 		out.String(string(in.BigAvatar))
 	}
+	{
+		const prefix string = ",\"save_search_history\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.SaveSearchHistory))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,40 @@
+package models
+
+import "2021_2_LostPointer/internal/microservices/music/proto"
+
+//easyjson:json
+type (
+	Suggestions []Suggestion
+
+	Suggestion struct {
+		Type       string       `json:"type"`
+		ID         int64        `json:"id"`
+		Title      string       `json:"title"`
+		Subtitle   string       `json:"subtitle,omitempty"`
+		Artwork    string       `json:"artwork,omitempty"`
+		Highlights []MatchRange `json:"highlights"`
+	}
+
+	MatchRange struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end"`
+	}
+)
+
+func (s *Suggestion) BindProto(suggestion *proto.Suggestion) {
+	highlights := make([]MatchRange, 0)
+	for _, highlight := range suggestion.Highlights {
+		highlights = append(highlights, MatchRange{Start: highlight.Start, End: highlight.End})
+	}
+
+	bindedSuggestion := &Suggestion{
+		Type:       suggestion.Type,
+		ID:         suggestion.ID,
+		Title:      suggestion.Title,
+		Subtitle:   suggestion.Subtitle,
+		Artwork:    suggestion.Artwork,
+		Highlights: highlights,
+	}
+
+	*s = *bindedSuggestion
+}
@@ -0,0 +1,291 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF34c9ac8Decode20212LostPointerInternalModels(in *jlexer.Lexer, out *Suggestions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Suggestions, 0, 0)
+			} else {
+				*out = Suggestions{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 Suggestion
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF34c9ac8Encode20212LostPointerInternalModels(out *jwriter.Writer, in Suggestions) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Suggestions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF34c9ac8Encode20212LostPointerInternalModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Suggestions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF34c9ac8Encode20212LostPointerInternalModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Suggestions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF34c9ac8Decode20212LostPointerInternalModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Suggestions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF34c9ac8Decode20212LostPointerInternalModels(l, v)
+}
+func easyjsonF34c9ac8Decode20212LostPointerInternalModels1(in *jlexer.Lexer, out *Suggestion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "subtitle":
+			out.Subtitle = string(in.String())
+		case "artwork":
+			out.Artwork = string(in.String())
+		case "highlights":
+			if in.IsNull() {
+				in.Skip()
+				out.Highlights = nil
+			} else {
+				in.Delim('[')
+				if out.Highlights == nil {
+					if !in.IsDelim(']') {
+						out.Highlights = make([]MatchRange, 0, 4)
+					} else {
+						out.Highlights = []MatchRange{}
+					}
+				} else {
+					out.Highlights = (out.Highlights)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 MatchRange
+					(v4).UnmarshalEasyJSON(in)
+					out.Highlights = append(out.Highlights, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF34c9ac8Encode20212LostPointerInternalModels1(out *jwriter.Writer, in Suggestion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	if in.Subtitle != "" {
+		const prefix string = ",\"subtitle\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtitle))
+	}
+	if in.Artwork != "" {
+		const prefix string = ",\"artwork\":"
+		out.RawString(prefix)
+		out.String(string(in.Artwork))
+	}
+	{
+		const prefix string = ",\"highlights\":"
+		out.RawString(prefix)
+		if in.Highlights == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Highlights {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Suggestion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF34c9ac8Encode20212LostPointerInternalModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Suggestion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF34c9ac8Encode20212LostPointerInternalModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Suggestion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF34c9ac8Decode20212LostPointerInternalModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Suggestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF34c9ac8Decode20212LostPointerInternalModels1(l, v)
+}
+func easyjsonF34c9ac8Decode20212LostPointerInternalModels2(in *jlexer.Lexer, out *MatchRange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = int64(in.Int64())
+		case "end":
+			out.End = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF34c9ac8Encode20212LostPointerInternalModels2(out *jwriter.Writer, in MatchRange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Start))
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.End))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchRange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF34c9ac8Encode20212LostPointerInternalModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchRange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF34c9ac8Encode20212LostPointerInternalModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchRange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF34c9ac8Decode20212LostPointerInternalModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchRange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF34c9ac8Decode20212LostPointerInternalModels2(l, v)
+}
@@ -9,18 +9,21 @@ type (
 	Tracks []Track
 
 	Track struct {
-		ID            int64  `json:"id,omitempty"`
-		Title         string `json:"title,omitempty"`
-		Explicit      bool   `json:"explicit,omitempty"`
-		Genre         string `json:"genre,omitempty"`
-		Number        int64  `json:"number,omitempty"`
-		File          string `json:"file,omitempty"`
-		ListenCount   int64  `json:"listen_count,omitempty"`
-		Duration      int64  `json:"duration,omitempty"`
-		Lossless      bool   `json:"lossless,omitempty"`
-		Album         Album  `json:"album,omitempty"`
-		Artist        Artist `json:"artist,omitempty"`
-		IsInFavorites bool   `json:"is_in_favorites,omitempty"`
+		ID            int64   `json:"id,omitempty"`
+		Title         string  `json:"title,omitempty"`
+		Explicit      bool    `json:"explicit,omitempty"`
+		Genre         string  `json:"genre,omitempty"`
+		Number        int64   `json:"number,omitempty"`
+		File          string  `json:"file,omitempty"`
+		ListenCount   int64   `json:"listen_count,omitempty"`
+		Duration      int64   `json:"duration,omitempty"`
+		Lossless      bool    `json:"lossless,omitempty"`
+		Album         Album   `json:"album,omitempty"`
+		Artist        Artist  `json:"artist,omitempty"`
+		IsInFavorites bool    `json:"is_in_favorites,omitempty"`
+		AddedAt       int64   `json:"added_at,omitempty"`
+		HasLyrics     bool    `json:"has_lyrics,omitempty"`
+		Playlists     []int64 `json:"playlists,omitempty"`
 	}
 
 	TrackAlbum struct {
@@ -34,6 +37,7 @@ type (
 		Duration      int64  `json:"duration,omitempty"`
 		Lossless      bool   `json:"lossless,omitempty"`
 		IsInFavorites bool   `json:"is_in_favorites,omitempty"`
+		HasLyrics     bool   `json:"has_lyrics,omitempty"`
 	}
 
 	TrackID struct {
@@ -53,6 +57,7 @@ func (t *TrackAlbum) BindProto(track *proto.AlbumTrack) {
 		Duration:      track.Duration,
 		Lossless:      track.Lossless,
 		IsInFavorites: track.IsInFavorites,
+		HasLyrics:     track.HasLyrics,
 	}
 
 	*t = *bindedTrack
@@ -86,6 +91,9 @@ func (t *Track) BindProto(track *proto.Track) {
 			Video:  track.Artist.Video,
 		},
 		IsInFavorites: track.IsInFavorites,
+		AddedAt:       track.AddedAt,
+		HasLyrics:     track.HasLyrics,
+		Playlists:     track.PlaylistIDs,
 	}
 
 	*t = *bindedTrack
@@ -189,6 +189,8 @@ func easyjsonAe118d8fDecode20212LostPointerInternalModels2(in *jlexer.Lexer, out
 			out.Lossless = bool(in.Bool())
 		case "is_in_favorites":
 			out.IsInFavorites = bool(in.Bool())
+		case "has_lyrics":
+			out.HasLyrics = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -264,6 +266,11 @@ func easyjsonAe118d8fEncode20212LostPointerInternalModels2(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Bool(bool(in.IsInFavorites))
 	}
+	if in.HasLyrics {
+		const prefix string = ",\"has_lyrics\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasLyrics))
+	}
 	out.RawByte('}')
 }
 
@@ -333,6 +340,33 @@ func easyjsonAe118d8fDecode20212LostPointerInternalModels3(in *jlexer.Lexer, out
 			(out.Artist).UnmarshalEasyJSON(in)
 		case "is_in_favorites":
 			out.IsInFavorites = bool(in.Bool())
+		case "added_at":
+			out.AddedAt = int64(in.Int64())
+		case "has_lyrics":
+			out.HasLyrics = bool(in.Bool())
+		case "playlists":
+			if in.IsNull() {
+				in.Skip()
+				out.Playlists = nil
+			} else {
+				in.Delim('[')
+				if out.Playlists == nil {
+					if !in.IsDelim(']') {
+						out.Playlists = make([]int64, 0, 8)
+					} else {
+						out.Playlists = []int64{}
+					}
+				} else {
+					out.Playlists = (out.Playlists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 int64
+					v4 = int64(in.Int64())
+					out.Playlists = append(out.Playlists, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -463,6 +497,45 @@ func easyjsonAe118d8fEncode20212LostPointerInternalModels3(out *jwriter.Writer,
 		}
 		out.Bool(bool(in.IsInFavorites))
 	}
+	if in.AddedAt != 0 {
+		const prefix string = ",\"added_at\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.AddedAt))
+	}
+	if in.HasLyrics {
+		const prefix string = ",\"has_lyrics\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.HasLyrics))
+	}
+	if len(in.Playlists) != 0 {
+		const prefix string = ",\"playlists\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Playlists {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v6))
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
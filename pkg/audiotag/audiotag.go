@@ -0,0 +1,107 @@
+package audiotag
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatMP3  = ".mp3"
+	FormatFLAC = ".flac"
+	FormatM4A  = ".m4a"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported audio format")
+	ErrInvalidFile       = errors.New("invalid audio file")
+)
+
+// Метаданные аудиофайла: теги, обложка и свойства потока. Длительность в секундах
+type Tags struct {
+	Title    string
+	Artist   string
+	Album    string
+	Genre    string
+	Number   int64
+	Year     int64
+	Cover    []byte
+	Duration int64
+	Lossless bool
+}
+
+// Читает теги файла, формат определяется по расширению
+func Read(reader io.Reader, format string) (*Tags, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(format) {
+	case FormatMP3:
+		return readMP3(data)
+	case FormatFLAC:
+		return readFLAC(data)
+	case FormatM4A:
+		return readMP4(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func IsSupported(format string) bool {
+	switch strings.ToLower(format) {
+	case FormatMP3, FormatFLAC, FormatM4A:
+		return true
+	default:
+		return false
+	}
+}
+
+var leadingNumber = regexp.MustCompile(`^\s*(\d+)`)
+
+// Номер трека вида "3" или "3/12", год вида "2013" или "2013-05-01"
+func parseLeadingNumber(value string) int64 {
+	match := leadingNumber.FindStringSubmatch(value)
+	if match == nil {
+		return 0
+	}
+	number, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
+var genreReference = regexp.MustCompile(`^\((\d+)\)(.*)$`)
+
+// Жанр может быть задан номером из списка ID3v1: "17", "(17)" или "(17)Rock"
+func parseGenre(value string) string {
+	value = strings.TrimSpace(value)
+	if match := genreReference.FindStringSubmatch(value); match != nil {
+		if refinement := strings.TrimSpace(match[2]); len(refinement) != 0 {
+			return refinement
+		}
+		value = match[1]
+	}
+	if index, err := strconv.Atoi(value); err == nil {
+		if index >= 0 && index < len(id3v1Genres) {
+			return id3v1Genres[index]
+		}
+		return ""
+	}
+	return value
+}
+
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop", "Jazz", "Metal",
+	"New Age", "Oldies", "Other", "Pop", "R&B", "Rap", "Reggae", "Rock", "Techno", "Industrial",
+	"Alternative", "Ska", "Death Metal", "Pranks", "Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk",
+	"Fusion", "Trance", "Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"AlternRock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock", "Ethnic", "Gothic",
+	"Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream", "Southern Rock", "Comedy", "Cult", "Gangsta",
+	"Top 40", "Christian Rap", "Pop/Funk", "Jungle", "Native American", "Cabaret", "New Wave", "Psychadelic", "Rave", "Showtunes",
+	"Trailer", "Lo-Fi", "Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+}
@@ -0,0 +1,223 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCover = []byte{0x89, 'P', 'N', 'G', 0x01, 0x02}
+
+func uint32Bytes(value uint32) []byte {
+	result := make([]byte, 4)
+	binary.BigEndian.PutUint32(result, value)
+	return result
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func id3Frame(id string, payload []byte) []byte {
+	return concat([]byte(id), uint32Bytes(uint32(len(payload))), []byte{0, 0}, payload)
+}
+
+func id3Tag(frames ...[]byte) []byte {
+	body := concat(frames...)
+	size := len(body)
+	return concat(
+		[]byte{'I', 'D', '3', 3, 0, 0},
+		[]byte{byte(size >> 21 & 0x7f), byte(size >> 14 & 0x7f), byte(size >> 7 & 0x7f), byte(size & 0x7f)},
+		body,
+	)
+}
+
+// Первый кадр MPEG-1 Layer III, 128 кбит/с, 44100 Гц, стерео
+func mpegFrame128(size int, extra []byte) []byte {
+	frame := make([]byte, size)
+	copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+	copy(frame[4+32:], extra)
+	return frame
+}
+
+func vorbisComment(comments ...string) []byte {
+	little := func(value int) []byte {
+		result := make([]byte, 4)
+		binary.LittleEndian.PutUint32(result, uint32(value))
+		return result
+	}
+	block := concat(little(len("vendor")), []byte("vendor"), little(len(comments)))
+	for _, comment := range comments {
+		block = concat(block, little(len(comment)), []byte(comment))
+	}
+	return block
+}
+
+func flacBlock(blockType byte, last bool, payload []byte) []byte {
+	if last {
+		blockType |= 0x80
+	}
+	size := len(payload)
+	return concat([]byte{blockType, byte(size >> 16), byte(size >> 8), byte(size)}, payload)
+}
+
+func flacStreamInfoBlock(sampleRate, totalSamples uint64) []byte {
+	block := make([]byte, 34)
+	info := sampleRate<<44 | 1<<41 | 15<<36 | totalSamples
+	binary.BigEndian.PutUint64(block[10:], info)
+	return block
+}
+
+func mp4Atom(name string, payloads ...[]byte) []byte {
+	payload := concat(payloads...)
+	return concat(uint32Bytes(uint32(len(payload)+8)), []byte(name), payload)
+}
+
+func mp4Item(name string, value []byte) []byte {
+	return mp4Atom(name, mp4Atom("data", make([]byte, 8), value))
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		data          []byte
+		expected      *Tags
+		expectedError error
+	}{
+		{
+			name:   "MP3 with Xing header",
+			format: ".MP3",
+			data: concat(
+				id3Tag(
+					id3Frame("TIT2", []byte("\x03Ghetto\x00")),
+					id3Frame("TPE1", []byte("\x00Face")),
+					id3Frame("TALB", []byte("\x03Hate Love")),
+					id3Frame("TRCK", []byte("\x033/12")),
+					id3Frame("TYER", []byte("\x032019")),
+					id3Frame("TCON", []byte("\x00(7)")),
+					id3Frame("APIC", concat([]byte("\x00image/png\x00\x03cover\x00"), testCover)),
+				),
+				mpegFrame128(417, concat([]byte("Xing"), uint32Bytes(1), uint32Bytes(1000))),
+			),
+			expected: &Tags{
+				Title:    "Ghetto",
+				Artist:   "Face",
+				Album:    "Hate Love",
+				Genre:    "Hip-Hop",
+				Number:   3,
+				Year:     2019,
+				Cover:    testCover,
+				Duration: 26,
+			},
+		},
+		{
+			name:   "Constant bitrate MP3 with UTF-16 tags",
+			format: ".mp3",
+			data: concat(
+				id3Tag(
+					id3Frame("TIT2", []byte{1, 0xff, 0xfe, 0x1f, 0x04, 0x35, 0x04, 0x41, 0x04, 0x3d, 0x04, 0x4f, 0x04, 0, 0}),
+					id3Frame("TCON", []byte("\x00Rap")),
+				),
+				mpegFrame128(160000, nil),
+			),
+			expected: &Tags{
+				Title:    "Песня",
+				Genre:    "Rap",
+				Duration: 10,
+			},
+		},
+		{
+			name:   "FLAC",
+			format: ".flac",
+			data: concat(
+				[]byte("fLaC"),
+				flacBlock(flacStreamInfo, false, flacStreamInfoBlock(44100, 44100*200)),
+				flacBlock(flacVorbisComment, false, vorbisComment(
+					"title=Ghetto", "ARTIST=Face", "ALBUM=Hate Love", "TRACKNUMBER=3", "DATE=2019-05-01", "GENRE=Rap",
+				)),
+				flacBlock(flacPicture, true, concat(
+					uint32Bytes(id3FrontCover), uint32Bytes(9), []byte("image/png"), uint32Bytes(0),
+					make([]byte, 16), uint32Bytes(uint32(len(testCover))), testCover,
+				)),
+			),
+			expected: &Tags{
+				Title:    "Ghetto",
+				Artist:   "Face",
+				Album:    "Hate Love",
+				Genre:    "Rap",
+				Number:   3,
+				Year:     2019,
+				Cover:    testCover,
+				Duration: 200,
+				Lossless: true,
+			},
+		},
+		{
+			name:   "M4A with ALAC",
+			format: ".m4a",
+			data: concat(
+				mp4Atom("ftyp", []byte("M4A "), uint32Bytes(0)),
+				mp4Atom("moov",
+					mp4Atom("mvhd", uint32Bytes(0), uint32Bytes(0), uint32Bytes(0), uint32Bytes(1000), uint32Bytes(185500)),
+					mp4Atom("trak", mp4Atom("mdia", mp4Atom("minf", mp4Atom("stbl", mp4Atom("stsd",
+						uint32Bytes(0), uint32Bytes(1), mp4Atom("alac", make([]byte, 28)),
+					))))),
+					mp4Atom("udta", mp4Atom("meta", uint32Bytes(0), mp4Atom("ilst",
+						mp4Item("\xa9nam", []byte("Ghetto")),
+						mp4Item("\xa9ART", []byte("Face")),
+						mp4Item("\xa9alb", []byte("Hate Love")),
+						mp4Item("trkn", []byte{0, 0, 0, 3, 0, 12, 0, 0}),
+						mp4Item("\xa9day", []byte("2019")),
+						mp4Item("gnre", []byte{0, 8}),
+						mp4Item("covr", testCover),
+					))),
+				),
+			),
+			expected: &Tags{
+				Title:    "Ghetto",
+				Artist:   "Face",
+				Album:    "Hate Love",
+				Genre:    "Hip-Hop",
+				Number:   3,
+				Year:     2019,
+				Cover:    testCover,
+				Duration: 185,
+				Lossless: true,
+			},
+		},
+		{
+			name:          "Broken FLAC",
+			format:        ".flac",
+			data:          []byte("fLaC\x00\x00\x00\x22"),
+			expectedError: ErrInvalidFile,
+		},
+		{
+			name:          "MP3 without audio frames",
+			format:        ".mp3",
+			data:          id3Tag(id3Frame("TIT2", []byte("\x03Ghetto"))),
+			expectedError: ErrInvalidFile,
+		},
+		{
+			name:          "Unsupported format",
+			format:        ".ogg",
+			data:          []byte("OggS"),
+			expectedError: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			tags, err := Read(bytes.NewReader(currentTest.data), currentTest.format)
+			if currentTest.expectedError != nil {
+				assert.ErrorIs(t, err, currentTest.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, tags)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+)
+
+const (
+	flacStreamInfo    = 0
+	flacVorbisComment = 4
+	flacPicture       = 6
+)
+
+func readFLAC(data []byte) (*Tags, error) {
+	// Некоторые кодировщики пишут ID3 перед потоком FLAC, его теги не используются
+	offset, err := readID3(data, &Tags{})
+	if err != nil {
+		return nil, err
+	}
+	data = data[offset:]
+	if len(data) < 4 || !bytes.Equal(data[:4], []byte("fLaC")) {
+		return nil, ErrInvalidFile
+	}
+
+	tags := &Tags{Lossless: true}
+	var (
+		cover        []byte
+		isFrontCover bool
+	)
+	for position, last := 4, false; !last; {
+		if position+4 > len(data) {
+			return nil, ErrInvalidFile
+		}
+		last = data[position]&0x80 != 0
+		blockType := data[position] & 0x7f
+		size := int(data[position+1])<<16 | int(data[position+2])<<8 | int(data[position+3])
+		position += 4
+		if position+size > len(data) {
+			return nil, ErrInvalidFile
+		}
+		block := data[position : position+size]
+		position += size
+
+		switch blockType {
+		case flacStreamInfo:
+			if len(block) < 18 {
+				return nil, ErrInvalidFile
+			}
+			info := binary.BigEndian.Uint64(block[10:18])
+			sampleRate := int64(info >> 44)
+			totalSamples := int64(info & (1<<36 - 1))
+			if sampleRate != 0 {
+				tags.Duration = totalSamples / sampleRate
+			}
+		case flacVorbisComment:
+			if err = readVorbisComment(block, tags); err != nil {
+				return nil, err
+			}
+		case flacPicture:
+			pictureType, picture := readFLACPicture(block)
+			if picture != nil && !isFrontCover {
+				cover = picture
+				isFrontCover = pictureType == id3FrontCover
+			}
+		}
+	}
+	tags.Cover = cover
+
+	return tags, nil
+}
+
+func readVorbisComment(block []byte, tags *Tags) error {
+	if len(block) < 4 {
+		return ErrInvalidFile
+	}
+	position := 4 + int(binary.LittleEndian.Uint32(block))
+	if position+4 > len(block) {
+		return ErrInvalidFile
+	}
+	count := int(binary.LittleEndian.Uint32(block[position:]))
+	position += 4
+
+	for i := 0; i < count; i++ {
+		if position+4 > len(block) {
+			return ErrInvalidFile
+		}
+		length := int(binary.LittleEndian.Uint32(block[position:]))
+		position += 4
+		if position+length > len(block) {
+			return ErrInvalidFile
+		}
+		comment := string(block[position : position+length])
+		position += length
+
+		separator := strings.IndexByte(comment, '=')
+		if separator == -1 {
+			continue
+		}
+		value := comment[separator+1:]
+		switch strings.ToUpper(comment[:separator]) {
+		case "TITLE":
+			tags.Title = value
+		case "ARTIST":
+			tags.Artist = value
+		case "ALBUM":
+			tags.Album = value
+		case "GENRE":
+			tags.Genre = parseGenre(value)
+		case "TRACKNUMBER":
+			tags.Number = parseLeadingNumber(value)
+		case "DATE", "YEAR":
+			tags.Year = parseLeadingNumber(value)
+		}
+	}
+	return nil
+}
+
+func readFLACPicture(block []byte) (uint32, []byte) {
+	position := 0
+	field := func() (uint32, bool) {
+		if position+4 > len(block) {
+			return 0, false
+		}
+		value := binary.BigEndian.Uint32(block[position:])
+		position += 4
+		return value, true
+	}
+
+	pictureType, ok := field()
+	if !ok {
+		return 0, nil
+	}
+	// MIME-тип и описание
+	for i := 0; i < 2; i++ {
+		length, ok := field()
+		if !ok || position+int(length) > len(block) {
+			return 0, nil
+		}
+		position += int(length)
+	}
+	// Ширина, высота, глубина цвета и размер палитры
+	position += 16
+	length, ok := field()
+	if !ok || length == 0 || position+int(length) > len(block) {
+		return 0, nil
+	}
+	return pictureType, block[position : position+int(length)]
+}
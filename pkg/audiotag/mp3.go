@@ -0,0 +1,267 @@
+package audiotag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+const (
+	id3HeaderSize  = 10
+	id3FrontCover  = 3
+	mpegHeaderSize = 4
+)
+
+var (
+	mpeg1LayerIIIBitrates = []int64{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+	mpeg2LayerIIIBitrates = []int64{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160}
+	mpeg1SampleRates      = []int64{44100, 48000, 32000}
+)
+
+func readMP3(data []byte) (*Tags, error) {
+	tags := &Tags{}
+	offset, err := readID3(data, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := mp3Duration(data, offset)
+	if err != nil {
+		return nil, err
+	}
+	tags.Duration = duration
+
+	return tags, nil
+}
+
+func syncsafe(data []byte) int {
+	return int(data[0]&0x7f)<<21 | int(data[1]&0x7f)<<14 | int(data[2]&0x7f)<<7 | int(data[3]&0x7f)
+}
+
+// Разбирает тег ID3v2.3/2.4 в начале файла и возвращает смещение, с которого начинается звук
+func readID3(data []byte, tags *Tags) (int, error) {
+	if len(data) < id3HeaderSize || !bytes.Equal(data[:3], []byte("ID3")) {
+		return 0, nil
+	}
+	version := data[3]
+	flags := data[5]
+	end := id3HeaderSize + syncsafe(data[6:10])
+	if flags&0x10 != 0 {
+		end += id3HeaderSize
+	}
+	if end > len(data) {
+		return 0, ErrInvalidFile
+	}
+	if version != 3 && version != 4 {
+		return end, nil
+	}
+
+	position := id3HeaderSize
+	if flags&0x40 != 0 && position+4 <= end {
+		if version == 4 {
+			position += syncsafe(data[position : position+4])
+		} else {
+			position += 4 + int(binary.BigEndian.Uint32(data[position:position+4]))
+		}
+	}
+
+	var cover []byte
+	for position+id3HeaderSize <= end {
+		id := string(data[position : position+4])
+		if id[0] == 0 {
+			break
+		}
+		var size int
+		if version == 4 {
+			size = syncsafe(data[position+4 : position+8])
+		} else {
+			size = int(binary.BigEndian.Uint32(data[position+4 : position+8]))
+		}
+		position += id3HeaderSize
+		if size < 0 || position+size > end {
+			return 0, ErrInvalidFile
+		}
+		frame := data[position : position+size]
+		position += size
+		if len(frame) == 0 {
+			continue
+		}
+
+		switch id {
+		case "TIT2":
+			tags.Title = decodeID3Text(frame)
+		case "TPE1":
+			tags.Artist = decodeID3Text(frame)
+		case "TALB":
+			tags.Album = decodeID3Text(frame)
+		case "TCON":
+			tags.Genre = parseGenre(decodeID3Text(frame))
+		case "TRCK":
+			tags.Number = parseLeadingNumber(decodeID3Text(frame))
+		case "TYER", "TDRC":
+			tags.Year = parseLeadingNumber(decodeID3Text(frame))
+		case "APIC":
+			pictureType, picture := decodeID3Picture(frame)
+			if picture != nil && (cover == nil || pictureType == id3FrontCover) {
+				cover = picture
+			}
+		}
+	}
+	tags.Cover = cover
+
+	return end, nil
+}
+
+// Ищет конец строки с учётом кодировки: для UTF-16 терминатор занимает два байта
+func splitID3String(encoding byte, data []byte) ([]byte, []byte) {
+	if encoding == 1 || encoding == 2 {
+		for i := 0; i+1 < len(data); i += 2 {
+			if data[i] == 0 && data[i+1] == 0 {
+				return data[:i], data[i+2:]
+			}
+		}
+		return data, nil
+	}
+	if i := bytes.IndexByte(data, 0); i != -1 {
+		return data[:i], data[i+1:]
+	}
+	return data, nil
+}
+
+func decodeID3Text(frame []byte) string {
+	encoding := frame[0]
+	value, _ := splitID3String(encoding, frame[1:])
+	return decodeID3String(encoding, value)
+}
+
+func decodeID3String(encoding byte, data []byte) string {
+	switch encoding {
+	case 0:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	case 1, 2:
+		bigEndian := encoding == 2
+		if len(data) >= 2 {
+			switch {
+			case data[0] == 0xfe && data[1] == 0xff:
+				bigEndian, data = true, data[2:]
+			case data[0] == 0xff && data[1] == 0xfe:
+				bigEndian, data = false, data[2:]
+			}
+		}
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			if bigEndian {
+				units[i] = binary.BigEndian.Uint16(data[2*i:])
+			} else {
+				units[i] = binary.LittleEndian.Uint16(data[2*i:])
+			}
+		}
+		return string(utf16.Decode(units))
+	default:
+		return string(data)
+	}
+}
+
+func decodeID3Picture(frame []byte) (byte, []byte) {
+	encoding := frame[0]
+	_, rest := splitID3String(0, frame[1:])
+	if len(rest) == 0 {
+		return 0, nil
+	}
+	pictureType := rest[0]
+	_, picture := splitID3String(encoding, rest[1:])
+	if len(picture) == 0 {
+		return 0, nil
+	}
+	return pictureType, picture
+}
+
+type mpegFrame struct {
+	mpeg1      bool
+	mono       bool
+	bitrate    int64
+	sampleRate int64
+}
+
+func (frame mpegFrame) samples() int64 {
+	if frame.mpeg1 {
+		return 1152
+	}
+	return 576
+}
+
+func (frame mpegFrame) sideInfoSize() int {
+	switch {
+	case frame.mpeg1 && frame.mono:
+		return 17
+	case frame.mpeg1:
+		return 32
+	case frame.mono:
+		return 9
+	default:
+		return 17
+	}
+}
+
+// Заголовок кадра MPEG Layer III
+func parseMPEGFrame(header []byte) (mpegFrame, bool) {
+	if header[0] != 0xff || header[1]&0xe0 != 0xe0 {
+		return mpegFrame{}, false
+	}
+	version := (header[1] >> 3) & 0x03
+	layer := (header[1] >> 1) & 0x03
+	bitrateIndex := header[2] >> 4
+	sampleRateIndex := (header[2] >> 2) & 0x03
+	if version == 1 || layer != 1 || bitrateIndex == 0 || bitrateIndex == 0x0f || sampleRateIndex == 3 {
+		return mpegFrame{}, false
+	}
+
+	frame := mpegFrame{
+		mpeg1:      version == 3,
+		mono:       header[3]>>6 == 3,
+		sampleRate: mpeg1SampleRates[sampleRateIndex],
+	}
+	if frame.mpeg1 {
+		frame.bitrate = mpeg1LayerIIIBitrates[bitrateIndex]
+	} else {
+		frame.bitrate = mpeg2LayerIIIBitrates[bitrateIndex]
+		frame.sampleRate /= 2
+		if version == 0 {
+			frame.sampleRate /= 2
+		}
+	}
+	return frame, true
+}
+
+// Длительность берётся из заголовка Xing/Info или VBRI, иначе оценивается по битрейту первого кадра
+func mp3Duration(data []byte, offset int) (int64, error) {
+	for ; offset+mpegHeaderSize <= len(data); offset++ {
+		frame, ok := parseMPEGFrame(data[offset : offset+mpegHeaderSize])
+		if !ok {
+			continue
+		}
+
+		xing := offset + mpegHeaderSize + frame.sideInfoSize()
+		if xing+12 <= len(data) {
+			tag := string(data[xing : xing+4])
+			flags := binary.BigEndian.Uint32(data[xing+4 : xing+8])
+			if (tag == "Xing" || tag == "Info") && flags&0x01 != 0 {
+				frames := int64(binary.BigEndian.Uint32(data[xing+8 : xing+12]))
+				return frames * frame.samples() / frame.sampleRate, nil
+			}
+		}
+
+		vbri := offset + mpegHeaderSize + 32
+		if vbri+18 <= len(data) && string(data[vbri:vbri+4]) == "VBRI" {
+			frames := int64(binary.BigEndian.Uint32(data[vbri+14 : vbri+18]))
+			return frames * frame.samples() / frame.sampleRate, nil
+		}
+
+		return int64(len(data)-offset) * 8 / (frame.bitrate * 1000), nil
+	}
+	return 0, ErrInvalidFile
+}
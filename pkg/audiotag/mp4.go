@@ -0,0 +1,146 @@
+package audiotag
+
+import (
+	"encoding/binary"
+)
+
+const mp4BoxHeaderSize = 8
+
+// Возвращает содержимое вложенных боксов по пути, например "moov", "udta", "meta"
+func findMP4Box(data []byte, path ...string) []byte {
+	for _, name := range path {
+		box, found := childMP4Box(data, name)
+		if !found {
+			return nil
+		}
+		if name == "meta" {
+			// meta является full box: перед дочерними боксами идут версия и флаги
+			if len(box) < 4 {
+				return nil
+			}
+			box = box[4:]
+		}
+		data = box
+	}
+	return data
+}
+
+func childMP4Box(data []byte, name string) ([]byte, bool) {
+	for _, box := range splitMP4Boxes(data) {
+		if box.name == name {
+			return box.payload, true
+		}
+	}
+	return nil, false
+}
+
+type mp4Box struct {
+	name    string
+	payload []byte
+}
+
+func splitMP4Boxes(data []byte) []mp4Box {
+	var boxes []mp4Box
+	for position := 0; position+mp4BoxHeaderSize <= len(data); {
+		size := uint64(binary.BigEndian.Uint32(data[position:]))
+		name := string(data[position+4 : position+8])
+		headerSize := uint64(mp4BoxHeaderSize)
+		switch size {
+		case 0:
+			size = uint64(len(data) - position)
+		case 1:
+			if position+16 > len(data) {
+				return boxes
+			}
+			size = binary.BigEndian.Uint64(data[position+8:])
+			headerSize = 16
+		}
+		if size < headerSize || uint64(position)+size > uint64(len(data)) {
+			return boxes
+		}
+		boxes = append(boxes, mp4Box{
+			name:    name,
+			payload: data[uint64(position)+headerSize : uint64(position)+size],
+		})
+		position += int(size)
+	}
+	return boxes
+}
+
+func readMP4(data []byte) (*Tags, error) {
+	if _, found := childMP4Box(data, "ftyp"); !found {
+		return nil, ErrInvalidFile
+	}
+	moov, found := childMP4Box(data, "moov")
+	if !found {
+		return nil, ErrInvalidFile
+	}
+
+	tags := &Tags{}
+	if header := findMP4Box(moov, "mvhd"); len(header) != 0 {
+		var timescale, duration uint64
+		if header[0] == 1 && len(header) >= 32 {
+			timescale = uint64(binary.BigEndian.Uint32(header[20:]))
+			duration = binary.BigEndian.Uint64(header[24:])
+		} else if len(header) >= 20 {
+			timescale = uint64(binary.BigEndian.Uint32(header[12:]))
+			duration = uint64(binary.BigEndian.Uint32(header[16:]))
+		}
+		if timescale != 0 {
+			tags.Duration = int64(duration / timescale)
+		}
+	}
+
+	for _, track := range splitMP4Boxes(moov) {
+		if track.name != "trak" {
+			continue
+		}
+		// stsd является full box: версия, флаги и число записей, затем описания кодеков
+		description := findMP4Box(track.payload, "mdia", "minf", "stbl", "stsd")
+		if len(description) < 16 {
+			continue
+		}
+		switch string(description[12:16]) {
+		case "alac", "fLaC":
+			tags.Lossless = true
+		}
+	}
+
+	for _, item := range splitMP4Boxes(findMP4Box(moov, "udta", "meta", "ilst")) {
+		value := findMP4Box(item.payload, "data")
+		// Тип значения и локаль
+		if len(value) < 8 {
+			continue
+		}
+		value = value[8:]
+
+		switch item.name {
+		case "\xa9nam":
+			tags.Title = string(value)
+		case "\xa9ART":
+			tags.Artist = string(value)
+		case "\xa9alb":
+			tags.Album = string(value)
+		case "\xa9gen":
+			tags.Genre = parseGenre(string(value))
+		case "gnre":
+			if len(value) >= 2 {
+				if index := int(binary.BigEndian.Uint16(value)); index > 0 && index <= len(id3v1Genres) {
+					tags.Genre = id3v1Genres[index-1]
+				}
+			}
+		case "\xa9day":
+			tags.Year = parseLeadingNumber(string(value))
+		case "trkn":
+			if len(value) >= 4 {
+				tags.Number = int64(binary.BigEndian.Uint16(value[2:]))
+			}
+		case "covr":
+			if len(value) != 0 {
+				tags.Cover = value
+			}
+		}
+	}
+
+	return tags, nil
+}
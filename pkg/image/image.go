@@ -28,7 +28,6 @@ func NewImagesService() ImagesService {
 	return ImagesService{}
 }
 
-//nolint:cyclop
 func (service *ImagesService) CreateImages(fileHeader *multipart.FileHeader, path string, extensions map[int]string) (*models.ImageData, error) {
 	file, err := fileHeader.Open()
 	if err != nil {
@@ -37,6 +36,14 @@ func (service *ImagesService) CreateImages(fileHeader *multipart.FileHeader, pat
 	defer func(f multipart.File) {
 		_ = f.Close()
 	}(file)
+
+	return service.CreateImagesFromReader(file, path, extensions)
+}
+
+// Создаёт изображения из произвольного источника, например из обложки, встроенной в аудиофайл
+//
+//nolint:cyclop
+func (service *ImagesService) CreateImagesFromReader(file io.ReadSeeker, path string, extensions map[int]string) (*models.ImageData, error) {
 	reader := bufio.NewReader(file)
 	src, err := imgconv.Decode(reader)
 	if err != nil {
@@ -44,7 +51,7 @@ func (service *ImagesService) CreateImages(fileHeader *multipart.FileHeader, pat
 	}
 
 	// Получаем размеры изображения
-	_, err = file.Seek(0, 0)
+	_, err = file.Seek(0, io.SeekStart)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,177 @@
+package lrc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrNoLines = errors.New("lyrics have no timed lines")
+
+// Строка синхронизированного текста, время в миллисекундах от начала трека
+type Line struct {
+	Time int64  `json:"time"`
+	Text string `json:"text"`
+}
+
+// Ошибка разбора LRC, номер строки считается с единицы
+type SyntaxError struct {
+	Line    int
+	Message string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", err.Line, err.Message)
+}
+
+// Разбирает текст в формате LRC. Строка может содержать несколько меток времени ([00:12.50][01:40.00]припев),
+// теги вида [ar:...] пропускаются, [offset:...] сдвигает все строки. Строки возвращаются по возрастанию времени
+//
+//nolint:cyclop
+func Parse(text string) ([]Line, error) {
+	var (
+		lines  []Line
+		offset int64
+	)
+	for number, raw := range strings.Split(text, "\n") {
+		raw = strings.TrimSpace(raw)
+		if len(raw) == 0 {
+			continue
+		}
+		if raw[0] != '[' {
+			return nil, &SyntaxError{Line: number + 1, Message: "line has no timestamp"}
+		}
+
+		times := make([]int64, 0, 1)
+		for strings.HasPrefix(raw, "[") {
+			end := strings.IndexByte(raw, ']')
+			if end == -1 {
+				return nil, &SyntaxError{Line: number + 1, Message: "unterminated tag"}
+			}
+			tag := raw[1:end]
+			raw = strings.TrimSpace(raw[end+1:])
+
+			if time, isTime := parseTime(tag); isTime {
+				times = append(times, time)
+				continue
+			}
+			if len(times) != 0 {
+				return nil, &SyntaxError{Line: number + 1, Message: "invalid timestamp " + tag}
+			}
+			key, value, isTag := splitTag(tag)
+			if !isTag {
+				return nil, &SyntaxError{Line: number + 1, Message: "invalid tag " + tag}
+			}
+			if key == "offset" {
+				var err error
+				if offset, err = strconv.ParseInt(strings.TrimPrefix(value, "+"), 10, 64); err != nil {
+					return nil, &SyntaxError{Line: number + 1, Message: "invalid offset " + value}
+				}
+			}
+		}
+
+		if len(times) == 0 {
+			if len(raw) != 0 {
+				return nil, &SyntaxError{Line: number + 1, Message: "line has no timestamp"}
+			}
+			continue
+		}
+		for _, time := range times {
+			lines = append(lines, Line{Time: time, Text: raw})
+		}
+	}
+	if len(lines) == 0 {
+		return nil, ErrNoLines
+	}
+
+	// Положительное смещение показывает текст раньше
+	for i := range lines {
+		lines[i].Time -= offset
+		if lines[i].Time < 0 {
+			lines[i].Time = 0
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Time < lines[j].Time
+	})
+
+	return lines, nil
+}
+
+// Собирает обычный текст из синхронизированных строк, пустые строки (проигрыши) пропускаются
+func Plain(lines []Line) string {
+	texts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if len(line.Text) != 0 {
+			texts = append(texts, line.Text)
+		}
+	}
+
+	return strings.Join(texts, "\n")
+}
+
+// Метка времени вида mm:ss, mm:ss.xx или mm:ss.xxx
+func parseTime(tag string) (int64, bool) {
+	minutesPart, secondsPart, found := cut(tag, ":")
+	if !found || len(minutesPart) == 0 || !isDigits(minutesPart) {
+		return 0, false
+	}
+	secondsPart, fractionPart, hasFraction := cut(secondsPart, ".")
+	if !hasFraction {
+		secondsPart, fractionPart, hasFraction = cut(secondsPart, ":")
+	}
+	if len(secondsPart) != 2 || !isDigits(secondsPart) {
+		return 0, false
+	}
+	if hasFraction && (len(fractionPart) == 0 || len(fractionPart) > 3 || !isDigits(fractionPart)) {
+		return 0, false
+	}
+
+	minutes, _ := strconv.ParseInt(minutesPart, 10, 64)
+	seconds, _ := strconv.ParseInt(secondsPart, 10, 64)
+	if seconds >= 60 {
+		return 0, false
+	}
+	var milliseconds int64
+	if hasFraction {
+		milliseconds, _ = strconv.ParseInt(fractionPart, 10, 64)
+		for i := len(fractionPart); i < 3; i++ {
+			milliseconds *= 10
+		}
+	}
+
+	return (minutes*60+seconds)*1000 + milliseconds, true
+}
+
+// Тег метаданных вида [ar:Исполнитель]
+func splitTag(tag string) (string, string, bool) {
+	key, value, found := cut(tag, ":")
+	if !found || len(key) == 0 {
+		return "", "", false
+	}
+	for _, symbol := range key {
+		if !(symbol >= 'a' && symbol <= 'z' || symbol >= 'A' && symbol <= 'Z' || symbol == '#') {
+			return "", "", false
+		}
+	}
+
+	return strings.ToLower(key), strings.TrimSpace(value), true
+}
+
+func cut(s string, separator string) (string, string, bool) {
+	if i := strings.Index(s, separator); i >= 0 {
+		return s[:i], s[i+len(separator):], true
+	}
+	return s, "", false
+}
+
+func isDigits(s string) bool {
+	for _, symbol := range s {
+		if symbol < '0' || symbol > '9' {
+			return false
+		}
+	}
+	return true
+}
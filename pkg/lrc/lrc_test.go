@@ -0,0 +1,81 @@
+package lrc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		text          string
+		expected      []Line
+		expectedError string
+	}{
+		{
+			name: "lines with metadata",
+			text: "[ar:Face]\n[ti:Burger]\r\n\n[00:12.50]First line\n[00:15.123] Second line\n[01:02]",
+			expected: []Line{
+				{Time: 12500, Text: "First line"},
+				{Time: 15123, Text: "Second line"},
+				{Time: 62000, Text: ""},
+			},
+		},
+		{
+			name: "several timestamps are sorted",
+			text: "[00:40.00][00:10.00]Chorus\n[00:20.00]Verse",
+			expected: []Line{
+				{Time: 10000, Text: "Chorus"},
+				{Time: 20000, Text: "Verse"},
+				{Time: 40000, Text: "Chorus"},
+			},
+		},
+		{
+			name: "offset shifts lines",
+			text: "[offset:+500]\n[00:00.20]Start\n[00:01.00]Next",
+			expected: []Line{
+				{Time: 0, Text: "Start"},
+				{Time: 500, Text: "Next"},
+			},
+		},
+		{
+			name:          "line without timestamp",
+			text:          "[00:01.00]Start\nplain text",
+			expectedError: "line 2: line has no timestamp",
+		},
+		{
+			name:          "invalid timestamp",
+			text:          "[00:01.00][00:99]Start",
+			expectedError: "line 1: invalid timestamp 00:99",
+		},
+		{
+			name:          "unterminated tag",
+			text:          "[00:01.00",
+			expectedError: "line 1: unterminated tag",
+		},
+		{
+			name:          "only metadata",
+			text:          "[ar:Face]\n[ti:Burger]",
+			expectedError: ErrNoLines.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			lines, err := Parse(currentTest.text)
+			if len(currentTest.expectedError) != 0 {
+				assert.EqualError(t, err, currentTest.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, lines)
+			}
+		})
+	}
+}
+
+func TestPlain(t *testing.T) {
+	lines := []Line{{Time: 0, Text: "First"}, {Time: 1000, Text: ""}, {Time: 2000, Text: "Second"}}
+	assert.Equal(t, "First\nSecond", Plain(lines))
+}
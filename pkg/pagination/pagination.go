@@ -0,0 +1,262 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Type - тип значения ключа в курсоре. Большинство ключей - идентификаторы и счетчики,
+// поэтому по умолчанию значение целое.
+type Type int
+
+const (
+	Integer Type = iota
+	Float
+	Text
+	// Время в формате RFC3339Nano
+	Time
+)
+
+// Key - колонка (или выражение), по которой упорядочен список.
+type Key struct {
+	Column string
+	Desc   bool
+	Type   Type
+}
+
+// Cursor хранит значения ключей последней отданной строки и общее число строк,
+// посчитанное на первой странице.
+type Cursor struct {
+	Values []interface{} `json:"v"`
+	Total  int64         `json:"t"`
+}
+
+// Part - состояние одного из списков в составном курсоре.
+// Пустой Cursor у незаконченного списка означает, что список читается с начала.
+type Part struct {
+	Cursor string `json:"c,omitempty"`
+	Total  int64  `json:"t"`
+	Done   bool   `json:"d,omitempty"`
+}
+
+// Page - готовые части запроса для одной страницы списка.
+type Page struct {
+	Cursor *Cursor
+	Where  string
+	Order  string
+	Args   []interface{}
+	Limit  int64
+}
+
+func NewPage(keys []Key, encoded string, limit int64, args ...interface{}) (*Page, error) {
+	cursor, err := Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	where, args, err := Where(keys, cursor, args)
+	if err != nil {
+		return nil, err
+	}
+	order := "ORDER BY " + OrderBy(keys)
+	limitClause, args := Limit(limit, args)
+	if len(limitClause) != 0 {
+		order += " " + limitClause
+	}
+
+	return &Page{
+		Cursor: cursor,
+		Where:  where,
+		Order:  order,
+		Args:   args,
+		Limit:  limit,
+	}, nil
+}
+
+// HasMore сообщает, нашлась ли лишняя строка сверх размера страницы.
+func (page *Page) HasMore(fetched int) bool {
+	return page.Limit > 0 && int64(fetched) > page.Limit
+}
+
+func (page *Page) Next(total int64, last []interface{}, hasMore bool) (string, int64) {
+	return Next(page.Cursor, total, last, hasMore)
+}
+
+func Encode(cursor *Cursor) string {
+	return encode(cursor)
+}
+
+func Decode(encoded string) (*Cursor, error) {
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	cursor := &Cursor{}
+	if err := decode(encoded, cursor); err != nil {
+		return nil, err
+	}
+	for i, value := range cursor.Values {
+		number, ok := value.(json.Number)
+		if !ok {
+			continue
+		}
+		if integer, err := number.Int64(); err == nil {
+			cursor.Values[i] = integer
+		} else if float, err := number.Float64(); err == nil {
+			cursor.Values[i] = float
+		} else {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return cursor, nil
+}
+
+func EncodeParts(parts map[string]Part) string {
+	for _, part := range parts {
+		if !part.Done {
+			return encode(parts)
+		}
+	}
+	return ""
+}
+
+func DecodeParts(encoded string) (map[string]Part, error) {
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	parts := make(map[string]Part)
+	if err := decode(encoded, &parts); err != nil {
+		return nil, err
+	}
+
+	return parts, nil
+}
+
+// Where возвращает условие keyset-пагинации для строк после курсора.
+// Плейсхолдеры нумеруются после уже имеющихся аргументов запроса.
+// Курсор приходит от клиента, поэтому значения неподходящего ключу типа отклоняются до запроса.
+func Where(keys []Key, cursor *Cursor, args []interface{}) (string, []interface{}, error) {
+	if cursor == nil {
+		return "TRUE", args, nil
+	}
+	if len(cursor.Values) != len(keys) {
+		return "", nil, ErrInvalidCursor
+	}
+
+	placeholders := make([]string, 0, len(keys))
+	for i, value := range cursor.Values {
+		checked, ok := keys[i].Type.check(value)
+		if !ok {
+			return "", nil, ErrInvalidCursor
+		}
+		args = append(args, checked)
+		placeholders = append(placeholders, "$"+strconv.Itoa(len(args)))
+	}
+
+	condition := ""
+	for i := len(keys) - 1; i >= 0; i-- {
+		operator := " > "
+		if keys[i].Desc {
+			operator = " < "
+		}
+		current := keys[i].Column + operator + placeholders[i]
+		if len(condition) != 0 {
+			current = "(" + current + " OR (" + keys[i].Column + " = " + placeholders[i] + " AND " + condition + "))"
+		}
+		condition = current
+	}
+
+	return condition, args, nil
+}
+
+// Целые значения дробных ключей приходят из JSON как int64 и приводятся к float64.
+func (valueType Type) check(value interface{}) (interface{}, bool) {
+	switch valueType {
+	case Float:
+		switch number := value.(type) {
+		case float64:
+			return number, true
+		case int64:
+			return float64(number), true
+		}
+		return nil, false
+	case Text:
+		text, ok := value.(string)
+		return text, ok
+	case Time:
+		text, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		if _, err := time.Parse(time.RFC3339Nano, text); err != nil {
+			return nil, false
+		}
+		return text, true
+	default:
+		integer, ok := value.(int64)
+		return integer, ok
+	}
+}
+
+func OrderBy(keys []Key) string {
+	columns := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.Desc {
+			columns = append(columns, key.Column+" DESC")
+		} else {
+			columns = append(columns, key.Column)
+		}
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// Limit запрашивает на одну строку больше, чтобы понять, есть ли следующая страница.
+// Нулевой лимит означает весь список одной страницей.
+func Limit(limit int64, args []interface{}) (string, []interface{}) {
+	if limit <= 0 {
+		return "", args
+	}
+	args = append(args, limit+1)
+	return "LIMIT $" + strconv.Itoa(len(args)), args
+}
+
+// Next возвращает курсор следующей страницы (пустой, если страница последняя) и общее число строк.
+func Next(cursor *Cursor, total int64, last []interface{}, hasMore bool) (string, int64) {
+	if cursor != nil {
+		total = cursor.Total
+	}
+	if !hasMore {
+		return "", total
+	}
+
+	return Encode(&Cursor{Values: last, Total: total}), total
+}
+
+func encode(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decode(encoded string, value interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(value); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name          string
+		encoded       string
+		expected      *Cursor
+		expectedError bool
+	}{
+		{
+			name:     "empty cursor",
+			encoded:  "",
+			expected: nil,
+		},
+		{
+			name:     "numbers and strings",
+			encoded:  Encode(&Cursor{Values: []interface{}{int64(10), 1.5, "title"}, Total: 30}),
+			expected: &Cursor{Values: []interface{}{int64(10), 1.5, "title"}, Total: 30},
+		},
+		{
+			name:          "not base64",
+			encoded:       "!!!",
+			expectedError: true,
+		},
+		{
+			name:          "not json",
+			encoded:       "cXdl",
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			cursor, err := Decode(currentTest.encoded)
+			if currentTest.expectedError {
+				assert.ErrorIs(t, err, ErrInvalidCursor)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, cursor)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	keys := []Key{{Column: "t.listen_count", Desc: true}, {Column: "t.id"}}
+
+	tests := []struct {
+		name          string
+		keys          []Key
+		cursor        *Cursor
+		args          []interface{}
+		expected      string
+		expectedArgs  []interface{}
+		expectedError bool
+	}{
+		{
+			name:         "first page",
+			keys:         keys,
+			args:         []interface{}{1},
+			expected:     "TRUE",
+			expectedArgs: []interface{}{1},
+		},
+		{
+			name:         "single key",
+			keys:         []Key{{Column: "l.id"}},
+			cursor:       &Cursor{Values: []interface{}{int64(5)}},
+			args:         []interface{}{1},
+			expected:     "l.id > $2",
+			expectedArgs: []interface{}{1, int64(5)},
+		},
+		{
+			name:         "mixed directions",
+			keys:         keys,
+			cursor:       &Cursor{Values: []interface{}{int64(10), int64(7)}},
+			args:         []interface{}{1, 2},
+			expected:     "(t.listen_count < $3 OR (t.listen_count = $3 AND t.id > $4))",
+			expectedArgs: []interface{}{1, 2, int64(10), int64(7)},
+		},
+		{
+			name:          "cursor does not match keys",
+			keys:          keys,
+			cursor:        &Cursor{Values: []interface{}{int64(10)}},
+			expectedError: true,
+		},
+		{
+			name:         "typed keys",
+			keys:         []Key{{Column: "score", Desc: true, Type: Float}, {Column: "t.title", Type: Text}, {Column: "l.created_at", Type: Time}},
+			cursor:       &Cursor{Values: []interface{}{int64(1), "Lahaine", "2021-12-06T12:00:00.5Z"}},
+			expected:     "(score < $1 OR (score = $1 AND (t.title > $2 OR (t.title = $2 AND l.created_at > $3))))",
+			expectedArgs: []interface{}{float64(1), "Lahaine", "2021-12-06T12:00:00.5Z"},
+		},
+		{
+			name:          "string instead of integer",
+			keys:          keys,
+			cursor:        &Cursor{Values: []interface{}{int64(10), "7"}},
+			expectedError: true,
+		},
+		{
+			name:          "float instead of integer",
+			keys:          keys,
+			cursor:        &Cursor{Values: []interface{}{1.5, int64(7)}},
+			expectedError: true,
+		},
+		{
+			name:          "integer instead of text",
+			keys:          []Key{{Column: "t.title", Type: Text}},
+			cursor:        &Cursor{Values: []interface{}{int64(10)}},
+			expectedError: true,
+		},
+		{
+			name:          "text instead of time",
+			keys:          []Key{{Column: "l.created_at", Type: Time}},
+			cursor:        &Cursor{Values: []interface{}{"yesterday"}},
+			expectedError: true,
+		},
+		{
+			name:          "null value",
+			keys:          []Key{{Column: "t.id"}},
+			cursor:        &Cursor{Values: []interface{}{nil}},
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			where, args, err := Where(currentTest.keys, currentTest.cursor, currentTest.args)
+			if currentTest.expectedError {
+				assert.ErrorIs(t, err, ErrInvalidCursor)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, currentTest.expected, where)
+				assert.Equal(t, currentTest.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	keys := []Key{{Column: "alb.year", Desc: true}, {Column: "alb.id"}}
+	cursor := &Cursor{Values: []interface{}{int64(2021), int64(3)}, Total: 12}
+
+	page, err := NewPage(keys, Encode(cursor), 5, "%text%")
+	assert.NoError(t, err)
+	assert.Equal(t, "(alb.year < $2 OR (alb.year = $2 AND alb.id > $3))", page.Where)
+	assert.Equal(t, "ORDER BY alb.year DESC, alb.id LIMIT $4", page.Order)
+	assert.Equal(t, []interface{}{"%text%", int64(2021), int64(3), int64(6)}, page.Args)
+	assert.False(t, page.HasMore(5))
+	assert.True(t, page.HasMore(6))
+
+	next, total := page.Next(0, []interface{}{int64(2020), int64(8)}, true)
+	assert.Equal(t, Encode(&Cursor{Values: []interface{}{int64(2020), int64(8)}, Total: 12}), next)
+	assert.Equal(t, int64(12), total)
+
+	next, total = page.Next(0, nil, false)
+	assert.Equal(t, "", next)
+	assert.Equal(t, int64(12), total)
+
+	_, err = NewPage(keys, "qwe", 5)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	tampered := Encode(&Cursor{Values: []interface{}{"2021) OR (TRUE", int64(3)}, Total: 12})
+	_, err = NewPage(keys, tampered, 5)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestNewPageWithoutLimit(t *testing.T) {
+	page, err := NewPage([]Key{{Column: "alb.id"}}, "", 0, int64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "ORDER BY alb.id", page.Order)
+	assert.Equal(t, []interface{}{int64(1)}, page.Args)
+	assert.False(t, page.HasMore(1000))
+}
+
+func TestParts(t *testing.T) {
+	parts := map[string]Part{
+		"tracks": {Cursor: "cursor", Total: 10},
+		"albums": {Total: 2, Done: true},
+	}
+
+	decoded, err := DecodeParts(EncodeParts(parts))
+	assert.NoError(t, err)
+	assert.Equal(t, parts, decoded)
+
+	assert.Equal(t, "", EncodeParts(map[string]Part{"tracks": {Done: true}}))
+
+	decoded, err = DecodeParts("")
+	assert.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	_, err = DecodeParts("!!!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,21 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+func Every(ctx context.Context, interval time.Duration, job func()) {
+	job()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			job()
+		}
+	}
+}
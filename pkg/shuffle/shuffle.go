@@ -0,0 +1,50 @@
+package shuffle
+
+import "math/rand"
+
+// Permutation - перестановка позиций 0..n-1 вида (shift + i * step) mod n.
+// Шаг взаимно прост с n, поэтому каждая позиция встречается ровно один раз,
+// а одинаковый seed дает одинаковый порядок, что позволяет листать выборку страницами.
+type Permutation struct {
+	size  int64
+	step  int64
+	shift int64
+}
+
+func New(size int64, seed int64) *Permutation {
+	if size <= 0 {
+		return &Permutation{}
+	}
+
+	random := rand.New(rand.NewSource(seed)) //nolint:gosec
+	step := 1 + random.Int63n(size)
+	for gcd(step, size) != 1 {
+		step = step%size + 1
+	}
+
+	return &Permutation{size: size, step: step, shift: random.Int63n(size)}
+}
+
+// Positions - позиции с offset по offset+amount, не выходя за размер перестановки
+func (permutation *Permutation) Positions(offset int64, amount int64) []int64 {
+	if offset < 0 || offset >= permutation.size {
+		return []int64{}
+	}
+	if offset+amount > permutation.size {
+		amount = permutation.size - offset
+	}
+
+	positions := make([]int64, 0, amount)
+	for i := offset; i < offset+amount; i++ {
+		positions = append(positions, (permutation.shift+i*permutation.step)%permutation.size)
+	}
+
+	return positions
+}
+
+func gcd(a int64, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,57 @@
+package shuffle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermutation_Positions(t *testing.T) {
+	tests := []struct {
+		name string
+		size int64
+		seed int64
+	}{
+		{
+			name: "single element",
+			size: 1,
+			seed: 42,
+		},
+		{
+			name: "prime size",
+			size: 13,
+			seed: 7,
+		},
+		{
+			name: "even size",
+			size: 100,
+			seed: 1,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			permutation := New(currentTest.size, currentTest.seed)
+			seen := make(map[int64]bool)
+			var offset int64
+			for ; offset < currentTest.size; offset += 3 {
+				for _, position := range permutation.Positions(offset, 3) {
+					assert.False(t, seen[position])
+					assert.True(t, position >= 0 && position < currentTest.size)
+					seen[position] = true
+				}
+			}
+			assert.Equal(t, int(currentTest.size), len(seen))
+			assert.Equal(t, permutation.Positions(0, 5), New(currentTest.size, currentTest.seed).Positions(0, 5))
+		})
+	}
+}
+
+func TestPermutation_PositionsOutOfRange(t *testing.T) {
+	permutation := New(10, 1)
+	assert.Equal(t, []int64{}, permutation.Positions(10, 5))
+	assert.Equal(t, []int64{}, permutation.Positions(-1, 5))
+	assert.Equal(t, 2, len(permutation.Positions(8, 5)))
+	assert.Equal(t, []int64{}, New(0, 1).Positions(0, 5))
+}
@@ -3,6 +3,9 @@ package validation
 import (
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/asaskevich/govalidator"
 
@@ -69,3 +72,62 @@ func ValidatePlaylistTitle(title string) (bool, string, error) {
 
 	return true, "", nil
 }
+
+// Названия исполнителей, альбомов, треков и жанров могут быть на кириллице, поэтому длина считается в символах
+func ValidateCatalogName(name string) (bool, string, error) {
+	minLength, _ := strconv.Atoi(constants.MinCatalogNameLength)
+	maxLength, _ := strconv.Atoi(constants.MaxCatalogNameLength)
+
+	length := utf8.RuneCountInString(strings.TrimSpace(name))
+	if length < minLength || length > maxLength {
+		return false, constants.CatalogNameInvalidLengthMessage, nil
+	}
+
+	return true, "", nil
+}
+
+func ValidateDeviceName(name string) (bool, string, error) {
+	minLength, _ := strconv.Atoi(constants.MinDeviceNameLength)
+	maxLength, _ := strconv.Atoi(constants.MaxDeviceNameLength)
+
+	length := utf8.RuneCountInString(strings.TrimSpace(name))
+	if length < minLength || length > maxLength {
+		return false, constants.DeviceNameInvalidLengthMessage, nil
+	}
+
+	return true, "", nil
+}
+
+// Год выпуска не может быть позже следующего года: альбомы иногда добавляются заранее
+func ValidateAlbumYear(year int64) (bool, string, error) {
+	if year < constants.MinAlbumYear || year > int64(time.Now().Year()+1) {
+		return false, constants.AlbumYearInvalidMessage, nil
+	}
+
+	return true, "", nil
+}
+
+// Дата выпуска необязательна, но если указана, то должна совпадать с годом альбома
+func ValidateAlbumReleaseDate(releaseDate string, year int64) (bool, string, error) {
+	if len(releaseDate) == 0 {
+		return true, "", nil
+	}
+	date, err := time.Parse(constants.ChartDateLayout, releaseDate)
+	if err != nil || int64(date.Year()) != year {
+		return false, constants.AlbumReleaseDateInvalidMessage, nil
+	}
+
+	return true, "", nil
+}
+
+func ValidateTrack(title string, number int64) (bool, string, error) {
+	isTitleValid, msg, err := ValidateCatalogName(title)
+	if err != nil || !isTitleValid {
+		return isTitleValid, msg, err
+	}
+	if number < 0 {
+		return false, constants.TrackNumberInvalidMessage, nil
+	}
+
+	return true, "", nil
+}
@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -198,3 +200,194 @@ func TestValidatePlaylistTitle(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCatalogName(t *testing.T) {
+	tests := []struct {
+		name                 string
+		value                string
+		expected             bool
+		expectedErrorMessage string
+	}{
+		{
+			name:     "valid name",
+			value:    "Лёд",
+			expected: true,
+		},
+		{
+			name:                 "blank name",
+			value:                "   ",
+			expectedErrorMessage: constants.CatalogNameInvalidLengthMessage,
+		},
+		{
+			name:                 "too long name",
+			value:                strings.Repeat("я", 101),
+			expectedErrorMessage: constants.CatalogNameInvalidLengthMessage,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			isValid, errMsg, err := ValidateCatalogName(currentTest.value)
+			assert.Equal(t, currentTest.expected, isValid)
+			assert.Equal(t, currentTest.expectedErrorMessage, errMsg)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDeviceName(t *testing.T) {
+	tests := []struct {
+		name                 string
+		value                string
+		expected             bool
+		expectedErrorMessage string
+	}{
+		{
+			name:     "valid name",
+			value:    "Ноутбук",
+			expected: true,
+		},
+		{
+			name:                 "empty name",
+			value:                "",
+			expectedErrorMessage: constants.DeviceNameInvalidLengthMessage,
+		},
+		{
+			name:                 "too long name",
+			value:                strings.Repeat("a", 65),
+			expectedErrorMessage: constants.DeviceNameInvalidLengthMessage,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			isValid, errMsg, err := ValidateDeviceName(currentTest.value)
+			assert.Equal(t, currentTest.expected, isValid)
+			assert.Equal(t, currentTest.expectedErrorMessage, errMsg)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateAlbumYear(t *testing.T) {
+	tests := []struct {
+		name                 string
+		year                 int64
+		expected             bool
+		expectedErrorMessage string
+	}{
+		{
+			name:     "valid year",
+			year:     2019,
+			expected: true,
+		},
+		{
+			name:     "next year",
+			year:     int64(time.Now().Year() + 1),
+			expected: true,
+		},
+		{
+			name:                 "too old",
+			year:                 1899,
+			expectedErrorMessage: constants.AlbumYearInvalidMessage,
+		},
+		{
+			name:                 "far future",
+			year:                 int64(time.Now().Year() + 2),
+			expectedErrorMessage: constants.AlbumYearInvalidMessage,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			isValid, errMsg, err := ValidateAlbumYear(currentTest.year)
+			assert.Equal(t, currentTest.expected, isValid)
+			assert.Equal(t, currentTest.expectedErrorMessage, errMsg)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateAlbumReleaseDate(t *testing.T) {
+	tests := []struct {
+		name                 string
+		releaseDate          string
+		year                 int64
+		expected             bool
+		expectedErrorMessage string
+	}{
+		{
+			name:     "release date is not set",
+			year:     2019,
+			expected: true,
+		},
+		{
+			name:        "valid release date",
+			releaseDate: "2019-03-15",
+			year:        2019,
+			expected:    true,
+		},
+		{
+			name:                 "another year",
+			releaseDate:          "2018-03-15",
+			year:                 2019,
+			expectedErrorMessage: constants.AlbumReleaseDateInvalidMessage,
+		},
+		{
+			name:                 "invalid format",
+			releaseDate:          "15.03.2019",
+			year:                 2019,
+			expectedErrorMessage: constants.AlbumReleaseDateInvalidMessage,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			isValid, errMsg, err := ValidateAlbumReleaseDate(currentTest.releaseDate, currentTest.year)
+			assert.Equal(t, currentTest.expected, isValid)
+			assert.Equal(t, currentTest.expectedErrorMessage, errMsg)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateTrack(t *testing.T) {
+	tests := []struct {
+		name                 string
+		title                string
+		number               int64
+		expected             bool
+		expectedErrorMessage string
+	}{
+		{
+			name:     "valid track",
+			title:    "Ghetto",
+			number:   3,
+			expected: true,
+		},
+		{
+			name:                 "empty title",
+			expectedErrorMessage: constants.CatalogNameInvalidLengthMessage,
+		},
+		{
+			name:                 "negative number",
+			title:                "Ghetto",
+			number:               -1,
+			expectedErrorMessage: constants.TrackNumberInvalidMessage,
+		},
+	}
+
+	for _, test := range tests {
+		currentTest := test
+		t.Run(currentTest.name, func(t *testing.T) {
+			isValid, errMsg, err := ValidateTrack(currentTest.title, currentTest.number)
+			assert.Equal(t, currentTest.expected, isValid)
+			assert.Equal(t, currentTest.expectedErrorMessage, errMsg)
+			assert.NoError(t, err)
+		})
+	}
+}